@@ -0,0 +1,160 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/applicationset/validation"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Validator validates the ApplicationSets on creation and update, as a validating admission webhook. ApplicationSets
+// which would only fail later, once reconciled by the controller, are rejected instead.
+type Validator struct {
+	client client.Reader
+	// namespace is the Argo CD namespace, holding the projects.
+	namespace string
+	// functions provides the template functions registered by the plugins.
+	functions utils.FunctionProvider
+	// repos fetches the templates referenced by the ApplicationSets.
+	repos services.Repos
+}
+
+var _ admission.CustomValidator = &Validator{}
+
+// NewValidator returns a Validator looking up the projects of the ApplicationSets in the given namespace, and fetching
+// their referenced templates with the given repos. tokenRefStrictMode is the strict mode of the tokens of the template
+// function plugins.
+func NewValidator(c client.Reader, namespace string, repos services.Repos, tokenRefStrictMode bool) *Validator {
+	return &Validator{
+		client:    c,
+		namespace: namespace,
+		functions: plugin.NewFunctionRegistry(c, namespace, tokenRefStrictMode),
+		repos:     repos,
+	}
+}
+
+// SetupWithManager registers the validating admission webhook of the ApplicationSets with the webhook server of the
+// manager.
+func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&argov1alpha1.ApplicationSet{}).
+		WithValidator(v).
+		Complete()
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(ctx, obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(ctx, newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator. Deletions are always allowed.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *Validator) validate(ctx context.Context, obj runtime.Object) error {
+	appSet, ok := obj.(*argov1alpha1.ApplicationSet)
+	if !ok {
+		return fmt.Errorf("expected an ApplicationSet, got %T", obj)
+	}
+	// The ApplicationSets being deleted are only updated to remove their finalizers
+	if appSet.DeletionTimestamp != nil {
+		return nil
+	}
+	// The referenced template is validated as it will be rendered by the controller
+	resolved, err := template.ResolveTemplateRef(ctx, v.repos, appSet)
+	if err != nil {
+		return fmt.Errorf("error resolving the template reference of ApplicationSet %s: %w", appSet.Name, err)
+	}
+	appSet = resolved
+
+	errs := []error{
+		utils.CheckInvalidGenerators(appSet),
+		checkDuplicatedListApplications(ctx, appSet),
+		validation.ValidateGenerators(appSet).ToAggregate(),
+		v.checkProject(ctx, appSet),
+	}
+	// The ApplicationSets are not rejected because the plugins could not be listed: the controller reports the
+	// templates which cannot be parsed once reconciled.
+	functions, err := v.functions.Functions(ctx, appSet.Namespace, appSet.Name)
+	if err != nil {
+		log.WithField("applicationset", appSet.Name).WithField("namespace", appSet.Namespace).
+			WithError(err).Warn("error getting the template functions of the plugins, not checking the template syntax")
+	} else {
+		errs = append(errs, utils.CheckTemplateSyntax(appSet, functions))
+	}
+	return errors.Join(errs...)
+}
+
+// checkProject returns an error if the project of the template of the ApplicationSet does not exist, or does not
+// permit its generators. Templated projects are only known once rendered, and are not checked.
+func (v *Validator) checkProject(ctx context.Context, appSet *argov1alpha1.ApplicationSet) error {
+	projectName := appSet.Spec.Template.Spec.Project
+	if projectName == "" || strings.Contains(projectName, "{{") {
+		return nil
+	}
+	project := &argov1alpha1.AppProject{}
+	if err := v.client.Get(ctx, types.NamespacedName{Namespace: v.namespace, Name: projectName}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("ApplicationSet %s references project %s which does not exist", appSet.Name, projectName)
+		}
+		return fmt.Errorf("error getting the project %s of ApplicationSet %s: %w", projectName, appSet.Name, err)
+	}
+	return utils.CheckPermittedGenerators(appSet, project)
+}
+
+// checkDuplicatedListApplications returns an error if the elements of the List generators of the ApplicationSet
+// produce several Applications of the same name. The other generators are only known when reconciled, and the List
+// generators whose elements cannot be rendered yet, e.g. because they rely on parameters added by an enricher or on
+// template functions registered by plugins, are not checked.
+func checkDuplicatedListApplications(ctx context.Context, appSet *argov1alpha1.ApplicationSet) error {
+	listGenerator := generators.NewListGenerator()
+	names := map[string]bool{}
+	var duplicated []string
+	for i := range appSet.Spec.Generators {
+		generator := &appSet.Spec.Generators[i]
+		if generator.List == nil || generator.List.Template.Name != "" {
+			continue
+		}
+		params, err := listGenerator.GenerateParams(generator, appSet, nil)
+		if err != nil {
+			return fmt.Errorf("error generating the parameters of list generator %d: %w", i, err)
+		}
+		apps, err := template.RenderApplications(ctx, nil, *appSet, params, &utils.Render{})
+		if err != nil {
+			continue
+		}
+		for _, app := range apps {
+			if names[app.Name] && !slices.Contains(duplicated, app.Name) {
+				duplicated = append(duplicated, app.Name)
+			}
+			names[app.Name] = true
+		}
+	}
+	if len(duplicated) > 0 {
+		return fmt.Errorf("ApplicationSet %s generates several Applications named %s from its list generators", appSet.Name, strings.Join(duplicated, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestValidator(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argov1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	project := &argov1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+	}
+	functions := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "naming",
+			Namespace: "argocd",
+			Labels:    map[string]string{common.LabelKeyApplicationSetTemplateFunctions: "true"},
+		},
+		Data: map[string]string{"baseUrl": "http://naming", "token": "$plugin.token", "functions": "teamOf"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd"},
+		Data:       map[string][]byte{"plugin.token": []byte("token")},
+	}
+	restricted := &argov1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "argocd"},
+		Spec:       argov1alpha1.AppProjectSpec{ApplicationSetGeneratorBlacklist: []string{"list"}},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, restricted, functions, secret).Build()
+	validator := NewValidator(client, "argocd", nil, false)
+
+	listGenerator := func(elements ...string) argov1alpha1.ApplicationSetGenerator {
+		list := &argov1alpha1.ListGenerator{}
+		for _, element := range elements {
+			list.Elements = append(list.Elements, apiextensionsv1.JSON{Raw: []byte(`{"cluster": "` + element + `"}`)})
+		}
+		return argov1alpha1.ApplicationSetGenerator{List: list}
+	}
+	appSet := func(mutate func(*argov1alpha1.ApplicationSet)) *argov1alpha1.ApplicationSet {
+		appSet := &argov1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec: argov1alpha1.ApplicationSetSpec{
+				GoTemplate: true,
+				Generators: []argov1alpha1.ApplicationSetGenerator{listGenerator("dev", "prod")},
+				Template: argov1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: argov1alpha1.ApplicationSetTemplateMeta{Name: "{{.cluster}}-guestbook"},
+					Spec:                       argov1alpha1.ApplicationSpec{Project: "default"},
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(appSet)
+		}
+		return appSet
+	}
+
+	templateRef := &argov1alpha1.ApplicationSetTemplateRef{
+		Git: &argov1alpha1.ApplicationSetTemplateGitRef{
+			RepoURL: "https://github.com/argoproj/templates",
+			Path:    "guestbook/template.yaml",
+		},
+	}
+
+	for _, c := range []struct {
+		name          string
+		appSet        *argov1alpha1.ApplicationSet
+		expectedError string
+	}{
+		{
+			name:   "valid ApplicationSet",
+			appSet: appSet(nil),
+		},
+		{
+			name: "unrecognized generator",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Generators = append(appSet.Spec.Generators, argov1alpha1.ApplicationSetGenerator{})
+			}),
+			expectedError: "ApplicationSet guestbook contains unrecognized generators",
+		},
+		{
+			name: "invalid Go template",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Template.Spec.Destination.Namespace = "{{.cluster"
+			}),
+			expectedError: "failed to parse template {{.cluster: template: :1: unclosed action",
+		},
+		{
+			name: "invalid Go template patch",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.TemplatePatch = ptr.To("{{ if .cluster }}")
+			}),
+			expectedError: "failed to parse template {{ if .cluster }}",
+		},
+		{
+			name: "template function registered by a plugin",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Template.Spec.Destination.Namespace = "{{ teamOf .cluster }}"
+			}),
+		},
+		{
+			name: "unknown template function",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Template.Spec.Destination.Namespace = "{{ clusterOf .cluster }}"
+			}),
+			expectedError: `function "clusterOf" not defined`,
+		},
+		{
+			name: "legacy template syntax is not parsed as Go template",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.GoTemplate = false
+				appSet.Spec.Template.Name = "{{cluster}}-guestbook"
+				appSet.Spec.Template.Spec.Destination.Namespace = "{{.cluster"
+			}),
+		},
+		{
+			name: "duplicated Application names within a list generator",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Generators = []argov1alpha1.ApplicationSetGenerator{listGenerator("dev", "prod", "dev")}
+			}),
+			expectedError: "ApplicationSet guestbook generates several Applications named dev-guestbook from its list generators",
+		},
+		{
+			name: "duplicated Application names across list generators",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Generators = append(appSet.Spec.Generators, listGenerator("prod"))
+			}),
+			expectedError: "ApplicationSet guestbook generates several Applications named prod-guestbook from its list generators",
+		},
+		{
+			name: "list elements which cannot be rendered yet are not checked",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.GoTemplateOptions = []string{"missingkey=error"}
+				appSet.Spec.Template.Name = "{{.cluster}}-{{.enriched}}"
+				appSet.Spec.Generators = []argov1alpha1.ApplicationSetGenerator{listGenerator("dev", "dev")}
+			}),
+		},
+		{
+			name: "invalid generator",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Generators = append(appSet.Spec.Generators, argov1alpha1.ApplicationSetGenerator{
+					Git: &argov1alpha1.GitGenerator{Directories: []argov1alpha1.GitDirectoryGeneratorItem{{Path: "*"}}},
+				})
+			}),
+			expectedError: "spec.generators[1].git.repoURL: Required value",
+		},
+		{
+			name: "unknown project",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Template.Spec.Project = "unknown"
+			}),
+			expectedError: "ApplicationSet guestbook references project unknown which does not exist",
+		},
+		{
+			name: "generators not permitted by the project",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Template.Spec.Project = "restricted"
+			}),
+			expectedError: "ApplicationSet guestbook contains generators which are not permitted in project restricted: list",
+		},
+		{
+			name: "templated project is not checked",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.Template.Spec.Project = "{{.cluster}}"
+			}),
+		},
+		{
+			name: "template reference which cannot be fetched",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.Spec.TemplateRef = templateRef
+			}),
+			expectedError: "error resolving the template reference of ApplicationSet guestbook: template references are not supported",
+		},
+		{
+			name: "ApplicationSet being deleted",
+			appSet: appSet(func(appSet *argov1alpha1.ApplicationSet) {
+				appSet.DeletionTimestamp = ptr.To(metav1.Now())
+				appSet.Spec.Template.Spec.Project = "unknown"
+			}),
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, createErr := validator.ValidateCreate(t.Context(), c.appSet)
+			_, updateErr := validator.ValidateUpdate(t.Context(), appSet(nil), c.appSet)
+			if c.expectedError == "" {
+				require.NoError(t, createErr)
+				require.NoError(t, updateErr)
+				return
+			}
+			require.ErrorContains(t, createErr, c.expectedError)
+			require.ErrorContains(t, updateErr, c.expectedError)
+		})
+	}
+
+	t.Run("all errors are reported", func(t *testing.T) {
+		_, err := validator.ValidateCreate(t.Context(), appSet(func(appSet *argov1alpha1.ApplicationSet) {
+			appSet.Spec.Template.Spec.Project = "unknown"
+			appSet.Spec.Template.Spec.Destination.Namespace = "{{.cluster"
+		}))
+		require.ErrorContains(t, err, "failed to parse template {{.cluster")
+		require.ErrorContains(t, err, "references project unknown which does not exist")
+	})
+
+	t.Run("referenced template is validated", func(t *testing.T) {
+		repos := &mocks.Repos{}
+		repos.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(map[string][]byte{"guestbook/template.yaml": []byte("spec:\n  project: unknown\n  destination:\n    namespace: '{{.cluster'\n")}, nil, nil)
+		validator := NewValidator(client, "argocd", repos, false)
+
+		_, err := validator.ValidateCreate(t.Context(), appSet(func(appSet *argov1alpha1.ApplicationSet) {
+			appSet.Spec.Template.Spec.Project = ""
+			appSet.Spec.TemplateRef = templateRef
+		}))
+		require.ErrorContains(t, err, "failed to parse template {{.cluster")
+		require.ErrorContains(t, err, "references project unknown which does not exist")
+	})
+
+	t.Run("template syntax is not checked when the plugins cannot be listed", func(t *testing.T) {
+		validator := NewValidator(client, "argocd", nil, false)
+		validator.functions = failingFunctionProvider{}
+
+		_, err := validator.ValidateCreate(t.Context(), appSet(func(appSet *argov1alpha1.ApplicationSet) {
+			appSet.Spec.Template.Spec.Destination.Namespace = "{{.cluster | teamOf}}"
+		}))
+		require.NoError(t, err)
+		_, err = validator.ValidateCreate(t.Context(), appSet(func(appSet *argov1alpha1.ApplicationSet) {
+			appSet.Spec.Template.Spec.Project = "unknown"
+		}))
+		require.ErrorContains(t, err, "references project unknown which does not exist")
+	})
+
+	t.Run("deletion is always allowed", func(t *testing.T) {
+		_, err := validator.ValidateDelete(t.Context(), appSet(func(appSet *argov1alpha1.ApplicationSet) {
+			appSet.Spec.Template.Spec.Project = "unknown"
+		}))
+		assert.NoError(t, err)
+	})
+}
+
+type failingFunctionProvider struct{}
+
+func (failingFunctionProvider) Functions(context.Context, string, string) (template.FuncMap, error) {
+	return nil, errors.New("plugins cannot be listed")
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+var ErrCacheMiss = cacheutil.ErrCacheMiss
+
+// Cache stores the parameters produced by the ApplicationSet generators in the shared Argo CD cache, so that they
+// survive restarts of the ApplicationSet controller and are shared between its replicas.
+type Cache struct {
+	cache                    *cacheutil.Cache
+	generatorCacheExpiration time.Duration
+}
+
+func NewCache(cache *cacheutil.Cache, generatorCacheExpiration time.Duration) *Cache {
+	return &Cache{cache, generatorCacheExpiration}
+}
+
+// AddCacheFlagsToCmd adds the cache flags to the command. The returned function returns a nil cache when the
+// generator cache is disabled, which is the case when its expiration is 0.
+func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*Cache, error) {
+	var generatorCacheExpiration time.Duration
+
+	cmd.Flags().DurationVar(&generatorCacheExpiration, "generator-cache-expiration", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATOR_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Cache expiration for the parameters produced by the Git, SCM provider, pull request and plugin generators. The cache is disabled when set to 0")
+
+	fn := cacheutil.AddCacheFlagsToCmd(cmd, opts...)
+
+	return func() (*Cache, error) {
+		if generatorCacheExpiration == 0 {
+			return nil, nil
+		}
+
+		cache, err := fn()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCache(cache, generatorCacheExpiration), nil
+	}
+}
+
+// The generation of the ApplicationSet is part of the key, as the parameters produced by a generator may depend on
+// other fields of the spec, such as goTemplate. The version is changed by InvalidateGeneratorParams.
+func generatorParamsKey(appSet *appv1.ApplicationSet, version string, generatorHash string) string {
+	return fmt.Sprintf("appset|%s|%d|%s|generator|%s", appSet.UID, appSet.Generation, version, generatorHash)
+}
+
+func generatorParamsVersionKey(appSet *appv1.ApplicationSet) string {
+	return fmt.Sprintf("appset|%s|generator-params-version", appSet.UID)
+}
+
+// generatorParamsVersion returns the version of the parameters cached for the ApplicationSet, which is empty until
+// they are invalidated.
+func (c *Cache) generatorParamsVersion(appSet *appv1.ApplicationSet) (string, error) {
+	var version string
+	err := c.cache.GetItem(generatorParamsVersionKey(appSet), &version)
+	if err != nil && !errors.Is(err, ErrCacheMiss) {
+		return "", fmt.Errorf("error getting generator params version: %w", err)
+	}
+	return version, nil
+}
+
+// InvalidateGeneratorParams invalidates the parameters cached for all the generators of the ApplicationSet, e.g. when
+// a webhook notifies a change of the Git repositories or pull requests they are generated from.
+func (c *Cache) InvalidateGeneratorParams(appSet *appv1.ApplicationSet) error {
+	// The version outlives the parameters cached before it was changed, so that they cannot be used anymore.
+	version := strconv.FormatInt(time.Now().UnixNano(), 10)
+	return c.cache.SetItem(generatorParamsVersionKey(appSet), version, &cacheutil.CacheActionOpts{Expiration: c.generatorCacheExpiration})
+}
+
+// GetGeneratorParams returns the parameters cached for the generator identified by generatorHash, or ErrCacheMiss.
+func (c *Cache) GetGeneratorParams(appSet *appv1.ApplicationSet, generatorHash string) ([]map[string]any, error) {
+	version, err := c.generatorParamsVersion(appSet)
+	if err != nil {
+		return nil, err
+	}
+	// The parameters are stored as JSON, so that they are decoded to the same types whatever the cache client.
+	var data string
+	if err := c.cache.GetItem(generatorParamsKey(appSet, version, generatorHash), &data); err != nil {
+		return nil, err
+	}
+	var params []map[string]any
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		return nil, fmt.Errorf("error unmarshaling cached generator params: %w", err)
+	}
+	return params, nil
+}
+
+// SetGeneratorParams caches the parameters produced by the generator identified by generatorHash.
+func (c *Cache) SetGeneratorParams(appSet *appv1.ApplicationSet, generatorHash string, params []map[string]any) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("error marshaling generator params: %w", err)
+	}
+	version, err := c.generatorParamsVersion(appSet)
+	if err != nil {
+		return err
+	}
+	return c.cache.SetItem(generatorParamsKey(appSet, version, generatorHash), string(data), &cacheutil.CacheActionOpts{Expiration: c.generatorCacheExpiration})
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+func TestCache_GeneratorParams(t *testing.T) {
+	cache := NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+	appSet := &appv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{UID: "uid", Generation: 1}}
+
+	// cache miss
+	_, err := cache.GetGeneratorParams(appSet, "hash")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// populate cache
+	err = cache.SetGeneratorParams(appSet, "hash", []map[string]any{{"branch": "main", "labels": []string{"a"}, "number": 1}})
+	require.NoError(t, err)
+	// cache miss
+	_, err = cache.GetGeneratorParams(appSet, "other-hash")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache miss
+	_, err = cache.GetGeneratorParams(&appv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{UID: "uid", Generation: 2}}, "hash")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache miss
+	_, err = cache.GetGeneratorParams(&appv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{UID: "other-uid", Generation: 1}}, "hash")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache hit, values are decoded the same way whatever the cache client
+	params, err := cache.GetGeneratorParams(appSet, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"branch": "main", "labels": []any{"a"}, "number": float64(1)}}, params)
+}
+
+func TestCache_InvalidateGeneratorParams(t *testing.T) {
+	cache := NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+	appSet := &appv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{UID: "uid", Generation: 1}}
+	other := &appv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{UID: "other-uid", Generation: 1}}
+
+	for _, a := range []*appv1.ApplicationSet{appSet, other} {
+		require.NoError(t, cache.SetGeneratorParams(a, "hash", []map[string]any{{"branch": "main"}}))
+	}
+	require.NoError(t, cache.InvalidateGeneratorParams(appSet))
+
+	// cache miss
+	_, err := cache.GetGeneratorParams(appSet, "hash")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache hit, the other ApplicationSets are not invalidated
+	_, err = cache.GetGeneratorParams(other, "hash")
+	require.NoError(t, err)
+
+	// the parameters cached after the invalidation are used
+	require.NoError(t, cache.SetGeneratorParams(appSet, "hash", []map[string]any{{"branch": "feature"}}))
+	params, err := cache.GetGeneratorParams(appSet, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"branch": "feature"}}, params)
+}
@@ -16,17 +16,24 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,10 +53,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
+	"github.com/argoproj/argo-cd/v3/applicationset/enrichers"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/hooks"
 	"github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/status"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/applicationset/validation"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/db"
 
@@ -66,8 +77,19 @@ const (
 	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
 	NotifiedAnnotationKey             = "notified.notifications.argoproj.io"
 	ReconcileRequeueOnValidationError = time.Minute * 3
+	// lastSuccessfulReconcileAtInterval is the minimum interval between two updates of the time of the last successful
+	// reconciliation in the status of an ApplicationSet.
+	lastSuccessfulReconcileAtInterval = time.Minute
+	// ReconcileRequeueOnPostponedCreations is the delay before the creation of the Applications postponed by
+	// MaxPendingCreationsPerCluster is retried.
+	ReconcileRequeueOnPostponedCreations = time.Second * 10
+	// tracerName is the name of the tracer of the applicationset reconciliations
+	tracerName = "github.com/argoproj/argo-cd/v3/applicationset/controllers"
 )
 
+// errGeneratorsNotPermitted is returned when the project of an ApplicationSet does not permit its generators
+var errGeneratorsNotPermitted = errors.New("generators not permitted")
+
 var defaultPreservedAnnotations = []string{
 	NotifiedAnnotationKey,
 	argov1alpha1.AnnotationKeyRefresh,
@@ -76,6 +98,9 @@ var defaultPreservedAnnotations = []string{
 // ApplicationSetReconciler reconciles a ApplicationSet object
 type ApplicationSetReconciler struct {
 	client.Client
+	// APIReader, if set, reads the Applications from the API server rather than from the cache of the client, when
+	// their update is retried after a conflict.
+	APIReader            client.Reader
 	Scheme               *runtime.Scheme
 	Recorder             record.EventRecorder
 	Generators           map[string]generators.Generator
@@ -91,6 +116,42 @@ type ApplicationSetReconciler struct {
 	GlobalPreservedAnnotations []string
 	GlobalPreservedLabels      []string
 	Metrics                    *metrics.ApplicationsetMetrics
+	// EnableServerSideApply makes the controller write the generated Applications with server-side apply, using
+	// ServerSideApplyFieldManager as field manager.
+	EnableServerSideApply       bool
+	ServerSideApplyFieldManager string
+	// Enricher, if set, enriches the parameter sets produced by the generators before the template is rendered.
+	Enricher enrichers.Enricher
+	// Hooks, if set, runs the pre-render and post-render hooks of the ApplicationSets.
+	Hooks *hooks.Runner
+	// StrictGenerators makes the unrecognized generators of an ApplicationSet block its reconciliation, unless
+	// overridden by the common.AnnotationApplicationSetStrictGenerators annotation of the ApplicationSet.
+	StrictGenerators bool
+	// DisableLegacyTemplates blocks the reconciliation of the ApplicationSets which do not use Go templates, rendered
+	// with the legacy fasttemplate syntax.
+	DisableLegacyTemplates bool
+	// Repos fetches the templates referenced by the ApplicationSets.
+	Repos services.Repos
+	// MinRequeueAfter is the minimum interval between the periodic reconciliations of an ApplicationSet requested by
+	// its generators, protecting the SCM APIs from too frequent polling. Zero means no minimum.
+	MinRequeueAfter time.Duration
+	// CRDCapabilities, if set, lists the fields known by the ApplicationSet CRD when the controller started. The
+	// features depending on the other fields are disabled, and the ApplicationSets using them are not reconciled.
+	CRDCapabilities *utils.CRDCapabilities
+	// MaxPendingCreationsPerCluster limits the number of Applications of an ApplicationSet targeting the same
+	// destination cluster which are created but not yet reconciled by the application controller. The other creations
+	// are postponed to the next reconciliations, so that the admission webhooks of the cluster and the refresh queue of
+	// the application controller are not flooded. Zero means no limit.
+	MaxPendingCreationsPerCluster int
+	// ApplicationSpecHash stamps the generated Applications with the hash of their desired state, and skips the
+	// comparison of the Applications whose hash is unchanged and which were not modified since they were last compared.
+	ApplicationSpecHash bool
+	// FullApplicationDiff, for debugging, compares all the generated Applications with their live state even when
+	// their hash is unchanged.
+	FullApplicationDiff bool
+
+	syncedApplications   syncedApplications
+	replacedApplications replacedApplications
 }
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
@@ -112,6 +173,12 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}()
 
+	// The reconciliation span links the reconcile duration metric to the trace through an exemplar
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "reconcile", oteltrace.WithAttributes(
+		attribute.String("applicationset", req.NamespacedName.String()),
+	))
+	defer span.End()
+
 	var applicationSetInfo argov1alpha1.ApplicationSet
 	parametersGenerated := false
 	startTime := time.Now()
@@ -123,7 +190,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	defer func() {
-		r.Metrics.ObserveReconcile(&applicationSetInfo, time.Since(startTime))
+		r.Metrics.ObserveReconcile(ctx, &applicationSetInfo, time.Since(startTime))
 	}()
 
 	// Do not attempt to further reconcile the ApplicationSet if it is being deleted.
@@ -150,11 +217,117 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	// Log a warning if there are unrecognized generators
-	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
+	// Log a warning if there are unrecognized generators, and stop there in strict mode
+	if err := utils.CheckInvalidGenerators(&applicationSetInfo); err != nil && r.strictGenerators(&applicationSetInfo) {
+		logCtx.Errorf("unrecognized generators: %v", err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonInvalidGenerators,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+
+	if err := validation.ValidateGenerators(&applicationSetInfo).ToAggregate(); err != nil {
+		logCtx.Errorf("invalid generators: %v", err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonInvalidGenerators,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+
+	if r.DisableLegacyTemplates && !applicationSetInfo.Spec.GoTemplate {
+		message := "the legacy template syntax is disabled: enable goTemplate, for instance with `argocd admin appset migrate-template`"
+		logCtx.Error(message)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: message,
+				Reason:  argov1alpha1.ApplicationSetReasonLegacyTemplateDisabled,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+
+	if r.CRDCapabilities != nil {
+		if unsupported := r.CRDCapabilities.UnsupportedFields(&applicationSetInfo); len(unsupported) > 0 {
+			message := fmt.Sprintf("the installed ApplicationSet CRD does not support %s: upgrade the CRD and restart the ApplicationSet controller", strings.Join(unsupported, ", "))
+			logCtx.Error(message)
+			_ = r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+					Message: message,
+					Reason:  argov1alpha1.ApplicationSetReasonUnsupportedByCRD,
+					Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+				}, parametersGenerated,
+			)
+			return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+		}
+	}
+
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
-	desiredApplications, applicationSetReason, err := template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+	enricher := r.Enricher
+	if r.Hooks != nil {
+		enricher = r.Hooks.Enricher(r.Enricher)
+	}
+	// The referenced template is only resolved in a copy of the ApplicationSet, so that it is never persisted
+	resolvedAppSet, err := template.ResolveTemplateRef(ctx, r.Repos, &applicationSetInfo)
 	if err != nil {
+		logCtx.Errorf("unable to resolve the template reference: %v", err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonTemplateRefError,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+	// The generators not permitted by the project are never run
+	if err := r.checkPermittedGenerators(ctx, resolvedAppSet); err != nil {
+		if !errors.Is(err, errGeneratorsNotPermitted) {
+			return ctrl.Result{}, fmt.Errorf("failed to get the project of the application set: %w", err)
+		}
+		logCtx.Error(err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonGeneratorNotPermitted,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+	// With Go templates, the status of the current Applications is available to the templates using it
+	var templateApplications []argov1alpha1.Application
+	if template.UsesAppParam(resolvedAppSet) {
+		templateApplications, err = r.getCurrentApplications(ctx, applicationSetInfo)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get current applications for application set: %w", err)
+		}
+	}
+	desiredApplications, generatedParameterSets, applicationSetReason, err := template.GenerateApplications(ctx, logCtx, *resolvedAppSet, r.Generators, enricher, r.Renderer, r.Client, templateApplications)
+	// When some parameter sets could not be generated, the Applications of the other ones are still created and
+	// updated, but none is deleted since the missing ones cannot be told apart from the removed ones.
+	incompleteParams := errors.Is(err, generators.ErrIncompleteParams)
+	if err != nil && !incompleteParams {
 		logCtx.Errorf("unable to generate applications: %v", err)
 		_ = r.setApplicationSetStatusCondition(ctx,
 			&applicationSetInfo,
@@ -169,7 +342,28 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
 	}
 
-	parametersGenerated = true
+	if incompleteParams {
+		logCtx.Warnf("some applications could not be generated: %v", err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  string(applicationSetReason),
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+	} else {
+		parametersGenerated = true
+	}
+
+	// The status fields computed from here on are written in a single update when the reconciliation ends
+	reconcileStatus := &appSetReconcileStatus{generatedParameterSets: generatedParameterSets}
+	defer func() {
+		if statusErr := r.setReconcileStatus(ctx, &applicationSetInfo, reconcileStatus); statusErr != nil && err == nil {
+			result, err = ctrl.Result{}, statusErr
+		}
+	}()
 
 	validateErrors, err := r.validateGeneratedApplications(ctx, desiredApplications, applicationSetInfo)
 	if err != nil {
@@ -194,6 +388,30 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
 	}
 
+	if r.Hooks != nil {
+		// Vetoed Applications are handled like invalid ones: they are neither created nor updated, but not deleted
+		vetoes, err := r.Hooks.PostRender(ctx, &applicationSetInfo, desiredApplications)
+		if err != nil {
+			logCtx.Errorf("error occurred during post-render hooks: %s", err.Error())
+
+			_ = r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+					Message: err.Error(),
+					Reason:  argov1alpha1.ApplicationSetReasonApplicationValidationError,
+					Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+				}, parametersGenerated,
+			)
+			return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+		}
+		for i, veto := range vetoes {
+			if validateErrors[i] == nil {
+				validateErrors[i] = veto
+			}
+		}
+	}
+
 	currentApplications, err := r.getCurrentApplications(ctx, applicationSetInfo)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to get current applications for application set: %w", err)
@@ -266,6 +484,46 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	frozenUntil, frozen, err := applicationSetInfo.Spec.ActiveFreezeWindowEnd(startReconcile)
+	if err != nil {
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonInvalidFreezeWindow,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		logCtx.WithError(err).Error("invalid freeze window")
+		// In order for the controller SDK to respect RequeueAfter, the error must be nil
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+	if frozen {
+		// The Applications are left as is until the freeze windows end, only the changes are reported
+		message := r.getFrozenChangesMessage(logCtx, applicationSetInfo, currentApplications, validApps, desiredApplications, frozenUntil)
+		logCtx.WithField("frozenUntil", frozenUntil).Info(message)
+		if err := r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionResourcesUpToDate,
+				Message: message,
+				Reason:  argov1alpha1.ApplicationSetReasonFreezeWindowActive,
+				Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+			}, parametersGenerated,
+		); err != nil {
+			return ctrl.Result{}, err
+		}
+		requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+		if untilEnd := frozenUntil.Sub(startReconcile); requeueAfter == 0 || untilEnd < requeueAfter {
+			requeueAfter = untilEnd
+		}
+		r.Metrics.SetRequeueInterval(&applicationSetInfo, requeueAfter)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	validApps, postponedCreations := r.postponeCreations(logCtx, validApps, currentApplications)
+
 	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
 		err = r.createOrUpdateInCluster(ctx, logCtx, applicationSetInfo, validApps)
 		if err != nil {
@@ -296,8 +554,18 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
-		err = r.deleteInCluster(ctx, logCtx, applicationSetInfo, desiredApplications)
+	// The drifted Applications are only left as is when the policy does not allow to update them
+	var driftedApplications []string
+	if !utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
+		driftedApplications = r.getDriftedApplications(logCtx, applicationSetInfo, currentApplications, validApps)
+	}
+	reconcileStatus.driftedApplications = driftedApplications
+	reconcileStatus.driftedApplicationsComputed = true
+
+	// The deletion of the Applications waiting for their replacement to be Healthy is retried after requeueAfterDeletion
+	var requeueAfterDeletion time.Duration
+	if !incompleteParams && utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
+		requeueAfterDeletion, err = r.deleteInCluster(ctx, logCtx, applicationSetInfo, desiredApplications)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
 				&applicationSetInfo,
@@ -331,8 +599,14 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if requeueAfterDeletion > 0 && (requeueAfter == 0 || requeueAfterDeletion < requeueAfter) {
+		requeueAfter = requeueAfterDeletion
+	}
+	if postponedCreations > 0 && (requeueAfter == 0 || ReconcileRequeueOnPostponedCreations < requeueAfter) {
+		requeueAfter = ReconcileRequeueOnPostponedCreations
+	}
 
-	if len(validateErrors) == 0 {
+	if len(validateErrors) == 0 && !incompleteParams {
 		if err := r.setApplicationSetStatusCondition(ctx,
 			&applicationSetInfo,
 			argov1alpha1.ApplicationSetCondition{
@@ -344,11 +618,13 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		); err != nil {
 			return ctrl.Result{}, err
 		}
+		reconcileStatus.lastSuccessfulReconcileAt = &startReconcile
 	} else if requeueAfter == time.Duration(0) {
-		// Ensure that the request is requeued if there are validation errors.
+		// Ensure that the request is requeued if there are validation or generation errors.
 		requeueAfter = ReconcileRequeueOnValidationError
 	}
 
+	r.Metrics.SetRequeueInterval(&applicationSetInfo, requeueAfter)
 	logCtx.WithField("requeueAfter", requeueAfter).Info("end reconcile in ", time.Since(startReconcile))
 
 	return ctrl.Result{
@@ -415,6 +691,10 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 
 	paramtersGeneratedCondition := getParametersGeneratedCondition(paramtersGenerated, condition.Message)
 	resourceUpToDateCondition := getResourceUpToDateCondition(errOccurred, condition.Message, condition.Reason)
+	if condition.Type == argov1alpha1.ApplicationSetConditionResourcesUpToDate && condition.Status == argov1alpha1.ApplicationSetConditionStatusFalse {
+		// The resources are not up to date without any error, e.g. while a freeze window is active
+		resourceUpToDateCondition = condition
+	}
 
 	evaluatedTypes := map[argov1alpha1.ApplicationSetConditionType]bool{
 		argov1alpha1.ApplicationSetConditionErrorOccurred:       true,
@@ -475,6 +755,19 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 	return nil
 }
 
+// strictGenerators returns whether the unrecognized generators of the ApplicationSet block its reconciliation: the
+// annotation of the ApplicationSet, when it is a valid boolean, takes precedence over the setting of the controller.
+func (r *ApplicationSetReconciler) strictGenerators(applicationSet *argov1alpha1.ApplicationSet) bool {
+	if value, ok := applicationSet.Annotations[common.AnnotationApplicationSetStrictGenerators]; ok {
+		strict, err := strconv.ParseBool(value)
+		if err == nil {
+			return strict
+		}
+		log.WithField("applicationset", applicationSet.Name).Warnf("ignoring invalid value %q of the %s annotation", value, common.AnnotationApplicationSetStrictGenerators)
+	}
+	return r.StrictGenerators
+}
+
 // validateGeneratedApplications uses the Argo CD validation functions to verify the correctness of the
 // generated applications.
 func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet) (map[int]error, error) {
@@ -497,6 +790,11 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 			return nil, err
 		}
 
+		if err := utils.CheckPermittedGenerators(&applicationSetInfo, appProject); err != nil {
+			errorsByIndex[i] = err
+			continue
+		}
+
 		if _, err = argoutil.GetDestinationCluster(ctx, app.Spec.Destination, r.ArgoDB); err != nil {
 			errorsByIndex[i] = fmt.Errorf("application destination spec is invalid: %s", err.Error())
 			continue
@@ -506,6 +804,40 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 	return errorsByIndex, nil
 }
 
+// checkPermittedGenerators returns an error wrapping errGeneratorsNotPermitted if the generators of the ApplicationSet
+// are not permitted by its project, or if the project does not exist. Since a templated project may be rendered to
+// any project, the generators must then be permitted by every project.
+func (r *ApplicationSetReconciler) checkPermittedGenerators(ctx context.Context, applicationSetInfo *argov1alpha1.ApplicationSet) error {
+	var appProjects []argov1alpha1.AppProject
+	projectName := applicationSetInfo.Spec.Template.Spec.GetProject()
+	if strings.Contains(projectName, "{{") {
+		appProjectList := &argov1alpha1.AppProjectList{}
+		if err := r.List(ctx, appProjectList, client.InNamespace(r.ArgoCDNamespace)); err != nil {
+			return err
+		}
+		appProjects = appProjectList.Items
+	} else {
+		appProject := &argov1alpha1.AppProject{}
+		err := r.Get(ctx, types.NamespacedName{Name: projectName, Namespace: r.ArgoCDNamespace}, appProject)
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: application set references project %s which does not exist", errGeneratorsNotPermitted, projectName)
+		}
+		if err != nil {
+			return err
+		}
+		appProjects = []argov1alpha1.AppProject{*appProject}
+	}
+	for i := range appProjects {
+		if err := utils.CheckPermittedGenerators(applicationSetInfo, &appProjects[i]); err != nil {
+			if projectName != appProjects[i].Name {
+				return fmt.Errorf("%w: %w, and the templated project may be rendered to it", errGeneratorsNotPermitted, err)
+			}
+			return fmt.Errorf("%w: %w", errGeneratorsNotPermitted, err)
+		}
+	}
+	return nil
+}
+
 func (r *ApplicationSetReconciler) getMinRequeueAfter(applicationSetInfo *argov1alpha1.ApplicationSet) time.Duration {
 	var res time.Duration
 	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
@@ -522,6 +854,9 @@ func (r *ApplicationSetReconciler) getMinRequeueAfter(applicationSetInfo *argov1
 		}
 	}
 
+	if res != 0 && res < r.MinRequeueAfter {
+		return r.MinRequeueAfter
+	}
 	return res
 }
 
@@ -551,6 +886,10 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &argov1alpha1.Application{}, ".metadata.controller", appControllerIndexer); err != nil {
 		return fmt.Errorf("error setting up with manager: %w", err)
 	}
+	// Used by the cluster generator to list the cluster secrets without scanning every secret
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &corev1.Secret{}, utils.SecretTypeIndexField, utils.SecretTypeIndexer); err != nil {
+		return fmt.Errorf("error setting up with manager: %w", err)
+	}
 
 	appOwnsHandler := getApplicationOwnsHandler(enableProgressiveSyncs)
 	appSetOwnsHandler := getApplicationSetOwnsHandler()
@@ -582,6 +921,27 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 		// Normalize to avoid fighting with the application controller.
 		generatedApp.Spec = *argoutil.NormalizeApplicationSpec(&generatedApp.Spec)
 
+		var specHash string
+		var live *argov1alpha1.Application
+		if r.ApplicationSpecHash {
+			preservedAnnotations, preservedLabels := r.getPreservedFields(applicationSet)
+			hash, err := applicationSpecHash(applicationSet, &generatedApp, preservedAnnotations, preservedLabels)
+			if err != nil {
+				appLog.WithError(err).Error("failed to hash Application")
+				if firstError == nil {
+					firstError = err
+				}
+				continue
+			}
+			specHash = hash
+			var unchanged bool
+			unchanged, live = r.isApplicationUnchanged(ctx, &generatedApp, specHash)
+			if unchanged && !r.FullApplicationDiff {
+				appLog.Debug("Application unchanged since it was last compared, skipping the comparison")
+				continue
+			}
+		}
+
 		found := &argov1alpha1.Application{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      generatedApp.Name,
@@ -593,51 +953,36 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			},
 		}
 
-		action, err := utils.CreateOrUpdate(ctx, appLog, r.Client, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
+		mutateFn := func() error {
+			// The MutateFn is called again on the fetched Application when its update conflicts with a concurrent
+			// edit, so the preserved fields are merged into a copy of the generatedApp
+			desiredApp := generatedApp.DeepCopy()
+
 			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
-			found.Spec = generatedApp.Spec
+			found.Spec = desiredApp.Spec
 
 			// allow setting the Operation field to trigger a sync operation on an Application
-			if generatedApp.Operation != nil {
-				found.Operation = generatedApp.Operation
-			}
-
-			preservedAnnotations := make([]string, 0)
-			preservedLabels := make([]string, 0)
-
-			if applicationSet.Spec.PreservedFields != nil {
-				preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
-				preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
-			}
-
-			if len(r.GlobalPreservedAnnotations) > 0 {
-				preservedAnnotations = append(preservedAnnotations, r.GlobalPreservedAnnotations...)
-			}
-
-			if len(r.GlobalPreservedLabels) > 0 {
-				preservedLabels = append(preservedLabels, r.GlobalPreservedLabels...)
+			if desiredApp.Operation != nil {
+				found.Operation = desiredApp.Operation
 			}
 
-			// Preserve specially treated argo cd annotations:
-			// * https://github.com/argoproj/applicationset/issues/180
-			// * https://github.com/argoproj/argo-cd/issues/10500
-			preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
+			preservedAnnotations, preservedLabels := r.getPreservedFields(applicationSet)
 
 			for _, key := range preservedAnnotations {
 				if state, exists := found.Annotations[key]; exists {
-					if generatedApp.Annotations == nil {
-						generatedApp.Annotations = map[string]string{}
+					if desiredApp.Annotations == nil {
+						desiredApp.Annotations = map[string]string{}
 					}
-					generatedApp.Annotations[key] = state
+					desiredApp.Annotations[key] = state
 				}
 			}
 
 			for _, key := range preservedLabels {
 				if state, exists := found.Labels[key]; exists {
-					if generatedApp.Labels == nil {
-						generatedApp.Labels = map[string]string{}
+					if desiredApp.Labels == nil {
+						desiredApp.Labels = map[string]string{}
 					}
-					generatedApp.Labels[key] = state
+					desiredApp.Labels[key] = state
 				}
 			}
 
@@ -645,20 +990,37 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			//   https://github.com/argoproj/argo-cd/issues/17181
 			for _, finalizer := range found.Finalizers {
 				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
-					if generatedApp.Finalizers == nil {
-						generatedApp.Finalizers = []string{}
+					if desiredApp.Finalizers == nil {
+						desiredApp.Finalizers = []string{}
 					}
-					generatedApp.Finalizers = append(generatedApp.Finalizers, finalizer)
+					desiredApp.Finalizers = append(desiredApp.Finalizers, finalizer)
 				}
 			}
 
-			found.Annotations = generatedApp.Annotations
+			if specHash != "" {
+				if desiredApp.Annotations == nil {
+					desiredApp.Annotations = map[string]string{}
+				}
+				desiredApp.Annotations[common.AnnotationApplicationSetSpecHash] = specHash
+			}
+
+			found.Annotations = desiredApp.Annotations
 
-			found.Finalizers = generatedApp.Finalizers
-			found.Labels = generatedApp.Labels
+			found.Finalizers = desiredApp.Finalizers
+			found.Labels = desiredApp.Labels
 
 			return controllerutil.SetControllerReference(&applicationSet, found, r.Scheme)
-		})
+		}
+
+		var action controllerutil.OperationResult
+		var err error
+		if r.EnableServerSideApply {
+			// The fields written by the controller before it used server-side apply are owned by its default field
+			// manager, which is named after its binary.
+			action, err = utils.CreateOrApply(ctx, appLog, r.Client, r.ServerSideApplyFieldManager, []string{common.ApplicationSetController}, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, mutateFn)
+		} else {
+			action, err = utils.CreateOrUpdate(ctx, appLog, r.Client, r.APIReader, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, mutateFn)
+		}
 		if err != nil {
 			appLog.WithError(err).WithField("action", action).Errorf("failed to %s Application", action)
 			if firstError == nil {
@@ -667,9 +1029,14 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			continue
 		}
 
+		if action == controllerutil.OperationResultNone && live != nil {
+			r.recordSyncedApplication(live, specHash)
+		}
+
 		if action != controllerutil.OperationResultNone {
 			// Don't pollute etcd with "unchanged Application" events
 			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, fmt.Sprint(action), "%s Application %q", action, generatedApp.Name)
+			r.Metrics.IncApplicationChange(&applicationSet, string(action))
 			appLog.Logf(log.InfoLevel, "%s Application", action)
 		} else {
 			// "unchanged Application" can be inferred by Reconcile Complete with no action being listed
@@ -680,6 +1047,160 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 	return firstError
 }
 
+// getPreservedFields returns the keys of the annotations and labels of the Applications which are not overwritten by
+// the ApplicationSet.
+func (r *ApplicationSetReconciler) getPreservedFields(applicationSet argov1alpha1.ApplicationSet) ([]string, []string) {
+	preservedAnnotations := make([]string, 0)
+	preservedLabels := make([]string, 0)
+
+	if applicationSet.Spec.PreservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
+		preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
+	}
+
+	if len(r.GlobalPreservedAnnotations) > 0 {
+		preservedAnnotations = append(preservedAnnotations, r.GlobalPreservedAnnotations...)
+	}
+
+	if len(r.GlobalPreservedLabels) > 0 {
+		preservedLabels = append(preservedLabels, r.GlobalPreservedLabels...)
+	}
+
+	// Preserve specially treated argo cd annotations:
+	// * https://github.com/argoproj/applicationset/issues/180
+	// * https://github.com/argoproj/argo-cd/issues/10500
+	preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
+
+	return preservedAnnotations, preservedLabels
+}
+
+// getDriftedApplications returns the sorted names of the current Applications which differ from the desired
+// Applications. The Applications which cannot be compared are logged and left out.
+func (r *ApplicationSetReconciler) getDriftedApplications(logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, currentApplications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) []string {
+	current := make(map[string]*argov1alpha1.Application, len(currentApplications))
+	for i := range currentApplications {
+		current[currentApplications[i].Name] = &currentApplications[i]
+	}
+	preservedAnnotations, preservedLabels := r.getPreservedFields(applicationSet)
+
+	drifted := []string{}
+	for i := range desiredApplications {
+		desiredApp := &desiredApplications[i]
+		found, ok := current[desiredApp.Name]
+		if !ok {
+			continue
+		}
+		isDrifted, err := utils.IsApplicationDrifted(applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, desiredApp, preservedAnnotations, preservedLabels)
+		if err != nil {
+			logCtx.WithField("app", desiredApp.QualifiedName()).WithError(err).Warn("unable to detect the drift of the Application")
+			continue
+		}
+		if isDrifted {
+			logCtx.WithField("app", desiredApp.QualifiedName()).Debug("Application drifted from the ApplicationSet")
+			drifted = append(drifted, desiredApp.Name)
+		}
+	}
+	sort.Strings(drifted)
+	return drifted
+}
+
+// maxFrozenChangesNames is the maximum number of Application names listed for each kind of change in the condition
+// reported while a freeze window is active, to keep the size of the status reasonable.
+const maxFrozenChangesNames = 10
+
+// getFrozenChangesMessage describes the Applications the controller would create, update and delete, according to the
+// policy of the ApplicationSet, if no freeze window was active.
+func (r *ApplicationSetReconciler) getFrozenChangesMessage(logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, currentApplications []argov1alpha1.Application, validApplications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, frozenUntil time.Time) string {
+	current := make(map[string]bool, len(currentApplications))
+	for _, app := range currentApplications {
+		current[app.Name] = true
+	}
+	desired := make(map[string]bool, len(desiredApplications))
+	for _, app := range desiredApplications {
+		desired[app.Name] = true
+	}
+	policy := utils.DefaultPolicy(applicationSet.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride)
+
+	creations := []string{}
+	for _, app := range validApplications {
+		if !current[app.Name] {
+			creations = append(creations, app.Name)
+		}
+	}
+	updates := []string{}
+	if policy.AllowUpdate() {
+		updates = r.getDriftedApplications(logCtx, applicationSet, currentApplications, validApplications)
+	}
+	deletions := []string{}
+	if policy.AllowDelete() {
+		for _, app := range currentApplications {
+			if !desired[app.Name] {
+				deletions = append(deletions, app.Name)
+			}
+		}
+	}
+	sort.Strings(creations)
+	sort.Strings(deletions)
+
+	return fmt.Sprintf("Changes are frozen until %s: %s to create, %s to update, %s to delete",
+		frozenUntil.UTC().Format(time.RFC3339), formatFrozenChanges(creations), formatFrozenChanges(updates), formatFrozenChanges(deletions))
+}
+
+// formatFrozenChanges returns the number of Applications followed by their names, e.g. `2 (app-a, app-b)`.
+func formatFrozenChanges(names []string) string {
+	if len(names) == 0 {
+		return "0"
+	}
+	if len(names) > maxFrozenChangesNames {
+		return fmt.Sprintf("%d (%s and %d more)", len(names), strings.Join(names[:maxFrozenChangesNames], ", "), len(names)-maxFrozenChangesNames)
+	}
+	return fmt.Sprintf("%d (%s)", len(names), strings.Join(names, ", "))
+}
+
+// postponeCreations drops from the desiredApplications the Applications to create which would exceed
+// MaxPendingCreationsPerCluster, and returns the number of postponed creations. An Application is pending until the
+// application controller has reconciled it once.
+func (r *ApplicationSetReconciler) postponeCreations(logCtx *log.Entry, desiredApplications []argov1alpha1.Application, currentApplications []argov1alpha1.Application) ([]argov1alpha1.Application, int) {
+	if r.MaxPendingCreationsPerCluster <= 0 {
+		return desiredApplications, 0
+	}
+
+	existing := make(map[string]bool, len(currentApplications))
+	pending := map[string]int{}
+	for _, app := range currentApplications {
+		existing[app.Name] = true
+		if app.Status.ReconciledAt == nil {
+			pending[destinationKey(app.Spec.Destination)]++
+		}
+	}
+
+	apps := make([]argov1alpha1.Application, 0, len(desiredApplications))
+	postponed := 0
+	for _, app := range desiredApplications {
+		if !existing[app.Name] {
+			key := destinationKey(app.Spec.Destination)
+			if pending[key] >= r.MaxPendingCreationsPerCluster {
+				postponed++
+				continue
+			}
+			pending[key]++
+		}
+		apps = append(apps, app)
+	}
+	if postponed > 0 {
+		logCtx.Infof("postponing the creation of %d Applications, to not exceed %d pending creations per destination cluster", postponed, r.MaxPendingCreationsPerCluster)
+	}
+	return apps, postponed
+}
+
+// destinationKey identifies the destination cluster of an Application, by its server URL or else its name.
+func destinationKey(destination argov1alpha1.ApplicationDestination) string {
+	if destination.Server != "" {
+		return destination.Server
+	}
+	return destination.Name
+}
+
 // createInCluster will filter from the desiredApplications only the application that needs to be created
 // Then it will call createOrUpdateInCluster to do the actual create
 func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
@@ -719,16 +1240,18 @@ func (r *ApplicationSetReconciler) getCurrentApplications(ctx context.Context, a
 
 // deleteInCluster will delete Applications that are currently on the cluster, but not in appList.
 // The function must be called after all generators had been called and generated applications
-func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
+// With syncPolicy.pruneAfterHealthy, the Applications whose replacement is not Healthy yet are kept, and the returned
+// duration is how long to wait before trying to delete them again.
+func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) (time.Duration, error) {
 	clusterList, err := utils.ListClusters(ctx, r.KubeClientset, r.ArgoCDNamespace)
 	if err != nil {
-		return fmt.Errorf("error listing clusters: %w", err)
+		return 0, fmt.Errorf("error listing clusters: %w", err)
 	}
 
 	// Save current applications to be able to delete the ones that are not in appList
 	current, err := r.getCurrentApplications(ctx, applicationSet)
 	if err != nil {
-		return fmt.Errorf("error getting current applications: %w", err)
+		return 0, fmt.Errorf("error getting current applications: %w", err)
 	}
 
 	m := make(map[string]bool) // Will holds the app names in appList for the deletion process
@@ -739,11 +1262,24 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 
 	// Delete apps that are not in m[string]bool
 	var firstError error
+	var requeueAfter time.Duration
 	for _, app := range current {
 		logCtx = logCtx.WithField("app", app.QualifiedName())
 		_, exists := m[app.Name]
+		if exists {
+			r.replacedApplications.delete(app.UID)
+		}
 
 		if !exists {
+			now := time.Now()
+			if wait := waitForHealthyReplacement(applicationSet, &app, current, desiredApplications, r.replacedApplications.getOrSet(app.UID, now), now); wait > 0 {
+				logCtx.WithField("wait", wait).Info("Waiting for the Applications replacing the application to be Healthy before deleting it")
+				if requeueAfter == 0 || wait < requeueAfter {
+					requeueAfter = wait
+				}
+				continue
+			}
+
 			// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 			err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, logCtx)
 			if err != nil {
@@ -762,11 +1298,96 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 				}
 				continue
 			}
+			r.syncedApplications.delete(app.UID)
+			r.replacedApplications.delete(app.UID)
 			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
+			r.Metrics.IncApplicationChange(&applicationSet, "deleted")
 			logCtx.Log(log.InfoLevel, "Deleted application")
 		}
 	}
-	return firstError
+	return requeueAfter, firstError
+}
+
+const (
+	// defaultPruneAfterHealthyTimeout is how long to wait for the replacing Applications to be Healthy when
+	// syncPolicy.pruneAfterHealthy does not set a timeout
+	defaultPruneAfterHealthyTimeout = 10 * time.Minute
+	// pruneAfterHealthyRequeue is how often the health of the replacing Applications is checked
+	pruneAfterHealthyRequeue = 30 * time.Second
+)
+
+// replacedApplications records, by UID, when the Applications were first found to be no longer generated, so that
+// their replacements which are not created yet are waited for up to the timeout of syncPolicy.pruneAfterHealthy.
+type replacedApplications struct {
+	lock  sync.Mutex
+	since map[types.UID]time.Time
+}
+
+// getOrSet returns when the Application was first found to be no longer generated, recording now if it was not yet.
+func (s *replacedApplications) getOrSet(uid types.UID, now time.Time) time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if since, ok := s.since[uid]; ok {
+		return since
+	}
+	if s.since == nil {
+		s.since = map[types.UID]time.Time{}
+	}
+	s.since[uid] = now
+	return now
+}
+
+func (s *replacedApplications) delete(uid types.UID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.since, uid)
+}
+
+// waitForHealthyReplacement returns how long to wait before deleting the given Application, which is no longer
+// generated since replacedSince, for the generated Applications with the same destination to be Healthy. It returns 0
+// when the Application can be deleted: syncPolicy.pruneAfterHealthy is not set, the Application is not replaced, its
+// replacements are Healthy or the timeout expired. The timeout is counted from the creation of the replacements, or
+// from replacedSince for the replacements which are not created yet.
+func waitForHealthyReplacement(applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application, current []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, replacedSince time.Time, now time.Time) time.Duration {
+	syncPolicy := applicationSet.Spec.SyncPolicy
+	if syncPolicy == nil || syncPolicy.PruneAfterHealthy == nil {
+		return 0
+	}
+	timeout := defaultPruneAfterHealthyTimeout
+	if syncPolicy.PruneAfterHealthy.TimeoutSeconds != nil {
+		timeout = time.Duration(*syncPolicy.PruneAfterHealthy.TimeoutSeconds) * time.Second
+	}
+
+	liveApps := make(map[string]*argov1alpha1.Application, len(current))
+	for i := range current {
+		liveApps[current[i].Name] = &current[i]
+	}
+
+	var wait time.Duration
+	for _, desired := range desiredApplications {
+		if !sameDestination(desired.Spec.Destination, app.Spec.Destination) {
+			continue
+		}
+		created := replacedSince
+		if replacement, ok := liveApps[desired.Name]; ok {
+			if replacement.Status.Health.Status == health.HealthStatusHealthy {
+				continue
+			}
+			created = replacement.CreationTimestamp.Time
+		}
+		remaining := created.Add(timeout).Sub(now)
+		if remaining <= 0 {
+			continue
+		}
+		wait = max(wait, min(remaining, pruneAfterHealthyRequeue))
+	}
+	return wait
+}
+
+// sameDestination returns whether both destinations target the same namespace of the same cluster, as written in
+// the template.
+func sameDestination(a, b argov1alpha1.ApplicationDestination) bool {
+	return a.Server == b.Server && a.Name == b.Name && a.Namespace == b.Namespace
 }
 
 // removeFinalizerOnInvalidDestination removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
@@ -1338,6 +1959,69 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 	return nil
 }
 
+// appSetReconcileStatus holds the fields of the status of an ApplicationSet computed during a reconciliation.
+type appSetReconcileStatus struct {
+	// generatedParameterSets is the number of parameter sets produced by each generator.
+	generatedParameterSets []int64
+	// driftedApplications are the names of the drifted Applications, only written if driftedApplicationsComputed is
+	// true, i.e. if the reconciliation got to compare the Applications.
+	driftedApplications         []string
+	driftedApplicationsComputed bool
+	// lastSuccessfulReconcileAt is the start time of the reconciliation, if it succeeded.
+	lastSuccessfulReconcileAt *time.Time
+}
+
+// setReconcileStatus writes the fields computed during a reconciliation to the status of the ApplicationSet, in a
+// single update, if any of them changed.
+func (r *ApplicationSetReconciler) setReconcileStatus(ctx context.Context, appset *argov1alpha1.ApplicationSet, reconcileStatus *appSetReconcileStatus) error {
+	updateParameterSets := !slices.Equal(appset.Status.GeneratedParameterSets, reconcileStatus.generatedParameterSets)
+	updateDrifted := reconcileStatus.driftedApplicationsComputed && !slices.Equal(appset.Status.DriftedApplications, reconcileStatus.driftedApplications)
+	// The frequent reconciliations of an ApplicationSet do not each write its status
+	updateReconciledAt := false
+	if reconciledAt := reconcileStatus.lastSuccessfulReconcileAt; reconciledAt != nil {
+		previous := appset.Status.LastSuccessfulReconcileAt
+		updateReconciledAt = previous == nil || reconciledAt.Sub(previous.Time) >= lastSuccessfulReconcileAtInterval
+	}
+	if !updateParameterSets && !updateDrifted && !updateReconciledAt {
+		return nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			// The ApplicationSet was deleted meanwhile, there is no status to update
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		if updateParameterSets {
+			updatedAppset.Status.GeneratedParameterSets = reconcileStatus.generatedParameterSets
+		}
+		if updateDrifted {
+			updatedAppset.Status.DriftedApplications = reconcileStatus.driftedApplications
+		}
+		if updateReconciledAt {
+			lastSuccessfulReconcileAt := metav1.NewTime(*reconcileStatus.lastSuccessfulReconcileAt)
+			updatedAppset.Status.LastSuccessfulReconcileAt = &lastSuccessfulReconcileAt
+		}
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to set application set status: %w", err)
+	}
+	return nil
+}
+
 // setAppSetApplicationStatus updates the ApplicationSet's status field
 // with any new/changed Application statuses.
 func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
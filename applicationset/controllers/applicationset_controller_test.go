@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -14,15 +17,19 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
@@ -31,7 +38,9 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/hooks"
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/hook"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
@@ -657,6 +666,85 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Ensure that configured preserved labels are preserved from an existing app, over the template value",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{
+							Project: "project",
+						},
+					},
+					PreservedFields: &v1alpha1.ApplicationPreservedFields{
+						Annotations: []string{"notifications.argoproj.io/subscribe.on-sync-failed.slack"},
+						Labels:      []string{"preserved-label-key"},
+					},
+				},
+			},
+			existingApps: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+						Annotations: map[string]string{
+							"notifications.argoproj.io/subscribe.on-sync-failed.slack": "my-channel",
+						},
+						Labels: map[string]string{
+							"label-key":           "label-value",
+							"preserved-label-key": "live-value",
+						},
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+					},
+				},
+			},
+			desiredApps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app1",
+						Namespace: "namespace",
+						Labels: map[string]string{
+							"preserved-label-key": "template-value",
+						},
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+					},
+				},
+			},
+			expected: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "3",
+						Annotations: map[string]string{
+							"notifications.argoproj.io/subscribe.on-sync-failed.slack": "my-channel",
+						},
+						Labels: map[string]string{
+							"preserved-label-key": "live-value",
+						},
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+					},
+				},
+			},
+		},
 		{
 			name: "Ensure that the app spec is normalized before applying",
 			appSet: v1alpha1.ApplicationSet{
@@ -1113,6 +1201,158 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 	}
 }
 
+func TestCreateOrUpdateInClusterServerSideApply(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{
+					Project: "default",
+				},
+			},
+		},
+	}
+	desiredApp := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app1",
+			Namespace: "namespace",
+			Labels:    map[string]string{"label-key": "label-value"},
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "project",
+		},
+	}
+
+	var applied []*unstructured.Unstructured
+	var patchOpts []crtclient.PatchOption
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c crtclient.WithWatch, obj crtclient.Object, patch crtclient.Patch, opts ...crtclient.PatchOption) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+			applied = append(applied, obj.(*unstructured.Unstructured).DeepCopy())
+			patchOpts = append(patchOpts, opts...)
+			return nil
+		},
+	}).Build()
+
+	r := ApplicationSetReconciler{
+		Client:                      client,
+		Scheme:                      scheme,
+		Recorder:                    record.NewFakeRecorder(1),
+		Metrics:                     appsetmetrics.NewFakeAppsetMetrics(),
+		EnableServerSideApply:       true,
+		ServerSideApplyFieldManager: "my-field-manager",
+	}
+
+	err = r.createOrUpdateInCluster(t.Context(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{desiredApp})
+	require.NoError(t, err)
+
+	require.Len(t, applied, 1)
+	assert.Equal(t, "app1", applied[0].GetName())
+	assert.Equal(t, map[string]string{"label-key": "label-value"}, applied[0].GetLabels())
+	require.Len(t, applied[0].GetOwnerReferences(), 1)
+	assert.Equal(t, "name", applied[0].GetOwnerReferences()[0].Name)
+	assert.Contains(t, patchOpts, crtclient.FieldOwner("my-field-manager"))
+	assert.Contains(t, patchOpts, crtclient.ForceOwnership)
+}
+
+func TestCreateOrUpdateInClusterSpecHash(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+			UID:       "appset-uid",
+		},
+	}
+	desiredApp := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app1",
+			Namespace: "namespace",
+			Labels:    map[string]string{"label-key": "label-value"},
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "project",
+		},
+	}
+
+	var gets int
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c crtclient.WithWatch, key crtclient.ObjectKey, obj crtclient.Object, opts ...crtclient.GetOption) error {
+			if _, ok := obj.(*v1alpha1.Application); ok {
+				gets++
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}).Build()
+
+	r := ApplicationSetReconciler{
+		Client:              client,
+		Scheme:              scheme,
+		Recorder:            record.NewFakeRecorder(10),
+		Metrics:             appsetmetrics.NewFakeAppsetMetrics(),
+		ApplicationSpecHash: true,
+	}
+	// reconcile returns the number of Gets of the Application, 1 when its comparison is skipped
+	reconcile := func() int {
+		gets = 0
+		err := r.createOrUpdateInCluster(t.Context(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{desiredApp})
+		require.NoError(t, err)
+		return gets
+	}
+	getApp := func() *v1alpha1.Application {
+		app := &v1alpha1.Application{}
+		require.NoError(t, client.Get(t.Context(), crtclient.ObjectKey{Namespace: "namespace", Name: "app1"}, app))
+		return app
+	}
+
+	// The Application is created with its hash, then compared once before the comparisons are skipped
+	assert.Equal(t, 2, reconcile())
+	assert.NotEmpty(t, getApp().Annotations[argocommon.AnnotationApplicationSetSpecHash])
+	assert.Equal(t, 2, reconcile())
+	assert.Equal(t, 1, reconcile())
+
+	t.Run("a change of the live Application is reverted", func(t *testing.T) {
+		live := getApp()
+		live.Labels["label-key"] = "edited"
+		require.NoError(t, client.Update(t.Context(), live))
+		assert.Equal(t, 2, reconcile())
+		assert.Equal(t, "label-value", getApp().Labels["label-key"])
+
+		live = getApp()
+		live.Spec.Project = "edited"
+		live.Generation++
+		require.NoError(t, client.Update(t.Context(), live))
+		assert.Equal(t, 2, reconcile())
+		assert.Equal(t, "project", getApp().Spec.Project)
+	})
+
+	t.Run("a change of the desired Application is applied", func(t *testing.T) {
+		reconcile()
+		desiredApp.Spec.Project = "other-project"
+		assert.Equal(t, 2, reconcile())
+		assert.Equal(t, "other-project", getApp().Spec.Project)
+	})
+
+	t.Run("the comparisons are not skipped with FullApplicationDiff", func(t *testing.T) {
+		reconcile()
+		assert.Equal(t, 1, reconcile())
+		r.FullApplicationDiff = true
+		assert.Equal(t, 2, reconcile())
+	})
+}
+
 func TestRemoveFinalizerOnInvalidDestination_FinalizerTypes(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -1788,7 +2028,7 @@ func TestDeleteInCluster(t *testing.T) {
 			Metrics:       metrics,
 		}
 
-		err = r.deleteInCluster(t.Context(), log.NewEntry(log.StandardLogger()), c.appSet, c.desiredApps)
+		_, err = r.deleteInCluster(t.Context(), log.NewEntry(log.StandardLogger()), c.appSet, c.desiredApps)
 		require.NoError(t, err)
 
 		// For each of the expected objects, verify they exist on the cluster
@@ -1818,6 +2058,98 @@ func TestDeleteInCluster(t *testing.T) {
 	}
 }
 
+func TestWaitForHealthyReplacement(t *testing.T) {
+	now := time.Now()
+	destination := v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}
+	app := func(name string, destination v1alpha1.ApplicationDestination, created time.Time, healthStatus health.HealthStatusCode) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Spec:       v1alpha1.ApplicationSpec{Destination: destination},
+			Status:     v1alpha1.ApplicationStatus{Health: v1alpha1.HealthStatus{Status: healthStatus}},
+		}
+	}
+	replaced := app("old", destination, now.Add(-time.Hour), health.HealthStatusHealthy)
+	timeout := int64(300)
+	pruneAfterHealthy := &v1alpha1.ApplicationSetSyncPolicy{
+		PruneAfterHealthy: &v1alpha1.ApplicationSetPruneAfterHealthy{TimeoutSeconds: &timeout},
+	}
+
+	for _, c := range []struct {
+		name          string
+		syncPolicy    *v1alpha1.ApplicationSetSyncPolicy
+		replacements  []v1alpha1.Application
+		desired       []v1alpha1.Application
+		replacedSince time.Time
+		expectedWait  time.Duration
+	}{
+		{
+			name:         "pruneAfterHealthy is not set",
+			replacements: []v1alpha1.Application{app("new", destination, now, health.HealthStatusProgressing)},
+		},
+		{
+			name:         "replacement is Healthy",
+			syncPolicy:   pruneAfterHealthy,
+			replacements: []v1alpha1.Application{app("new", destination, now, health.HealthStatusHealthy)},
+		},
+		{
+			name:         "replacement is not Healthy",
+			syncPolicy:   pruneAfterHealthy,
+			replacements: []v1alpha1.Application{app("new", destination, now, health.HealthStatusProgressing)},
+			expectedWait: pruneAfterHealthyRequeue,
+		},
+		{
+			name:         "replacement is not Healthy when the timeout is about to expire",
+			syncPolicy:   pruneAfterHealthy,
+			replacements: []v1alpha1.Application{app("new", destination, now.Add(-290*time.Second), health.HealthStatusProgressing)},
+			expectedWait: 10 * time.Second,
+		},
+		{
+			name:         "timeout expired",
+			syncPolicy:   pruneAfterHealthy,
+			replacements: []v1alpha1.Application{app("new", destination, now.Add(-301*time.Second), health.HealthStatusDegraded)},
+		},
+		{
+			name:         "default timeout",
+			syncPolicy:   &v1alpha1.ApplicationSetSyncPolicy{PruneAfterHealthy: &v1alpha1.ApplicationSetPruneAfterHealthy{}},
+			replacements: []v1alpha1.Application{app("new", destination, now.Add(-301*time.Second), health.HealthStatusDegraded)},
+			expectedWait: pruneAfterHealthyRequeue,
+		},
+		{
+			name:       "Application with another destination is not a replacement",
+			syncPolicy: pruneAfterHealthy,
+			replacements: []v1alpha1.Application{
+				app("new", v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "other"}, now, health.HealthStatusProgressing),
+			},
+		},
+		{
+			name:         "replacement which is not created yet",
+			syncPolicy:   pruneAfterHealthy,
+			desired:      []v1alpha1.Application{app("new", destination, time.Time{}, "")},
+			expectedWait: pruneAfterHealthyRequeue,
+		},
+		{
+			name:          "replacement which could not be created before the timeout",
+			syncPolicy:    pruneAfterHealthy,
+			desired:       []v1alpha1.Application{app("new", destination, time.Time{}, "")},
+			replacedSince: now.Add(-301 * time.Second),
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := v1alpha1.ApplicationSet{Spec: v1alpha1.ApplicationSetSpec{SyncPolicy: c.syncPolicy}}
+			current := append([]v1alpha1.Application{replaced}, c.replacements...)
+			desired := c.desired
+			if desired == nil {
+				desired = c.replacements
+			}
+			replacedSince := c.replacedSince
+			if replacedSince.IsZero() {
+				replacedSince = now
+			}
+			assert.Equal(t, c.expectedWait, waitForHealthyReplacement(appSet, &replaced, current, desired, replacedSince, now))
+		})
+	}
+}
+
 func TestGetMinRequeueAfter(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -1863,6 +2195,24 @@ func TestGetMinRequeueAfter(t *testing.T) {
 	})
 
 	assert.Equal(t, time.Duration(1)*time.Second, got)
+
+	t.Run("minimum requeue interval", func(t *testing.T) {
+		appSet := &v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			},
+		}
+
+		r.MinRequeueAfter = 5 * time.Second
+		assert.Equal(t, 5*time.Second, r.getMinRequeueAfter(appSet))
+
+		r.Generators = map[string]generators.Generator{
+			"List":     &generatorMock0,
+			"Git":      &generatorMock0,
+			"Clusters": &generatorMock0,
+		}
+		assert.Equal(t, generators.NoRequeueAfter, r.getMinRequeueAfter(appSet), "the ApplicationSets which are not requeued must not be requeued because of the minimum")
+	})
 }
 
 func TestRequeueGeneratorFails(t *testing.T) {
@@ -1919,6 +2269,103 @@ func TestRequeueGeneratorFails(t *testing.T) {
 	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
 }
 
+func TestReconcilerIncompleteParams(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	project := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+	}
+	generator := v1alpha1.ApplicationSetGenerator{
+		Clusters: &v1alpha1.ClusterGenerator{},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:      "{{.name}}",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+					Project:     "default",
+					Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+				},
+			},
+		},
+	}
+	// The Application of the cluster whose secret cannot be read anymore
+	existing := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "malformed",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+			Project:     "default",
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+		},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(&appSet, &existing, scheme))
+
+	generatorMock := mocks.Generator{}
+	generatorMock.On("GetTemplate", &generator).
+		Return(&v1alpha1.ApplicationSetTemplate{})
+	generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{{"name": "valid"}}, fmt.Errorf("%w: skipped invalid cluster secrets %q", generators.ErrIncompleteParams, "malformed"))
+	generatorMock.On("GetRequeueAfter", &generator).
+		Return(generators.NoRequeueAfter)
+
+	kubeclientset := getDefaultTestClientSet()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project, &existing).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"Clusters": &generatorMock,
+		},
+		ArgoDB:          argodb,
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		ArgoCDNamespace: "argocd",
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+	var app v1alpha1.Application
+	// the Application of the parameter set which could be generated is created
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "valid"}, &app))
+	// the Application of the parameter set which could not be generated is kept
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "malformed"}, &app))
+
+	var updated v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+	assert.Nil(t, updated.Status.LastSuccessfulReconcileAt)
+	var errorOccurred *v1alpha1.ApplicationSetCondition
+	for i := range updated.Status.Conditions {
+		if updated.Status.Conditions[i].Type == v1alpha1.ApplicationSetConditionErrorOccurred {
+			errorOccurred = &updated.Status.Conditions[i]
+		}
+	}
+	require.NotNil(t, errorOccurred)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusTrue, errorOccurred.Status)
+	assert.Contains(t, errorOccurred.Message, `"malformed"`)
+}
+
 func TestValidateGeneratedApplications(t *testing.T) {
 	t.Parallel()
 
@@ -2070,44 +2517,631 @@ func TestValidateGeneratedApplications(t *testing.T) {
 		t.Run(cc.name, func(t *testing.T) {
 			t.Parallel()
 
-			secret := &corev1.Secret{
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "argocd",
+					Labels: map[string]string{
+						argocommon.LabelKeySecretType: argocommon.LabelValueSecretTypeCluster,
+					},
+				},
+				Data: map[string][]byte{
+					"name":   []byte("my-cluster"),
+					"server": []byte("https://kubernetes.default.svc"),
+					"config": []byte("{\"username\":\"foo\",\"password\":\"foo\"}"),
+				},
+			}
+
+			kubeclientset := getDefaultTestClientSet(secret)
+
+			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+			r := ApplicationSetReconciler{
+				Client:          client,
+				Scheme:          scheme,
+				Recorder:        record.NewFakeRecorder(1),
+				Generators:      map[string]generators.Generator{},
+				ArgoDB:          argodb,
+				ArgoCDNamespace: "namespace",
+				KubeClientset:   kubeclientset,
+				Metrics:         metrics,
+			}
+
+			appSetInfo := v1alpha1.ApplicationSet{}
+			validationErrors, _ := r.validateGeneratedApplications(t.Context(), cc.apps, appSetInfo)
+			assert.Equal(t, cc.validationErrors, validationErrors)
+		})
+	}
+}
+
+func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	project := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "good-project", Namespace: "argocd"},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{
+							Raw: []byte(`{"project": "good-project"}`),
+						}, {
+							Raw: []byte(`{"project": "bad-project"}`),
+						}},
+					},
+				},
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:      "{{.project}}",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+					Project:     "{{.project}}",
+					Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+				},
+			},
+		},
+	}
+
+	kubeclientset := getDefaultTestClientSet()
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	metrics := appsetmetrics.NewFakeAppsetMetrics()
+
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(1),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:          argodb,
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		ArgoCDNamespace: "argocd",
+		Metrics:         metrics,
+	}
+
+	req := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "argocd",
+			Name:      "name",
+		},
+	}
+
+	// Verify that on validation error, no error is returned, but the object is requeued
+	res, err := r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+	var app v1alpha1.Application
+
+	// make sure good app got created
+	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "good-project"}, &app)
+	require.NoError(t, err)
+	assert.Equal(t, "good-project", app.Name)
+
+	// make sure bad app was not created
+	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "bad-project"}, &app)
+	require.Error(t, err)
+}
+
+func TestReconcilerStrictGenerators(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	project := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+	}
+
+	for _, c := range []struct {
+		name             string
+		strictGenerators bool
+		annotation       string
+		expectedBlocked  bool
+	}{
+		{name: "default", expectedBlocked: false},
+		{name: "strict controller", strictGenerators: true, expectedBlocked: true},
+		{name: "strict ApplicationSet", annotation: "true", expectedBlocked: true},
+		{name: "ApplicationSet opting out of strict controller", strictGenerators: true, annotation: "false", expectedBlocked: false},
+		{name: "invalid annotation", strictGenerators: true, annotation: "yes please", expectedBlocked: true},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			annotations := map[string]string{
+				// the unrecognized "cluster" generator is pruned by the API server, only an empty generator remains
+				"kubectl.kubernetes.io/last-applied-configuration": `{"spec":{"generators":[{"list":{"elements":[{"cluster":"dev"}]}},{"cluster":{}}]}}`,
+			}
+			if c.annotation != "" {
+				annotations[argocommon.AnnotationApplicationSetStrictGenerators] = c.annotation
+			}
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "name",
+					Namespace:   "argocd",
+					Annotations: annotations,
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: true,
+					Generators: []v1alpha1.ApplicationSetGenerator{
+						{
+							List: &v1alpha1.ListGenerator{
+								Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev"}`)}},
+							},
+						},
+						{},
+					},
+					Template: v1alpha1.ApplicationSetTemplate{
+						ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+							Name:      "{{.cluster}}-guestbook",
+							Namespace: "argocd",
+						},
+						Spec: v1alpha1.ApplicationSpec{
+							Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+							Project:     "default",
+							Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+						},
+					},
+				},
+			}
+
+			kubeclientset := getDefaultTestClientSet()
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+			r := ApplicationSetReconciler{
+				Client:   client,
+				Scheme:   scheme,
+				Renderer: &utils.Render{},
+				Recorder: record.NewFakeRecorder(10),
+				Generators: map[string]generators.Generator{
+					"List": generators.NewListGenerator(),
+				},
+				ArgoDB:           argodb,
+				KubeClientset:    kubeclientset,
+				Policy:           v1alpha1.ApplicationsSyncPolicySync,
+				ArgoCDNamespace:  "argocd",
+				Metrics:          appsetmetrics.NewFakeAppsetMetrics(),
+				StrictGenerators: c.strictGenerators,
+			}
+
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+			require.NoError(t, err)
+
+			var app v1alpha1.Application
+			err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "dev-guestbook"}, &app)
+			if !c.expectedBlocked {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, apierrors.IsNotFound(err))
+			assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+			var updated v1alpha1.ApplicationSet
+			require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+			condition := updated.Status.Conditions[0]
+			assert.Equal(t, v1alpha1.ApplicationSetConditionErrorOccurred, condition.Type)
+			assert.Equal(t, v1alpha1.ApplicationSetReasonInvalidGenerators, condition.Reason)
+			assert.Equal(t, "ApplicationSet name contains unrecognized generators: cluster", condition.Message)
+		})
+	}
+}
+
+func TestReconcilerInvalidGenerators(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev"}`)}},
+					},
+					Selector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "cluster", Operator: "Equals", Values: []string{"dev"}}},
+					},
+				},
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:      "{{.cluster}}-guestbook",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+					Project:     "default",
+					Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+				},
+			},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		ArgoCDNamespace: "argocd",
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+	var updated v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+	condition := updated.Status.Conditions[0]
+	assert.Equal(t, v1alpha1.ApplicationSetConditionErrorOccurred, condition.Type)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonInvalidGenerators, condition.Reason)
+	assert.Equal(t, `spec.generators[0].selector: Invalid value: "Equals" is not a valid pod selector operator`, condition.Message)
+}
+
+func TestReconcilerDisableLegacyTemplates(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	project := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+	}
+
+	for _, c := range []struct {
+		name                   string
+		disableLegacyTemplates bool
+		goTemplate             bool
+		expectedBlocked        bool
+	}{
+		{name: "legacy templates enabled", goTemplate: false, expectedBlocked: false},
+		{name: "legacy templates disabled", disableLegacyTemplates: true, goTemplate: false, expectedBlocked: true},
+		{name: "Go templates with legacy templates disabled", disableLegacyTemplates: true, goTemplate: true, expectedBlocked: false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			name := "{{cluster}}-guestbook"
+			if c.goTemplate {
+				name = "{{.cluster}}-guestbook"
+			}
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: c.goTemplate,
+					Generators: []v1alpha1.ApplicationSetGenerator{
+						{
+							List: &v1alpha1.ListGenerator{
+								Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev"}`)}},
+							},
+						},
+					},
+					Template: v1alpha1.ApplicationSetTemplate{
+						ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+							Name:      name,
+							Namespace: "argocd",
+						},
+						Spec: v1alpha1.ApplicationSpec{
+							Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+							Project:     "default",
+							Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+						},
+					},
+				},
+			}
+
+			kubeclientset := getDefaultTestClientSet()
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+			r := ApplicationSetReconciler{
+				Client:   client,
+				Scheme:   scheme,
+				Renderer: &utils.Render{},
+				Recorder: record.NewFakeRecorder(10),
+				Generators: map[string]generators.Generator{
+					"List": generators.NewListGenerator(),
+				},
+				ArgoDB:                 argodb,
+				KubeClientset:          kubeclientset,
+				Policy:                 v1alpha1.ApplicationsSyncPolicySync,
+				ArgoCDNamespace:        "argocd",
+				Metrics:                appsetmetrics.NewFakeAppsetMetrics(),
+				DisableLegacyTemplates: c.disableLegacyTemplates,
+			}
+
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+			require.NoError(t, err)
+
+			var app v1alpha1.Application
+			err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "dev-guestbook"}, &app)
+			if !c.expectedBlocked {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, apierrors.IsNotFound(err))
+			assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+			var updated v1alpha1.ApplicationSet
+			require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+			condition := updated.Status.Conditions[0]
+			assert.Equal(t, v1alpha1.ApplicationSetConditionErrorOccurred, condition.Type)
+			assert.Equal(t, v1alpha1.ApplicationSetReasonLegacyTemplateDisabled, condition.Reason)
+			assert.Contains(t, condition.Message, "argocd admin appset migrate-template")
+		})
+	}
+}
+
+func TestPostponeCreations(t *testing.T) {
+	app := func(name string, server string, reconciled bool) v1alpha1.Application {
+		a := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: server}},
+		}
+		if reconciled {
+			a.Status.ReconciledAt = &metav1.Time{Time: time.Now()}
+		}
+		return a
+	}
+	names := func(apps []v1alpha1.Application) []string {
+		var res []string
+		for _, a := range apps {
+			res = append(res, a.Name)
+		}
+		return res
+	}
+
+	current := []v1alpha1.Application{
+		app("a-1", "https://a", true),
+		app("a-2", "https://a", false),
+		app("b-1", "https://b", false),
+	}
+	desired := []v1alpha1.Application{
+		app("a-1", "https://a", false),
+		app("a-2", "https://a", false),
+		app("a-3", "https://a", false),
+		app("a-4", "https://a", false),
+		app("b-1", "https://b", false),
+		app("b-2", "https://b", false),
+		app("c-1", "https://c", false),
+	}
+
+	for _, c := range []struct {
+		name              string
+		maxPending        int
+		expectedApps      []string
+		expectedPostponed int
+	}{
+		{name: "no limit", maxPending: 0, expectedApps: names(desired)},
+		{name: "one pending creation per cluster", maxPending: 1, expectedApps: []string{"a-1", "a-2", "b-1", "c-1"}, expectedPostponed: 3},
+		{name: "two pending creations per cluster", maxPending: 2, expectedApps: []string{"a-1", "a-2", "a-3", "b-1", "b-2", "c-1"}, expectedPostponed: 1},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := ApplicationSetReconciler{MaxPendingCreationsPerCluster: c.maxPending}
+			apps, postponed := r.postponeCreations(log.NewEntry(log.StandardLogger()), desired, current)
+			assert.Equal(t, c.expectedApps, names(apps))
+			assert.Equal(t, c.expectedPostponed, postponed)
+		})
+	}
+}
+
+func TestReconcilerUnsupportedByCRD(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	project := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+	}
+
+	for _, c := range []struct {
+		name            string
+		capabilities    *utils.CRDCapabilities
+		templatePatch   *string
+		expectedBlocked bool
+	}{
+		{name: "capabilities not detected", templatePatch: ptr.To(`metadata: {annotations: {patched: "true"}}`)},
+		{name: "field not used", capabilities: &utils.CRDCapabilities{Strategy: true}},
+		{name: "field unknown to the CRD", capabilities: &utils.CRDCapabilities{Strategy: true}, templatePatch: ptr.To(`metadata: {annotations: {patched: "true"}}`), expectedBlocked: true},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: true,
+					Generators: []v1alpha1.ApplicationSetGenerator{
+						{
+							List: &v1alpha1.ListGenerator{
+								Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev"}`)}},
+							},
+						},
+					},
+					Template: v1alpha1.ApplicationSetTemplate{
+						ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+							Name:      "{{.cluster}}-guestbook",
+							Namespace: "argocd",
+						},
+						Spec: v1alpha1.ApplicationSpec{
+							Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+							Project:     "default",
+							Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+						},
+					},
+					TemplatePatch: c.templatePatch,
+				},
+			}
+
+			kubeclientset := getDefaultTestClientSet()
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+			r := ApplicationSetReconciler{
+				Client:   client,
+				Scheme:   scheme,
+				Renderer: &utils.Render{},
+				Recorder: record.NewFakeRecorder(10),
+				Generators: map[string]generators.Generator{
+					"List": generators.NewListGenerator(),
+				},
+				ArgoDB:          argodb,
+				KubeClientset:   kubeclientset,
+				Policy:          v1alpha1.ApplicationsSyncPolicySync,
+				ArgoCDNamespace: "argocd",
+				Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+				CRDCapabilities: c.capabilities,
+			}
+
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+			require.NoError(t, err)
+
+			var app v1alpha1.Application
+			err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "dev-guestbook"}, &app)
+			if !c.expectedBlocked {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, apierrors.IsNotFound(err))
+			assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+			var updated v1alpha1.ApplicationSet
+			require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+			condition := updated.Status.Conditions[0]
+			assert.Equal(t, v1alpha1.ApplicationSetConditionErrorOccurred, condition.Type)
+			assert.Equal(t, v1alpha1.ApplicationSetReasonUnsupportedByCRD, condition.Reason)
+			assert.Contains(t, condition.Message, "does not support spec.templatePatch")
+		})
+	}
+}
+
+func TestReconcilerGeneratorsNotPermitted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	for _, c := range []struct {
+		name             string
+		project          string
+		blacklist        []string
+		expectedBlocked  bool
+		expectedReason   string
+		expectedMessage  string
+		expectedCreation bool
+	}{
+		{name: "permitted generators", project: "tenant", blacklist: []string{"plugin"}, expectedCreation: true},
+		{name: "permitted generators with templated project", project: "{{.project}}", blacklist: []string{"plugin"}, expectedCreation: true},
+		{name: "generator not permitted", project: "tenant", blacklist: []string{"list"}, expectedBlocked: true, expectedReason: v1alpha1.ApplicationSetReasonGeneratorNotPermitted, expectedMessage: "ApplicationSet name contains generators which are not permitted in project tenant: list"},
+		{name: "generator not permitted with templated project", project: "{{.project}}", blacklist: []string{"list"}, expectedBlocked: true, expectedReason: v1alpha1.ApplicationSetReasonGeneratorNotPermitted, expectedMessage: "ApplicationSet name contains generators which are not permitted in project tenant: list, and the templated project may be rendered to it"},
+		{name: "missing project", project: "missing", blacklist: []string{"plugin"}, expectedBlocked: true, expectedReason: v1alpha1.ApplicationSetReasonGeneratorNotPermitted, expectedMessage: "application set references project missing which does not exist"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			project := v1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "argocd"},
+				Spec: v1alpha1.AppProjectSpec{
+					SourceRepos:                      []string{"*"},
+					Destinations:                     []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+					ApplicationSetGeneratorBlacklist: c.blacklist,
+				},
+			}
+			appSet := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "my-secret",
+					Name:      "name",
 					Namespace: "argocd",
-					Labels: map[string]string{
-						argocommon.LabelKeySecretType: argocommon.LabelValueSecretTypeCluster,
-					},
 				},
-				Data: map[string][]byte{
-					"name":   []byte("my-cluster"),
-					"server": []byte("https://kubernetes.default.svc"),
-					"config": []byte("{\"username\":\"foo\",\"password\":\"foo\"}"),
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: true,
+					Generators: []v1alpha1.ApplicationSetGenerator{
+						{
+							List: &v1alpha1.ListGenerator{
+								Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev", "project": "tenant"}`)}},
+							},
+						},
+					},
+					Template: v1alpha1.ApplicationSetTemplate{
+						ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+							Name:      "{{.cluster}}-guestbook",
+							Namespace: "argocd",
+						},
+						Spec: v1alpha1.ApplicationSpec{
+							Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+							Project:     c.project,
+							Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+						},
+					},
 				},
 			}
 
-			kubeclientset := getDefaultTestClientSet(secret)
-
+			kubeclientset := getDefaultTestClientSet()
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
 			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
 
 			r := ApplicationSetReconciler{
-				Client:          client,
-				Scheme:          scheme,
-				Recorder:        record.NewFakeRecorder(1),
-				Generators:      map[string]generators.Generator{},
+				Client:   client,
+				Scheme:   scheme,
+				Renderer: &utils.Render{},
+				Recorder: record.NewFakeRecorder(10),
+				Generators: map[string]generators.Generator{
+					"List": generators.NewListGenerator(),
+				},
 				ArgoDB:          argodb,
-				ArgoCDNamespace: "namespace",
 				KubeClientset:   kubeclientset,
-				Metrics:         metrics,
+				Policy:          v1alpha1.ApplicationsSyncPolicySync,
+				ArgoCDNamespace: "argocd",
+				Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
 			}
 
-			appSetInfo := v1alpha1.ApplicationSet{}
-			validationErrors, _ := r.validateGeneratedApplications(t.Context(), cc.apps, appSetInfo)
-			assert.Equal(t, cc.validationErrors, validationErrors)
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+			require.NoError(t, err)
+
+			var app v1alpha1.Application
+			err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "dev-guestbook"}, &app)
+			if c.expectedCreation {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, apierrors.IsNotFound(err))
+			if c.expectedBlocked {
+				assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+			}
+
+			var updated v1alpha1.ApplicationSet
+			require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+			condition := updated.Status.Conditions[0]
+			assert.Equal(t, v1alpha1.ApplicationSetConditionErrorOccurred, condition.Type)
+			assert.Equal(t, c.expectedReason, condition.Reason)
+			assert.Contains(t, condition.Message, c.expectedMessage)
 		})
 	}
 }
 
-func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
+func TestReconcilerLastSuccessfulReconcileAt(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
 	require.NoError(t, err)
@@ -2115,6 +3149,204 @@ func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 	project := v1alpha1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{Name: "good-project", Namespace: "argocd"},
 	}
+	newAppSet := func(name string, projects ...string) v1alpha1.ApplicationSet {
+		var elements []apiextensionsv1.JSON
+		for _, p := range projects {
+			elements = append(elements, apiextensionsv1.JSON{Raw: []byte(`{"project": "` + p + `"}`)})
+		}
+		return v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argocd",
+			},
+			Spec: v1alpha1.ApplicationSetSpec{
+				GoTemplate: true,
+				Generators: []v1alpha1.ApplicationSetGenerator{
+					{
+						List: &v1alpha1.ListGenerator{Elements: elements},
+					},
+				},
+				Template: v1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+						Name:      name + "-{{.project}}",
+						Namespace: "argocd",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+						Project:     "{{.project}}",
+						Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+					},
+				},
+			},
+		}
+	}
+	successful := newAppSet("successful", "good-project")
+	failing := newAppSet("failing", "good-project", "bad-project")
+
+	kubeclientset := getDefaultTestClientSet()
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&successful, &failing, &project).WithStatusSubresource(&successful, &failing).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:          argodb,
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		ArgoCDNamespace: "argocd",
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	start := metav1.Now().Rfc3339Copy()
+	for _, name := range []string{"successful", "failing"} {
+		_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: name}})
+		require.NoError(t, err)
+	}
+
+	var appSet v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "successful"}, &appSet))
+	require.NotNil(t, appSet.Status.LastSuccessfulReconcileAt)
+	assert.False(t, appSet.Status.LastSuccessfulReconcileAt.Before(&start))
+
+	// an ApplicationSet with validation errors is not successfully reconciled
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "failing"}, &appSet))
+	assert.Nil(t, appSet.Status.LastSuccessfulReconcileAt)
+
+	t.Run("recent time is not updated", func(t *testing.T) {
+		recent := metav1.NewTime(time.Now().Add(-30 * time.Second).Truncate(time.Second))
+		require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "successful"}, &appSet))
+		appSet.Status.LastSuccessfulReconcileAt = &recent
+		require.NoError(t, r.Status().Update(t.Context(), &appSet))
+
+		_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "successful"}})
+		require.NoError(t, err)
+		require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "successful"}, &appSet))
+		assert.True(t, appSet.Status.LastSuccessfulReconcileAt.Equal(&recent))
+	})
+
+	t.Run("older time is updated", func(t *testing.T) {
+		old := metav1.NewTime(time.Now().Add(-2 * time.Minute).Truncate(time.Second))
+		require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "successful"}, &appSet))
+		appSet.Status.LastSuccessfulReconcileAt = &old
+		require.NoError(t, r.Status().Update(t.Context(), &appSet))
+
+		_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "successful"}})
+		require.NoError(t, err)
+		require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "successful"}, &appSet))
+		assert.True(t, appSet.Status.LastSuccessfulReconcileAt.After(old.Time))
+	})
+}
+
+func TestSetReconcileStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+	}
+	statusUpdates := 0
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).WithInterceptorFuncs(interceptor.Funcs{
+		SubResourceUpdate: func(ctx context.Context, client crtclient.Client, subResourceName string, obj crtclient.Object, opts ...crtclient.SubResourceUpdateOption) error {
+			statusUpdates++
+			return client.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+	}).Build()
+	r := ApplicationSetReconciler{Client: client, Scheme: scheme}
+
+	t.Run("all the fields are written in a single update", func(t *testing.T) {
+		statusUpdates = 0
+		reconciledAt := time.Now().Truncate(time.Second)
+		err := r.setReconcileStatus(t.Context(), &appSet, &appSetReconcileStatus{
+			generatedParameterSets:      []int64{2, 1},
+			driftedApplications:         []string{"app"},
+			driftedApplicationsComputed: true,
+			lastSuccessfulReconcileAt:   &reconciledAt,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, statusUpdates)
+
+		var updated v1alpha1.ApplicationSet
+		require.NoError(t, r.Get(t.Context(), crtclient.ObjectKeyFromObject(&appSet), &updated))
+		assert.Equal(t, []int64{2, 1}, updated.Status.GeneratedParameterSets)
+		assert.Equal(t, []string{"app"}, updated.Status.DriftedApplications)
+		require.NotNil(t, updated.Status.LastSuccessfulReconcileAt)
+		assert.True(t, updated.Status.LastSuccessfulReconcileAt.Time.Equal(reconciledAt))
+	})
+
+	t.Run("an unchanged status is not written", func(t *testing.T) {
+		statusUpdates = 0
+		err := r.setReconcileStatus(t.Context(), &appSet, &appSetReconcileStatus{
+			generatedParameterSets: []int64{2, 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, statusUpdates)
+		assert.Equal(t, []string{"app"}, appSet.Status.DriftedApplications)
+	})
+
+	t.Run("a deleted ApplicationSet is ignored", func(t *testing.T) {
+		statusUpdates = 0
+		deleted := v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: "argocd"},
+		}
+		err := r.setReconcileStatus(t.Context(), &deleted, &appSetReconcileStatus{
+			generatedParameterSets: []int64{1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, statusUpdates)
+	})
+
+	t.Run("an error fetching the ApplicationSet is returned", func(t *testing.T) {
+		failing := ApplicationSetReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(_ context.Context, _ crtclient.WithWatch, _ crtclient.ObjectKey, _ crtclient.Object, _ ...crtclient.GetOption) error {
+					return errors.New("get failed")
+				},
+			}).Build(),
+			Scheme: scheme,
+		}
+		err := failing.setReconcileStatus(t.Context(), &appSet, &appSetReconcileStatus{
+			generatedParameterSets: []int64{1},
+		})
+		require.ErrorContains(t, err, "get failed")
+	})
+}
+
+func TestReconcilerPostRenderHookVeto(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+	err = corev1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request hook.PostRenderRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var response hook.PostRenderResponse
+		for _, app := range request.Applications {
+			if app.Spec.Project == "default" {
+				response.Vetoes = append(response.Vetoes, hook.Veto{Application: app.Name, Reason: "the default project is forbidden"})
+			}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer ts.Close()
+
+	hookConfigMap := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "argocd"},
+		Data:       map[string]string{"baseUrl": ts.URL},
+	}
+	projects := []crtclient.Object{
+		&v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}},
+		&v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "argocd"}},
+	}
 	appSet := v1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "name",
@@ -2124,13 +3356,10 @@ func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 			GoTemplate: true,
 			Generators: []v1alpha1.ApplicationSetGenerator{
 				{
-					List: &v1alpha1.ListGenerator{
-						Elements: []apiextensionsv1.JSON{{
-							Raw: []byte(`{"project": "good-project"}`),
-						}, {
-							Raw: []byte(`{"project": "bad-project"}`),
-						}},
-					},
+					List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"project": "team-a"}`)},
+						{Raw: []byte(`{"project": "default"}`)},
+					}},
 				},
 			},
 			Template: v1alpha1.ApplicationSetTemplate{
@@ -2144,13 +3373,15 @@ func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 					Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
 				},
 			},
+			Hooks: &v1alpha1.ApplicationSetHooks{
+				PostRender: []v1alpha1.ApplicationSetHook{{Name: "policy", ConfigMapRef: v1alpha1.PluginConfigMapRef{Name: "policy"}}},
+			},
 		},
 	}
 
 	kubeclientset := getDefaultTestClientSet()
 
-	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &project).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
-	metrics := appsetmetrics.NewFakeAppsetMetrics()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &hookConfigMap).WithObjects(projects...).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
 
 	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
 
@@ -2158,7 +3389,7 @@ func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 		Client:   client,
 		Scheme:   scheme,
 		Renderer: &utils.Render{},
-		Recorder: record.NewFakeRecorder(1),
+		Recorder: record.NewFakeRecorder(10),
 		Generators: map[string]generators.Generator{
 			"List": generators.NewListGenerator(),
 		},
@@ -2166,31 +3397,155 @@ func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 		KubeClientset:   kubeclientset,
 		Policy:          v1alpha1.ApplicationsSyncPolicySync,
 		ArgoCDNamespace: "argocd",
-		Metrics:         metrics,
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+		Hooks:           hooks.NewRunner(client, "argocd", false),
 	}
 
-	req := ctrl.Request{
-		NamespacedName: types.NamespacedName{
-			Namespace: "argocd",
+	_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+	require.NoError(t, err)
+
+	var app v1alpha1.Application
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "team-a"}, &app))
+	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "default"}, &app)
+	require.True(t, apierrors.IsNotFound(err))
+
+	var updated v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+	var messages []string
+	for _, condition := range updated.Status.Conditions {
+		messages = append(messages, condition.Message)
+	}
+	assert.Contains(t, messages, "application default was vetoed by post-render hook policy: the default project is forbidden")
+}
+
+func TestReconcilerFreezeWindow(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+	err = corev1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
 			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"name": "a"}`)},
+						{Raw: []byte(`{"name": "b"}`)},
+					}},
+				},
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:      "{{.name}}",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+					Project:     "default",
+					Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+				},
+			},
+			// The window began within the last hour
+			FreezeWindows: []v1alpha1.ApplicationSetFreezeWindow{{Schedule: "* * * * *", Duration: "1h"}},
 		},
 	}
+	newApp := func(name string, path string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argocd",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					Kind:       "ApplicationSet",
+					Name:       "name",
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: path},
+				Project:     "default",
+				Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+			},
+		}
+	}
 
-	// Verify that on validation error, no error is returned, but the object is requeued
-	res, err := r.Reconcile(t.Context(), req)
+	kubeclientset := getDefaultTestClientSet()
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, newApp("b", "helm-guestbook"), newApp("c", "guestbook"), &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:          argodb,
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		ArgoCDNamespace: "argocd",
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
 	require.NoError(t, err)
-	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+	// The window ends an hour after its latest occurrence
+	assert.Greater(t, res.RequeueAfter, 59*time.Minute)
+	assert.LessOrEqual(t, res.RequeueAfter, time.Hour)
 
 	var app v1alpha1.Application
+	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "a"}, &app)
+	require.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "b"}, &app))
+	assert.Equal(t, "helm-guestbook", app.Spec.Source.Path)
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "c"}, &app))
+
+	var updated v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+	var upToDate *v1alpha1.ApplicationSetCondition
+	for i := range updated.Status.Conditions {
+		if updated.Status.Conditions[i].Type == v1alpha1.ApplicationSetConditionResourcesUpToDate {
+			upToDate = &updated.Status.Conditions[i]
+		}
+	}
+	require.NotNil(t, upToDate)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusFalse, upToDate.Status)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonFreezeWindowActive, upToDate.Reason)
+	assert.Contains(t, upToDate.Message, ": 1 (a) to create, 1 (b) to update, 1 (c) to delete")
 
-	// make sure good app got created
-	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "good-project"}, &app)
-	require.NoError(t, err)
-	assert.Equal(t, "good-project", app.Name)
+	t.Run("invalid freeze window", func(t *testing.T) {
+		updated.Spec.FreezeWindows = []v1alpha1.ApplicationSetFreezeWindow{{Schedule: "not a schedule", Duration: "1h"}}
+		require.NoError(t, r.Update(t.Context(), &updated))
 
-	// make sure bad app was not created
-	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "bad-project"}, &app)
-	require.Error(t, err)
+		res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+		require.NoError(t, err)
+		assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+
+		require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updated))
+		var errorOccurred *v1alpha1.ApplicationSetCondition
+		for i := range updated.Status.Conditions {
+			if updated.Status.Conditions[i].Type == v1alpha1.ApplicationSetConditionErrorOccurred {
+				errorOccurred = &updated.Status.Conditions[i]
+			}
+		}
+		require.NotNil(t, errorOccurred)
+		assert.Equal(t, v1alpha1.ApplicationSetReasonInvalidFreezeWindow, errorOccurred.Reason)
+	})
+}
+
+func TestFormatFrozenChanges(t *testing.T) {
+	assert.Equal(t, "0", formatFrozenChanges(nil))
+	assert.Equal(t, "2 (a, b)", formatFrozenChanges([]string{"a", "b"}))
+	assert.Equal(t, "12 (a0, a1, a2, a3, a4, a5, a6, a7, a8, a9 and 2 more)", formatFrozenChanges([]string{"a0", "a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8", "a9", "a10", "a11"}))
 }
 
 func TestSetApplicationSetStatusCondition(t *testing.T) {
@@ -2499,6 +3854,15 @@ func applicationsUpdateSyncPolicyTest(t *testing.T, applicationsSyncPolicy v1alp
 	assert.Equal(t, time.Duration(0), resUpdate.RequeueAfter)
 	assert.Equal(t, "good-cluster", app.Name)
 
+	// The Application is reported as drifted when the policy does not allow to update it
+	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &retrievedApplicationSet)
+	require.NoError(t, err)
+	if utils.DefaultPolicy(retrievedApplicationSet.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
+		assert.Empty(t, retrievedApplicationSet.Status.DriftedApplications)
+	} else {
+		assert.Equal(t, []string{"good-cluster"}, retrievedApplicationSet.Status.DriftedApplications)
+	}
+
 	return app
 }
 
@@ -4408,6 +5772,39 @@ func TestBuildAppSyncMap(t *testing.T) {
 	}
 }
 
+func TestIsApplicationHealthy(t *testing.T) {
+	newApp := func(healthStatus health.HealthStatusCode, syncStatus v1alpha1.SyncStatusCode, phase common.OperationPhase) v1alpha1.Application {
+		app := v1alpha1.Application{
+			Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.HealthStatus{Status: healthStatus},
+				Sync:   v1alpha1.SyncStatus{Status: syncStatus},
+			},
+		}
+		if phase != "" {
+			app.Status.OperationState = &v1alpha1.OperationState{Phase: phase}
+		}
+		return app
+	}
+
+	for _, cc := range []struct {
+		name     string
+		app      v1alpha1.Application
+		expected bool
+	}{
+		{name: "healthy and synced", app: newApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced, ""), expected: true},
+		{name: "healthy and synced after a successful sync", app: newApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced, common.OperationSucceeded), expected: true},
+		{name: "healthy but out of sync", app: newApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync, ""), expected: false},
+		{name: "healthy with a running sync", app: newApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced, common.OperationRunning), expected: false},
+		{name: "healthy after a failed sync", app: newApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced, common.OperationFailed), expected: false},
+		{name: "synced but progressing", app: newApp(health.HealthStatusProgressing, v1alpha1.SyncStatusCodeSynced, ""), expected: false},
+		{name: "synced but degraded", app: newApp(health.HealthStatusDegraded, v1alpha1.SyncStatusCodeSynced, ""), expected: false},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			assert.Equal(t, cc.expected, isApplicationHealthy(cc.app))
+		})
+	}
+}
+
 func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
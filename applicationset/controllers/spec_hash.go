@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// syncedApplication is the state of an Application confirmed to match the ApplicationSet by a full diff.
+type syncedApplication struct {
+	specHash   string
+	generation int64
+	liveHash   string
+}
+
+// syncedApplications records, by UID, the Applications confirmed to match the ApplicationSet by a full diff. It is
+// safe for concurrent use.
+type syncedApplications struct {
+	lock sync.Mutex
+	apps map[types.UID]syncedApplication
+}
+
+func (s *syncedApplications) get(uid types.UID) (syncedApplication, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	app, ok := s.apps[uid]
+	return app, ok
+}
+
+func (s *syncedApplications) set(uid types.UID, app syncedApplication) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.apps == nil {
+		s.apps = map[types.UID]syncedApplication{}
+	}
+	s.apps[uid] = app
+}
+
+func (s *syncedApplications) delete(uid types.UID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.apps, uid)
+}
+
+// applicationSpecHash returns the hash of the desired state of a generated Application: the fields rendered from the
+// template and the parameters, and the settings of the ApplicationSet changing how they are written.
+func applicationSpecHash(applicationSet argov1alpha1.ApplicationSet, desiredApp *argov1alpha1.Application, preservedAnnotations []string, preservedLabels []string) (string, error) {
+	return hashJSON(struct {
+		ApplicationSet       types.UID                                    `json:"applicationSet"`
+		Labels               map[string]string                            `json:"labels,omitempty"`
+		Annotations          map[string]string                            `json:"annotations,omitempty"`
+		Finalizers           []string                                     `json:"finalizers,omitempty"`
+		Spec                 argov1alpha1.ApplicationSpec                 `json:"spec"`
+		Operation            *argov1alpha1.Operation                      `json:"operation,omitempty"`
+		PreservedAnnotations []string                                     `json:"preservedAnnotations,omitempty"`
+		PreservedLabels      []string                                     `json:"preservedLabels,omitempty"`
+		IgnoreDifferences    argov1alpha1.ApplicationSetIgnoreDifferences `json:"ignoreDifferences,omitempty"`
+	}{
+		ApplicationSet:       applicationSet.UID,
+		Labels:               desiredApp.Labels,
+		Annotations:          desiredApp.Annotations,
+		Finalizers:           desiredApp.Finalizers,
+		Spec:                 desiredApp.Spec,
+		Operation:            desiredApp.Operation,
+		PreservedAnnotations: preservedAnnotations,
+		PreservedLabels:      preservedLabels,
+		IgnoreDifferences:    applicationSet.Spec.IgnoreApplicationDifferences,
+	})
+}
+
+// liveApplicationHash returns the hash of the fields of a live Application which may change without bumping its
+// generation.
+func liveApplicationHash(app *argov1alpha1.Application) (string, error) {
+	return hashJSON(struct {
+		Labels          map[string]string       `json:"labels,omitempty"`
+		Annotations     map[string]string       `json:"annotations,omitempty"`
+		Finalizers      []string                `json:"finalizers,omitempty"`
+		OwnerReferences []metav1.OwnerReference `json:"ownerReferences,omitempty"`
+		Operation       *argov1alpha1.Operation `json:"operation,omitempty"`
+	}{
+		Labels:          app.Labels,
+		Annotations:     app.Annotations,
+		Finalizers:      app.Finalizers,
+		OwnerReferences: app.OwnerReferences,
+		Operation:       app.Operation,
+	})
+}
+
+func hashJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling the Application: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// isApplicationUnchanged returns whether the live Application has the given spec hash, and has not changed since a
+// full diff confirmed it matches the ApplicationSet. The live Application is returned, if it exists.
+func (r *ApplicationSetReconciler) isApplicationUnchanged(ctx context.Context, desiredApp *argov1alpha1.Application, specHash string) (bool, *argov1alpha1.Application) {
+	live := &argov1alpha1.Application{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desiredApp), live); err != nil {
+		return false, nil
+	}
+	if live.Annotations[common.AnnotationApplicationSetSpecHash] != specHash {
+		return false, live
+	}
+	synced, ok := r.syncedApplications.get(live.UID)
+	if !ok || synced.specHash != specHash || synced.generation != live.Generation {
+		return false, live
+	}
+	liveHash, err := liveApplicationHash(live)
+	if err != nil || liveHash != synced.liveHash {
+		return false, live
+	}
+	return true, live
+}
+
+// recordSyncedApplication records that a full diff confirmed the live Application matches the ApplicationSet.
+func (r *ApplicationSetReconciler) recordSyncedApplication(live *argov1alpha1.Application, specHash string) {
+	liveHash, err := liveApplicationHash(live)
+	if err != nil {
+		return
+	}
+	r.syncedApplications.set(live.UID, syncedApplication{specHash: specHash, generation: live.Generation, liveHash: liveHash})
+}
@@ -3,6 +3,8 @@ package template
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
@@ -21,6 +23,11 @@ func applyTemplatePatch(app *appv1.Application, templatePatch string) (*appv1.Ap
 		return nil, fmt.Errorf("error while converting template to json %q: %w", convertedTemplatePatch, err)
 	}
 
+	convertedTemplatePatch, err = normalizeRevisionHistoryLimit(convertedTemplatePatch)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := json.Unmarshal([]byte(convertedTemplatePatch), &appv1.Application{}); err != nil {
 		return nil, fmt.Errorf("invalid templatePatch %q: %w", convertedTemplatePatch, err)
 	}
@@ -41,3 +48,34 @@ func applyTemplatePatch(app *appv1.Application, templatePatch string) (*appv1.Ap
 
 	return &finalApp, nil
 }
+
+// normalizeRevisionHistoryLimit converts a quoted spec.revisionHistoryLimit, as produced when the value is rendered
+// from a template parameter, into the integer expected by the Application spec.
+func normalizeRevisionHistoryLimit(templatePatch string) (string, error) {
+	var patch map[string]any
+	if err := json.Unmarshal([]byte(templatePatch), &patch); err != nil {
+		// the patch is validated, and the error reported, by the caller
+		return templatePatch, nil
+	}
+
+	spec, ok := patch["spec"].(map[string]any)
+	if !ok {
+		return templatePatch, nil
+	}
+	rendered, ok := spec["revisionHistoryLimit"].(string)
+	if !ok {
+		return templatePatch, nil
+	}
+
+	limit, err := strconv.ParseInt(strings.TrimSpace(rendered), 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid templatePatch: revisionHistoryLimit %q is not an integer", rendered)
+	}
+	spec["revisionHistoryLimit"] = limit
+
+	normalized, err := json.Marshal(patch)
+	if err != nil {
+		return "", fmt.Errorf("error while marshalling templatePatch: %w", err)
+	}
+	return string(normalized), nil
+}
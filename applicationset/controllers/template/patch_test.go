@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -228,6 +229,29 @@ spec:
 				},
 			},
 		},
+		{
+			name: "quoted revisionHistoryLimit is converted to an integer",
+			appTemplate: &appv1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster-guestbook",
+				},
+				Spec: appv1.ApplicationSpec{
+					Project: "default",
+				},
+			},
+			templatePatch: `
+spec:
+  revisionHistoryLimit: "3"`,
+			expectedApp: &appv1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster-guestbook",
+				},
+				Spec: appv1.ApplicationSpec{
+					Project:              "default",
+					RevisionHistoryLimit: ptr.To(int64(3)),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -247,3 +271,11 @@ func TestError(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestInvalidRevisionHistoryLimit(t *testing.T) {
+	app := &appv1.Application{}
+
+	result, err := applyTemplatePatch(app, `{"spec": {"revisionHistoryLimit": "ten"}}`)
+	require.ErrorContains(t, err, `revisionHistoryLimit "ten" is not an integer`)
+	require.Nil(t, result)
+}
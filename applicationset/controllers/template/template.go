@@ -1,26 +1,92 @@
 package template
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template/parse"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/enrichers"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
-func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client) ([]argov1alpha1.Application, argov1alpha1.ApplicationSetReasonType, error) {
+const (
+	// indexParam is the parameter holding the position of a parameter set among the ones produced by its generator.
+	indexParam = "index"
+	// countParam is the parameter holding the number of parameter sets produced by a generator.
+	countParam = "count"
+	// appParam is the parameter holding the live state of the generated Application, if it already exists.
+	appParam = "app"
+)
+
+// GenerateApplications generates the Applications of the ApplicationSet. The parameter sets produced by each generator
+// are passed to the enricher, if any, before the template is rendered against them. With Go templates, the status of
+// the Application generated from a parameter set is available to the template as `.app.status` if it is one of the
+// given current Applications. The number of parameter sets produced by each generator is returned along with the
+// Applications.
+func GenerateApplications(ctx context.Context, logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, enricher enrichers.Enricher, renderer utils.Renderer, client client.Client, currentApplications []argov1alpha1.Application) ([]argov1alpha1.Application, []int64, argov1alpha1.ApplicationSetReasonType, error) {
+	return generateApplications(ctx, logCtx, applicationSetInfo, g, enricher, renderer, client, currentApplications, nil)
+}
+
+// ParameterSet is a parameter set produced by a generator of an ApplicationSet, along with the name of the
+// Application rendered from it.
+type ParameterSet struct {
+	// Generator is the position of the generator in the ApplicationSet.
+	Generator int
+	// Params is the parameter set, including the index and count parameters.
+	Params map[string]any
+	// Application is the name of the Application rendered from the parameter set.
+	Application string
+}
+
+// GenerateParameterSets generates the Applications of the ApplicationSet like GenerateApplications, and returns the
+// parameter set each of them was rendered from.
+func GenerateParameterSets(ctx context.Context, logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client) ([]ParameterSet, error) {
+	var res []ParameterSet
+	_, _, _, err := generateApplications(ctx, logCtx, applicationSetInfo, g, nil, renderer, client, nil, func(generatorIndex int, params map[string]any, app *argov1alpha1.Application) {
+		res = append(res, ParameterSet{Generator: generatorIndex, Params: params, Application: app.Name})
+	})
+	return res, err
+}
+
+// generateApplications implements GenerateApplications, calling onApplication, if not nil, with each generated
+// Application and the parameter set it was rendered from.
+func generateApplications(ctx context.Context, logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, enricher enrichers.Enricher, renderer utils.Renderer, client client.Client, currentApplications []argov1alpha1.Application, onApplication func(generatorIndex int, params map[string]any, app *argov1alpha1.Application)) ([]argov1alpha1.Application, []int64, argov1alpha1.ApplicationSetReasonType, error) {
 	var res []argov1alpha1.Application
+	parameterSets := make([]int64, len(applicationSetInfo.Spec.Generators))
+	// The templates are the same for all the parameter sets: parse them once
+	renderer, err := utils.ForApplicationSet(ctx, utils.WithTemplateCache(renderer), &applicationSetInfo)
+	if err != nil {
+		return nil, parameterSets, argov1alpha1.ApplicationSetReasonRenderTemplateParamsError, err
+	}
+
+	currentApps := make(map[string]*argov1alpha1.Application, len(currentApplications))
+	for i := range currentApplications {
+		currentApps[currentApplications[i].Name] = &currentApplications[i]
+	}
 
 	var firstError error
 	var applicationSetReason argov1alpha1.ApplicationSetReasonType
+	// The Applications are only rendered again with their status when the templates may use it
+	usesAppParam := UsesAppParam(&applicationSetInfo)
 
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
+	for generatorIndex, requestedGenerator := range applicationSetInfo.Spec.Generators {
 		t, err := generators.Transform(requestedGenerator, g, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]any{}, client)
+		for _, a := range t {
+			parameterSets[generatorIndex] += int64(len(a.Params))
+		}
 		if err != nil {
 			logCtx.WithError(err).WithField("generator", requestedGenerator).
 				Error("error generating application from params")
@@ -28,14 +94,56 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 				firstError = err
 				applicationSetReason = argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError
 			}
-			continue
+			// The Applications of the parameter sets which could still be generated are rendered
+			if !errors.Is(err, generators.ErrIncompleteParams) {
+				continue
+			}
 		}
 
 		for _, a := range t {
-			tmplApplication := GetTempApplication(a.Template)
+			if enricher != nil {
+				a.Params, err = enricher.Enrich(ctx, &applicationSetInfo, a.Params)
+				if err != nil {
+					logCtx.WithError(err).WithField("generator", requestedGenerator).
+						Error("error enriching params")
+					if firstError == nil {
+						firstError = err
+						applicationSetReason = argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError
+					}
+					continue
+				}
+			}
 
-			for _, p := range a.Params {
-				app, err := renderer.RenderTemplateParams(tmplApplication, applicationSetInfo.Spec.SyncPolicy, p, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+			for i, p := range a.Params {
+				// The child generators of a Matrix or Merge generator may have templates of their own
+				var tmplApplication *argov1alpha1.Application
+				if a.ParamsTemplates != nil {
+					tmplApplication = GetTempApplication(a.ParamsTemplates[i])
+				} else {
+					tmplApplication = GetTempApplication(a.Template)
+				}
+				applyManagedNamespace(tmplApplication, applicationSetInfo.Spec.ManagedNamespace)
+				_, hasAppParam := p[appParam]
+				p = withIndexParams(p, i, len(a.Params), applicationSetInfo.Spec.GoTemplate)
+				generatedParams := p
+				withStatus := applicationSetInfo.Spec.GoTemplate && !hasAppParam
+				var app *argov1alpha1.Application
+				var err error
+				if withStatus {
+					p, err = withAppParam(p, nil)
+				}
+				if err == nil {
+					app, err = renderer.RenderTemplateParams(tmplApplication, &applicationSetInfo, p, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+				}
+				if err == nil && withStatus && usesAppParam {
+					// The name of the Application is only known once rendered: render again with its status if it exists
+					if current, ok := currentApps[app.Name]; ok {
+						p, err = withAppParam(p, current)
+						if err == nil {
+							app, err = renderer.RenderTemplateParams(tmplApplication, &applicationSetInfo, p, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+						}
+					}
+				}
 				if err != nil {
 					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
 						Error("error generating application from params")
@@ -63,9 +171,23 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 					app = patchedApplication
 				}
 
+				if err := applyRevisionHistoryLimit(app, applicationSetInfo.Spec.RevisionHistoryLimit); err != nil {
+					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+						Error("error generating application from params")
+
+					if firstError == nil {
+						firstError = err
+						applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+					}
+					continue
+				}
+
 				// The app's namespace must be the same as the AppSet's namespace to preserve the appsets-in-any-namespace
 				// security boundary.
 				app.Namespace = applicationSetInfo.Namespace
+				if onApplication != nil {
+					onApplication(generatorIndex, generatedParams, app)
+				}
 				res = append(res, *app)
 			}
 		}
@@ -76,11 +198,192 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 		}
 	}
 
-	return res, applicationSetReason, firstError
+	return res, parameterSets, applicationSetReason, firstError
+}
+
+// RenderApplications renders the ApplicationSet template, and its templatePatch if any, against each of the given
+// parameter sets. Unlike GenerateApplications no generator is invoked, which makes it possible to render a template
+// locally against user-supplied parameters. The template referenced by the ApplicationSet, if any, is fetched with the
+// given repos, which may be nil when no repository can be reached.
+func RenderApplications(ctx context.Context, repos services.Repos, applicationSet argov1alpha1.ApplicationSet, params []map[string]any, renderer utils.Renderer) ([]argov1alpha1.Application, error) {
+	resolved, err := ResolveTemplateRef(ctx, repos, &applicationSet)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving template reference: %w", err)
+	}
+	applicationSetInfo := *resolved
+	res := make([]argov1alpha1.Application, 0, len(params))
+	renderer, err = utils.ForApplicationSet(ctx, utils.WithTemplateCache(renderer), &applicationSetInfo)
+	if err != nil {
+		return nil, err
+	}
+	tmplApplication := GetTempApplication(applicationSetInfo.Spec.Template)
+	applyManagedNamespace(tmplApplication, applicationSetInfo.Spec.ManagedNamespace)
+
+	for i, p := range params {
+		_, hasAppParam := p[appParam]
+		p = withIndexParams(p, i, len(params), applicationSetInfo.Spec.GoTemplate)
+		if applicationSetInfo.Spec.GoTemplate && !hasAppParam {
+			var err error
+			if p, err = withAppParam(p, nil); err != nil {
+				return nil, fmt.Errorf("error rendering template with parameter set %d: %w", i, err)
+			}
+		}
+		app, err := renderer.RenderTemplateParams(tmplApplication, &applicationSetInfo, p, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("error rendering template with parameter set %d: %w", i, err)
+		}
+
+		if applicationSetInfo.Spec.TemplatePatch != nil {
+			app, err = renderTemplatePatch(renderer, app, applicationSetInfo, p)
+			if err != nil {
+				return nil, fmt.Errorf("error rendering template patch with parameter set %d: %w", i, err)
+			}
+		}
+
+		if err := applyRevisionHistoryLimit(app, applicationSetInfo.Spec.RevisionHistoryLimit); err != nil {
+			return nil, fmt.Errorf("error rendering template with parameter set %d: %w", i, err)
+		}
+
+		app.Namespace = applicationSetInfo.Namespace
+		res = append(res, *app)
+	}
+
+	return res, nil
+}
+
+// withIndexParams returns a copy of the given parameter set with the `index` and `count` parameters added, holding
+// respectively the position of the parameter set among the ones produced by its generator and the number of parameter
+// sets produced. Parameters of the same name set by the generator take precedence. Values are strings when Go
+// templating is disabled, as fasttemplate only substitutes string values.
+func withIndexParams(params map[string]any, index, count int, useGoTemplate bool) map[string]any {
+	res := make(map[string]any, len(params)+2)
+	if useGoTemplate {
+		res[indexParam] = index
+		res[countParam] = count
+	} else {
+		res[indexParam] = strconv.Itoa(index)
+		res[countParam] = strconv.Itoa(count)
+	}
+	for k, v := range params {
+		res[k] = v
+	}
+	return res
+}
+
+// withAppParam returns a copy of the given parameter set with the `app` parameter added, holding the status of the
+// given existing Application, or an empty status if there is none.
+func withAppParam(params map[string]any, current *argov1alpha1.Application) (map[string]any, error) {
+	status := map[string]any{}
+	if current != nil {
+		data, err := json.Marshal(current.Status)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling status of Application %s: %w", current.Name, err)
+		}
+		if err := json.Unmarshal(data, &status); err != nil {
+			return nil, fmt.Errorf("error unmarshaling status of Application %s: %w", current.Name, err)
+		}
+	}
+	res := make(map[string]any, len(params)+1)
+	for k, v := range params {
+		res[k] = v
+	}
+	res[appParam] = map[string]any{"status": status}
+	return res, nil
+}
+
+// UsesAppParam returns whether the Go templates of the ApplicationSet, including the ones of its generators, may use the
+// `app` parameter. It errs on the side of caution: the templates passing the whole parameter set to a function, or
+// which cannot be parsed, are reported as using it.
+func UsesAppParam(applicationSetInfo *argov1alpha1.ApplicationSet) bool {
+	if !applicationSetInfo.Spec.GoTemplate {
+		return false
+	}
+	data, err := json.Marshal([]any{applicationSetInfo.Spec.Template, applicationSetInfo.Spec.TemplatePatch, applicationSetInfo.Spec.Generators})
+	if err != nil {
+		return true
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return true
+	}
+	for _, tmpl := range collectTemplateStrings(value, nil) {
+		tree := parse.New("")
+		// The functions registered by the plugins are not known here
+		tree.Mode = parse.SkipFuncCheck
+		if _, err := tree.Parse(tmpl, "", "", map[string]*parse.Tree{}); err != nil {
+			return true
+		}
+		if usesAppParamNode(tree.Root) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectTemplateStrings appends the strings of the given JSON value which contain a template action, including the
+// keys of its objects, to res.
+func collectTemplateStrings(value any, res []string) []string {
+	switch v := value.(type) {
+	case string:
+		if strings.Contains(v, "{{") {
+			res = append(res, v)
+		}
+	case []any:
+		for _, item := range v {
+			res = collectTemplateStrings(item, res)
+		}
+	case map[string]any:
+		for key, item := range v {
+			res = collectTemplateStrings(item, collectTemplateStrings(key, res))
+		}
+	}
+	return res
+}
+
+func usesAppParamNode(node parse.Node) bool {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		return slices.ContainsFunc(n.Nodes, usesAppParamNode)
+	case *parse.ActionNode:
+		return usesAppParamNode(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		return slices.ContainsFunc(n.Cmds, func(cmd *parse.CommandNode) bool { return usesAppParamNode(cmd) })
+	case *parse.CommandNode:
+		return slices.ContainsFunc(n.Args, usesAppParamNode)
+	case *parse.IfNode:
+		return usesAppParamNode(&n.BranchNode)
+	case *parse.RangeNode:
+		return usesAppParamNode(&n.BranchNode)
+	case *parse.WithNode:
+		return usesAppParamNode(&n.BranchNode)
+	case *parse.BranchNode:
+		return usesAppParamNode(n.Pipe) || usesAppParamNode(n.List) || usesAppParamNode(n.ElseList)
+	case *parse.TemplateNode:
+		return usesAppParamNode(n.Pipe)
+	case *parse.ChainNode:
+		return usesAppParamNode(n.Node)
+	case *parse.FieldNode:
+		return n.Ident[0] == appParam
+	case *parse.VariableNode:
+		return n.Ident[0] == "$" && (len(n.Ident) == 1 || n.Ident[1] == appParam)
+	case *parse.DotNode:
+		// The whole parameter set is passed along, e.g. to toJson
+		return true
+	case *parse.StringNode:
+		// e.g. index . "app"
+		return n.Text == appParam
+	}
+	return false
 }
 
 func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, params map[string]any) (*argov1alpha1.Application, error) {
-	replacedTemplate, err := r.Replace(*applicationSetInfo.Spec.TemplatePatch, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+	replacedTemplate, err := r.Replace(*applicationSetInfo.Spec.TemplatePatch, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions, false)
 	if err != nil {
 		return nil, fmt.Errorf("error replacing values in templatePatch: %w", err)
 	}
@@ -88,6 +391,39 @@ func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applic
 	return applyTemplatePatch(app, replacedTemplate)
 }
 
+// applyRevisionHistoryLimit defaults the revision history limit of the generated Application to the one of the
+// ApplicationSet, unless the template already sets one, and validates the resulting value.
+func applyRevisionHistoryLimit(app *argov1alpha1.Application, revisionHistoryLimit *int64) error {
+	if app.Spec.RevisionHistoryLimit == nil && revisionHistoryLimit != nil {
+		limit := *revisionHistoryLimit
+		app.Spec.RevisionHistoryLimit = &limit
+	}
+	if app.Spec.RevisionHistoryLimit != nil && *app.Spec.RevisionHistoryLimit < 0 {
+		return fmt.Errorf("invalid revisionHistoryLimit %d: must not be negative", *app.Spec.RevisionHistoryLimit)
+	}
+	return nil
+}
+
+// applyManagedNamespace makes the template Application create its destination namespace with the metadata of the
+// given managed namespace, unless the template already configures the namespace creation or its metadata. The metadata
+// is applied before rendering so that it is templated like the rest of the Application.
+func applyManagedNamespace(tmplApplication *argov1alpha1.Application, managedNamespace *argov1alpha1.ApplicationSetManagedNamespace) {
+	if managedNamespace == nil {
+		return
+	}
+	syncPolicy := &argov1alpha1.SyncPolicy{}
+	if tmplApplication.Spec.SyncPolicy != nil {
+		syncPolicy = tmplApplication.Spec.SyncPolicy.DeepCopy()
+	}
+	if !syncPolicy.SyncOptions.HasOption("CreateNamespace=false") {
+		syncPolicy.SyncOptions = syncPolicy.SyncOptions.AddOption("CreateNamespace=true")
+	}
+	if syncPolicy.ManagedNamespaceMetadata == nil && managedNamespace.Metadata != nil {
+		syncPolicy.ManagedNamespaceMetadata = managedNamespace.Metadata.DeepCopy()
+	}
+	tmplApplication.Spec.SyncPolicy = syncPolicy
+}
+
 func GetTempApplication(applicationSetTemplate argov1alpha1.ApplicationSetTemplate) *argov1alpha1.Application {
 	var tmplApplication argov1alpha1.Application
 	tmplApplication.Annotations = applicationSetTemplate.Annotations
@@ -0,0 +1,65 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"dario.cat/mergo"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ErrTemplateRefNotSupported is returned when an ApplicationSet references a template which cannot be fetched.
+var ErrTemplateRefNotSupported = errors.New("template references are not supported")
+
+// ResolveTemplateRef returns a copy of the ApplicationSet whose template is the one referenced by its templateRef,
+// merged with the fields of its own template, which take precedence. The copy no longer references a template, so that
+// resolving it again is a no-op. The ApplicationSet itself is returned if it does not reference a template.
+func ResolveTemplateRef(ctx context.Context, repos services.Repos, appSet *argov1alpha1.ApplicationSet) (*argov1alpha1.ApplicationSet, error) {
+	if appSet.Spec.TemplateRef == nil {
+		return appSet, nil
+	}
+	ref := appSet.Spec.TemplateRef.Git
+	if ref == nil {
+		return nil, fmt.Errorf("%w: the template reference has no git field", ErrTemplateRefNotSupported)
+	}
+	if repos == nil {
+		return nil, ErrTemplateRefNotSupported
+	}
+
+	revision := ref.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+	// As for the Git generator, only the globally-scoped repo credentials can be used when the project is templated
+	project := appSet.Spec.Template.Spec.Project
+	if strings.Contains(project, "{{") {
+		project = ""
+	}
+
+	files, _, err := repos.GetFiles(ctx, ref.RepoURL, revision, project, ref.Path, appSet.RefreshRequired(), false, false)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the template %s of %s at revision %s: %w", ref.Path, ref.RepoURL, revision, err)
+	}
+	content, ok := files[ref.Path]
+	if !ok {
+		return nil, fmt.Errorf("template %s not found in %s at revision %s", ref.Path, ref.RepoURL, revision)
+	}
+
+	var refTemplate argov1alpha1.ApplicationSetTemplate
+	if err := yaml.UnmarshalStrict(content, &refTemplate); err != nil {
+		return nil, fmt.Errorf("error unmarshaling the template %s of %s: %w", ref.Path, ref.RepoURL, err)
+	}
+
+	resolved := appSet.DeepCopy()
+	resolved.Spec.TemplateRef = nil
+	if err := mergo.Merge(&resolved.Spec.Template, refTemplate); err != nil {
+		return nil, fmt.Errorf("error merging the template %s of %s: %w", ref.Path, ref.RepoURL, err)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,152 @@
+package template
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestResolveTemplateRef(t *testing.T) {
+	const templateFile = `
+metadata:
+  name: '{{.cluster}}-guestbook'
+  labels:
+    team: guestbook
+spec:
+  project: guestbook
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps
+    path: guestbook
+  destination:
+    server: '{{.url}}'
+    namespace: guestbook
+`
+	newAppSet := func(ref *v1alpha1.ApplicationSetTemplateRef) *v1alpha1.ApplicationSet {
+		return &v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Template: v1alpha1.ApplicationSetTemplate{
+					Spec: v1alpha1.ApplicationSpec{Project: "default"},
+				},
+				TemplateRef: ref,
+			},
+		}
+	}
+	gitRef := &v1alpha1.ApplicationSetTemplateRef{
+		Git: &v1alpha1.ApplicationSetTemplateGitRef{
+			RepoURL: "https://github.com/argoproj/templates",
+			Path:    "guestbook/template.yaml",
+		},
+	}
+
+	t.Run("no template reference", func(t *testing.T) {
+		appSet := newAppSet(nil)
+		resolved, err := ResolveTemplateRef(t.Context(), nil, appSet)
+		require.NoError(t, err)
+		assert.Same(t, appSet, resolved)
+	})
+
+	t.Run("template merged with the referenced one", func(t *testing.T) {
+		repos := &mocks.Repos{}
+		repos.On("GetFiles", mock.Anything, "https://github.com/argoproj/templates", "HEAD", "default", "guestbook/template.yaml", false, false, false).
+			Return(map[string][]byte{"guestbook/template.yaml": []byte(templateFile)}, nil, nil)
+		appSet := newAppSet(gitRef)
+
+		resolved, err := ResolveTemplateRef(t.Context(), repos, appSet)
+		require.NoError(t, err)
+		assert.Equal(t, "{{.cluster}}-guestbook", resolved.Spec.Template.Name)
+		assert.Equal(t, map[string]string{"team": "guestbook"}, resolved.Spec.Template.Labels)
+		assert.Equal(t, "default", resolved.Spec.Template.Spec.Project)
+		assert.Equal(t, "{{.url}}", resolved.Spec.Template.Spec.Destination.Server)
+		assert.Equal(t, "guestbook", resolved.Spec.Template.Spec.Source.Path)
+		assert.Nil(t, resolved.Spec.TemplateRef)
+		// The ApplicationSet itself is left untouched
+		assert.Empty(t, appSet.Spec.Template.Name)
+		assert.NotNil(t, appSet.Spec.TemplateRef)
+		repos.AssertExpectations(t)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		repos := &mocks.Repos{}
+		repos.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(map[string][]byte{}, nil, nil)
+
+		_, err := ResolveTemplateRef(t.Context(), repos, newAppSet(gitRef))
+		require.EqualError(t, err, "template guestbook/template.yaml not found in https://github.com/argoproj/templates at revision HEAD")
+	})
+
+	t.Run("repo-server error", func(t *testing.T) {
+		repos := &mocks.Repos{}
+		repos.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, nil, errors.New("repository not accessible"))
+
+		_, err := ResolveTemplateRef(t.Context(), repos, newAppSet(gitRef))
+		require.ErrorContains(t, err, "repository not accessible")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		repos := &mocks.Repos{}
+		repos.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(map[string][]byte{"guestbook/template.yaml": []byte("metadata:\n  nmae: typo\n")}, nil, nil)
+
+		_, err := ResolveTemplateRef(t.Context(), repos, newAppSet(gitRef))
+		require.ErrorContains(t, err, "error unmarshaling the template guestbook/template.yaml")
+	})
+
+	t.Run("template reference without repos", func(t *testing.T) {
+		_, err := ResolveTemplateRef(t.Context(), nil, newAppSet(gitRef))
+		require.ErrorIs(t, err, ErrTemplateRefNotSupported)
+	})
+}
+
+func TestRenderApplicationsTemplateRef(t *testing.T) {
+	const templateFile = `
+metadata:
+  name: '{{.cluster}}-guestbook'
+spec:
+  destination:
+    server: '{{.url}}'
+    namespace: guestbook
+`
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Template: v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{Project: "default"},
+			},
+			TemplateRef: &v1alpha1.ApplicationSetTemplateRef{
+				Git: &v1alpha1.ApplicationSetTemplateGitRef{
+					RepoURL: "https://github.com/argoproj/templates",
+					Path:    "guestbook/template.yaml",
+				},
+			},
+		},
+	}
+	params := []map[string]any{{"cluster": "dev", "url": "https://dev"}}
+
+	t.Run("renders the referenced template", func(t *testing.T) {
+		repos := &mocks.Repos{}
+		repos.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(map[string][]byte{"guestbook/template.yaml": []byte(templateFile)}, nil, nil)
+
+		apps, err := RenderApplications(t.Context(), repos, appSet, params, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 1)
+		assert.Equal(t, "dev-guestbook", apps[0].Name)
+		assert.Equal(t, "default", apps[0].Spec.Project)
+		assert.Equal(t, "https://dev", apps[0].Spec.Destination.Server)
+	})
+
+	t.Run("fails without repos", func(t *testing.T) {
+		_, err := RenderApplications(t.Context(), nil, appSet, params, &utils.Render{})
+		require.ErrorIs(t, err, ErrTemplateRefNotSupported)
+	})
+}
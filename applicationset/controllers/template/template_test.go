@@ -1,6 +1,7 @@
 package template
 
 import (
+	"context"
 	"errors"
 	"maps"
 	"testing"
@@ -12,7 +13,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/enrichers"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	genmock "github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
@@ -30,13 +33,14 @@ func TestGenerateApplications(t *testing.T) {
 	require.NoError(t, err)
 
 	for _, c := range []struct {
-		name                string
-		params              []map[string]any
-		template            v1alpha1.ApplicationSetTemplate
-		generateParamsError error
-		rendererError       error
-		expectErr           bool
-		expectedReason      v1alpha1.ApplicationSetReasonType
+		name                  string
+		params                []map[string]any
+		template              v1alpha1.ApplicationSetTemplate
+		generateParamsError   error
+		rendererError         error
+		expectErr             bool
+		expectedReason        v1alpha1.ApplicationSetReasonType
+		expectedParameterSets []int64
 	}{
 		{
 			name:   "Generate two applications",
@@ -49,13 +53,15 @@ func TestGenerateApplications(t *testing.T) {
 				},
 				Spec: v1alpha1.ApplicationSpec{},
 			},
-			expectedReason: "",
+			expectedReason:        "",
+			expectedParameterSets: []int64{2},
 		},
 		{
-			name:                "Handles error from the generator",
-			generateParamsError: errors.New("error"),
-			expectErr:           true,
-			expectedReason:      v1alpha1.ApplicationSetReasonApplicationParamsGenerationError,
+			name:                  "Handles error from the generator",
+			generateParamsError:   errors.New("error"),
+			expectErr:             true,
+			expectedReason:        v1alpha1.ApplicationSetReasonApplicationParamsGenerationError,
+			expectedParameterSets: []int64{0},
 		},
 		{
 			name:   "Handles error from the render",
@@ -68,9 +74,10 @@ func TestGenerateApplications(t *testing.T) {
 				},
 				Spec: v1alpha1.ApplicationSpec{},
 			},
-			rendererError:  errors.New("error"),
-			expectErr:      true,
-			expectedReason: v1alpha1.ApplicationSetReasonRenderTemplateParamsError,
+			rendererError:         errors.New("error"),
+			expectErr:             true,
+			expectedReason:        v1alpha1.ApplicationSetReasonRenderTemplateParamsError,
+			expectedParameterSets: []int64{2},
 		},
 	} {
 		cc := c
@@ -102,12 +109,13 @@ func TestGenerateApplications(t *testing.T) {
 			var expectedApps []v1alpha1.Application
 
 			if cc.generateParamsError == nil {
-				for _, p := range cc.params {
+				for i, p := range cc.params {
+					p = withIndexParams(p, i, len(cc.params), false)
 					if cc.rendererError != nil {
-						rendererMock.On("RenderTemplateParams", GetTempApplication(cc.template), mock.AnythingOfType("*v1alpha1.ApplicationSetSyncPolicy"), p, false, []string(nil)).
+						rendererMock.On("RenderTemplateParams", GetTempApplication(cc.template), mock.AnythingOfType("*v1alpha1.ApplicationSet"), p, false, []string(nil)).
 							Return(nil, cc.rendererError)
 					} else {
-						rendererMock.On("RenderTemplateParams", GetTempApplication(cc.template), mock.AnythingOfType("*v1alpha1.ApplicationSetSyncPolicy"), p, false, []string(nil)).
+						rendererMock.On("RenderTemplateParams", GetTempApplication(cc.template), mock.AnythingOfType("*v1alpha1.ApplicationSet"), p, false, []string(nil)).
 							Return(&app, nil)
 						expectedApps = append(expectedApps, app)
 					}
@@ -119,7 +127,7 @@ func TestGenerateApplications(t *testing.T) {
 			}
 			renderer := &rendererMock
 
-			got, reason, err := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			got, parameterSets, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
 					Namespace: "namespace",
@@ -130,8 +138,10 @@ func TestGenerateApplications(t *testing.T) {
 				},
 			},
 				generators,
+				nil,
 				renderer,
 				nil,
+				nil,
 			)
 
 			if cc.expectErr {
@@ -140,6 +150,7 @@ func TestGenerateApplications(t *testing.T) {
 				require.NoError(t, err)
 			}
 			assert.Equal(t, expectedApps, got)
+			assert.Equal(t, cc.expectedParameterSets, parameterSets)
 			assert.Equal(t, cc.expectedReason, reason)
 			generatorMock.AssertNumberOfCalls(t, "GenerateParams", 1)
 
@@ -213,7 +224,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 
 			rendererMock := rendmock.Renderer{}
 
-			rendererMock.On("RenderTemplateParams", GetTempApplication(cc.expectedMerged), mock.AnythingOfType("*v1alpha1.ApplicationSetSyncPolicy"), cc.params[0], false, []string(nil)).
+			rendererMock.On("RenderTemplateParams", GetTempApplication(cc.expectedMerged), mock.AnythingOfType("*v1alpha1.ApplicationSet"), withIndexParams(cc.params[0], 0, len(cc.params), false), false, []string(nil)).
 				Return(&cc.expectedApps[0], nil)
 
 			generators := map[string]generators.Generator{
@@ -221,7 +232,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 			}
 			renderer := &rendererMock
 
-			got, _, _ := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			got, _, _, _ := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
 					Namespace: "namespace",
@@ -232,8 +243,10 @@ func TestMergeTemplateApplications(t *testing.T) {
 				},
 			},
 				generators,
+				nil,
 				renderer,
 				nil,
+				nil,
 			)
 
 			assert.Equal(t, cc.expectedApps, got)
@@ -328,7 +341,7 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 			}
 			renderer := &utils.Render{}
 
-			gotApp, _, _ := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			gotApp, _, _, _ := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
 				Spec: v1alpha1.ApplicationSetSpec{
 					GoTemplate: true,
 					Generators: []v1alpha1.ApplicationSetGenerator{{
@@ -338,8 +351,10 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 				},
 			},
 				generators,
+				nil,
 				renderer,
 				nil,
+				nil,
 			)
 			assert.Equal(t, cases.expectedApp[0].Name, gotApp[0].Name)
 			assert.Equal(t, cases.expectedApp[0].Spec.Source.TargetRevision, gotApp[0].Spec.Source.TargetRevision)
@@ -348,3 +363,495 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateApplicationsWithEnricher(t *testing.T) {
+	generator := v1alpha1.ApplicationSetGenerator{
+		List: &v1alpha1.ListGenerator{},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{name}}",
+					Labels: map[string]string{
+						"cost-center": "{{costCenter}}",
+					},
+				},
+			},
+		},
+	}
+	costCenter := enrichers.EnricherFunc(func(_ context.Context, _ *v1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+		res := make([]map[string]any, 0, len(params))
+		for _, p := range params {
+			enriched := maps.Clone(p)
+			enriched["costCenter"] = "cc-" + p["name"].(string)
+			res = append(res, enriched)
+		}
+		return res, nil
+	})
+	failing := enrichers.EnricherFunc(func(_ context.Context, _ *v1alpha1.ApplicationSet, _ []map[string]any) ([]map[string]any, error) {
+		return nil, errors.New("service unavailable")
+	})
+
+	for _, c := range []struct {
+		name           string
+		enricher       enrichers.Enricher
+		expectedLabels []string
+		expectedError  string
+	}{
+		{
+			name:           "enriches the params",
+			enricher:       enrichers.Chain{{Name: "cost-center", Enricher: costCenter, FailurePolicy: enrichers.FailurePolicyFail}},
+			expectedLabels: []string{"cc-app1", "cc-app2"},
+		},
+		{
+			name: "ignores a failing enricher",
+			enricher: enrichers.Chain{
+				{Name: "failing", Enricher: failing, FailurePolicy: enrichers.FailurePolicyIgnore},
+				{Name: "cost-center", Enricher: costCenter, FailurePolicy: enrichers.FailurePolicyFail},
+			},
+			expectedLabels: []string{"cc-app1", "cc-app2"},
+		},
+		{
+			name: "fails on a failing enricher",
+			enricher: enrichers.Chain{
+				{Name: "cost-center", Enricher: costCenter, FailurePolicy: enrichers.FailurePolicyFail},
+				{Name: "failing", Enricher: failing, FailurePolicy: enrichers.FailurePolicyFail},
+			},
+			expectedError: "error enriching parameters with enricher failing: service unavailable",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			generatorMock := genmock.Generator{}
+			generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+				Return([]map[string]any{{"name": "app1"}, {"name": "app2"}}, nil)
+			generatorMock.On("GetTemplate", &generator).
+				Return(&v1alpha1.ApplicationSetTemplate{})
+
+			got, _, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet,
+				map[string]generators.Generator{"List": &generatorMock},
+				c.enricher,
+				&utils.Render{},
+				nil,
+				nil,
+			)
+
+			if c.expectedError != "" {
+				require.EqualError(t, err, c.expectedError)
+				assert.Equal(t, v1alpha1.ApplicationSetReasonType(v1alpha1.ApplicationSetReasonApplicationParamsGenerationError), reason)
+				assert.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			var labels []string
+			for _, app := range got {
+				labels = append(labels, app.Labels["cost-center"])
+			}
+			assert.Equal(t, c.expectedLabels, labels)
+		})
+	}
+}
+
+func TestGenerateParameterSets(t *testing.T) {
+	generator := v1alpha1.ApplicationSetGenerator{
+		List: &v1alpha1.ListGenerator{},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{generator, generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{cluster}}-{{index}}",
+				},
+			},
+		},
+	}
+	generatorMock := genmock.Generator{}
+	generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{{"cluster": "dev"}, {"cluster": "prod"}}, nil)
+	generatorMock.On("GetTemplate", &generator).
+		Return(&v1alpha1.ApplicationSetTemplate{})
+
+	got, err := GenerateParameterSets(t.Context(), log.NewEntry(log.StandardLogger()), appSet,
+		map[string]generators.Generator{"List": &generatorMock},
+		&utils.Render{},
+		nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []ParameterSet{
+		{Generator: 0, Params: map[string]any{"cluster": "dev", "index": "0", "count": "2"}, Application: "dev-0"},
+		{Generator: 0, Params: map[string]any{"cluster": "prod", "index": "1", "count": "2"}, Application: "prod-1"},
+		{Generator: 1, Params: map[string]any{"cluster": "dev", "index": "0", "count": "2"}, Application: "dev-0"},
+		{Generator: 1, Params: map[string]any{"cluster": "prod", "index": "1", "count": "2"}, Application: "prod-1"},
+	}, got)
+}
+
+func TestGenerateApplicationsWithAppStatus(t *testing.T) {
+	generator := v1alpha1.ApplicationSetGenerator{
+		List: &v1alpha1.ListGenerator{},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate:        true,
+			GoTemplateOptions: []string{"missingkey=error"},
+			Generators:        []v1alpha1.ApplicationSetGenerator{generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{ .name }}",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source: &v1alpha1.ApplicationSource{
+						TargetRevision: `{{ if eq (dig "health" "status" "" .app.status) "Healthy" }}v2{{ else }}v1{{ end }}`,
+					},
+				},
+			},
+		},
+	}
+	currentApplications := []v1alpha1.Application{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "healthy", Namespace: "namespace"},
+			Status:     v1alpha1.ApplicationStatus{Health: v1alpha1.HealthStatus{Status: "Healthy"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "progressing", Namespace: "namespace"},
+			Status:     v1alpha1.ApplicationStatus{Health: v1alpha1.HealthStatus{Status: "Progressing"}},
+		},
+	}
+
+	generatorMock := genmock.Generator{}
+	generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{{"name": "healthy"}, {"name": "progressing"}, {"name": "new"}}, nil)
+	generatorMock.On("GetTemplate", &generator).
+		Return(&v1alpha1.ApplicationSetTemplate{})
+
+	got, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet,
+		map[string]generators.Generator{"List": &generatorMock},
+		nil,
+		&utils.Render{},
+		nil,
+		currentApplications,
+	)
+	require.NoError(t, err)
+
+	revisions := map[string]string{}
+	for _, app := range got {
+		revisions[app.Name] = app.Spec.Source.TargetRevision
+	}
+	assert.Equal(t, map[string]string{"healthy": "v2", "progressing": "v1", "new": "v1"}, revisions)
+
+	t.Run("app parameter of the generator takes precedence", func(t *testing.T) {
+		generatorMock := genmock.Generator{}
+		generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+			Return([]map[string]any{{"name": "healthy", "app": map[string]any{"status": map[string]any{}}}}, nil)
+		generatorMock.On("GetTemplate", &generator).
+			Return(&v1alpha1.ApplicationSetTemplate{})
+
+		got, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet,
+			map[string]generators.Generator{"List": &generatorMock},
+			nil,
+			&utils.Render{},
+			nil,
+			currentApplications,
+		)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "v1", got[0].Spec.Source.TargetRevision)
+	})
+}
+
+func TestUsesAppParam(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		goTemplate bool
+		template   string
+		patch      string
+		generator  v1alpha1.ApplicationSetGenerator
+		expected   bool
+	}{
+		{name: "field", goTemplate: true, template: "{{ .app.status.health.status }}", expected: true},
+		{name: "function argument", goTemplate: true, template: `{{ dig "health" "status" "" .app.status }}`, expected: true},
+		{name: "root variable", goTemplate: true, template: "{{ range $.app.status.resources }}{{ .name }}{{ end }}", expected: true},
+		{name: "index", goTemplate: true, template: `{{ index . "app" }}`, expected: true},
+		{name: "whole parameter set", goTemplate: true, template: "{{ toJson . }}", expected: true},
+		{name: "template patch", goTemplate: true, template: "{{ .name }}", patch: "{{ .app.status }}", expected: true},
+		{
+			name:       "template of a generator",
+			goTemplate: true,
+			template:   "{{ .name }}",
+			generator: v1alpha1.ApplicationSetGenerator{List: &v1alpha1.ListGenerator{Template: v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{Project: "{{ .app.status.sync.status }}"},
+			}}},
+			expected: true,
+		},
+		{name: "function registered by a plugin", goTemplate: true, template: "{{ teamOf .app.status }}", expected: true},
+		{name: "invalid template", goTemplate: true, template: "{{ .name", expected: true},
+		{name: "other parameters", goTemplate: true, template: "{{ .name }}-{{ .application }}-{{ .path.basename }}"},
+		{name: "fasttemplate", template: "{{app}}"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := &v1alpha1.ApplicationSet{
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: c.goTemplate,
+					Generators: []v1alpha1.ApplicationSetGenerator{c.generator},
+					Template: v1alpha1.ApplicationSetTemplate{
+						ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: c.template},
+					},
+				},
+			}
+			if c.patch != "" {
+				appSet.Spec.TemplatePatch = &c.patch
+			}
+			assert.Equal(t, c.expected, UsesAppParam(appSet))
+		})
+	}
+}
+
+func TestRenderApplications(t *testing.T) {
+	templatePatch := `spec:
+  destination:
+    namespace: {{ .namespace }}`
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate:        true,
+			GoTemplateOptions: []string{"missingkey=error"},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{ .cluster }}-guestbook",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Project: "default",
+					Destination: v1alpha1.ApplicationDestination{
+						Server:    "{{ .url }}",
+						Namespace: "guestbook",
+					},
+				},
+			},
+			TemplatePatch: &templatePatch,
+		},
+	}
+
+	t.Run("renders every parameter set", func(t *testing.T) {
+		apps, err := RenderApplications(t.Context(), nil, appSet, []map[string]any{
+			{"cluster": "dev", "url": "https://dev", "namespace": "dev-guestbook"},
+			{"cluster": "prod", "url": "https://prod", "namespace": "prod-guestbook"},
+		}, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 2)
+		assert.Equal(t, "dev-guestbook", apps[0].Name)
+		assert.Equal(t, "argocd", apps[0].Namespace)
+		assert.Equal(t, "https://dev", apps[0].Spec.Destination.Server)
+		assert.Equal(t, "dev-guestbook", apps[0].Spec.Destination.Namespace)
+		assert.Equal(t, "prod-guestbook", apps[1].Name)
+		assert.Equal(t, "prod-guestbook", apps[1].Spec.Destination.Namespace)
+	})
+
+	t.Run("returns an error for a parameter set that fails to render", func(t *testing.T) {
+		_, err := RenderApplications(t.Context(), nil, appSet, []map[string]any{
+			{"cluster": "dev", "url": "https://dev", "namespace": "dev-guestbook"},
+			{"cluster": "prod"},
+		}, &utils.Render{})
+		require.ErrorContains(t, err, "parameter set 1")
+	})
+}
+
+func TestRenderApplicationsRevisionHistoryLimit(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate:           true,
+			RevisionHistoryLimit: ptr.To(int64(5)),
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{ .cluster }}",
+				},
+			},
+		},
+	}
+
+	t.Run("defaults to the ApplicationSet revisionHistoryLimit", func(t *testing.T) {
+		apps, err := RenderApplications(t.Context(), nil, appSet, []map[string]any{{"cluster": "dev"}}, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 1)
+		assert.Equal(t, ptr.To(int64(5)), apps[0].Spec.RevisionHistoryLimit)
+	})
+
+	t.Run("template overrides the ApplicationSet revisionHistoryLimit per element", func(t *testing.T) {
+		appSet := appSet.DeepCopy()
+		appSet.Spec.TemplatePatch = ptr.To(`{{ if .limit }}
+spec:
+  revisionHistoryLimit: "{{ .limit }}"
+{{ end }}`)
+		apps, err := RenderApplications(t.Context(), nil, *appSet, []map[string]any{{"cluster": "dev", "limit": "2"}, {"cluster": "prod"}}, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 2)
+		assert.Equal(t, ptr.To(int64(2)), apps[0].Spec.RevisionHistoryLimit)
+		assert.Equal(t, ptr.To(int64(5)), apps[1].Spec.RevisionHistoryLimit)
+	})
+
+	t.Run("negative revisionHistoryLimit is rejected", func(t *testing.T) {
+		appSet := appSet.DeepCopy()
+		appSet.Spec.RevisionHistoryLimit = ptr.To(int64(-1))
+		_, err := RenderApplications(t.Context(), nil, *appSet, []map[string]any{{"cluster": "dev"}}, &utils.Render{})
+		require.ErrorContains(t, err, "must not be negative")
+	})
+}
+
+func TestRenderApplicationsManagedNamespace(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			ManagedNamespace: &v1alpha1.ApplicationSetManagedNamespace{
+				Metadata: &v1alpha1.ManagedNamespaceMetadata{
+					Labels: map[string]string{
+						"pod-security.kubernetes.io/enforce": "restricted",
+						"team":                               "{{ .team }}",
+					},
+				},
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{ .cluster }}",
+				},
+			},
+		},
+	}
+
+	t.Run("creates the namespace with the rendered metadata", func(t *testing.T) {
+		apps, err := RenderApplications(t.Context(), nil, appSet, []map[string]any{{"cluster": "dev", "team": "payments"}}, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 1)
+		require.NotNil(t, apps[0].Spec.SyncPolicy)
+		assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, apps[0].Spec.SyncPolicy.SyncOptions)
+		require.NotNil(t, apps[0].Spec.SyncPolicy.ManagedNamespaceMetadata)
+		assert.Equal(t, map[string]string{
+			"pod-security.kubernetes.io/enforce": "restricted",
+			"team":                               "payments",
+		}, apps[0].Spec.SyncPolicy.ManagedNamespaceMetadata.Labels)
+		assert.Nil(t, appSet.Spec.Template.Spec.SyncPolicy)
+	})
+
+	t.Run("template configuration takes precedence", func(t *testing.T) {
+		appSet := appSet.DeepCopy()
+		appSet.Spec.Template.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
+			SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=false"},
+			ManagedNamespaceMetadata: &v1alpha1.ManagedNamespaceMetadata{
+				Labels: map[string]string{"team": "platform"},
+			},
+		}
+		apps, err := RenderApplications(t.Context(), nil, *appSet, []map[string]any{{"cluster": "dev", "team": "payments"}}, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 1)
+		assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=false"}, apps[0].Spec.SyncPolicy.SyncOptions)
+		assert.Equal(t, map[string]string{"team": "platform"}, apps[0].Spec.SyncPolicy.ManagedNamespaceMetadata.Labels)
+	})
+}
+
+func TestGenerateApplicationsManagedNamespace(t *testing.T) {
+	generator := v1alpha1.ApplicationSetGenerator{
+		List: &v1alpha1.ListGenerator{},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			ManagedNamespace: &v1alpha1.ApplicationSetManagedNamespace{
+				Metadata: &v1alpha1.ManagedNamespaceMetadata{
+					Labels: map[string]string{"team": "{{ .team }}"},
+				},
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{ .cluster }}",
+				},
+			},
+		},
+	}
+	generatorMock := genmock.Generator{}
+	generatorMock.On("GenerateParams", &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{{"cluster": "dev", "team": "payments"}}, nil)
+	generatorMock.On("GetTemplate", &generator).
+		Return(&v1alpha1.ApplicationSetTemplate{})
+
+	apps, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet,
+		map[string]generators.Generator{"List": &generatorMock}, nil, &utils.Render{}, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	require.NotNil(t, apps[0].Spec.SyncPolicy)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, apps[0].Spec.SyncPolicy.SyncOptions)
+	require.NotNil(t, apps[0].Spec.SyncPolicy.ManagedNamespaceMetadata)
+	assert.Equal(t, map[string]string{"team": "payments"}, apps[0].Spec.SyncPolicy.ManagedNamespaceMetadata.Labels)
+}
+
+func TestRenderApplicationsIndexAndCount(t *testing.T) {
+	params := []map[string]any{{"cluster": "dev"}, {"cluster": "staging"}}
+
+	t.Run("go template", func(t *testing.T) {
+		appSet := v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				GoTemplate: true,
+				Template: v1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+						Name:   "{{ .cluster }}-{{ .index }}",
+						Labels: map[string]string{"canary": "{{ if eq .index 0 }}true{{ else }}false{{ end }}", "count": "{{ .count }}"},
+					},
+				},
+			},
+		}
+		apps, err := RenderApplications(t.Context(), nil, appSet, params, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 2)
+		assert.Equal(t, "dev-0", apps[0].Name)
+		assert.Equal(t, map[string]string{"canary": "true", "count": "2"}, apps[0].Labels)
+		assert.Equal(t, "staging-1", apps[1].Name)
+		assert.Equal(t, map[string]string{"canary": "false", "count": "2"}, apps[1].Labels)
+	})
+
+	t.Run("fasttemplate", func(t *testing.T) {
+		appSet := v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Template: v1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+						Name: "{{cluster}}-{{index}}-of-{{count}}",
+					},
+				},
+			},
+		}
+		apps, err := RenderApplications(t.Context(), nil, appSet, params, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 2)
+		assert.Equal(t, "dev-0-of-2", apps[0].Name)
+		assert.Equal(t, "staging-1-of-2", apps[1].Name)
+	})
+
+	t.Run("parameters set by the generator take precedence", func(t *testing.T) {
+		appSet := v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Template: v1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+						Name: "{{cluster}}-{{index}}-of-{{count}}",
+					},
+				},
+			},
+		}
+		apps, err := RenderApplications(t.Context(), nil, appSet, []map[string]any{{"cluster": "prod", "index": "last"}}, &utils.Render{})
+		require.NoError(t, err)
+		require.Len(t, apps, 1)
+		assert.Equal(t, "prod-last-of-1", apps[0].Name)
+	})
+}
@@ -0,0 +1,76 @@
+package enrichers
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Config is the configuration of the HTTP enrichers run by the ApplicationSet controller.
+type Config struct {
+	// Enrichers is the list of enrichers, run in the order they are listed.
+	Enrichers []EnricherConfig `json:"enrichers"`
+}
+
+// EnricherConfig is the configuration of an HTTP enricher.
+type EnricherConfig struct {
+	// Name identifies the enricher in logs and errors.
+	Name string `json:"name"`
+	// BaseURL is the URL of the enricher service.
+	BaseURL string `json:"baseUrl"`
+	// Token optionally references the key of a Secret holding the bearer token sent to the enricher service, e.g.
+	// `$enricher.token` for a key of argocd-secret or `$my-secret:enricher.token` for a key of another Secret.
+	Token string `json:"token,omitempty"`
+	// RequestTimeout is the timeout of the requests to the enricher service, in seconds. Defaults to 30.
+	RequestTimeout int `json:"requestTimeout,omitempty"`
+	// FailurePolicy is the behaviour when the enricher fails. One of Fail (default) or Ignore.
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// LoadConfig reads the enrichers configuration from the given YAML or JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading enrichers configuration: %w", err)
+	}
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing enrichers configuration: %w", err)
+	}
+	return &config, nil
+}
+
+// NewChain returns a Chain of HTTP enrichers from the given configuration. Secrets referenced by the enrichers are
+// looked up in the given namespace, with the given strict mode for the secret stores.
+func NewChain(config *Config, c client.Client, namespace string, tokenRefStrictMode bool) (Chain, error) {
+	chain := make(Chain, 0, len(config.Enrichers))
+	names := map[string]bool{}
+	for _, e := range config.Enrichers {
+		if e.Name == "" {
+			return nil, fmt.Errorf("enricher with base URL %q has no name", e.BaseURL)
+		}
+		if names[e.Name] {
+			return nil, fmt.Errorf("duplicate enricher %s", e.Name)
+		}
+		names[e.Name] = true
+		if e.BaseURL == "" {
+			return nil, fmt.Errorf("enricher %s has no base URL", e.Name)
+		}
+		failurePolicy := e.FailurePolicy
+		switch failurePolicy {
+		case "":
+			failurePolicy = FailurePolicyFail
+		case FailurePolicyFail, FailurePolicyIgnore:
+		default:
+			return nil, fmt.Errorf("enricher %s has an invalid failure policy %q: must be one of %s, %s", e.Name, failurePolicy, FailurePolicyFail, FailurePolicyIgnore)
+		}
+		chain = append(chain, Step{
+			Name:          e.Name,
+			Enricher:      NewHTTPEnricher(c, namespace, e.BaseURL, e.Token, e.RequestTimeout, tokenRefStrictMode),
+			FailurePolicy: failurePolicy,
+		})
+	}
+	return chain, nil
+}
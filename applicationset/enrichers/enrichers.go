@@ -0,0 +1,66 @@
+package enrichers
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Enricher enriches the parameter sets produced by a generator of an ApplicationSet, before the template of the
+// ApplicationSet is rendered against them.
+type Enricher interface {
+	// Enrich receives all the parameter sets produced by a generator and returns the enriched parameter sets, in the
+	// same order. The given parameter sets must not be modified.
+	Enrich(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error)
+}
+
+// EnricherFunc is an adapter allowing the use of an ordinary function as an Enricher.
+type EnricherFunc func(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error)
+
+func (f EnricherFunc) Enrich(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+	return f(ctx, appSet, params)
+}
+
+// FailurePolicy defines what happens to the generation of Applications when an enricher fails.
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail fails the generation of the Applications of the ApplicationSet.
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore logs the error and renders the template against the parameter sets as they were before the
+	// enricher ran.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// Step is an enricher of a Chain.
+type Step struct {
+	Name          string
+	Enricher      Enricher
+	FailurePolicy FailurePolicy
+}
+
+// Chain is an Enricher running its steps in order, each step receiving the parameter sets returned by the previous
+// one.
+type Chain []Step
+
+func (c Chain) Enrich(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+	for _, step := range c {
+		enriched, err := step.Enricher.Enrich(ctx, appSet, params)
+		if err == nil && len(enriched) != len(params) {
+			err = fmt.Errorf("expected %d parameter sets, got %d", len(params), len(enriched))
+		}
+		if err != nil {
+			if step.FailurePolicy == FailurePolicyIgnore {
+				log.WithField("applicationset", appSet.Name).WithField("enricher", step.Name).WithError(err).
+					Warn("error enriching parameters, ignoring")
+				continue
+			}
+			return nil, fmt.Errorf("error enriching parameters with enricher %s: %w", step.Name, err)
+		}
+		params = enriched
+	}
+	return params, nil
+}
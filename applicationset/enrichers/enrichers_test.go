@@ -0,0 +1,162 @@
+package enrichers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/enricher"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/secret_store"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestChain(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset"}}
+	params := []map[string]any{{"name": "app1"}, {"name": "app2"}}
+	drop := EnricherFunc(func(_ context.Context, _ *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+		return params[1:], nil
+	})
+
+	t.Run("rejects a different number of parameter sets", func(t *testing.T) {
+		_, err := Chain{{Name: "drop", Enricher: drop, FailurePolicy: FailurePolicyFail}}.Enrich(t.Context(), appSet, params)
+		require.EqualError(t, err, "error enriching parameters with enricher drop: expected 2 parameter sets, got 1")
+	})
+
+	t.Run("ignores a different number of parameter sets", func(t *testing.T) {
+		res, err := Chain{{Name: "drop", Enricher: drop, FailurePolicy: FailurePolicyIgnore}}.Enrich(t.Context(), appSet, params)
+		require.NoError(t, err)
+		assert.Equal(t, params, res)
+	})
+}
+
+func TestNewChain(t *testing.T) {
+	token := "0bc57212c3cbbec69d20b34c507284bd300def5b"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var request enricher.ServiceRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		for _, params := range request.Parameters {
+			params["costCenter"] = "cc-" + request.ApplicationSetName
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(enricher.ServiceResponse{Parameters: request.Parameters}))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "enrichers.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`enrichers:
+- name: cost-center
+  baseUrl: `+ts.URL+`
+  token: $enricher.token
+- name: unavailable
+  baseUrl: http://127.0.0.1:1
+  failurePolicy: Ignore
+`), 0o600))
+
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"enricher.token": []byte(token)},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	chain, err := NewChain(config, c, "argocd", false)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, FailurePolicyFail, chain[0].FailurePolicy)
+	assert.Equal(t, FailurePolicyIgnore, chain[1].FailurePolicy)
+
+	res, err := chain.Enrich(t.Context(), &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset"}}, []map[string]any{{"name": "app1"}})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "app1", "costCenter": "cc-appset"}}, res)
+}
+
+type fakeSecretStoreBackend map[string]map[string]string
+
+func (b fakeSecretStoreBackend) GetSecret(_ context.Context, path string) (map[string]string, error) {
+	values, ok := b[path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return values, nil
+}
+
+func TestHTTPEnricherSecretStoreToken(t *testing.T) {
+	defaultStores := secret_store.Default
+	t.Cleanup(func() { secret_store.Default = defaultStores })
+	secret_store.Default = secret_store.New(map[string]secret_store.Store{
+		"vault": {Backend: fakeSecretStoreBackend{"enrichers/cost-center": {"token": "store-token"}}, Namespaces: []string{"argocd"}},
+	}, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer store-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var request enricher.ServiceRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.NoError(t, json.NewEncoder(w).Encode(enricher.ServiceResponse{Parameters: request.Parameters}))
+	}))
+	defer ts.Close()
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset"}}
+	params := []map[string]any{{"name": "app1"}}
+
+	res, err := NewHTTPEnricher(nil, "argocd", ts.URL, "secretstore://vault/enrichers/cost-center#token", 0, false).Enrich(t.Context(), appSet, params)
+	require.NoError(t, err)
+	assert.Equal(t, params, res)
+
+	// The stores with no path prefix cannot be read in strict mode
+	_, err = NewHTTPEnricher(nil, "argocd", ts.URL, "secretstore://vault/enrichers/cost-center#token", 0, true).Enrich(t.Context(), appSet, params)
+	require.ErrorContains(t, err, "the store has no path prefix, which is required in strict mode")
+}
+
+func TestNewChainInvalidConfig(t *testing.T) {
+	for _, c := range []struct {
+		name          string
+		config        Config
+		expectedError string
+	}{
+		{
+			name:          "missing name",
+			config:        Config{Enrichers: []EnricherConfig{{BaseURL: "http://enricher"}}},
+			expectedError: `enricher with base URL "http://enricher" has no name`,
+		},
+		{
+			name:          "duplicate name",
+			config:        Config{Enrichers: []EnricherConfig{{Name: "a", BaseURL: "http://a"}, {Name: "a", BaseURL: "http://b"}}},
+			expectedError: "duplicate enricher a",
+		},
+		{
+			name:          "missing base URL",
+			config:        Config{Enrichers: []EnricherConfig{{Name: "a"}}},
+			expectedError: "enricher a has no base URL",
+		},
+		{
+			name:          "invalid failure policy",
+			config:        Config{Enrichers: []EnricherConfig{{Name: "a", BaseURL: "http://a", FailurePolicy: "Retry"}}},
+			expectedError: `enricher a has an invalid failure policy "Retry": must be one of Fail, Ignore`,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := NewChain(&c.config, nil, "argocd", false)
+			require.EqualError(t, err, c.expectedError)
+		})
+	}
+}
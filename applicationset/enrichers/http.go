@@ -0,0 +1,64 @@
+package enrichers
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/enricher"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Enricher = (*HTTPEnricher)(nil)
+
+// HTTPEnricher is an Enricher delegating the enrichment of the parameter sets to an HTTP service.
+type HTTPEnricher struct {
+	client             client.Client
+	namespace          string
+	baseURL            string
+	tokenRef           string
+	requestTimeout     int
+	tokenRefStrictMode bool
+}
+
+// NewHTTPEnricher returns an Enricher sending the parameter sets to the service at the given URL. The token, if any,
+// references a key of a Secret of the given namespace or a secret of a secret store, in the same format as the token
+// of the plugin generator, and is looked up with the given strict mode.
+func NewHTTPEnricher(c client.Client, namespace string, baseURL string, tokenRef string, requestTimeout int, tokenRefStrictMode bool) *HTTPEnricher {
+	return &HTTPEnricher{
+		client:             c,
+		namespace:          namespace,
+		baseURL:            baseURL,
+		tokenRef:           tokenRef,
+		requestTimeout:     requestTimeout,
+		tokenRefStrictMode: tokenRefStrictMode,
+	}
+}
+
+func (e *HTTPEnricher) Enrich(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+	var token string
+	if e.tokenRef != "" {
+		var err error
+		token, err = plugin.GetToken(ctx, e.client, e.namespace, e.tokenRef, e.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret token: %w", err)
+		}
+	}
+
+	service, err := enricher.NewEnricherService(e.baseURL, token, e.requestTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := service.Enrich(ctx, enricher.ServiceRequest{
+		ApplicationSetName:      appSet.Name,
+		ApplicationSetNamespace: appSet.Namespace,
+		Parameters:              params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Parameters, nil
+}
@@ -0,0 +1,158 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/aws_organizations"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*AWSAccountsGenerator)(nil)
+
+const (
+	DefaultAWSAccountsRequeueAfter = 30 * time.Minute
+)
+
+// AWSAccountsConfig is what the administrator of the controller allows the AWS Accounts generators to use. The zero
+// value only allows the accounts to be listed with the access keys of the generators.
+type AWSAccountsConfig struct {
+	// AllowPodCredentials allows the accounts to be listed with the identity of the pod, such as IRSA, including
+	// assuming the AWS role of a generator with it.
+	AllowPodCredentials bool
+}
+
+// AWSAccountsGenerator generates a parameter set for each account of an AWS Organization.
+type AWSAccountsGenerator struct {
+	client             client.Client
+	tokenRefStrictMode bool
+	config             AWSAccountsConfig
+	newServiceFunc     func(context.Context, *argoprojiov1alpha1.AWSAccountsGenerator, *argoprojiov1alpha1.ApplicationSet) (aws_organizations.Service, error)
+}
+
+func NewAWSAccountsGenerator(client client.Client, tokenRefStrictMode bool, config AWSAccountsConfig) Generator {
+	g := &AWSAccountsGenerator{
+		client:             client,
+		tokenRefStrictMode: tokenRefStrictMode,
+		config:             config,
+	}
+	g.newServiceFunc = g.newService
+	return g
+}
+
+func (g *AWSAccountsGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.AWSAccounts.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.AWSAccounts.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultAWSAccountsRequeueAfter
+}
+
+func (g *AWSAccountsGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.AWSAccounts.Template
+}
+
+func (g *AWSAccountsGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.AWSAccounts == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.AWSAccounts
+
+	ctx := context.Background()
+	service, err := g.newServiceFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS Organizations client: %w", err)
+	}
+
+	accounts, err := service.ListAccounts(ctx, generatorConfig.OrganizationalUnits, generatorConfig.Recursive)
+	if err != nil {
+		return nil, fmt.Errorf("error listing AWS accounts: %w", err)
+	}
+
+	params := make([]map[string]any, 0, len(accounts))
+	for _, account := range accounts {
+		if !generatorConfig.IncludeInactive && account.Status != organizations.AccountStatusActive {
+			continue
+		}
+		if !matchesAWSTagFilters(account.Tags, generatorConfig.TagFilters) {
+			continue
+		}
+
+		paramMap := map[string]any{
+			"accountId":          account.ID,
+			"accountName":        account.Name,
+			"accountEmail":       account.Email,
+			"accountArn":         account.ARN,
+			"status":             account.Status,
+			"organizationalUnit": account.OrganizationalUnit,
+		}
+		if applicationSetInfo.Spec.GoTemplate {
+			paramMap["tags"] = account.Tags
+		} else {
+			for key, value := range account.Tags {
+				paramMap["tags."+key] = value
+			}
+		}
+
+		err := appendTemplatedValues(generatorConfig.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		params = append(params, paramMap)
+	}
+	return params, nil
+}
+
+// matchesAWSTagFilters returns whether the tags match the filters. The filters of different keys must all match, while
+// one of the filters of the same key must match. A filter without value matches any value of its key.
+func matchesAWSTagFilters(tags map[string]string, tagFilters []*argoprojiov1alpha1.TagFilter) bool {
+	matches := map[string]bool{}
+	for _, tagFilter := range tagFilters {
+		value, ok := tags[tagFilter.Key]
+		matches[tagFilter.Key] = matches[tagFilter.Key] || (ok && (tagFilter.Value == "" || tagFilter.Value == value))
+	}
+	for _, match := range matches {
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// newService returns the AWS Organizations service of the generator, authenticated with the identity of the pod or
+// with the access key of the generator. The identity of the pod is only used when the controller allows it.
+func (g *AWSAccountsGenerator) newService(ctx context.Context, generatorConfig *argoprojiov1alpha1.AWSAccountsGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (aws_organizations.Service, error) {
+	if (generatorConfig.AccessKeyIDRef == nil) != (generatorConfig.SecretAccessKeyRef == nil) {
+		return nil, errors.New("accessKeyIDRef and secretAccessKeyRef must be set together")
+	}
+	accessKeyID, err := utils.GetSecretRef(ctx, g.client, generatorConfig.AccessKeyIDRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Secret access key ID: %w", err)
+	}
+	secretAccessKey, err := utils.GetSecretRef(ctx, g.client, generatorConfig.SecretAccessKeyRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Secret secret access key: %w", err)
+	}
+	if accessKeyID == "" && !g.config.AllowPodCredentials {
+		return nil, errors.New("accessKeyIDRef is required: listing the accounts, or assuming the role, with the identity of the ApplicationSet controller is not allowed")
+	}
+
+	return aws_organizations.NewAWSOrganizationsService(generatorConfig.Region, generatorConfig.Role, aws_organizations.Credentials{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+	})
+}
@@ -0,0 +1,224 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/aws_organizations"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeAWSOrganizationsService struct {
+	accounts []*aws_organizations.Account
+	err      error
+}
+
+func (s *fakeAWSOrganizationsService) ListAccounts(_ context.Context, _ []string, _ bool) ([]*aws_organizations.Account, error) {
+	return s.accounts, s.err
+}
+
+func TestAWSAccountsGenerateParams(t *testing.T) {
+	accounts := []*aws_organizations.Account{
+		{
+			ID:                 "111111111111",
+			Name:               "payments-prod",
+			Email:              "payments-prod@example.com",
+			ARN:                "arn:aws:organizations::000000000000:account/o-example/111111111111",
+			Status:             "ACTIVE",
+			OrganizationalUnit: "ou-prod",
+			Tags:               map[string]string{"env": "prod", "team": "payments"},
+		},
+		{
+			ID:                 "222222222222",
+			Name:               "payments-staging",
+			Email:              "payments-staging@example.com",
+			ARN:                "arn:aws:organizations::000000000000:account/o-example/222222222222",
+			Status:             "ACTIVE",
+			OrganizationalUnit: "ou-staging",
+			Tags:               map[string]string{"env": "staging", "team": "payments"},
+		},
+		{
+			ID:                 "333333333333",
+			Name:               "legacy",
+			Email:              "legacy@example.com",
+			ARN:                "arn:aws:organizations::000000000000:account/o-example/333333333333",
+			Status:             "SUSPENDED",
+			OrganizationalUnit: "ou-prod",
+			Tags:               map[string]string{"env": "prod"},
+		},
+	}
+	prodParams := map[string]any{
+		"accountId":          "111111111111",
+		"accountName":        "payments-prod",
+		"accountEmail":       "payments-prod@example.com",
+		"accountArn":         "arn:aws:organizations::000000000000:account/o-example/111111111111",
+		"status":             "ACTIVE",
+		"organizationalUnit": "ou-prod",
+		"tags.env":           "prod",
+		"tags.team":          "payments",
+	}
+
+	cases := []struct {
+		name        string
+		generator   *argoprojiov1alpha1.AWSAccountsGenerator
+		goTemplate  bool
+		serviceErr  error
+		expectedIDs []string
+		expected    []map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "active accounts",
+			generator:   &argoprojiov1alpha1.AWSAccountsGenerator{},
+			expectedIDs: []string{"111111111111", "222222222222"},
+		},
+		{
+			name: "inactive accounts",
+			generator: &argoprojiov1alpha1.AWSAccountsGenerator{
+				IncludeInactive: true,
+			},
+			expectedIDs: []string{"111111111111", "222222222222", "333333333333"},
+		},
+		{
+			name: "tag filters of the same key",
+			generator: &argoprojiov1alpha1.AWSAccountsGenerator{
+				TagFilters: []*argoprojiov1alpha1.TagFilter{{Key: "env", Value: "prod"}, {Key: "env", Value: "staging"}},
+			},
+			expectedIDs: []string{"111111111111", "222222222222"},
+		},
+		{
+			name: "tag filters of different keys",
+			generator: &argoprojiov1alpha1.AWSAccountsGenerator{
+				IncludeInactive: true,
+				TagFilters:      []*argoprojiov1alpha1.TagFilter{{Key: "env", Value: "prod"}, {Key: "team"}},
+			},
+			expectedIDs: []string{"111111111111"},
+		},
+		{
+			name: "flat params",
+			generator: &argoprojiov1alpha1.AWSAccountsGenerator{
+				TagFilters: []*argoprojiov1alpha1.TagFilter{{Key: "env", Value: "prod"}},
+				Values: map[string]string{
+					"cluster": "{{accountName}}",
+				},
+			},
+			expected: []map[string]any{
+				func() map[string]any {
+					params := map[string]any{"values.cluster": "payments-prod"}
+					for k, v := range prodParams {
+						params[k] = v
+					}
+					return params
+				}(),
+			},
+		},
+		{
+			name: "go template params",
+			generator: &argoprojiov1alpha1.AWSAccountsGenerator{
+				TagFilters: []*argoprojiov1alpha1.TagFilter{{Key: "env", Value: "prod"}},
+				Values: map[string]string{
+					"cluster": "{{ .tags.team }}-{{ .accountId }}",
+				},
+			},
+			goTemplate: true,
+			expected: []map[string]any{
+				{
+					"accountId":          "111111111111",
+					"accountName":        "payments-prod",
+					"accountEmail":       "payments-prod@example.com",
+					"accountArn":         "arn:aws:organizations::000000000000:account/o-example/111111111111",
+					"status":             "ACTIVE",
+					"organizationalUnit": "ou-prod",
+					"tags":               map[string]string{"env": "prod", "team": "payments"},
+					"values":             map[string]string{"cluster": "payments-111111111111"},
+				},
+			},
+		},
+		{
+			name:        "service error",
+			generator:   &argoprojiov1alpha1.AWSAccountsGenerator{},
+			serviceErr:  errors.New("AccessDeniedException"),
+			expectedErr: "error listing AWS accounts: AccessDeniedException",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &AWSAccountsGenerator{
+				newServiceFunc: func(context.Context, *argoprojiov1alpha1.AWSAccountsGenerator, *argoprojiov1alpha1.ApplicationSet) (aws_organizations.Service, error) {
+					return &fakeAWSOrganizationsService{accounts: accounts, err: c.serviceErr}, nil
+				},
+			}
+			generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+				AWSAccounts: c.generator,
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate},
+			}
+
+			got, err := gen.GenerateParams(&generatorConfig, appSet, nil)
+			if c.expectedErr != "" {
+				require.EqualError(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if c.expected != nil {
+				assert.Equal(t, c.expected, got)
+				return
+			}
+			ids := make([]string, 0, len(got))
+			for _, params := range got {
+				ids = append(ids, params["accountId"].(string))
+			}
+			assert.Equal(t, c.expectedIDs, ids)
+		})
+	}
+}
+
+func TestAWSAccountsNewServiceSecretRefs(t *testing.T) {
+	gen := &AWSAccountsGenerator{}
+
+	_, err := gen.newService(t.Context(), &argoprojiov1alpha1.AWSAccountsGenerator{
+		AccessKeyIDRef: &argoprojiov1alpha1.SecretRef{SecretName: "aws-creds", Key: "access-key-id"},
+	}, &argoprojiov1alpha1.ApplicationSet{})
+	require.EqualError(t, err, "accessKeyIDRef and secretAccessKeyRef must be set together")
+}
+
+func TestAWSAccountsNewServicePodCredentials(t *testing.T) {
+	generatorConfig := &argoprojiov1alpha1.AWSAccountsGenerator{
+		Role:   "arn:aws:iam::111111111111:role/argocd-organizations-read-only",
+		Region: "us-east-1",
+	}
+
+	t.Run("not allowed", func(t *testing.T) {
+		gen := &AWSAccountsGenerator{}
+
+		_, err := gen.newService(t.Context(), generatorConfig, &argoprojiov1alpha1.ApplicationSet{})
+		require.EqualError(t, err, "accessKeyIDRef is required: listing the accounts, or assuming the role, with the identity of the ApplicationSet controller is not allowed")
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		gen := &AWSAccountsGenerator{config: AWSAccountsConfig{AllowPodCredentials: true}}
+
+		_, err := gen.newService(t.Context(), generatorConfig, &argoprojiov1alpha1.ApplicationSet{})
+		require.NoError(t, err)
+	})
+}
+
+func TestAWSAccountsGetRequeueAfter(t *testing.T) {
+	gen := NewAWSAccountsGenerator(nil, false, AWSAccountsConfig{})
+
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		AWSAccounts: &argoprojiov1alpha1.AWSAccountsGenerator{},
+	}
+	assert.Equal(t, DefaultAWSAccountsRequeueAfter, gen.GetRequeueAfter(&generatorConfig))
+
+	requeueAfterSeconds := int64(60)
+	generatorConfig.AWSAccounts.RequeueAfterSeconds = &requeueAfterSeconds
+	assert.Equal(t, time.Minute, gen.GetRequeueAfter(&generatorConfig))
+}
@@ -0,0 +1,80 @@
+package generators
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsetcache "github.com/argoproj/argo-cd/v3/applicationset/cache"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*CachedGenerator)(nil)
+
+// CachedGenerator persists the parameters produced by the wrapped generator in the shared cache, so that restarts and
+// failovers of the ApplicationSet controller do not refetch them from the SCM providers and Git repositories. The
+// cache is bypassed, and updated, when a refresh of the ApplicationSet is requested, e.g. by a webhook.
+type CachedGenerator struct {
+	Generator
+	cache *appsetcache.Cache
+}
+
+func NewCachedGenerator(generator Generator, cache *appsetcache.Cache) Generator {
+	return &CachedGenerator{
+		Generator: generator,
+		cache:     cache,
+	}
+}
+
+func (g *CachedGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	logCtx := log.WithField("applicationset", appSet.Name).WithField("namespace", appSet.Namespace)
+
+	hash, err := generatorHash(appSetGenerator)
+	if err != nil {
+		logCtx.WithError(err).Warn("error computing generator hash, not using the generator cache")
+		return g.Generator.GenerateParams(appSetGenerator, appSet, client)
+	}
+
+	if !appSet.RefreshRequired() {
+		params, err := g.cache.GetGeneratorParams(appSet, hash)
+		if err == nil {
+			logCtx.Debugf("using cached params for generator %s", hash)
+			return params, nil
+		}
+		if !errors.Is(err, appsetcache.ErrCacheMiss) {
+			logCtx.WithError(err).Warn("error getting generator params from cache")
+		}
+	}
+
+	params, err := g.Generator.GenerateParams(appSetGenerator, appSet, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.cache.SetGeneratorParams(appSet, hash, params); err != nil {
+		logCtx.WithError(err).Warn("error setting generator params in cache")
+	}
+	return params, nil
+}
+
+// HandlePullRequestEvent forwards the pull request events to the cached generator, if it handles them.
+func (g *CachedGenerator) HandlePullRequestEvent(event *PullRequestEvent) {
+	if handler, ok := g.Generator.(PullRequestEventHandler); ok {
+		handler.HandlePullRequestEvent(event)
+	}
+}
+
+// generatorHash identifies the generator, once interpolated, within its ApplicationSet.
+func generatorHash(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) (string, error) {
+	data, err := json.Marshal(appSetGenerator)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling generator: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
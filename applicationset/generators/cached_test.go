@@ -0,0 +1,91 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsetcache "github.com/argoproj/argo-cd/v3/applicationset/cache"
+	genmock "github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
+	"github.com/argoproj/argo-cd/v3/common"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+func TestCachedGenerator(t *testing.T) {
+	newAppSet := func() *argoprojiov1alpha1.ApplicationSet {
+		return &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset", UID: "uid", Generation: 1}}
+	}
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		Git: &argoprojiov1alpha1.GitGenerator{RepoURL: "https://github.com/argoproj/argocd-example-apps", Revision: "HEAD"},
+	}
+	otherAppSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		Git: &argoprojiov1alpha1.GitGenerator{RepoURL: "https://github.com/argoproj/argocd-example-apps", Revision: "main"},
+	}
+
+	t.Run("caches the params of the generator", func(t *testing.T) {
+		generatorMock := &genmock.Generator{}
+		generatorMock.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return([]map[string]any{{"path": "guestbook"}}, nil)
+		generatorMock.On("GenerateParams", otherAppSetGenerator, mock.Anything, mock.Anything).Return([]map[string]any{{"path": "helm-guestbook"}}, nil)
+		generator := NewCachedGenerator(generatorMock, appsetcache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute))
+
+		for range 2 {
+			params, err := generator.GenerateParams(appSetGenerator, newAppSet(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, []map[string]any{{"path": "guestbook"}}, params)
+		}
+		generatorMock.AssertNumberOfCalls(t, "GenerateParams", 1)
+
+		params, err := generator.GenerateParams(otherAppSetGenerator, newAppSet(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"path": "helm-guestbook"}}, params)
+		generatorMock.AssertNumberOfCalls(t, "GenerateParams", 2)
+
+		// A change of the ApplicationSet spec invalidates the cache.
+		appSet := newAppSet()
+		appSet.Generation = 2
+		_, err = generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		generatorMock.AssertNumberOfCalls(t, "GenerateParams", 3)
+	})
+
+	t.Run("refresh bypasses and updates the cache", func(t *testing.T) {
+		generatorMock := &genmock.Generator{}
+		generatorMock.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return([]map[string]any{{"path": "guestbook"}}, nil).Once()
+		generatorMock.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return([]map[string]any{{"path": "kustomize-guestbook"}}, nil).Once()
+		generator := NewCachedGenerator(generatorMock, appsetcache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute))
+
+		_, err := generator.GenerateParams(appSetGenerator, newAppSet(), nil)
+		require.NoError(t, err)
+
+		appSet := newAppSet()
+		appSet.Annotations = map[string]string{common.AnnotationApplicationSetRefresh: "true"}
+		params, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"path": "kustomize-guestbook"}}, params)
+
+		params, err = generator.GenerateParams(appSetGenerator, newAppSet(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"path": "kustomize-guestbook"}}, params)
+		generatorMock.AssertNumberOfCalls(t, "GenerateParams", 2)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		generatorMock := &genmock.Generator{}
+		generatorMock.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return(nil, errors.New("rate limited")).Once()
+		generatorMock.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return([]map[string]any{{"path": "guestbook"}}, nil).Once()
+		generator := NewCachedGenerator(generatorMock, appsetcache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute))
+
+		_, err := generator.GenerateParams(appSetGenerator, newAppSet(), nil)
+		require.EqualError(t, err, "rate limited")
+
+		params, err := generator.GenerateParams(appSetGenerator, newAppSet(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"path": "guestbook"}}, params)
+	})
+}
@@ -2,7 +2,10 @@ package generators
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -12,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
@@ -29,26 +33,40 @@ type ClusterGenerator struct {
 	// namespace is the Argo CD namespace
 	namespace       string
 	settingsManager *settings.SettingsManager
+	// secretTypeIndexed is true when the client is backed by a cache which indexes the secrets by type, see
+	// utils.SecretTypeIndexField.
+	secretTypeIndexed bool
+	// strict is true when a malformed cluster secret fails the generation instead of being skipped
+	strict bool
+	// recorder, if set, records a warning event on the ApplicationSet for each skipped cluster secret
+	recorder record.EventRecorder
 }
 
 var render = &utils.Render{}
 
-func NewClusterGenerator(ctx context.Context, c client.Client, clientset kubernetes.Interface, namespace string) Generator {
+func NewClusterGenerator(ctx context.Context, c client.Client, clientset kubernetes.Interface, namespace string, secretTypeIndexed bool, strict bool, recorder record.EventRecorder) Generator {
 	settingsManager := settings.NewSettingsManager(ctx, clientset, namespace)
 
 	g := &ClusterGenerator{
-		Client:          c,
-		ctx:             ctx,
-		clientset:       clientset,
-		namespace:       namespace,
-		settingsManager: settingsManager,
+		Client:            c,
+		ctx:               ctx,
+		clientset:         clientset,
+		namespace:         namespace,
+		settingsManager:   settingsManager,
+		secretTypeIndexed: secretTypeIndexed,
+		strict:            strict,
+		recorder:          recorder,
 	}
 	return g
 }
 
-// GetRequeueAfter never requeue the cluster generator because the `clusterSecretEventHandler` will requeue the appsets
-// when the cluster secrets change
-func (g *ClusterGenerator) GetRequeueAfter(_ *argoappsetv1alpha1.ApplicationSetGenerator) time.Duration {
+// GetRequeueAfter returns the requeueAfterSeconds of the generator if set. Otherwise, the cluster generator is never
+// requeued because the `clusterSecretEventHandler` will requeue the appsets when the cluster secrets change
+func (g *ClusterGenerator) GetRequeueAfter(appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.Clusters.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.Clusters.RequeueAfterSeconds) * time.Second
+	}
+
 	return NoRequeueAfter
 }
 
@@ -70,59 +88,59 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 	// - Since local clusters do not have secrets, they do not have labels to match against
 	ignoreLocalClusters := len(appSetGenerator.Clusters.Selector.MatchExpressions) > 0 || len(appSetGenerator.Clusters.Selector.MatchLabels) > 0
 
-	// ListCluster will include the local cluster in the list of clusters
-	clustersFromArgoCD, err := utils.ListClusters(g.ctx, g.clientset, g.namespace)
-	if err != nil {
-		return nil, fmt.Errorf("error listing clusters: %w", err)
-	}
-
-	if clustersFromArgoCD == nil {
-		return nil, nil
-	}
-
-	clusterSecrets, err := g.getSecretsByClusterName(logCtx, appSetGenerator)
+	clusterSecrets, err := g.getClusterSecrets(logCtx, appSetGenerator)
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster secrets: %w", err)
 	}
 
 	res := []map[string]any{}
 
-	secretsFound := []corev1.Secret{}
-
 	isFlatMode := appSetGenerator.Clusters.FlatList
 	logCtx.Debugf("Using flat mode = %t for cluster generator", isFlatMode)
 	clustersParams := make([]map[string]any, 0)
 
-	for _, cluster := range clustersFromArgoCD {
-		// If there is a secret for this cluster, then it's a non-local cluster, so it will be
-		// handled by the next step.
-		if secretForCluster, exists := clusterSecrets[cluster.Name]; exists {
-			secretsFound = append(secretsFound, secretForCluster)
-		} else if !ignoreLocalClusters {
-			// If there is no secret for the cluster, it's the local cluster, so handle it here.
-			params := map[string]any{}
-			params["name"] = cluster.Name
-			params["nameNormalized"] = cluster.Name
-			params["server"] = cluster.Server
-			params["project"] = ""
-
-			err = appendTemplatedValues(appSetGenerator.Clusters.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
-			if err != nil {
-				return nil, fmt.Errorf("error appending templated values for local cluster: %w", err)
-			}
+	// The local cluster is the only cluster which may not have a secret. When there is no selector, every cluster
+	// secret matches, so the local cluster has to be added unless one of the secrets already describes it.
+	if !ignoreLocalClusters && !hasLocalClusterSecret(clusterSecrets) {
+		params := map[string]any{}
+		params["name"] = "in-cluster"
+		params["nameNormalized"] = "in-cluster"
+		params["server"] = argoappsetv1alpha1.KubernetesInternalAPIServerAddr
+		params["project"] = ""
+		if appSet.Spec.GoTemplate {
+			params["metadata"] = clusterMetadataParam(nil, nil)
+		}
 
-			if isFlatMode {
-				clustersParams = append(clustersParams, params)
-			} else {
-				res = append(res, params)
-			}
+		err = appendTemplatedValuesAndObject(appSetGenerator.Clusters.Values, appSetGenerator.Clusters.ValuesObject, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("error appending templated values for local cluster: %w", err)
+		}
 
-			logCtx.WithField("cluster", "local cluster").Info("matched local cluster")
+		if isFlatMode {
+			clustersParams = append(clustersParams, params)
+		} else {
+			res = append(res, params)
 		}
+
+		logCtx.WithField("cluster", "local cluster").Info("matched local cluster")
 	}
 
 	// For each matching cluster secret (non-local clusters only)
-	for _, cluster := range secretsFound {
+	var invalidSecrets []string
+	for _, cluster := range clusterSecrets {
+		if err := validateClusterSecret(&cluster); err != nil {
+			if g.strict {
+				return nil, fmt.Errorf("invalid cluster secret %q: %w", cluster.Name, err)
+			}
+			// A single malformed secret should not prevent the Applications of the other clusters from being generated
+			logCtx.WithField("cluster", cluster.Name).Warnf("skipping invalid cluster secret: %v", err)
+			if g.recorder != nil {
+				g.recorder.Eventf(appSet, corev1.EventTypeWarning, "InvalidClusterSecret", "Skipped invalid cluster secret %q: %v", cluster.Name, err)
+			}
+			invalidSecrets = append(invalidSecrets, fmt.Sprintf("%q: %v", cluster.Name, err))
+			continue
+		}
+
 		params := map[string]any{}
 
 		params["name"] = string(cluster.Data["name"])
@@ -137,16 +155,7 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 		}
 
 		if appSet.Spec.GoTemplate {
-			meta := map[string]any{}
-
-			if len(cluster.Annotations) > 0 {
-				meta["annotations"] = cluster.Annotations
-			}
-			if len(cluster.Labels) > 0 {
-				meta["labels"] = cluster.Labels
-			}
-
-			params["metadata"] = meta
+			params["metadata"] = clusterMetadataParam(cluster.Labels, cluster.Annotations)
 		} else {
 			for key, value := range cluster.Annotations {
 				params["metadata.annotations."+key] = value
@@ -157,7 +166,7 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 			}
 		}
 
-		err = appendTemplatedValues(appSetGenerator.Clusters.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		err = appendTemplatedValuesAndObject(appSetGenerator.Clusters.Values, appSetGenerator.Clusters.ValuesObject, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
 		if err != nil {
 			return nil, fmt.Errorf("error appending templated values for cluster: %w", err)
 		}
@@ -176,30 +185,75 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 			"clusters": clustersParams,
 		})
 	}
+	if len(invalidSecrets) > 0 {
+		return res, fmt.Errorf("%w: skipped invalid cluster secrets %s", ErrIncompleteParams, strings.Join(invalidSecrets, ", "))
+	}
 	return res, nil
 }
 
-func (g *ClusterGenerator) getSecretsByClusterName(log *log.Entry, appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator) (map[string]corev1.Secret, error) {
+// clusterMetadataParam returns the metadata parameter of a cluster for Go templates. The labels and annotations are
+// always set, even if empty, so that templates using missingkey=error can look them up for any cluster.
+func clusterMetadataParam(labels, annotations map[string]string) map[string]any {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	return map[string]any{
+		"labels":      labels,
+		"annotations": annotations,
+	}
+}
+
+// getClusterSecrets returns the cluster secrets of the Argo CD namespace matching the selector of the generator.
+func (g *ClusterGenerator) getClusterSecrets(log *log.Entry, appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator) ([]corev1.Secret, error) {
 	clusterSecretList := &corev1.SecretList{}
 
-	selector := metav1.AddLabelToSelector(&appSetGenerator.Clusters.Selector, common.LabelKeySecretType, common.LabelValueSecretTypeCluster)
+	selector := &appSetGenerator.Clusters.Selector
+	listOptions := []client.ListOption{client.InNamespace(g.namespace)}
+	if g.secretTypeIndexed {
+		// the index restricts the list to the cluster secrets, the generator selector is then matched in memory
+		listOptions = append(listOptions, client.MatchingFields{utils.SecretTypeIndexField: common.LabelValueSecretTypeCluster})
+	} else {
+		// let the API server filter the cluster secrets
+		selector = metav1.AddLabelToSelector(selector, common.LabelKeySecretType, common.LabelValueSecretTypeCluster)
+	}
 	secretSelector, err := metav1.LabelSelectorAsSelector(selector)
 	if err != nil {
 		return nil, fmt.Errorf("error converting label selector: %w", err)
 	}
+	listOptions = append(listOptions, client.MatchingLabelsSelector{Selector: secretSelector})
 
-	if err := g.List(context.Background(), clusterSecretList, client.MatchingLabelsSelector{Selector: secretSelector}); err != nil {
+	if err := g.List(g.ctx, clusterSecretList, listOptions...); err != nil {
 		return nil, err
 	}
 	log.Debugf("clusters matching labels: %d", len(clusterSecretList.Items))
 
-	res := map[string]corev1.Secret{}
-
-	for _, cluster := range clusterSecretList.Items {
-		clusterName := string(cluster.Data["name"])
+	return clusterSecretList.Items, nil
+}
 
-		res[clusterName] = cluster
+// validateClusterSecret returns an error if the given cluster secret cannot describe a cluster, either because it has
+// no server or because its config is not valid JSON.
+func validateClusterSecret(secret *corev1.Secret) error {
+	if len(secret.Data["server"]) == 0 {
+		return errors.New("server is missing")
 	}
+	if config := secret.Data["config"]; len(config) > 0 {
+		var clusterConfig argoappsetv1alpha1.ClusterConfig
+		if err := json.Unmarshal(config, &clusterConfig); err != nil {
+			return fmt.Errorf("failed to unmarshal cluster config: %w", err)
+		}
+	}
+	return nil
+}
 
-	return res, nil
+// hasLocalClusterSecret returns true if one of the given secrets describes the local cluster.
+func hasLocalClusterSecret(clusterSecrets []corev1.Secret) bool {
+	for _, secret := range clusterSecrets {
+		if strings.TrimRight(string(secret.Data["server"]), "/") == argoappsetv1alpha1.KubernetesInternalAPIServerAddr {
+			return true
+		}
+	}
+	return false
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +13,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -297,6 +300,23 @@ func TestGenerateParams(t *testing.T) {
 			clientError:   false,
 			expectedError: nil,
 		},
+		{
+			name: "flat mode without matching cluster",
+			selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"environment": "qa",
+				},
+			},
+			isFlatMode: true,
+			values:     nil,
+			expected: []map[string]any{
+				{
+					"clusters": []map[string]any{},
+				},
+			},
+			clientError:   false,
+			expectedError: nil,
+		},
 	}
 
 	// convert []client.Object to []runtime.Object, for use by kubefake package
@@ -315,7 +335,7 @@ func TestGenerateParams(t *testing.T) {
 				testCase.clientError,
 			}
 
-			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace")
+			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace", false, false, nil)
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -473,13 +493,17 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 					"name":           "in-cluster",
 					"server":         "https://kubernetes.default.svc",
 					"project":        "",
+					"metadata": map[string]any{
+						"labels":      map[string]string{},
+						"annotations": map[string]string{},
+					},
 					"values": map[string]string{
 						"lol1":  "lol",
 						"lol2":  "<no value><no value>",
 						"lol3":  "<no value><no value><no value>",
 						"foo":   "bar",
 						"bar":   "",
-						"bat":   "",
+						"bat":   "<no value>",
 						"aaa":   "https://kubernetes.default.svc",
 						"no-op": "<no value>",
 					},
@@ -701,13 +725,17 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 							"name":           "in-cluster",
 							"server":         "https://kubernetes.default.svc",
 							"project":        "",
+							"metadata": map[string]any{
+								"labels":      map[string]string{},
+								"annotations": map[string]string{},
+							},
 							"values": map[string]string{
 								"lol1":  "lol",
 								"lol2":  "<no value><no value>",
 								"lol3":  "<no value><no value><no value>",
 								"foo":   "bar",
 								"bar":   "",
-								"bat":   "",
+								"bat":   "<no value>",
 								"aaa":   "https://kubernetes.default.svc",
 								"no-op": "<no value>",
 							},
@@ -853,7 +881,7 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 				testCase.clientError,
 			}
 
-			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace")
+			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace", false, false, nil)
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -882,6 +910,17 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 	}
 }
 
+func TestClusterGetRequeueAfter(t *testing.T) {
+	g := NewClusterGenerator(t.Context(), nil, nil, "argocd", false, false, nil)
+
+	assert.Equal(t, NoRequeueAfter, g.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Clusters: &argoprojiov1alpha1.ClusterGenerator{},
+	}))
+	assert.Equal(t, 10*time.Minute, g.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Clusters: &argoprojiov1alpha1.ClusterGenerator{RequeueAfterSeconds: ptr.To(int64(600))},
+	}))
+}
+
 func TestSanitizeClusterName(t *testing.T) {
 	t.Run("valid DNS-1123 subdomain name", func(t *testing.T) {
 		assert.Equal(t, "cluster-name", utils.SanitizeName("cluster-name"))
@@ -891,3 +930,126 @@ func TestSanitizeClusterName(t *testing.T) {
 		assert.Equal(t, "cluster-name", utils.SanitizeName(invalidName))
 	})
 }
+
+func TestGenerateParamsWithSecretTypeIndex(t *testing.T) {
+	newSecret := func(name, namespace, secretType, environment string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"argocd.argoproj.io/secret-type": secretType,
+					"environment":                    environment,
+				},
+			},
+			Data: map[string][]byte{
+				"name":   []byte(name),
+				"server": []byte("https://" + name + ".example.com"),
+			},
+		}
+	}
+	objects := []client.Object{
+		newSecret("staging-01", "namespace", "cluster", "staging"),
+		newSecret("production-01", "namespace", "cluster", "production"),
+		newSecret("production-repo", "namespace", "repository", "production"),
+		newSecret("production-02", "other-namespace", "cluster", "production"),
+	}
+
+	testCases := []struct {
+		name     string
+		selector metav1.LabelSelector
+		expected []string
+	}{
+		{
+			name:     "no label selector",
+			selector: metav1.LabelSelector{},
+			expected: []string{"in-cluster", "production-01", "staging-01"},
+		},
+		{
+			name: "label selector",
+			selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"environment": "production"},
+			},
+			expected: []string{"production-01"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(objects...).
+				WithIndex(&corev1.Secret{}, utils.SecretTypeIndexField, utils.SecretTypeIndexer).
+				Build()
+
+			clusterGenerator := NewClusterGenerator(t.Context(), fakeClient, kubefake.NewSimpleClientset(), "namespace", true, false, nil)
+
+			got, err := clusterGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				Clusters: &argoprojiov1alpha1.ClusterGenerator{
+					Selector: testCase.selector,
+				},
+			}, &argoprojiov1alpha1.ApplicationSet{}, nil)
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, params := range got {
+				names = append(names, params["name"].(string))
+			}
+			assert.ElementsMatch(t, testCase.expected, names)
+		})
+	}
+}
+
+func TestGenerateParamsWithMalformedClusterSecret(t *testing.T) {
+	newSecret := func(name string, config string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "namespace",
+				Labels: map[string]string{
+					"argocd.argoproj.io/secret-type": "cluster",
+				},
+			},
+			Data: map[string][]byte{
+				"name":   []byte(name),
+				"server": []byte("https://" + name + ".example.com"),
+				"config": []byte(config),
+			},
+		}
+	}
+	objects := []client.Object{
+		newSecret("valid", `{"bearerToken":"token"}`),
+		newSecret("malformed", `{"bearerToken":`),
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "namespace"},
+	}
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		Clusters: &argoprojiov1alpha1.ClusterGenerator{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+			},
+		},
+	}
+
+	t.Run("malformed secret is skipped and reported", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+		recorder := record.NewFakeRecorder(1)
+		clusterGenerator := NewClusterGenerator(t.Context(), fakeClient, kubefake.NewSimpleClientset(), "namespace", false, false, recorder)
+
+		got, err := clusterGenerator.GenerateParams(appSetGenerator, appSet, nil)
+		require.ErrorIs(t, err, ErrIncompleteParams)
+		require.ErrorContains(t, err, `"malformed"`)
+		require.Len(t, got, 1)
+		assert.Equal(t, "valid", got[0]["name"])
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, `Warning InvalidClusterSecret Skipped invalid cluster secret "malformed"`)
+	})
+
+	t.Run("malformed secret fails in strict mode", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+		clusterGenerator := NewClusterGenerator(t.Context(), fakeClient, kubefake.NewSimpleClientset(), "namespace", false, true, nil)
+
+		_, err := clusterGenerator.GenerateParams(appSetGenerator, appSet, nil)
+		require.ErrorContains(t, err, `invalid cluster secret "malformed"`)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -154,14 +155,15 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 	for _, duckResource := range duckResources.Items {
 		log.WithField("duckResourceName", duckResource.GetName()).Debug("found resource")
 
-		if duckResource.Object["status"] == nil || len(duckResource.Object["status"].(map[string]any)) == 0 {
-			log.Warningf("clusterDecisionResource: %s, has no status", duckResource.GetName())
+		decisions, found, err := unstructured.NestedSlice(duckResource.Object, "status", statusListKey)
+		if err != nil || !found {
+			log.Warningf("clusterDecisionResource: %s, has no status.%s list", duckResource.GetName(), statusListKey)
 			continue
 		}
 
 		log.WithField("duckResourceStatus", duckResource.Object["status"]).Debug("found resource")
 
-		clusterDecisions = append(clusterDecisions, duckResource.Object["status"].(map[string]any)[statusListKey].([]any)...)
+		clusterDecisions = append(clusterDecisions, decisions...)
 	}
 	log.Infof("Number of decisions found: %v", len(clusterDecisions))
 
@@ -174,13 +176,17 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 		params := map[string]any{}
 
 		log.Infof("cluster: %v", cluster)
-		matchValue := cluster.(map[string]any)[matchKey]
-		if matchValue == nil || matchValue.(string) == "" {
-			log.Warningf("matchKey=%v not found in \"%v\" list: %v\n", matchKey, statusListKey, cluster.(map[string]any))
+		decision, ok := cluster.(map[string]any)
+		if !ok {
+			log.Warningf("unexpected item in \"%v\" list: %v", statusListKey, cluster)
+			continue
+		}
+		strMatchValue, ok := decision[matchKey].(string)
+		if !ok || strMatchValue == "" {
+			log.Warningf("matchKey=%v not found in \"%v\" list: %v\n", matchKey, statusListKey, decision)
 			continue
 		}
 
-		strMatchValue := matchValue.(string)
 		log.WithField(matchKey, strMatchValue).Debug("validate against ArgoCD")
 
 		found := false
@@ -201,8 +207,8 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 			continue
 		}
 
-		for key, value := range cluster.(map[string]any) {
-			params[key] = value.(string)
+		for key, value := range decision {
+			params[key] = fmt.Sprint(value)
 		}
 
 		for key, value := range appSetGenerator.ClusterDecisionResource.Values {
@@ -130,6 +130,45 @@ func TestGenerateParamsForDuckType(t *testing.T) {
 		},
 	}
 
+	duckTypeMalformed := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resourceAPIVersion,
+			"kind":       "Duck",
+			"metadata": map[string]any{
+				"name":      resourceName,
+				"namespace": "namespace",
+				"labels":    map[string]any{"duck": "malformed"},
+			},
+			"status": map[string]any{
+				"decisions": []any{
+					"production-01",
+					map[string]any{
+						"clusterName": int64(1),
+					},
+					map[string]any{
+						"clusterName": "staging-01",
+						"weight":      int64(2),
+					},
+				},
+			},
+		},
+	}
+
+	duckTypeOtherStatus := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resourceAPIVersion,
+			"kind":       "Duck",
+			"metadata": map[string]any{
+				"name":      resourceName,
+				"namespace": "namespace",
+				"labels":    map[string]any{"duck": "other"},
+			},
+			"status": map[string]any{
+				"conditions": []any{},
+			},
+		},
+	}
+
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "my-configmap",
@@ -203,6 +242,24 @@ func TestGenerateParamsForDuckType(t *testing.T) {
 			expected:      nil,
 			expectedError: nil,
 		},
+		{
+			name:          "duck type status without decisions",
+			resourceName:  resourceName,
+			resource:      duckTypeOtherStatus,
+			values:        nil,
+			expected:      nil,
+			expectedError: nil,
+		},
+		{
+			name:         "duck type malformed decisions are skipped",
+			resourceName: resourceName,
+			resource:     duckTypeMalformed,
+			values:       nil,
+			expected: []map[string]any{
+				{"clusterName": "staging-01", "name": "staging-01", "server": "https://staging-01.example.com", "weight": "2"},
+			},
+			expectedError: nil,
+		},
 		{
 			name:          "duck type empty status labelSelector.matchLabels",
 			resourceName:  "",
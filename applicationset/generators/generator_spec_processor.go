@@ -1,6 +1,7 @@
 package generators
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -24,6 +25,29 @@ const (
 type TransformResult struct {
 	Params   []map[string]any
 	Template argoprojiov1alpha1.ApplicationSetTemplate
+	// ParamsTemplates holds, if the parameter sets were produced by child generators with templates of their own, the
+	// template of each of the parameter sets: the template of its child generators merged over Template.
+	ParamsTemplates []argoprojiov1alpha1.ApplicationSetTemplate
+}
+
+// paramsTemplates returns the template of each of the parameter sets.
+func (t TransformResult) paramsTemplates() []argoprojiov1alpha1.ApplicationSetTemplate {
+	if t.ParamsTemplates != nil {
+		return t.ParamsTemplates
+	}
+	templates := make([]argoprojiov1alpha1.ApplicationSetTemplate, len(t.Params))
+	for i := range templates {
+		templates[i] = t.Template
+	}
+	return templates
+}
+
+// paramsTemplatesGenerator is implemented by the Matrix and Merge generators, whose child generators may have templates
+// of their own, only applying to the parameter sets they produced.
+type paramsTemplatesGenerator interface {
+	// generateParamsWithTemplates returns the parameter sets of the generator along with, if any of its child
+	// generators has a template, the template of the child generators which produced each of them.
+	generateParamsWithTemplates(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, []argoprojiov1alpha1.ApplicationSetTemplate, error)
 }
 
 // Transform a spec generator to list of paramSets and a template
@@ -65,17 +89,26 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 				continue
 			}
 		}
-		params, err = g.GenerateParams(interpolatedGenerator, appSet, client)
+		var paramsTemplates []argoprojiov1alpha1.ApplicationSetTemplate
+		if templatesGenerator, ok := g.(paramsTemplatesGenerator); ok {
+			params, paramsTemplates, err = templatesGenerator.generateParamsWithTemplates(interpolatedGenerator, appSet, client)
+		} else {
+			params, err = g.GenerateParams(interpolatedGenerator, appSet, client)
+		}
 		if err != nil {
 			log.WithError(err).WithField("generator", g).
 				Error("error generating params")
 			if firstError == nil {
 				firstError = err
 			}
-			continue
+			// The parameter sets a generator could still generate are kept
+			if !errors.Is(err, ErrIncompleteParams) {
+				continue
+			}
 		}
 		var filterParams []map[string]any
-		for _, param := range params {
+		var filterTemplates []argoprojiov1alpha1.ApplicationSetTemplate
+		for i, param := range params {
 			flatParam, err := flattenParameters(param)
 			if err != nil {
 				log.WithError(err).WithField("generator", g).
@@ -89,12 +122,25 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 			if requestedGenerator.Selector != nil && !selector.Matches(labels.Set(flatParam)) {
 				continue
 			}
+			if paramsTemplates != nil {
+				paramsTemplate, err := mergeTemplates(paramsTemplates[i], mergedTemplate)
+				if err != nil {
+					log.WithError(err).WithField("generator", g).
+						Error("error merging the template of the child generators")
+					if firstError == nil {
+						firstError = err
+					}
+					continue
+				}
+				filterTemplates = append(filterTemplates, paramsTemplate)
+			}
 			filterParams = append(filterParams, param)
 		}
 
 		res = append(res, TransformResult{
-			Params:   filterParams,
-			Template: mergedTemplate,
+			Params:          filterParams,
+			Template:        mergedTemplate,
+			ParamsTemplates: filterTemplates,
 		})
 	}
 
@@ -138,15 +184,30 @@ func flattenParameters(in map[string]any) (map[string]string, error) {
 }
 
 func mergeGeneratorTemplate(g Generator, requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetTemplate argoprojiov1alpha1.ApplicationSetTemplate) (argoprojiov1alpha1.ApplicationSetTemplate, error) {
-	// Make a copy of the value from `GetTemplate()` before merge, rather than copying directly into
-	// the provided parameter (which will touch the original resource object returned by client-go)
-	dest := g.GetTemplate(requestedGenerator).DeepCopy()
+	return mergeTemplates(*g.GetTemplate(requestedGenerator), applicationSetTemplate)
+}
+
+// mergeTemplates returns a copy of the given template, whose empty fields are set from the given base template.
+func mergeTemplates(template, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate) (argoprojiov1alpha1.ApplicationSetTemplate, error) {
+	// Make a copy of the template before merge, rather than merging directly into it (which will touch the original
+	// resource object returned by client-go)
+	dest := template.DeepCopy()
 
-	err := mergo.Merge(dest, applicationSetTemplate)
+	err := mergo.Merge(dest, baseTemplate)
 
 	return *dest, err
 }
 
+// hasTemplates returns whether any of the given templates is set.
+func hasTemplates(templates []argoprojiov1alpha1.ApplicationSetTemplate) bool {
+	for i := range templates {
+		if !reflect.ValueOf(templates[i]).IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
 // InterpolateGenerator allows interpolating the matrix's 2nd child generator with values from the 1st child generator
 // "params" parameter is an array, where each index corresponds to a generator. Each index contains a map w/ that generator's parameters.
 func InterpolateGenerator(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
@@ -18,6 +18,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -145,6 +146,35 @@ func TestMatchValuesGoTemplate(t *testing.T) {
 			},
 			expected: []map[string]any{{"cluster": "cluster", "url": "url", "values": []any{"bar"}}},
 		},
+		{
+			name: "environment in staging or prod",
+			elements: []apiextensionsv1.JSON{
+				{Raw: []byte(`{"cluster": "dev","environment": "dev"}`)},
+				{Raw: []byte(`{"cluster": "staging","environment": "staging"}`)},
+				{Raw: []byte(`{"cluster": "prod","environment": "prod"}`)},
+				{Raw: []byte(`{"cluster": "other"}`)},
+			},
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "environment", Operator: metav1.LabelSelectorOpIn, Values: []string{"staging", "prod"}},
+				},
+			},
+			expected: []map[string]any{{"cluster": "staging", "environment": "staging"}, {"cluster": "prod", "environment": "prod"}},
+		},
+		{
+			name: "environment not in prod",
+			elements: []apiextensionsv1.JSON{
+				{Raw: []byte(`{"cluster": "staging","environment": "staging"}`)},
+				{Raw: []byte(`{"cluster": "prod","environment": "prod"}`)},
+				{Raw: []byte(`{"cluster": "other"}`)},
+			},
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "environment", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"prod"}},
+				},
+			},
+			expected: []map[string]any{{"cluster": "staging", "environment": "staging"}, {"cluster": "other"}},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -342,7 +372,7 @@ func getMockClusterGenerator() Generator {
 	appClientset := kubefake.NewSimpleClientset(runtimeClusters...)
 
 	fakeClient := fake.NewClientBuilder().WithObjects(clusters...).Build()
-	return NewClusterGenerator(context.Background(), fakeClient, appClientset, "namespace")
+	return NewClusterGenerator(context.Background(), fakeClient, appClientset, "namespace", false, false, nil)
 }
 
 func getMockGitGenerator() Generator {
@@ -352,6 +382,136 @@ func getMockGitGenerator() Generator {
 	return gitGenerator
 }
 
+func TestTransformChildGeneratorTemplates(t *testing.T) {
+	terminalGenerators := map[string]Generator{
+		"List": &ListGenerator{},
+	}
+	allGenerators := map[string]Generator{
+		"List":   terminalGenerators["List"],
+		"Matrix": NewMatrixGenerator(terminalGenerators),
+		"Merge":  NewMergeGenerator(terminalGenerators),
+	}
+	baseTemplate := argov1alpha1.ApplicationSetTemplate{
+		ApplicationSetTemplateMeta: argov1alpha1.ApplicationSetTemplateMeta{
+			Name:   "{{.env}}",
+			Labels: map[string]string{"team": "a"},
+		},
+		Spec: argov1alpha1.ApplicationSpec{
+			Project:     "default",
+			Destination: argov1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "base"},
+		},
+	}
+	appSet := &argov1alpha1.ApplicationSet{Spec: argov1alpha1.ApplicationSetSpec{GoTemplate: true}}
+	list := func(template argov1alpha1.ApplicationSetTemplate, elements ...string) *argov1alpha1.ListGenerator {
+		generator := &argov1alpha1.ListGenerator{Template: template}
+		for _, element := range elements {
+			generator.Elements = append(generator.Elements, apiextensionsv1.JSON{Raw: []byte(element)})
+		}
+		return generator
+	}
+	namespaceTemplate := func(namespace string) argov1alpha1.ApplicationSetTemplate {
+		return argov1alpha1.ApplicationSetTemplate{
+			Spec: argov1alpha1.ApplicationSpec{Destination: argov1alpha1.ApplicationDestination{Namespace: namespace}},
+		}
+	}
+	// namespaces returns the destination namespace of the template of each parameter set, by environment
+	namespaces := func(t *testing.T, result TransformResult) map[string]string {
+		t.Helper()
+		require.Len(t, result.ParamsTemplates, len(result.Params))
+		res := map[string]string{}
+		for i, params := range result.Params {
+			template := result.ParamsTemplates[i]
+			assert.Equal(t, "{{.env}}", template.Name)
+			assert.Equal(t, "default", template.Spec.Project)
+			assert.Equal(t, "https://kubernetes.default.svc", template.Spec.Destination.Server)
+			res[params["env"].(string)] = template.Spec.Destination.Namespace
+		}
+		return res
+	}
+
+	t.Run("matrix child templates", func(t *testing.T) {
+		envs := list(argov1alpha1.ApplicationSetTemplate{
+			ApplicationSetTemplateMeta: argov1alpha1.ApplicationSetTemplateMeta{Labels: map[string]string{"tier": "web"}},
+			Spec:                       argov1alpha1.ApplicationSpec{Destination: argov1alpha1.ApplicationDestination{Namespace: "envs"}},
+		}, `{"env": "dev"}`, `{"env": "prod"}`)
+		clusters := list(namespaceTemplate("clusters"), `{"cluster": "a"}`)
+		results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			Matrix: &argov1alpha1.MatrixGenerator{
+				Generators: []argov1alpha1.ApplicationSetNestedGenerator{{List: envs}, {List: clusters}},
+			},
+		}, allGenerators, baseTemplate, appSet, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, baseTemplate, results[0].Template)
+		// The template of the first generator takes precedence
+		assert.Equal(t, map[string]string{"dev": "envs", "prod": "envs"}, namespaces(t, results[0]))
+		assert.Equal(t, map[string]string{"team": "a", "tier": "web"}, results[0].ParamsTemplates[0].Labels)
+	})
+
+	t.Run("matrix template takes precedence over the ApplicationSet template", func(t *testing.T) {
+		results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			Matrix: &argov1alpha1.MatrixGenerator{
+				Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+					{List: list(argov1alpha1.ApplicationSetTemplate{}, `{"env": "dev"}`)},
+					{List: list(namespaceTemplate("clusters"), `{"cluster": "a"}`)},
+				},
+				Template: namespaceTemplate("matrix"),
+			},
+		}, allGenerators, baseTemplate, appSet, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, map[string]string{"dev": "clusters"}, namespaces(t, results[0]))
+	})
+
+	t.Run("matrix without child templates", func(t *testing.T) {
+		results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			Matrix: &argov1alpha1.MatrixGenerator{
+				Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+					{List: list(argov1alpha1.ApplicationSetTemplate{}, `{"env": "dev"}`)},
+					{List: list(argov1alpha1.ApplicationSetTemplate{}, `{"cluster": "a"}`)},
+				},
+			},
+		}, allGenerators, baseTemplate, appSet, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Nil(t, results[0].ParamsTemplates)
+		assert.Equal(t, baseTemplate, results[0].Template)
+	})
+
+	t.Run("merge child templates", func(t *testing.T) {
+		results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			Merge: &argov1alpha1.MergeGenerator{
+				MergeKeys: []string{"env"},
+				Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+					{List: list(namespaceTemplate("base-generator"), `{"env": "dev"}`, `{"env": "prod"}`, `{"env": "test"}`)},
+					{List: list(namespaceTemplate("prod"), `{"env": "prod"}`)},
+					{List: list(argov1alpha1.ApplicationSetTemplate{}, `{"env": "test"}`)},
+				},
+			},
+		}, allGenerators, baseTemplate, appSet, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		// The template of the generators overriding a parameter set takes precedence
+		assert.Equal(t, map[string]string{"dev": "base-generator", "prod": "prod", "test": "base-generator"}, namespaces(t, results[0]))
+	})
+
+	t.Run("selector keeps the templates of the selected parameter sets", func(t *testing.T) {
+		results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			Merge: &argov1alpha1.MergeGenerator{
+				MergeKeys: []string{"env"},
+				Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+					{List: list(argov1alpha1.ApplicationSetTemplate{}, `{"env": "dev"}`, `{"env": "prod"}`)},
+					{List: list(namespaceTemplate("prod"), `{"env": "prod"}`)},
+				},
+			},
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+		}, allGenerators, baseTemplate, appSet, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, map[string]string{"prod": "prod"}, namespaces(t, results[0]))
+	})
+}
+
 func TestGetRelevantGenerators(t *testing.T) {
 	testGenerators := map[string]Generator{
 		"Clusters": getMockClusterGenerator(),
@@ -548,6 +708,8 @@ func TestInterpolateGeneratorError(t *testing.T) {
 			params: map[string]any{
 				"name":     "in-cluster",
 				"override": "foo",
+				"rmap":     nil,
+				"test":     nil,
 			},
 			useGoTemplate:     true,
 			goTemplateOptions: []string{},
@@ -565,3 +727,59 @@ func TestInterpolateGeneratorError(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpolateGeneratorUnresolvedParameters(t *testing.T) {
+	requestedGenerator := &argov1alpha1.ApplicationSetGenerator{
+		Git: &argov1alpha1.GitGenerator{
+			RepoURL: "{{ .repoURL }}",
+			Files:   []argov1alpha1.GitFileGeneratorItem{{Path: "{{ .path.path }}/config.json"}},
+			Values:  map[string]string{"cluster": "{{ .name }}-{{ .path.basename }}"},
+		},
+		Clusters: &argov1alpha1.ClusterGenerator{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "{{ .env }}", "region": "{{ .region }}"}},
+		},
+	}
+	params := map[string]any{"repoURL": "https://github.com/argoproj/argo-cd", "name": "in-cluster", "env": "prod"}
+
+	for _, goTemplateOptions := range [][]string{nil, {"missingkey=zero"}, {"missingkey=error"}} {
+		interpolated, err := InterpolateGenerator(requestedGenerator, params, true, goTemplateOptions)
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/argoproj/argo-cd", interpolated.Git.RepoURL)
+		assert.Equal(t, "{{ .path.path }}/config.json", interpolated.Git.Files[0].Path)
+		assert.Equal(t, "{{ .name }}-{{ .path.basename }}", interpolated.Git.Values["cluster"])
+		assert.Equal(t, map[string]string{"env": "prod", "region": "{{ .region }}"}, interpolated.Clusters.Selector.MatchLabels)
+	}
+
+	interpolated, err := InterpolateGenerator(&argov1alpha1.ApplicationSetGenerator{
+		Git: &argov1alpha1.GitGenerator{RepoURL: "{{repoURL}}", Files: []argov1alpha1.GitFileGeneratorItem{{Path: "{{path}}/config.json"}}},
+	}, params, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/argoproj/argo-cd", interpolated.Git.RepoURL)
+	assert.Equal(t, "{{path}}/config.json", interpolated.Git.Files[0].Path)
+}
+
+func TestInterpolateGeneratorStringFields(t *testing.T) {
+	requestedGenerator := &argov1alpha1.ApplicationSetGenerator{
+		Git: &argov1alpha1.GitGenerator{
+			RepoURL:  "https://github.com/argoproj/argocd-example-apps",
+			Revision: "release-{{ .version }}",
+		},
+		SCMProvider: &argov1alpha1.SCMProviderGenerator{
+			Github:  &argov1alpha1.SCMProviderGeneratorGithub{Organization: "{{ .org }}"},
+			Filters: []argov1alpha1.SCMProviderGeneratorFilter{{RepositoryMatch: ptr.To("^{{ .team }}-")}},
+		},
+		PullRequest: &argov1alpha1.PullRequestGenerator{
+			Github: &argov1alpha1.PullRequestGeneratorGithub{Owner: "{{ .org }}", Repo: "guestbook", Labels: []string{"preview-{{ .team }}"}},
+		},
+	}
+	params := map[string]any{"version": "1.2", "org": "argoproj", "team": "payments"}
+
+	interpolated, err := InterpolateGenerator(requestedGenerator, params, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "release-1.2", interpolated.Git.Revision)
+	assert.Equal(t, "argoproj", interpolated.SCMProvider.Github.Organization)
+	assert.Equal(t, "^payments-", *interpolated.SCMProvider.Filters[0].RepositoryMatch)
+	assert.Equal(t, "argoproj", interpolated.PullRequest.Github.Owner)
+	assert.Equal(t, []string{"preview-payments"}, interpolated.PullRequest.Github.Labels)
+	assert.Equal(t, "^{{ .team }}-", *requestedGenerator.SCMProvider.Filters[0].RepositoryMatch, "the generator must not be modified")
+}
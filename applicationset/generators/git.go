@@ -1,8 +1,12 @@
 package generators
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"path"
 	"sort"
 	"strconv"
@@ -11,18 +15,51 @@ import (
 
 	"github.com/jeremywohl/flatten"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 )
 
 var _ Generator = (*GitGenerator)(nil)
 
+const (
+	// DefaultGitFileMaxSize is the default maximum size of a file read by the Git files generator.
+	DefaultGitFileMaxSize = "10M"
+	// DefaultGitGeneratorFilesMaxSize is the default maximum combined size of the files read by a Git files generator.
+	DefaultGitGeneratorFilesMaxSize = "100M"
+)
+
+// getGitFileMaxSize returns the maximum size in bytes of a file read by the Git files generator, 0 meaning unlimited.
+func getGitFileMaxSize() int64 {
+	return parseSizeFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GIT_FILE_MAX_SIZE", DefaultGitFileMaxSize)
+}
+
+// getGitGeneratorFilesMaxSize returns the maximum combined size in bytes of the files read by a Git files generator, 0
+// meaning unlimited.
+func getGitGeneratorFilesMaxSize() int64 {
+	return parseSizeFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GIT_GENERATOR_FILES_MAX_SIZE", DefaultGitGeneratorFilesMaxSize)
+}
+
+// parseSizeFromEnv parses a size given as a quantity, such as 10M or 1Gi, from the environment variable. The default
+// value is used if the variable is not set or is invalid.
+func parseSizeFromEnv(envVar string, defaultValue string) int64 {
+	value := env.StringFromEnv(envVar, defaultValue)
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil || quantity.Sign() < 0 {
+		log.Warnf("Invalid size %q in %s, using the default %s", value, envVar, defaultValue)
+		quantity = resource.MustParse(defaultValue)
+	}
+	return quantity.Value()
+}
+
 type GitGenerator struct {
 	repos     services.Repos
 	namespace string
@@ -130,14 +167,18 @@ func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoproj
 func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, noRevisionCache, verifyCommit, useGoTemplate bool, project string, goTemplateOptions []string) ([]map[string]any, error) {
 	// Get all files that match the requested path string, removing duplicates
 	allFiles := make(map[string][]byte)
+	allCommits := make(map[string]*apiclient.GitFileCommit)
 	for _, requestedPath := range appSetGenerator.Git.Files {
-		files, err := g.repos.GetFiles(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, project, requestedPath.Path, noRevisionCache, verifyCommit)
+		files, commits, err := g.repos.GetFiles(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, project, requestedPath.Path, noRevisionCache, verifyCommit, appSetGenerator.Git.CommitParams)
 		if err != nil {
 			return nil, err
 		}
 		for filePath, content := range files {
 			allFiles[filePath] = content
 		}
+		for filePath, commit := range commits {
+			allCommits[filePath] = commit
+		}
 	}
 
 	// Extract the unduplicated map into a list, and sort by path to ensure a deterministic
@@ -148,11 +189,26 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	}
 	sort.Strings(allPaths)
 
+	// Check the sizes of the files before parsing any of them, the parsed objects taking several times the size of a file
+	maxFileSize := getGitFileMaxSize()
+	maxFilesSize := getGitGeneratorFilesMaxSize()
+	var filesSize int64
+	for _, path := range allPaths {
+		size := int64(len(allFiles[path]))
+		if maxFileSize > 0 && size > maxFileSize {
+			return nil, fmt.Errorf("file '%s' is %d bytes, which exceeds the maximum size of a file of %d bytes", path, size, maxFileSize)
+		}
+		filesSize += size
+		if maxFilesSize > 0 && filesSize > maxFilesSize {
+			return nil, fmt.Errorf("file '%s' brings the combined size of the files to %d bytes, which exceeds the maximum of %d bytes", path, filesSize, maxFilesSize)
+		}
+	}
+
 	// Generate params from each path, and return
 	res := []map[string]any{}
 	for _, path := range allPaths {
 		// A JSON / YAML file path can contain multiple sets of parameters (ie it is an array)
-		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path], appSetGenerator.Git.Values, useGoTemplate, goTemplateOptions, appSetGenerator.Git.PathParamPrefix)
+		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path], allCommits[path], appSetGenerator.Git.Values, useGoTemplate, goTemplateOptions, appSetGenerator.Git.PathParamPrefix, appSetGenerator.Git.ContentParamName)
 		if err != nil {
 			return nil, fmt.Errorf("unable to process file '%s': %w", path, err)
 		}
@@ -162,20 +218,12 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	return res, nil
 }
 
-func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, values map[string]string, useGoTemplate bool, goTemplateOptions []string, pathParamPrefix string) ([]map[string]any, error) {
-	objectsFound := []map[string]any{}
-
-	// First, we attempt to parse as an array
-	err := yaml.Unmarshal(fileContent, &objectsFound)
+func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, commit *apiclient.GitFileCommit, values map[string]string, useGoTemplate bool, goTemplateOptions []string, pathParamPrefix string, contentParamName string) ([]map[string]any, error) {
+	objectsFound, err := parseGitFile(fileContent)
 	if err != nil {
-		// If unable to parse as an array, attempt to parse as a single object
-		singleObj := make(map[string]any)
-		err = yaml.Unmarshal(fileContent, &singleObj)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse file: %w", err)
-		}
-		objectsFound = append(objectsFound, singleObj)
-	} else if len(objectsFound) == 0 {
+		return nil, fmt.Errorf("unable to parse file: %w", err)
+	}
+	if len(objectsFound) == 0 {
 		// If file is valid but empty, add a default empty item
 		objectsFound = append(objectsFound, map[string]any{})
 	}
@@ -186,8 +234,12 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 		params := map[string]any{}
 
 		if useGoTemplate {
-			for k, v := range objectFound {
-				params[k] = v
+			if contentParamName != "" {
+				params[contentParamName] = objectFound
+			} else {
+				for k, v := range objectFound {
+					params[k] = v
+				}
 			}
 
 			paramPath := map[string]any{}
@@ -199,12 +251,19 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 			paramPath["filenameNormalized"] = utils.SanitizeName(path.Base(paramPath["filename"].(string)))
 			paramPath["segments"] = strings.Split(paramPath["path"].(string), "/")
 			if pathParamPrefix != "" {
-				params[pathParamPrefix] = map[string]any{"path": paramPath}
+				prefixed := map[string]any{"path": paramPath}
+				addCommitParams(prefixed, "", commit)
+				params[pathParamPrefix] = prefixed
 			} else {
 				params["path"] = paramPath
+				addCommitParams(params, "", commit)
 			}
 		} else {
-			flat, err := flatten.Flatten(objectFound, "", flatten.DotStyle)
+			contentParamPrefix := ""
+			if contentParamName != "" {
+				contentParamPrefix = contentParamName + "."
+			}
+			flat, err := flatten.Flatten(objectFound, contentParamPrefix, flatten.DotStyle)
 			if err != nil {
 				return nil, fmt.Errorf("error flattening object: %w", err)
 			}
@@ -225,6 +284,11 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 					params[pathParamName+"["+strconv.Itoa(k)+"]"] = v
 				}
 			}
+			commitParamPrefix := ""
+			if pathParamPrefix != "" {
+				commitParamPrefix = pathParamPrefix + "."
+			}
+			addCommitParams(params, commitParamPrefix, commit)
 		}
 
 		err := appendTemplatedValues(values, params, useGoTemplate, goTemplateOptions)
@@ -238,6 +302,80 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 	return res, nil
 }
 
+// parseGitFile returns the objects found in a JSON or YAML file. The file may contain several YAML documents, each of
+// them being either a single object or an array of objects. The documents and the items of the arrays are decoded one
+// at a time, so that a file is not held in memory several times while it is parsed.
+func parseGitFile(fileContent []byte) ([]map[string]any, error) {
+	objectsFound := []map[string]any{}
+
+	decoder := kubeyaml.NewYAMLOrJSONDecoder(bytes.NewReader(fileContent), 4096)
+	for {
+		var document json.RawMessage
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(document) == 0 || string(document) == "null" {
+			// Empty document, for example after a trailing document separator
+			continue
+		}
+
+		objects, err := decodeGitFileDocument(document)
+		if err != nil {
+			return nil, err
+		}
+		objectsFound = append(objectsFound, objects...)
+	}
+	return objectsFound, nil
+}
+
+// decodeGitFileDocument returns the objects of a JSON document, which is either a single object or an array of objects.
+func decodeGitFileDocument(document json.RawMessage) ([]map[string]any, error) {
+	if document[0] != '[' {
+		object := map[string]any{}
+		if err := json.Unmarshal(document, &object); err != nil {
+			return nil, err
+		}
+		return []map[string]any{object}, nil
+	}
+
+	objects := []map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	// Consume the opening bracket of the array
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	for decoder.More() {
+		var object map[string]any
+		if err := decoder.Decode(&object); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// addCommitParams sets the commitSha, commitAuthor and commitTimestamp params, with the given prefix, from the last
+// commit modifying a file, if known. The params already set from the content of the file are kept.
+func addCommitParams(params map[string]any, prefix string, commit *apiclient.GitFileCommit) {
+	if commit == nil {
+		return
+	}
+	commitParams := map[string]string{
+		"commitSha":       commit.Sha,
+		"commitAuthor":    commit.Author,
+		"commitTimestamp": time.Unix(commit.Date, 0).UTC().Format(time.RFC3339),
+	}
+	for name, value := range commitParams {
+		if _, ok := params[prefix+name]; !ok {
+			params[prefix+name] = value
+		}
+	}
+}
+
 func (g *GitGenerator) filterApps(directories []argoprojiov1alpha1.GitDirectoryGeneratorItem, allPaths []string) []string {
 	res := []string{}
 	for _, appPath := range allPaths {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 )
 
 func Test_generateParamsFromGitFile(t *testing.T) {
@@ -22,13 +23,16 @@ func Test_generateParamsFromGitFile(t *testing.T) {
 foo:
   bar: baz
 `)
+	commit := &apiclient.GitFileCommit{Sha: "632039659e542ed7de0c170a4fcc1c571b288fc0", Author: "foo <foo@foo.com>", Date: 1622923200}
 	type args struct {
 		filePath          string
 		fileContent       []byte
+		commit            *apiclient.GitFileCommit
 		values            map[string]string
 		useGoTemplate     bool
 		goTemplateOptions []string
 		pathParamPrefix   string
+		contentParamName  string
 	}
 	tests := []struct {
 		name    string
@@ -87,6 +91,63 @@ foo:
 				},
 			},
 		},
+		{
+			name: "multiple yaml documents are added to params",
+			args: args{
+				filePath: "path/dir/file_name.yaml",
+				fileContent: []byte(`
+foo: bar
+---
+- foo: baz
+- foo: qux
+---
+`),
+				values:        map[string]string{},
+				useGoTemplate: false,
+			},
+			want: []map[string]any{
+				{
+					"foo":                     "bar",
+					"path":                    "path/dir",
+					"path.basename":           "dir",
+					"path.filename":           "file_name.yaml",
+					"path.basenameNormalized": "dir",
+					"path.filenameNormalized": "file-name.yaml",
+					"path[0]":                 "path",
+					"path[1]":                 "dir",
+				},
+				{
+					"foo":                     "baz",
+					"path":                    "path/dir",
+					"path.basename":           "dir",
+					"path.filename":           "file_name.yaml",
+					"path.basenameNormalized": "dir",
+					"path.filenameNormalized": "file-name.yaml",
+					"path[0]":                 "path",
+					"path[1]":                 "dir",
+				},
+				{
+					"foo":                     "qux",
+					"path":                    "path/dir",
+					"path.basename":           "dir",
+					"path.filename":           "file_name.yaml",
+					"path.basenameNormalized": "dir",
+					"path.filenameNormalized": "file-name.yaml",
+					"path[0]":                 "path",
+					"path[1]":                 "dir",
+				},
+			},
+		},
+		{
+			name: "array of scalars returns error",
+			args: args{
+				filePath:      "path/dir/file_name.json",
+				fileContent:   []byte(`["foo", "bar"]`),
+				values:        map[string]string{},
+				useGoTemplate: false,
+			},
+			wantErr: true,
+		},
 		{
 			name: "path parameter are prefixed",
 			args: args{
@@ -166,10 +227,176 @@ foo:
 				},
 			},
 		},
+		{
+			name: "file content is exposed under the content param name",
+			args: args{
+				filePath:         "path/dir/file_name.yaml",
+				fileContent:      defaultContent,
+				values:           map[string]string{},
+				useGoTemplate:    false,
+				contentParamName: "config",
+			},
+			want: []map[string]any{
+				{
+					"config.foo.bar":          "baz",
+					"path":                    "path/dir",
+					"path.basename":           "dir",
+					"path.filename":           "file_name.yaml",
+					"path.basenameNormalized": "dir",
+					"path.filenameNormalized": "file-name.yaml",
+					"path[0]":                 "path",
+					"path[1]":                 "dir",
+				},
+			},
+		},
+		{
+			name: "file content is exposed under the content param name with go template",
+			args: args{
+				filePath: "path/dir/file_name.yaml",
+				fileContent: []byte(`
+path: from-file
+regions:
+- eu-west-1
+- us-east-1
+`),
+				values:           map[string]string{},
+				useGoTemplate:    true,
+				contentParamName: "config",
+			},
+			want: []map[string]any{
+				{
+					"config": map[string]any{
+						"path":    "from-file",
+						"regions": []any{"eu-west-1", "us-east-1"},
+					},
+					"path": map[string]any{
+						"path":               "path/dir",
+						"basename":           "dir",
+						"filename":           "file_name.yaml",
+						"basenameNormalized": "dir",
+						"filenameNormalized": "file-name.yaml",
+						"segments": []string{
+							"path",
+							"dir",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "commit parameters are added to params",
+			args: args{
+				filePath:      "path/dir/file_name.yaml",
+				fileContent:   defaultContent,
+				commit:        commit,
+				values:        map[string]string{},
+				useGoTemplate: false,
+			},
+			want: []map[string]any{
+				{
+					"foo.bar":                 "baz",
+					"path":                    "path/dir",
+					"path.basename":           "dir",
+					"path.filename":           "file_name.yaml",
+					"path.basenameNormalized": "dir",
+					"path.filenameNormalized": "file-name.yaml",
+					"path[0]":                 "path",
+					"path[1]":                 "dir",
+					"commitSha":               "632039659e542ed7de0c170a4fcc1c571b288fc0",
+					"commitAuthor":            "foo <foo@foo.com>",
+					"commitTimestamp":         "2021-06-05T20:00:00Z",
+				},
+			},
+		},
+		{
+			name: "commit parameters are prefixed",
+			args: args{
+				filePath:        "path/dir/file_name.yaml",
+				fileContent:     defaultContent,
+				commit:          commit,
+				values:          map[string]string{},
+				useGoTemplate:   false,
+				pathParamPrefix: "myRepo",
+			},
+			want: []map[string]any{
+				{
+					"foo.bar":                        "baz",
+					"myRepo.path":                    "path/dir",
+					"myRepo.path.basename":           "dir",
+					"myRepo.path.filename":           "file_name.yaml",
+					"myRepo.path.basenameNormalized": "dir",
+					"myRepo.path.filenameNormalized": "file-name.yaml",
+					"myRepo.path[0]":                 "path",
+					"myRepo.path[1]":                 "dir",
+					"myRepo.commitSha":               "632039659e542ed7de0c170a4fcc1c571b288fc0",
+					"myRepo.commitAuthor":            "foo <foo@foo.com>",
+					"myRepo.commitTimestamp":         "2021-06-05T20:00:00Z",
+				},
+			},
+		},
+		{
+			name: "commit parameters are added to params with go template",
+			args: args{
+				filePath:      "path/dir/file_name.yaml",
+				fileContent:   defaultContent,
+				commit:        commit,
+				values:        map[string]string{},
+				useGoTemplate: true,
+			},
+			want: []map[string]any{
+				{
+					"foo": map[string]any{
+						"bar": "baz",
+					},
+					"path": map[string]any{
+						"path":               "path/dir",
+						"basename":           "dir",
+						"filename":           "file_name.yaml",
+						"basenameNormalized": "dir",
+						"filenameNormalized": "file-name.yaml",
+						"segments": []string{
+							"path",
+							"dir",
+						},
+					},
+					"commitSha":       "632039659e542ed7de0c170a4fcc1c571b288fc0",
+					"commitAuthor":    "foo <foo@foo.com>",
+					"commitTimestamp": "2021-06-05T20:00:00Z",
+				},
+			},
+		},
+		{
+			name: "commit parameters do not override the content of the file",
+			args: args{
+				filePath:      "path/dir/file_name.yaml",
+				fileContent:   []byte("commitSha: pinned\ncommitAuthor: me"),
+				commit:        commit,
+				values:        map[string]string{},
+				useGoTemplate: true,
+			},
+			want: []map[string]any{
+				{
+					"path": map[string]any{
+						"path":               "path/dir",
+						"basename":           "dir",
+						"filename":           "file_name.yaml",
+						"basenameNormalized": "dir",
+						"filenameNormalized": "file-name.yaml",
+						"segments": []string{
+							"path",
+							"dir",
+						},
+					},
+					"commitSha":       "pinned",
+					"commitAuthor":    "me",
+					"commitTimestamp": "2021-06-05T20:00:00Z",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			params, err := (*GitGenerator)(nil).generateParamsFromGitFile(tt.args.filePath, tt.args.fileContent, tt.args.values, tt.args.useGoTemplate, tt.args.goTemplateOptions, tt.args.pathParamPrefix)
+			params, err := (*GitGenerator)(nil).generateParamsFromGitFile(tt.args.filePath, tt.args.fileContent, tt.args.commit, tt.args.values, tt.args.useGoTemplate, tt.args.goTemplateOptions, tt.args.pathParamPrefix, tt.args.contentParamName)
 			if tt.wantErr {
 				assert.Error(t, err, "GitGenerator.generateParamsFromGitFile()")
 			} else {
@@ -820,7 +1047,7 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 			},
 			repoPathsError: nil,
 			expected:       []map[string]any{},
-			expectedError:  errors.New("error generating params from git: unable to process file 'cluster-config/production/config.json': unable to parse file: error unmarshaling JSON: while decoding JSON: json: cannot unmarshal string into Go value of type map[string]interface {}"),
+			expectedError:  errors.New("error generating params from git: unable to process file 'cluster-config/production/config.json': unable to parse file: json: cannot unmarshal string into Go value of type map[string]interface {}"),
 		},
 		{
 			name:  "test JSON array",
@@ -986,8 +1213,8 @@ cluster:
 			t.Parallel()
 
 			argoCDServiceMock := mocks.Repos{}
-			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-				Return(testCaseCopy.repoFileContents, testCaseCopy.repoPathsError)
+			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(testCaseCopy.repoFileContents, nil, testCaseCopy.repoPathsError)
 
 			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
 			applicationSetInfo := v1alpha1.ApplicationSet{
@@ -1028,6 +1255,150 @@ cluster:
 	}
 }
 
+func TestGitGenerateParamsFromFilesCommitParams(t *testing.T) {
+	commits := map[string]*apiclient.GitFileCommit{
+		"cluster-config/production/config.json": {Sha: "632039659e542ed7de0c170a4fcc1c571b288fc0", Author: "foo <foo@foo.com>", Date: 1622923200},
+	}
+	for _, commitParams := range []bool{false, true} {
+		t.Run(fmt.Sprintf("commitParams=%t", commitParams), func(t *testing.T) {
+			// The repo-server only returns the commits when they are requested
+			var returnedCommits map[string]*apiclient.GitFileCommit
+			if commitParams {
+				returnedCommits = commits
+			}
+			argoCDServiceMock := mocks.Repos{}
+			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, commitParams).
+				Return(map[string][]byte{"cluster-config/production/config.json": []byte(`{"cluster": "production"}`)}, returnedCommits, nil)
+
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			applicationSetInfo := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set"},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Generators: []v1alpha1.ApplicationSetGenerator{{
+						Git: &v1alpha1.GitGenerator{
+							RepoURL:      "RepoURL",
+							Revision:     "Revision",
+							Files:        []v1alpha1.GitFileGeneratorItem{{Path: "**/config.json"}},
+							CommitParams: commitParams,
+						},
+					}},
+				},
+			}
+			scheme := runtime.NewScheme()
+			require.NoError(t, v1alpha1.AddToScheme(scheme))
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			if commitParams {
+				assert.Equal(t, "632039659e542ed7de0c170a4fcc1c571b288fc0", got[0]["commitSha"])
+			} else {
+				assert.NotContains(t, got[0], "commitSha")
+			}
+			argoCDServiceMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGitGenerateParamsFromFilesSizeLimits(t *testing.T) {
+	repoFileContents := map[string][]byte{
+		"cluster-config/production/config.json": []byte(`{"cluster": {"name": "production"}}`),
+		"cluster-config/staging/config.json":    []byte(`{"cluster": {"name": "staging"}}`),
+	}
+
+	cases := []struct {
+		name          string
+		fileMaxSize   string
+		filesMaxSize  string
+		expectedError string
+	}{
+		{
+			name:         "within the limits",
+			fileMaxSize:  "35",
+			filesMaxSize: "67",
+		},
+		{
+			name:          "file exceeding the limit",
+			fileMaxSize:   "34",
+			expectedError: "error generating params from git: file 'cluster-config/production/config.json' is 35 bytes, which exceeds the maximum size of a file of 34 bytes",
+		},
+		{
+			name:          "files exceeding the limit",
+			filesMaxSize:  "66",
+			expectedError: "error generating params from git: file 'cluster-config/staging/config.json' brings the combined size of the files to 67 bytes, which exceeds the maximum of 66 bytes",
+		},
+		{
+			name:         "unlimited",
+			fileMaxSize:  "0",
+			filesMaxSize: "0",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.fileMaxSize != "" {
+				t.Setenv("ARGOCD_APPLICATIONSET_CONTROLLER_GIT_FILE_MAX_SIZE", testCase.fileMaxSize)
+			}
+			if testCase.filesMaxSize != "" {
+				t.Setenv("ARGOCD_APPLICATIONSET_CONTROLLER_GIT_GENERATOR_FILES_MAX_SIZE", testCase.filesMaxSize)
+			}
+
+			argoCDServiceMock := mocks.Repos{}
+			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(repoFileContents, nil, nil)
+
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			applicationSetInfo := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Generators: []v1alpha1.ApplicationSetGenerator{{
+						Git: &v1alpha1.GitGenerator{
+							RepoURL:  "RepoURL",
+							Revision: "Revision",
+							Files:    []v1alpha1.GitFileGeneratorItem{{Path: "**/config.json"}},
+						},
+					}},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			err := v1alpha1.AddToScheme(scheme)
+			require.NoError(t, err)
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			if testCase.expectedError != "" {
+				require.EqualError(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got, 2)
+		})
+	}
+}
+
+func TestParseGitFile(t *testing.T) {
+	objects, err := parseGitFile([]byte(`
+- name: a
+- null
+---
+name: b
+---
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "a"}, nil, {"name": "b"}}, objects)
+
+	objects, err = parseGitFile([]byte(`[{"name": "a"}, {"name": "b"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "a"}, {"name": "b"}}, objects)
+
+	_, err = parseGitFile([]byte(`[{"name": "a"}, "b"]`))
+	require.EqualError(t, err, "json: cannot unmarshal string into Go value of type map[string]interface {}")
+}
+
 func TestGitGenerateParamsFromFilesGoTemplate(t *testing.T) {
 	cases := []struct {
 		name string
@@ -1132,7 +1503,7 @@ func TestGitGenerateParamsFromFilesGoTemplate(t *testing.T) {
 			},
 			repoPathsError: nil,
 			expected:       []map[string]any{},
-			expectedError:  errors.New("error generating params from git: unable to process file 'cluster-config/production/config.json': unable to parse file: error unmarshaling JSON: while decoding JSON: json: cannot unmarshal string into Go value of type map[string]interface {}"),
+			expectedError:  errors.New("error generating params from git: unable to process file 'cluster-config/production/config.json': unable to parse file: json: cannot unmarshal string into Go value of type map[string]interface {}"),
 		},
 		{
 			name:  "test JSON array",
@@ -1342,8 +1713,8 @@ cluster:
 			t.Parallel()
 
 			argoCDServiceMock := mocks.Repos{}
-			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-				Return(testCaseCopy.repoFileContents, testCaseCopy.repoPathsError)
+			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(testCaseCopy.repoFileContents, nil, testCaseCopy.repoPathsError)
 
 			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
 			applicationSetInfo := v1alpha1.ApplicationSet{
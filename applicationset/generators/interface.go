@@ -28,7 +28,10 @@ type Generator interface {
 
 var (
 	ErrEmptyAppSetGenerator = errors.New("ApplicationSet is empty")
-	NoRequeueAfter          time.Duration
+	// ErrIncompleteParams is wrapped by the errors of the generators which still return the parameter sets they could
+	// generate. The Applications of the missing parameter sets are kept rather than deleted.
+	ErrIncompleteParams = errors.New("some parameter sets could not be generated")
+	NoRequeueAfter      time.Duration
 )
 
 const (
@@ -82,3 +82,47 @@ func TestGenerateListParamsGoTemplate(t *testing.T) {
 		assert.ElementsMatch(t, testCase.expected, got)
 	}
 }
+
+func TestGenerateListParamsElementsYaml(t *testing.T) {
+	listGenerator := NewListGenerator()
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	}
+
+	t.Run("multi-line YAML appended to the elements", func(t *testing.T) {
+		got, err := listGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			List: &argoprojiov1alpha1.ListGenerator{
+				Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev"}`)}},
+				ElementsYaml: `
+- cluster: staging
+  replicas: 2
+- cluster: prod
+  replicas: 3
+  zones: [a, b]
+`,
+			},
+		}, &applicationSetInfo, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{
+			{"cluster": "dev"},
+			{"cluster": "staging", "replicas": float64(2)},
+			{"cluster": "prod", "replicas": float64(3), "zones": []any{"a", "b"}},
+		}, got)
+	})
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		_, err := listGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			List: &argoprojiov1alpha1.ListGenerator{
+				ElementsYaml: "cluster: staging",
+			},
+		}, &applicationSetInfo, nil)
+
+		require.ErrorContains(t, err, "error unmarshling decoded ElementsYaml")
+	})
+}
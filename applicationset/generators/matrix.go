@@ -1,11 +1,13 @@
 package generators
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"dario.cat/mergo"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
@@ -18,6 +20,7 @@ var (
 	ErrMoreThanTwoGenerators      = errors.New("found more than two generators, Matrix support only two")
 	ErrLessThanTwoGenerators      = errors.New("found less than two generators, Matrix support only two")
 	ErrMoreThenOneInnerGenerators = errors.New("found more than one generator in matrix.Generators")
+	ErrNestedTooDeeply            = errors.New("found a Matrix or Merge generator nested in a nested generator, combination-type generators can only be nested once")
 )
 
 type MatrixGenerator struct {
@@ -33,60 +36,78 @@ func NewMatrixGenerator(supportedGenerators map[string]Generator) Generator {
 }
 
 func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	params, _, err := m.generateParamsWithTemplates(appSetGenerator, appSet, client)
+	return params, err
+}
+
+// generateParamsWithTemplates returns the combinations of the parameter sets of both child generators. The template of
+// a combination is the template of the first child generator merged over the template of the second one.
+func (m *MatrixGenerator) generateParamsWithTemplates(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, []argoprojiov1alpha1.ApplicationSetTemplate, error) {
 	if appSetGenerator.Matrix == nil {
-		return nil, ErrEmptyAppSetGenerator
+		return nil, nil, ErrEmptyAppSetGenerator
 	}
 
 	if len(appSetGenerator.Matrix.Generators) < 2 {
-		return nil, ErrLessThanTwoGenerators
+		return nil, nil, ErrLessThanTwoGenerators
 	}
 
 	if len(appSetGenerator.Matrix.Generators) > 2 {
-		return nil, ErrMoreThanTwoGenerators
+		return nil, nil, ErrMoreThanTwoGenerators
 	}
 
 	res := []map[string]any{}
+	var templates []argoprojiov1alpha1.ApplicationSetTemplate
 
-	g0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, client)
+	g0, t0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, client)
 	if err != nil {
-		return nil, fmt.Errorf("error failed to get params for first generator in matrix generator: %w", err)
+		return nil, nil, fmt.Errorf("error failed to get params for first generator in matrix generator: %w", err)
 	}
-	for _, a := range g0 {
-		g1, err := m.getParams(appSetGenerator.Matrix.Generators[1], appSet, a, client)
+	for i, a := range g0 {
+		g1, t1, err := m.getParams(appSetGenerator.Matrix.Generators[1], appSet, a, client)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
+			return nil, nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
 		}
-		for _, b := range g1 {
+		for j, b := range g1 {
 			if appSet.Spec.GoTemplate {
 				tmp := map[string]any{}
 				if err := mergo.Merge(&tmp, b, mergo.WithOverride); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with temp map: %w", err)
+					return nil, nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with temp map: %w", err)
 				}
 				if err := mergo.Merge(&tmp, a, mergo.WithOverride); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with the first: %w", err)
+					return nil, nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with the first: %w", err)
 				}
 				res = append(res, tmp)
 			} else {
 				val, err := utils.CombineStringMaps(a, b)
 				if err != nil {
-					return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+					return nil, nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
 				}
 				res = append(res, utils.ConvertToMapStringInterface(val))
 			}
+			template, err := mergeTemplates(t0[i], t1[j])
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to merge the templates of the generators in the matrix generator: %w", err)
+			}
+			templates = append(templates, template)
 		}
 	}
 
-	return res, nil
+	if !hasTemplates(templates) {
+		return res, nil, nil
+	}
+	return res, templates, nil
 }
 
-func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, client client.Client) ([]map[string]any, error) {
+// getParams returns the parameter sets of the given child generator, along with the template of the child generator
+// applying to each of them.
+func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, client client.Client) ([]map[string]any, []argoprojiov1alpha1.ApplicationSetTemplate, error) {
 	matrixGen, err := getMatrixGenerator(appSetBaseGenerator)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	mergeGen, err := getMergeGenerator(appSetBaseGenerator)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving merge generator: %w", err)
+		return nil, nil, fmt.Errorf("error retrieving merge generator: %w", err)
 	}
 
 	t, err := Transform(
@@ -98,6 +119,9 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			OCI:                     appSetBaseGenerator.OCI,
+			AWSAccounts:             appSetBaseGenerator.AWSAccounts,
+			TerraformState:          appSetBaseGenerator.TerraformState,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -108,18 +132,18 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 		params,
 		client)
 	if err != nil {
-		return nil, fmt.Errorf("child generator returned an error on parameter generation: %w", err)
+		return nil, nil, fmt.Errorf("child generator returned an error on parameter generation: %w", err)
 	}
 
 	if len(t) == 0 {
-		return nil, errors.New("child generator generated no parameters")
+		return nil, nil, errors.New("child generator generated no parameters")
 	}
 
 	if len(t) > 1 {
-		return nil, ErrMoreThenOneInnerGenerators
+		return nil, nil, ErrMoreThenOneInnerGenerators
 	}
 
-	return t[0].Params, nil
+	return t[0].Params, t[0].paramsTemplates(), nil
 }
 
 const maxDuration time.Duration = 1<<63 - 1
@@ -137,6 +161,9 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			OCI:                     r.OCI,
+			AWSAccounts:             r.AWSAccounts,
+			TerraformState:          r.TerraformState,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
@@ -163,6 +190,9 @@ func getMatrixGenerator(r argoprojiov1alpha1.ApplicationSetNestedGenerator) (*ar
 	if r.Matrix == nil {
 		return nil, nil
 	}
+	if err := checkNestingDepth(r.Matrix); err != nil {
+		return nil, err
+	}
 	matrix, err := argoprojiov1alpha1.ToNestedMatrixGenerator(r.Matrix)
 	if err != nil {
 		return nil, err
@@ -170,6 +200,26 @@ func getMatrixGenerator(r argoprojiov1alpha1.ApplicationSetNestedGenerator) (*ar
 	return matrix.ToMatrixGenerator(), nil
 }
 
+// checkNestingDepth returns ErrNestedTooDeeply if a child of the given nested Matrix or Merge generator is itself a
+// Matrix or Merge generator, which would otherwise be silently ignored.
+func checkNestingDepth(j *apiextensionsv1.JSON) error {
+	var nested struct {
+		Generators []map[string]json.RawMessage `json:"generators"`
+	}
+	if err := json.Unmarshal(j.Raw, &nested); err != nil {
+		return fmt.Errorf("error unmarshaling nested generator: %w", err)
+	}
+	for _, g := range nested.Generators {
+		if _, ok := g["matrix"]; ok {
+			return ErrNestedTooDeeply
+		}
+		if _, ok := g["merge"]; ok {
+			return ErrNestedTooDeeply
+		}
+	}
+	return nil
+}
+
 func (m *MatrixGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
 	return &appSetGenerator.Matrix.Template
 }
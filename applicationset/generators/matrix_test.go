@@ -643,7 +643,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 				fakeClient,
 				testCase.clientError,
 			}
-			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace")
+			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace", false, false, nil)
 
 			for _, g := range testCaseCopy.baseGenerators {
 				gitGeneratorSpec := v1alpha1.ApplicationSetGenerator{
@@ -738,6 +738,7 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 							"environment":                    "dev",
 							"argocd.argoproj.io/secret-type": "cluster",
 						},
+						"annotations": map[string]string{},
 					},
 				},
 				{
@@ -755,6 +756,7 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 							"environment":                    "prod",
 							"argocd.argoproj.io/secret-type": "cluster",
 						},
+						"annotations": map[string]string{},
 					},
 				},
 			},
@@ -826,7 +828,7 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 				fakeClient,
 				testCase.clientError,
 			}
-			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace")
+			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace", false, false, nil)
 
 			for _, g := range testCaseCopy.baseGenerators {
 				gitGeneratorSpec := v1alpha1.ApplicationSetGenerator{
@@ -1037,6 +1039,122 @@ func TestMatrixGenerateListElementsYaml(t *testing.T) {
 	}
 }
 
+func TestMatrixGenerateNestedCombinations(t *testing.T) {
+	terminalGenerators := map[string]Generator{
+		"List": &ListGenerator{},
+	}
+	nestedGenerators := map[string]Generator{
+		"List":   terminalGenerators["List"],
+		"Matrix": NewMatrixGenerator(terminalGenerators),
+		"Merge":  NewMergeGenerator(terminalGenerators),
+	}
+
+	testCases := []struct {
+		name           string
+		baseGenerators []v1alpha1.ApplicationSetNestedGenerator
+		expectedErr    error
+		expected       []map[string]any
+	}{
+		{
+			name: "nested matrix generator",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"a": "1"}`)}}},
+				},
+				{
+					Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [
+						{"list": {"elements": [{"b": "1"}, {"b": "2"}]}},
+						{"list": {"elements": [{"c": "{{ .a }}"}]}}
+					]}`)},
+				},
+			},
+			expected: []map[string]any{
+				{"a": "1", "b": "1", "c": "1"},
+				{"a": "1", "b": "2", "c": "1"},
+			},
+		},
+		{
+			name: "nested merge generator",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"a": "1"}`)}, {Raw: []byte(`{"a": "2"}`)}}},
+				},
+				{
+					Merge: &apiextensionsv1.JSON{Raw: []byte(`{"mergeKeys": ["b"], "generators": [
+						{"list": {"elements": [{"b": "1", "c": "base"}, {"b": "2", "c": "base"}]}},
+						{"list": {"elements": [{"b": "2", "c": "override"}]}}
+					]}`)},
+				},
+			},
+			expected: []map[string]any{
+				{"a": "1", "b": "1", "c": "base"},
+				{"a": "1", "b": "2", "c": "override"},
+				{"a": "2", "b": "1", "c": "base"},
+				{"a": "2", "b": "2", "c": "override"},
+			},
+		},
+		{
+			name: "returns error if a nested matrix generator has a matrix child",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"a": "1"}`)}}},
+				},
+				{
+					Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [
+						{"list": {"elements": [{"b": "1"}]}},
+						{"matrix": {"generators": [{"list": {"elements": [{"c": "1"}]}}, {"list": {"elements": [{"d": "1"}]}}]}}
+					]}`)},
+				},
+			},
+			expectedErr: ErrNestedTooDeeply,
+		},
+		{
+			name: "returns error if a nested merge generator has a merge child",
+			baseGenerators: []v1alpha1.ApplicationSetNestedGenerator{
+				{
+					Merge: &apiextensionsv1.JSON{Raw: []byte(`{"mergeKeys": ["b"], "generators": [
+						{"list": {"elements": [{"b": "1"}]}},
+						{"merge": {"mergeKeys": ["b"], "generators": [{"list": {"elements": [{"b": "1"}]}}, {"list": {"elements": [{"b": "1"}]}}]}}
+					]}`)},
+				},
+				{
+					List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"a": "1"}`)}}},
+				},
+			},
+			expectedErr: ErrNestedTooDeeply,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			appSet := &v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: true,
+				},
+			}
+
+			matrixGenerator := NewMatrixGenerator(nestedGenerators)
+
+			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+				Matrix: &v1alpha1.MatrixGenerator{
+					Generators: testCase.baseGenerators,
+				},
+			}, appSet, nil)
+
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+			} else {
+				require.NoError(t, err)
+				// The Merge generator does not preserve the order of its parameter sets
+				assert.ElementsMatch(t, testCase.expected, got)
+			}
+		})
+	}
+}
+
 type generatorMock struct {
 	mock.Mock
 }
@@ -1086,9 +1204,9 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 	}
 
 	repoServiceMock := &mocks.Repos{}
-	repoServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(map[string][]byte{
+	repoServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(map[string][]byte{
 		"some/path.json": []byte("test: content"),
-	}, nil)
+	}, nil, nil)
 	gitGenerator := NewGitGenerator(repoServiceMock, "")
 
 	matrixGenerator := NewMatrixGenerator(map[string]Generator{
@@ -1135,3 +1253,69 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 		"test":                    "content",
 	}}, params)
 }
+
+func TestGitGenerator_GenerateParams_git_x_git_matrix_generator_with_path_param_prefix(t *testing.T) {
+	// Given a matrix generator over two git generators, the pathParamPrefix of each git generator should expose its
+	// path parameters under its own key, so that the parameters of both generators are kept.
+
+	repoServiceMock := &mocks.Repos{}
+	repoServiceMock.On("GetDirectories", mock.Anything, "https://git.example.com/apps", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"apps/guestbook"}, nil)
+	repoServiceMock.On("GetFiles", mock.Anything, "https://git.example.com/targets", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(map[string][]byte{
+		"clusters/dev/config.json": []byte(`{"cluster": "dev"}`),
+	}, nil, nil)
+	gitGenerator := NewGitGenerator(repoServiceMock, "")
+
+	matrixGenerator := NewMatrixGenerator(map[string]Generator{
+		"Git": gitGenerator,
+	})
+
+	matrixGeneratorSpec := &v1alpha1.MatrixGenerator{
+		Generators: []v1alpha1.ApplicationSetNestedGenerator{
+			{
+				Git: &v1alpha1.GitGenerator{
+					RepoURL:         "https://git.example.com/apps",
+					Directories:     []v1alpha1.GitDirectoryGeneratorItem{{Path: "apps/*"}},
+					PathParamPrefix: "app",
+				},
+			},
+			{
+				Git: &v1alpha1.GitGenerator{
+					RepoURL:         "https://git.example.com/targets",
+					Files:           []v1alpha1.GitFileGeneratorItem{{Path: "clusters/*/config.json"}},
+					PathParamPrefix: "target",
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+	params, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+		Matrix: matrixGeneratorSpec,
+	}, &v1alpha1.ApplicationSet{Spec: v1alpha1.ApplicationSetSpec{GoTemplate: true}}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{
+		"app": map[string]any{
+			"path": map[string]any{
+				"path":               "apps/guestbook",
+				"basename":           "guestbook",
+				"basenameNormalized": "guestbook",
+				"segments":           []string{"apps", "guestbook"},
+			},
+		},
+		"target": map[string]any{
+			"path": map[string]any{
+				"path":               "clusters/dev",
+				"basename":           "dev",
+				"basenameNormalized": "dev",
+				"filename":           "config.json",
+				"filenameNormalized": "config.json",
+				"segments":           []string{"clusters", "dev"},
+			},
+		},
+		"cluster": "dev",
+	}}, params)
+}
@@ -1,9 +1,13 @@
 package generators
 
 import (
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"dario.cat/mergo"
@@ -19,6 +23,7 @@ var (
 	ErrLessThanTwoGeneratorsInMerge = errors.New("found less than two generators, Merge requires two or more")
 	ErrNoMergeKeys                  = errors.New("no merge keys were specified, Merge requires at least one")
 	ErrNonUniqueParamSets           = errors.New("the parameters from a generator were not unique by the given mergeKeys, Merge requires all param sets to be unique")
+	ErrInvalidMergePriorities       = errors.New("the priorities of the generators are invalid, Merge requires either no priority or a unique priority for every generator")
 )
 
 type MergeGenerator struct {
@@ -34,73 +39,193 @@ func NewMergeGenerator(supportedGenerators map[string]Generator) Generator {
 	return m
 }
 
-// getParamSetsForAllGenerators generates params for each child generator in a MergeGenerator. Param sets are returned
-// in slices ordered according to the order of the given generators.
-func (m *MergeGenerator) getParamSetsForAllGenerators(generators []argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([][]map[string]any, error) {
-	var paramSets [][]map[string]any
-	for i, generator := range generators {
-		generatorParamSets, err := m.getParams(generator, appSet, client)
+// mergeOverrides holds the parameter sets of a generator overriding the base generator, and the templates applying to
+// each of them, by their merge key.
+type mergeOverrides struct {
+	paramSets map[string]map[string]any
+	templates map[string]argoprojiov1alpha1.ApplicationSetTemplate
+}
+
+// getOverridesForBaseParamSets returns the overrides of the given generator for each parameter set of the base
+// generator, by the merge key of the base parameter set. The generator is interpolated with the base parameter set, so
+// that it may be parameterized by the output of the base generator, and is run once per distinct interpolation.
+func (m *MergeGenerator) getOverridesForBaseParamSets(generator argoprojiov1alpha1.ApplicationSetNestedGenerator, mergeKeys []string, baseParamSetsByMergeKey map[string]map[string]any, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) (map[string]mergeOverrides, error) {
+	appSetGenerator, err := toApplicationSetGenerator(generator)
+	if err != nil {
+		return nil, err
+	}
+
+	overridesByGenerator := map[string]mergeOverrides{}
+	res := make(map[string]mergeOverrides, len(baseParamSetsByMergeKey))
+	for mergeKeyValue, baseParamSet := range baseParamSetsByMergeKey {
+		interpolatedGenerator, err := interpolateMergeGenerator(appSetGenerator, baseParamSet, appSet)
+		if err != nil {
+			return nil, err
+		}
+		interpolatedGeneratorJSON, err := json.Marshal(interpolatedGenerator)
 		if err != nil {
-			return nil, fmt.Errorf("error getting params from generator %d of %d: %w", i+1, len(generators), err)
+			return nil, fmt.Errorf("error marshalling the interpolated generator: %w", err)
+		}
+		overrides, ok := overridesByGenerator[string(interpolatedGeneratorJSON)]
+		if !ok {
+			paramSets, templates, err := m.getParams(interpolatedGenerator, appSet, client)
+			if err != nil {
+				return nil, err
+			}
+			overrides.paramSets, err = getParamSetsByMergeKey(mergeKeys, paramSets)
+			if err != nil {
+				return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
+			}
+			overrides.templates, err = getTemplatesByMergeKey(mergeKeys, paramSets, templates)
+			if err != nil {
+				return nil, fmt.Errorf("error getting templates by merge key: %w", err)
+			}
+			overridesByGenerator[string(interpolatedGeneratorJSON)] = overrides
 		}
-		// concatenate param lists produced by each generator
-		paramSets = append(paramSets, generatorParamSets)
+		res[mergeKeyValue] = overrides
 	}
-	return paramSets, nil
+	return res, nil
+}
+
+// interpolateMergeGenerator returns the given generator interpolated with the given parameter set of the base
+// generator. The values of the generator are left as is, as they reference the parameters of the generator itself.
+func interpolateMergeGenerator(appSetGenerator argoprojiov1alpha1.ApplicationSetGenerator, params map[string]any, appSet *argoprojiov1alpha1.ApplicationSet) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
+	interpolatedGenerator, err := InterpolateGenerator(&appSetGenerator, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+	if err != nil {
+		return argoprojiov1alpha1.ApplicationSetGenerator{}, fmt.Errorf("error interpolating generator with the params of the base generator: %w", err)
+	}
+
+	original := reflect.ValueOf(appSetGenerator)
+	interpolated := reflect.ValueOf(&interpolatedGenerator).Elem()
+	for i := 0; i < original.NumField(); i++ {
+		if original.Field(i).Kind() != reflect.Ptr || original.Field(i).IsNil() || original.Field(i).Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if values := original.Field(i).Elem().FieldByName("Values"); values.IsValid() {
+			interpolated.Field(i).Elem().FieldByName("Values").Set(values)
+		}
+	}
+	return interpolatedGenerator, nil
 }
 
 // GenerateParams gets the params produced by the MergeGenerator.
 func (m *MergeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	params, _, err := m.generateParamsWithTemplates(appSetGenerator, appSet, client)
+	return params, err
+}
+
+// generateParamsWithTemplates gets the params produced by the MergeGenerator. The template of a merged param set is the
+// template of the base generator, overridden by the templates of the generators whose param sets were merged into it.
+func (m *MergeGenerator) generateParamsWithTemplates(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, []argoprojiov1alpha1.ApplicationSetTemplate, error) {
 	if appSetGenerator.Merge == nil {
-		return nil, ErrEmptyAppSetGenerator
+		return nil, nil, ErrEmptyAppSetGenerator
 	}
 
 	if len(appSetGenerator.Merge.Generators) < 2 {
-		return nil, ErrLessThanTwoGeneratorsInMerge
+		return nil, nil, ErrLessThanTwoGeneratorsInMerge
 	}
 
-	paramSetsFromGenerators, err := m.getParamSetsForAllGenerators(appSetGenerator.Merge.Generators, appSet, client)
+	generators, err := sortGeneratorsByPriority(appSetGenerator.Merge.Generators)
 	if err != nil {
-		return nil, fmt.Errorf("error getting param sets from generators: %w", err)
+		return nil, nil, err
 	}
 
-	baseParamSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSetsFromGenerators[0])
+	baseGenerator, err := toApplicationSetGenerator(generators[0])
 	if err != nil {
-		return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
+		return nil, nil, fmt.Errorf("error getting params from generator 1 of %d: %w", len(generators), err)
+	}
+	baseParamSets, baseTemplates, err := m.getParams(baseGenerator, appSet, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting params from generator 1 of %d: %w", len(generators), err)
 	}
 
-	for _, paramSets := range paramSetsFromGenerators[1:] {
-		paramSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSets)
+	baseParamSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, baseParamSets)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting param sets by merge key: %w", err)
+	}
+	baseTemplatesByMergeKey, err := getTemplatesByMergeKey(appSetGenerator.Merge.MergeKeys, baseParamSets, baseTemplates)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting templates by merge key: %w", err)
+	}
+
+	// The other generators are all interpolated with the parameter sets of the base generator, before any of them is
+	// merged into these parameter sets.
+	overridesFromGenerators := make([]map[string]mergeOverrides, 0, len(generators)-1)
+	for i, generator := range generators[1:] {
+		overrides, err := m.getOverridesForBaseParamSets(generator, appSetGenerator.Merge.MergeKeys, baseParamSetsByMergeKey, appSet, client)
 		if err != nil {
-			return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
+			return nil, nil, fmt.Errorf("error getting params from generator %d of %d: %w", i+2, len(generators), err)
 		}
+		overridesFromGenerators = append(overridesFromGenerators, overrides)
+	}
 
+	for _, overrides := range overridesFromGenerators {
 		for mergeKeyValue, baseParamSet := range baseParamSetsByMergeKey {
+			paramSetsByMergeKey, templatesByMergeKey := overrides[mergeKeyValue].paramSets, overrides[mergeKeyValue].templates
 			if overrideParamSet, exists := paramSetsByMergeKey[mergeKeyValue]; exists {
 				if appSet.Spec.GoTemplate {
 					if err := mergo.Merge(&baseParamSet, overrideParamSet, mergo.WithOverride); err != nil {
-						return nil, fmt.Errorf("error merging base param set with override param set: %w", err)
+						return nil, nil, fmt.Errorf("error merging base param set with override param set: %w", err)
 					}
 					baseParamSetsByMergeKey[mergeKeyValue] = baseParamSet
 				} else {
 					overriddenParamSet, err := utils.CombineStringMapsAllowDuplicates(baseParamSet, overrideParamSet)
 					if err != nil {
-						return nil, fmt.Errorf("error combining string maps: %w", err)
+						return nil, nil, fmt.Errorf("error combining string maps: %w", err)
 					}
 					baseParamSetsByMergeKey[mergeKeyValue] = utils.ConvertToMapStringInterface(overriddenParamSet)
 				}
+				template, err := mergeTemplates(templatesByMergeKey[mergeKeyValue], baseTemplatesByMergeKey[mergeKeyValue])
+				if err != nil {
+					return nil, nil, fmt.Errorf("error merging base template with override template: %w", err)
+				}
+				baseTemplatesByMergeKey[mergeKeyValue] = template
 			}
 		}
 	}
 
 	mergedParamSets := make([]map[string]any, len(baseParamSetsByMergeKey))
+	mergedTemplates := make([]argoprojiov1alpha1.ApplicationSetTemplate, len(baseParamSetsByMergeKey))
 	i := 0
-	for _, mergedParamSet := range baseParamSetsByMergeKey {
+	for mergeKeyValue, mergedParamSet := range baseParamSetsByMergeKey {
 		mergedParamSets[i] = mergedParamSet
+		mergedTemplates[i] = baseTemplatesByMergeKey[mergeKeyValue]
 		i++
 	}
 
-	return mergedParamSets, nil
+	if !hasTemplates(mergedTemplates) {
+		return mergedParamSets, nil, nil
+	}
+	return mergedParamSets, mergedTemplates, nil
+}
+
+// sortGeneratorsByPriority returns the given child generators ordered by increasing priority, so that the generator with
+// the lowest priority is the base generator and the ones with higher priorities override it. The generators are
+// returned in their original order if none of them has a priority.
+func sortGeneratorsByPriority(generators []argoprojiov1alpha1.ApplicationSetNestedGenerator) ([]argoprojiov1alpha1.ApplicationSetNestedGenerator, error) {
+	priorities := make(map[int64]bool, len(generators))
+	for _, generator := range generators {
+		if generator.Priority == nil {
+			continue
+		}
+		if priorities[*generator.Priority] {
+			return nil, fmt.Errorf("%w. Duplicate priority was %d", ErrInvalidMergePriorities, *generator.Priority)
+		}
+		priorities[*generator.Priority] = true
+	}
+
+	if len(priorities) == 0 {
+		return generators, nil
+	}
+	if len(priorities) != len(generators) {
+		return nil, fmt.Errorf("%w. %d of %d generators have no priority", ErrInvalidMergePriorities, len(generators)-len(priorities), len(generators))
+	}
+
+	sorted := slices.Clone(generators)
+	slices.SortFunc(sorted, func(a, b argoprojiov1alpha1.ApplicationSetNestedGenerator) int {
+		return cmp.Compare(*a.Priority, *b.Priority)
+	})
+	return sorted, nil
 }
 
 // getParamSetsByMergeKey converts the given list of parameter sets to a map of parameter sets where the key is the
@@ -111,22 +236,12 @@ func getParamSetsByMergeKey(mergeKeys []string, paramSets []map[string]any) (map
 		return nil, ErrNoMergeKeys
 	}
 
-	deDuplicatedMergeKeys := make(map[string]bool, len(mergeKeys))
-	for _, mergeKey := range mergeKeys {
-		deDuplicatedMergeKeys[mergeKey] = false
-	}
-
 	paramSetsByMergeKey := make(map[string]map[string]any, len(paramSets))
 	for _, paramSet := range paramSets {
-		paramSetKey := make(map[string]any)
-		for mergeKey := range deDuplicatedMergeKeys {
-			paramSetKey[mergeKey] = paramSet[mergeKey]
-		}
-		paramSetKeyJSON, err := json.Marshal(paramSetKey)
+		paramSetKeyString, err := getMergeKey(mergeKeys, paramSet)
 		if err != nil {
-			return nil, fmt.Errorf("error marshalling param set key json: %w", err)
+			return nil, err
 		}
-		paramSetKeyString := string(paramSetKeyJSON)
 		if _, exists := paramSetsByMergeKey[paramSetKeyString]; exists {
 			return nil, fmt.Errorf("%w. Duplicate key was %s", ErrNonUniqueParamSets, paramSetKeyString)
 		}
@@ -136,47 +251,101 @@ func getParamSetsByMergeKey(mergeKeys []string, paramSets []map[string]any) (map
 	return paramSetsByMergeKey, nil
 }
 
-// getParams get the parameters generated by this generator.
-func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+// getTemplatesByMergeKey returns the given templates, applying to the parameter set of the same index, by the unique key
+// of their parameter set as determined by the given mergeKeys.
+func getTemplatesByMergeKey(mergeKeys []string, paramSets []map[string]any, templates []argoprojiov1alpha1.ApplicationSetTemplate) (map[string]argoprojiov1alpha1.ApplicationSetTemplate, error) {
+	templatesByMergeKey := make(map[string]argoprojiov1alpha1.ApplicationSetTemplate, len(paramSets))
+	for i, paramSet := range paramSets {
+		paramSetKeyString, err := getMergeKey(mergeKeys, paramSet)
+		if err != nil {
+			return nil, err
+		}
+		templatesByMergeKey[paramSetKeyString] = templates[i]
+	}
+	return templatesByMergeKey, nil
+}
+
+// getMergeKey returns the unique key of the parameter set as determined by the given mergeKeys.
+func getMergeKey(mergeKeys []string, paramSet map[string]any) (string, error) {
+	paramSetKey := make(map[string]any, len(mergeKeys))
+	for _, mergeKey := range mergeKeys {
+		paramSetKey[mergeKey] = getMergeKeyValue(paramSet, mergeKey)
+	}
+	paramSetKeyJSON, err := json.Marshal(paramSetKey)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling param set key json: %w", err)
+	}
+	return string(paramSetKeyJSON), nil
+}
+
+// getMergeKeyValue returns the value of the given merge key in the parameter set. Flat parameters, such as the
+// `values.selector` parameter produced when goTemplate is disabled, take precedence; otherwise a dotted merge key is
+// resolved through the nested parameters produced when goTemplate is enabled. nil is returned if the key is absent.
+func getMergeKeyValue(paramSet map[string]any, mergeKey string) any {
+	if value, ok := paramSet[mergeKey]; ok {
+		return value
+	}
+	var value any = paramSet
+	for _, field := range strings.Split(mergeKey, ".") {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = nested[field]
+	}
+	return value
+}
+
+// toApplicationSetGenerator returns the given child generator as a generator of the ApplicationSet.
+func toApplicationSetGenerator(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
 	matrixGen, err := getMatrixGenerator(appSetBaseGenerator)
 	if err != nil {
-		return nil, err
+		return argoprojiov1alpha1.ApplicationSetGenerator{}, err
 	}
 	mergeGen, err := getMergeGenerator(appSetBaseGenerator)
 	if err != nil {
-		return nil, err
+		return argoprojiov1alpha1.ApplicationSetGenerator{}, err
 	}
 
+	return argoprojiov1alpha1.ApplicationSetGenerator{
+		List:                    appSetBaseGenerator.List,
+		Clusters:                appSetBaseGenerator.Clusters,
+		Git:                     appSetBaseGenerator.Git,
+		SCMProvider:             appSetBaseGenerator.SCMProvider,
+		ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
+		PullRequest:             appSetBaseGenerator.PullRequest,
+		Plugin:                  appSetBaseGenerator.Plugin,
+		OCI:                     appSetBaseGenerator.OCI,
+		AWSAccounts:             appSetBaseGenerator.AWSAccounts,
+		TerraformState:          appSetBaseGenerator.TerraformState,
+		Matrix:                  matrixGen,
+		Merge:                   mergeGen,
+		Selector:                appSetBaseGenerator.Selector,
+	}, nil
+}
+
+// getParams get the parameters generated by this generator, along with the template of the generator applying to each
+// of them.
+func (m *MergeGenerator) getParams(appSetGenerator argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, []argoprojiov1alpha1.ApplicationSetTemplate, error) {
 	t, err := Transform(
-		argoprojiov1alpha1.ApplicationSetGenerator{
-			List:                    appSetBaseGenerator.List,
-			Clusters:                appSetBaseGenerator.Clusters,
-			Git:                     appSetBaseGenerator.Git,
-			SCMProvider:             appSetBaseGenerator.SCMProvider,
-			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
-			PullRequest:             appSetBaseGenerator.PullRequest,
-			Plugin:                  appSetBaseGenerator.Plugin,
-			Matrix:                  matrixGen,
-			Merge:                   mergeGen,
-			Selector:                appSetBaseGenerator.Selector,
-		},
+		appSetGenerator,
 		m.supportedGenerators,
 		argoprojiov1alpha1.ApplicationSetTemplate{},
 		appSet,
 		map[string]any{}, client)
 	if err != nil {
-		return nil, fmt.Errorf("child generator returned an error on parameter generation: %w", err)
+		return nil, nil, fmt.Errorf("child generator returned an error on parameter generation: %w", err)
 	}
 
 	if len(t) == 0 {
-		return nil, errors.New("child generator generated no parameters")
+		return nil, nil, errors.New("child generator generated no parameters")
 	}
 
 	if len(t) > 1 {
-		return nil, ErrMoreThenOneInnerGenerators
+		return nil, nil, ErrMoreThenOneInnerGenerators
 	}
 
-	return t[0].Params, nil
+	return t[0].Params, t[0].paramsTemplates(), nil
 }
 
 func (m *MergeGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
@@ -192,6 +361,9 @@ func (m *MergeGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.App
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			OCI:                     r.OCI,
+			AWSAccounts:             r.AWSAccounts,
+			TerraformState:          r.TerraformState,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
@@ -218,6 +390,9 @@ func getMergeGenerator(r argoprojiov1alpha1.ApplicationSetNestedGenerator) (*arg
 	if r.Merge == nil {
 		return nil, nil
 	}
+	if err := checkNestingDepth(r.Merge); err != nil {
+		return nil, err
+	}
 	merge, err := argoprojiov1alpha1.ToNestedMergeGenerator(r.Merge)
 	if err != nil {
 		return nil, fmt.Errorf("error converting to nested merge generator: %w", err)
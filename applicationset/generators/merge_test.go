@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -36,6 +37,11 @@ func getTerminalListGeneratorMultiple(jsons []string) argoprojiov1alpha1.Applica
 	return generator
 }
 
+func withPriority(generator *argoprojiov1alpha1.ApplicationSetNestedGenerator, priority int64) argoprojiov1alpha1.ApplicationSetNestedGenerator {
+	generator.Priority = &priority
+	return *generator
+}
+
 func listOfMapsToSet(maps []map[string]any) (map[string]bool, error) {
 	set := make(map[string]bool, len(maps))
 	for _, paramMap := range maps {
@@ -105,6 +111,37 @@ func TestMergeGenerate(t *testing.T) {
 				{"a": "a"},
 			},
 		},
+		{
+			name: "generators are ordered by priority",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				withPriority(getNestedListGenerator(`{"a": "1_1","b": "same","c": "1_3"}`), 20),
+				withPriority(getNestedListGenerator(`{"a": "2_1","b": "same"}`), 10),
+				withPriority(getNestedListGenerator(`{"b": "same","c": "3_3"}`), 30),
+			},
+			mergeKeys: []string{"b"},
+			expected: []map[string]any{
+				{"a": "1_1", "b": "same", "c": "3_3"},
+			},
+		},
+		{
+			name: "duplicate priorities",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				withPriority(getNestedListGenerator(`{"a": "1_1","b": "same"}`), 10),
+				withPriority(getNestedListGenerator(`{"a": "2_1","b": "same"}`), 10),
+			},
+			mergeKeys:   []string{"b"},
+			expectedErr: fmt.Errorf("%w. Duplicate priority was 10", ErrInvalidMergePriorities),
+		},
+		{
+			name: "missing priorities",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				withPriority(getNestedListGenerator(`{"a": "1_1","b": "same"}`), 10),
+				*getNestedListGenerator(`{"a": "2_1","b": "same"}`),
+				*getNestedListGenerator(`{"a": "3_1","b": "same"}`),
+			},
+			mergeKeys:   []string{"b"},
+			expectedErr: fmt.Errorf("%w. 2 of 3 generators have no priority", ErrInvalidMergePriorities),
+		},
 		{
 			name: "merge nested matrix with some lists",
 			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
@@ -196,6 +233,46 @@ func TestMergeGenerate(t *testing.T) {
 	}
 }
 
+func TestMergeGenerateGoTemplate(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	}
+
+	mergeGenerator := NewMergeGenerator(
+		map[string]Generator{
+			"List": &ListGenerator{},
+		},
+	)
+
+	// The merge key is nested in the values, which are deep-merged
+	got, err := mergeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Merge: &argoprojiov1alpha1.MergeGenerator{
+			Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: getTerminalListGeneratorMultiple([]string{
+						`{"name": "staging", "values": {"selector": "eu", "kafka": "true", "redis": "false"}}`,
+						`{"name": "production", "values": {"selector": "us", "kafka": "true", "redis": "false"}}`,
+					}).List,
+				},
+				*getNestedListGenerator(`{"values": {"selector": "us", "redis": "true"}}`),
+			},
+			MergeKeys: []string{"values.selector"},
+		},
+	}, appSet, nil)
+	require.NoError(t, err)
+
+	expectedSet, err := listOfMapsToSet([]map[string]any{
+		{"name": "staging", "values": map[string]any{"selector": "eu", "kafka": "true", "redis": "false"}},
+		{"name": "production", "values": map[string]any{"selector": "us", "kafka": "true", "redis": "true"}},
+	})
+	require.NoError(t, err)
+	actualSet, err := listOfMapsToSet(got)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSet, actualSet)
+}
+
 func toAPIExtensionsJSON(t *testing.T, g any) *apiextensionsv1.JSON {
 	t.Helper()
 	resVal, err := json.Marshal(g)
@@ -328,6 +405,39 @@ func TestParamSetsAreUniqueByMergeKeys(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("%w. Duplicate key was %s", ErrNonUniqueParamSets, `{"key1":"a","key2":"a"}`),
 		},
+		{
+			name:      "nested key, unique paramSets",
+			mergeKeys: []string{"values.key"},
+			paramSets: []map[string]any{
+				{"values": map[string]any{"key": "a"}},
+				{"values": map[string]any{"key": "b"}},
+				{"values": "c"},
+			},
+			expected: map[string]map[string]any{
+				`{"values.key":"a"}`:  {"values": map[string]any{"key": "a"}},
+				`{"values.key":"b"}`:  {"values": map[string]any{"key": "b"}},
+				`{"values.key":null}`: {"values": "c"},
+			},
+		},
+		{
+			name:      "nested key, non-unique paramSets",
+			mergeKeys: []string{"values.key"},
+			paramSets: []map[string]any{
+				{"values": map[string]any{"key": "a", "other": "a"}},
+				{"values": map[string]any{"key": "a", "other": "b"}},
+			},
+			expectedErr: fmt.Errorf("%w. Duplicate key was %s", ErrNonUniqueParamSets, `{"values.key":"a"}`),
+		},
+		{
+			name:      "flat key takes precedence over nested key",
+			mergeKeys: []string{"values.key"},
+			paramSets: []map[string]any{
+				{"values.key": "a", "values": map[string]any{"key": "b"}},
+			},
+			expected: map[string]map[string]any{
+				`{"values.key":"a"}`: {"values.key": "a", "values": map[string]any{"key": "b"}},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -347,3 +457,106 @@ func TestParamSetsAreUniqueByMergeKeys(t *testing.T) {
 		})
 	}
 }
+
+// countingGenerator counts the calls to the generator it wraps.
+type countingGenerator struct {
+	Generator
+	calls int
+}
+
+func (g *countingGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	g.calls++
+	return g.Generator.GenerateParams(appSetGenerator, appSet, client)
+}
+
+func TestInterpolatedMergeGenerate(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		goTemplate bool
+		override   string
+	}{
+		{
+			name:       "go template",
+			goTemplate: true,
+			override:   `{"name": "{{ .name }}", "replicas": "{{ if eq .region \"us\" }}3{{ else }}1{{ end }}"}`,
+		},
+		{
+			name:     "fasttemplate",
+			override: `{"name": "{{name}}", "zone": "{{region}}-1"}`,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate}}
+			listGenerator := &countingGenerator{Generator: &ListGenerator{}}
+			mergeGenerator := NewMergeGenerator(map[string]Generator{"List": listGenerator})
+
+			got, err := mergeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				Merge: &argoprojiov1alpha1.MergeGenerator{
+					Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+						{List: getTerminalListGeneratorMultiple([]string{
+							`{"name": "staging", "region": "eu"}`,
+							`{"name": "production", "region": "us"}`,
+						}).List},
+						*getNestedListGenerator(c.override),
+					},
+					MergeKeys: []string{"name"},
+				},
+			}, appSet, nil)
+			require.NoError(t, err)
+			assert.Len(t, got, 2)
+			for _, params := range got {
+				switch params["name"] {
+				case "staging":
+					if c.goTemplate {
+						assert.Equal(t, "1", params["replicas"])
+					} else {
+						assert.Equal(t, "eu-1", params["zone"])
+					}
+				case "production":
+					if c.goTemplate {
+						assert.Equal(t, "3", params["replicas"])
+					} else {
+						assert.Equal(t, "us-1", params["zone"])
+					}
+				default:
+					t.Errorf("unexpected params %v", params)
+				}
+			}
+			assert.Equal(t, 3, listGenerator.calls, "the override generator is run once per distinct interpolation")
+		})
+	}
+
+	t.Run("generators which are not templated are run once", func(t *testing.T) {
+		appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true}}
+		listGenerator := &countingGenerator{Generator: &ListGenerator{}}
+		mergeGenerator := NewMergeGenerator(map[string]Generator{"List": listGenerator})
+
+		got, err := mergeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			Merge: &argoprojiov1alpha1.MergeGenerator{
+				Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+					{List: getTerminalListGeneratorMultiple([]string{`{"name": "staging"}`, `{"name": "production"}`}).List},
+					*getNestedListGenerator(`{"name": "production", "replicas": "3"}`),
+				},
+				MergeKeys: []string{"name"},
+			},
+		}, appSet, nil)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, 2, listGenerator.calls)
+	})
+}
+
+func TestInterpolateMergeGeneratorKeepsValues(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true}}
+	interpolated, err := interpolateMergeGenerator(argoprojiov1alpha1.ApplicationSetGenerator{
+		Git: &argoprojiov1alpha1.GitGenerator{
+			RepoURL:     "https://github.com/argoproj/argocd-example-apps",
+			Revision:    "{{ .path.basename }}",
+			Directories: []argoprojiov1alpha1.GitDirectoryGeneratorItem{{Path: "*"}},
+			Values:      map[string]string{"selector": "{{ .path.basename }}"},
+		},
+	}, map[string]any{"path": map[string]any{"basename": "main"}}, appSet)
+	require.NoError(t, err)
+	assert.Equal(t, "main", interpolated.Git.Revision)
+	assert.Equal(t, map[string]string{"selector": "{{ .path.basename }}"}, interpolated.Git.Values)
+}
@@ -0,0 +1,42 @@
+package generators
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*ObservedGenerator)(nil)
+
+// ObservedGenerator reports the failures of the wrapped generator, e.g. to the metrics of the ApplicationSet
+// controller.
+type ObservedGenerator struct {
+	Generator
+	name    string
+	onError func(appSet *argoprojiov1alpha1.ApplicationSet, generator string)
+}
+
+// WithErrorObserver wraps each generator so that onError is called, with the ApplicationSet and the name of the
+// generator, each time the generator fails to generate the parameters of an ApplicationSet.
+func WithErrorObserver(generators map[string]Generator, onError func(appSet *argoprojiov1alpha1.ApplicationSet, generator string)) map[string]Generator {
+	res := make(map[string]Generator, len(generators))
+	for name, g := range generators {
+		res[name] = &ObservedGenerator{Generator: g, name: name, onError: onError}
+	}
+	return res
+}
+
+func (g *ObservedGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	params, err := g.Generator.GenerateParams(appSetGenerator, appSet, client)
+	if err != nil {
+		g.onError(appSet, g.name)
+	}
+	return params, err
+}
+
+// HandlePullRequestEvent forwards the pull request events to the observed generator, if it handles them.
+func (g *ObservedGenerator) HandlePullRequestEvent(event *PullRequestEvent) {
+	if handler, ok := g.Generator.(PullRequestEventHandler); ok {
+		handler.HandlePullRequestEvent(event)
+	}
+}
@@ -0,0 +1,40 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	genmock "github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestWithErrorObserver(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"}}
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{List: &argoprojiov1alpha1.ListGenerator{}}
+
+	failing := &genmock.Generator{}
+	failing.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return(nil, errors.New("fail"))
+	succeeding := &genmock.Generator{}
+	succeeding.On("GenerateParams", appSetGenerator, mock.Anything, mock.Anything).Return([]map[string]any{{"a": "b"}}, nil)
+
+	var observed []string
+	generators := WithErrorObserver(map[string]Generator{"Git": failing, "List": succeeding}, func(a *argoprojiov1alpha1.ApplicationSet, generator string) {
+		assert.Same(t, appSet, a)
+		observed = append(observed, generator)
+	})
+
+	_, err := generators["Git"].GenerateParams(appSetGenerator, appSet, nil)
+	require.EqualError(t, err, "fail")
+	params, err := generators["List"].GenerateParams(appSetGenerator, appSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"a": "b"}}, params)
+	assert.Equal(t, []string{"Git"}, observed)
+
+	_, ok := generators["List"].(PullRequestEventHandler)
+	assert.True(t, ok, "the pull request events must be forwarded to the observed generators")
+}
@@ -0,0 +1,187 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/oci"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*OCIGenerator)(nil)
+
+const (
+	DefaultOCIRequeueAfter = 30 * time.Minute
+)
+
+// OCIGenerator generates a parameter set for each tag of an OCI repository, or for each version of a chart of a Helm
+// repository.
+type OCIGenerator struct {
+	client             client.Client
+	repos              services.Repos
+	tokenRefStrictMode bool
+	newRegistryFunc    func(context.Context, *argoprojiov1alpha1.OCIGenerator, *argoprojiov1alpha1.ApplicationSet) (oci.Registry, error)
+}
+
+func NewOCIGenerator(client client.Client, repos services.Repos, tokenRefStrictMode bool) Generator {
+	g := &OCIGenerator{
+		client:             client,
+		repos:              repos,
+		tokenRefStrictMode: tokenRefStrictMode,
+	}
+	g.newRegistryFunc = g.newRegistry
+	return g
+}
+
+func (g *OCIGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.OCI.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.OCI.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultOCIRequeueAfter
+}
+
+func (g *OCIGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.OCI.Template
+}
+
+func (g *OCIGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.OCI == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.OCI
+
+	var tagFilter *regexp.Regexp
+	if generatorConfig.TagFilter != "" {
+		var err error
+		tagFilter, err = regexp.Compile(generatorConfig.TagFilter)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling tag filter %q: %w", generatorConfig.TagFilter, err)
+		}
+	}
+	var constraint *semver.Constraints
+	if generatorConfig.SemverConstraint != "" {
+		var err error
+		constraint, err = semver.NewConstraint(generatorConfig.SemverConstraint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing semver constraint %q: %w", generatorConfig.SemverConstraint, err)
+		}
+	}
+
+	ctx := context.Background()
+	tags, err := g.listTags(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]map[string]any, 0, len(tags))
+	for _, tag := range tags {
+		if tagFilter != nil && !tagFilter.MatchString(tag) {
+			continue
+		}
+		version, versionErr := semver.NewVersion(tag)
+		if constraint != nil && (versionErr != nil || !constraint.Check(version)) {
+			continue
+		}
+
+		paramMap := map[string]any{
+			"repoURL": generatorConfig.RepoURL,
+			"tag":     tag,
+		}
+		if generatorConfig.Chart != "" {
+			paramMap["chart"] = generatorConfig.Chart
+		}
+		if versionErr == nil {
+			paramMap["version"] = version.String()
+			paramMap["major"] = strconv.FormatUint(version.Major(), 10)
+			paramMap["minor"] = strconv.FormatUint(version.Minor(), 10)
+			paramMap["patch"] = strconv.FormatUint(version.Patch(), 10)
+			paramMap["prerelease"] = version.Prerelease()
+		}
+
+		err := appendTemplatedValues(generatorConfig.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		params = append(params, paramMap)
+	}
+	return params, nil
+}
+
+// listTags returns the tags of the OCI repository of the generator, or the versions of its chart when the repository is
+// an HTTP(S) Helm repository.
+func (g *OCIGenerator) listTags(ctx context.Context, generatorConfig *argoprojiov1alpha1.OCIGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) ([]string, error) {
+	if generatorConfig.Chart != "" && isHTTPRepoURL(generatorConfig.RepoURL) {
+		// If the project field is templated, we cannot resolve the project name, so we pass an empty string to the repo-server.
+		project := resolveProjectName(applicationSetInfo.Spec.Template.Spec.Project)
+		versions, err := g.repos.GetHelmChartVersions(ctx, generatorConfig.RepoURL, project, generatorConfig.Chart)
+		if err != nil {
+			return nil, fmt.Errorf("error listing chart versions: %w", err)
+		}
+		return versions, nil
+	}
+
+	registry, err := g.newRegistryFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCI registry client: %w", err)
+	}
+	tags, err := registry.ListTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	return tags, nil
+}
+
+// isHTTPRepoURL returns whether the repository is an HTTP(S) Helm repository rather than an OCI repository.
+func isHTTPRepoURL(repoURL string) bool {
+	lower := strings.ToLower(repoURL)
+	return strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://")
+}
+
+// newRegistry returns the client of the registry of the generator, authenticated with its credentials.
+func (g *OCIGenerator) newRegistry(ctx context.Context, generatorConfig *argoprojiov1alpha1.OCIGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (oci.Registry, error) {
+	var creds oci.Credentials
+	if generatorConfig.AWSECR != nil {
+		var err error
+		creds, err = oci.GetAWSECRCredentials(ctx, generatorConfig.AWSECR.Region, generatorConfig.AWSECR.Role)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching AWS ECR credentials: %w", err)
+		}
+	} else {
+		password, err := utils.GetSecretRef(ctx, g.client, generatorConfig.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret password: %w", err)
+		}
+		creds = oci.Credentials{
+			Username: generatorConfig.Username,
+			Password: password,
+		}
+	}
+
+	return oci.NewRegistry(ociRepositoryURL(generatorConfig), creds, generatorConfig.Insecure)
+}
+
+// ociRepositoryURL returns the OCI repository to list the tags of, which is the repository of the chart if any.
+func ociRepositoryURL(generatorConfig *argoprojiov1alpha1.OCIGenerator) string {
+	if generatorConfig.Chart == "" {
+		return generatorConfig.RepoURL
+	}
+	return strings.TrimSuffix(generatorConfig.RepoURL, "/") + "/" + generatorConfig.Chart
+}
@@ -0,0 +1,201 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/oci"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeOCIRegistry struct {
+	tags []string
+	err  error
+}
+
+func (r *fakeOCIRegistry) ListTags(_ context.Context) ([]string, error) {
+	return r.tags, r.err
+}
+
+func TestOCIGenerateParams(t *testing.T) {
+	tags := []string{"latest", "1.0.0", "v1.2.3", "1.3.0-rc.1", "2.0.0", "main"}
+
+	cases := []struct {
+		name        string
+		generator   *argoprojiov1alpha1.OCIGenerator
+		registryErr error
+		expected    []map[string]any
+		expectedErr string
+	}{
+		{
+			name: "all tags",
+			generator: &argoprojiov1alpha1.OCIGenerator{
+				RepoURL: "ghcr.io/argoproj/chart",
+			},
+			expected: []map[string]any{
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "latest"},
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "1.0.0", "version": "1.0.0", "major": "1", "minor": "0", "patch": "0", "prerelease": ""},
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "v1.2.3", "version": "1.2.3", "major": "1", "minor": "2", "patch": "3", "prerelease": ""},
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "1.3.0-rc.1", "version": "1.3.0-rc.1", "major": "1", "minor": "3", "patch": "0", "prerelease": "rc.1"},
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "2.0.0", "version": "2.0.0", "major": "2", "minor": "0", "patch": "0", "prerelease": ""},
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "main"},
+			},
+		},
+		{
+			name: "tag filter",
+			generator: &argoprojiov1alpha1.OCIGenerator{
+				RepoURL:   "ghcr.io/argoproj/chart",
+				TagFilter: "^(latest|main)$",
+			},
+			expected: []map[string]any{
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "latest"},
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "main"},
+			},
+		},
+		{
+			name: "semver constraint",
+			generator: &argoprojiov1alpha1.OCIGenerator{
+				RepoURL:          "ghcr.io/argoproj/chart",
+				SemverConstraint: ">=1.1.0 <2.0.0",
+				Values: map[string]string{
+					"env": "staging",
+				},
+			},
+			expected: []map[string]any{
+				{"repoURL": "ghcr.io/argoproj/chart", "tag": "v1.2.3", "version": "1.2.3", "major": "1", "minor": "2", "patch": "3", "prerelease": "", "values.env": "staging"},
+			},
+		},
+		{
+			name: "invalid tag filter",
+			generator: &argoprojiov1alpha1.OCIGenerator{
+				RepoURL:   "ghcr.io/argoproj/chart",
+				TagFilter: "(",
+			},
+			expectedErr: `error compiling tag filter "("`,
+		},
+		{
+			name: "invalid semver constraint",
+			generator: &argoprojiov1alpha1.OCIGenerator{
+				RepoURL:          "ghcr.io/argoproj/chart",
+				SemverConstraint: "not a constraint",
+			},
+			expectedErr: `error parsing semver constraint "not a constraint"`,
+		},
+		{
+			name: "registry error",
+			generator: &argoprojiov1alpha1.OCIGenerator{
+				RepoURL: "ghcr.io/argoproj/chart",
+			},
+			registryErr: errors.New("unauthorized"),
+			expectedErr: "error listing tags: unauthorized",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &OCIGenerator{
+				newRegistryFunc: func(context.Context, *argoprojiov1alpha1.OCIGenerator, *argoprojiov1alpha1.ApplicationSet) (oci.Registry, error) {
+					return &fakeOCIRegistry{tags: tags, err: c.registryErr}, nil
+				},
+			}
+			generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+				OCI: c.generator,
+			}
+
+			got, err := gen.GenerateParams(&generatorConfig, &argoprojiov1alpha1.ApplicationSet{}, nil)
+			if c.expectedErr != "" {
+				require.ErrorContains(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, got)
+		})
+	}
+}
+
+func TestOCIGenerateParamsHelmRepository(t *testing.T) {
+	repos := &mocks.Repos{}
+	repos.On("GetHelmChartVersions", mock.Anything, "https://charts.example.com", "my-project", "guestbook").
+		Return([]string{"2.0.0", "1.1.0", "1.0.0"}, nil)
+	repos.On("GetHelmChartVersions", mock.Anything, "https://charts.example.com", "my-project", "missing").
+		Return(nil, errors.New("chart missing not found in Helm repository https://charts.example.com"))
+	gen := &OCIGenerator{
+		repos: repos,
+		newRegistryFunc: func(context.Context, *argoprojiov1alpha1.OCIGenerator, *argoprojiov1alpha1.ApplicationSet) (oci.Registry, error) {
+			return nil, errors.New("the index of HTTP repositories must be read by the repo-server")
+		},
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{}
+	appSet.Spec.Template.Spec.Project = "my-project"
+
+	got, err := gen.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		OCI: &argoprojiov1alpha1.OCIGenerator{
+			RepoURL:          "https://charts.example.com",
+			Chart:            "guestbook",
+			SemverConstraint: "^1.0.0",
+		},
+	}, appSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"repoURL": "https://charts.example.com", "chart": "guestbook", "tag": "1.1.0", "version": "1.1.0", "major": "1", "minor": "1", "patch": "0", "prerelease": ""},
+		{"repoURL": "https://charts.example.com", "chart": "guestbook", "tag": "1.0.0", "version": "1.0.0", "major": "1", "minor": "0", "patch": "0", "prerelease": ""},
+	}, got)
+
+	_, err = gen.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		OCI: &argoprojiov1alpha1.OCIGenerator{
+			RepoURL: "https://charts.example.com",
+			Chart:   "missing",
+		},
+	}, appSet, nil)
+	require.EqualError(t, err, "error listing chart versions: chart missing not found in Helm repository https://charts.example.com")
+	repos.AssertExpectations(t)
+}
+
+func TestOCIGenerateParamsOCIChart(t *testing.T) {
+	gen := &OCIGenerator{
+		newRegistryFunc: func(context.Context, *argoprojiov1alpha1.OCIGenerator, *argoprojiov1alpha1.ApplicationSet) (oci.Registry, error) {
+			return &fakeOCIRegistry{tags: []string{"1.0.0"}}, nil
+		},
+	}
+
+	got, err := gen.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		OCI: &argoprojiov1alpha1.OCIGenerator{
+			RepoURL: "oci://ghcr.io/argoproj/argo-helm",
+			Chart:   "argo-cd",
+		},
+	}, &argoprojiov1alpha1.ApplicationSet{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"repoURL": "oci://ghcr.io/argoproj/argo-helm", "chart": "argo-cd", "tag": "1.0.0", "version": "1.0.0", "major": "1", "minor": "0", "patch": "0", "prerelease": ""},
+	}, got)
+}
+
+func TestOCIRepositoryURL(t *testing.T) {
+	assert.Equal(t, "ghcr.io/argoproj/argo-helm/argo-cd", ociRepositoryURL(&argoprojiov1alpha1.OCIGenerator{
+		RepoURL: "ghcr.io/argoproj/argo-helm/argo-cd",
+	}))
+	assert.Equal(t, "oci://ghcr.io/argoproj/argo-helm/argo-cd", ociRepositoryURL(&argoprojiov1alpha1.OCIGenerator{
+		RepoURL: "oci://ghcr.io/argoproj/argo-helm/",
+		Chart:   "argo-cd",
+	}))
+}
+
+func TestOCIGetRequeueAfter(t *testing.T) {
+	gen := NewOCIGenerator(nil, nil, false)
+
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		OCI: &argoprojiov1alpha1.OCIGenerator{},
+	}
+	assert.Equal(t, DefaultOCIRequeueAfter, gen.GetRequeueAfter(&generatorConfig))
+
+	requeueAfterSeconds := int64(60)
+	generatorConfig.OCI.RequeueAfterSeconds = &requeueAfterSeconds
+	assert.Equal(t, time.Minute, gen.GetRequeueAfter(&generatorConfig))
+}
@@ -2,10 +2,11 @@ package generators
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/jeremywohl/flatten"
@@ -14,41 +15,51 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
-	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
 )
 
 const (
 	DefaultPluginRequeueAfter = 30 * time.Minute
+	// pluginRequeueHintMaxAge is the age after which the requeue hints of the generators which are no longer run are
+	// forgotten.
+	pluginRequeueHintMaxAge = 24 * time.Hour
 )
 
 var _ Generator = (*PluginGenerator)(nil)
 
 type PluginGenerator struct {
-	client    client.Client
-	ctx       context.Context
-	clientset kubernetes.Interface
-	namespace string
+	client             client.Client
+	ctx                context.Context
+	clientset          kubernetes.Interface
+	namespace          string
+	tokenRefStrictMode bool
+	hints              *pluginRequeueHints
 }
 
-func NewPluginGenerator(ctx context.Context, client client.Client, clientset kubernetes.Interface, namespace string) Generator {
+func NewPluginGenerator(ctx context.Context, client client.Client, clientset kubernetes.Interface, namespace string, tokenRefStrictMode bool) Generator {
 	g := &PluginGenerator{
-		client:    client,
-		ctx:       ctx,
-		clientset: clientset,
-		namespace: namespace,
+		client:             client,
+		ctx:                ctx,
+		clientset:          clientset,
+		namespace:          namespace,
+		tokenRefStrictMode: tokenRefStrictMode,
+		hints:              newPluginRequeueHints(),
 	}
 	return g
 }
 
+// GetRequeueAfter returns the requeueAfterSeconds of the generator if set. Otherwise, it returns the time left until
+// the parameters are expected to change, as last hinted by the plugin, or a default of 30 minutes.
 func (g *PluginGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
-	// Return a requeue default of 30 minutes, if no default is specified.
-
 	if appSetGenerator.Plugin.RequeueAfterSeconds != nil {
 		return time.Duration(*appSetGenerator.Plugin.RequeueAfterSeconds) * time.Second
 	}
 
+	if requeueAfter, ok := g.hints.get(appSetGenerator.Plugin); ok {
+		return requeueAfter
+	}
+
 	return DefaultPluginRequeueAfter
 }
 
@@ -78,6 +89,10 @@ func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 	if err != nil {
 		return nil, fmt.Errorf("error listing params: %w", err)
 	}
+	if list.Output.RequeueAfterSeconds != nil && *list.Output.RequeueAfterSeconds < 0 {
+		return nil, fmt.Errorf("invalid requeueAfterSeconds returned by the plugin: %d", *list.Output.RequeueAfterSeconds)
+	}
+	g.hints.set(providerConfig, list.Output.RequeueAfterSeconds)
 
 	res, err := g.generateParams(appSetGenerator, applicationSetInfo, list.Output.Parameters, appSetGenerator.Plugin.Input.Parameters, applicationSetInfo.Spec.GoTemplate)
 	if err != nil {
@@ -151,33 +166,7 @@ func (g *PluginGenerator) generateParams(appSetGenerator *argoprojiov1alpha1.App
 }
 
 func (g *PluginGenerator) getToken(ctx context.Context, tokenRef string) (string, error) {
-	if tokenRef == "" || !strings.HasPrefix(tokenRef, "$") {
-		return "", fmt.Errorf("token is empty, or does not reference a secret key starting with '$': %v", tokenRef)
-	}
-
-	secretName, tokenKey := plugin.ParseSecretKey(tokenRef)
-
-	secret := &corev1.Secret{}
-	err := g.client.Get(
-		ctx,
-		client.ObjectKey{
-			Name:      secretName,
-			Namespace: g.namespace,
-		},
-		secret)
-	if err != nil {
-		return "", fmt.Errorf("error fetching secret %s/%s: %w", g.namespace, secretName, err)
-	}
-
-	secretValues := make(map[string]string, len(secret.Data))
-
-	for k, v := range secret.Data {
-		secretValues[k] = string(v)
-	}
-
-	token := settings.ReplaceStringSecret(tokenKey, secretValues)
-
-	return token, err
+	return plugin.GetToken(ctx, g.client, g.namespace, tokenRef, g.tokenRefStrictMode)
 }
 
 func (g *PluginGenerator) getConfigMap(ctx context.Context, configMapRef string) (map[string]string, error) {
@@ -205,3 +194,65 @@ func (g *PluginGenerator) getConfigMap(ctx context.Context, configMapRef string)
 
 	return cm.Data, nil
 }
+
+// pluginRequeueHint is the requeue interval last hinted by the plugin of a generator.
+type pluginRequeueHint struct {
+	requeueAfter time.Duration
+	hintedAt     time.Time
+}
+
+// pluginRequeueHints holds the requeue hints returned by the plugins, by generator. The generators of ApplicationSets
+// with the same spec share their hint.
+type pluginRequeueHints struct {
+	lock  sync.Mutex
+	hints map[string]pluginRequeueHint
+}
+
+func newPluginRequeueHints() *pluginRequeueHints {
+	return &pluginRequeueHints{hints: map[string]pluginRequeueHint{}}
+}
+
+func pluginRequeueHintKey(generator *argoprojiov1alpha1.PluginGenerator) string {
+	data, err := json.Marshal(generator)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// get returns the time left until the next requeue hinted by the plugin of the generator, if any. A hint which is past
+// due requeues the ApplicationSet right away.
+func (h *pluginRequeueHints) get(generator *argoprojiov1alpha1.PluginGenerator) (time.Duration, bool) {
+	key := pluginRequeueHintKey(generator)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	hint, ok := h.hints[key]
+	if !ok || key == "" {
+		return 0, false
+	}
+	if hint.requeueAfter == 0 {
+		return 0, true
+	}
+	return max(hint.requeueAfter-time.Since(hint.hintedAt), time.Second), true
+}
+
+// set records the requeue hint returned by the plugin of the generator, or forgets the previous one when the plugin
+// returned none. The hints which were not refreshed for long are forgotten.
+func (h *pluginRequeueHints) set(generator *argoprojiov1alpha1.PluginGenerator, requeueAfterSeconds *int64) {
+	key := pluginRequeueHintKey(generator)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for k, hint := range h.hints {
+		if time.Since(hint.hintedAt) >= max(hint.requeueAfter, pluginRequeueHintMaxAge) {
+			delete(h.hints, k)
+		}
+	}
+	if requeueAfterSeconds == nil || key == "" {
+		delete(h.hints, key)
+		return
+	}
+	h.hints[key] = pluginRequeueHint{
+		requeueAfter: time.Duration(*requeueAfterSeconds) * time.Second,
+		hintedAt:     time.Now(),
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -670,7 +673,7 @@ func TestPluginGenerateParams(t *testing.T) {
 
 			fakeClientWithCache := fake.NewClientBuilder().WithObjects([]client.Object{testCase.configmap, testCase.secret}...).Build()
 
-			pluginGenerator := NewPluginGenerator(ctx, fakeClientWithCache, fakeClient, "default")
+			pluginGenerator := NewPluginGenerator(ctx, fakeClientWithCache, fakeClient, "default", false)
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -699,3 +702,62 @@ func TestPluginGenerateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginGetRequeueAfter(t *testing.T) {
+	var content string
+	fakeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(content))
+		assert.NoError(t, err)
+	}))
+	defer fakeServer.Close()
+
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "first-plugin-cm", Namespace: "default"},
+		Data:       map[string]string{"baseUrl": fakeServer.URL, "token": "$plugin.token"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "default"},
+		Data:       map[string][]byte{"plugin.token": []byte("my-secret")},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(configmap, secret).Build()
+	pluginGenerator := NewPluginGenerator(t.Context(), fakeClient, kubefake.NewSimpleClientset(), "default", false)
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set"}}
+	newGenerator := func(input string) *argoprojiov1alpha1.ApplicationSetGenerator {
+		return &argoprojiov1alpha1.ApplicationSetGenerator{Plugin: &argoprojiov1alpha1.PluginGenerator{
+			ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: configmap.Name},
+			Input: argoprojiov1alpha1.PluginInput{Parameters: argoprojiov1alpha1.PluginParameters{
+				"input": {Raw: []byte(strconv.Quote(input))},
+			}},
+		}}
+	}
+
+	hourly := newGenerator("hourly")
+	assert.Equal(t, DefaultPluginRequeueAfter, pluginGenerator.GetRequeueAfter(hourly), "no hint before the plugin is called")
+
+	content = `{"output": {"parameters": [], "requeueAfterSeconds": 3600}}`
+	_, err := pluginGenerator.GenerateParams(hourly, appSet, nil)
+	require.NoError(t, err)
+	requeueAfter := pluginGenerator.GetRequeueAfter(hourly)
+	assert.LessOrEqual(t, requeueAfter, time.Hour)
+	assert.Greater(t, requeueAfter, time.Hour-time.Minute)
+	assert.Equal(t, DefaultPluginRequeueAfter, pluginGenerator.GetRequeueAfter(newGenerator("other")), "hints are recorded per generator")
+
+	hourly.Plugin.RequeueAfterSeconds = ptr.To(int64(60))
+	assert.Equal(t, time.Minute, pluginGenerator.GetRequeueAfter(hourly), "the generator takes precedence over the hint")
+	hourly.Plugin.RequeueAfterSeconds = nil
+
+	content = `{"output": {"parameters": [], "requeueAfterSeconds": 0}}`
+	_, err = pluginGenerator.GenerateParams(hourly, appSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), pluginGenerator.GetRequeueAfter(hourly), "a hint of zero disables the requeue")
+
+	content = `{"output": {"parameters": []}}`
+	_, err = pluginGenerator.GenerateParams(hourly, appSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPluginRequeueAfter, pluginGenerator.GetRequeueAfter(hourly), "the hint is forgotten when no longer returned")
+
+	content = `{"output": {"parameters": [], "requeueAfterSeconds": -1}}`
+	_, err = pluginGenerator.GenerateParams(hourly, appSet, nil)
+	require.EqualError(t, err, "invalid requeueAfterSeconds returned by the plugin: -1")
+}
@@ -10,13 +10,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gosimple/slug"
+	log "github.com/sirupsen/logrus"
 
 	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
-var _ Generator = (*PullRequestGenerator)(nil)
+var (
+	_ Generator               = (*PullRequestGenerator)(nil)
+	_ PullRequestEventHandler = (*PullRequestGenerator)(nil)
+)
 
 const (
 	DefaultPullRequestRequeueAfter = 30 * time.Minute
@@ -25,12 +29,14 @@ const (
 type PullRequestGenerator struct {
 	client                    client.Client
 	selectServiceProviderFunc func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error)
+	listings                  *pullRequestListings
 	SCMConfig
 }
 
 func NewPullRequestGenerator(client client.Client, scmConfig SCMConfig) Generator {
 	g := &PullRequestGenerator{
 		client:    client,
+		listings:  newPullRequestListings(),
 		SCMConfig: scmConfig,
 	}
 	g.selectServiceProviderFunc = g.selectServiceProvider
@@ -60,15 +66,9 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		return nil, ErrEmptyAppSetGenerator
 	}
 
-	ctx := context.Background()
-	svc, err := g.selectServiceProviderFunc(ctx, appSetGenerator.PullRequest, applicationSetInfo)
+	pulls, err := g.listPullRequests(context.Background(), appSetGenerator, applicationSetInfo)
 	if err != nil {
-		return nil, fmt.Errorf("failed to select pull request service provider: %w", err)
-	}
-
-	pulls, err := pullrequest.ListPullRequests(ctx, svc, appSetGenerator.PullRequest.Filters)
-	if err != nil {
-		return nil, fmt.Errorf("error listing repos: %w", err)
+		return nil, err
 	}
 	params := make([]map[string]any, 0, len(pulls))
 
@@ -123,6 +123,53 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return params, nil
 }
 
+// HandlePullRequestEvent updates the pull requests last listed by the generators of the repository of the event. They
+// are used by the next reconciliation of the ApplicationSets, rather than listing all the pull requests again.
+func (g *PullRequestGenerator) HandlePullRequestEvent(event *PullRequestEvent) {
+	g.listings.apply(event)
+}
+
+// listPullRequests returns the pull requests of the generator matching its filters. The pull requests listed by the
+// previous reconciliation are used when a webhook event updated them since, as long as they were listed less than a
+// requeue interval ago.
+func (g *PullRequestGenerator) listPullRequests(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) ([]*pullrequest.PullRequest, error) {
+	generatorConfig := appSetGenerator.PullRequest
+	key := ""
+	if supportsPullRequestEvents(generatorConfig) {
+		var err error
+		key, err = pullRequestListingKey(applicationSetInfo, generatorConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute the key of the pull request listing: %w", err)
+		}
+		if pulls, ok := g.listings.getUpdated(key, g.GetRequeueAfter(appSetGenerator)); ok {
+			log.WithField("applicationset", applicationSetInfo.Name).WithField("namespace", applicationSetInfo.Namespace).
+				Debug("using the pull requests updated by webhook")
+			pulls, err = pullrequest.FilterPullRequests(pulls, generatorConfig.Filters)
+			if err != nil {
+				return nil, fmt.Errorf("error listing repos: %w", err)
+			}
+			return pulls, nil
+		}
+	}
+
+	svc, err := g.selectServiceProviderFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select pull request service provider: %w", err)
+	}
+	pulls, err := svc.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repos: %w", err)
+	}
+	if key != "" {
+		g.listings.set(key, generatorConfig, pulls)
+	}
+	pulls, err = pullrequest.FilterPullRequests(pulls, generatorConfig.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repos: %w", err)
+	}
+	return pulls, nil
+}
+
 // selectServiceProvider selects the provider to get pull requests from the configuration
 func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
 	if !g.enableSCMProviders {
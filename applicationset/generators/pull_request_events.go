@@ -0,0 +1,162 @@
+package generators
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// pullRequestListingMaxAge is the age after which the unused pull request listings are forgotten.
+const pullRequestListingMaxAge = 24 * time.Hour
+
+// PullRequestEvent is a change of a pull request received by webhook.
+type PullRequestEvent struct {
+	// Owner and Repo are the GitHub repository of the pull request.
+	Owner string
+	Repo  string
+	// APIRegexp matches the API URLs of the GitHub instance which sent the event.
+	APIRegexp *regexp.Regexp
+	// PullRequest is the pull request, as it would be listed after the event.
+	PullRequest *pullrequest.PullRequest
+	// Closed is true when the pull request was closed or merged, and is no longer listed.
+	Closed bool
+}
+
+// Matches returns whether the given generator lists the pull requests of the repository of the event.
+func (e *PullRequestEvent) Matches(gen *argoprojiov1alpha1.PullRequestGenerator) bool {
+	return GithubGeneratorMatches(gen.Github, e.Owner, e.Repo, e.APIRegexp)
+}
+
+// GithubGeneratorMatches returns whether the given GitHub pull request generator lists the pull requests of the
+// repository owner/repo, on a GitHub instance whose API URLs match apiRegexp.
+func GithubGeneratorMatches(gen *argoprojiov1alpha1.PullRequestGeneratorGithub, owner string, repo string, apiRegexp *regexp.Regexp) bool {
+	if gen == nil {
+		return false
+	}
+	// repository owner and name are case-insensitive
+	// See https://docs.github.com/en/rest/pulls/pulls?apiVersion=2022-11-28#list-pull-requests
+	if !strings.EqualFold(gen.Owner, owner) {
+		return false
+	}
+	if !strings.EqualFold(gen.Repo, repo) {
+		return false
+	}
+	api := gen.API
+	if api == "" {
+		api = "https://api.github.com/"
+	}
+	if !apiRegexp.MatchString(api) {
+		log.Debugf("%s does not match %s", api, apiRegexp.String())
+		return false
+	}
+	return true
+}
+
+// PullRequestEventHandler is implemented by the generators which apply the pull request events received by webhook,
+// rather than listing all the pull requests again.
+type PullRequestEventHandler interface {
+	HandlePullRequestEvent(event *PullRequestEvent)
+}
+
+// pullRequestListing holds the pull requests listed by a generator, updated with the webhook events received since.
+type pullRequestListing struct {
+	generator *argoprojiov1alpha1.PullRequestGenerator
+	pulls     []*pullrequest.PullRequest
+	listedAt  time.Time
+	// updated is true when a webhook event updated the pull requests since they were last used.
+	updated bool
+}
+
+// apply updates the pull requests of the listing with the given event.
+func (l *pullRequestListing) apply(event *PullRequestEvent) {
+	pulls := slices.DeleteFunc(slices.Clone(l.pulls), func(pull *pullrequest.PullRequest) bool {
+		return pull.Number == event.PullRequest.Number
+	})
+	// The pull requests without the labels of the generator are not listed by the provider
+	if !event.Closed && containsAllLabels(event.PullRequest.Labels, l.generator.Github.Labels) {
+		pulls = append(pulls, event.PullRequest)
+	}
+	l.pulls = pulls
+	l.updated = true
+}
+
+func containsAllLabels(labels []string, expectedLabels []string) bool {
+	for _, expected := range expectedLabels {
+		if !slices.Contains(labels, expected) {
+			return false
+		}
+	}
+	return true
+}
+
+// pullRequestListings holds the last pull requests listed by each generator of each ApplicationSet.
+type pullRequestListings struct {
+	lock     sync.Mutex
+	listings map[string]*pullRequestListing
+}
+
+func newPullRequestListings() *pullRequestListings {
+	return &pullRequestListings{listings: map[string]*pullRequestListing{}}
+}
+
+// supportsPullRequestEvents returns whether the pull requests of the generator can be updated from the webhook events.
+// Only the GitHub payloads hold all the fields of the listed pull requests.
+func supportsPullRequestEvents(generator *argoprojiov1alpha1.PullRequestGenerator) bool {
+	return generator.Github != nil
+}
+
+func pullRequestListingKey(appSet *argoprojiov1alpha1.ApplicationSet, generator *argoprojiov1alpha1.PullRequestGenerator) (string, error) {
+	data, err := json.Marshal(generator)
+	if err != nil {
+		return "", err
+	}
+	return appSet.Namespace + "/" + appSet.Name + "/" + string(data), nil
+}
+
+// getUpdated returns the pull requests of the listing if they were updated by a webhook event since they were last
+// used, and were listed less than maxAge ago.
+func (l *pullRequestListings) getUpdated(key string, maxAge time.Duration) ([]*pullrequest.PullRequest, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	listing, ok := l.listings[key]
+	if !ok || !listing.updated || time.Since(listing.listedAt) >= maxAge {
+		return nil, false
+	}
+	listing.updated = false
+	return slices.Clone(listing.pulls), true
+}
+
+// set records the pull requests listed by the generator, and forgets the listings which were not refreshed for long.
+func (l *pullRequestListings) set(key string, generator *argoprojiov1alpha1.PullRequestGenerator, pulls []*pullrequest.PullRequest) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for k, listing := range l.listings {
+		if time.Since(listing.listedAt) >= pullRequestListingMaxAge {
+			delete(l.listings, k)
+		}
+	}
+	l.listings[key] = &pullRequestListing{
+		generator: generator.DeepCopy(),
+		pulls:     slices.Clone(pulls),
+		listedAt:  time.Now(),
+	}
+}
+
+// apply updates the listings of the generators matching the event.
+func (l *pullRequestListings) apply(event *PullRequestEvent) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, listing := range l.listings {
+		if event.Matches(listing.generator) {
+			listing.apply(event)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package generators
 import (
 	"context"
 	"errors"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -368,3 +370,73 @@ func TestSCMProviderDisabled_PRGenerator(t *testing.T) {
 	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
 	assert.ErrorIs(t, err, ErrSCMProvidersDisabled)
 }
+
+func TestPullRequestGeneratorWebhookEvents(t *testing.T) {
+	listed := []*pullrequest.PullRequest{
+		{Number: 1, Title: "title1", Branch: "branch1", TargetBranch: "main", HeadSHA: "089d92cbf9ff857a39e6feccd32798ca700fb958", Labels: []string{"preview"}, Author: "author1"},
+	}
+	lists := 0
+	gen := PullRequestGenerator{
+		selectServiceProviderFunc: func(ctx context.Context, _ *argoprojiov1alpha1.PullRequestGenerator, _ *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+			lists++
+			return pullrequest.NewFakeService(ctx, listed, nil)
+		},
+		listings: newPullRequestListings(),
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+	}
+	generatorConfig := &argoprojiov1alpha1.ApplicationSetGenerator{
+		PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
+			Github: &argoprojiov1alpha1.PullRequestGeneratorGithub{Owner: "org", Repo: "repo", Labels: []string{"preview"}},
+		},
+	}
+	event := func(pull *pullrequest.PullRequest, closed bool) *PullRequestEvent {
+		return &PullRequestEvent{Owner: "org", Repo: "repo", APIRegexp: regexp.MustCompile(`^https://api\.github\.com/$`), PullRequest: pull, Closed: closed}
+	}
+	numbers := func(t *testing.T) []string {
+		t.Helper()
+		params, err := gen.GenerateParams(generatorConfig, appSet, nil)
+		require.NoError(t, err)
+		numbers := []string{}
+		for _, param := range params {
+			numbers = append(numbers, param["number"].(string))
+		}
+		return numbers
+	}
+
+	assert.Equal(t, []string{"1"}, numbers(t))
+	assert.Equal(t, 1, lists)
+
+	// Without event, the pull requests are listed again
+	assert.Equal(t, []string{"1"}, numbers(t))
+	assert.Equal(t, 2, lists)
+
+	// The opened pull request is used by the next generation only
+	gen.HandlePullRequestEvent(event(&pullrequest.PullRequest{Number: 2, Branch: "branch2", TargetBranch: "main", HeadSHA: "ec26c3e57ca3a959ca5aad62de7213c562f8c821", Labels: []string{"preview"}}, false))
+	assert.Equal(t, []string{"1", "2"}, numbers(t))
+	assert.Equal(t, 2, lists)
+	assert.Equal(t, []string{"1"}, numbers(t))
+	assert.Equal(t, 3, lists)
+
+	// The closed pull requests and the pull requests without the labels of the generator are removed
+	gen.HandlePullRequestEvent(event(&pullrequest.PullRequest{Number: 1}, true))
+	gen.HandlePullRequestEvent(event(&pullrequest.PullRequest{Number: 3, Branch: "branch3"}, false))
+	assert.Equal(t, []string{}, numbers(t))
+	assert.Equal(t, 3, lists)
+
+	// The events of other repositories are ignored
+	otherRepoEvent := event(&pullrequest.PullRequest{Number: 4, Labels: []string{"preview"}}, false)
+	otherRepoEvent.Repo = "other"
+	gen.HandlePullRequestEvent(otherRepoEvent)
+	assert.Equal(t, []string{"1"}, numbers(t))
+	assert.Equal(t, 4, lists)
+
+	// The pull requests are listed again once they are older than the requeue interval
+	gen.HandlePullRequestEvent(event(&pullrequest.PullRequest{Number: 1}, true))
+	for _, listing := range gen.listings.listings {
+		listing.listedAt = time.Now().Add(-DefaultPullRequestRequeueAfter)
+	}
+	assert.Equal(t, []string{"1"}, numbers(t))
+	assert.Equal(t, 5, lists)
+}
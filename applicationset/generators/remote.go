@@ -0,0 +1,85 @@
+package generators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generatorserver/apiclient"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var (
+	_ Generator               = (*RemoteGenerator)(nil)
+	_ PullRequestEventHandler = (*RemoteGenerator)(nil)
+)
+
+// RemoteGenerator runs the wrapped generator in the generator service instead of the ApplicationSet controller, so
+// that the calls to the SCM providers and Git repositories do not slow down the reconciliation loop. The template and
+// the requeue delay of the generator are still computed locally.
+type RemoteGenerator struct {
+	Generator
+	ctx       context.Context
+	name      string
+	clientset apiclient.Clientset
+}
+
+func NewRemoteGenerator(ctx context.Context, name string, generator Generator, clientset apiclient.Clientset) Generator {
+	return &RemoteGenerator{
+		Generator: generator,
+		ctx:       ctx,
+		name:      name,
+		clientset: clientset,
+	}
+}
+
+func (g *RemoteGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	generatorClient, err := g.clientset.NewGeneratorServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating generator server client: %w", err)
+	}
+
+	res, err := generatorClient.GenerateParams(g.ctx, &apiclient.GenerateParamsRequest{
+		Generator:      g.name,
+		GeneratorSpec:  appSetGenerator,
+		ApplicationSet: appSet,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error running the %s generator in the generator service: %w", g.name, err)
+	}
+
+	var params []map[string]any
+	if err := json.Unmarshal(res.Params, &params); err != nil {
+		return nil, fmt.Errorf("error unmarshaling params: %w", err)
+	}
+	return params, nil
+}
+
+// HandlePullRequestEvent forwards the pull request events to the generator service, where the pull requests are
+// listed.
+func (g *RemoteGenerator) HandlePullRequestEvent(event *PullRequestEvent) {
+	generatorClient, err := g.clientset.NewGeneratorServerClient()
+	if err != nil {
+		log.Errorf("error creating generator server client: %v", err)
+		return
+	}
+	_, err = generatorClient.HandlePullRequestEvent(g.ctx, &apiclient.PullRequestEventRequest{
+		Owner:        event.Owner,
+		Repo:         event.Repo,
+		ApiRegexp:    event.APIRegexp.String(),
+		Number:       int64(event.PullRequest.Number),
+		Title:        event.PullRequest.Title,
+		Branch:       event.PullRequest.Branch,
+		TargetBranch: event.PullRequest.TargetBranch,
+		HeadSHA:      event.PullRequest.HeadSHA,
+		Labels:       event.PullRequest.Labels,
+		Author:       event.PullRequest.Author,
+		Closed:       event.Closed,
+	})
+	if err != nil {
+		log.Errorf("error forwarding the pull request event to the generator service: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -257,12 +258,20 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			"url":              repo.URL,
 			"branch":           repo.Branch,
 			"sha":              repo.SHA,
+			"branchSha":        repo.SHA,
 			"short_sha":        repo.SHA[:shortSHALength],
 			"short_sha_7":      repo.SHA[:shortSHALength7],
 			"labels":           strings.Join(repo.Labels, ","),
 			"branchNormalized": utils.SanitizeName(repo.Branch),
 		}
 
+		// fasttemplate only substitutes string values.
+		if applicationSetInfo.Spec.GoTemplate {
+			params["branchProtected"] = repo.Protected
+		} else {
+			params["branchProtected"] = strconv.FormatBool(repo.Protected)
+		}
+
 		err := appendTemplatedValues(appSetGenerator.SCMProvider.Values, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
 		if err != nil {
 			return nil, fmt.Errorf("failed to append templated values: %w", err)
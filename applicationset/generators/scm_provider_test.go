@@ -29,6 +29,7 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 					URL:          "git@github.com:myorg/repo1.git",
 					Branch:       "main",
 					SHA:          "0bc57212c3cbbec69d20b34c507284bd300def5b",
+					Protected:    true,
 					Labels:       []string{"prod", "staging"},
 				},
 				{
@@ -48,7 +49,9 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 					"url":              "git@github.com:myorg/repo1.git",
 					"branch":           "main",
 					"branchNormalized": "main",
+					"branchProtected":  "true",
 					"sha":              "0bc57212c3cbbec69d20b34c507284bd300def5b",
+					"branchSha":        "0bc57212c3cbbec69d20b34c507284bd300def5b",
 					"short_sha":        "0bc57212",
 					"short_sha_7":      "0bc5721",
 					"labels":           "prod,staging",
@@ -60,7 +63,9 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 					"url":              "git@github.com:myorg/repo2.git",
 					"branch":           "main",
 					"branchNormalized": "main",
+					"branchProtected":  "false",
 					"sha":              "59d0",
+					"branchSha":        "59d0",
 					"short_sha":        "59d0",
 					"short_sha_7":      "59d0",
 					"labels":           "",
@@ -92,7 +97,9 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 					"url":                           "git@github.com:myorg/repo3.git",
 					"branch":                        "main",
 					"branchNormalized":              "main",
+					"branchProtected":               "false",
 					"sha":                           "0bc57212c3cbbec69d20b34c507284bd300def5b",
+					"branchSha":                     "0bc57212c3cbbec69d20b34c507284bd300def5b",
 					"short_sha":                     "0bc57212",
 					"short_sha_7":                   "0bc5721",
 					"labels":                        "prod,staging",
@@ -128,7 +135,9 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 					"url":              "git@github.com:myorg/repo4.git",
 					"branch":           "main",
 					"branchNormalized": "main",
+					"branchProtected":  "false",
 					"sha":              "0bc57212c3cbbec69d20b34c507284bd300def5b",
+					"branchSha":        "0bc57212c3cbbec69d20b34c507284bd300def5b",
 					"short_sha":        "0bc57212",
 					"short_sha_7":      "0bc5721",
 					"labels":           "",
@@ -140,7 +149,9 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 					"url":              "git@github.com:myorg/repo5.git",
 					"branch":           "main",
 					"branchNormalized": "main",
+					"branchProtected":  "false",
 					"sha":              "0bc57212c3cbbec69d20b34c507284bd300def5b",
+					"branchSha":        "0bc57212c3cbbec69d20b34c507284bd300def5b",
 					"short_sha":        "0bc57212",
 					"short_sha_7":      "0bc5721",
 					"labels":           "",
@@ -184,6 +195,50 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 	}
 }
 
+func TestSCMProviderGenerateParamsGoTemplate(t *testing.T) {
+	mockProvider := &scm_provider.MockProvider{
+		Repos: []*scm_provider.Repository{
+			{
+				Organization: "myorg",
+				Repository:   "repo1",
+				URL:          "git@github.com:myorg/repo1.git",
+				Branch:       "main",
+				SHA:          "0bc57212c3cbbec69d20b34c507284bd300def5b",
+				Protected:    true,
+			},
+			{
+				Organization: "myorg",
+				Repository:   "repo1",
+				URL:          "git@github.com:myorg/repo1.git",
+				Branch:       "feature/foo",
+				SHA:          "59d0",
+			},
+		},
+	}
+	scmGenerator := &SCMProviderGenerator{overrideProvider: mockProvider, SCMConfig: SCMConfig{enableSCMProviders: true}}
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+				SCMProvider: &argoprojiov1alpha1.SCMProviderGenerator{},
+			}},
+		},
+	}
+
+	got, err := scmGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	// branchProtected is a boolean with Go templates, so that it can be used in conditions.
+	assert.Equal(t, map[string]any{"main": true, "feature-foo": false}, map[string]any{
+		got[0]["branchNormalized"].(string): got[0]["branchProtected"],
+		got[1]["branchNormalized"].(string): got[1]["branchProtected"],
+	})
+	assert.Equal(t, "0bc57212c3cbbec69d20b34c507284bd300def5b", got[0]["branchSha"])
+}
+
 func TestAllowedSCMProvider(t *testing.T) {
 	cases := []struct {
 		name           string
@@ -0,0 +1,294 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremywohl/flatten"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/terraform_state"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+var _ Generator = (*TerraformStateGenerator)(nil)
+
+const (
+	DefaultTerraformStateRequeueAfter = 10 * time.Minute
+)
+
+// TerraformStateConfig is what the administrator of the controller allows the Terraform State generators to use. The
+// zero value only allows the states read with the credentials of the generators, from AWS S3, Google Cloud Storage and
+// HCP Terraform.
+type TerraformStateConfig struct {
+	// AllowPodCredentials allows the states to be read with the identity of the pod, such as IRSA or Workload
+	// Identity, including assuming the AWS role of a generator with it.
+	AllowPodCredentials bool
+	// AllowSensitiveOutputs allows the generators to include the sensitive outputs in the parameters.
+	AllowSensitiveOutputs bool
+	// AllowInsecure allows the http backends to skip the verification of the TLS certificates.
+	AllowInsecure bool
+	// AllowedURLs are the glob patterns of the URLs of the http backends, of the S3 endpoints and of the HCP Terraform
+	// or Terraform Enterprise instances the generators may reach, in addition to AWS S3 and HCP Terraform.
+	AllowedURLs []string
+}
+
+// TerraformStateGenerator generates parameter sets from the outputs of a Terraform or OpenTofu state.
+type TerraformStateGenerator struct {
+	client             client.Client
+	tokenRefStrictMode bool
+	config             TerraformStateConfig
+	newReaderFunc      func(context.Context, *argoprojiov1alpha1.TerraformStateGenerator, *argoprojiov1alpha1.ApplicationSet) (terraform_state.Reader, error)
+}
+
+func NewTerraformStateGenerator(client client.Client, tokenRefStrictMode bool, config TerraformStateConfig) Generator {
+	g := &TerraformStateGenerator{
+		client:             client,
+		tokenRefStrictMode: tokenRefStrictMode,
+		config:             config,
+	}
+	g.newReaderFunc = g.newReader
+	return g
+}
+
+func (g *TerraformStateGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 10 minutes, if no default is specified.
+
+	if appSetGenerator.TerraformState.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.TerraformState.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultTerraformStateRequeueAfter
+}
+
+func (g *TerraformStateGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.TerraformState.Template
+}
+
+func (g *TerraformStateGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.TerraformState == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.TerraformState
+	if generatorConfig.IncludeSensitive && !g.config.AllowSensitiveOutputs {
+		return nil, errors.New("includeSensitive is not allowed by the ApplicationSet controller")
+	}
+
+	ctx := context.Background()
+	reader, err := g.newReaderFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Terraform state reader: %w", err)
+	}
+
+	outputs, err := reader.ReadOutputs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Terraform state outputs: %w", err)
+	}
+
+	values := map[string]any{}
+	for name, output := range outputs {
+		if output.Sensitive && !generatorConfig.IncludeSensitive {
+			continue
+		}
+		values[name] = output.Value
+	}
+
+	elements := []map[string]any{{}}
+	if generatorConfig.ElementsFrom != "" {
+		elements, err = terraformStateElements(outputs, values, generatorConfig.ElementsFrom)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	params := make([]map[string]any, 0, len(elements))
+	for _, element := range elements {
+		element["outputs"] = values
+
+		paramMap := element
+		if !applicationSetInfo.Spec.GoTemplate {
+			flat, err := flatten.Flatten(element, "", flatten.DotStyle)
+			if err != nil {
+				return nil, fmt.Errorf("error flattening Terraform state outputs: %w", err)
+			}
+			paramMap = make(map[string]any, len(flat))
+			for k, v := range flat {
+				paramMap[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		err := appendTemplatedValues(generatorConfig.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		params = append(params, paramMap)
+	}
+	return params, nil
+}
+
+// terraformStateElements returns the parameters of each element of the output holding a list or a map. The fields of
+// an element which is an object are parameters, while other elements are passed as value. The elements of a list also
+// have their index, and those of a map their key, in the order of the keys.
+func terraformStateElements(outputs map[string]terraform_state.Output, values map[string]any, name string) ([]map[string]any, error) {
+	if _, ok := outputs[name]; !ok {
+		return nil, fmt.Errorf("output %s of elementsFrom not found in the Terraform state", name)
+	}
+	value, ok := values[name]
+	if !ok {
+		return nil, fmt.Errorf("output %s of elementsFrom is sensitive, and includeSensitive is not set", name)
+	}
+
+	newElement := func(v any) map[string]any {
+		if fields, ok := v.(map[string]any); ok {
+			element := make(map[string]any, len(fields))
+			for k, field := range fields {
+				element[k] = field
+			}
+			return element
+		}
+		return map[string]any{"value": v}
+	}
+
+	var elements []map[string]any
+	switch v := value.(type) {
+	case []any:
+		for i, item := range v {
+			element := newElement(item)
+			element["index"] = i
+			elements = append(elements, element)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			element := newElement(v[key])
+			element["key"] = key
+			elements = append(elements, element)
+		}
+	default:
+		return nil, fmt.Errorf("output %s of elementsFrom must be a list or a map, found %T", name, value)
+	}
+	return elements, nil
+}
+
+// newReader returns the reader of the backend of the generator, authenticated with the identity of the pod or with the
+// credentials of the generator.
+func (g *TerraformStateGenerator) newReader(ctx context.Context, generatorConfig *argoprojiov1alpha1.TerraformStateGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (terraform_state.Reader, error) {
+	var backends []string
+	for name, set := range map[string]bool{
+		"s3":    generatorConfig.S3 != nil,
+		"gcs":   generatorConfig.GCS != nil,
+		"http":  generatorConfig.HTTP != nil,
+		"cloud": generatorConfig.Cloud != nil,
+	} {
+		if set {
+			backends = append(backends, name)
+		}
+	}
+	switch len(backends) {
+	case 0:
+		return nil, errors.New("one of s3, gcs, http or cloud must be set")
+	case 1:
+	default:
+		sort.Strings(backends)
+		return nil, fmt.Errorf("only one of s3, gcs, http or cloud may be set, found %s", strings.Join(backends, ", "))
+	}
+
+	namespace := applicationSetInfo.Namespace
+	switch {
+	case generatorConfig.S3 != nil:
+		s3 := generatorConfig.S3
+		if (s3.AccessKeyIDRef == nil) != (s3.SecretAccessKeyRef == nil) {
+			return nil, errors.New("accessKeyIDRef and secretAccessKeyRef must be set together")
+		}
+		accessKeyID, err := utils.GetSecretRef(ctx, g.client, s3.AccessKeyIDRef, namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret access key ID: %w", err)
+		}
+		secretAccessKey, err := utils.GetSecretRef(ctx, g.client, s3.SecretAccessKeyRef, namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret secret access key: %w", err)
+		}
+		if accessKeyID == "" && !g.config.AllowPodCredentials {
+			return nil, errors.New("accessKeyIDRef is required: reading the state with the identity of the ApplicationSet controller is not allowed")
+		}
+		if s3.Endpoint != "" {
+			if err := g.checkURLAllowed(s3.Endpoint); err != nil {
+				return nil, err
+			}
+		}
+		return terraform_state.NewS3Reader(s3.Bucket, s3.Key, s3.Region, s3.Endpoint, s3.Role, terraform_state.AWSCredentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		})
+	case generatorConfig.GCS != nil:
+		gcs := generatorConfig.GCS
+		credentials, err := utils.GetSecretRef(ctx, g.client, gcs.CredentialsRef, namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret credentials: %w", err)
+		}
+		if credentials == "" && !g.config.AllowPodCredentials {
+			return nil, errors.New("credentialsRef is required: reading the state with the identity of the ApplicationSet controller is not allowed")
+		}
+		return terraform_state.NewGCSReader(ctx, gcs.Bucket, gcs.Object, credentials)
+	case generatorConfig.HTTP != nil:
+		http := generatorConfig.HTTP
+		if (http.UsernameRef == nil) != (http.PasswordRef == nil) {
+			return nil, errors.New("usernameRef and passwordRef must be set together")
+		}
+		if err := g.checkURLAllowed(http.URL); err != nil {
+			return nil, err
+		}
+		if http.Insecure && !g.config.AllowInsecure {
+			return nil, errors.New("insecure is not allowed by the ApplicationSet controller")
+		}
+		username, err := utils.GetSecretRef(ctx, g.client, http.UsernameRef, namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret username: %w", err)
+		}
+		password, err := utils.GetSecretRef(ctx, g.client, http.PasswordRef, namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret password: %w", err)
+		}
+		return terraform_state.NewHTTPReader(http.URL, username, password, http.Insecure, g.checkURLAllowed), nil
+	default:
+		cloud := generatorConfig.Cloud
+		if cloud.TokenRef == nil {
+			return nil, errors.New("tokenRef is required to read the outputs of a workspace")
+		}
+		if cloud.Hostname != "" && cloud.Hostname != terraform_state.DefaultCloudHostname {
+			if err := g.checkURLAllowed("https://" + cloud.Hostname); err != nil {
+				return nil, err
+			}
+		}
+		token, err := utils.GetSecretRef(ctx, g.client, cloud.TokenRef, namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret token: %w", err)
+		}
+		return terraform_state.NewCloudReader(cloud.Hostname, cloud.Organization, cloud.Workspace, token, generatorConfig.IncludeSensitive), nil
+	}
+}
+
+// checkURLAllowed returns an error if the URL does not match the URLs allowed by the controller, so that the
+// ApplicationSets cannot make the controller send requests to arbitrary hosts.
+func (g *TerraformStateGenerator) checkURLAllowed(u string) error {
+	if !glob.MatchStringInList(g.config.AllowedURLs, u, glob.GLOB) {
+		return fmt.Errorf("URL %s is not allowed by the ApplicationSet controller", u)
+	}
+	return nil
+}
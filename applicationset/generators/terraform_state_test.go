@@ -0,0 +1,309 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/terraform_state"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeTerraformStateReader struct {
+	outputs map[string]terraform_state.Output
+	err     error
+}
+
+func (r *fakeTerraformStateReader) ReadOutputs(_ context.Context) (map[string]terraform_state.Output, error) {
+	return r.outputs, r.err
+}
+
+func TestTerraformStateGenerateParams(t *testing.T) {
+	outputs := map[string]terraform_state.Output{
+		"vpc_id": {Value: "vpc-123"},
+		"clusters": {Value: []any{
+			map[string]any{"name": "prod", "endpoint": "https://prod.example.com"},
+			map[string]any{"name": "staging", "endpoint": "https://staging.example.com"},
+		}},
+		"regions":  {Value: map[string]any{"us": "us-east-1", "eu": "eu-west-1"}},
+		"password": {Value: "s3cr3t", Sensitive: true},
+	}
+
+	cases := []struct {
+		name        string
+		generator   *argoprojiov1alpha1.TerraformStateGenerator
+		config      TerraformStateConfig
+		goTemplate  bool
+		readerErr   error
+		expected    []map[string]any
+		expectedErr string
+	}{
+		{
+			name: "flat outputs",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				Values: map[string]string{"vpc": "{{outputs.vpc_id}}"},
+			},
+			expected: []map[string]any{
+				{
+					"outputs.vpc_id":              "vpc-123",
+					"outputs.clusters.0.name":     "prod",
+					"outputs.clusters.0.endpoint": "https://prod.example.com",
+					"outputs.clusters.1.name":     "staging",
+					"outputs.clusters.1.endpoint": "https://staging.example.com",
+					"outputs.regions.us":          "us-east-1",
+					"outputs.regions.eu":          "eu-west-1",
+					"values.vpc":                  "vpc-123",
+				},
+			},
+		},
+		{
+			name: "go template outputs with sensitive outputs",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				IncludeSensitive: true,
+			},
+			config:     TerraformStateConfig{AllowSensitiveOutputs: true},
+			goTemplate: true,
+			expected: []map[string]any{
+				{
+					"outputs": map[string]any{
+						"vpc_id":   "vpc-123",
+						"clusters": outputs["clusters"].Value,
+						"regions":  outputs["regions"].Value,
+						"password": "s3cr3t",
+					},
+				},
+			},
+		},
+		{
+			name: "elements of a list",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				ElementsFrom: "clusters",
+				Values:       map[string]string{"app": "{{ .name }}-{{ .outputs.vpc_id }}"},
+			},
+			goTemplate: true,
+			expected: []map[string]any{
+				{
+					"name":     "prod",
+					"endpoint": "https://prod.example.com",
+					"index":    0,
+					"outputs": map[string]any{
+						"vpc_id":   "vpc-123",
+						"clusters": outputs["clusters"].Value,
+						"regions":  outputs["regions"].Value,
+					},
+					"values": map[string]string{"app": "prod-vpc-123"},
+				},
+				{
+					"name":     "staging",
+					"endpoint": "https://staging.example.com",
+					"index":    1,
+					"outputs": map[string]any{
+						"vpc_id":   "vpc-123",
+						"clusters": outputs["clusters"].Value,
+						"regions":  outputs["regions"].Value,
+					},
+					"values": map[string]string{"app": "staging-vpc-123"},
+				},
+			},
+		},
+		{
+			name: "elements of a map",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				ElementsFrom: "regions",
+				Values:       map[string]string{"region": "{{key}}={{value}}"},
+			},
+			expected: []map[string]any{
+				func() map[string]any {
+					params := map[string]any{"key": "eu", "value": "eu-west-1", "values.region": "eu=eu-west-1"}
+					addFlatTerraformOutputs(params)
+					return params
+				}(),
+				func() map[string]any {
+					params := map[string]any{"key": "us", "value": "us-east-1", "values.region": "us=us-east-1"}
+					addFlatTerraformOutputs(params)
+					return params
+				}(),
+			},
+		},
+		{
+			name:        "missing elements output",
+			generator:   &argoprojiov1alpha1.TerraformStateGenerator{ElementsFrom: "subnets"},
+			expectedErr: "output subnets of elementsFrom not found in the Terraform state",
+		},
+		{
+			name:        "sensitive elements output",
+			generator:   &argoprojiov1alpha1.TerraformStateGenerator{ElementsFrom: "password"},
+			expectedErr: "output password of elementsFrom is sensitive, and includeSensitive is not set",
+		},
+		{
+			name:        "elements output which is not a collection",
+			generator:   &argoprojiov1alpha1.TerraformStateGenerator{ElementsFrom: "vpc_id"},
+			expectedErr: "output vpc_id of elementsFrom must be a list or a map, found string",
+		},
+		{
+			name:        "sensitive outputs not allowed",
+			generator:   &argoprojiov1alpha1.TerraformStateGenerator{IncludeSensitive: true},
+			expectedErr: "includeSensitive is not allowed by the ApplicationSet controller",
+		},
+		{
+			name:        "reader error",
+			generator:   &argoprojiov1alpha1.TerraformStateGenerator{},
+			readerErr:   errors.New("unexpected status 403"),
+			expectedErr: "error reading Terraform state outputs: unexpected status 403",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &TerraformStateGenerator{
+				config: c.config,
+				newReaderFunc: func(context.Context, *argoprojiov1alpha1.TerraformStateGenerator, *argoprojiov1alpha1.ApplicationSet) (terraform_state.Reader, error) {
+					return &fakeTerraformStateReader{outputs: outputs, err: c.readerErr}, nil
+				},
+			}
+			generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+				TerraformState: c.generator,
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate},
+			}
+
+			got, err := gen.GenerateParams(&generatorConfig, appSet, nil)
+			if c.expectedErr != "" {
+				require.EqualError(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, got)
+		})
+	}
+}
+
+// addFlatTerraformOutputs adds the flattened non-sensitive outputs of TestTerraformStateGenerateParams to the params.
+func addFlatTerraformOutputs(params map[string]any) {
+	for k, v := range map[string]any{
+		"outputs.vpc_id":              "vpc-123",
+		"outputs.clusters.0.name":     "prod",
+		"outputs.clusters.0.endpoint": "https://prod.example.com",
+		"outputs.clusters.1.name":     "staging",
+		"outputs.clusters.1.endpoint": "https://staging.example.com",
+		"outputs.regions.us":          "us-east-1",
+		"outputs.regions.eu":          "eu-west-1",
+	} {
+		params[k] = v
+	}
+}
+
+func TestTerraformStateNewReader(t *testing.T) {
+	secretRef := &argoprojiov1alpha1.SecretRef{SecretName: "state", Key: "token"}
+
+	for _, c := range []struct {
+		name        string
+		generator   *argoprojiov1alpha1.TerraformStateGenerator
+		config      TerraformStateConfig
+		expectedErr string
+	}{
+		{
+			name:        "no backend",
+			generator:   &argoprojiov1alpha1.TerraformStateGenerator{},
+			expectedErr: "one of s3, gcs, http or cloud must be set",
+		},
+		{
+			name: "several backends",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				S3:   &argoprojiov1alpha1.TerraformStateS3{Bucket: "states", Key: "terraform.tfstate"},
+				HTTP: &argoprojiov1alpha1.TerraformStateHTTP{URL: "https://states.example.com"},
+			},
+			expectedErr: "only one of s3, gcs, http or cloud may be set, found http, s3",
+		},
+		{
+			name: "s3 access key without secret",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				S3: &argoprojiov1alpha1.TerraformStateS3{Bucket: "states", Key: "terraform.tfstate", AccessKeyIDRef: secretRef},
+			},
+			expectedErr: "accessKeyIDRef and secretAccessKeyRef must be set together",
+		},
+		{
+			name: "http password without username",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				HTTP: &argoprojiov1alpha1.TerraformStateHTTP{URL: "https://states.example.com", PasswordRef: secretRef},
+			},
+			expectedErr: "usernameRef and passwordRef must be set together",
+		},
+		{
+			name: "cloud without token",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				Cloud: &argoprojiov1alpha1.TerraformStateCloud{Organization: "argoproj", Workspace: "network"},
+			},
+			expectedErr: "tokenRef is required to read the outputs of a workspace",
+		},
+		{
+			name: "s3 with the identity of the pod",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				S3: &argoprojiov1alpha1.TerraformStateS3{Bucket: "states", Key: "terraform.tfstate", Role: "arn:aws:iam::123456789012:role/states"},
+			},
+			expectedErr: "accessKeyIDRef is required: reading the state with the identity of the ApplicationSet controller is not allowed",
+		},
+		{
+			name: "s3 endpoint not allowed",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				S3: &argoprojiov1alpha1.TerraformStateS3{Bucket: "states", Key: "terraform.tfstate", Endpoint: "http://169.254.169.254"},
+			},
+			config:      TerraformStateConfig{AllowPodCredentials: true, AllowedURLs: []string{"https://minio.example.com"}},
+			expectedErr: "URL http://169.254.169.254 is not allowed by the ApplicationSet controller",
+		},
+		{
+			name: "gcs with the identity of the pod",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				GCS: &argoprojiov1alpha1.TerraformStateGCS{Bucket: "states", Object: "default.tfstate"},
+			},
+			expectedErr: "credentialsRef is required: reading the state with the identity of the ApplicationSet controller is not allowed",
+		},
+		{
+			name: "http URL not allowed",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				HTTP: &argoprojiov1alpha1.TerraformStateHTTP{URL: "https://states.example.com.evil.com/network"},
+			},
+			config:      TerraformStateConfig{AllowedURLs: []string{"https://states.example.com/*"}},
+			expectedErr: "URL https://states.example.com.evil.com/network is not allowed by the ApplicationSet controller",
+		},
+		{
+			name: "http insecure not allowed",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				HTTP: &argoprojiov1alpha1.TerraformStateHTTP{URL: "https://states.example.com/network", Insecure: true},
+			},
+			config:      TerraformStateConfig{AllowedURLs: []string{"https://states.example.com/*"}},
+			expectedErr: "insecure is not allowed by the ApplicationSet controller",
+		},
+		{
+			name: "cloud hostname not allowed",
+			generator: &argoprojiov1alpha1.TerraformStateGenerator{
+				Cloud: &argoprojiov1alpha1.TerraformStateCloud{Hostname: "tfe.internal", Organization: "argoproj", Workspace: "network", TokenRef: secretRef},
+			},
+			expectedErr: "URL https://tfe.internal is not allowed by the ApplicationSet controller",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &TerraformStateGenerator{config: c.config}
+			_, err := gen.newReader(t.Context(), c.generator, &argoprojiov1alpha1.ApplicationSet{})
+			require.EqualError(t, err, c.expectedErr)
+		})
+	}
+}
+
+func TestTerraformStateGetRequeueAfter(t *testing.T) {
+	gen := NewTerraformStateGenerator(nil, false, TerraformStateConfig{})
+
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		TerraformState: &argoprojiov1alpha1.TerraformStateGenerator{},
+	}
+	assert.Equal(t, DefaultTerraformStateRequeueAfter, gen.GetRequeueAfter(&generatorConfig))
+
+	requeueAfterSeconds := int64(60)
+	generatorConfig.TerraformState.RequeueAfterSeconds = &requeueAfterSeconds
+	assert.Equal(t, time.Minute, gen.GetRequeueAfter(&generatorConfig))
+}
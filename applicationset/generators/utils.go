@@ -5,20 +5,47 @@ import (
 
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	appsetcache "github.com/argoproj/argo-cd/v3/applicationset/cache"
+	"github.com/argoproj/argo-cd/v3/applicationset/generatorserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig) map[string]Generator {
+// GetGenerators returns the generators available to the ApplicationSets. secretTypeIndexed must only be true when the
+// client is backed by a cache which indexes the secrets with utils.SecretTypeIndexer. When generatorCache is not nil,
+// the parameters of the generators relying on external systems are cached. When generatorClientset is not nil, these
+// generators are run by the generator service instead of the current process. When clusterGeneratorStrict is true, a
+// malformed cluster secret fails the cluster generator instead of being skipped with a warning event recorded by
+// recorder, which may be nil. awsAccountsConfig and terraformStateConfig hold what the AWS Accounts and Terraform State
+// generators are allowed to use.
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, awsAccountsConfig AWSAccountsConfig, terraformStateConfig TerraformStateConfig, secretTypeIndexed bool, generatorCache *appsetcache.Cache, generatorClientset apiclient.Clientset, clusterGeneratorStrict bool, recorder record.EventRecorder) map[string]Generator {
 	terminalGenerators := map[string]Generator{
 		"List":                    NewListGenerator(),
-		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, namespace),
+		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, namespace, secretTypeIndexed, clusterGeneratorStrict, recorder),
 		"Git":                     NewGitGenerator(argoCDService, namespace),
 		"SCMProvider":             NewSCMProviderGenerator(c, scmConfig),
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, namespace),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
-		"Plugin":                  NewPluginGenerator(ctx, c, k8sClient, namespace),
+		"Plugin":                  NewPluginGenerator(ctx, c, k8sClient, namespace, scmConfig.tokenRefStrictMode),
+		"OCI":                     NewOCIGenerator(c, argoCDService, scmConfig.tokenRefStrictMode),
+		"AWSAccounts":             NewAWSAccountsGenerator(c, scmConfig.tokenRefStrictMode, awsAccountsConfig),
+		"TerraformState":          NewTerraformStateGenerator(c, scmConfig.tokenRefStrictMode, terraformStateConfig),
+	}
+
+	if generatorClientset != nil {
+		// The other generators do not reach external systems, or are cheap enough to run in the controller.
+		for _, name := range []string{"Git", "SCMProvider", "PullRequest", "Plugin", "OCI", "AWSAccounts", "TerraformState"} {
+			terminalGenerators[name] = NewRemoteGenerator(ctx, name, terminalGenerators[name], generatorClientset)
+		}
+	}
+
+	if generatorCache != nil {
+		// The other generators either do not reach external systems, or watch the resources they depend on.
+		for _, name := range []string{"Git", "SCMProvider", "PullRequest", "Plugin", "OCI", "AWSAccounts", "TerraformState"} {
+			terminalGenerators[name] = NewCachedGenerator(terminalGenerators[name], generatorCache)
+		}
 	}
 
 	nestedGenerators := map[string]Generator{
@@ -29,6 +56,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"OCI":                     terminalGenerators["OCI"],
+		"AWSAccounts":             terminalGenerators["AWSAccounts"],
+		"TerraformState":          terminalGenerators["TerraformState"],
 		"Matrix":                  NewMatrixGenerator(terminalGenerators),
 		"Merge":                   NewMergeGenerator(terminalGenerators),
 	}
@@ -41,6 +71,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"OCI":                     terminalGenerators["OCI"],
+		"AWSAccounts":             terminalGenerators["AWSAccounts"],
+		"TerraformState":          terminalGenerators["TerraformState"],
 		"Matrix":                  NewMatrixGenerator(nestedGenerators),
 		"Merge":                   NewMergeGenerator(nestedGenerators),
 	}
@@ -1,7 +1,11 @@
 package generators
 
 import (
+	"encoding/json"
 	"fmt"
+
+	"github.com/jeremywohl/flatten"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 func appendTemplatedValues(values map[string]string, params map[string]any, useGoTemplate bool, goTemplateOptions []string) error {
@@ -33,8 +37,88 @@ func appendTemplatedValues(values map[string]string, params map[string]any, useG
 	return nil
 }
 
+// appendTemplatedValuesAndObject appends the templated values and the templated nested values of valuesObject to the
+// params. With Go templates, the nested values keep their structure under the "values" param, otherwise they are
+// flattened to "values."-prefixed params. Like the values, they are templated with the params only, so that they
+// cannot reference each other, and the nested values take precedence over the values.
+func appendTemplatedValuesAndObject(values map[string]string, valuesObject *apiextensionsv1.JSON, params map[string]any, useGoTemplate bool, goTemplateOptions []string) error {
+	if valuesObject == nil {
+		return appendTemplatedValues(values, params, useGoTemplate, goTemplateOptions)
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(valuesObject.Raw, &object); err != nil {
+		return fmt.Errorf("failed to unmarshal values object: %w", err)
+	}
+	templated, err := replaceTemplatedValue(object, params, useGoTemplate, goTemplateOptions)
+	if err != nil {
+		return err
+	}
+	object = templated.(map[string]any)
+
+	if err := appendTemplatedValues(values, params, useGoTemplate, goTemplateOptions); err != nil {
+		return err
+	}
+
+	if !useGoTemplate {
+		flat, err := flatten.Flatten(object, "values.", flatten.DotStyle)
+		if err != nil {
+			return fmt.Errorf("failed to flatten values object: %w", err)
+		}
+		for key, value := range flat {
+			params[key] = fmt.Sprintf("%v", value)
+		}
+		return nil
+	}
+
+	merged := map[string]any{}
+	if existing, ok := params["values"].(map[string]string); ok {
+		for key, value := range existing {
+			merged[key] = value
+		}
+	}
+	for key, value := range object {
+		merged[key] = value
+	}
+	params["values"] = merged
+	return nil
+}
+
+// replaceTemplatedValue templates the strings of a value unmarshaled from JSON, recursing into its objects and arrays.
+func replaceTemplatedValue(value any, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (any, error) {
+	switch v := value.(type) {
+	case string:
+		result, err := replaceTemplatedString(v, params, useGoTemplate, goTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replace templated string: %w", err)
+		}
+		return result, nil
+	case map[string]any:
+		for key, element := range v {
+			result, err := replaceTemplatedValue(element, params, useGoTemplate, goTemplateOptions)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = result
+		}
+		return v, nil
+	case []any:
+		for i, element := range v {
+			result, err := replaceTemplatedValue(element, params, useGoTemplate, goTemplateOptions)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = result
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
 func replaceTemplatedString(value string, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (string, error) {
-	replacedTmplStr, err := render.Replace(value, params, useGoTemplate, goTemplateOptions)
+	// The legacy templates keep the unresolved parameters, while the Go templates follow their missingkey option
+	replacedTmplStr, err := render.Replace(value, params, useGoTemplate, goTemplateOptions, !useGoTemplate)
 	if err != nil {
 		return "", fmt.Errorf("failed to replace templated string with rendered values: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 func TestValueInterpolation(t *testing.T) {
@@ -122,3 +123,58 @@ func TestValueInterpolationWithGoTemplating(t *testing.T) {
 		})
 	}
 }
+
+func TestValuesObjectInterpolation(t *testing.T) {
+	valuesObject := &apiextensionsv1.JSON{Raw: []byte(`{
+		"hello": "overridden {{ .world }}",
+		"cluster": {"name": "{{ .name }}", "replicas": 3, "zones": ["{{ .name }}-a", "{{ .name }}-b"]},
+		"laughs": "{{ .values.lol }}"
+	}`)}
+
+	t.Run("Go template", func(t *testing.T) {
+		params := map[string]any{"world": "world!", "name": "dev"}
+		err := appendTemplatedValuesAndObject(map[string]string{"hello": "{{ .world }}", "lol": "lol"}, valuesObject, params, true, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"world": "world!",
+			"name":  "dev",
+			"values": map[string]any{
+				"hello": "overridden world!",
+				"lol":   "lol",
+				"cluster": map[string]any{
+					"name":     "dev",
+					"replicas": float64(3),
+					"zones":    []any{"dev-a", "dev-b"},
+				},
+				"laughs": "<no value>",
+			},
+		}, params)
+	})
+
+	t.Run("fasttemplate", func(t *testing.T) {
+		params := map[string]any{"world": "world!", "name": "dev"}
+		valuesObject := &apiextensionsv1.JSON{Raw: []byte(`{
+			"hello": "overridden {{ world }}",
+			"cluster": {"name": "{{ name }}", "replicas": 3, "zones": ["{{ name }}-a", "{{ name }}-b"]},
+			"laughs": "{{ values.lol }}"
+		}`)}
+		err := appendTemplatedValuesAndObject(map[string]string{"hello": "{{ world }}", "lol": "lol"}, valuesObject, params, false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"world":                   "world!",
+			"name":                    "dev",
+			"values.hello":            "overridden world!",
+			"values.lol":              "lol",
+			"values.cluster.name":     "dev",
+			"values.cluster.replicas": "3",
+			"values.cluster.zones.0":  "dev-a",
+			"values.cluster.zones.1":  "dev-b",
+			"values.laughs":           "{{ values.lol }}",
+		}, params)
+	})
+
+	t.Run("invalid object", func(t *testing.T) {
+		err := appendTemplatedValuesAndObject(nil, &apiextensionsv1.JSON{Raw: []byte(`["not", "an", "object"]`)}, map[string]any{}, true, nil)
+		require.ErrorContains(t, err, "failed to unmarshal values object")
+	})
+}
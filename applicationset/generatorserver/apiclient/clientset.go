@@ -0,0 +1,105 @@
+package apiclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// MetaDataTokenKey is the gRPC metadata key holding the token authenticating the callers of the generator server
+const MetaDataTokenKey = "authorization"
+
+// TLSConfiguration describes parameters for TLS configuration to be used by a generator server API client
+type TLSConfiguration struct {
+	// Whether to disable TLS for connections
+	DisableTLS bool
+	// Whether to enforce strict validation of TLS certificates
+	StrictValidation bool
+	// List of certificates to validate the peer against (if StrictCerts is true)
+	Certificates *x509.CertPool
+}
+
+// Clientset represents generator server api clients
+type Clientset interface {
+	NewGeneratorServerClient() (GeneratorServiceClient, error)
+}
+
+type clientSet struct {
+	address   string
+	tlsConfig TLSConfiguration
+	token     string
+
+	lock sync.Mutex
+	conn *grpc.ClientConn
+}
+
+// NewGeneratorServerClient returns a client of the generator server. The connection is opened by the first call, and
+// shared by the clients returned by the next ones.
+func (c *clientSet) NewGeneratorServerClient() (GeneratorServiceClient, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn == nil {
+		conn, err := NewConnection(c.address, &c.tlsConfig, c.token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open a new connection to generator server: %w", err)
+		}
+		c.conn = conn
+	}
+	return NewGeneratorServiceClient(c.conn), nil
+}
+
+// tokenCredentials sends the token authenticating the caller with every request to the generator server
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{
+		MetaDataTokenKey: "Bearer " + c.token,
+	}, nil
+}
+
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+// NewConnection creates new connection to generator server
+func NewConnection(address string, tlsConfig *TLSConfiguration, token string) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithPerRPCCredentials(tokenCredentials{token: token, requireTLS: !tlsConfig.DisableTLS}),
+	}
+
+	tlsC := &tls.Config{}
+	if !tlsConfig.DisableTLS {
+		if !tlsConfig.StrictValidation {
+			tlsC.InsecureSkipVerify = true
+		} else {
+			tlsC.RootCAs = tlsConfig.Certificates
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsC)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	// TODO: switch to grpc.NewClient.
+	//nolint:staticcheck
+	conn, err := grpc.Dial(address, opts...)
+	if err != nil {
+		log.Errorf("Unable to connect to generator service with address %s", address)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// NewGeneratorServerClientset creates new instance of generator server Clientset, authenticating with the given token
+func NewGeneratorServerClientset(address string, tlsConfig TLSConfiguration, token string) Clientset {
+	return &clientSet{address: address, tlsConfig: tlsConfig, token: token}
+}
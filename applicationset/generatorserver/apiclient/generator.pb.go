@@ -0,0 +1,1587 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: applicationset/generatorserver/generator/generator.proto
+
+package apiclient
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenerateParamsRequest is the request to run a generator of an ApplicationSet.
+type GenerateParamsRequest struct {
+	// Generator is the name of the generator to run, e.g. Git or SCMProvider.
+	Generator string `protobuf:"bytes,1,opt,name=generator,proto3" json:"generator,omitempty"`
+	// GeneratorSpec is the generator to run, once interpolated with the parameters of its parent generator if any.
+	GeneratorSpec *v1alpha1.ApplicationSetGenerator `protobuf:"bytes,2,opt,name=generatorSpec,proto3" json:"generatorSpec,omitempty"`
+	// ApplicationSet is the ApplicationSet the generator belongs to.
+	ApplicationSet       *v1alpha1.ApplicationSet `protobuf:"bytes,3,opt,name=applicationSet,proto3" json:"applicationSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GenerateParamsRequest) Reset()         { *m = GenerateParamsRequest{} }
+func (m *GenerateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateParamsRequest) ProtoMessage()    {}
+func (*GenerateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1bccb2f1b4986e3, []int{0}
+}
+func (m *GenerateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenerateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenerateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateParamsRequest.Merge(m, src)
+}
+func (m *GenerateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateParamsRequest proto.InternalMessageInfo
+
+func (m *GenerateParamsRequest) GetGenerator() string {
+	if m != nil {
+		return m.Generator
+	}
+	return ""
+}
+
+func (m *GenerateParamsRequest) GetGeneratorSpec() *v1alpha1.ApplicationSetGenerator {
+	if m != nil {
+		return m.GeneratorSpec
+	}
+	return nil
+}
+
+func (m *GenerateParamsRequest) GetApplicationSet() *v1alpha1.ApplicationSet {
+	if m != nil {
+		return m.ApplicationSet
+	}
+	return nil
+}
+
+// GenerateParamsResponse holds the parameters produced by a generator.
+type GenerateParamsResponse struct {
+	// Params is the JSON encoded list of the parameter sets produced by the generator.
+	Params               []byte   `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateParamsResponse) Reset()         { *m = GenerateParamsResponse{} }
+func (m *GenerateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateParamsResponse) ProtoMessage()    {}
+func (*GenerateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1bccb2f1b4986e3, []int{1}
+}
+func (m *GenerateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenerateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenerateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateParamsResponse.Merge(m, src)
+}
+func (m *GenerateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateParamsResponse proto.InternalMessageInfo
+
+func (m *GenerateParamsResponse) GetParams() []byte {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// PullRequestEventRequest is a change of a GitHub pull request received by webhook by the ApplicationSet controller.
+type PullRequestEventRequest struct {
+	// Owner and repo are the GitHub repository of the pull request.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo  string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// APIRegexp matches the API URLs of the GitHub instance which sent the event.
+	ApiRegexp    string   `protobuf:"bytes,3,opt,name=apiRegexp,proto3" json:"apiRegexp,omitempty"`
+	Number       int64    `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Title        string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Branch       string   `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
+	TargetBranch string   `protobuf:"bytes,7,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
+	HeadSHA      string   `protobuf:"bytes,8,opt,name=headSHA,proto3" json:"headSHA,omitempty"`
+	Labels       []string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty"`
+	Author       string   `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	// Closed is true when the pull request was closed or merged.
+	Closed               bool     `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullRequestEventRequest) Reset()         { *m = PullRequestEventRequest{} }
+func (m *PullRequestEventRequest) String() string { return proto.CompactTextString(m) }
+func (*PullRequestEventRequest) ProtoMessage()    {}
+func (*PullRequestEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1bccb2f1b4986e3, []int{2}
+}
+func (m *PullRequestEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestEventRequest.Merge(m, src)
+}
+func (m *PullRequestEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestEventRequest proto.InternalMessageInfo
+
+func (m *PullRequestEventRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetApiRegexp() string {
+	if m != nil {
+		return m.ApiRegexp
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *PullRequestEventRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetTargetBranch() string {
+	if m != nil {
+		return m.TargetBranch
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetHeadSHA() string {
+	if m != nil {
+		return m.HeadSHA
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PullRequestEventRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *PullRequestEventRequest) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+// PullRequestEventResponse is the response to a pull request event.
+type PullRequestEventResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullRequestEventResponse) Reset()         { *m = PullRequestEventResponse{} }
+func (m *PullRequestEventResponse) String() string { return proto.CompactTextString(m) }
+func (*PullRequestEventResponse) ProtoMessage()    {}
+func (*PullRequestEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1bccb2f1b4986e3, []int{3}
+}
+func (m *PullRequestEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestEventResponse.Merge(m, src)
+}
+func (m *PullRequestEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestEventResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GenerateParamsRequest)(nil), "GenerateParamsRequest")
+	proto.RegisterType((*GenerateParamsResponse)(nil), "GenerateParamsResponse")
+	proto.RegisterType((*PullRequestEventRequest)(nil), "PullRequestEventRequest")
+	proto.RegisterType((*PullRequestEventResponse)(nil), "PullRequestEventResponse")
+}
+
+func init() {
+	proto.RegisterFile("applicationset/generatorserver/generator/generator.proto", fileDescriptor_a1bccb2f1b4986e3)
+}
+
+var fileDescriptor_a1bccb2f1b4986e3 = []byte{
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x56, 0xda, 0xad, 0x5b, 0xbd, 0x31, 0x21, 0x0b, 0x3a, 0x53, 0xa1, 0xaa, 0xea, 0xa9, 0x17,
+	0x1c, 0xb6, 0x5e, 0xb8, 0x76, 0x12, 0xea, 0x0e, 0x43, 0x9a, 0x5c, 0xed, 0xc2, 0x05, 0x39, 0xc9,
+	0x53, 0x12, 0xe6, 0xc6, 0xc6, 0x71, 0x02, 0x12, 0x7f, 0x87, 0x03, 0x17, 0xfe, 0x07, 0x47, 0x7e,
+	0x02, 0xea, 0x2f, 0x41, 0x76, 0x92, 0x66, 0x2d, 0x54, 0xbb, 0x70, 0x7b, 0xdf, 0xf7, 0xac, 0xf7,
+	0x7d, 0xf9, 0x9e, 0x1d, 0xf4, 0x86, 0x2b, 0x25, 0xd2, 0x90, 0x9b, 0x54, 0x66, 0x39, 0x18, 0x3f,
+	0x86, 0x0c, 0x34, 0x37, 0x52, 0xe7, 0xa0, 0x4b, 0xd0, 0x2d, 0x6e, 0x2b, 0xaa, 0xb4, 0x34, 0x72,
+	0x78, 0x13, 0xa7, 0x26, 0x29, 0x02, 0x1a, 0xca, 0x95, 0xcf, 0x75, 0x2c, 0x95, 0x96, 0x1f, 0x5d,
+	0xf1, 0x2a, 0x8c, 0xfc, 0x72, 0xe6, 0xab, 0xfb, 0xd8, 0xe7, 0x2a, 0xcd, 0xfd, 0x07, 0x0a, 0x7e,
+	0x79, 0xc1, 0x85, 0x4a, 0xf8, 0x45, 0x33, 0x0d, 0xa2, 0x6a, 0xda, 0xe4, 0x7b, 0x07, 0x3d, 0x5f,
+	0xd4, 0xdc, 0x2d, 0xd7, 0x7c, 0x95, 0x33, 0xf8, 0x54, 0x40, 0x6e, 0xf0, 0x4b, 0xd4, 0xdf, 0x48,
+	0x13, 0x6f, 0xec, 0x4d, 0xfb, 0xac, 0x25, 0xf0, 0x57, 0xf4, 0x64, 0x03, 0x96, 0x0a, 0x42, 0xd2,
+	0x19, 0x7b, 0xd3, 0x93, 0xcb, 0x3b, 0xda, 0xba, 0xa3, 0x8d, 0x3b, 0x57, 0x7c, 0x08, 0x23, 0x5a,
+	0xce, 0xa8, 0xba, 0x8f, 0xa9, 0x75, 0x47, 0x1f, 0xb8, 0xa3, 0x8d, 0x3b, 0x3a, 0x6f, 0xc9, 0x25,
+	0x98, 0x45, 0x23, 0xc0, 0xb6, 0xb5, 0xb0, 0x41, 0x67, 0x7c, 0xeb, 0x24, 0xe9, 0x3a, 0xf5, 0x9b,
+	0xff, 0xa9, 0xce, 0x76, 0x34, 0x26, 0xaf, 0xd1, 0x60, 0x37, 0xa9, 0x5c, 0xd9, 0xed, 0xe1, 0x01,
+	0xea, 0x29, 0xc7, 0xb8, 0x9c, 0x4e, 0x59, 0x8d, 0x26, 0x3f, 0x3a, 0xe8, 0xfc, 0xb6, 0x10, 0xa2,
+	0x8e, 0xf4, 0x6d, 0x09, 0x99, 0x69, 0xe2, 0x7d, 0x86, 0x0e, 0xe5, 0xe7, 0x0c, 0x9a, 0x68, 0x2b,
+	0x80, 0x31, 0x3a, 0xd0, 0xa0, 0xa4, 0x4b, 0xb3, 0xcf, 0x5c, 0x6d, 0x17, 0xc1, 0x55, 0xca, 0x20,
+	0x86, 0x2f, 0xca, 0x7d, 0x68, 0x9f, 0xb5, 0x84, 0xd5, 0xce, 0x8a, 0x55, 0x00, 0x9a, 0x1c, 0x8c,
+	0xbd, 0x69, 0x97, 0xd5, 0xc8, 0xce, 0x37, 0xa9, 0x11, 0x40, 0x0e, 0xab, 0xf9, 0x0e, 0xd8, 0xd3,
+	0x81, 0xe6, 0x59, 0x98, 0x90, 0x9e, 0xa3, 0x6b, 0x84, 0x27, 0xe8, 0xd4, 0x70, 0x1d, 0x83, 0xb9,
+	0xaa, 0xba, 0x47, 0xae, 0xbb, 0xc5, 0x61, 0x82, 0x8e, 0x12, 0xe0, 0xd1, 0xf2, 0x7a, 0x4e, 0x8e,
+	0x5d, 0xbb, 0x81, 0x76, 0xaa, 0xe0, 0x01, 0x88, 0x9c, 0xf4, 0xc7, 0x5d, 0x3b, 0xb5, 0x42, 0x96,
+	0xe7, 0x85, 0x49, 0xa4, 0x26, 0xa8, 0x52, 0xab, 0x90, 0xe5, 0x43, 0x21, 0x73, 0x88, 0xc8, 0xc9,
+	0xd8, 0x9b, 0x1e, 0xb3, 0x1a, 0x4d, 0x86, 0x88, 0xfc, 0x1d, 0x57, 0x95, 0xf1, 0xe5, 0x37, 0x0f,
+	0x3d, 0xdd, 0x5c, 0x88, 0x25, 0xe8, 0x32, 0x0d, 0x01, 0xcf, 0xd1, 0xd9, 0xf6, 0x4a, 0xf0, 0x80,
+	0xfe, 0xf3, 0x36, 0x0f, 0xcf, 0xe9, 0x9e, 0xdd, 0xbd, 0x43, 0x83, 0x6b, 0x9e, 0x45, 0x02, 0x76,
+	0x95, 0x31, 0xa1, 0x7b, 0x76, 0x37, 0x7c, 0x41, 0xf7, 0xd9, 0xbc, 0xba, 0xfb, 0xb9, 0x1e, 0x79,
+	0xbf, 0xd6, 0x23, 0xef, 0xf7, 0x7a, 0xe4, 0xbd, 0x5f, 0x3c, 0xf2, 0x56, 0x1f, 0xf9, 0x09, 0x70,
+	0x95, 0x86, 0x22, 0x85, 0xcc, 0x04, 0x3d, 0xf7, 0x5a, 0x67, 0x7f, 0x06, 0x00, 0xa8, 0xf5, 0x0a,
+	0x48, 0x37, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GeneratorServiceClient is the client API for GeneratorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GeneratorServiceClient interface {
+	// GenerateParams runs a generator and returns the parameter sets it produces.
+	GenerateParams(ctx context.Context, in *GenerateParamsRequest, opts ...grpc.CallOption) (*GenerateParamsResponse, error)
+	// HandlePullRequestEvent applies a pull request event to the pull requests listed by the Pull Request generator.
+	HandlePullRequestEvent(ctx context.Context, in *PullRequestEventRequest, opts ...grpc.CallOption) (*PullRequestEventResponse, error)
+}
+
+type generatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGeneratorServiceClient(cc *grpc.ClientConn) GeneratorServiceClient {
+	return &generatorServiceClient{cc}
+}
+
+func (c *generatorServiceClient) GenerateParams(ctx context.Context, in *GenerateParamsRequest, opts ...grpc.CallOption) (*GenerateParamsResponse, error) {
+	out := new(GenerateParamsResponse)
+	err := c.cc.Invoke(ctx, "/GeneratorService/GenerateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generatorServiceClient) HandlePullRequestEvent(ctx context.Context, in *PullRequestEventRequest, opts ...grpc.CallOption) (*PullRequestEventResponse, error) {
+	out := new(PullRequestEventResponse)
+	err := c.cc.Invoke(ctx, "/GeneratorService/HandlePullRequestEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeneratorServiceServer is the server API for GeneratorService service.
+type GeneratorServiceServer interface {
+	// GenerateParams runs a generator and returns the parameter sets it produces.
+	GenerateParams(context.Context, *GenerateParamsRequest) (*GenerateParamsResponse, error)
+	// HandlePullRequestEvent applies a pull request event to the pull requests listed by the Pull Request generator.
+	HandlePullRequestEvent(context.Context, *PullRequestEventRequest) (*PullRequestEventResponse, error)
+}
+
+// UnimplementedGeneratorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedGeneratorServiceServer struct {
+}
+
+func (*UnimplementedGeneratorServiceServer) GenerateParams(ctx context.Context, req *GenerateParamsRequest) (*GenerateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateParams not implemented")
+}
+func (*UnimplementedGeneratorServiceServer) HandlePullRequestEvent(ctx context.Context, req *PullRequestEventRequest) (*PullRequestEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePullRequestEvent not implemented")
+}
+
+func RegisterGeneratorServiceServer(s *grpc.Server, srv GeneratorServiceServer) {
+	s.RegisterService(&_GeneratorService_serviceDesc, srv)
+}
+
+func _GeneratorService_GenerateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeneratorServiceServer).GenerateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GeneratorService/GenerateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeneratorServiceServer).GenerateParams(ctx, req.(*GenerateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeneratorService_HandlePullRequestEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeneratorServiceServer).HandlePullRequestEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GeneratorService/HandlePullRequestEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeneratorServiceServer).HandlePullRequestEvent(ctx, req.(*PullRequestEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GeneratorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "GeneratorService",
+	HandlerType: (*GeneratorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateParams",
+			Handler:    _GeneratorService_GenerateParams_Handler,
+		},
+		{
+			MethodName: "HandlePullRequestEvent",
+			Handler:    _GeneratorService_HandlePullRequestEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "applicationset/generatorserver/generator/generator.proto",
+}
+
+func (m *GenerateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ApplicationSet != nil {
+		{
+			size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GeneratorSpec != nil {
+		{
+			size, err := m.GeneratorSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Generator) > 0 {
+		i -= len(m.Generator)
+		copy(dAtA[i:], m.Generator)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Generator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenerateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Params) > 0 {
+		i -= len(m.Params)
+		copy(dAtA[i:], m.Params)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Params)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerator(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.HeadSHA) > 0 {
+		i -= len(m.HeadSHA)
+		copy(dAtA[i:], m.HeadSHA)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.HeadSHA)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TargetBranch) > 0 {
+		i -= len(m.TargetBranch)
+		copy(dAtA[i:], m.TargetBranch)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.TargetBranch)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Number != 0 {
+		i = encodeVarintGenerator(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ApiRegexp) > 0 {
+		i -= len(m.ApiRegexp)
+		copy(dAtA[i:], m.ApiRegexp)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.ApiRegexp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenerator(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenerateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Generator)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if m.GeneratorSpec != nil {
+		l = m.GeneratorSpec.Size()
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if m.ApplicationSet != nil {
+		l = m.ApplicationSet.Size()
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GenerateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullRequestEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	l = len(m.ApiRegexp)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovGenerator(uint64(m.Number))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	l = len(m.HeadSHA)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovGenerator(uint64(l))
+		}
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovGenerator(uint64(l))
+	}
+	if m.Closed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullRequestEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovGenerator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerator(x uint64) (n int) {
+	return sovGenerator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenerateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratorSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GeneratorSpec == nil {
+				m.GeneratorSpec = &v1alpha1.ApplicationSetGenerator{}
+			}
+			if err := m.GeneratorSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationSet == nil {
+				m.ApplicationSet = &v1alpha1.ApplicationSet{}
+			}
+			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params[:0], dAtA[iNdEx:postIndex]...)
+			if m.Params == nil {
+				m.Params = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRegexp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiRegexp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadSHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenerator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenerator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenerator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenerator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenerator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenerator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenerator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenerator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenerator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,150 @@
+package generatorserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/generatorserver/apiclient"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/common"
+	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
+	"github.com/argoproj/argo-cd/v3/server/version"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
+)
+
+// The hostnames to generate self-signed issues with
+var tlsHostList = []string{"localhost", "argocd-applicationset-generator-server"}
+
+// Server runs the generators of the ApplicationSets on behalf of the ApplicationSet controllers, so that the load on
+// the SCM providers and Git repositories can be scaled independently of the reconciliation loop.
+type Server struct {
+	generators map[string]generators.Generator
+	client     client.Client
+	token      string
+	tlsConfig  *tls.Config
+}
+
+// NewServer returns a new instance of the generator server, running the given generators for the callers
+// authenticated with the given token. The server is served over TLS unless tlsConfCustomizer is nil.
+func NewServer(generators map[string]generators.Generator, client client.Client, token string, tlsConfCustomizer tlsutil.ConfigCustomizer) (*Server, error) {
+	if token == "" {
+		return nil, errors.New("a token is required to authenticate the callers of the generator server")
+	}
+
+	var tlsConfig *tls.Config
+	// Generate or load TLS server certificates to use with this instance of generator server.
+	if tlsConfCustomizer != nil {
+		var err error
+		certPath := env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath) + "/applicationset-generator-server/tls/tls.crt"
+		keyPath := env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath) + "/applicationset-generator-server/tls/tls.key"
+		tlsConfig, err = tlsutil.CreateServerTLSConfig(certPath, keyPath, tlsHostList)
+		if err != nil {
+			return nil, fmt.Errorf("error creating server TLS config: %w", err)
+		}
+		tlsConfCustomizer(tlsConfig)
+	}
+
+	return &Server{
+		generators: generators,
+		client:     client,
+		token:      token,
+		tlsConfig:  tlsConfig,
+	}, nil
+}
+
+// GenerateParams runs the requested generator and returns the parameter sets it produces.
+func (s *Server) GenerateParams(_ context.Context, req *apiclient.GenerateParamsRequest) (*apiclient.GenerateParamsResponse, error) {
+	generator, ok := s.generators[req.Generator]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown generator %q", req.Generator)
+	}
+	if req.GeneratorSpec == nil || req.ApplicationSet == nil {
+		return nil, status.Error(codes.InvalidArgument, "generator spec and ApplicationSet are required")
+	}
+
+	params, err := generator.GenerateParams(req.GeneratorSpec, req.ApplicationSet, s.client)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling params: %w", err)
+	}
+	return &apiclient.GenerateParamsResponse{Params: data}, nil
+}
+
+// HandlePullRequestEvent applies a pull request event received by webhook by a controller to the Pull Request
+// generator.
+func (s *Server) HandlePullRequestEvent(_ context.Context, req *apiclient.PullRequestEventRequest) (*apiclient.PullRequestEventResponse, error) {
+	apiRegexp, err := regexp.Compile(req.ApiRegexp)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid API regexp %q: %v", req.ApiRegexp, err)
+	}
+	if handler, ok := s.generators["PullRequest"].(generators.PullRequestEventHandler); ok {
+		handler.HandlePullRequestEvent(&generators.PullRequestEvent{
+			Owner:     req.Owner,
+			Repo:      req.Repo,
+			APIRegexp: apiRegexp,
+			PullRequest: &pullrequest.PullRequest{
+				Number:       int(req.Number),
+				Title:        req.Title,
+				Branch:       req.Branch,
+				TargetBranch: req.TargetBranch,
+				HeadSHA:      req.HeadSHA,
+				Labels:       req.Labels,
+				Author:       req.Author,
+			},
+			Closed: req.Closed,
+		})
+	}
+	return &apiclient.PullRequestEventResponse{}, nil
+}
+
+// authenticate rejects the requests without the token of the server, except the health checks.
+func (s *Server) authenticate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get(apiclient.MetaDataTokenKey)
+		if len(tokens) != 1 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte("Bearer "+s.token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid or missing generator server token")
+		}
+	}
+	return handler(ctx, req)
+}
+
+// CreateGRPC creates a new gRPC server.
+func (s *Server) CreateGRPC() *grpc.Server {
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(s.authenticate)}
+	// We do allow for non-TLS servers to be created, in case of mTLS will be
+	// implemented by e.g. a sidecar container.
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+	server := grpc.NewServer(opts...)
+	versionpkg.RegisterVersionServiceServer(server, version.NewServer(nil, func() (bool, error) {
+		return true, nil
+	}))
+	apiclient.RegisterGeneratorServiceServer(server, s)
+
+	healthService := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthService)
+
+	return server
+}
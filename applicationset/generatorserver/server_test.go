@@ -0,0 +1,134 @@
+package generatorserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	genmock "github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/generatorserver/apiclient"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/common"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const testToken = "test-token"
+
+func startServer(t *testing.T, gens map[string]generators.Generator) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	// The server generates a self-signed certificate, as none is mounted
+	t.Setenv(common.EnvAppConfigPath, t.TempDir())
+	server, err := NewServer(gens, nil, testToken, func(*tls.Config) {})
+	require.NoError(t, err)
+	grpcServer := server.CreateGRPC()
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	return listener.Addr().String()
+}
+
+func newClientset(address string, token string) apiclient.Clientset {
+	return apiclient.NewGeneratorServerClientset(address, apiclient.TLSConfiguration{}, token)
+}
+
+func TestRemoteGenerator(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"}}
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		Git: &argoprojiov1alpha1.GitGenerator{RepoURL: "https://github.com/argoproj/argocd-example-apps", Revision: "HEAD"},
+	}
+
+	t.Run("runs the generator in the generator service", func(t *testing.T) {
+		serverGenerator := &genmock.Generator{}
+		serverGenerator.On("GenerateParams", appSetGenerator, mock.MatchedBy(func(a *argoprojiov1alpha1.ApplicationSet) bool {
+			return a.Name == "appset" && a.Namespace == "argocd"
+		}), mock.Anything).Return([]map[string]any{{"path": map[string]any{"path": "guestbook"}}}, nil)
+		clientset := newClientset(startServer(t, map[string]generators.Generator{"Git": serverGenerator}), testToken)
+
+		localGenerator := &genmock.Generator{}
+		generator := generators.NewRemoteGenerator(t.Context(), "Git", localGenerator, clientset)
+
+		params, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"path": map[string]any{"path": "guestbook"}}}, params)
+		serverGenerator.AssertNumberOfCalls(t, "GenerateParams", 1)
+		localGenerator.AssertNotCalled(t, "GenerateParams", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns the error of the generator", func(t *testing.T) {
+		serverGenerator := &genmock.Generator{}
+		serverGenerator.On("GenerateParams", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("rate limited"))
+		clientset := newClientset(startServer(t, map[string]generators.Generator{"Git": serverGenerator}), testToken)
+
+		_, err := generators.NewRemoteGenerator(t.Context(), "Git", &genmock.Generator{}, clientset).GenerateParams(appSetGenerator, appSet, nil)
+		require.ErrorContains(t, err, "rate limited")
+	})
+
+	t.Run("rejects the callers without the token", func(t *testing.T) {
+		serverGenerator := &genmock.Generator{}
+		address := startServer(t, map[string]generators.Generator{"Git": serverGenerator})
+
+		_, err := generators.NewRemoteGenerator(t.Context(), "Git", &genmock.Generator{}, newClientset(address, "wrong-token")).GenerateParams(appSetGenerator, appSet, nil)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		serverGenerator.AssertNotCalled(t, "GenerateParams", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("forwards the pull request events", func(t *testing.T) {
+		serverGenerator := &pullRequestEventRecorder{Generator: &genmock.Generator{}}
+		clientset := newClientset(startServer(t, map[string]generators.Generator{"PullRequest": serverGenerator}), testToken)
+
+		generator := generators.NewRemoteGenerator(t.Context(), "PullRequest", &genmock.Generator{}, clientset)
+		generator.(generators.PullRequestEventHandler).HandlePullRequestEvent(&generators.PullRequestEvent{
+			Owner:       "org",
+			Repo:        "repo",
+			APIRegexp:   regexp.MustCompile(`^https://api\.github\.com/$`),
+			PullRequest: &pullrequest.PullRequest{Number: 1, Branch: "branch1", HeadSHA: "ec26c3e57ca3a959ca5aad62de7213c562f8c821", Labels: []string{"preview"}},
+			Closed:      true,
+		})
+
+		require.Len(t, serverGenerator.events, 1)
+		event := serverGenerator.events[0]
+		assert.True(t, event.Matches(&argoprojiov1alpha1.PullRequestGenerator{Github: &argoprojiov1alpha1.PullRequestGeneratorGithub{Owner: "org", Repo: "repo"}}))
+		assert.Equal(t, &pullrequest.PullRequest{Number: 1, Branch: "branch1", HeadSHA: "ec26c3e57ca3a959ca5aad62de7213c562f8c821", Labels: []string{"preview"}}, event.PullRequest)
+		assert.True(t, event.Closed)
+	})
+}
+
+type pullRequestEventRecorder struct {
+	generators.Generator
+	events []*generators.PullRequestEvent
+}
+
+func (r *pullRequestEventRecorder) HandlePullRequestEvent(event *generators.PullRequestEvent) {
+	r.events = append(r.events, event)
+}
+
+func TestNewServer_RequiresToken(t *testing.T) {
+	_, err := NewServer(map[string]generators.Generator{}, nil, "", nil)
+	require.ErrorContains(t, err, "token is required")
+}
+
+func TestGenerateParams_InvalidRequest(t *testing.T) {
+	server, err := NewServer(map[string]generators.Generator{"Git": &genmock.Generator{}}, nil, testToken, nil)
+	require.NoError(t, err)
+
+	_, err = server.GenerateParams(context.Background(), &apiclient.GenerateParamsRequest{Generator: "Matrix"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GenerateParams(context.Background(), &apiclient.GenerateParamsRequest{Generator: "Git"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,197 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/enrichers"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/hook"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Runner invokes the pre-render and post-render hooks of ApplicationSets. The hook services are configured by
+// ConfigMaps of the Argo CD namespace, so that ApplicationSets can only invoke the services set up by the
+// administrators.
+type Runner struct {
+	client             client.Client
+	namespace          string
+	tokenRefStrictMode bool
+}
+
+// NewRunner returns a Runner looking up the ConfigMaps and Secrets of the hooks in the given namespace. The tokens of
+// the hooks referencing a secret store are looked up with the given strict mode.
+func NewRunner(c client.Client, namespace string, tokenRefStrictMode bool) *Runner {
+	return &Runner{
+		client:             c,
+		namespace:          namespace,
+		tokenRefStrictMode: tokenRefStrictMode,
+	}
+}
+
+// Enricher returns an Enricher running the given enricher, if any, followed by the pre-render hooks of the
+// ApplicationSet.
+func (r *Runner) Enricher(next enrichers.Enricher) enrichers.Enricher {
+	return enrichers.EnricherFunc(func(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+		if next != nil {
+			var err error
+			params, err = next.Enrich(ctx, appSet, params)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return r.PreRender(ctx, appSet, params)
+	})
+}
+
+// PreRender runs the pre-render hooks of the ApplicationSet in order, each hook receiving the parameter sets returned
+// by the previous one. Pre-render hook services implement the same API as the parameter enrichers.
+func (r *Runner) PreRender(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+	if appSet.Spec.Hooks == nil {
+		return params, nil
+	}
+	for _, h := range appSet.Spec.Hooks.PreRender {
+		failurePolicy, err := getFailurePolicy(h)
+		if err != nil {
+			return nil, err
+		}
+		enriched, err := r.preRender(ctx, h, appSet, params)
+		if err == nil && len(enriched) != len(params) {
+			err = fmt.Errorf("expected %d parameter sets, got %d", len(params), len(enriched))
+		}
+		if err != nil {
+			if failurePolicy == enrichers.FailurePolicyIgnore {
+				log.WithField("applicationset", appSet.Name).WithField("hook", h.Name).WithError(err).
+					Warn("error running pre-render hook, ignoring")
+				continue
+			}
+			return nil, fmt.Errorf("error running pre-render hook %s: %w", h.Name, err)
+		}
+		params = enriched
+	}
+	return params, nil
+}
+
+// PostRender runs the post-render hooks of the ApplicationSet against the rendered Applications, and returns an error
+// for each vetoed Application, by index of the Application.
+func (r *Runner) PostRender(ctx context.Context, appSet *argoprojiov1alpha1.ApplicationSet, apps []argoprojiov1alpha1.Application) (map[int]error, error) {
+	vetoes := map[int]error{}
+	if appSet.Spec.Hooks == nil || len(appSet.Spec.Hooks.PostRender) == 0 {
+		return vetoes, nil
+	}
+
+	indexesByName := map[string][]int{}
+	for i, app := range apps {
+		indexesByName[app.Name] = append(indexesByName[app.Name], i)
+	}
+
+	for _, h := range appSet.Spec.Hooks.PostRender {
+		failurePolicy, err := getFailurePolicy(h)
+		if err != nil {
+			return nil, err
+		}
+		res, err := r.postRender(ctx, h, appSet, apps)
+		if err != nil {
+			if failurePolicy == enrichers.FailurePolicyIgnore {
+				log.WithField("applicationset", appSet.Name).WithField("hook", h.Name).WithError(err).
+					Warn("error running post-render hook, ignoring")
+				continue
+			}
+			return nil, fmt.Errorf("error running post-render hook %s: %w", h.Name, err)
+		}
+		for _, veto := range res.Vetoes {
+			indexes, ok := indexesByName[veto.Application]
+			if !ok {
+				log.WithField("applicationset", appSet.Name).WithField("hook", h.Name).
+					Warnf("post-render hook vetoed unknown application %s", veto.Application)
+				continue
+			}
+			for _, i := range indexes {
+				if vetoes[i] == nil {
+					vetoes[i] = fmt.Errorf("application %s was vetoed by post-render hook %s: %s", veto.Application, h.Name, veto.Reason)
+				}
+			}
+		}
+	}
+	return vetoes, nil
+}
+
+func (r *Runner) preRender(ctx context.Context, h argoprojiov1alpha1.ApplicationSetHook, appSet *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+	config, err := r.getConfig(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	return enrichers.NewHTTPEnricher(r.client, r.namespace, config.baseURL, config.tokenRef, config.requestTimeout, r.tokenRefStrictMode).Enrich(ctx, appSet, params)
+}
+
+func (r *Runner) postRender(ctx context.Context, h argoprojiov1alpha1.ApplicationSetHook, appSet *argoprojiov1alpha1.ApplicationSet, apps []argoprojiov1alpha1.Application) (*hook.PostRenderResponse, error) {
+	config, err := r.getConfig(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	var token string
+	if config.tokenRef != "" {
+		token, err = plugin.GetToken(ctx, r.client, r.namespace, config.tokenRef, r.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret token: %w", err)
+		}
+	}
+	service, err := hook.NewHookService(config.baseURL, token, config.requestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return service.PostRender(ctx, hook.PostRenderRequest{
+		ApplicationSetName:      appSet.Name,
+		ApplicationSetNamespace: appSet.Namespace,
+		Applications:            apps,
+	})
+}
+
+type hookConfig struct {
+	baseURL        string
+	tokenRef       string
+	requestTimeout int
+}
+
+// getConfig reads the configuration of the hook service from the ConfigMap referenced by the hook.
+func (r *Runner) getConfig(ctx context.Context, h argoprojiov1alpha1.ApplicationSetHook) (*hookConfig, error) {
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: h.ConfigMapRef.Name, Namespace: r.namespace}, cm); err != nil {
+		return nil, fmt.Errorf("error fetching ConfigMap: %w", err)
+	}
+
+	baseURL := cm.Data["baseUrl"]
+	if baseURL == "" {
+		return nil, errors.New("baseUrl not found in ConfigMap")
+	}
+
+	config := &hookConfig{
+		baseURL:  baseURL,
+		tokenRef: cm.Data["token"],
+	}
+	if requestTimeout, ok := cm.Data["requestTimeout"]; ok {
+		var err error
+		config.requestTimeout, err = strconv.Atoi(requestTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing requestTimeout: %w", err)
+		}
+	}
+	return config, nil
+}
+
+func getFailurePolicy(h argoprojiov1alpha1.ApplicationSetHook) (enrichers.FailurePolicy, error) {
+	switch failurePolicy := enrichers.FailurePolicy(h.FailurePolicy); failurePolicy {
+	case "":
+		return enrichers.FailurePolicyFail, nil
+	case enrichers.FailurePolicyFail, enrichers.FailurePolicyIgnore:
+		return failurePolicy, nil
+	default:
+		return "", fmt.Errorf("hook %s has an invalid failure policy %q: must be one of %s, %s", h.Name, failurePolicy, enrichers.FailurePolicyFail, enrichers.FailurePolicyIgnore)
+	}
+}
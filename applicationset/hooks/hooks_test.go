@@ -0,0 +1,181 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/enrichers"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/enricher"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/hook"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const token = "0bc57212c3cbbec69d20b34c507284bd300def5b"
+
+func newHookServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/enrich":
+			var request enricher.ServiceRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			for _, params := range request.Parameters {
+				params["team"] = "team-" + params["name"].(string)
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(enricher.ServiceResponse{Parameters: request.Parameters}))
+		case "/api/v1/post-render":
+			var request hook.PostRenderRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			var response hook.PostRenderResponse
+			for _, app := range request.Applications {
+				if app.Spec.Project == "default" {
+					response.Vetoes = append(response.Vetoes, hook.Veto{Application: app.Name, Reason: "the default project is forbidden"})
+				}
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(response))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newClient(t *testing.T, baseURL string) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "argocd"},
+			Data:       map[string]string{"baseUrl": baseURL, "token": "$hook.token"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unavailable", Namespace: "argocd"},
+			Data:       map[string]string{"baseUrl": "http://127.0.0.1:1"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+			Data:       map[string][]byte{"hook.token": []byte(token)},
+		},
+	).Build()
+}
+
+func newAppSet(hooks *argoprojiov1alpha1.ApplicationSetHooks) *argoprojiov1alpha1.ApplicationSet {
+	return &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"},
+		Spec:       argoprojiov1alpha1.ApplicationSetSpec{Hooks: hooks},
+	}
+}
+
+func TestPreRender(t *testing.T) {
+	ts := newHookServer(t)
+	runner := NewRunner(newClient(t, ts.URL), "argocd", false)
+	params := []map[string]any{{"name": "a"}, {"name": "b"}}
+
+	t.Run("no hooks", func(t *testing.T) {
+		res, err := runner.PreRender(t.Context(), newAppSet(nil), params)
+		require.NoError(t, err)
+		assert.Equal(t, params, res)
+	})
+
+	t.Run("mutates the parameters", func(t *testing.T) {
+		res, err := runner.PreRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PreRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "policy", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "policy"}}},
+		}), params)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"name": "a", "team": "team-a"}, {"name": "b", "team": "team-b"}}, res)
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		_, err := runner.PreRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PreRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "unavailable", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "unavailable"}}},
+		}), params)
+		require.ErrorContains(t, err, "error running pre-render hook unavailable")
+	})
+
+	t.Run("ignores the failure", func(t *testing.T) {
+		res, err := runner.PreRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PreRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "missing", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "missing"}, FailurePolicy: "Ignore"}},
+		}), params)
+		require.NoError(t, err)
+		assert.Equal(t, params, res)
+	})
+
+	t.Run("invalid failure policy", func(t *testing.T) {
+		_, err := runner.PreRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PreRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "policy", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "policy"}, FailurePolicy: "Retry"}},
+		}), params)
+		require.EqualError(t, err, `hook policy has an invalid failure policy "Retry": must be one of Fail, Ignore`)
+	})
+
+	t.Run("runs after the given enricher", func(t *testing.T) {
+		upper := enrichers.EnricherFunc(func(_ context.Context, _ *argoprojiov1alpha1.ApplicationSet, params []map[string]any) ([]map[string]any, error) {
+			res := make([]map[string]any, 0, len(params))
+			for _, p := range params {
+				res = append(res, map[string]any{"name": strings.ToUpper(p["name"].(string))})
+			}
+			return res, nil
+		})
+		res, err := runner.Enricher(upper).Enrich(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PreRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "policy", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "policy"}}},
+		}), params)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"name": "A", "team": "team-A"}, {"name": "B", "team": "team-B"}}, res)
+	})
+}
+
+func TestPostRender(t *testing.T) {
+	ts := newHookServer(t)
+	runner := NewRunner(newClient(t, ts.URL), "argocd", false)
+	apps := []argoprojiov1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}, Spec: argoprojiov1alpha1.ApplicationSpec{Project: "team-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}, Spec: argoprojiov1alpha1.ApplicationSpec{Project: "default"}},
+	}
+
+	t.Run("no hooks", func(t *testing.T) {
+		vetoes, err := runner.PostRender(t.Context(), newAppSet(nil), apps)
+		require.NoError(t, err)
+		assert.Empty(t, vetoes)
+	})
+
+	t.Run("vetoes applications", func(t *testing.T) {
+		vetoes, err := runner.PostRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PostRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "policy", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "policy"}}},
+		}), apps)
+		require.NoError(t, err)
+		require.Len(t, vetoes, 1)
+		require.EqualError(t, vetoes[1], "application app2 was vetoed by post-render hook policy: the default project is forbidden")
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		_, err := runner.PostRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PostRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "unavailable", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "unavailable"}}},
+		}), apps)
+		require.ErrorContains(t, err, "error running post-render hook unavailable")
+	})
+
+	t.Run("ignores the failure", func(t *testing.T) {
+		vetoes, err := runner.PostRender(t.Context(), newAppSet(&argoprojiov1alpha1.ApplicationSetHooks{
+			PostRender: []argoprojiov1alpha1.ApplicationSetHook{{Name: "unavailable", ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "unavailable"}, FailurePolicy: "Ignore"}},
+		}), apps)
+		require.NoError(t, err)
+		assert.Empty(t, vetoes)
+	})
+}
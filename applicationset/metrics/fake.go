@@ -16,6 +16,10 @@ func NewFakeAppsetMetrics() *ApplicationsetMetrics {
 	)
 
 	return &ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:     reconcileHistogram,
+		webhookEventsCounter:   newWebhookEventsCounter(),
+		requeueIntervalGauge:   newRequeueIntervalGauge(),
+		generatorErrorsCounter: newGeneratorErrorsCounter(),
+		appChangesCounter:      newAppChangesCounter(),
 	}
 }
@@ -1,11 +1,17 @@
 package metrics
 
 import (
+	"context"
+	"net/http"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -13,6 +19,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/metrics/kubectl"
 )
 
+// MetricsPath is the path the applicationset controller metrics are served on
+const MetricsPath = "/metrics"
+
 var (
 	descAppsetLabels        *prometheus.Desc
 	descAppsetDefaultLabels = []string{"namespace", "name"}
@@ -29,10 +38,42 @@ var (
 		descAppsetDefaultLabels,
 		nil,
 	)
+
+	descAppsetDriftedApps = prometheus.NewDesc(
+		"argocd_appset_drifted_applications",
+		"Number of applications which differ from the applications generated by the applicationset, and are not updated because of its sync policy",
+		descAppsetDefaultLabels,
+		nil,
+	)
+
+	descAppsetGeneratedParameterSets = prometheus.NewDesc(
+		"argocd_appset_generated_parameter_sets",
+		"Number of parameter sets produced by the generators of the applicationset in its last reconciliation, each of them being rendered into an application",
+		descAppsetDefaultLabels,
+		nil,
+	)
+
+	descAppsetLastSuccessfulReconcile = prometheus.NewDesc(
+		"argocd_appset_last_successful_reconcile_timestamp_seconds",
+		"Unix timestamp of the last successful reconciliation of the applicationset",
+		descAppsetDefaultLabels,
+		nil,
+	)
+
+	descAppsetLegacyTemplate = prometheus.NewDesc(
+		"argocd_appset_legacy_template",
+		"Set to 1 for the applicationsets rendered with the legacy template syntax instead of Go templates",
+		descAppsetDefaultLabels,
+		nil,
+	)
 )
 
 type ApplicationsetMetrics struct {
-	reconcileHistogram *prometheus.HistogramVec
+	reconcileHistogram     *prometheus.HistogramVec
+	webhookEventsCounter   *prometheus.CounterVec
+	requeueIntervalGauge   *prometheus.GaugeVec
+	generatorErrorsCounter *prometheus.CounterVec
+	appChangesCounter      *prometheus.CounterVec
 }
 
 type appsetCollector struct {
@@ -52,10 +93,19 @@ func NewApplicationsetMetrics(appsetLister applisters.ApplicationSetLister, apps
 		descAppsetDefaultLabels,
 	)
 
+	webhookEventsCounter := newWebhookEventsCounter()
+	requeueIntervalGauge := newRequeueIntervalGauge()
+	generatorErrorsCounter := newGeneratorErrorsCounter()
+	appChangesCounter := newAppChangesCounter()
+
 	appsetCollector := newAppsetCollector(appsetLister, appsetLabels, appsetFilter)
 
 	// Register collectors and metrics
 	metrics.Registry.MustRegister(reconcileHistogram)
+	metrics.Registry.MustRegister(webhookEventsCounter)
+	metrics.Registry.MustRegister(requeueIntervalGauge)
+	metrics.Registry.MustRegister(generatorErrorsCounter)
+	metrics.Registry.MustRegister(appChangesCounter)
 	metrics.Registry.MustRegister(appsetCollector)
 
 	kubectlMetricsServer := kubectl.NewKubectlMetrics()
@@ -63,12 +113,140 @@ func NewApplicationsetMetrics(appsetLister applisters.ApplicationSetLister, apps
 	kubectl.RegisterWithPrometheus(metrics.Registry)
 
 	return ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:     reconcileHistogram,
+		webhookEventsCounter:   webhookEventsCounter,
+		requeueIntervalGauge:   requeueIntervalGauge,
+		generatorErrorsCounter: generatorErrorsCounter,
+		appChangesCounter:      appChangesCounter,
+	}
+}
+
+func newWebhookEventsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_appset_webhook_events_total",
+			Help: "Number of webhook events received by the applicationset controller.",
+		},
+		[]string{"provider", "result"},
+	)
+}
+
+func newRequeueIntervalGauge() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_appset_requeue_interval_seconds",
+			Help: "Interval in seconds after which the applicationset is reconciled again, if it is periodically requeued.",
+		},
+		descAppsetDefaultLabels,
+	)
+}
+
+func newGeneratorErrorsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_appset_generator_errors_total",
+			Help: "Number of times the generators of the applicationset failed to generate its parameters, by generator.",
+		},
+		append(descAppsetDefaultLabels, "generator"),
+	)
+}
+
+func newAppChangesCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_appset_application_changes_total",
+			Help: "Number of applications created, updated and deleted by the applicationset controller, by applicationset and action.",
+		},
+		append(descAppsetDefaultLabels, "action"),
+	)
+}
+
+func newSCMAPIRequestsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_appset_scm_api_requests_total",
+			Help: "Number of requests sent to the SCM APIs by the SCM Provider and Pull Request generators.",
+		},
+		[]string{"host", "method", "status"},
+	)
+}
+
+// RegisterSCMAPIRequestsCounter registers and returns the counter of the requests sent to the SCM APIs.
+func RegisterSCMAPIRequestsCounter() *prometheus.CounterVec {
+	counter := newSCMAPIRequestsCounter()
+	metrics.Registry.MustRegister(counter)
+	return counter
+}
+
+func newRepoRequestsWaitingGauge() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_appset_repo_requests_waiting",
+			Help: "Number of repo server requests waiting for the concurrent requests to the same repository to complete.",
+		},
+		[]string{"repo"},
+	)
+}
+
+// RegisterRepoRequestsWaitingGauge registers and returns the gauge of the repo server requests waiting for the
+// concurrent requests to the same repository to complete.
+func RegisterRepoRequestsWaitingGauge() *prometheus.GaugeVec {
+	gauge := newRepoRequestsWaitingGauge()
+	metrics.Registry.MustRegister(gauge)
+	return gauge
+}
+
+// ObserveReconcile observes the reconcile duration of the applicationset. If the context holds a sampled span, the ID of
+// its trace is attached to the observation as an exemplar.
+func (m *ApplicationsetMetrics) ObserveReconcile(ctx context.Context, appset *argoappv1.ApplicationSet, duration time.Duration) {
+	metricsutil.ObserveWithTraceExemplar(ctx, m.reconcileHistogram.WithLabelValues(appset.Namespace, appset.Name), duration.Seconds())
+}
+
+// OpenMetricsFilterProvider is a metrics server filter provider serving the metrics in the OpenMetrics format to the
+// clients which accept it, which is required to expose the exemplars linking the reconcile metrics to the traces.
+func OpenMetricsFilterProvider(_ *rest.Config, _ *http.Client) (metricsserver.Filter, error) {
+	openMetricsHandler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling:     promhttp.HTTPErrorOnError,
+		EnableOpenMetrics: true,
+	})
+	return func(_ logr.Logger, handler http.Handler) (http.Handler, error) {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == MetricsPath {
+				openMetricsHandler.ServeHTTP(w, r)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		}), nil
+	}, nil
+}
+
+// SetRequeueInterval records the interval after which the applicationset is reconciled again. The series is removed
+// when the applicationset is not periodically requeued.
+func (m *ApplicationsetMetrics) SetRequeueInterval(appset *argoappv1.ApplicationSet, interval time.Duration) {
+	if interval <= 0 {
+		m.requeueIntervalGauge.DeleteLabelValues(appset.Namespace, appset.Name)
+		return
 	}
+	m.requeueIntervalGauge.WithLabelValues(appset.Namespace, appset.Name).Set(interval.Seconds())
 }
 
-func (m *ApplicationsetMetrics) ObserveReconcile(appset *argoappv1.ApplicationSet, duration time.Duration) {
-	m.reconcileHistogram.WithLabelValues(appset.Namespace, appset.Name).Observe(duration.Seconds())
+// IncGeneratorError increments the generator errors counter of the applicationset for the given generator
+func (m *ApplicationsetMetrics) IncGeneratorError(appset *argoappv1.ApplicationSet, generator string) {
+	m.generatorErrorsCounter.WithLabelValues(appset.Namespace, appset.Name, generator).Inc()
+}
+
+// IncApplicationChange increments the application changes counter of the applicationset for the given action:
+// created, updated or deleted. It is a no-op on nil metrics, as the reconcilers of the unit tests have none.
+func (m *ApplicationsetMetrics) IncApplicationChange(appset *argoappv1.ApplicationSet, action string) {
+	if m == nil {
+		return
+	}
+	m.appChangesCounter.WithLabelValues(appset.Namespace, appset.Name, action).Inc()
+}
+
+// IncWebhookEvent increments the webhook event counter for the given provider and result (accepted or rejected)
+func (m *ApplicationsetMetrics) IncWebhookEvent(provider, result string) {
+	m.webhookEventsCounter.WithLabelValues(provider, result).Inc()
 }
 
 func newAppsetCollector(lister applisters.ApplicationSetLister, labels []string, filter func(appset *argoappv1.ApplicationSet) bool) *appsetCollector {
@@ -94,6 +272,10 @@ func newAppsetCollector(lister applisters.ApplicationSetLister, labels []string,
 func (c *appsetCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descAppsetInfo
 	ch <- descAppsetGeneratedApps
+	ch <- descAppsetDriftedApps
+	ch <- descAppsetGeneratedParameterSets
+	ch <- descAppsetLastSuccessfulReconcile
+	ch <- descAppsetLegacyTemplate
 
 	if len(c.labels) > 0 {
 		ch <- descAppsetLabels
@@ -133,4 +315,16 @@ func collectAppset(appset *argoappv1.ApplicationSet, labelsToCollect []string, c
 
 	ch <- prometheus.MustNewConstMetric(descAppsetInfo, prometheus.GaugeValue, 1, appset.Namespace, appset.Name, resourceUpdateStatus)
 	ch <- prometheus.MustNewConstMetric(descAppsetGeneratedApps, prometheus.GaugeValue, float64(len(appset.Status.Resources)), appset.Namespace, appset.Name)
+	ch <- prometheus.MustNewConstMetric(descAppsetDriftedApps, prometheus.GaugeValue, float64(len(appset.Status.DriftedApplications)), appset.Namespace, appset.Name)
+	var parameterSets int64
+	for _, count := range appset.Status.GeneratedParameterSets {
+		parameterSets += count
+	}
+	ch <- prometheus.MustNewConstMetric(descAppsetGeneratedParameterSets, prometheus.GaugeValue, float64(parameterSets), appset.Namespace, appset.Name)
+	if appset.Status.LastSuccessfulReconcileAt != nil {
+		ch <- prometheus.MustNewConstMetric(descAppsetLastSuccessfulReconcile, prometheus.GaugeValue, float64(appset.Status.LastSuccessfulReconcileAt.Unix()), appset.Namespace, appset.Name)
+	}
+	if !appset.Spec.GoTemplate {
+		ch <- prometheus.MustNewConstMetric(descAppsetLegacyTemplate, prometheus.GaugeValue, 1, appset.Namespace, appset.Name)
+	}
 }
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -42,6 +44,7 @@ metadata:
     included/test: test
     not-included.label/test: test
 spec:
+  goTemplate: true
   generators:
   - git:
       directories:
@@ -61,6 +64,11 @@ spec:
         repoURL: https://github.com/test/test.git
         targetRevision: HEAD
 status:
+  lastSuccessfulReconcileAt: "2025-03-01T10:00:00Z"
+  driftedApplications:
+  - test-app2
+  generatedParameterSets:
+  - 3
   resources:
   - group: argoproj.io
     health:
@@ -188,6 +196,23 @@ argocd_appset_info{name="test1",namespace="argocd",resource_update_status="Appli
 	assert.Contains(t, rr.Body.String(), `
 argocd_appset_owned_applications{name="test1",namespace="argocd"} 2
 `)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_last_successful_reconcile_timestamp_seconds{name="test1",namespace="argocd"} 1.7408232e+09
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_drifted_applications{name="test1",namespace="argocd"} 1
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_drifted_applications{name="test2",namespace="argocd"} 0
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generated_parameter_sets{name="test1",namespace="argocd"} 3
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generated_parameter_sets{name="test2",namespace="argocd"} 0
+`)
+	// If the applicationset was never successfully reconciled, no timestamp is reported
+	assert.NotContains(t, rr.Body.String(), `argocd_appset_last_successful_reconcile_timestamp_seconds{name="test2"`)
 	// Test labels collection - should not include labels not included in the list of collected labels and include the ones that do.
 	assert.Contains(t, rr.Body.String(), `
 argocd_appset_labels{label_included_test="test",name="test1",namespace="argocd"} 1
@@ -205,6 +230,11 @@ argocd_appset_info{name="test2",namespace="argocd",resource_update_status="Unkno
 	assert.Contains(t, rr.Body.String(), `
 argocd_appset_owned_applications{name="test2",namespace="argocd"} 0
 `)
+	// Only the applicationsets which do not use Go templates are reported as using the legacy syntax
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_legacy_template{name="test2",namespace="argocd"} 1
+`)
+	assert.NotContains(t, rr.Body.String(), `argocd_appset_legacy_template{name="test1"`)
 	// Test that filter is working
 	assert.NotContains(t, rr.Body.String(), `name="should-be-filtered-out"`)
 }
@@ -220,7 +250,7 @@ func TestObserveReconcile(t *testing.T) {
 	require.NoError(t, err)
 	rr := httptest.NewRecorder()
 	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
-	appsetMetrics.ObserveReconcile(&appsetList[0], 5*time.Second)
+	appsetMetrics.ObserveReconcile(t.Context(), &appsetList[0], 5*time.Second)
 	handler.ServeHTTP(rr, req)
 	assert.Contains(t, rr.Body.String(), `
 argocd_appset_reconcile_sum{name="test1",namespace="argocd"} 5
@@ -231,6 +261,67 @@ argocd_appset_reconcile_count{name="test1",namespace="argocd"} 1
 `)
 }
 
+func TestObserveReconcileExemplar(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+	filterProvider, err := OpenMetricsFilterProvider(nil, nil)
+	require.NoError(t, err)
+	handler, err := filterProvider(logr.Discard(), http.NotFoundHandler())
+	require.NoError(t, err)
+
+	traceID := oteltrace.TraceID{0x01, 0x02, 0x03}
+	ctx := oteltrace.ContextWithSpanContext(t.Context(), oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     oteltrace.SpanID{0x04},
+		TraceFlags: oteltrace.FlagsSampled,
+	}))
+	appsetMetrics.ObserveReconcile(ctx, &appsetList[0], 5*time.Second)
+
+	req, err := http.NewRequest(http.MethodGet, MetricsPath, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `argocd_appset_reconcile_bucket{name="test1",namespace="argocd",le="5.0"} 1 # {trace_id="`+traceID.String()+`"} 5.0`)
+
+	// Other paths are served by the wrapped handler
+	req, err = http.NewRequest(http.MethodGet, "/other", nil)
+	require.NoError(t, err)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestSetRequeueInterval(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+
+	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+	scrape := func() string {
+		req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Body.String()
+	}
+
+	appsetMetrics.SetRequeueInterval(&appsetList[0], 3*time.Minute)
+	assert.Contains(t, scrape(), `
+argocd_appset_requeue_interval_seconds{name="test1",namespace="argocd"} 180
+`)
+
+	// the series is removed when the applicationset is not requeued anymore
+	appsetMetrics.SetRequeueInterval(&appsetList[0], 0)
+	assert.NotContains(t, scrape(), `argocd_appset_requeue_interval_seconds{name="test1"`)
+}
+
 func initializeClient(appsets []argoappv1.ApplicationSet) ctrlclient.WithWatch {
 	scheme := runtime.NewScheme()
 	err := argoappv1.AddToScheme(scheme)
@@ -250,3 +341,80 @@ func initializeClient(appsets []argoappv1.ApplicationSet) ctrlclient.WithWatch {
 func normalizeLabel(label string) string {
 	return metricsutil.NormalizeLabels("label", []string{label})[0]
 }
+
+func TestIncWebhookEvent(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+	appsetMetrics.IncWebhookEvent("github", "accepted")
+	appsetMetrics.IncWebhookEvent("github", "accepted")
+	appsetMetrics.IncWebhookEvent("gitlab", "rejected")
+	handler.ServeHTTP(rr, req)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_webhook_events_total{provider="github",result="accepted"} 2
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_webhook_events_total{provider="gitlab",result="rejected"} 1
+`)
+}
+
+func TestIncGeneratorError(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+	scmAPIRequests := RegisterSCMAPIRequestsCounter()
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+	appsetMetrics.IncGeneratorError(&appsetList[0], "Git")
+	appsetMetrics.IncGeneratorError(&appsetList[0], "Git")
+	appsetMetrics.IncGeneratorError(&appsetList[0], "Matrix")
+	scmAPIRequests.WithLabelValues("api.github.com", http.MethodGet, "304").Inc()
+	handler.ServeHTTP(rr, req)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_errors_total{generator="Git",name="test1",namespace="argocd"} 2
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_errors_total{generator="Matrix",name="test1",namespace="argocd"} 1
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_scm_api_requests_total{host="api.github.com",method="GET",status="304"} 1
+`)
+}
+
+func TestIncApplicationChange(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+	appsetMetrics.IncApplicationChange(&appsetList[0], "created")
+	appsetMetrics.IncApplicationChange(&appsetList[0], "created")
+	appsetMetrics.IncApplicationChange(&appsetList[0], "deleted")
+	handler.ServeHTTP(rr, req)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_application_changes_total{action="created",name="test1",namespace="argocd"} 2
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_application_changes_total{action="deleted",name="test1",namespace="argocd"} 1
+`)
+
+	var nilMetrics *ApplicationsetMetrics
+	assert.NotPanics(t, func() { nilMetrics.IncApplicationChange(&appsetList[0], "created") })
+}
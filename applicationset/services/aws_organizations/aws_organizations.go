@@ -0,0 +1,214 @@
+package aws_organizations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultRegion is the region of the AWS Organizations endpoint of the commercial partition, used when neither the
+// generator nor the pod environment provide one.
+const defaultRegion = "us-east-1"
+
+// Account is an account of an AWS Organization.
+type Account struct {
+	ID     string
+	Name   string
+	Email  string
+	ARN    string
+	Status string
+	// OrganizationalUnit is the organizational unit the account was listed from, it is empty when all the accounts of
+	// the organization are listed.
+	OrganizationalUnit string
+	Tags               map[string]string
+}
+
+// Service lists the accounts of an AWS Organization.
+type Service interface {
+	// ListAccounts returns the accounts of the given organizational units, and of their nested organizational units if
+	// recursive is true. All the accounts of the organization are returned if organizationalUnits is empty.
+	ListAccounts(ctx context.Context, organizationalUnits []string, recursive bool) ([]*Account, error)
+}
+
+// OrganizationsClient is a lean facade to the organizationsiface.OrganizationsAPI
+// it helps to reduce the mockery generated code.
+type OrganizationsClient interface {
+	ListAccountsWithContext(aws.Context, *organizations.ListAccountsInput, ...request.Option) (*organizations.ListAccountsOutput, error)
+	ListAccountsForParentWithContext(aws.Context, *organizations.ListAccountsForParentInput, ...request.Option) (*organizations.ListAccountsForParentOutput, error)
+	ListOrganizationalUnitsForParentWithContext(aws.Context, *organizations.ListOrganizationalUnitsForParentInput, ...request.Option) (*organizations.ListOrganizationalUnitsForParentOutput, error)
+	ListTagsForResourceWithContext(aws.Context, *organizations.ListTagsForResourceInput, ...request.Option) (*organizations.ListTagsForResourceOutput, error)
+}
+
+// Credentials is a static AWS access key, used instead of the identity of the pod when it is not empty.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type AWSOrganizationsService struct {
+	client OrganizationsClient
+}
+
+var _ Service = (*AWSOrganizationsService)(nil)
+
+// NewAWSOrganizationsService returns a Service authenticated with the AWS identity of the pod, such as IRSA, or with
+// the given static credentials. The given role is assumed if it is not empty.
+func NewAWSOrganizationsService(region string, role string, creds Credentials) (*AWSOrganizationsService, error) {
+	config := &aws.Config{}
+	if creds.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(creds.AccessKeyID, creds.SecretAccessKey, "")
+	}
+	podSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new AWS pod session: %w", err)
+	}
+	organizationsSession := podSession
+	if role != "" {
+		log.Debugf("role %s is provided for AWS Organizations", role)
+		organizationsSession, err = session.NewSession(&aws.Config{
+			Credentials: stscreds.NewCredentials(podSession, role),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating new AWS Organizations session: %w", err)
+		}
+	}
+	if region == "" && aws.StringValue(podSession.Config.Region) == "" {
+		region = defaultRegion
+	}
+	if region != "" {
+		organizationsSession = organizationsSession.Copy(&aws.Config{
+			Region: aws.String(region),
+		})
+	}
+	return NewAWSOrganizationsServiceWithClient(organizations.New(organizationsSession)), nil
+}
+
+// NewAWSOrganizationsServiceWithClient returns a Service using the given client.
+func NewAWSOrganizationsServiceWithClient(client OrganizationsClient) *AWSOrganizationsService {
+	return &AWSOrganizationsService{client: client}
+}
+
+func (s *AWSOrganizationsService) ListAccounts(ctx context.Context, organizationalUnits []string, recursive bool) ([]*Account, error) {
+	var accounts []*Account
+	if len(organizationalUnits) == 0 {
+		input := &organizations.ListAccountsInput{}
+		for {
+			output, err := s.client.ListAccountsWithContext(ctx, input)
+			if err != nil {
+				return nil, fmt.Errorf("error listing AWS accounts: %w", err)
+			}
+			for _, account := range output.Accounts {
+				accounts = append(accounts, newAccount(account, ""))
+			}
+			if aws.StringValue(output.NextToken) == "" {
+				break
+			}
+			input.NextToken = output.NextToken
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, organizationalUnit := range organizationalUnits {
+			ouAccounts, err := s.listOrganizationalUnitAccounts(ctx, organizationalUnit, recursive)
+			if err != nil {
+				return nil, err
+			}
+			// An account belongs to a single organizational unit, but the same unit may be listed several times when
+			// the given units are nested
+			for _, account := range ouAccounts {
+				if !seen[account.ID] {
+					seen[account.ID] = true
+					accounts = append(accounts, account)
+				}
+			}
+		}
+	}
+
+	for _, account := range accounts {
+		tags, err := s.listTags(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+		account.Tags = tags
+	}
+	return accounts, nil
+}
+
+// listOrganizationalUnitAccounts returns the accounts of the given organizational unit, followed by the accounts of
+// its nested organizational units if recursive is true.
+func (s *AWSOrganizationsService) listOrganizationalUnitAccounts(ctx context.Context, organizationalUnit string, recursive bool) ([]*Account, error) {
+	var accounts []*Account
+	input := &organizations.ListAccountsForParentInput{ParentId: aws.String(organizationalUnit)}
+	for {
+		output, err := s.client.ListAccountsForParentWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("error listing AWS accounts of organizational unit %s: %w", organizationalUnit, err)
+		}
+		for _, account := range output.Accounts {
+			accounts = append(accounts, newAccount(account, organizationalUnit))
+		}
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	if !recursive {
+		return accounts, nil
+	}
+
+	childInput := &organizations.ListOrganizationalUnitsForParentInput{ParentId: aws.String(organizationalUnit)}
+	for {
+		output, err := s.client.ListOrganizationalUnitsForParentWithContext(ctx, childInput)
+		if err != nil {
+			return nil, fmt.Errorf("error listing AWS organizational units of %s: %w", organizationalUnit, err)
+		}
+		for _, child := range output.OrganizationalUnits {
+			childAccounts, err := s.listOrganizationalUnitAccounts(ctx, aws.StringValue(child.Id), true)
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, childAccounts...)
+		}
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		childInput.NextToken = output.NextToken
+	}
+	return accounts, nil
+}
+
+func (s *AWSOrganizationsService) listTags(ctx context.Context, accountID string) (map[string]string, error) {
+	tags := map[string]string{}
+	input := &organizations.ListTagsForResourceInput{ResourceId: aws.String(accountID)}
+	for {
+		output, err := s.client.ListTagsForResourceWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags of AWS account %s: %w", accountID, err)
+		}
+		for _, tag := range output.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return tags, nil
+}
+
+func newAccount(account *organizations.Account, organizationalUnit string) *Account {
+	return &Account{
+		ID:                 aws.StringValue(account.Id),
+		Name:               aws.StringValue(account.Name),
+		Email:              aws.StringValue(account.Email),
+		ARN:                aws.StringValue(account.Arn),
+		Status:             aws.StringValue(account.Status),
+		OrganizationalUnit: organizationalUnit,
+	}
+}
@@ -0,0 +1,158 @@
+package aws_organizations
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOrganizationsClient serves an organization from memory, returning a single item per page to exercise the
+// pagination.
+type fakeOrganizationsClient struct {
+	accounts map[string][]*organizations.Account
+	children map[string][]string
+	tags     map[string]map[string]string
+	err      error
+}
+
+// page returns the index of the item of the given token, and the token of the next item if any.
+func page(length int, token *string) (int, *string) {
+	index := 0
+	if token != nil {
+		index, _ = strconv.Atoi(*token)
+	}
+	if index+1 < length {
+		return index, aws.String(strconv.Itoa(index + 1))
+	}
+	return index, nil
+}
+
+func (c *fakeOrganizationsClient) ListAccountsWithContext(_ aws.Context, input *organizations.ListAccountsInput, _ ...request.Option) (*organizations.ListAccountsOutput, error) {
+	var all []*organizations.Account
+	for _, parent := range []string{"r-root", "ou-a", "ou-a-1", "ou-b"} {
+		all = append(all, c.accounts[parent]...)
+	}
+	index, next := page(len(all), input.NextToken)
+	return &organizations.ListAccountsOutput{Accounts: all[index : index+1], NextToken: next}, c.err
+}
+
+func (c *fakeOrganizationsClient) ListAccountsForParentWithContext(_ aws.Context, input *organizations.ListAccountsForParentInput, _ ...request.Option) (*organizations.ListAccountsForParentOutput, error) {
+	accounts := c.accounts[*input.ParentId]
+	if len(accounts) == 0 {
+		return &organizations.ListAccountsForParentOutput{}, c.err
+	}
+	index, next := page(len(accounts), input.NextToken)
+	return &organizations.ListAccountsForParentOutput{Accounts: accounts[index : index+1], NextToken: next}, c.err
+}
+
+func (c *fakeOrganizationsClient) ListOrganizationalUnitsForParentWithContext(_ aws.Context, input *organizations.ListOrganizationalUnitsForParentInput, _ ...request.Option) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	var units []*organizations.OrganizationalUnit
+	for _, child := range c.children[*input.ParentId] {
+		units = append(units, &organizations.OrganizationalUnit{Id: aws.String(child)})
+	}
+	return &organizations.ListOrganizationalUnitsForParentOutput{OrganizationalUnits: units}, nil
+}
+
+func (c *fakeOrganizationsClient) ListTagsForResourceWithContext(_ aws.Context, input *organizations.ListTagsForResourceInput, _ ...request.Option) (*organizations.ListTagsForResourceOutput, error) {
+	var tags []*organizations.Tag
+	for key, value := range c.tags[*input.ResourceId] {
+		tags = append(tags, &organizations.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return &organizations.ListTagsForResourceOutput{Tags: tags}, nil
+}
+
+func newFakeAccount(id string, status string) *organizations.Account {
+	return &organizations.Account{
+		Id:     aws.String(id),
+		Name:   aws.String("account-" + id),
+		Email:  aws.String(id + "@example.com"),
+		Arn:    aws.String("arn:aws:organizations::000000000000:account/o-example/" + id),
+		Status: aws.String(status),
+	}
+}
+
+func newFakeOrganizationsClient() *fakeOrganizationsClient {
+	return &fakeOrganizationsClient{
+		accounts: map[string][]*organizations.Account{
+			"r-root": {newFakeAccount("000000000000", organizations.AccountStatusActive)},
+			"ou-a":   {newFakeAccount("111111111111", organizations.AccountStatusActive), newFakeAccount("222222222222", organizations.AccountStatusSuspended)},
+			"ou-a-1": {newFakeAccount("333333333333", organizations.AccountStatusActive)},
+			"ou-b":   {newFakeAccount("444444444444", organizations.AccountStatusActive)},
+		},
+		children: map[string][]string{
+			"r-root": {"ou-a", "ou-b"},
+			"ou-a":   {"ou-a-1"},
+		},
+		tags: map[string]map[string]string{
+			"111111111111": {"env": "prod", "team": "payments"},
+		},
+	}
+}
+
+func accountIDs(accounts []*Account) []string {
+	ids := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		ids = append(ids, account.ID)
+	}
+	return ids
+}
+
+func TestListAccounts(t *testing.T) {
+	service := NewAWSOrganizationsServiceWithClient(newFakeOrganizationsClient())
+
+	accounts, err := service.ListAccounts(t.Context(), nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"000000000000", "111111111111", "222222222222", "333333333333", "444444444444"}, accountIDs(accounts))
+	assert.Equal(t, &Account{
+		ID:     "111111111111",
+		Name:   "account-111111111111",
+		Email:  "111111111111@example.com",
+		ARN:    "arn:aws:organizations::000000000000:account/o-example/111111111111",
+		Status: organizations.AccountStatusActive,
+		Tags:   map[string]string{"env": "prod", "team": "payments"},
+	}, accounts[1])
+	assert.Equal(t, map[string]string{}, accounts[0].Tags)
+}
+
+func TestListAccountsOrganizationalUnits(t *testing.T) {
+	service := NewAWSOrganizationsServiceWithClient(newFakeOrganizationsClient())
+
+	t.Run("not recursive", func(t *testing.T) {
+		accounts, err := service.ListAccounts(t.Context(), []string{"ou-a", "ou-b"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"111111111111", "222222222222", "444444444444"}, accountIDs(accounts))
+		assert.Equal(t, "ou-a", accounts[0].OrganizationalUnit)
+		assert.Equal(t, "ou-b", accounts[2].OrganizationalUnit)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		accounts, err := service.ListAccounts(t.Context(), []string{"ou-a"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"111111111111", "222222222222", "333333333333"}, accountIDs(accounts))
+		assert.Equal(t, "ou-a-1", accounts[2].OrganizationalUnit)
+	})
+
+	t.Run("nested units are listed once", func(t *testing.T) {
+		accounts, err := service.ListAccounts(t.Context(), []string{"ou-a", "ou-a-1"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"111111111111", "222222222222", "333333333333"}, accountIDs(accounts))
+	})
+}
+
+func TestListAccountsError(t *testing.T) {
+	client := newFakeOrganizationsClient()
+	client.err = errors.New("AccessDeniedException")
+	service := NewAWSOrganizationsServiceWithClient(client)
+
+	_, err := service.ListAccounts(t.Context(), nil, false)
+	require.EqualError(t, err, "error listing AWS accounts: AccessDeniedException")
+
+	_, err = service.ListAccounts(t.Context(), []string{"ou-b"}, false)
+	require.EqualError(t, err, "error listing AWS accounts of organizational unit ou-b: AccessDeniedException")
+}
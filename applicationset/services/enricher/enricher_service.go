@@ -0,0 +1,65 @@
+package enricher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	internalhttp "github.com/argoproj/argo-cd/v3/applicationset/services/internal/http"
+)
+
+// ServiceRequest is the request object sent to the enricher service.
+type ServiceRequest struct {
+	// ApplicationSetName is the name of the ApplicationSet whose parameters are enriched.
+	ApplicationSetName string `json:"applicationSetName"`
+	// ApplicationSetNamespace is the namespace of the ApplicationSet whose parameters are enriched.
+	ApplicationSetNamespace string `json:"applicationSetNamespace"`
+	// Parameters is the list of parameter sets produced by a generator of the ApplicationSet.
+	Parameters []map[string]any `json:"parameters"`
+}
+
+// ServiceResponse is the response object returned by the enricher service.
+type ServiceResponse struct {
+	// Parameters is the list of enriched parameter sets, in the same order as the ones of the request.
+	Parameters []map[string]any `json:"parameters"`
+}
+
+type Service struct {
+	client *internalhttp.Client
+}
+
+func NewEnricherService(baseURL string, token string, requestTimeout int) (*Service, error) {
+	var clientOptionFns []internalhttp.ClientOptionFunc
+
+	clientOptionFns = append(clientOptionFns, internalhttp.WithToken(token))
+
+	if requestTimeout != 0 {
+		clientOptionFns = append(clientOptionFns, internalhttp.WithTimeout(requestTimeout))
+	}
+
+	client, err := internalhttp.NewClient(baseURL, clientOptionFns...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating enricher client: %w", err)
+	}
+
+	return &Service{
+		client: client,
+	}, nil
+}
+
+// Enrich sends the parameter sets to the enricher service and returns its response.
+func (s *Service) Enrich(ctx context.Context, request ServiceRequest) (*ServiceResponse, error) {
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "api/v1/enrich", request)
+	if err != nil {
+		return nil, fmt.Errorf("NewRequest returned unexpected error: %w", err)
+	}
+
+	var data ServiceResponse
+
+	_, err = s.client.Do(req, &data)
+	if err != nil {
+		return nil, fmt.Errorf("error enriching parameters of '%s': %w", request.ApplicationSetName, err)
+	}
+
+	return &data, nil
+}
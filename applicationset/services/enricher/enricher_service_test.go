@@ -0,0 +1,57 @@
+package enricher
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrich(t *testing.T) {
+	token := "0bc57212c3cbbec69d20b34c507284bd300def5b"
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "/api/v1/enrich", r.URL.Path)
+
+		var request ServiceRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		for _, params := range request.Parameters {
+			params["costCenter"] = request.ApplicationSetNamespace + "-" + request.ApplicationSetName
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(ServiceResponse{Parameters: request.Parameters}))
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	t.Run("enriches the parameters", func(t *testing.T) {
+		client, err := NewEnricherService(ts.URL, token, 0)
+		require.NoError(t, err)
+
+		data, err := client.Enrich(t.Context(), ServiceRequest{
+			ApplicationSetName:      "appset",
+			ApplicationSetNamespace: "argocd",
+			Parameters:              []map[string]any{{"cluster": "a"}, {"cluster": "b"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{
+			{"cluster": "a", "costCenter": "argocd-appset"},
+			{"cluster": "b", "costCenter": "argocd-appset"},
+		}, data.Parameters)
+	})
+
+	t.Run("returns the error of the service", func(t *testing.T) {
+		client, err := NewEnricherService(ts.URL, "wrong-token", 0)
+		require.NoError(t, err)
+
+		_, err = client.Enrich(t.Context(), ServiceRequest{ApplicationSetName: "appset"})
+		require.ErrorContains(t, err, "status code 401")
+	})
+}
@@ -0,0 +1,74 @@
+package hook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	internalhttp "github.com/argoproj/argo-cd/v3/applicationset/services/internal/http"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// PostRenderRequest is the request object sent to a post-render hook service.
+type PostRenderRequest struct {
+	// ApplicationSetName is the name of the ApplicationSet whose Applications were rendered.
+	ApplicationSetName string `json:"applicationSetName"`
+	// ApplicationSetNamespace is the namespace of the ApplicationSet whose Applications were rendered.
+	ApplicationSetNamespace string `json:"applicationSetNamespace"`
+	// Applications is the list of rendered Applications.
+	Applications []argoprojiov1alpha1.Application `json:"applications"`
+}
+
+// Veto is the refusal of a post-render hook service to let an Application be created or updated.
+type Veto struct {
+	// Application is the name of the vetoed Application.
+	Application string `json:"application"`
+	// Reason is a human-readable explanation of the veto.
+	Reason string `json:"reason"`
+}
+
+// PostRenderResponse is the response object returned by a post-render hook service.
+type PostRenderResponse struct {
+	// Vetoes is the list of the vetoed Applications. Applications which are not listed are allowed.
+	Vetoes []Veto `json:"vetoes,omitempty"`
+}
+
+type Service struct {
+	client *internalhttp.Client
+}
+
+func NewHookService(baseURL string, token string, requestTimeout int) (*Service, error) {
+	var clientOptionFns []internalhttp.ClientOptionFunc
+
+	clientOptionFns = append(clientOptionFns, internalhttp.WithToken(token))
+
+	if requestTimeout != 0 {
+		clientOptionFns = append(clientOptionFns, internalhttp.WithTimeout(requestTimeout))
+	}
+
+	client, err := internalhttp.NewClient(baseURL, clientOptionFns...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating hook client: %w", err)
+	}
+
+	return &Service{
+		client: client,
+	}, nil
+}
+
+// PostRender sends the rendered Applications to the post-render hook service and returns its response.
+func (s *Service) PostRender(ctx context.Context, request PostRenderRequest) (*PostRenderResponse, error) {
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "api/v1/post-render", request)
+	if err != nil {
+		return nil, fmt.Errorf("NewRequest returned unexpected error: %w", err)
+	}
+
+	var data PostRenderResponse
+
+	_, err = s.client.Do(req, &data)
+	if err != nil {
+		return nil, fmt.Errorf("error running post-render hook for '%s': %w", request.ApplicationSetName, err)
+	}
+
+	return &data, nil
+}
@@ -0,0 +1,63 @@
+package hook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPostRender(t *testing.T) {
+	token := "0bc57212c3cbbec69d20b34c507284bd300def5b"
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "/api/v1/post-render", r.URL.Path)
+
+		var request PostRenderRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var response PostRenderResponse
+		for _, app := range request.Applications {
+			if app.Spec.Project == "default" {
+				response.Vetoes = append(response.Vetoes, Veto{Application: app.Name, Reason: "the default project is forbidden in " + request.ApplicationSetNamespace})
+			}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	t.Run("returns the vetoes", func(t *testing.T) {
+		client, err := NewHookService(ts.URL, token, 0)
+		require.NoError(t, err)
+
+		data, err := client.PostRender(t.Context(), PostRenderRequest{
+			ApplicationSetName:      "appset",
+			ApplicationSetNamespace: "argocd",
+			Applications: []argoprojiov1alpha1.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "app1"}, Spec: argoprojiov1alpha1.ApplicationSpec{Project: "team-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app2"}, Spec: argoprojiov1alpha1.ApplicationSpec{Project: "default"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []Veto{{Application: "app2", Reason: "the default project is forbidden in argocd"}}, data.Vetoes)
+	})
+
+	t.Run("returns the error of the service", func(t *testing.T) {
+		client, err := NewHookService(ts.URL, "wrong-token", 0)
+		require.NoError(t, err)
+
+		_, err = client.PostRender(t.Context(), PostRenderRequest{ApplicationSetName: "appset"})
+		require.ErrorContains(t, err, "status code 401")
+	})
+}
@@ -8,11 +8,12 @@ import (
 	"github.com/google/go-github/v69/github"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_cache"
 )
 
 // Client builds a github client for the given app authentication.
 func Client(g github_app_auth.Authentication, url string) (*github.Client, error) {
-	rt, err := ghinstallation.New(http.DefaultTransport, g.Id, g.InstallationId, []byte(g.PrivateKey))
+	rt, err := ghinstallation.New(scm_cache.Default.Transport(http.DefaultTransport), g.Id, g.InstallationId, []byte(g.PrivateKey))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create github app install: %w", err)
 	}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	apiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -43,29 +45,68 @@ func (_m *Repos) GetDirectories(ctx context.Context, repoURL string, revision st
 	return r0, r1
 }
 
-// GetFiles provides a mock function with given fields: ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit
-func (_m *Repos) GetFiles(ctx context.Context, repoURL string, revision string, project string, pattern string, noRevisionCache bool, verifyCommit bool) (map[string][]byte, error) {
-	ret := _m.Called(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit)
+// GetFiles provides a mock function with given fields: ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits
+func (_m *Repos) GetFiles(ctx context.Context, repoURL string, revision string, project string, pattern string, noRevisionCache bool, verifyCommit bool, includeCommits bool) (map[string][]byte, map[string]*apiclient.GitFileCommit, error) {
+	ret := _m.Called(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFiles")
 	}
 
 	var r0 map[string][]byte
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool, bool) (map[string][]byte, error)); ok {
-		return rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit)
+	var r1 map[string]*apiclient.GitFileCommit
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool, bool, bool) (map[string][]byte, map[string]*apiclient.GitFileCommit, error)); ok {
+		return rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool, bool) map[string][]byte); ok {
-		r0 = rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool, bool, bool) map[string][]byte); ok {
+		r0 = rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string][]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, bool, bool) error); ok {
-		r1 = rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, bool, bool, bool) map[string]*apiclient.GitFileCommit); ok {
+		r1 = rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]*apiclient.GitFileCommit)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string, bool, bool, bool) error); ok {
+		r2 = rf(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetHelmChartVersions provides a mock function with given fields: ctx, repoURL, project, chart
+func (_m *Repos) GetHelmChartVersions(ctx context.Context, repoURL string, project string, chart string) ([]string, error) {
+	ret := _m.Called(ctx, repoURL, project, chart)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHelmChartVersions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]string, error)); ok {
+		return rf(ctx, repoURL, project, chart)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []string); ok {
+		r0 = rf(ctx, repoURL, project, chart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, repoURL, project, chart)
 	} else {
 		r1 = ret.Error(1)
 	}
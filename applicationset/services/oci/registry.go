@@ -0,0 +1,147 @@
+package oci
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+const (
+	dockerHubHost         = "docker.io"
+	dockerHubRegistryHost = "registry-1.docker.io"
+)
+
+// Registry lists the tags of an OCI repository.
+type Registry interface {
+	// ListTags returns the tags of the repository, in the order returned by the registry.
+	ListTags(ctx context.Context) ([]string, error)
+}
+
+// Credentials are the basic auth credentials used to authenticate to a registry. Registries relying on token
+// authentication, such as Docker Hub, GHCR or Harbor, exchange them for a token.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+type remoteRegistry struct {
+	repo *remote.Repository
+}
+
+var _ Registry = (*remoteRegistry)(nil)
+
+// NewRegistry returns a Registry listing the tags of the given repository, for example ghcr.io/argoproj/argo-helm/argo-cd.
+// The oci:// prefix is optional. Anonymous access is used when the credentials are empty.
+func NewRegistry(repoURL string, creds Credentials, insecure bool) (Registry, error) {
+	repo, err := remote.NewRepository(NormalizeRepoURL(repoURL))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing OCI repository %q: %w", repoURL, err)
+	}
+
+	httpClient := &http.Client{}
+	if insecure {
+		httpClient.Transport = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			// #nosec G402
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	client := &auth.Client{
+		Client: httpClient,
+		Cache:  auth.NewCache(),
+	}
+	if creds.Username != "" || creds.Password != "" {
+		client.Credential = auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+			Username: creds.Username,
+			Password: creds.Password,
+		})
+	}
+	repo.Client = client
+
+	return &remoteRegistry{repo: repo}, nil
+}
+
+func (r *remoteRegistry) ListTags(ctx context.Context) ([]string, error) {
+	var tags []string
+	err := r.repo.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags of %s: %w", r.repo.Reference, err)
+	}
+	return tags, nil
+}
+
+// NormalizeRepoURL removes the oci:// prefix of the given repository and expands the Docker Hub short names, for
+// example docker.io/nginx to registry-1.docker.io/library/nginx.
+func NormalizeRepoURL(repoURL string) string {
+	repoURL = strings.TrimSuffix(strings.TrimPrefix(repoURL, "oci://"), "/")
+
+	host, path, found := strings.Cut(repoURL, "/")
+	if !found || (host != dockerHubHost && host != "index."+dockerHubHost) {
+		return repoURL
+	}
+	if !strings.Contains(path, "/") {
+		path = "library/" + path
+	}
+	return dockerHubRegistryHost + "/" + path
+}
+
+// GetAWSECRCredentials returns the credentials of an Amazon ECR registry, obtained with the AWS identity of the pod,
+// or with the given role if it is not empty. The region of the pod is used if region is empty.
+func GetAWSECRCredentials(ctx context.Context, region string, role string) (Credentials, error) {
+	podSession, err := session.NewSession()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error creating new AWS pod session: %w", err)
+	}
+	ecrSession := podSession
+	if role != "" {
+		log.Debugf("role %s is provided for AWS ECR authentication", role)
+		ecrSession, err = session.NewSession(&aws.Config{
+			Credentials: stscreds.NewCredentials(podSession, role),
+		})
+		if err != nil {
+			return Credentials{}, fmt.Errorf("error creating new AWS ECR session: %w", err)
+		}
+	}
+	if region != "" {
+		ecrSession = ecrSession.Copy(&aws.Config{
+			Region: aws.String(region),
+		})
+	}
+
+	output, err := ecr.New(ecrSession).GetAuthorizationTokenWithContext(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error getting AWS ECR authorization token: %w", err)
+	}
+	if len(output.AuthorizationData) == 0 || output.AuthorizationData[0].AuthorizationToken == nil {
+		return Credentials{}, errors.New("no AWS ECR authorization token was returned")
+	}
+	return decodeAuthorizationToken(*output.AuthorizationData[0].AuthorizationToken)
+}
+
+// decodeAuthorizationToken decodes an ECR authorization token, which is the base64 encoding of "username:password".
+func decodeAuthorizationToken(token string) (Credentials, error) {
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error decoding AWS ECR authorization token: %w", err)
+	}
+	username, password, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return Credentials{}, errors.New("invalid AWS ECR authorization token")
+	}
+	return Credentials{Username: username, Password: password}, nil
+}
@@ -0,0 +1,82 @@
+package oci
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRepoURL(t *testing.T) {
+	cases := []struct {
+		repoURL  string
+		expected string
+	}{
+		{"ghcr.io/argoproj/argo-helm/argo-cd", "ghcr.io/argoproj/argo-helm/argo-cd"},
+		{"oci://ghcr.io/argoproj/argo-helm/argo-cd/", "ghcr.io/argoproj/argo-helm/argo-cd"},
+		{"docker.io/bitnami/nginx", "registry-1.docker.io/bitnami/nginx"},
+		{"docker.io/nginx", "registry-1.docker.io/library/nginx"},
+		{"oci://index.docker.io/nginx", "registry-1.docker.io/library/nginx"},
+		{"harbor.example.com:8443/project/chart", "harbor.example.com:8443/project/chart"},
+	}
+	for _, c := range cases {
+		t.Run(c.repoURL, func(t *testing.T) {
+			assert.Equal(t, c.expected, NormalizeRepoURL(c.repoURL))
+		})
+	}
+}
+
+func TestListTags(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/project/chart/tags/list" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "password" {
+			w.Header().Set("Www-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"project/chart","tags":["1.0.0","1.1.0","latest"]}`))
+	}))
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "https://")
+
+	t.Run("authenticated", func(t *testing.T) {
+		registry, err := NewRegistry("oci://"+host+"/project/chart", Credentials{Username: "user", Password: "password"}, true)
+		require.NoError(t, err)
+		tags, err := registry.ListTags(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.0.0", "1.1.0", "latest"}, tags)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		registry, err := NewRegistry(host+"/project/chart", Credentials{}, true)
+		require.NoError(t, err)
+		_, err = registry.ListTags(t.Context())
+		require.Error(t, err)
+	})
+
+	t.Run("invalid repository", func(t *testing.T) {
+		_, err := NewRegistry("oci://"+host+"/Project", Credentials{}, true)
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeAuthorizationToken(t *testing.T) {
+	creds, err := decodeAuthorizationToken(base64.StdEncoding.EncodeToString([]byte("AWS:secret:with:colons")))
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{Username: "AWS", Password: "secret:with:colons"}, creds)
+
+	_, err = decodeAuthorizationToken(base64.StdEncoding.EncodeToString([]byte("no-separator")))
+	require.Error(t, err)
+
+	_, err = decodeAuthorizationToken("not base64!")
+	require.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/secret_store"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// FunctionRegistry looks up the template functions registered by the plugins, and makes them available to the Go
+// templates of the ApplicationSets. A plugin registers its functions with a ConfigMap of the Argo CD namespace labeled
+// with common.LabelKeyApplicationSetTemplateFunctions, holding:
+//   - baseUrl, token and requestTimeout, as for the plugin generator,
+//   - functions, the comma-separated names of the functions,
+//   - namespaces, the comma-separated namespaces, or glob patterns, of the ApplicationSets which may call the functions.
+//     The ApplicationSets of the Argo CD namespace may always call them.
+type FunctionRegistry struct {
+	client client.Reader
+	// namespace is the Argo CD namespace, holding the ConfigMaps and the Secrets of the plugins.
+	namespace string
+	// tokenRefStrictMode is the strict mode of the tokens of the plugins read from the secret stores.
+	tokenRefStrictMode bool
+}
+
+// NewFunctionRegistry returns a FunctionRegistry looking up the plugins in the given Argo CD namespace.
+func NewFunctionRegistry(c client.Reader, namespace string, tokenRefStrictMode bool) *FunctionRegistry {
+	return &FunctionRegistry{
+		client:             c,
+		namespace:          namespace,
+		tokenRefStrictMode: tokenRefStrictMode,
+	}
+}
+
+// Functions returns the template functions available to the ApplicationSets of the given namespace. The functions
+// call their plugin at most once per distinct list of arguments: the returned map is meant to render the templates of
+// an ApplicationSet once, and to be dropped afterwards.
+func (r *FunctionRegistry) Functions(ctx context.Context, namespace string, appSetName string) (template.FuncMap, error) {
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.client.List(ctx, configMaps, client.InNamespace(r.namespace), client.MatchingLabels{common.LabelKeyApplicationSetTemplateFunctions: "true"}); err != nil {
+		return nil, fmt.Errorf("error listing the ConfigMaps of the template function plugins: %w", err)
+	}
+	slices.SortFunc(configMaps.Items, func(a, b corev1.ConfigMap) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	funcMap := template.FuncMap{}
+	for _, cm := range configMaps.Items {
+		if namespace != r.namespace && !glob.MatchStringInList(splitList(cm.Data["namespaces"]), namespace, glob.REGEXP) {
+			continue
+		}
+		functions := splitList(cm.Data["functions"])
+		if len(functions) == 0 {
+			continue
+		}
+		service, err := r.newService(ctx, &cm, appSetName)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing the template function plugin %s: %w", cm.Name, err)
+		}
+		for _, function := range functions {
+			if _, ok := funcMap[function]; ok {
+				return nil, fmt.Errorf("template function %q is registered by several plugins", function)
+			}
+			funcMap[function] = newFunction(ctx, service, function)
+		}
+	}
+	return funcMap, nil
+}
+
+func (r *FunctionRegistry) newService(ctx context.Context, cm *corev1.ConfigMap, appSetName string) (*Service, error) {
+	baseURL := cm.Data["baseUrl"]
+	if baseURL == "" {
+		return nil, errors.New("baseUrl not found in ConfigMap")
+	}
+	token, err := GetToken(ctx, r.client, r.namespace, cm.Data["token"], r.tokenRefStrictMode)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Secret token: %w", err)
+	}
+	var requestTimeout int
+	if requestTimeoutStr, ok := cm.Data["requestTimeout"]; ok {
+		requestTimeout, err = strconv.Atoi(requestTimeoutStr)
+		if err != nil {
+			return nil, fmt.Errorf("error set requestTimeout : %w", err)
+		}
+	}
+	return NewPluginService(appSetName, baseURL, token, requestTimeout)
+}
+
+// newFunction returns a template function calling the given function of the plugin, and memoizing its results.
+func newFunction(ctx context.Context, service *Service, function string) func(args ...any) (any, error) {
+	var lock sync.Mutex
+	results := map[string]any{}
+	return func(args ...any) (any, error) {
+		key, err := json.Marshal(args)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling the arguments of template function %q: %w", function, err)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		if result, ok := results[string(key)]; ok {
+			return result, nil
+		}
+		result, err := service.CallFunction(ctx, function, args)
+		if err != nil {
+			return nil, err
+		}
+		results[string(key)] = result
+		return result, nil
+	}
+}
+
+// GetToken returns the token of a plugin, referenced as '$key' in the argocd-secret Secret, as '$secret:key' in
+// another Secret of the given namespace, or as 'secretstore://<store>/<path>#<key>' in an external secret store. In
+// strict mode, only the secret stores restricted to a path prefix can be read.
+func GetToken(ctx context.Context, c client.Reader, namespace string, tokenRef string, strict bool) (string, error) {
+	if secret_store.IsReference(tokenRef) {
+		return secret_store.Default.GetReference(ctx, namespace, tokenRef, strict)
+	}
+	if tokenRef == "" || !strings.HasPrefix(tokenRef, "$") {
+		return "", fmt.Errorf("token is empty, or does not reference a secret key starting with '$': %v", tokenRef)
+	}
+
+	secretName, tokenKey := ParseSecretKey(tokenRef)
+
+	secret := &corev1.Secret{}
+	err := c.Get(
+		ctx,
+		client.ObjectKey{
+			Name:      secretName,
+			Namespace: namespace,
+		},
+		secret)
+	if err != nil {
+		return "", fmt.Errorf("error fetching secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	secretValues := make(map[string]string, len(secret.Data))
+
+	for k, v := range secret.Data {
+		secretValues[k] = string(v)
+	}
+
+	return settings.ReplaceStringSecret(tokenKey, secretValues), nil
+}
+
+func splitList(value string) []string {
+	var res []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
@@ -0,0 +1,103 @@
+package plugin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func TestFunctionRegistry(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/function.execute", r.URL.Path)
+		assert.Equal(t, "Bearer plugin-token", r.Header.Get("Authorization"))
+		var request FunctionRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "guestbook", request.ApplicationSetName)
+		assert.Equal(t, "teamOf", request.Function)
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(FunctionResponse{Output: FunctionOutput{Result: "team-" + request.Args[0].(string)}}))
+	}))
+	defer ts.Close()
+
+	configMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argocd",
+				Labels:    map[string]string{common.LabelKeyApplicationSetTemplateFunctions: "true"},
+			},
+			Data: data,
+		}
+	}
+	naming := configMap("naming", map[string]string{
+		"baseUrl":    ts.URL,
+		"token":      "$plugin.token",
+		"functions":  "teamOf, clusterOf",
+		"namespaces": "team-*",
+	})
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd"},
+		Data:       map[string][]byte{"plugin.token": []byte("plugin-token")},
+	}
+
+	t.Run("functions are scoped to namespaces", func(t *testing.T) {
+		registry := NewFunctionRegistry(fake.NewClientBuilder().WithObjects(naming, secret).Build(), "argocd", false)
+		for _, c := range []struct {
+			namespace         string
+			expectedFunctions []string
+		}{
+			{namespace: "argocd", expectedFunctions: []string{"clusterOf", "teamOf"}},
+			{namespace: "team-a", expectedFunctions: []string{"clusterOf", "teamOf"}},
+			{namespace: "other"},
+		} {
+			funcMap, err := registry.Functions(t.Context(), c.namespace, "guestbook")
+			require.NoError(t, err)
+			var functions []string
+			for name := range funcMap {
+				functions = append(functions, name)
+			}
+			assert.ElementsMatch(t, c.expectedFunctions, functions, c.namespace)
+		}
+	})
+
+	t.Run("results are memoized", func(t *testing.T) {
+		calls.Store(0)
+		registry := NewFunctionRegistry(fake.NewClientBuilder().WithObjects(naming, secret).Build(), "argocd", false)
+		funcMap, err := registry.Functions(t.Context(), "team-a", "guestbook")
+		require.NoError(t, err)
+		tmpl, err := template.New("").Funcs(funcMap).Parse(`{{ teamOf "a" }},{{ teamOf "b" }},{{ teamOf "a" }}`)
+		require.NoError(t, err)
+		out := &strings.Builder{}
+		require.NoError(t, tmpl.Execute(out, nil))
+		assert.Equal(t, "team-a,team-b,team-a", out.String())
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("functions registered by several plugins", func(t *testing.T) {
+		other := configMap("other", map[string]string{"baseUrl": ts.URL, "token": "$plugin.token", "functions": "teamOf"})
+		registry := NewFunctionRegistry(fake.NewClientBuilder().WithObjects(naming, other, secret).Build(), "argocd", false)
+		_, err := registry.Functions(t.Context(), "argocd", "guestbook")
+		require.EqualError(t, err, `template function "teamOf" is registered by several plugins`)
+	})
+
+	t.Run("invalid plugin", func(t *testing.T) {
+		invalid := configMap("invalid", map[string]string{"baseUrl": ts.URL, "token": "$missing:token", "functions": "teamOf"})
+		registry := NewFunctionRegistry(fake.NewClientBuilder().WithObjects(invalid).Build(), "argocd", false)
+		_, err := registry.Functions(t.Context(), "argocd", "guestbook")
+		require.ErrorContains(t, err, "error initializing the template function plugin invalid: error fetching Secret token")
+	})
+}
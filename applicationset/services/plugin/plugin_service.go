@@ -21,6 +21,9 @@ type ServiceRequest struct {
 type Output struct {
 	// Parameters is the list of parameter sets returned by the plugin.
 	Parameters []map[string]any `json:"parameters"`
+	// RequeueAfterSeconds is the optional number of seconds after which the parameters are expected to change, and the
+	// ApplicationSet should be reconciled again. Zero disables the periodic reconciliation.
+	RequeueAfterSeconds *int64 `json:"requeueAfterSeconds,omitempty"`
 }
 
 // ServiceResponse is the response object returned by the plugin service.
@@ -29,6 +32,27 @@ type ServiceResponse struct {
 	Output Output `json:"output"`
 }
 
+// FunctionRequest is the request object sent to the plugin service to call one of its template functions.
+type FunctionRequest struct {
+	// ApplicationSetName is the name of the ApplicationSet whose template calls the function.
+	ApplicationSetName string `json:"applicationSetName"`
+	// Function is the name of the template function.
+	Function string `json:"function"`
+	// Args are the arguments of the function call.
+	Args []any `json:"args"`
+}
+
+// FunctionOutput holds the result of a template function call.
+type FunctionOutput struct {
+	// Result is the value returned by the template function.
+	Result any `json:"result"`
+}
+
+// FunctionResponse is the response object returned by the plugin service to a template function call.
+type FunctionResponse struct {
+	Output FunctionOutput `json:"output"`
+}
+
 type Service struct {
 	client     *internalhttp.Client
 	appSetName string
@@ -69,3 +93,20 @@ func (p *Service) List(ctx context.Context, parameters v1alpha1.PluginParameters
 
 	return &data, err
 }
+
+// CallFunction calls the given template function of the plugin with the given arguments, and returns its result.
+func (p *Service) CallFunction(ctx context.Context, function string, args []any) (any, error) {
+	req, err := p.client.NewRequestWithContext(ctx, http.MethodPost, "api/v1/function.execute", FunctionRequest{ApplicationSetName: p.appSetName, Function: function, Args: args})
+	if err != nil {
+		return nil, fmt.Errorf("NewRequest returned unexpected error: %w", err)
+	}
+
+	var data FunctionResponse
+
+	_, err = p.client.Do(req, &data)
+	if err != nil {
+		return nil, fmt.Errorf("error calling function '%s' for '%s': %w", function, p.appSetName, err)
+	}
+
+	return data.Output.Result, nil
+}
@@ -9,6 +9,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 func TestPlugin(t *testing.T) {
@@ -41,3 +44,36 @@ func TestPlugin(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, &expectedData, data)
 }
+
+func TestPluginRequest(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/getparams.execute", r.URL.Path)
+
+		var request map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		// The plugin service receives the name of the ApplicationSet along with the input parameters of the generator
+		assert.Equal(t, map[string]any{
+			"applicationSetName": "plugin-test",
+			"input": map[string]any{
+				"parameters": map[string]any{"team": "payments", "regions": []any{"eu", "us"}},
+			},
+		}, request)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"output": {"parameters": [{"cluster": "payments-eu"}, {"cluster": "payments-us"}]}}`))
+		assert.NoError(t, err)
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	client, err := NewPluginService("plugin-test", ts.URL, "token", 0)
+	require.NoError(t, err)
+
+	data, err := client.List(t.Context(), v1alpha1.PluginParameters{
+		"team":    apiextensionsv1.JSON{Raw: []byte(`"payments"`)},
+		"regions": apiextensionsv1.JSON{Raw: []byte(`["eu", "us"]`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"cluster": "payments-eu"}, {"cluster": "payments-us"}}, data.Output.Parameters)
+}
@@ -3,10 +3,11 @@ package pull_request
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 
 	"github.com/google/go-github/v69/github"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_cache"
 )
 
 type GithubService struct {
@@ -23,7 +24,7 @@ func NewGithubService(token, url, owner, repo string, labels []string) (PullRequ
 	if token == "" {
 		token = os.Getenv("GITHUB_TOKEN")
 	}
-	httpClient := &http.Client{}
+	httpClient := scm_cache.Default.Client()
 	var client *github.Client
 	if url == "" {
 		if token == "" {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_cache"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
@@ -37,7 +38,7 @@ func NewGitLabService(token, url, project string, labels []string, pullRequestSt
 	tr.TLSClientConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
 
 	retryClient := retryablehttp.NewClient()
-	retryClient.HTTPClient.Transport = tr
+	retryClient.HTTPClient.Transport = scm_cache.Default.Transport(tr)
 
 	clientOptionFns = append(clientOptionFns, gitlab.WithHTTPClient(retryClient.HTTPClient))
 
@@ -52,8 +52,21 @@ func ListPullRequests(ctx context.Context, provider PullRequestService, filters
 		return nil, err
 	}
 
+	return filterPullRequests(pullRequests, compiledFilters), nil
+}
+
+// FilterPullRequests returns the given pull requests which match one of the filters.
+func FilterPullRequests(pullRequests []*PullRequest, filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]*PullRequest, error) {
+	compiledFilters, err := compileFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	return filterPullRequests(pullRequests, compiledFilters), nil
+}
+
+func filterPullRequests(pullRequests []*PullRequest, compiledFilters []*Filter) []*PullRequest {
 	if len(compiledFilters) == 0 {
-		return pullRequests, nil
+		return pullRequests
 	}
 
 	filteredPullRequests := make([]*PullRequest, 0, len(pullRequests))
@@ -67,5 +80,5 @@ func ListPullRequests(ctx context.Context, provider PullRequestService, filters
 		}
 	}
 
-	return filteredPullRequests, nil
+	return filteredPullRequests
 }
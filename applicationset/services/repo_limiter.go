@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// repoLimitedService limits the number of concurrent requests to the repo server for each repository, so that the
+// ApplicationSets sharing a repository do not all hit the repo server at once. The other requests wait in queue.
+type repoLimitedService struct {
+	repos Repos
+	limit int64
+	// waiting counts the requests waiting for their turn, per repository. It may be nil.
+	waiting *prometheus.GaugeVec
+
+	lock       sync.Mutex
+	semaphores map[string]*semaphore.Weighted
+}
+
+// NewRepoLimitedService returns a Repos running at most limit concurrent requests per repository, keyed by normalized
+// repository URL, against the given Repos. The number of requests waiting for each repository is reported by the
+// waiting gauge, labelled with the repository, if not nil.
+func NewRepoLimitedService(repos Repos, limit int, waiting *prometheus.GaugeVec) Repos {
+	return &repoLimitedService{
+		repos:      repos,
+		limit:      int64(limit),
+		waiting:    waiting,
+		semaphores: map[string]*semaphore.Weighted{},
+	}
+}
+
+// acquire waits until a request to the given repository may run, and returns the function releasing it.
+func (s *repoLimitedService) acquire(ctx context.Context, repoURL string) (func(), error) {
+	repo := git.NormalizeGitURLAllowInvalid(repoURL)
+
+	s.lock.Lock()
+	sem, ok := s.semaphores[repo]
+	if !ok {
+		sem = semaphore.NewWeighted(s.limit)
+		s.semaphores[repo] = sem
+	}
+	s.lock.Unlock()
+
+	if !sem.TryAcquire(1) {
+		if s.waiting != nil {
+			s.waiting.WithLabelValues(repo).Inc()
+		}
+		err := sem.Acquire(ctx, 1)
+		if s.waiting != nil {
+			s.waiting.WithLabelValues(repo).Dec()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for a concurrent request to repository %s to complete: %w", repoURL, err)
+		}
+	}
+	return func() { sem.Release(1) }, nil
+}
+
+func (s *repoLimitedService) GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit, includeCommits bool) (map[string][]byte, map[string]*apiclient.GitFileCommit, error) {
+	release, err := s.acquire(ctx, repoURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+	return s.repos.GetFiles(ctx, repoURL, revision, project, pattern, noRevisionCache, verifyCommit, includeCommits)
+}
+
+func (s *repoLimitedService) GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error) {
+	release, err := s.acquire(ctx, repoURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.repos.GetDirectories(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+}
+
+func (s *repoLimitedService) GetHelmChartVersions(ctx context.Context, repoURL, project, chart string) ([]string, error) {
+	release, err := s.acquire(ctx, repoURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.repos.GetHelmChartVersions(ctx, repoURL, project, chart)
+}
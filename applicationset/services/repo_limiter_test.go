@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+)
+
+func TestRepoLimitedService(t *testing.T) {
+	waiting := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "waiting"}, []string{"repo"})
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	repos := &mocks.Repos{}
+	repos.On("GetDirectories", mock.Anything, "https://github.com/argoproj/argo-cd.git", "HEAD", "", false, false).
+		Run(func(mock.Arguments) {
+			close(started)
+			<-unblock
+		}).Return([]string{"first"}, nil).Once()
+	repos.On("GetDirectories", mock.Anything, "https://github.com/Argoproj/argo-cd", "HEAD", "", false, false).
+		Return([]string{"second"}, nil).Once()
+	repos.On("GetDirectories", mock.Anything, "https://github.com/argoproj/other", "HEAD", "", false, false).
+		Return([]string{"other"}, nil).Once()
+
+	service := NewRepoLimitedService(repos, 1, waiting)
+
+	first := make(chan []string)
+	go func() {
+		dirs, err := service.GetDirectories(t.Context(), "https://github.com/argoproj/argo-cd.git", "HEAD", "", false, false)
+		assert.NoError(t, err)
+		first <- dirs
+	}()
+	<-started
+
+	// The same repository, once normalized, waits for the first request to complete
+	second := make(chan []string)
+	go func() {
+		dirs, err := service.GetDirectories(t.Context(), "https://github.com/Argoproj/argo-cd", "HEAD", "", false, false)
+		assert.NoError(t, err)
+		second <- dirs
+	}()
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(waiting.WithLabelValues("https://github.com/argoproj/argo-cd")) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Other repositories are not limited
+	dirs, err := service.GetDirectories(t.Context(), "https://github.com/argoproj/other", "HEAD", "", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other"}, dirs)
+
+	// A waiting request gives up when its context is canceled
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = service.GetDirectories(ctx, "https://github.com/argoproj/argo-cd", "HEAD", "", false, false)
+	require.ErrorContains(t, err, "error waiting for a concurrent request to repository https://github.com/argoproj/argo-cd to complete")
+
+	close(unblock)
+	assert.Equal(t, []string{"first"}, <-first)
+	assert.Equal(t, []string{"second"}, <-second)
+	assert.InDelta(t, 0, testutil.ToFloat64(waiting.WithLabelValues("https://github.com/argoproj/argo-cd")), 0)
+	repos.AssertExpectations(t)
+}
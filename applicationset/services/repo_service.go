@@ -16,14 +16,19 @@ type argoCDService struct {
 	newFileGlobbingEnabled          bool
 	getGitFilesFromRepoServer       func(ctx context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error)
 	getGitDirectoriesFromRepoServer func(ctx context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error)
+	getHelmChartsFromRepoServer     func(ctx context.Context, req *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error)
 }
 
 type Repos interface {
-	// GetFiles returns content of files (not directories) within the target repo
-	GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit bool) (map[string][]byte, error)
+	// GetFiles returns content of files (not directories) within the target repo, and, if includeCommits is set, the
+	// last commit modifying each of them
+	GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit, includeCommits bool) (map[string][]byte, map[string]*apiclient.GitFileCommit, error)
 
 	// GetDirectories returns a list of directories (not files) within the target repo
 	GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error)
+
+	// GetHelmChartVersions returns the versions of a chart, read from the index of the target Helm repository
+	GetHelmChartVersions(ctx context.Context, repoURL, project, chart string) ([]string, error)
 }
 
 func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) Repos {
@@ -47,13 +52,21 @@ func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclie
 			defer io.Close(closer)
 			return client.GetGitDirectories(ctx, dirRequest)
 		},
+		getHelmChartsFromRepoServer: func(ctx context.Context, chartsRequest *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer io.Close(closer)
+			return client.GetHelmCharts(ctx, chartsRequest)
+		},
 	}
 }
 
-func (a *argoCDService) GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit bool) (map[string][]byte, error) {
+func (a *argoCDService) GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit, includeCommits bool) (map[string][]byte, map[string]*apiclient.GitFileCommit, error) {
 	repo, err := a.getRepository(ctx, repoURL, project)
 	if err != nil {
-		return nil, fmt.Errorf("error in GetRepository: %w", err)
+		return nil, nil, fmt.Errorf("error in GetRepository: %w", err)
 	}
 
 	fileRequest := &apiclient.GitFilesRequest{
@@ -64,12 +77,13 @@ func (a *argoCDService) GetFiles(ctx context.Context, repoURL, revision, project
 		NewGitFileGlobbingEnabled: a.newFileGlobbingEnabled,
 		NoRevisionCache:           noRevisionCache,
 		VerifyCommit:              verifyCommit,
+		IncludeCommits:            includeCommits,
 	}
 	fileResponse, err := a.getGitFilesFromRepoServer(ctx, fileRequest)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving Git files: %w", err)
+		return nil, nil, fmt.Errorf("error retrieving Git files: %w", err)
 	}
-	return fileResponse.GetMap(), nil
+	return fileResponse.GetMap(), fileResponse.GetCommits(), nil
 }
 
 func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error) {
@@ -92,3 +106,21 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 	}
 	return dirResponse.GetPaths(), nil
 }
+
+func (a *argoCDService) GetHelmChartVersions(ctx context.Context, repoURL, project, chart string) ([]string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	chartsResponse, err := a.getHelmChartsFromRepoServer(ctx, &apiclient.HelmChartsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Helm charts: %w", err)
+	}
+	for _, item := range chartsResponse.GetItems() {
+		if item.Name == chart {
+			return item.Versions, nil
+		}
+	}
+	return nil, fmt.Errorf("chart %s not found in Helm repository %s", chart, repoURL)
+}
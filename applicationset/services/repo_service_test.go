@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -102,6 +103,7 @@ func TestGetFiles(t *testing.T) {
 		pattern         string
 		noRevisionCache bool
 		verifyCommit    bool
+		includeCommits  bool
 	}
 	tests := []struct {
 		name    string
@@ -143,6 +145,28 @@ func TestGetFiles(t *testing.T) {
 			"foo.json": []byte("hello: world!"),
 			"bar.yaml": []byte("yay: appsets"),
 		}, wantErr: assert.NoError},
+		{name: "CommitsOnlyRequestedIfIncluded", fields: fields{
+			getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{}, nil
+			},
+			getGitFiles: func(_ context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error) {
+				if req.IncludeCommits {
+					return nil, errors.New("commits requested")
+				}
+				return &apiclient.GitFilesResponse{Map: map[string][]byte{"foo.json": []byte("hello: world!")}}, nil
+			},
+		}, args: args{}, want: map[string][]byte{"foo.json": []byte("hello: world!")}, wantErr: assert.NoError},
+		{name: "CommitsRequested", fields: fields{
+			getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{}, nil
+			},
+			getGitFiles: func(_ context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error) {
+				if !req.IncludeCommits {
+					return nil, errors.New("commits not requested")
+				}
+				return &apiclient.GitFilesResponse{Map: map[string][]byte{"foo.json": []byte("hello: world!")}}, nil
+			},
+		}, args: args{includeCommits: true}, want: map[string][]byte{"foo.json": []byte("hello: world!")}, wantErr: assert.NoError},
 		{name: "ErrorVerifyingCommit", fields: fields{
 			getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
 				return &v1alpha1.Repository{}, nil
@@ -159,7 +183,7 @@ func TestGetFiles(t *testing.T) {
 				submoduleEnabled:          tt.fields.submoduleEnabled,
 				getGitFilesFromRepoServer: tt.fields.getGitFiles,
 			}
-			got, err := a.GetFiles(tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.pattern, "", tt.args.noRevisionCache, tt.args.verifyCommit)
+			got, _, err := a.GetFiles(tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.pattern, "", tt.args.noRevisionCache, tt.args.verifyCommit, tt.args.includeCommits)
 			if !tt.wantErr(t, err, fmt.Sprintf("GetFiles(%v, %v, %v, %v, %v)", tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.pattern, tt.args.noRevisionCache)) {
 				return
 			}
@@ -168,6 +192,34 @@ func TestGetFiles(t *testing.T) {
 	}
 }
 
+func TestGetHelmChartVersions(t *testing.T) {
+	a := &argoCDService{
+		getRepository: func(_ context.Context, url, project string) (*v1alpha1.Repository, error) {
+			return &v1alpha1.Repository{Repo: url, Project: project}, nil
+		},
+		getHelmChartsFromRepoServer: func(_ context.Context, req *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
+			assert.Equal(t, &v1alpha1.Repository{Repo: "https://charts.example.com", Project: "my-project"}, req.Repo)
+			return &apiclient.HelmChartsResponse{Items: []*apiclient.HelmChart{
+				{Name: "guestbook", Versions: []string{"1.1.0", "1.0.0"}},
+				{Name: "redis", Versions: []string{"7.0.0"}},
+			}}, nil
+		},
+	}
+
+	versions, err := a.GetHelmChartVersions(t.Context(), "https://charts.example.com", "my-project", "guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.1.0", "1.0.0"}, versions)
+
+	_, err = a.GetHelmChartVersions(t.Context(), "https://charts.example.com", "my-project", "missing")
+	require.EqualError(t, err, "chart missing not found in Helm repository https://charts.example.com")
+
+	a.getHelmChartsFromRepoServer = func(_ context.Context, _ *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
+		return nil, errors.New("unauthorized")
+	}
+	_, err = a.GetHelmChartVersions(t.Context(), "https://charts.example.com", "my-project", "guestbook")
+	require.EqualError(t, err, "error retrieving Helm charts: unauthorized")
+}
+
 func TestNewArgoCDService(t *testing.T) {
 	testNamespace := "test"
 	clientset := fake.NewClientset()
@@ -0,0 +1,304 @@
+package scm_cache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// defaultMaxSize is the default bound of the total size of the responses held by a Cache.
+	defaultMaxSize = 64 * 1024 * 1024
+	// maxCachedBodySize is the size above which a response is not cached.
+	maxCachedBodySize = 1024 * 1024
+)
+
+// Options configures a Cache.
+type Options struct {
+	// TTL is how long a response is served from the cache without calling the SCM API. Zero means the responses are
+	// always revalidated with a conditional request.
+	TTL time.Duration
+	// RequestsPerSecond is the number of requests per second sent to each SCM API host. Zero means no limit.
+	RequestsPerSecond float64
+	// Burst is the number of requests which may be sent at once to each SCM API host above RequestsPerSecond.
+	Burst int
+	// Requests, if not nil, counts the requests sent to the SCM APIs, labelled with the host, the method and the
+	// status code of the response, or "error".
+	Requests *prometheus.CounterVec
+	// MaxSize bounds the total size in bytes of the cached responses, bodies and headers included. The responses least
+	// recently stored or revalidated are evicted when it is exceeded. Zero means 64 MiB.
+	MaxSize int64
+}
+
+// Cache holds the state shared by the HTTP clients of the SCM providers: the rate limiter of each SCM API host, and
+// the responses of the GET requests. The cached responses are revalidated with conditional requests, using their ETag
+// or Last-Modified headers, which do not count against the rate limit of most SCM APIs when nothing changed.
+type Cache struct {
+	opts Options
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	// responses indexes the elements of order, which holds the cached responses from the least to the most recently
+	// stored or revalidated one.
+	responses map[string]*list.Element
+	order     *list.List
+	// size is the total size of the cached responses.
+	size int64
+}
+
+type cacheEntry struct {
+	key      string
+	response *cachedResponse
+}
+
+type cachedResponse struct {
+	statusCode   int
+	header       http.Header
+	body         []byte
+	etag         string
+	lastModified string
+	storedAt     time.Time
+}
+
+// Default is the Cache shared by the SCM providers and the pull request services. It is configured once, when the
+// ApplicationSet controller starts.
+var Default = New(Options{})
+
+// New returns a Cache with the given options.
+func New(opts Options) *Cache {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = defaultMaxSize
+	}
+	return &Cache{
+		opts:      opts,
+		limiters:  map[string]*rate.Limiter{},
+		responses: map[string]*list.Element{},
+		order:     list.New(),
+	}
+}
+
+// Transport returns an http.RoundTripper sending the requests with the given base transport, through the rate
+// limiters and the response cache of the Cache.
+func (c *Cache) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{cache: c, base: base}
+}
+
+// Client returns an http.Client using the Transport of the Cache over the default transport.
+func (c *Cache) Client() *http.Client {
+	return &http.Client{Transport: c.Transport(nil)}
+}
+
+type transport struct {
+	cache *Cache
+	base  http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := t.cache
+	if req.Method != http.MethodGet {
+		if err := c.wait(req); err != nil {
+			return nil, err
+		}
+		return t.send(req)
+	}
+
+	key := cacheKey(req)
+	cached := c.get(key)
+	if cached != nil && c.opts.TTL > 0 && time.Since(cached.storedAt) < c.opts.TTL {
+		return cached.response(req), nil
+	}
+
+	if err := c.wait(req); err != nil {
+		return nil, err
+	}
+	if cached != nil && req.Header.Get("If-None-Match") == "" && req.Header.Get("If-Modified-Since") == "" {
+		req = req.Clone(req.Context())
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+	resp, err := t.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		c.touch(key, cached)
+		log.WithField("url", req.URL.Redacted()).Debug("SCM API response not modified, served from the cache")
+		return cached.response(req), nil
+	}
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "" && c.opts.TTL == 0) || resp.ContentLength > maxCachedBodySize {
+		return resp, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("error reading the response of %s: %w", req.URL.Redacted(), err)
+	}
+	if len(body) > maxCachedBodySize {
+		// The remaining of the body is read after the part already read
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	c.set(key, &cachedResponse{
+		statusCode:   resp.StatusCode,
+		header:       resp.Header.Clone(),
+		body:         body,
+		etag:         etag,
+		lastModified: lastModified,
+		storedAt:     time.Now(),
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// send sends the request with the base transport, and counts it.
+func (t *transport) send(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if t.cache.opts.Requests != nil {
+		status := "error"
+		if err == nil {
+			status = strconv.Itoa(resp.StatusCode)
+		}
+		t.cache.opts.Requests.WithLabelValues(req.URL.Host, req.Method, status).Inc()
+	}
+	return resp, err
+}
+
+// wait blocks until the rate limiter of the host of the request allows it.
+func (c *Cache) wait(req *http.Request) error {
+	if c.opts.RequestsPerSecond <= 0 {
+		return nil
+	}
+	c.lock.Lock()
+	limiter, ok := c.limiters[req.URL.Host]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(c.opts.RequestsPerSecond), max(c.opts.Burst, 1))
+		c.limiters[req.URL.Host] = limiter
+	}
+	c.lock.Unlock()
+	if err := limiter.Wait(req.Context()); err != nil {
+		return fmt.Errorf("error waiting for the rate limit of %s: %w", req.URL.Host, err)
+	}
+	return nil
+}
+
+func (c *Cache) get(key string) *cachedResponse {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.responses[key]; ok {
+		return elem.Value.(*cacheEntry).response
+	}
+	return nil
+}
+
+func (c *Cache) set(key string, resp *cachedResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.responses[key]; ok {
+		c.remove(elem)
+	}
+	if resp.size() > c.opts.MaxSize {
+		return
+	}
+	for c.size+resp.size() > c.opts.MaxSize {
+		c.remove(c.order.Front())
+	}
+	c.responses[key] = c.order.PushBack(&cacheEntry{key: key, response: resp})
+	c.size += resp.size()
+}
+
+// remove removes the element from the cache. The lock must be held.
+func (c *Cache) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*cacheEntry)
+	delete(c.responses, entry.key)
+	c.size -= entry.response.size()
+}
+
+// touch records that the cached response was revalidated by the SCM API.
+func (c *Cache) touch(key string, resp *cachedResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.responses[key]; ok && elem.Value.(*cacheEntry).response == resp {
+		elem.Value = &cacheEntry{key: key, response: &cachedResponse{
+			statusCode:   resp.statusCode,
+			header:       resp.header,
+			body:         resp.body,
+			etag:         resp.etag,
+			lastModified: resp.lastModified,
+			storedAt:     time.Now(),
+		}}
+		c.order.MoveToBack(elem)
+	}
+}
+
+// size returns the number of bytes held by the cached response.
+func (r *cachedResponse) size() int64 {
+	size := len(r.body) + len(r.etag) + len(r.lastModified)
+	for name, values := range r.header {
+		size += len(name)
+		for _, value := range values {
+			size += len(value)
+		}
+	}
+	return int64(size)
+}
+
+// response returns a copy of the cached response for the request.
+func (r *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.statusCode, http.StatusText(r.statusCode)),
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// cacheKey identifies the response of a GET request by its URL and its headers, so that the clients authenticated
+// with different credentials never share their responses.
+func cacheKey(req *http.Request) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(req.URL.String()))
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		if name != "If-None-Match" && name != "If-Modified-Since" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(h, "\n%s: %q", name, req.Header.Values(name))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,153 @@
+package scm_cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, client *http.Client, url string, token string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestCache(t *testing.T) {
+	var calls, notModified atomic.Int32
+	var version atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		etag := fmt.Sprintf(`"%s-%d"`, r.Header.Get("Authorization"), version.Load())
+		if r.URL.Path == "/no-etag" {
+			etag = ""
+		}
+		if etag != "" && r.Header.Get("If-None-Match") == etag {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+		_, _ = fmt.Fprintf(w, "%s %s %d", r.URL.Path, r.Header.Get("Authorization"), version.Load())
+	}))
+	defer ts.Close()
+
+	t.Run("responses are revalidated with conditional requests", func(t *testing.T) {
+		calls.Store(0)
+		notModified.Store(0)
+		requests := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "requests"}, []string{"host", "method", "status"})
+		client := New(Options{Requests: requests}).Client()
+
+		for range 3 {
+			status, body := get(t, client, ts.URL+"/repos", "a")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "/repos Bearer a 0", body)
+		}
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, int32(2), notModified.Load())
+		host := strings.TrimPrefix(ts.URL, "http://")
+		assert.InDelta(t, 1, testutil.ToFloat64(requests.WithLabelValues(host, http.MethodGet, "200")), 0)
+		assert.InDelta(t, 2, testutil.ToFloat64(requests.WithLabelValues(host, http.MethodGet, "304")), 0)
+
+		version.Add(1)
+		_, body := get(t, client, ts.URL+"/repos", "a")
+		assert.Equal(t, "/repos Bearer a 1", body)
+	})
+
+	t.Run("responses are not shared across credentials", func(t *testing.T) {
+		client := New(Options{}).Client()
+		_, body := get(t, client, ts.URL+"/repos", "a")
+		assert.Contains(t, body, "Bearer a")
+		_, body = get(t, client, ts.URL+"/repos", "b")
+		assert.Contains(t, body, "Bearer b")
+	})
+
+	t.Run("responses are served from the cache during the TTL", func(t *testing.T) {
+		calls.Store(0)
+		client := New(Options{TTL: time.Hour}).Client()
+		for range 3 {
+			_, body := get(t, client, ts.URL+"/no-etag", "a")
+			assert.Contains(t, body, "/no-etag")
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("responses without validators are not cached without TTL", func(t *testing.T) {
+		calls.Store(0)
+		client := New(Options{}).Client()
+		for range 3 {
+			get(t, client, ts.URL+"/no-etag", "a")
+		}
+		assert.Equal(t, int32(3), calls.Load())
+	})
+}
+
+func TestCacheMaxSize(t *testing.T) {
+	response := func(size int) *cachedResponse {
+		return &cachedResponse{statusCode: http.StatusOK, body: []byte(strings.Repeat("x", size)), storedAt: time.Now()}
+	}
+	c := New(Options{MaxSize: 100})
+
+	c.set("a", response(40))
+	c.set("b", response(40))
+	// revalidating a response makes it the most recently stored one
+	c.touch("a", c.get("a"))
+	c.set("c", response(40))
+	assert.NotNil(t, c.get("a"))
+	assert.Nil(t, c.get("b"), "the least recently stored response is evicted")
+	assert.NotNil(t, c.get("c"))
+	assert.Equal(t, int64(80), c.size)
+
+	// replacing a response accounts for the size of the previous one
+	c.set("c", response(60))
+	assert.NotNil(t, c.get("a"))
+	assert.Equal(t, int64(100), c.size)
+
+	// the responses larger than the cache are not cached, and evict nothing
+	c.set("d", response(101))
+	assert.Nil(t, c.get("d"))
+	assert.Equal(t, int64(100), c.size)
+
+	c.set("e", response(100))
+	assert.Nil(t, c.get("a"))
+	assert.Nil(t, c.get("c"))
+	assert.Equal(t, int64(100), c.size)
+}
+
+func TestCacheRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := New(Options{RequestsPerSecond: 1, Burst: 2}).Client()
+	get(t, client, ts.URL, "a")
+	get(t, client, ts.URL, "a")
+
+	// The burst is exhausted, the next request waits for the rate limiter
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, http.NoBody)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.ErrorContains(t, err, "error waiting for the rate limit of")
+}
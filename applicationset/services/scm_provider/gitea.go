@@ -62,6 +62,7 @@ func (g *GiteaProvider) GetBranches(_ context.Context, repo *Repository) ([]*Rep
 				Branch:       repo.Branch,
 				URL:          repo.URL,
 				SHA:          branch.Commit.ID,
+				Protected:    branch.Protected,
 				Labels:       repo.Labels,
 				RepositoryId: repo.RepositoryId,
 			},
@@ -80,6 +81,7 @@ func (g *GiteaProvider) GetBranches(_ context.Context, repo *Repository) ([]*Rep
 			Branch:       branch.Name,
 			URL:          repo.URL,
 			SHA:          branch.Commit.ID,
+			Protected:    branch.Protected,
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
 		})
@@ -7,6 +7,8 @@ import (
 	"os"
 
 	"github.com/google/go-github/v69/github"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_cache"
 )
 
 type GithubProvider struct {
@@ -22,7 +24,7 @@ func NewGithubProvider(organization string, token string, url string, allBranche
 	if token == "" {
 		token = os.Getenv("GITHUB_TOKEN")
 	}
-	httpClient := &http.Client{}
+	httpClient := scm_cache.Default.Client()
 	var client *github.Client
 	if url == "" {
 		if token == "" {
@@ -54,6 +56,7 @@ func (g *GithubProvider) GetBranches(ctx context.Context, repo *Repository) ([]*
 			URL:          repo.URL,
 			Branch:       branch.GetName(),
 			SHA:          branch.GetCommit().GetSHA(),
+			Protected:    branch.GetProtected(),
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
 		})
@@ -303,6 +303,7 @@ func TestGithubGetBranches(t *testing.T) {
 		require.NoError(t, err)
 	} else {
 		assert.Equal(t, "master", repos[0].Branch)
+		assert.True(t, repos[0].Protected)
 	}
 	// Branch Doesn't exists instead of error will return no error
 	repo2 := &Repository{
@@ -321,5 +322,6 @@ func TestGithubGetBranches(t *testing.T) {
 	} else {
 		// considering master  branch to  exist.
 		assert.Len(t, repos, 1)
+		assert.True(t, repos[0].Protected)
 	}
 }
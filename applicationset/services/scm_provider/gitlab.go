@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_cache"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
@@ -35,7 +36,7 @@ func NewGitlabProvider(organization string, token string, url string, allBranche
 	tr.TLSClientConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
 
 	retryClient := retryablehttp.NewClient()
-	retryClient.HTTPClient.Transport = tr
+	retryClient.HTTPClient.Transport = scm_cache.Default.Transport(tr)
 
 	if url == "" {
 		var err error
@@ -68,6 +69,7 @@ func (g *GitlabProvider) GetBranches(ctx context.Context, repo *Repository) ([]*
 			URL:          repo.URL,
 			Branch:       branch.Name,
 			SHA:          branch.Commit.ID,
+			Protected:    branch.Protected,
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
 		})
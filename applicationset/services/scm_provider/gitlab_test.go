@@ -1255,6 +1255,7 @@ func TestGitlabGetBranches(t *testing.T) {
 		repos, err := host.GetBranches(t.Context(), repo)
 		require.NoError(t, err)
 		assert.Equal(t, "master", repos[0].Branch)
+		assert.True(t, repos[0].Protected)
 	})
 
 	repo2 := &Repository{
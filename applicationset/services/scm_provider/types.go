@@ -12,6 +12,9 @@ type Repository struct {
 	URL          string
 	Branch       string
 	SHA          string
+	// Protected is true when the branch is protected in the SCM provider. It is only set by the providers which
+	// expose branch protection through their API.
+	Protected    bool
 	Labels       []string
 	RepositoryId any
 }
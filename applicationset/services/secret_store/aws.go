@@ -0,0 +1,94 @@
+package secret_store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+// awsPlainTextKey is the key of the value of the secrets of AWS Secrets Manager whose secret string is not a JSON
+// object.
+const awsPlainTextKey = "value"
+
+// AWSSecretsManagerConfig configures AWS Secrets Manager. The secrets are read with the AWS identity of the pod, such
+// as IRSA.
+type AWSSecretsManagerConfig struct {
+	// Region is the region of the secrets. Defaults to the region of the pod environment.
+	Region string `json:"region,omitempty"`
+	// Role is the ARN of a role to assume to read the secrets.
+	Role string `json:"role,omitempty"`
+}
+
+// SecretsManagerClient is a lean facade to the secretsmanageriface.SecretsManagerAPI.
+type SecretsManagerClient interface {
+	GetSecretValueWithContext(aws.Context, *secretsmanager.GetSecretValueInput, ...request.Option) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+type awsSecretsManagerBackend struct {
+	client SecretsManagerClient
+}
+
+var _ Backend = (*awsSecretsManagerBackend)(nil)
+
+// NewAWSSecretsManagerBackend returns a Backend reading the secrets of AWS Secrets Manager.
+func NewAWSSecretsManagerBackend(config AWSSecretsManagerConfig) (Backend, error) {
+	awsConfig := &aws.Config{}
+	if config.Region != "" {
+		awsConfig.Region = aws.String(config.Region)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+	if config.Role != "" {
+		awsConfig.Credentials = stscreds.NewCredentials(sess, config.Role)
+		sess, err = session.NewSession(awsConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error creating AWS session with role %s: %w", config.Role, err)
+		}
+	}
+	return NewAWSSecretsManagerBackendWithClient(secretsmanager.New(sess)), nil
+}
+
+// NewAWSSecretsManagerBackendWithClient returns a Backend reading the secrets of AWS Secrets Manager with the given
+// client.
+func NewAWSSecretsManagerBackendWithClient(client SecretsManagerClient) Backend {
+	return &awsSecretsManagerBackend{client: client}
+}
+
+// GetSecret returns the key-value pairs of the JSON object of the secret string, or the secret string under the
+// `value` key if it is not a JSON object.
+func (b *awsSecretsManagerBackend) GetSecret(ctx context.Context, path string) (map[string]string, error) {
+	out, err := b.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(path)})
+	if err != nil {
+		return nil, fmt.Errorf("error getting secret value: %w", err)
+	}
+	if out.SecretString == nil {
+		return nil, errors.New("secret has no secret string")
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal([]byte(*out.SecretString), &object); err != nil {
+		return map[string]string{awsPlainTextKey: *out.SecretString}, nil
+	}
+	values := make(map[string]string, len(object))
+	for k, v := range object {
+		if s, ok := v.(string); ok {
+			values[k] = s
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling value of key %q: %w", k, err)
+		}
+		values[k] = string(data)
+	}
+	return values, nil
+}
@@ -0,0 +1,258 @@
+package secret_store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+const (
+	// defaultCacheTTL is how long the secrets read from the stores are cached when the configuration does not say.
+	defaultCacheTTL = 5 * time.Minute
+	// referencePrefix is the prefix of the references to a secret of a store in a string, such as the token of a
+	// plugin: secretstore://<store>/<path>#<key>.
+	referencePrefix = "secretstore://"
+	// namespacePlaceholder is replaced with the namespace of the ApplicationSet in the path prefix of a store.
+	namespacePlaceholder = "{{namespace}}"
+)
+
+// Backend reads the secrets of an external secret store.
+type Backend interface {
+	// GetSecret returns the key-value pairs of the secret at the given path.
+	GetSecret(ctx context.Context, path string) (map[string]string, error)
+}
+
+// Config is the configuration of the external secret stores of the ApplicationSet controller.
+type Config struct {
+	// CacheTTL is how long the secrets read from the stores are cached, e.g. `5m`. Defaults to 5 minutes, `0s` disables
+	// the cache.
+	CacheTTL string `json:"cacheTTL,omitempty"`
+	// Stores is the list of the secret stores.
+	Stores []StoreConfig `json:"stores"`
+}
+
+// StoreConfig is the configuration of a secret store. Exactly one backend must be set.
+type StoreConfig struct {
+	// Name identifies the store in the secret references of the generators.
+	Name string `json:"name"`
+	// Vault configures a HashiCorp Vault KV version 2 secrets engine.
+	Vault *VaultConfig `json:"vault,omitempty"`
+	// AWSSecretsManager configures AWS Secrets Manager.
+	AWSSecretsManager *AWSSecretsManagerConfig `json:"awsSecretsManager,omitempty"`
+	// Namespaces are the namespaces of the ApplicationSets allowed to read the secrets of the store, as glob
+	// patterns. Defaults to the namespace of the ApplicationSet controller.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// PathPrefix restricts the secrets readable by the ApplicationSets to the paths starting with it. {{namespace}} is
+	// replaced with the namespace of the ApplicationSet, e.g. `argocd/{{namespace}}/`.
+	PathPrefix string `json:"pathPrefix,omitempty"`
+}
+
+// LoadConfig reads the secret stores configuration from the given YAML or JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret stores configuration: %w", err)
+	}
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing secret stores configuration: %w", err)
+	}
+	return &config, nil
+}
+
+// Store is a secret store, and the ApplicationSets allowed to read its secrets.
+type Store struct {
+	Backend Backend
+	// Namespaces are the namespaces of the ApplicationSets allowed to read the secrets, as glob patterns.
+	Namespaces []string
+	// PathPrefix is the prefix of the paths of the secrets readable by the ApplicationSets, if not empty.
+	PathPrefix string
+}
+
+// Stores reads the secrets of the configured secret stores, and caches them.
+type Stores struct {
+	stores   map[string]Store
+	cacheTTL time.Duration
+
+	lock  sync.Mutex
+	cache map[string]*cachedSecret
+}
+
+type cachedSecret struct {
+	values    map[string]string
+	fetchedAt time.Time
+}
+
+// Default holds the secret stores used by the generators. It has no store until it is configured, when the
+// ApplicationSet controller starts.
+var Default = New(nil, 0)
+
+// New returns Stores reading the secrets of the given stores, by store name, and caching them for the given TTL.
+func New(stores map[string]Store, cacheTTL time.Duration) *Stores {
+	return &Stores{
+		stores:   stores,
+		cacheTTL: cacheTTL,
+		cache:    map[string]*cachedSecret{},
+	}
+}
+
+// NewFromConfig returns the Stores of the given configuration. The stores are only readable by the ApplicationSets
+// of the given controller namespace, unless their configuration lists other namespaces.
+func NewFromConfig(config *Config, controllerNamespace string) (*Stores, error) {
+	cacheTTL := defaultCacheTTL
+	if config.CacheTTL != "" {
+		var err error
+		cacheTTL, err = time.ParseDuration(config.CacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret stores cache TTL %q: %w", config.CacheTTL, err)
+		}
+	}
+
+	stores := make(map[string]Store, len(config.Stores))
+	for _, s := range config.Stores {
+		if s.Name == "" {
+			return nil, errors.New("secret store with no name")
+		}
+		if _, ok := stores[s.Name]; ok {
+			return nil, fmt.Errorf("duplicate secret store %s", s.Name)
+		}
+		var backend Backend
+		var err error
+		switch {
+		case s.Vault != nil && s.AWSSecretsManager == nil:
+			backend, err = NewVaultBackend(*s.Vault)
+		case s.AWSSecretsManager != nil && s.Vault == nil:
+			backend, err = NewAWSSecretsManagerBackend(*s.AWSSecretsManager)
+		default:
+			return nil, fmt.Errorf("secret store %s must configure exactly one of vault, awsSecretsManager", s.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error configuring secret store %s: %w", s.Name, err)
+		}
+		namespaces := s.Namespaces
+		if len(namespaces) == 0 {
+			namespaces = []string{controllerNamespace}
+		}
+		stores[s.Name] = Store{Backend: backend, Namespaces: namespaces, PathPrefix: s.PathPrefix}
+	}
+	return New(stores, cacheTTL), nil
+}
+
+// Get returns the value of the key of the secret at the given path of the store, for an ApplicationSet of the given
+// namespace. In strict mode, like the Kubernetes Secrets which must be labelled as SCM credentials, only the secrets
+// of the stores restricted to a path prefix can be read.
+func (s *Stores) Get(ctx context.Context, store string, namespace string, path string, key string, strict bool) (string, error) {
+	config, ok := s.stores[store]
+	if !ok {
+		return "", fmt.Errorf("secret store %q is not configured", store)
+	}
+	if err := config.checkAllowed(namespace, path, strict); err != nil {
+		return "", fmt.Errorf("secret %s of secret store %s cannot be read by the ApplicationSets of namespace %s: %w", path, store, namespace, err)
+	}
+	values, err := s.getSecret(ctx, store, config.Backend, path)
+	if err != nil {
+		return "", err
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %q in secret %s of secret store %s not found", key, path, store)
+	}
+	return value, nil
+}
+
+// checkAllowed returns an error if the secret at the given path cannot be read by the ApplicationSets of the given
+// namespace.
+func (s Store) checkAllowed(namespace string, path string, strict bool) error {
+	if !glob.MatchStringInList(s.Namespaces, namespace, glob.GLOB) {
+		return errors.New("the namespace is not allowed by the store")
+	}
+	if s.PathPrefix == "" {
+		if strict {
+			return errors.New("the store has no path prefix, which is required in strict mode")
+		}
+		return nil
+	}
+	// The paths must not escape the prefix with relative segments
+	if slices.Contains(strings.Split(path, "/"), "..") {
+		return errors.New("the path must not contain '..' segments")
+	}
+	// The prefix must end at a segment boundary, so that the prefix team does not allow team-b/...
+	prefix := strings.ReplaceAll(s.PathPrefix, namespacePlaceholder, namespace)
+	rest, ok := strings.CutPrefix(path, prefix)
+	if !ok || rest == "" || (!strings.HasSuffix(prefix, "/") && !strings.HasPrefix(rest, "/")) {
+		return errors.New("the path is outside of the path prefix of the store")
+	}
+	return nil
+}
+
+func (s *Stores) getSecret(ctx context.Context, store string, backend Backend, path string) (map[string]string, error) {
+	cacheKey := store + "\x00" + path
+	if s.cacheTTL > 0 {
+		s.lock.Lock()
+		cached, ok := s.cache[cacheKey]
+		s.lock.Unlock()
+		if ok && time.Since(cached.fetchedAt) < s.cacheTTL {
+			return cached.values, nil
+		}
+	}
+
+	values, err := backend.GetSecret(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret %s of secret store %s: %w", path, store, err)
+	}
+
+	if s.cacheTTL > 0 {
+		s.lock.Lock()
+		s.cache[cacheKey] = &cachedSecret{values: values, fetchedAt: time.Now()}
+		s.lock.Unlock()
+	}
+	return values, nil
+}
+
+// IsReference returns whether the string references a secret of a store, as secretstore://<store>/<path>#<key>.
+func IsReference(ref string) bool {
+	return strings.HasPrefix(ref, referencePrefix)
+}
+
+// GetReference returns the value of the secret referenced as secretstore://<store>/<path>#<key>, for the given
+// namespace. The strict mode is the one of Get.
+func (s *Stores) GetReference(ctx context.Context, namespace string, ref string, strict bool) (string, error) {
+	store, path, key, err := ParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+	return s.Get(ctx, store, namespace, path, key, strict)
+}
+
+// ParseReference returns the store, the path and the key of a secret referenced as secretstore://<store>/<path>#<key>.
+func ParseReference(ref string) (store string, path string, key string, err error) {
+	rest, ok := strings.CutPrefix(ref, referencePrefix)
+	if !ok {
+		return "", "", "", fmt.Errorf("secret reference %q does not start with %s", ref, referencePrefix)
+	}
+	rest, key, ok = cutLast(rest, "#")
+	if !ok || key == "" {
+		return "", "", "", fmt.Errorf("secret reference %q has no key: it must be of the form %s<store>/<path>#<key>", ref, referencePrefix)
+	}
+	store, path, ok = strings.Cut(rest, "/")
+	if !ok || store == "" || path == "" {
+		return "", "", "", fmt.Errorf("secret reference %q has no store or path: it must be of the form %s<store>/<path>#<key>", ref, referencePrefix)
+	}
+	return store, path, key, nil
+}
+
+func cutLast(s string, sep string) (before string, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
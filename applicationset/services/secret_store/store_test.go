@@ -0,0 +1,284 @@
+package secret_store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingBackend struct {
+	calls  int
+	values map[string]string
+}
+
+func (b *countingBackend) GetSecret(_ context.Context, path string) (map[string]string, error) {
+	b.calls++
+	if path != "scm/github" {
+		return nil, errors.New("not found")
+	}
+	return b.values, nil
+}
+
+func TestStores(t *testing.T) {
+	t.Run("secrets are cached", func(t *testing.T) {
+		backend := &countingBackend{values: map[string]string{"token": "secret"}}
+		stores := New(map[string]Store{"vault": {Backend: backend, Namespaces: []string{"argocd"}}}, defaultCacheTTL)
+		for range 3 {
+			value, err := stores.Get(t.Context(), "vault", "argocd", "scm/github", "token", false)
+			require.NoError(t, err)
+			assert.Equal(t, "secret", value)
+		}
+		assert.Equal(t, 1, backend.calls)
+	})
+
+	t.Run("secrets are not cached without TTL", func(t *testing.T) {
+		backend := &countingBackend{values: map[string]string{"token": "secret"}}
+		stores := New(map[string]Store{"vault": {Backend: backend, Namespaces: []string{"argocd"}}}, 0)
+		for range 3 {
+			_, err := stores.Get(t.Context(), "vault", "argocd", "scm/github", "token", false)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, backend.calls)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		stores := New(map[string]Store{"vault": {Backend: &countingBackend{values: map[string]string{"token": "secret"}}, Namespaces: []string{"argocd"}}}, 0)
+		_, err := stores.Get(t.Context(), "aws", "argocd", "scm/github", "token", false)
+		require.EqualError(t, err, `secret store "aws" is not configured`)
+		_, err = stores.Get(t.Context(), "vault", "argocd", "scm/gitlab", "token", false)
+		require.EqualError(t, err, "error reading secret scm/gitlab of secret store vault: not found")
+		_, err = stores.Get(t.Context(), "vault", "argocd", "scm/github", "password", false)
+		require.EqualError(t, err, `key "password" in secret scm/github of secret store vault not found`)
+	})
+
+	t.Run("references", func(t *testing.T) {
+		stores := New(map[string]Store{"vault": {Backend: &countingBackend{values: map[string]string{"token": "secret"}}, Namespaces: []string{"argocd"}}}, 0)
+		assert.True(t, IsReference("secretstore://vault/scm/github#token"))
+		assert.False(t, IsReference("$plugin.token"))
+		value, err := stores.GetReference(t.Context(), "argocd", "secretstore://vault/scm/github#token", false)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", value)
+
+		// The strict mode applies to the references too
+		_, err = stores.GetReference(t.Context(), "argocd", "secretstore://vault/scm/github#token", true)
+		require.ErrorContains(t, err, "the store has no path prefix, which is required in strict mode")
+	})
+}
+
+func TestStoresScoping(t *testing.T) {
+	backend := fakeBackend{
+		"scm/github":             {"token": "shared"},
+		"teams/team-a/github":    {"token": "team-a"},
+		"teams/team-b/github":    {"token": "team-b"},
+		"teams/team-a/../team-b": {"token": "team-b"},
+		"team-a/github":          {"token": "team-a"},
+		"team-ab/github":         {"token": "team-ab"},
+	}
+	stores := New(map[string]Store{
+		"shared":    {Backend: backend, Namespaces: []string{"argocd"}},
+		"teams":     {Backend: backend, Namespaces: []string{"team-*"}, PathPrefix: "teams/{{namespace}}/"},
+		"unslashed": {Backend: backend, Namespaces: []string{"team-*"}, PathPrefix: "{{namespace}}"},
+	}, 0)
+
+	for _, c := range []struct {
+		name, store, namespace, path string
+		strict                       bool
+		expectedValue, expectedError string
+	}{
+		{name: "allowed namespace", store: "shared", namespace: "argocd", path: "scm/github", expectedValue: "shared"},
+		{name: "namespace not allowed", store: "shared", namespace: "team-a", path: "scm/github", expectedError: "the namespace is not allowed by the store"},
+		{name: "strict mode without path prefix", store: "shared", namespace: "argocd", path: "scm/github", strict: true, expectedError: "the store has no path prefix, which is required in strict mode"},
+		{name: "path in the prefix of the namespace", store: "teams", namespace: "team-a", path: "teams/team-a/github", strict: true, expectedValue: "team-a"},
+		{name: "path in the prefix of another namespace", store: "teams", namespace: "team-a", path: "teams/team-b/github", expectedError: "the path is outside of the path prefix of the store"},
+		{name: "path escaping the prefix", store: "teams", namespace: "team-a", path: "teams/team-a/../team-b", expectedError: "the path must not contain '..' segments"},
+		{name: "path in the prefix without trailing slash", store: "unslashed", namespace: "team-a", path: "team-a/github", expectedValue: "team-a"},
+		{name: "path sharing the prefix without trailing slash", store: "unslashed", namespace: "team-a", path: "team-ab/github", expectedError: "the path is outside of the path prefix of the store"},
+		{name: "path equal to the prefix", store: "teams", namespace: "team-a", path: "teams/team-a/", expectedError: "the path is outside of the path prefix of the store"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			value, err := stores.Get(t.Context(), c.store, c.namespace, c.path, "token", c.strict)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expectedValue, value)
+		})
+	}
+}
+
+type fakeBackend map[string]map[string]string
+
+func (b fakeBackend) GetSecret(_ context.Context, path string) (map[string]string, error) {
+	values, ok := b[path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return values, nil
+}
+
+func TestParseReference(t *testing.T) {
+	store, path, key, err := ParseReference("secretstore://vault/teams/a#b/scm#token")
+	require.NoError(t, err)
+	assert.Equal(t, "vault", store)
+	assert.Equal(t, "teams/a#b/scm", path)
+	assert.Equal(t, "token", key)
+
+	for _, ref := range []string{"vault/scm#token", "secretstore://vault/scm", "secretstore://vault#token", "secretstore:///scm#token"} {
+		_, _, _, err := ParseReference(ref)
+		assert.Error(t, err, ref)
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	for _, c := range []struct {
+		name          string
+		config        Config
+		expectedError string
+	}{
+		{name: "valid", config: Config{CacheTTL: "1m", Stores: []StoreConfig{{Name: "vault", Vault: &VaultConfig{Address: "https://vault", TokenPath: "/token"}}}}},
+		{name: "no name", config: Config{Stores: []StoreConfig{{Vault: &VaultConfig{Address: "https://vault", TokenPath: "/token"}}}}, expectedError: "secret store with no name"},
+		{name: "no backend", config: Config{Stores: []StoreConfig{{Name: "vault"}}}, expectedError: "secret store vault must configure exactly one of vault, awsSecretsManager"},
+		{name: "duplicate", config: Config{Stores: []StoreConfig{
+			{Name: "vault", Vault: &VaultConfig{Address: "https://vault", TokenPath: "/token"}},
+			{Name: "vault", Vault: &VaultConfig{Address: "https://vault", TokenPath: "/token"}},
+		}}, expectedError: "duplicate secret store vault"},
+		{name: "invalid TTL", config: Config{CacheTTL: "soon"}, expectedError: `invalid secret stores cache TTL "soon"`},
+		{name: "vault without auth", config: Config{Stores: []StoreConfig{{Name: "vault", Vault: &VaultConfig{Address: "https://vault"}}}}, expectedError: "vault must configure exactly one of tokenPath, kubernetesAuth"},
+		{name: "vault without role", config: Config{Stores: []StoreConfig{{Name: "vault", Vault: &VaultConfig{Address: "https://vault", KubernetesAuth: &VaultKubernetesAuthConfig{}}}}}, expectedError: "vault kubernetes authentication has no role"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := NewFromConfig(&c.config, "argocd")
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("namespaces default to the controller namespace", func(t *testing.T) {
+		stores, err := NewFromConfig(&Config{Stores: []StoreConfig{
+			{Name: "default", Vault: &VaultConfig{Address: "https://vault", TokenPath: "/token"}},
+			{Name: "teams", Vault: &VaultConfig{Address: "https://vault", TokenPath: "/token"}, Namespaces: []string{"team-*"}, PathPrefix: "teams/{{namespace}}/"},
+		}}, "argocd")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"argocd"}, stores.stores["default"].Namespaces)
+		assert.Equal(t, []string{"team-*"}, stores.stores["teams"].Namespaces)
+		assert.Equal(t, "teams/{{namespace}}/", stores.stores["teams"].PathPrefix)
+	})
+}
+
+func newVaultServer(t *testing.T, logins *int) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/auth/kubernetes/login":
+			*logins++
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "appset", body["role"])
+			assert.Equal(t, "sa-token", body["jwt"])
+			_, _ = w.Write([]byte(`{"auth": {"client_token": "login-token", "lease_duration": 3600}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/kv/data/scm/github":
+			token := r.Header.Get("X-Vault-Token")
+			if token != "file-token" && token != "login-token" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+				return
+			}
+			assert.Equal(t, "team-a", r.Header.Get("X-Vault-Namespace"))
+			_, _ = w.Write([]byte(`{"data": {"data": {"token": "secret", "port": 8080}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestVaultBackend(t *testing.T) {
+	var logins int
+	ts := newVaultServer(t, &logins)
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("file-token\n"), 0o600))
+	saTokenPath := filepath.Join(dir, "sa-token")
+	require.NoError(t, os.WriteFile(saTokenPath, []byte("sa-token"), 0o600))
+
+	t.Run("token file", func(t *testing.T) {
+		backend, err := NewVaultBackend(VaultConfig{Address: ts.URL, MountPath: "kv", Namespace: "team-a", TokenPath: tokenPath})
+		require.NoError(t, err)
+		values, err := backend.GetSecret(t.Context(), "scm/github")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"token": "secret", "port": "8080"}, values)
+
+		_, err = backend.GetSecret(t.Context(), "scm/gitlab")
+		require.ErrorContains(t, err, "vault returned status 404")
+
+		// The path is escaped instead of adding a query to the URL
+		_, err = backend.GetSecret(t.Context(), "scm/github?version=1")
+		require.ErrorContains(t, err, "vault returned status 404")
+	})
+
+	t.Run("kubernetes authentication", func(t *testing.T) {
+		backend, err := NewVaultBackend(VaultConfig{Address: ts.URL, MountPath: "kv", Namespace: "team-a", KubernetesAuth: &VaultKubernetesAuthConfig{Role: "appset", ServiceAccountTokenPath: saTokenPath}})
+		require.NoError(t, err)
+		for range 2 {
+			values, err := backend.GetSecret(t.Context(), "scm/github")
+			require.NoError(t, err)
+			assert.Equal(t, "secret", values["token"])
+		}
+		// The token is cached until shortly before it expires
+		assert.Equal(t, 1, logins)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		badTokenPath := filepath.Join(dir, "bad-token")
+		require.NoError(t, os.WriteFile(badTokenPath, []byte("bad-token"), 0o600))
+		backend, err := NewVaultBackend(VaultConfig{Address: ts.URL, MountPath: "kv", Namespace: "team-a", TokenPath: badTokenPath})
+		require.NoError(t, err)
+		_, err = backend.GetSecret(t.Context(), "scm/github")
+		require.EqualError(t, err, "vault returned status 403: permission denied")
+	})
+}
+
+type fakeSecretsManagerClient map[string]string
+
+func (c fakeSecretsManagerClient) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput, _ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	value, ok := c[*input.SecretId]
+	if !ok {
+		return nil, errors.New("ResourceNotFoundException")
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(value)}, nil
+}
+
+func TestAWSSecretsManagerBackend(t *testing.T) {
+	backend := NewAWSSecretsManagerBackendWithClient(fakeSecretsManagerClient{
+		"scm/github": `{"token": "secret", "port": 8080}`,
+		"scm/gitlab": "plain-token",
+	})
+
+	values, err := backend.GetSecret(t.Context(), "scm/github")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"token": "secret", "port": "8080"}, values)
+
+	values, err = backend.GetSecret(t.Context(), "scm/gitlab")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"value": "plain-token"}, values)
+
+	_, err = backend.GetSecret(t.Context(), "scm/bitbucket")
+	require.ErrorContains(t, err, "ResourceNotFoundException")
+}
@@ -0,0 +1,209 @@
+package secret_store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultVaultMountPath               = "secret"
+	defaultVaultKubernetesAuthMountPath = "kubernetes"
+	defaultVaultServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	vaultRequestTimeout                 = 30 * time.Second
+	vaultTokenRenewBeforeExpiryRatio    = 0.8
+	vaultNamespaceHeader                = "X-Vault-Namespace"
+	vaultTokenHeader                    = "X-Vault-Token"
+)
+
+// VaultConfig configures a HashiCorp Vault KV version 2 secrets engine. The token is read from TokenPath, or obtained
+// with the Kubernetes authentication method if KubernetesAuth is set.
+type VaultConfig struct {
+	// Address is the URL of the Vault server, e.g. https://vault.example.com:8200.
+	Address string `json:"address"`
+	// MountPath is the path the KV version 2 secrets engine is mounted at. Defaults to `secret`.
+	MountPath string `json:"mountPath,omitempty"`
+	// Namespace is the Vault Enterprise namespace of the secrets engine.
+	Namespace string `json:"namespace,omitempty"`
+	// TokenPath is the path of a file holding the Vault token, e.g. written by the Vault agent.
+	TokenPath string `json:"tokenPath,omitempty"`
+	// KubernetesAuth authenticates with the service account token of the ApplicationSet controller.
+	KubernetesAuth *VaultKubernetesAuthConfig `json:"kubernetesAuth,omitempty"`
+}
+
+// VaultKubernetesAuthConfig configures the Vault Kubernetes authentication method.
+type VaultKubernetesAuthConfig struct {
+	// Role is the Vault role bound to the service account of the ApplicationSet controller.
+	Role string `json:"role"`
+	// MountPath is the path the Kubernetes authentication method is mounted at. Defaults to `kubernetes`.
+	MountPath string `json:"mountPath,omitempty"`
+	// ServiceAccountTokenPath is the path of the service account token. Defaults to the token mounted in the pod.
+	ServiceAccountTokenPath string `json:"serviceAccountTokenPath,omitempty"`
+}
+
+type vaultBackend struct {
+	config VaultConfig
+	client *http.Client
+
+	lock        sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+var _ Backend = (*vaultBackend)(nil)
+
+// NewVaultBackend returns a Backend reading the secrets of a Vault KV version 2 secrets engine.
+func NewVaultBackend(config VaultConfig) (Backend, error) {
+	if config.Address == "" {
+		return nil, errors.New("vault has no address")
+	}
+	if _, err := url.Parse(config.Address); err != nil {
+		return nil, fmt.Errorf("invalid vault address %q: %w", config.Address, err)
+	}
+	if (config.TokenPath == "") == (config.KubernetesAuth == nil) {
+		return nil, errors.New("vault must configure exactly one of tokenPath, kubernetesAuth")
+	}
+	if config.KubernetesAuth != nil && config.KubernetesAuth.Role == "" {
+		return nil, errors.New("vault kubernetes authentication has no role")
+	}
+	if config.MountPath == "" {
+		config.MountPath = defaultVaultMountPath
+	}
+	return &vaultBackend{config: config, client: &http.Client{Timeout: vaultRequestTimeout}}, nil
+}
+
+func (b *vaultBackend) GetSecret(ctx context.Context, path string) (map[string]string, error) {
+	token, err := b.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	// Each segment is escaped, so that the path cannot add a query or encoded relative segments to the URL
+	segments := strings.Split(strings.TrimLeft(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	secretPath := fmt.Sprintf("/v1/%s/data/%s", strings.Trim(b.config.MountPath, "/"), strings.Join(segments, "/"))
+	if err := b.do(ctx, http.MethodGet, secretPath, token, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(resp.Data.Data))
+	for k, v := range resp.Data.Data {
+		if s, ok := v.(string); ok {
+			values[k] = s
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling value of key %q: %w", k, err)
+		}
+		values[k] = string(data)
+	}
+	return values, nil
+}
+
+// getToken returns the Vault token, read from the token file at every call so that the renewals of the token by
+// another process are picked up, or obtained with the Kubernetes authentication and cached until shortly before it
+// expires.
+func (b *vaultBackend) getToken(ctx context.Context) (string, error) {
+	if b.config.KubernetesAuth == nil {
+		data, err := os.ReadFile(b.config.TokenPath)
+		if err != nil {
+			return "", fmt.Errorf("error reading vault token: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.token != "" && time.Now().Before(b.tokenExpiry) {
+		return b.token, nil
+	}
+
+	auth := b.config.KubernetesAuth
+	jwtPath := auth.ServiceAccountTokenPath
+	if jwtPath == "" {
+		jwtPath = defaultVaultServiceAccountTokenPath
+	}
+	jwt, err := os.ReadFile(jwtPath)
+	if err != nil {
+		return "", fmt.Errorf("error reading service account token: %w", err)
+	}
+	mountPath := auth.MountPath
+	if mountPath == "" {
+		mountPath = defaultVaultKubernetesAuthMountPath
+	}
+
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	body := map[string]string{"role": auth.Role, "jwt": strings.TrimSpace(string(jwt))}
+	if err := b.do(ctx, http.MethodPost, fmt.Sprintf("/v1/auth/%s/login", strings.Trim(mountPath, "/")), "", body, &resp); err != nil {
+		return "", fmt.Errorf("error logging in to vault: %w", err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", errors.New("error logging in to vault: no client token returned")
+	}
+	b.token = resp.Auth.ClientToken
+	b.tokenExpiry = time.Now().Add(time.Duration(float64(resp.Auth.LeaseDuration)*vaultTokenRenewBeforeExpiryRatio) * time.Second)
+	return b.token, nil
+}
+
+func (b *vaultBackend) do(ctx context.Context, method string, path string, token string, body any, result any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(b.config.Address, "/")+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set(vaultTokenHeader, token)
+	}
+	if b.config.Namespace != "" {
+		req.Header.Set(vaultNamespaceHeader, b.config.Namespace)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error calling vault: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading vault response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(data, &vaultErr)
+		return fmt.Errorf("vault returned status %d: %s", resp.StatusCode, strings.Join(vaultErr.Errors, ", "))
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("error parsing vault response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package terraform_state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultCloudHostname is the hostname of HCP Terraform, formerly Terraform Cloud.
+const DefaultCloudHostname = "app.terraform.io"
+
+// CloudReader reads the outputs of the current state version of a workspace of HCP Terraform or Terraform Enterprise,
+// with their API.
+type CloudReader struct {
+	client           *http.Client
+	baseURL          string
+	organization     string
+	workspace        string
+	token            string
+	includeSensitive bool
+}
+
+var _ Reader = (*CloudReader)(nil)
+
+// NewCloudReader returns a Reader of the outputs of the workspace of the organization, at the given HCP Terraform or
+// Terraform Enterprise hostname. The API redacts the values of the sensitive outputs, which are only read one by one
+// when includeSensitive is true.
+func NewCloudReader(hostname, organization, workspace, token string, includeSensitive bool) *CloudReader {
+	if hostname == "" {
+		hostname = DefaultCloudHostname
+	}
+	return NewCloudReaderWithClient(&http.Client{}, "https://"+hostname, organization, workspace, token, includeSensitive)
+}
+
+// NewCloudReaderWithClient returns a Reader of the outputs of the workspace of the organization, using the given client
+// and the API at the given base URL.
+func NewCloudReaderWithClient(client *http.Client, baseURL, organization, workspace, token string, includeSensitive bool) *CloudReader {
+	return &CloudReader{
+		client:           client,
+		baseURL:          baseURL,
+		organization:     organization,
+		workspace:        workspace,
+		token:            token,
+		includeSensitive: includeSensitive,
+	}
+}
+
+// cloudOutput is a state version output of the API.
+type cloudOutput struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		Name      string `json:"name"`
+		Sensitive bool   `json:"sensitive"`
+		Value     any    `json:"value"`
+	} `json:"attributes"`
+}
+
+func (r *CloudReader) ReadOutputs(ctx context.Context) (map[string]Output, error) {
+	var workspace struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	workspaceURL := fmt.Sprintf("%s/api/v2/organizations/%s/workspaces/%s", r.baseURL, url.PathEscape(r.organization), url.PathEscape(r.workspace))
+	if err := r.get(ctx, workspaceURL, &workspace); err != nil {
+		return nil, fmt.Errorf("error getting workspace %s/%s: %w", r.organization, r.workspace, err)
+	}
+
+	outputs := map[string]Output{}
+	outputsURL := fmt.Sprintf("%s/api/v2/workspaces/%s/current-state-version-outputs", r.baseURL, url.PathEscape(workspace.Data.ID))
+	for outputsURL != "" {
+		var page struct {
+			Data  []cloudOutput `json:"data"`
+			Links struct {
+				Next string `json:"next"`
+			} `json:"links"`
+		}
+		if err := r.get(ctx, outputsURL, &page); err != nil {
+			return nil, fmt.Errorf("error listing the outputs of workspace %s/%s: %w", r.organization, r.workspace, err)
+		}
+		for _, output := range page.Data {
+			if output.Attributes.Sensitive && r.includeSensitive {
+				var sensitive struct {
+					Data cloudOutput `json:"data"`
+				}
+				if err := r.get(ctx, fmt.Sprintf("%s/api/v2/state-version-outputs/%s", r.baseURL, url.PathEscape(output.ID)), &sensitive); err != nil {
+					return nil, fmt.Errorf("error getting output %s of workspace %s/%s: %w", output.Attributes.Name, r.organization, r.workspace, err)
+				}
+				output = sensitive.Data
+			}
+			outputs[output.Attributes.Name] = Output{Value: output.Attributes.Value, Sensitive: output.Attributes.Sensitive}
+		}
+		// The token must not be sent to another host
+		if page.Links.Next != "" && !strings.HasPrefix(page.Links.Next, r.baseURL+"/") {
+			return nil, fmt.Errorf("unexpected next page %s of the outputs of workspace %s/%s", page.Links.Next, r.organization, r.workspace)
+		}
+		outputsURL = page.Links.Next
+	}
+	return outputs, nil
+}
+
+// get decodes into v the JSON document at the given URL of the API.
+func (r *CloudReader) get(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+r.token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+	data, err := fetch(r.client, req)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,274 @@
+package terraform_state
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// maxStateSize is the maximum size of a state read by the generator, to protect the memory of the controller.
+const maxStateSize = 64 * 1024 * 1024
+
+const (
+	// httpRequestTimeout is the timeout of the requests reading a state served by an HTTP endpoint.
+	httpRequestTimeout = 30 * time.Second
+	// maxHTTPRedirects is the maximum number of redirects followed, the same as the default of the http.Client.
+	maxHTTPRedirects = 10
+)
+
+// Output is an output of a Terraform state.
+type Output struct {
+	Value     any
+	Sensitive bool
+}
+
+// Reader reads the outputs of a Terraform state.
+type Reader interface {
+	// ReadOutputs returns the outputs of the state by name.
+	ReadOutputs(ctx context.Context) (map[string]Output, error)
+}
+
+// state is the part of a Terraform state holding the outputs, in the format of the version 4 written since
+// Terraform 0.12 and by OpenTofu.
+type state struct {
+	Version int `json:"version"`
+	Outputs map[string]struct {
+		Value     any  `json:"value"`
+		Sensitive bool `json:"sensitive"`
+	} `json:"outputs"`
+}
+
+// ParseState returns the outputs of a Terraform or OpenTofu state.
+func ParseState(data []byte) (map[string]Output, error) {
+	var s state
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error parsing the Terraform state: %w", err)
+	}
+	if s.Version != 4 {
+		return nil, fmt.Errorf("unsupported Terraform state version %d: only the version 4 is supported", s.Version)
+	}
+	outputs := make(map[string]Output, len(s.Outputs))
+	for name, output := range s.Outputs {
+		outputs[name] = Output{Value: output.Value, Sensitive: output.Sensitive}
+	}
+	return outputs, nil
+}
+
+// AWSCredentials is a static AWS access key, used instead of the identity of the pod when it is not empty.
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Client is a lean facade to the s3iface.S3API.
+type S3Client interface {
+	GetObjectWithContext(aws.Context, *s3.GetObjectInput, ...request.Option) (*s3.GetObjectOutput, error)
+}
+
+// S3Reader reads a Terraform state stored in an S3 bucket.
+type S3Reader struct {
+	client S3Client
+	bucket string
+	key    string
+}
+
+var _ Reader = (*S3Reader)(nil)
+
+// NewS3Reader returns a Reader of the state stored at the key of the S3 bucket, authenticated with the AWS identity
+// of the pod, such as IRSA, or with the given static credentials. The given role is assumed if it is not empty.
+func NewS3Reader(bucket, key, region, endpoint, role string, creds AWSCredentials) (*S3Reader, error) {
+	config := &aws.Config{}
+	if creds.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(creds.AccessKeyID, creds.SecretAccessKey, "")
+	}
+	podSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new AWS pod session: %w", err)
+	}
+	s3Session := podSession
+	if role != "" {
+		log.Debugf("role %s is provided for the Terraform state", role)
+		s3Session, err = session.NewSession(&aws.Config{
+			Credentials: stscreds.NewCredentials(podSession, role),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating new AWS S3 session: %w", err)
+		}
+	}
+	s3Config := &aws.Config{}
+	if region != "" {
+		s3Config.Region = aws.String(region)
+	}
+	if endpoint != "" {
+		// The S3 compatible storages usually do not support the virtual-hosted style
+		s3Config.Endpoint = aws.String(endpoint)
+		s3Config.S3ForcePathStyle = aws.Bool(true)
+	}
+	return NewS3ReaderWithClient(s3.New(s3Session, s3Config), bucket, key), nil
+}
+
+// NewS3ReaderWithClient returns a Reader of the state stored at the key of the S3 bucket using the given client.
+func NewS3ReaderWithClient(client S3Client, bucket, key string) *S3Reader {
+	return &S3Reader{client: client, bucket: bucket, key: key}
+}
+
+func (r *S3Reader) ReadOutputs(ctx context.Context) (map[string]Output, error) {
+	output, err := r.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading s3://%s/%s: %w", r.bucket, r.key, err)
+	}
+	defer output.Body.Close()
+	data, err := readAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading s3://%s/%s: %w", r.bucket, r.key, err)
+	}
+	return ParseState(data)
+}
+
+// gcsBaseURL is the base URL of the JSON API of Google Cloud Storage.
+const gcsBaseURL = "https://storage.googleapis.com"
+
+// GCSReader reads a Terraform state stored in a Google Cloud Storage bucket.
+type GCSReader struct {
+	client  *http.Client
+	baseURL string
+	bucket  string
+	object  string
+}
+
+var _ Reader = (*GCSReader)(nil)
+
+// NewGCSReader returns a Reader of the state stored in the object of the GCS bucket, authenticated with the identity
+// of the pod, such as Workload Identity, or with the given JSON key of a service account.
+func NewGCSReader(ctx context.Context, bucket, object, credentialsJSON string) (*GCSReader, error) {
+	const scope = "https://www.googleapis.com/auth/devstorage.read_only"
+	var client *http.Client
+	if credentialsJSON != "" {
+		creds, err := google.CredentialsFromJSON(ctx, []byte(credentialsJSON), scope)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the Google Cloud credentials: %w", err)
+		}
+		client = oauth2.NewClient(ctx, creds.TokenSource)
+	} else {
+		var err error
+		client, err = google.DefaultClient(ctx, scope)
+		if err != nil {
+			return nil, fmt.Errorf("error finding the default Google Cloud credentials: %w", err)
+		}
+	}
+	return NewGCSReaderWithClient(client, gcsBaseURL, bucket, object), nil
+}
+
+// NewGCSReaderWithClient returns a Reader of the state stored in the object of the GCS bucket, using the given
+// authenticated client and the JSON API at the given base URL.
+func NewGCSReaderWithClient(client *http.Client, baseURL, bucket, object string) *GCSReader {
+	return &GCSReader{client: client, baseURL: baseURL, bucket: bucket, object: object}
+}
+
+func (r *GCSReader) ReadOutputs(ctx context.Context) (map[string]Output, error) {
+	u := fmt.Sprintf("%s/storage/v1/b/%s/o/%s?alt=media", r.baseURL, url.PathEscape(r.bucket), url.PathEscape(r.object))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	data, err := fetch(r.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading gs://%s/%s: %w", r.bucket, r.object, err)
+	}
+	return ParseState(data)
+}
+
+// HTTPReader reads a Terraform state served by an HTTP endpoint, like the http backend of Terraform.
+type HTTPReader struct {
+	client   *http.Client
+	url      string
+	username string
+	password string
+}
+
+var _ Reader = (*HTTPReader)(nil)
+
+// NewHTTPReader returns a Reader of the state served at the URL, authenticated with basic authentication if the
+// username is not empty. If checkURL is not nil, it is called with the target of each redirect, which is not
+// followed if it returns an error.
+func NewHTTPReader(u, username, password string, insecure bool, checkURL func(u string) error) *HTTPReader {
+	client := &http.Client{
+		Timeout: httpRequestTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxHTTPRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxHTTPRedirects)
+			}
+			if checkURL == nil {
+				return nil
+			}
+			if err := checkURL(req.URL.String()); err != nil {
+				return fmt.Errorf("redirect refused: %w", err)
+			}
+			return nil
+		},
+	}
+	if insecure {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		client.Transport = tr
+	}
+	return &HTTPReader{client: client, url: u, username: username, password: password}
+}
+
+func (r *HTTPReader) ReadOutputs(ctx context.Context) (map[string]Output, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if r.username != "" {
+		req.SetBasicAuth(r.username, r.password)
+	}
+	data, err := fetch(r.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", r.url, err)
+	}
+	return ParseState(data)
+}
+
+// fetch sends the request and returns the body of the response, which must be successful.
+func fetch(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return readAll(resp.Body)
+}
+
+// readAll reads the body, up to maxStateSize.
+func readAll(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxStateSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxStateSize {
+		return nil, fmt.Errorf("the state exceeds %d bytes", maxStateSize)
+	}
+	return data, nil
+}
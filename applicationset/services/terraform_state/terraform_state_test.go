@@ -0,0 +1,201 @@
+package terraform_state
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testState = `{
+  "version": 4,
+  "terraform_version": "1.9.0",
+  "outputs": {
+    "vpc_id": {"value": "vpc-123", "type": "string"},
+    "subnets": {"value": ["subnet-a", "subnet-b"], "type": ["list", "string"]},
+    "password": {"value": "s3cr3t", "type": "string", "sensitive": true}
+  },
+  "resources": []
+}`
+
+var testOutputs = map[string]Output{
+	"vpc_id":   {Value: "vpc-123"},
+	"subnets":  {Value: []any{"subnet-a", "subnet-b"}},
+	"password": {Value: "s3cr3t", Sensitive: true},
+}
+
+func TestParseState(t *testing.T) {
+	outputs, err := ParseState([]byte(testState))
+	require.NoError(t, err)
+	assert.Equal(t, testOutputs, outputs)
+
+	_, err = ParseState([]byte(`{"version": 3, "modules": []}`))
+	require.EqualError(t, err, "unsupported Terraform state version 3: only the version 4 is supported")
+
+	_, err = ParseState([]byte(`not a state`))
+	require.ErrorContains(t, err, "error parsing the Terraform state")
+}
+
+type fakeS3Client struct {
+	objects map[string]string
+}
+
+func (c *fakeS3Client) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	object, ok := c.objects[*input.Bucket+"/"+*input.Key]
+	if !ok {
+		return nil, errors.New("NoSuchKey")
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(object))}, nil
+}
+
+func TestS3Reader(t *testing.T) {
+	client := &fakeS3Client{objects: map[string]string{"states/network/terraform.tfstate": testState}}
+
+	outputs, err := NewS3ReaderWithClient(client, "states", "network/terraform.tfstate").ReadOutputs(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, testOutputs, outputs)
+
+	_, err = NewS3ReaderWithClient(client, "states", "missing.tfstate").ReadOutputs(t.Context())
+	require.EqualError(t, err, "error reading s3://states/missing.tfstate: NoSuchKey")
+}
+
+func TestGCSReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/storage/v1/b/states/o/network%2Fdefault.tfstate" || r.URL.Query().Get("alt") != "media" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testState))
+	}))
+	defer server.Close()
+
+	outputs, err := NewGCSReaderWithClient(server.Client(), server.URL, "states", "network/default.tfstate").ReadOutputs(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, testOutputs, outputs)
+
+	_, err = NewGCSReaderWithClient(server.Client(), server.URL, "states", "missing.tfstate").ReadOutputs(t.Context())
+	require.EqualError(t, err, "error reading gs://states/missing.tfstate: unexpected status 404")
+}
+
+func TestHTTPReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "argo" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(testState))
+	}))
+	defer server.Close()
+
+	reader := NewHTTPReader(server.URL, "argo", "secret", false, nil)
+	outputs, err := reader.ReadOutputs(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, testOutputs, outputs)
+
+	_, err = NewHTTPReader(server.URL, "", "", false, nil).ReadOutputs(t.Context())
+	require.EqualError(t, err, fmt.Sprintf("error reading %s: unexpected status 401", server.URL))
+}
+
+func TestHTTPReaderRedirect(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testState))
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL+"/state", http.StatusFound)
+	}))
+	defer server.Close()
+
+	checkURL := func(allowed string) func(string) error {
+		return func(u string) error {
+			if !strings.HasPrefix(u, allowed) {
+				return fmt.Errorf("URL %s is not allowed", u)
+			}
+			return nil
+		}
+	}
+
+	outputs, err := NewHTTPReader(server.URL, "", "", false, checkURL(target.URL+"/")).ReadOutputs(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, testOutputs, outputs)
+
+	_, err = NewHTTPReader(server.URL, "", "", false, checkURL(server.URL+"/")).ReadOutputs(t.Context())
+	require.ErrorContains(t, err, fmt.Sprintf("redirect refused: URL %s/state is not allowed", target.URL))
+}
+
+func TestHTTPReaderStateTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat(" ", maxStateSize+1)))
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPReader(server.URL, "", "", false, nil).ReadOutputs(t.Context())
+	require.ErrorContains(t, err, fmt.Sprintf("the state exceeds %d bytes", maxStateSize))
+}
+
+func TestCloudReader(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.RequestURI() {
+		case "/api/v2/organizations/argoproj/workspaces/network":
+			_, _ = w.Write([]byte(`{"data": {"id": "ws-123"}}`))
+		case "/api/v2/workspaces/ws-123/current-state-version-outputs":
+			_, _ = fmt.Fprintf(w, `{
+  "data": [
+    {"id": "wsout-1", "attributes": {"name": "vpc_id", "sensitive": false, "value": "vpc-123"}},
+    {"id": "wsout-2", "attributes": {"name": "password", "sensitive": true, "value": null}}
+  ],
+  "links": {"next": "%s/api/v2/workspaces/ws-123/current-state-version-outputs?page%%5Bnumber%%5D=2"}
+}`, server.URL)
+		case "/api/v2/workspaces/ws-123/current-state-version-outputs?page%5Bnumber%5D=2":
+			_, _ = w.Write([]byte(`{
+  "data": [{"id": "wsout-3", "attributes": {"name": "subnets", "sensitive": false, "value": ["subnet-a", "subnet-b"]}}],
+  "links": {"next": null}
+}`))
+		case "/api/v2/state-version-outputs/wsout-2":
+			_, _ = w.Write([]byte(`{"data": {"id": "wsout-2", "attributes": {"name": "password", "sensitive": true, "value": "s3cr3t"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	outputs, err := NewCloudReaderWithClient(server.Client(), server.URL, "argoproj", "network", "token", true).ReadOutputs(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, testOutputs, outputs)
+
+	outputs, err = NewCloudReaderWithClient(server.Client(), server.URL, "argoproj", "network", "token", false).ReadOutputs(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, Output{Sensitive: true}, outputs["password"])
+
+	_, err = NewCloudReaderWithClient(server.Client(), server.URL, "argoproj", "missing", "token", false).ReadOutputs(t.Context())
+	require.EqualError(t, err, "error getting workspace argoproj/missing: unexpected status 404")
+}
+
+func TestCloudReaderNextPageOnAnotherHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/argoproj/workspaces/network":
+			_, _ = w.Write([]byte(`{"data": {"id": "ws-123"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data": [], "links": {"next": "https://attacker.example.com/outputs"}}`))
+		}
+	}))
+	defer server.Close()
+
+	_, err := NewCloudReaderWithClient(server.Client(), server.URL, "argoproj", "network", "token", false).ReadOutputs(t.Context())
+	require.EqualError(t, err, "unexpected next page https://attacker.example.com/outputs of the outputs of workspace argoproj/network")
+}
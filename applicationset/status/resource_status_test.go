@@ -0,0 +1,61 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestBuildResourceStatus(t *testing.T) {
+	lastTransitionTime := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	app := func(name string, syncStatus argov1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) argov1alpha1.Application {
+		return argov1alpha1.Application{
+			TypeMeta:   metav1.TypeMeta{Kind: "Application", APIVersion: "argoproj.io/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Status: argov1alpha1.ApplicationStatus{
+				Sync:   argov1alpha1.SyncStatus{Status: syncStatus},
+				Health: argov1alpha1.HealthStatus{Status: healthStatus, LastTransitionTime: &lastTransitionTime},
+			},
+		}
+	}
+
+	appset := &argov1alpha1.ApplicationSet{
+		Status: argov1alpha1.ApplicationSetStatus{
+			Resources: []argov1alpha1.ResourceStatus{
+				{Name: "app1", Status: argov1alpha1.SyncStatusCodeOutOfSync},
+				{Name: "deleted", Status: argov1alpha1.SyncStatusCodeSynced},
+			},
+		},
+	}
+
+	statusMap := BuildResourceStatus(GetResourceStatusMap(appset), []argov1alpha1.Application{
+		app("app1", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		app("app2", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusDegraded),
+	})
+
+	assert.Equal(t, map[string]argov1alpha1.ResourceStatus{
+		"app1": {
+			Group:     "argoproj.io",
+			Version:   "v1alpha1",
+			Kind:      "Application",
+			Namespace: "argocd",
+			Name:      "app1",
+			Status:    argov1alpha1.SyncStatusCodeSynced,
+			Health:    &argov1alpha1.HealthStatus{Status: health.HealthStatusHealthy, LastTransitionTime: &lastTransitionTime},
+		},
+		"app2": {
+			Group:     "argoproj.io",
+			Version:   "v1alpha1",
+			Kind:      "Application",
+			Namespace: "argocd",
+			Name:      "app2",
+			Status:    argov1alpha1.SyncStatusCodeOutOfSync,
+			Health:    &argov1alpha1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &lastTransitionTime},
+		},
+	}, statusMap)
+}
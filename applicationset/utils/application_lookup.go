@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// lookupApplicationFunction is the name of the template function returning the fields of an existing Application.
+const lookupApplicationFunction = "lookupApplication"
+
+// lookupApplicationDisabled replaces lookupApplication unless the application lookup is enabled in the ApplicationSet
+// controller, so that the templates using it fail with an explicit error.
+func lookupApplicationDisabled(string) (map[string]any, error) {
+	return nil, fmt.Errorf("the %s function is disabled: it must be enabled with the --enable-application-lookup flag of the ApplicationSet controller", lookupApplicationFunction)
+}
+
+// newLookupApplication returns the lookupApplication template function of the ApplicationSets of the given namespace.
+// The function returns the selected fields of the Application of the given name in the namespace, or an empty map if
+// it does not exist, so that the templates may use `dig` or `with` to fall back to a default.
+func newLookupApplication(ctx context.Context, c client.Reader, namespace string) func(name string) (map[string]any, error) {
+	return func(name string) (map[string]any, error) {
+		app := &argoappsv1.Application{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, app); err != nil {
+			if apierrors.IsNotFound(err) {
+				return map[string]any{}, nil
+			}
+			return nil, fmt.Errorf("error looking up Application %s/%s: %w", namespace, name, err)
+		}
+		return lookupApplicationResult(app), nil
+	}
+}
+
+// lookupApplicationResult returns the fields of the Application exposed to the templates. They are laid out as in the
+// Application resource, as nested maps which `dig` can walk through.
+func lookupApplicationResult(app *argoappsv1.Application) map[string]any {
+	spec := map[string]any{
+		"project": app.Spec.Project,
+		"destination": map[string]any{
+			"server":    app.Spec.Destination.Server,
+			"name":      app.Spec.Destination.Name,
+			"namespace": app.Spec.Destination.Namespace,
+		},
+	}
+	if app.Spec.Source != nil {
+		spec["source"] = lookupApplicationSource(*app.Spec.Source)
+	}
+	if len(app.Spec.Sources) > 0 {
+		sources := make([]any, 0, len(app.Spec.Sources))
+		for _, source := range app.Spec.Sources {
+			sources = append(sources, lookupApplicationSource(source))
+		}
+		spec["sources"] = sources
+	}
+
+	revisions := make([]any, 0, len(app.Status.Sync.Revisions))
+	for _, revision := range app.Status.Sync.Revisions {
+		revisions = append(revisions, revision)
+	}
+
+	return map[string]any{
+		"metadata": map[string]any{
+			"name":        app.Name,
+			"namespace":   app.Namespace,
+			"labels":      stringMapToAny(app.Labels),
+			"annotations": stringMapToAny(app.Annotations),
+		},
+		"spec": spec,
+		"status": map[string]any{
+			"sync": map[string]any{
+				"status":    string(app.Status.Sync.Status),
+				"revision":  app.Status.Sync.Revision,
+				"revisions": revisions,
+			},
+			"health": map[string]any{
+				"status": string(app.Status.Health.Status),
+			},
+		},
+	}
+}
+
+func lookupApplicationSource(source argoappsv1.ApplicationSource) map[string]any {
+	return map[string]any{
+		"repoURL":        source.RepoURL,
+		"path":           source.Path,
+		"chart":          source.Chart,
+		"targetRevision": source.TargetRevision,
+		"ref":            source.Ref,
+	}
+}
+
+func stringMapToAny(m map[string]string) map[string]any {
+	res := make(map[string]any, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"testing"
+	"text/template"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestLookupApplication(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argoappsv1.AddToScheme(scheme))
+	newApp := func(namespace string, revision string) *argoappsv1.Application {
+		return &argoappsv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook-legacy", Namespace: namespace, Labels: map[string]string{"team": "a"}},
+			Spec: argoappsv1.ApplicationSpec{
+				Project:     "default",
+				Source:      &argoappsv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "main"},
+				Destination: argoappsv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+			},
+			Status: argoappsv1.ApplicationStatus{
+				Sync:   argoappsv1.SyncStatus{Status: argoappsv1.SyncStatusCodeSynced, Revision: revision},
+				Health: argoappsv1.HealthStatus{Status: health.HealthStatusHealthy},
+			},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newApp("team-a", "abc123"), newApp("team-b", "def456")).Build()
+	appSet := &argoappsv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "team-a"}}
+	tmpl := &argoappsv1.Application{Spec: argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{
+		TargetRevision: `{{ dig "status" "sync" "revision" "HEAD" (lookupApplication .legacy) }}`,
+		Path:           `{{ (lookupApplication .legacy).spec.source.path }}-{{ (lookupApplication .legacy).metadata.labels.team }}`,
+	}}}
+
+	t.Run("the Applications of the namespace of the ApplicationSet are looked up", func(t *testing.T) {
+		renderer, err := ForApplicationSet(t.Context(), NewRender(nil).WithApplicationLookup(c), appSet)
+		require.NoError(t, err)
+		app, err := renderer.RenderTemplateParams(tmpl, appSet, map[string]any{"legacy": "guestbook-legacy"}, true, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", app.Spec.Source.TargetRevision)
+		assert.Equal(t, "guestbook-a", app.Spec.Source.Path)
+	})
+
+	t.Run("missing Applications are returned empty", func(t *testing.T) {
+		renderer, err := ForApplicationSet(t.Context(), NewRender(nil).WithApplicationLookup(c), appSet)
+		require.NoError(t, err)
+		app, err := renderer.RenderTemplateParams(&argoappsv1.Application{Spec: argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{
+			TargetRevision: tmpl.Spec.Source.TargetRevision,
+		}}}, appSet, map[string]any{"legacy": "missing"}, true, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "HEAD", app.Spec.Source.TargetRevision)
+	})
+
+	t.Run("the lookup is disabled by default", func(t *testing.T) {
+		renderer, err := ForApplicationSet(t.Context(), NewRender(nil), appSet)
+		require.NoError(t, err)
+		_, err = renderer.RenderTemplateParams(tmpl, appSet, map[string]any{"legacy": "guestbook-legacy"}, true, nil)
+		require.ErrorContains(t, err, "the lookupApplication function is disabled")
+	})
+
+	t.Run("the lookup cannot be overridden by the provided functions", func(t *testing.T) {
+		provider := &fakeFunctionProvider{functions: map[string]template.FuncMap{
+			"team-a": {"lookupApplication": func(string) map[string]any { return nil }},
+		}}
+		_, err := ForApplicationSet(t.Context(), NewRender(provider).WithApplicationLookup(c), appSet)
+		require.EqualError(t, err, `template function "lookupApplication" cannot override a built-in template function`)
+	})
+}
+
+func TestLookupApplicationResult(t *testing.T) {
+	app := &argoappsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+			{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "v1"},
+			{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "v2", Ref: "values"},
+		}},
+		Status: argoappsv1.ApplicationStatus{Sync: argoappsv1.SyncStatus{Revisions: []string{"abc123", "def456"}}},
+	}
+	result := lookupApplicationResult(app)
+	spec := result["spec"].(map[string]any)
+	assert.NotContains(t, spec, "source")
+	sources := spec["sources"].([]any)
+	require.Len(t, sources, 2)
+	assert.Equal(t, "values", sources[1].(map[string]any)["ref"])
+	assert.Equal(t, []any{"abc123", "def456"}, result["status"].(map[string]any)["sync"].(map[string]any)["revisions"])
+	assert.Empty(t, result["metadata"].(map[string]any)["labels"])
+}
@@ -10,8 +10,23 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// SecretTypeIndexField is the name of the cache index of the secrets by the value of their
+// argocd.argoproj.io/secret-type label. It allows listing the cluster secrets without scanning every secret
+// of the cache.
+const SecretTypeIndexField = ".metadata.labels.secret-type"
+
+// SecretTypeIndexer indexes the secrets by the value of their argocd.argoproj.io/secret-type label.
+func SecretTypeIndexer(obj client.Object) []string {
+	secretType, ok := obj.GetLabels()[common.LabelKeySecretType]
+	if !ok {
+		return nil
+	}
+	return []string{secretType}
+}
+
 // ClusterSpecifier contains only the name and server URL of a cluster. We use this struct to avoid partially-populating
 // the full Cluster struct, which would be misleading.
 type ClusterSpecifier struct {
@@ -0,0 +1,307 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ApplicationsToApplicationSet returns a suggested ApplicationSet of the given name generating the given Applications
+// with a List generator: the fields with the same value in all the Applications are kept as is in the template, while
+// the string fields with different values are replaced by parameters, whose values are listed in the elements of the
+// generator. A warning is returned for each field which cannot be templated this way and is left out of the template.
+func ApplicationsToApplicationSet(name string, apps []argoappsv1.Application) (*argoappsv1.ApplicationSet, []string, error) {
+	if len(apps) == 0 {
+		return nil, nil, errors.New("at least one Application is required")
+	}
+
+	values := make([]any, 0, len(apps))
+	for _, app := range apps {
+		if app.Namespace != apps[0].Namespace {
+			return nil, nil, fmt.Errorf("Applications %s and %s are in different namespaces", apps[0].Name, app.Name)
+		}
+		value, err := applicationToTemplateValue(app)
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values, value)
+	}
+
+	c := newApplicationsConverter(len(apps), nil)
+	tmpl, _ := c.convert(nil, values)
+
+	var template argoappsv1.ApplicationSetTemplate
+	data, err := json.Marshal(tmpl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshaling template: %w", err)
+	}
+	if err := json.Unmarshal(data, &template); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshaling template: %w", err)
+	}
+
+	elements := make([]apiextensionsv1.JSON, 0, len(c.elements))
+	for _, element := range c.elements {
+		raw, err := json.Marshal(element)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error marshaling element: %w", err)
+		}
+		elements = append(elements, apiextensionsv1.JSON{Raw: raw})
+	}
+
+	appSet := &argoappsv1.ApplicationSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       application.ApplicationSetKind,
+			APIVersion: application.Group + "/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: apps[0].Namespace,
+		},
+		Spec: argoappsv1.ApplicationSetSpec{
+			GoTemplate:        true,
+			GoTemplateOptions: []string{"missingkey=error"},
+			Generators: []argoappsv1.ApplicationSetGenerator{{
+				List: &argoappsv1.ListGenerator{Elements: elements},
+			}},
+			Template: template,
+		},
+	}
+	return appSet, c.warnings, nil
+}
+
+// applicationToTemplateValue returns the JSON value of the fields of the Application which can be set by the template
+// of an ApplicationSet.
+func applicationToTemplateValue(app argoappsv1.Application) (any, error) {
+	annotations := map[string]string{}
+	for k, v := range app.Annotations {
+		if k != corev1.LastAppliedConfigAnnotation {
+			annotations[k] = v
+		}
+	}
+	data, err := json.Marshal(argoappsv1.ApplicationSetTemplate{
+		ApplicationSetTemplateMeta: argoappsv1.ApplicationSetTemplateMeta{
+			Name:        app.Name,
+			Labels:      app.Labels,
+			Annotations: annotations,
+			Finalizers:  app.Finalizers,
+		},
+		Spec: app.Spec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling Application %s: %w", app.Name, err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("error unmarshaling Application %s: %w", app.Name, err)
+	}
+	return value, nil
+}
+
+type applicationsConverter struct {
+	// elements holds the parameters of each Application
+	elements []map[string]string
+	// params holds the names of the parameters
+	params   map[string]bool
+	warnings []string
+}
+
+func newApplicationsConverter(count int, params map[string]bool) *applicationsConverter {
+	c := &applicationsConverter{
+		elements: make([]map[string]string, count),
+		params:   map[string]bool{},
+	}
+	for i := range c.elements {
+		c.elements[i] = map[string]string{}
+	}
+	for k := range params {
+		c.params[k] = true
+	}
+	return c
+}
+
+// merge adds the parameters and warnings of the given converter to this one.
+func (c *applicationsConverter) merge(other *applicationsConverter) {
+	for i, element := range other.elements {
+		for k, v := range element {
+			c.elements[i][k] = v
+		}
+	}
+	for k := range other.params {
+		c.params[k] = true
+	}
+	c.warnings = append(c.warnings, other.warnings...)
+}
+
+// convert returns the template of the value at the given path, given its value in each Application (nil if the field
+// is not set), and whether the field should be set in the template at all.
+func (c *applicationsConverter) convert(path []string, values []any) (any, bool) {
+	if maps, ok := allMaps(values); ok {
+		keys := map[string]bool{}
+		for _, m := range maps {
+			for k := range m {
+				keys[k] = true
+			}
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+
+		res := map[string]any{}
+		for _, k := range sortedKeys {
+			children := make([]any, len(maps))
+			for i, m := range maps {
+				children[i] = m[k]
+			}
+			if child, ok := c.convert(append(path[:len(path):len(path)], k), children); ok {
+				res[k] = child
+			}
+		}
+		return res, true
+	}
+
+	if slices, ok := allSlicesOfSameLength(values); ok {
+		// Lists are templated item by item only if all their items can be templated, otherwise as a whole
+		items := newApplicationsConverter(len(c.elements), c.params)
+		res := make([]any, len(slices[0]))
+		complete := true
+		for j := range res {
+			children := make([]any, len(slices))
+			for i, s := range slices {
+				children[i] = s[j]
+			}
+			child, ok := items.convert(append(path[:len(path):len(path)], strconv.Itoa(j)), children)
+			if !ok {
+				complete = false
+				break
+			}
+			res[j] = child
+		}
+		if complete {
+			c.merge(items)
+			return res, true
+		}
+	}
+
+	for _, v := range values {
+		if v == nil {
+			c.warnings = append(c.warnings, fmt.Sprintf("%s is not set on all the Applications and was left out of the template", strings.Join(path, ".")))
+			return nil, false
+		}
+	}
+
+	if allEqual(values) {
+		return values[0], true
+	}
+
+	strs := make([]string, len(values))
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			c.warnings = append(c.warnings, fmt.Sprintf("%s has different non-string values and was left out of the template, consider a templatePatch", strings.Join(path, ".")))
+			return nil, false
+		}
+		strs[i] = s
+	}
+
+	param := c.newParamName(path)
+	for i, s := range strs {
+		c.elements[i][param] = s
+	}
+	return fmt.Sprintf("{{ .%s }}", param), true
+}
+
+// newParamName returns an unused parameter name for the field at the given path, from the last words of the path,
+// e.g. `path` for spec.source.path, or `sourcePath` if `path` is already used.
+func (c *applicationsConverter) newParamName(path []string) string {
+	var words []string
+	for _, segment := range path {
+		if _, err := strconv.Atoi(segment); err == nil {
+			continue
+		}
+		words = append(words, paramWords(segment)...)
+	}
+	for i := len(words) - 1; i >= 0; i-- {
+		if name := camelCase(words[i:]); !c.params[name] {
+			c.params[name] = true
+			return name
+		}
+	}
+	base := camelCase(words)
+	for i := 2; ; i++ {
+		if name := base + strconv.Itoa(i); !c.params[name] {
+			c.params[name] = true
+			return name
+		}
+	}
+}
+
+// paramWords splits a field name into the words of a parameter name, e.g. `app.kubernetes.io/name` into `app`,
+// `kubernetes`, `io`, `name`.
+func paramWords(field string) []string {
+	return strings.FieldsFunc(field, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func camelCase(words []string) string {
+	var sb strings.Builder
+	for i, w := range words {
+		if i == 0 {
+			sb.WriteString(strings.ToLower(w[:1]) + w[1:])
+		} else {
+			sb.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	name := sb.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "param" + strings.ToUpper(name[:min(1, len(name))]) + name[min(1, len(name)):]
+	}
+	return name
+}
+
+func allMaps(values []any) ([]map[string]any, bool) {
+	res := make([]map[string]any, len(values))
+	for i, v := range values {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		res[i] = m
+	}
+	return res, true
+}
+
+func allSlicesOfSameLength(values []any) ([][]any, bool) {
+	res := make([][]any, len(values))
+	for i, v := range values {
+		s, ok := v.([]any)
+		if !ok || i > 0 && len(s) != len(res[0]) {
+			return nil, false
+		}
+		res[i] = s
+	}
+	return res, true
+}
+
+func allEqual(values []any) bool {
+	for _, v := range values[1:] {
+		if !reflect.DeepEqual(values[0], v) {
+			return false
+		}
+	}
+	return true
+}
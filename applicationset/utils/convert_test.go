@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestApplicationsToApplicationSet(t *testing.T) {
+	newApp := func(name, env, server string, revisionHistoryLimit int64, valueFiles ...string) argoappsv1.Application {
+		return argoappsv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argocd",
+				Labels:    map[string]string{"app.kubernetes.io/env": env, "team": "a"},
+				Annotations: map[string]string{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				},
+			},
+			Spec: argoappsv1.ApplicationSpec{
+				Project: "default",
+				Source: &argoappsv1.ApplicationSource{
+					RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+					Path:           "helm-guestbook",
+					TargetRevision: "HEAD",
+					Helm:           &argoappsv1.ApplicationSourceHelm{ValueFiles: valueFiles},
+				},
+				Destination:          argoappsv1.ApplicationDestination{Server: server, Namespace: "guestbook"},
+				SyncPolicy:           &argoappsv1.SyncPolicy{Automated: &argoappsv1.SyncPolicyAutomated{Prune: true}},
+				RevisionHistoryLimit: &revisionHistoryLimit,
+			},
+		}
+	}
+
+	t.Run("templates the differing fields", func(t *testing.T) {
+		appSet, warnings, err := ApplicationsToApplicationSet("guestbook", []argoappsv1.Application{
+			newApp("guestbook-dev", "dev", "https://dev.example.com", 10, "values.yaml", "values-dev.yaml"),
+			newApp("guestbook-prod", "prod", "https://prod.example.com", 5, "values.yaml", "values-prod.yaml"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.revisionHistoryLimit has different non-string values and was left out of the template, consider a templatePatch"}, warnings)
+
+		assert.Equal(t, "guestbook", appSet.Name)
+		assert.Equal(t, "argocd", appSet.Namespace)
+		assert.True(t, appSet.Spec.GoTemplate)
+		assert.Equal(t, []apiextensionsv1.JSON{
+			{Raw: []byte(`{"env":"dev","name":"guestbook-dev","server":"https://dev.example.com","valueFiles":"values-dev.yaml"}`)},
+			{Raw: []byte(`{"env":"prod","name":"guestbook-prod","server":"https://prod.example.com","valueFiles":"values-prod.yaml"}`)},
+		}, appSet.Spec.Generators[0].List.Elements)
+
+		tmpl := appSet.Spec.Template
+		assert.Equal(t, "{{ .name }}", tmpl.Name)
+		assert.Equal(t, map[string]string{"app.kubernetes.io/env": "{{ .env }}", "team": "a"}, tmpl.Labels)
+		assert.Empty(t, tmpl.Annotations)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", tmpl.Spec.Source.RepoURL)
+		assert.Equal(t, []string{"values.yaml", "{{ .valueFiles }}"}, tmpl.Spec.Source.Helm.ValueFiles)
+		assert.Equal(t, argoappsv1.ApplicationDestination{Server: "{{ .server }}", Namespace: "guestbook"}, tmpl.Spec.Destination)
+		assert.True(t, tmpl.Spec.SyncPolicy.Automated.Prune)
+		assert.Nil(t, tmpl.Spec.RevisionHistoryLimit)
+	})
+
+	t.Run("leaves out the fields not set on all the Applications", func(t *testing.T) {
+		_, warnings, err := ApplicationsToApplicationSet("guestbook", []argoappsv1.Application{
+			newApp("guestbook-dev", "dev", "https://dev.example.com", 10, "values.yaml"),
+			newApp("guestbook-prod", "prod", "https://prod.example.com", 10),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.source.helm.valueFiles is not set on all the Applications and was left out of the template"}, warnings)
+	})
+
+	t.Run("generates unique parameter names", func(t *testing.T) {
+		dev := newApp("guestbook-dev", "dev", "https://dev.example.com", 10)
+		dev.Spec.Source.Path = "dev"
+		prod := newApp("guestbook-prod", "prod", "https://prod.example.com", 10)
+		prod.Spec.Source.Path = "prod"
+		dev.Spec.Source.Kustomize = &argoappsv1.ApplicationSourceKustomize{NamePrefix: "dev-"}
+		prod.Spec.Source.Kustomize = &argoappsv1.ApplicationSourceKustomize{NamePrefix: "prod-"}
+		dev.Spec.Source.Helm = nil
+		prod.Spec.Source.Helm = nil
+		dev.Spec.Destination.Name, dev.Spec.Destination.Server = "dev", ""
+		prod.Spec.Destination.Name, prod.Spec.Destination.Server = "prod", ""
+
+		appSet, _, err := ApplicationsToApplicationSet("guestbook", []argoappsv1.Application{dev, prod})
+		require.NoError(t, err)
+		assert.Equal(t, "{{ .name }}", appSet.Spec.Template.Name)
+		assert.Equal(t, "{{ .destinationName }}", appSet.Spec.Template.Spec.Destination.Name)
+		assert.Equal(t, "{{ .path }}", appSet.Spec.Template.Spec.Source.Path)
+	})
+
+	t.Run("requires Applications of the same namespace", func(t *testing.T) {
+		prod := newApp("guestbook-prod", "prod", "https://prod.example.com", 10)
+		prod.Namespace = "other"
+		_, _, err := ApplicationsToApplicationSet("guestbook", []argoappsv1.Application{newApp("guestbook-dev", "dev", "https://dev.example.com", 10), prod})
+		require.EqualError(t, err, "Applications guestbook-dev and guestbook-prod are in different namespaces")
+	})
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// applicationSetCRDName is the name of the ApplicationSet CustomResourceDefinition.
+const applicationSetCRDName = "applicationsets.argoproj.io"
+
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// CRDCapabilities lists which of the newer ApplicationSet fields are known by the installed ApplicationSet CRD. The
+// API server silently drops the fields missing from the schema of the CRD when an ApplicationSet is written, so the
+// features depending on them cannot work against an older CRD.
+type CRDCapabilities struct {
+	// Strategy is whether the CRD knows spec.strategy and status.applicationStatus, used by the progressive syncs.
+	Strategy bool
+	// TemplatePatch is whether the CRD knows spec.templatePatch.
+	TemplatePatch bool
+}
+
+// FullCRDCapabilities returns the capabilities of an up-to-date ApplicationSet CRD.
+func FullCRDCapabilities() CRDCapabilities {
+	return CRDCapabilities{Strategy: true, TemplatePatch: true}
+}
+
+// MissingFields returns the fields not known by the CRD.
+func (c CRDCapabilities) MissingFields() []string {
+	var missing []string
+	if !c.Strategy {
+		missing = append(missing, "spec.strategy", "status.applicationStatus")
+	}
+	if !c.TemplatePatch {
+		missing = append(missing, "spec.templatePatch")
+	}
+	return missing
+}
+
+// UnsupportedFields returns the fields set in the ApplicationSet which are not known by the CRD.
+func (c CRDCapabilities) UnsupportedFields(appSet *argoappsv1.ApplicationSet) []string {
+	var unsupported []string
+	if !c.Strategy && appSet.Spec.Strategy != nil {
+		unsupported = append(unsupported, "spec.strategy")
+	}
+	if !c.TemplatePatch && appSet.Spec.TemplatePatch != nil {
+		unsupported = append(unsupported, "spec.templatePatch")
+	}
+	return unsupported
+}
+
+// DetectCRDCapabilities reads the schema of the installed ApplicationSet CRD to find out which of the newer fields it
+// knows. A version of the CRD without a structural schema, or preserving the unknown fields, keeps all the fields.
+func DetectCRDCapabilities(ctx context.Context, dynamicClient dynamic.Interface) (CRDCapabilities, error) {
+	crd, err := dynamicClient.Resource(crdGVR).Get(ctx, applicationSetCRDName, metav1.GetOptions{})
+	if err != nil {
+		return CRDCapabilities{}, fmt.Errorf("error getting the %s CustomResourceDefinition: %w", applicationSetCRDName, err)
+	}
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return CRDCapabilities{}, fmt.Errorf("error reading the versions of the %s CustomResourceDefinition: %w", applicationSetCRDName, err)
+	}
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok || version["name"] != argoappsv1.SchemeGroupVersion.Version {
+			continue
+		}
+		openAPISchema, found, _ := unstructured.NestedMap(version, "schema", "openAPIV3Schema")
+		if !found || preservesUnknownFields(openAPISchema) {
+			return FullCRDCapabilities(), nil
+		}
+		return CRDCapabilities{
+			Strategy:      hasProperty(openAPISchema, "spec", "strategy") && hasProperty(openAPISchema, "status", "applicationStatus"),
+			TemplatePatch: hasProperty(openAPISchema, "spec", "templatePatch"),
+		}, nil
+	}
+	return CRDCapabilities{}, fmt.Errorf("the %s CustomResourceDefinition does not serve version %s", applicationSetCRDName, argoappsv1.SchemeGroupVersion.Version)
+}
+
+// hasProperty returns whether the object schema defines the property at the given path, or preserves the unknown
+// fields along the path.
+func hasProperty(objectSchema map[string]any, path ...string) bool {
+	for _, name := range path {
+		if preservesUnknownFields(objectSchema) {
+			return true
+		}
+		property, found, _ := unstructured.NestedMap(objectSchema, "properties", name)
+		if !found {
+			return false
+		}
+		objectSchema = property
+	}
+	return true
+}
+
+func preservesUnknownFields(objectSchema map[string]any) bool {
+	preserve, _, _ := unstructured.NestedBool(objectSchema, "x-kubernetes-preserve-unknown-fields")
+	return preserve
+}
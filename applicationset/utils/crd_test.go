@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newFakeApplicationSetCRD(version string, openAPISchema map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]any{"name": "applicationsets.argoproj.io"},
+		"spec": map[string]any{
+			"versions": []any{map[string]any{
+				"name":   version,
+				"schema": map[string]any{"openAPIV3Schema": openAPISchema},
+			}},
+		},
+	}}
+}
+
+func objectSchema(properties map[string]any) map[string]any {
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+func TestDetectCRDCapabilities(t *testing.T) {
+	current := objectSchema(map[string]any{
+		"spec": objectSchema(map[string]any{
+			"strategy":      objectSchema(nil),
+			"templatePatch": map[string]any{"type": "string"},
+		}),
+		"status": objectSchema(map[string]any{
+			"applicationStatus": map[string]any{"type": "array"},
+		}),
+	})
+	older := objectSchema(map[string]any{
+		"spec":   objectSchema(map[string]any{"generators": map[string]any{"type": "array"}}),
+		"status": objectSchema(nil),
+	})
+	preserved := objectSchema(map[string]any{
+		"spec":   map[string]any{"type": "object", "x-kubernetes-preserve-unknown-fields": true},
+		"status": map[string]any{"type": "object", "x-kubernetes-preserve-unknown-fields": true},
+	})
+
+	for _, c := range []struct {
+		name                 string
+		crd                  *unstructured.Unstructured
+		expectedCapabilities CRDCapabilities
+		expectedError        string
+	}{
+		{name: "current CRD", crd: newFakeApplicationSetCRD("v1alpha1", current), expectedCapabilities: FullCRDCapabilities()},
+		{name: "older CRD", crd: newFakeApplicationSetCRD("v1alpha1", older), expectedCapabilities: CRDCapabilities{}},
+		{name: "unknown fields preserved", crd: newFakeApplicationSetCRD("v1alpha1", preserved), expectedCapabilities: FullCRDCapabilities()},
+		{name: "version not served", crd: newFakeApplicationSetCRD("v1", current), expectedError: "does not serve version v1alpha1"},
+		{name: "no CRD", expectedError: "error getting the applicationsets.argoproj.io CustomResourceDefinition"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var objects []runtime.Object
+			if c.crd != nil {
+				objects = append(objects, c.crd)
+			}
+			dynamicClient := dynfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+			capabilities, err := DetectCRDCapabilities(t.Context(), dynamicClient)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expectedCapabilities, capabilities)
+		})
+	}
+}
+
+func TestCRDCapabilitiesUnsupportedFields(t *testing.T) {
+	appSet := &argoappsv1.ApplicationSet{Spec: argoappsv1.ApplicationSetSpec{
+		Strategy:      &argoappsv1.ApplicationSetStrategy{Type: "RollingSync"},
+		TemplatePatch: ptr.To("spec: {}"),
+	}}
+
+	assert.Empty(t, FullCRDCapabilities().UnsupportedFields(appSet))
+	assert.Equal(t, []string{"spec.templatePatch"}, CRDCapabilities{Strategy: true}.UnsupportedFields(appSet))
+	assert.Equal(t, []string{"spec.strategy", "spec.templatePatch"}, CRDCapabilities{}.UnsupportedFields(appSet))
+	assert.Equal(t, []string{"spec.strategy", "status.applicationStatus", "spec.templatePatch"}, CRDCapabilities{}.MissingFields())
+}
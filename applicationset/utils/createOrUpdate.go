@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"reflect"
+	"slices"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -15,6 +18,10 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -36,10 +43,62 @@ import (
 //
 // The MutateFn is called regardless of creating or updating an object.
 //
+// The update is made with an optimistic lock on the resource version of the live object, so that a concurrent edit of
+// the Application, e.g. with `argocd app set`, is never blindly overwritten. On conflict, the live object is fetched
+// again with apiReader, if not nil, and the MutateFn is called again on it, a bounded number of times. The cache of a
+// controller-runtime client may not have observed the conflicting edit yet, so apiReader should read from the API
+// server.
+//
 // It returns the executed operation and an error.
-func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, obj *argov1alpha1.Application, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, apiReader client.Reader, ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, obj *argov1alpha1.Application, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	initial := obj.DeepCopy()
+	result := controllerutil.OperationResultNone
+	var reader client.Reader = c
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Start each attempt from the given object, the live object being fetched again
+		initial.DeepCopyInto(obj)
+		var err error
+		result, err = createOrUpdate(ctx, logCtx, c, reader, ignoreAppDifferences, ignoreNormalizerOpts, obj, f)
+		if errors.IsConflict(err) {
+			logCtx.WithError(err).Debug("conflict while updating Application, retrying")
+			if apiReader != nil {
+				reader = apiReader
+			}
+		}
+		return err
+	})
+	return result, err
+}
+
+// applicationEquality compares Applications regardless of the formatting of their quantities, times and selectors.
+var applicationEquality = conversion.EqualitiesOrDie(
+	func(a, b resource.Quantity) bool {
+		// Ignore formatting, only care that numeric value stayed the same.
+		// TODO: if we decide it's important, it should be safe to start comparing the format.
+		//
+		// Uninitialized quantities are equivalent to 0 quantities.
+		return a.Cmp(b) == 0
+	},
+	func(a, b metav1.MicroTime) bool {
+		return a.UTC().Equal(b.UTC())
+	},
+	func(a, b metav1.Time) bool {
+		return a.UTC().Equal(b.UTC())
+	},
+	func(a, b labels.Selector) bool {
+		return a.String() == b.String()
+	},
+	func(a, b fields.Selector) bool {
+		return a.String() == b.String()
+	},
+	func(a, b argov1alpha1.ApplicationDestination) bool {
+		return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
+	},
+)
+
+func createOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, reader client.Reader, ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, obj *argov1alpha1.Application, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 	key := client.ObjectKeyFromObject(obj)
-	if err := c.Get(ctx, key, obj); err != nil {
+	if err := reader.Get(ctx, key, obj); err != nil {
 		if !errors.IsNotFound(err) {
 			return controllerutil.OperationResultNone, err
 		}
@@ -70,36 +129,13 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
 	obj.Spec = *argo.NormalizeApplicationSpec(&obj.Spec)
 
-	equality := conversion.EqualitiesOrDie(
-		func(a, b resource.Quantity) bool {
-			// Ignore formatting, only care that numeric value stayed the same.
-			// TODO: if we decide it's important, it should be safe to start comparing the format.
-			//
-			// Uninitialized quantities are equivalent to 0 quantities.
-			return a.Cmp(b) == 0
-		},
-		func(a, b metav1.MicroTime) bool {
-			return a.UTC().Equal(b.UTC())
-		},
-		func(a, b metav1.Time) bool {
-			return a.UTC().Equal(b.UTC())
-		},
-		func(a, b labels.Selector) bool {
-			return a.String() == b.String()
-		},
-		func(a, b fields.Selector) bool {
-			return a.String() == b.String()
-		},
-		func(a, b argov1alpha1.ApplicationDestination) bool {
-			return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
-		},
-	)
-
-	if equality.DeepEqual(normalizedLive, obj) {
+	if applicationEquality.DeepEqual(normalizedLive, obj) {
 		return controllerutil.OperationResultNone, nil
 	}
 
-	patch := client.MergeFrom(normalizedLive)
+	// The resource version of the live object is part of the patch, which is rejected with a conflict if the
+	// Application was modified since it was fetched
+	patch := client.MergeFromWithOptions(normalizedLive, client.MergeFromWithOptimisticLock{})
 	if log.IsLevelEnabled(log.DebugLevel) {
 		LogPatch(logCtx, patch, obj)
 	}
@@ -109,6 +145,174 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 	return controllerutil.OperationResultUpdated, nil
 }
 
+var applicationTypeMeta = metav1.TypeMeta{
+	APIVersion: argov1alpha1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+	Kind:       argov1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+}
+
+// CreateOrApply is the server-side apply counterpart of CreateOrUpdate. The Application resulting from the MutateFn
+// is applied with the given field manager, so that the fields set by other managers are preserved. Only the labels,
+// annotations, finalizers, owner references, spec and operation of the Application are applied.
+//
+// The fields owned by the csaManagers through update operations, i.e. by the controller before it used server-side
+// apply, are first transferred to the field manager, so that the fields it no longer applies are removed.
+//
+// It returns the executed operation and an error.
+func CreateOrApply(ctx context.Context, logCtx *log.Entry, c client.Client, fieldManager string, csaManagers []string, ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, obj *argov1alpha1.Application, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	key := client.ObjectKeyFromObject(obj)
+	if err := c.Get(ctx, key, obj); err != nil {
+		if !errors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+		if err := mutate(f, key, obj); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		if _, err := applyApplication(ctx, c, fieldManager, obj, obj.Operation); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		return controllerutil.OperationResultCreated, nil
+	}
+
+	live := obj.DeepCopy()
+	upgradePatch, err := csaupgrade.UpgradeManagedFieldsPatch(live, sets.New(csaManagers...), fieldManager)
+	if err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to compute the managed fields upgrade patch: %w", err)
+	}
+	if upgradePatch != nil {
+		logCtx.Infof("transferring the fields managed by %v to the %s field manager", csaManagers, fieldManager)
+		if err := c.Patch(ctx, live, client.RawPatch(types.JSONPatchType, upgradePatch)); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("failed to upgrade managed fields: %w", err)
+		}
+	}
+
+	if err := mutate(f, key, obj); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	obj.Spec = *argo.NormalizeApplicationSpec(&obj.Spec)
+
+	if len(ignoreAppDifferences) > 0 {
+		// The ignored fields must keep their live value, rather than be left out of the applied configuration, which
+		// would remove them if they are owned by the field manager.
+		spec, err := specWithIgnoredDifferences(ignoreAppDifferences, ignoreNormalizerOpts, live, obj)
+		if err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		obj.Spec = *spec
+	}
+
+	var operation *argov1alpha1.Operation
+	if !reflect.DeepEqual(obj.Operation, live.Operation) {
+		operation = obj.Operation
+	}
+
+	resourceVersion, err := applyApplication(ctx, c, fieldManager, obj, operation)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	// Applying a configuration which does not change the Application does not bump its resource version.
+	if resourceVersion == live.ResourceVersion {
+		return controllerutil.OperationResultNone, nil
+	}
+	return controllerutil.OperationResultUpdated, nil
+}
+
+// applyApplication applies the fields of the Application managed by the ApplicationSet controller and returns the
+// resulting resource version.
+func applyApplication(ctx context.Context, c client.Client, fieldManager string, app *argov1alpha1.Application, operation *argov1alpha1.Operation) (string, error) {
+	applied := &argov1alpha1.Application{
+		TypeMeta: applicationTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            app.Name,
+			Namespace:       app.Namespace,
+			Labels:          app.Labels,
+			Annotations:     app.Annotations,
+			Finalizers:      app.Finalizers,
+			OwnerReferences: app.OwnerReferences,
+		},
+		Spec:      app.Spec,
+		Operation: operation,
+	}
+	u, err := appToUnstructured(applied)
+	if err != nil {
+		return "", err
+	}
+	// The zero values of these fields would otherwise be part of the applied configuration.
+	delete(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+
+	if err := c.Patch(ctx, u, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership); err != nil {
+		return "", err
+	}
+	return u.GetResourceVersion(), nil
+}
+
+// specWithIgnoredDifferences returns the spec of the desired Application, with the live value of the fields matched by
+// the ignoreApplicationDifferences rules.
+func specWithIgnoredDifferences(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, live *argov1alpha1.Application, desired *argov1alpha1.Application) (*argov1alpha1.ApplicationSpec, error) {
+	// The ignore differences rules only match objects of the Application kind.
+	normalizedLive := live.DeepCopy()
+	normalizedLive.TypeMeta = applicationTypeMeta
+	normalizedDesired := desired.DeepCopy()
+	normalizedDesired.TypeMeta = applicationTypeMeta
+	if err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, normalizedDesired, ignoreNormalizerOpts); err != nil {
+		return nil, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
+	normalizedDesired.Spec = *argo.NormalizeApplicationSpec(&normalizedDesired.Spec)
+
+	// The ignored fields are removed from both applications, so the patch leaves them untouched.
+	patch, err := client.MergeFrom(normalizedLive).Data(normalizedDesired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute the patch of the application: %w", err)
+	}
+	liveJSON, err := json.Marshal(live)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal live application: %w", err)
+	}
+	mergedJSON, err := jsonpatch.MergePatch(liveJSON, patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch live application: %w", err)
+	}
+	merged := &argov1alpha1.Application{}
+	if err := json.Unmarshal(mergedJSON, merged); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal patched application: %w", err)
+	}
+	return argo.NormalizeApplicationSpec(&merged.Spec), nil
+}
+
+// IsApplicationDrifted returns whether the live Application differs from the desired Application generated by an
+// ApplicationSet. The specs are compared once the ignoreApplicationDifferences rules are applied, while only the labels
+// and annotations of the desired Application are compared, except for the preserved ones.
+func IsApplicationDrifted(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, live *argov1alpha1.Application, desired *argov1alpha1.Application, preservedAnnotations []string, preservedLabels []string) (bool, error) {
+	if isMapDrifted(live.Annotations, desired.Annotations, preservedAnnotations) || isMapDrifted(live.Labels, desired.Labels, preservedLabels) {
+		return true, nil
+	}
+
+	// The ignore differences rules only match objects of the Application kind.
+	normalizedLive := live.DeepCopy()
+	normalizedLive.TypeMeta = applicationTypeMeta
+	normalizedDesired := desired.DeepCopy()
+	normalizedDesired.TypeMeta = applicationTypeMeta
+	if err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, normalizedDesired, ignoreNormalizerOpts); err != nil {
+		return false, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+	return !applicationEquality.DeepEqual(argo.NormalizeApplicationSpec(&normalizedLive.Spec), argo.NormalizeApplicationSpec(&normalizedDesired.Spec)), nil
+}
+
+// isMapDrifted returns whether one of the desired entries, which is not preserved, is missing or has another value in
+// the live map.
+func isMapDrifted(live map[string]string, desired map[string]string, preserved []string) bool {
+	for key, value := range desired {
+		if slices.Contains(preserved, key) {
+			continue
+		}
+		if liveValue, ok := live[key]; !ok || liveValue != value {
+			return true
+		}
+	}
+	return false
+}
+
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
 	patchBytes, err := patch.Data(obj)
 	if err != nil {
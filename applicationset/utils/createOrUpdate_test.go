@@ -1,12 +1,24 @@
 package utils
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
@@ -233,3 +245,292 @@ spec:
 		})
 	}
 }
+
+func TestCreateOrApply(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newLiveApp := func() *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "app",
+				Namespace:       "argocd",
+				ResourceVersion: "1",
+				Annotations:     map[string]string{"notified.notifications.argoproj.io": "{}"},
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "default",
+				Source:  &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/repo.git", TargetRevision: "foo"},
+			},
+		}
+	}
+
+	// newClient returns a client recording the applied configurations. The apply bumps the resource version when
+	// bumpResourceVersion is true, as the API server does when the configuration changes the Application.
+	newClient := func(bumpResourceVersion bool, applied *[]*unstructured.Unstructured, patchTypes *[]types.PatchType, objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				*patchTypes = append(*patchTypes, patch.Type())
+				if patch.Type() != types.ApplyPatchType {
+					return c.Patch(ctx, obj, patch, opts...)
+				}
+				u := obj.(*unstructured.Unstructured)
+				*applied = append(*applied, u.DeepCopy())
+				if bumpResourceVersion {
+					u.SetResourceVersion("1000")
+				} else {
+					u.SetResourceVersion("1")
+				}
+				return nil
+			},
+		}).Build()
+	}
+
+	apply := func(c client.Client, ignoreDifferences v1alpha1.ApplicationSetIgnoreDifferences, generated *v1alpha1.Application) (controllerutil.OperationResult, error) {
+		found := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: generated.Name, Namespace: generated.Namespace}}
+		return CreateOrApply(t.Context(), log.NewEntry(log.StandardLogger()), c, "argocd-applicationset-controller", []string{"argocd-applicationset-controller"}, ignoreDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
+			found.Spec = generated.Spec
+			found.Labels = generated.Labels
+			found.Annotations = generated.Annotations
+			return nil
+		})
+	}
+
+	generated := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd", Labels: map[string]string{"env": "prod"}},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source:  &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/other.git", TargetRevision: "bar"},
+		},
+	}
+
+	t.Run("creates the application", func(t *testing.T) {
+		var applied []*unstructured.Unstructured
+		var patchTypes []types.PatchType
+		action, err := apply(newClient(true, &applied, &patchTypes), nil, generated)
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultCreated, action)
+		require.Len(t, applied, 1)
+		assert.Equal(t, "Application", applied[0].GetKind())
+		assert.Equal(t, map[string]string{"env": "prod"}, applied[0].GetLabels())
+		assert.NotContains(t, applied[0].Object, "status")
+		assert.NotContains(t, applied[0].Object, "operation")
+		repoURL, _, _ := unstructured.NestedString(applied[0].Object, "spec", "source", "repoURL")
+		assert.Equal(t, "https://git.example.com/other.git", repoURL)
+	})
+
+	t.Run("applies only the fields managed by the controller", func(t *testing.T) {
+		var applied []*unstructured.Unstructured
+		var patchTypes []types.PatchType
+		action, err := apply(newClient(true, &applied, &patchTypes, newLiveApp()), nil, generated)
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultUpdated, action)
+		require.Len(t, applied, 1)
+		// The annotation set by another manager is not part of the applied configuration, so it is preserved.
+		assert.Empty(t, applied[0].GetAnnotations())
+		assert.Equal(t, []types.PatchType{types.ApplyPatchType}, patchTypes)
+	})
+
+	t.Run("reports unchanged applications", func(t *testing.T) {
+		var applied []*unstructured.Unstructured
+		var patchTypes []types.PatchType
+		action, err := apply(newClient(false, &applied, &patchTypes, newLiveApp()), nil, generated)
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultNone, action)
+	})
+
+	t.Run("keeps the live value of ignored fields", func(t *testing.T) {
+		var applied []*unstructured.Unstructured
+		var patchTypes []types.PatchType
+		ignoreDifferences := v1alpha1.ApplicationSetIgnoreDifferences{{JQPathExpressions: []string{".spec.source.targetRevision"}}}
+		_, err := apply(newClient(true, &applied, &patchTypes, newLiveApp()), ignoreDifferences, generated)
+		require.NoError(t, err)
+		require.Len(t, applied, 1)
+		repoURL, _, _ := unstructured.NestedString(applied[0].Object, "spec", "source", "repoURL")
+		assert.Equal(t, "https://git.example.com/other.git", repoURL)
+		targetRevision, _, _ := unstructured.NestedString(applied[0].Object, "spec", "source", "targetRevision")
+		assert.Equal(t, "foo", targetRevision)
+	})
+
+	t.Run("transfers the fields managed with update operations", func(t *testing.T) {
+		var applied []*unstructured.Unstructured
+		var patchTypes []types.PatchType
+		live := newLiveApp()
+		live.ManagedFields = []metav1.ManagedFieldsEntry{{
+			Manager:    "argocd-applicationset-controller",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "argoproj.io/v1alpha1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:project":{}}}`)},
+		}}
+		_, err := apply(newClient(true, &applied, &patchTypes, live), nil, generated)
+		require.NoError(t, err)
+		assert.Equal(t, []types.PatchType{types.JSONPatchType, types.ApplyPatchType}, patchTypes)
+	})
+}
+
+func TestCreateOrUpdateConflict(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	liveApp := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source:  &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/repo.git", TargetRevision: "foo"},
+		},
+	}
+
+	// newClient returns a client on which the first conflictingEdits patches are preceded by a concurrent edit of the
+	// Application, as made by `argocd app set`
+	newClient := func(conflictingEdits int, patches *int) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(liveApp.DeepCopy()).WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				*patches++
+				if *patches <= conflictingEdits {
+					concurrent := &v1alpha1.Application{}
+					require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(obj), concurrent))
+					concurrent.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{ReleaseName: fmt.Sprintf("edit-%d", *patches)}
+					require.NoError(t, c.Update(ctx, concurrent))
+				}
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		}).Build()
+	}
+
+	update := func(c client.Client, apiReader client.Reader, calls *int) (controllerutil.OperationResult, error) {
+		found := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"}}
+		ignoreDifferences := v1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/source/helm"}}}
+		return CreateOrUpdate(t.Context(), log.NewEntry(log.StandardLogger()), c, apiReader, ignoreDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
+			*calls++
+			helm := found.Spec.Source.Helm
+			found.Spec.Source = &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/repo.git", TargetRevision: "bar", Helm: helm}
+			return nil
+		})
+	}
+
+	t.Run("retries on conflict with a concurrent edit", func(t *testing.T) {
+		var patches, calls int
+		c := newClient(1, &patches)
+		action, err := update(c, nil, &calls)
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultUpdated, action)
+		assert.Equal(t, 2, patches)
+		// The MutateFn is called again on the Application fetched after the conflict
+		assert.Equal(t, 2, calls)
+
+		app := &v1alpha1.Application{}
+		require.NoError(t, c.Get(t.Context(), client.ObjectKey{Name: "app", Namespace: "argocd"}, app))
+		assert.Equal(t, "bar", app.Spec.Source.TargetRevision)
+		// The concurrent edit is preserved
+		require.NotNil(t, app.Spec.Source.Helm)
+		assert.Equal(t, "edit-1", app.Spec.Source.Helm.ReleaseName)
+	})
+
+	t.Run("re-reads the Application from the API server after a conflict", func(t *testing.T) {
+		var patches, calls int
+		apiServer := newClient(1, &patches)
+		stale := &v1alpha1.Application{}
+		require.NoError(t, apiServer.Get(t.Context(), client.ObjectKey{Name: "app", Namespace: "argocd"}, stale))
+		// The cache of the client has not observed the concurrent edit yet
+		cached := interceptor.NewClient(apiServer.(client.WithWatch), interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				stale.DeepCopyInto(obj.(*v1alpha1.Application))
+				return nil
+			},
+		})
+
+		action, err := update(cached, apiServer, &calls)
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultUpdated, action)
+		assert.Equal(t, 2, patches)
+
+		_, err = update(cached, nil, &calls)
+		require.Error(t, err)
+		assert.True(t, apierrors.IsConflict(err), "the cached Application is always stale")
+	})
+
+	t.Run("gives up after a bounded number of conflicts", func(t *testing.T) {
+		var patches, calls int
+		c := newClient(100, &patches)
+		_, err := update(c, nil, &calls)
+		require.Error(t, err)
+		assert.True(t, apierrors.IsConflict(err))
+		assert.Equal(t, retry.DefaultRetry.Steps, patches)
+	})
+}
+
+func TestIsApplicationDrifted(t *testing.T) {
+	newApp := func(targetRevision string, labels map[string]string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd", Labels: labels},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "default",
+				Source:      &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/repo.git", TargetRevision: targetRevision},
+				Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "app"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		ignoreDifferences v1alpha1.ApplicationSetIgnoreDifferences
+		preservedLabels   []string
+		live              *v1alpha1.Application
+		desired           *v1alpha1.Application
+		expected          bool
+	}{
+		{
+			name:     "identical applications",
+			live:     newApp("main", map[string]string{"team": "a"}),
+			desired:  newApp("main", map[string]string{"team": "a"}),
+			expected: false,
+		},
+		{
+			name:     "edited spec",
+			live:     newApp("feature", nil),
+			desired:  newApp("main", nil),
+			expected: true,
+		},
+		{
+			name:              "ignored spec difference",
+			ignoreDifferences: v1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/source/targetRevision"}}},
+			live:              newApp("feature", nil),
+			desired:           newApp("main", nil),
+			expected:          false,
+		},
+		{
+			name:     "edited label",
+			live:     newApp("main", map[string]string{"team": "b"}),
+			desired:  newApp("main", map[string]string{"team": "a"}),
+			expected: true,
+		},
+		{
+			name:     "removed label",
+			live:     newApp("main", nil),
+			desired:  newApp("main", map[string]string{"team": "a"}),
+			expected: true,
+		},
+		{
+			name:     "added label",
+			live:     newApp("main", map[string]string{"team": "a", "owner": "b"}),
+			desired:  newApp("main", map[string]string{"team": "a"}),
+			expected: false,
+		},
+		{
+			name:            "edited preserved label",
+			preservedLabels: []string{"team"},
+			live:            newApp("main", map[string]string{"team": "b"}),
+			desired:         newApp("main", map[string]string{"team": "a"}),
+			expected:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			drifted, err := IsApplicationDrifted(tc.ignoreDifferences, normalizers.IgnoreNormalizerOpts{}, tc.live, tc.desired, nil, tc.preservedLabels)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, drifted)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var (
+	legacyTagRegex         = regexp.MustCompile(`{{(.*?)}}`)
+	legacyIdentifierRegex  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	legacyPathSegmentRegex = regexp.MustCompile(`^path\[(\d+)\]$`)
+	legacyMetadataRegex    = regexp.MustCompile(`^metadata\.(labels|annotations)\.(.+)$`)
+
+	// legacyPathTags maps the path parameters of the Git generators to their Go template equivalents, since
+	// {{ .path }} is an object with Go templates.
+	legacyPathTags = map[string]string{
+		"path":                    "{{ .path.path }}",
+		"path.basename":           "{{ .path.basename }}",
+		"path.basenameNormalized": "{{ .path.basenameNormalized }}",
+		"path.filename":           "{{ .path.filename }}",
+		"path.filenameNormalized": "{{ .path.filenameNormalized }}",
+	}
+)
+
+// GetDeprecationWarnings returns a warning for each deprecated field set on the given ApplicationSet and for each
+// parameter it references with the legacy fasttemplate syntax, with the Go template equivalent when there is one.
+func GetDeprecationWarnings(appSet *argoappsv1.ApplicationSet) []string {
+	var warnings []string
+	if appSet.Spec.ApplyNestedSelectors {
+		warnings = append(warnings, "spec.applyNestedSelectors is deprecated and ignored, the selectors of nested generators are always applied: remove the field")
+	}
+	if appSet.Spec.GoTemplate {
+		return warnings
+	}
+	for _, tag := range getLegacyTemplateTags(appSet) {
+		if suggestion := legacyTagToGoTemplate(tag); suggestion != "" {
+			warnings = append(warnings, fmt.Sprintf("{{%s}} uses the legacy template syntax: set spec.goTemplate to true and use %s instead", tag, suggestion))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("{{%s}} uses the legacy template syntax: set spec.goTemplate to true and reference the parameter with a Go template instead", tag))
+		}
+	}
+	return warnings
+}
+
+// getLegacyTemplateTags returns the sorted, distinct parameter references of the template and of the generators of
+// the ApplicationSet which have the form handled by the fasttemplate renderer, e.g. {{path.basename}}.
+func getLegacyTemplateTags(appSet *argoappsv1.ApplicationSet) []string {
+	tags := map[string]bool{}
+	for _, v := range []any{appSet.Spec.Template, appSet.Spec.Generators} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		var obj any
+		if err := json.Unmarshal(data, &obj); err != nil {
+			continue
+		}
+		walkStrings(obj, func(s string) {
+			for _, match := range legacyTagRegex.FindAllStringSubmatch(s, -1) {
+				tag := strings.TrimSpace(match[1])
+				// Go template actions start with a dot or contain spaces, pipes, parentheses or quotes
+				if tag == "" || strings.HasPrefix(tag, ".") || strings.ContainsAny(tag, " |()\"`") {
+					continue
+				}
+				tags[tag] = true
+			}
+		})
+	}
+	res := make([]string, 0, len(tags))
+	for tag := range tags {
+		res = append(res, tag)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// walkStrings calls fn for every string value and map key of the given JSON object.
+func walkStrings(obj any, fn func(string)) {
+	switch v := obj.(type) {
+	case string:
+		fn(v)
+	case map[string]any:
+		for key, value := range v {
+			fn(key)
+			walkStrings(value, fn)
+		}
+	case []any:
+		for _, value := range v {
+			walkStrings(value, fn)
+		}
+	}
+}
+
+// legacyTagToGoTemplate returns the Go template equivalent of the given fasttemplate parameter reference, or an empty
+// string if there is no direct equivalent.
+func legacyTagToGoTemplate(tag string) string {
+	if suggestion, ok := legacyPathTags[tag]; ok {
+		return suggestion
+	}
+	if match := legacyPathSegmentRegex.FindStringSubmatch(tag); match != nil {
+		return fmt.Sprintf("{{ index .path.segments %s }}", match[1])
+	}
+	if match := legacyMetadataRegex.FindStringSubmatch(tag); match != nil && strings.ContainsAny(match[2], "./-") {
+		return fmt.Sprintf("{{ index .metadata.%s %q }}", match[1], match[2])
+	}
+	if legacyIdentifierRegex.MatchString(tag) {
+		return fmt.Sprintf("{{ .%s }}", tag)
+	}
+	return ""
+}
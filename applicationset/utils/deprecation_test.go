@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestGetDeprecationWarnings(t *testing.T) {
+	template := argoappsv1.ApplicationSetTemplate{
+		ApplicationSetTemplateMeta: argoappsv1.ApplicationSetTemplateMeta{
+			Name:        "{{path.basename}}-{{ metadata.labels.app.kubernetes.io/name }}",
+			Labels:      map[string]string{"{{metadata.labels.env}}": "{{path[1]}}"},
+			Annotations: map[string]string{"source": "{{path}}/{{path.filenameNormalized}}"},
+		},
+		Spec: argoappsv1.ApplicationSpec{
+			Destination: argoappsv1.ApplicationDestination{Server: "{{server}}", Namespace: "{{values.my-namespace}}"},
+		},
+	}
+	generators := []argoappsv1.ApplicationSetGenerator{{
+		List: &argoappsv1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"server": "{{ .url }}", "name": "{{ path.basename | lower }}"}`)}}},
+	}}
+
+	t.Run("legacy template syntax", func(t *testing.T) {
+		appSet := &argoappsv1.ApplicationSet{Spec: argoappsv1.ApplicationSetSpec{Template: template, Generators: generators}}
+
+		assert.Equal(t, []string{
+			"{{metadata.labels.app.kubernetes.io/name}} uses the legacy template syntax: set spec.goTemplate to true and use {{ index .metadata.labels \"app.kubernetes.io/name\" }} instead",
+			"{{metadata.labels.env}} uses the legacy template syntax: set spec.goTemplate to true and use {{ .metadata.labels.env }} instead",
+			"{{path}} uses the legacy template syntax: set spec.goTemplate to true and use {{ .path.path }} instead",
+			"{{path.basename}} uses the legacy template syntax: set spec.goTemplate to true and use {{ .path.basename }} instead",
+			"{{path.filenameNormalized}} uses the legacy template syntax: set spec.goTemplate to true and use {{ .path.filenameNormalized }} instead",
+			"{{path[1]}} uses the legacy template syntax: set spec.goTemplate to true and use {{ index .path.segments 1 }} instead",
+			"{{server}} uses the legacy template syntax: set spec.goTemplate to true and use {{ .server }} instead",
+			"{{values.my-namespace}} uses the legacy template syntax: set spec.goTemplate to true and reference the parameter with a Go template instead",
+		}, GetDeprecationWarnings(appSet))
+	})
+
+	t.Run("go template", func(t *testing.T) {
+		appSet := &argoappsv1.ApplicationSet{Spec: argoappsv1.ApplicationSetSpec{GoTemplate: true, Template: template, Generators: generators}}
+
+		assert.Empty(t, GetDeprecationWarnings(appSet))
+	})
+
+	t.Run("deprecated fields", func(t *testing.T) {
+		appSet := &argoappsv1.ApplicationSet{Spec: argoappsv1.ApplicationSetSpec{GoTemplate: true, ApplyNestedSelectors: true}}
+
+		assert.Equal(t, []string{
+			"spec.applyNestedSelectors is deprecated and ignored, the selectors of nested generators are always applied: remove the field",
+		}, GetDeprecationWarnings(appSet))
+	})
+}
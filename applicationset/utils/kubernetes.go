@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/secret_store"
 	"github.com/argoproj/argo-cd/v3/common"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -14,11 +17,16 @@ import (
 
 var ErrDisallowedSecretAccess = fmt.Errorf("secret must have label %q=%q", common.LabelKeySecretType, common.LabelValueSecretTypeSCMCreds)
 
-// getSecretRef gets the value of the key for the specified Secret resource.
+// getSecretRef gets the value of the key for the specified Secret resource, or of the secret of the external secret
+// store if the reference has a store. The secrets of the external stores are only readable from the namespaces
+// allowed by the store, and in strict mode, only from the stores restricted to a path prefix.
 func GetSecretRef(ctx context.Context, k8sClient client.Client, ref *argoprojiov1alpha1.SecretRef, namespace string, tokenRefStrictMode bool) (string, error) {
 	if ref == nil {
 		return "", nil
 	}
+	if ref.Store != "" {
+		return secret_store.Default.Get(ctx, ref.Store, namespace, ref.SecretName, ref.Key, tokenRefStrictMode)
+	}
 
 	secret := &corev1.Secret{}
 	err := k8sClient.Get(
@@ -61,3 +69,18 @@ func GetConfigMapData(ctx context.Context, k8sClient client.Client, ref *argopro
 
 	return []byte(data), nil
 }
+
+// ControllerInstanceSelector returns the selector of the ApplicationSets reconciled by the controller instance of the
+// given name: the ApplicationSets labelled with that instance name, or the ApplicationSets without an instance label if
+// the name is empty.
+func ControllerInstanceSelector(instance string) (labels.Selector, error) {
+	op, values := selection.Equals, []string{instance}
+	if instance == "" {
+		op, values = selection.DoesNotExist, nil
+	}
+	requirement, err := labels.NewRequirement(common.LabelKeyApplicationSetControllerInstance, op, values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid controller instance %q: %w", instance, err)
+	}
+	return labels.NewSelector().Add(*requirement), nil
+}
@@ -1,14 +1,19 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/secret_store"
+	"github.com/argoproj/argo-cd/v3/common"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -77,6 +82,48 @@ func TestGetSecretRef(t *testing.T) {
 	}
 }
 
+type fakeSecretStoreBackend map[string]map[string]string
+
+func (b fakeSecretStoreBackend) GetSecret(_ context.Context, path string) (map[string]string, error) {
+	values, ok := b[path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return values, nil
+}
+
+func TestGetSecretRefFromStore(t *testing.T) {
+	defaultStores := secret_store.Default
+	t.Cleanup(func() { secret_store.Default = defaultStores })
+	backend := fakeSecretStoreBackend{
+		"scm/github":        {"token": "vault-token"},
+		"teams/test/github": {"token": "team-token"},
+	}
+	secret_store.Default = secret_store.New(map[string]secret_store.Store{
+		"vault": {Backend: backend, Namespaces: []string{"test"}},
+		"teams": {Backend: backend, Namespaces: []string{"*"}, PathPrefix: "teams/{{namespace}}/"},
+	}, 0)
+	client := fake.NewClientBuilder().Build()
+
+	token, err := GetSecretRef(t.Context(), client, &argoprojiov1alpha1.SecretRef{Store: "vault", SecretName: "scm/github", Key: "token"}, "test", false)
+	require.NoError(t, err)
+	assert.Equal(t, "vault-token", token)
+
+	// The stores with no path prefix cannot be read in strict mode
+	_, err = GetSecretRef(t.Context(), client, &argoprojiov1alpha1.SecretRef{Store: "vault", SecretName: "scm/github", Key: "token"}, "test", true)
+	require.ErrorContains(t, err, "the store has no path prefix, which is required in strict mode")
+
+	token, err = GetSecretRef(t.Context(), client, &argoprojiov1alpha1.SecretRef{Store: "teams", SecretName: "teams/test/github", Key: "token"}, "test", true)
+	require.NoError(t, err)
+	assert.Equal(t, "team-token", token)
+
+	_, err = GetSecretRef(t.Context(), client, &argoprojiov1alpha1.SecretRef{Store: "vault", SecretName: "scm/github", Key: "token"}, "other", false)
+	require.ErrorContains(t, err, "the namespace is not allowed by the store")
+
+	_, err = GetSecretRef(t.Context(), client, &argoprojiov1alpha1.SecretRef{Store: "aws", SecretName: "scm/github", Key: "token"}, "test", false)
+	require.EqualError(t, err, `secret store "aws" is not configured`)
+}
+
 func TestGetConfigMapData(t *testing.T) {
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "test"},
@@ -143,3 +190,29 @@ func TestGetConfigMapData(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerInstanceSelector(t *testing.T) {
+	unlabelled := labels.Set{}
+	shardA := labels.Set{common.LabelKeyApplicationSetControllerInstance: "shard-a"}
+	shardB := labels.Set{common.LabelKeyApplicationSetControllerInstance: "shard-b"}
+
+	t.Run("named instance", func(t *testing.T) {
+		selector, err := ControllerInstanceSelector("shard-a")
+		require.NoError(t, err)
+		assert.False(t, selector.Matches(unlabelled))
+		assert.True(t, selector.Matches(shardA))
+		assert.False(t, selector.Matches(shardB))
+	})
+
+	t.Run("default instance", func(t *testing.T) {
+		selector, err := ControllerInstanceSelector("")
+		require.NoError(t, err)
+		assert.True(t, selector.Matches(unlabelled))
+		assert.False(t, selector.Matches(shardA))
+	})
+
+	t.Run("invalid instance", func(t *testing.T) {
+		_, err := ControllerInstanceSelector("shard/a")
+		require.ErrorContains(t, err, `invalid controller instance "shard/a"`)
+	})
+}
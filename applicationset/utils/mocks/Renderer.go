@@ -13,9 +13,9 @@ type Renderer struct {
 	mock.Mock
 }
 
-// RenderTemplateParams provides a mock function with given fields: tmpl, syncPolicy, params, useGoTemplate, goTemplateOptions
-func (_m *Renderer) RenderTemplateParams(tmpl *v1alpha1.Application, syncPolicy *v1alpha1.ApplicationSetSyncPolicy, params map[string]interface{}, useGoTemplate bool, goTemplateOptions []string) (*v1alpha1.Application, error) {
-	ret := _m.Called(tmpl, syncPolicy, params, useGoTemplate, goTemplateOptions)
+// RenderTemplateParams provides a mock function with given fields: tmpl, appSet, params, useGoTemplate, goTemplateOptions
+func (_m *Renderer) RenderTemplateParams(tmpl *v1alpha1.Application, appSet *v1alpha1.ApplicationSet, params map[string]interface{}, useGoTemplate bool, goTemplateOptions []string) (*v1alpha1.Application, error) {
+	ret := _m.Called(tmpl, appSet, params, useGoTemplate, goTemplateOptions)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RenderTemplateParams")
@@ -23,19 +23,19 @@ func (_m *Renderer) RenderTemplateParams(tmpl *v1alpha1.Application, syncPolicy
 
 	var r0 *v1alpha1.Application
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, *v1alpha1.ApplicationSetSyncPolicy, map[string]interface{}, bool, []string) (*v1alpha1.Application, error)); ok {
-		return rf(tmpl, syncPolicy, params, useGoTemplate, goTemplateOptions)
+	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, *v1alpha1.ApplicationSet, map[string]interface{}, bool, []string) (*v1alpha1.Application, error)); ok {
+		return rf(tmpl, appSet, params, useGoTemplate, goTemplateOptions)
 	}
-	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, *v1alpha1.ApplicationSetSyncPolicy, map[string]interface{}, bool, []string) *v1alpha1.Application); ok {
-		r0 = rf(tmpl, syncPolicy, params, useGoTemplate, goTemplateOptions)
+	if rf, ok := ret.Get(0).(func(*v1alpha1.Application, *v1alpha1.ApplicationSet, map[string]interface{}, bool, []string) *v1alpha1.Application); ok {
+		r0 = rf(tmpl, appSet, params, useGoTemplate, goTemplateOptions)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.Application)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*v1alpha1.Application, *v1alpha1.ApplicationSetSyncPolicy, map[string]interface{}, bool, []string) error); ok {
-		r1 = rf(tmpl, syncPolicy, params, useGoTemplate, goTemplateOptions)
+	if rf, ok := ret.Get(1).(func(*v1alpha1.Application, *v1alpha1.ApplicationSet, map[string]interface{}, bool, []string) error); ok {
+		r1 = rf(tmpl, appSet, params, useGoTemplate, goTemplateOptions)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,9 +43,9 @@ func (_m *Renderer) RenderTemplateParams(tmpl *v1alpha1.Application, syncPolicy
 	return r0, r1
 }
 
-// Replace provides a mock function with given fields: tmpl, replaceMap, useGoTemplate, goTemplateOptions
-func (_m *Renderer) Replace(tmpl string, replaceMap map[string]interface{}, useGoTemplate bool, goTemplateOptions []string) (string, error) {
-	ret := _m.Called(tmpl, replaceMap, useGoTemplate, goTemplateOptions)
+// Replace provides a mock function with given fields: tmpl, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved
+func (_m *Renderer) Replace(tmpl string, replaceMap map[string]interface{}, useGoTemplate bool, goTemplateOptions []string, allowUnresolved bool) (string, error) {
+	ret := _m.Called(tmpl, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Replace")
@@ -53,17 +53,17 @@ func (_m *Renderer) Replace(tmpl string, replaceMap map[string]interface{}, useG
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, bool, []string) (string, error)); ok {
-		return rf(tmpl, replaceMap, useGoTemplate, goTemplateOptions)
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, bool, []string, bool) (string, error)); ok {
+		return rf(tmpl, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 	}
-	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, bool, []string) string); ok {
-		r0 = rf(tmpl, replaceMap, useGoTemplate, goTemplateOptions)
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, bool, []string, bool) string); ok {
+		r0 = rf(tmpl, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, map[string]interface{}, bool, []string) error); ok {
-		r1 = rf(tmpl, replaceMap, useGoTemplate, goTemplateOptions)
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}, bool, []string, bool) error); ok {
+		r1 = rf(tmpl, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	apiextensionsopenapi "k8s.io/apiextensions-apiserver/pkg/generated/openapi"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/util"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect of the OpenAPI v2 schemas the Kubernetes OpenAPI definitions are made of.
+const jsonSchemaDraft = "http://json-schema.org/draft-04/schema#"
+
+// ApplicationSetJSONSchema returns the JSON Schema of the ApplicationSet custom resource. The schema is built from the
+// OpenAPI definitions generated from the Go types, so that it always describes the fields known to this version of
+// Argo CD. Referenced types are listed under `definitions`.
+func ApplicationSetJSONSchema() (map[string]any, error) {
+	ref := func(name string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + util.ToRESTFriendlyName(name))
+	}
+	// The apiextensions-apiserver definitions include the apimachinery types, such as ObjectMeta, referenced by the
+	// ApplicationSet types.
+	definitions := apiextensionsopenapi.GetOpenAPIDefinitions(ref)
+	maps.Copy(definitions, argoappsv1.GetOpenAPIDefinitions(ref))
+	// IntOrString has no generated definition, describe it the same way as in the CRD.
+	definitions[util.GetCanonicalTypeName(&intstr.IntOrString{})] = common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				AnyOf: []spec.Schema{*spec.Int64Property(), *spec.StringProperty()},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{"x-kubernetes-int-or-string": true},
+			},
+		},
+	}
+	// The structs embedded in plugin parameters have no JSON name, which openapi-gen does not support. Their fields are
+	// serialized inline.
+	inlineDefinitions(definitions, &argoappsv1.ApplicationSourcePluginParameter{}, &argoappsv1.OptionalMap{}, &argoappsv1.OptionalArray{})
+
+	root := util.GetCanonicalTypeName(&argoappsv1.ApplicationSet{})
+	schemas, err := collectDefinitions(definitions, root)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Schema:      jsonSchemaDraft,
+			AllOf:       []spec.Schema{{SchemaProps: spec.SchemaProps{Ref: ref(root)}}},
+			Definitions: schemas,
+		},
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the ApplicationSet schema: %w", err)
+	}
+	res := map[string]any{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("error unmarshaling the ApplicationSet schema: %w", err)
+	}
+	return res, nil
+}
+
+// collectDefinitions returns the schemas of the given type and of all the types it transitively depends on, keyed by
+// their REST friendly name.
+func collectDefinitions(definitions map[string]common.OpenAPIDefinition, root string) (spec.Definitions, error) {
+	res := spec.Definitions{}
+	queue := []string{root}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		key := util.ToRESTFriendlyName(name)
+		if _, ok := res[key]; ok {
+			continue
+		}
+		definition, ok := definitions[name]
+		if !ok {
+			return nil, fmt.Errorf("no OpenAPI definition found for type %s", name)
+		}
+		res[key] = definition.Schema
+		queue = append(queue, definition.Dependencies...)
+	}
+	return res, nil
+}
+
+// inlineDefinitions adds the properties of the embedded types to the definition of the given type.
+func inlineDefinitions(definitions map[string]common.OpenAPIDefinition, model any, embedded ...any) {
+	name := util.GetCanonicalTypeName(model)
+	definition := definitions[name]
+	properties := maps.Clone(definition.Schema.Properties)
+	for _, e := range embedded {
+		embeddedDefinition := definitions[util.GetCanonicalTypeName(e)]
+		maps.Copy(properties, embeddedDefinition.Schema.Properties)
+		definition.Dependencies = append(definition.Dependencies, embeddedDefinition.Dependencies...)
+	}
+	definition.Schema.Properties = properties
+	definitions[name] = definition
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestApplicationSetJSONSchema(t *testing.T) {
+	schema, err := ApplicationSetJSONSchema()
+	require.NoError(t, err)
+
+	definitions, ok := schema["definitions"].(map[string]any)
+	require.True(t, ok)
+
+	t.Run("all references resolve", func(t *testing.T) {
+		var check func(v any)
+		check = func(v any) {
+			switch v := v.(type) {
+			case map[string]any:
+				if ref, ok := v["$ref"].(string); ok {
+					assert.Contains(t, definitions, strings.TrimPrefix(ref, "#/definitions/"))
+				}
+				for _, v := range v {
+					check(v)
+				}
+			case []any:
+				for _, v := range v {
+					check(v)
+				}
+			}
+		}
+		check(schema)
+	})
+
+	t.Run("matches the CRD", func(t *testing.T) {
+		data, err := os.ReadFile("../../manifests/crds/applicationset-crd.yaml")
+		require.NoError(t, err)
+		var crd map[string]any
+		require.NoError(t, yaml.Unmarshal(data, &crd))
+		versions := crd["spec"].(map[string]any)["versions"].([]any)
+		crdSchema := versions[0].(map[string]any)["schema"].(map[string]any)["openAPIV3Schema"].(map[string]any)
+
+		resolve := func(v map[string]any) map[string]any {
+			for {
+				if allOf, ok := v["allOf"].([]any); ok && len(allOf) == 1 {
+					v = allOf[0].(map[string]any)
+				}
+				ref, ok := v["$ref"].(string)
+				if !ok {
+					return v
+				}
+				v = definitions[strings.TrimPrefix(ref, "#/definitions/")].(map[string]any)
+			}
+		}
+
+		var compare func(path string, crd, generated map[string]any)
+		compare = func(path string, crd, generated map[string]any) {
+			generated = resolve(generated)
+			if items, ok := crd["items"].(map[string]any); ok {
+				generatedItems, ok := generated["items"].(map[string]any)
+				if assert.True(t, ok, "%s: missing items", path) {
+					compare(path+"[]", items, generatedItems)
+				}
+				return
+			}
+			crdProperties, ok := crd["properties"].(map[string]any)
+			if !ok {
+				return
+			}
+			generatedProperties, _ := generated["properties"].(map[string]any)
+			assert.Equal(t, sortedKeys(crdProperties), sortedKeys(generatedProperties), "%s: properties differ", path)
+			for name, property := range crdProperties {
+				if generatedProperty, ok := generatedProperties[name].(map[string]any); ok {
+					compare(path+"."+name, property.(map[string]any), generatedProperty)
+				}
+			}
+		}
+		spec := resolve(schema)["properties"].(map[string]any)["spec"].(map[string]any)
+		compare("spec", crdSchema["properties"].(map[string]any)["spec"].(map[string]any), spec)
+	})
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+const (
+	// maxCachedTemplates bounds the number of entries of a templateCache. The templates are only parsed, and not
+	// cached, once it is reached.
+	maxCachedTemplates = 10000
+	// maxPooledBufferSize is the capacity above which a buffer is not returned to the pool, so that a single large
+	// rendering does not keep its memory allocated.
+	maxPooledBufferSize = 64 * 1024
+)
+
+// bufferPool holds the buffers the Go templates are executed into.
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+type templateCacheKey struct {
+	tmpl    string
+	options string
+	debug   bool
+	boundTo string
+}
+
+type funcMapCacheKey struct {
+	appSet string
+	debug  bool
+}
+
+// templateCache holds the parsed Go templates and the decoded JSON fields of the templates of an ApplicationSet, so
+// that they are parsed once and executed for each parameter set.
+type templateCache struct {
+	lock       sync.Mutex
+	templates  map[templateCacheKey]*template.Template
+	jsonFields map[string]any
+	funcMaps   map[funcMapCacheKey]template.FuncMap
+}
+
+func newTemplateCache() *templateCache {
+	return &templateCache{
+		templates:  map[templateCacheKey]*template.Template{},
+		jsonFields: map[string]any{},
+		funcMaps:   map[funcMapCacheKey]template.FuncMap{},
+	}
+}
+
+// WithTemplateCache returns a copy of the renderer which parses each Go template, and decodes each JSON field of the
+// templates, only once. It is meant to render the templates of an ApplicationSet against all its parameter sets, the
+// cache being dropped along with the returned renderer. Renderers other than Render are returned as is.
+func WithTemplateCache(renderer Renderer) Renderer {
+	r, ok := renderer.(*Render)
+	if !ok {
+		return renderer
+	}
+	return &Render{debug: r.debug, cache: newTemplateCache(), functionProvider: r.functionProvider, applicationReader: r.applicationReader}
+}
+
+// parseGoTemplate parses the Go template with the given options, or returns it from the cache of the renderer.
+func (r *Render) parseGoTemplate(tmpl string, goTemplateOptions []string) (*template.Template, error) {
+	key := templateCacheKey{tmpl: tmpl, options: strings.Join(goTemplateOptions, ","), debug: r.debug, boundTo: r.boundTo}
+	if r.cache != nil {
+		r.cache.lock.Lock()
+		parsed, ok := r.cache.templates[key]
+		r.cache.lock.Unlock()
+		if ok {
+			return parsed, nil
+		}
+	}
+
+	funcMap := r.funcMap
+	if funcMap == nil {
+		funcMap = sprigFuncMap
+		if r.debug {
+			funcMap = debugFuncMap
+		}
+	}
+	parsed, err := template.New("").Funcs(funcMap).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", tmpl, err)
+	}
+	parsed, err = withGoTemplateOptions(parsed, goTemplateOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.cache != nil {
+		r.cache.lock.Lock()
+		if len(r.cache.templates) < maxCachedTemplates {
+			r.cache.templates[key] = parsed
+		}
+		r.cache.lock.Unlock()
+	}
+	return parsed, nil
+}
+
+// decodeJSONField decodes a raw JSON or YAML field of a template, or returns it from the cache of the renderer. The
+// decoded value is only read when rendered, and is shared by the parameter sets.
+func (r *Render) decodeJSONField(raw []byte) (any, error) {
+	if r.cache != nil {
+		r.cache.lock.Lock()
+		decoded, ok := r.cache.jsonFields[string(raw)]
+		r.cache.lock.Unlock()
+		if ok {
+			return decoded, nil
+		}
+	}
+
+	var decoded any
+	convertedToJSON, err := ConvertYAMLToJSON(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("error while converting template to json %q: %w", convertedToJSON, err)
+	}
+	if err := json.Unmarshal([]byte(convertedToJSON), &decoded); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON field: %w", err)
+	}
+
+	if r.cache != nil {
+		r.cache.lock.Lock()
+		if len(r.cache.jsonFields) < maxCachedTemplates {
+			r.cache.jsonFields[string(raw)] = decoded
+		}
+		r.cache.lock.Unlock()
+	}
+	return decoded, nil
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils/mocks"
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newCacheTestApplication() *argoappsv1.Application {
+	return &argoappsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "{{ .cluster }}-guestbook",
+			Annotations: map[string]string{"cluster": "{{ .cluster }}"},
+		},
+		Spec: argoappsv1.ApplicationSpec{
+			Source: &argoappsv1.ApplicationSource{
+				Helm: &argoappsv1.ApplicationSourceHelm{
+					ValuesObject: &runtime.RawExtension{Raw: []byte(`{"cluster": "{{ .cluster }}"}`)},
+				},
+			},
+			Destination: argoappsv1.ApplicationDestination{Namespace: "{{ .cluster }}"},
+		},
+	}
+}
+
+func TestWithTemplateCache(t *testing.T) {
+	renderer := WithTemplateCache(&Render{})
+	render := renderer.(*Render)
+	tmpl := newCacheTestApplication()
+
+	var cachedTemplates int
+	for _, cluster := range []string{"dev", "prod"} {
+		app, err := renderer.RenderTemplateParams(tmpl, nil, map[string]any{"cluster": cluster}, true, []string{"missingkey=error"})
+		require.NoError(t, err)
+		assert.Equal(t, cluster+"-guestbook", app.Name)
+		assert.Equal(t, cluster, app.Annotations["cluster"])
+		assert.Equal(t, cluster, app.Spec.Destination.Namespace)
+		assert.JSONEq(t, fmt.Sprintf(`{"cluster": %q}`, cluster), string(app.Spec.Source.Helm.ValuesObject.Raw))
+		// The templates are only parsed for the first parameter set
+		if cachedTemplates == 0 {
+			cachedTemplates = len(render.cache.templates)
+		}
+		assert.Len(t, render.cache.templates, cachedTemplates)
+	}
+	assert.Len(t, render.cache.jsonFields, 1)
+	assert.Equal(t, `{"cluster": "{{ .cluster }}"}`, string(tmpl.Spec.Source.Helm.ValuesObject.Raw), "the template must not be modified")
+
+	t.Run("templates are parsed per option and debug mode", func(t *testing.T) {
+		renderer := WithTemplateCache(&Render{})
+		render := renderer.(*Render)
+		_, err := renderer.Replace("{{ .cluster }}", map[string]any{"cluster": "dev"}, true, nil, false)
+		require.NoError(t, err)
+		_, err = renderer.Replace("{{ .cluster }}", map[string]any{"cluster": "dev"}, true, []string{"missingkey=error"}, false)
+		require.NoError(t, err)
+		appSet := &argoappsv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.AnnotationApplicationSetDebug: "true"},
+		}}
+		tmpl := &argoappsv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "{{ .cluster }}"}}
+		_, err = renderer.RenderTemplateParams(tmpl, appSet, map[string]any{"cluster": "dev"}, true, nil)
+		require.NoError(t, err)
+		assert.Contains(t, render.cache.templates, templateCacheKey{tmpl: "{{ .cluster }}"})
+		assert.Contains(t, render.cache.templates, templateCacheKey{tmpl: "{{ .cluster }}", options: "missingkey=error"})
+		assert.Contains(t, render.cache.templates, templateCacheKey{tmpl: "{{ .cluster }}", debug: true})
+	})
+
+	t.Run("invalid templates are not cached", func(t *testing.T) {
+		renderer := WithTemplateCache(&Render{})
+		for range 2 {
+			_, err := renderer.Replace("{{ .cluster", map[string]any{"cluster": "dev"}, true, nil, false)
+			require.ErrorContains(t, err, "failed to parse template {{ .cluster")
+		}
+		assert.Empty(t, renderer.(*Render).cache.templates)
+	})
+
+	t.Run("other renderers are returned as is", func(t *testing.T) {
+		renderer := &mocks.Renderer{}
+		assert.Same(t, renderer, WithTemplateCache(renderer))
+	})
+}
+
+func BenchmarkRenderTemplateParams(b *testing.B) {
+	tmpl := newCacheTestApplication()
+	params := make([]map[string]any, 1000)
+	for i := range params {
+		params[i] = map[string]any{"cluster": fmt.Sprintf("cluster-%d", i)}
+	}
+
+	for _, c := range []struct {
+		name     string
+		renderer func() Renderer
+	}{
+		{name: "without cache", renderer: func() Renderer { return &Render{} }},
+		{name: "with cache", renderer: func() Renderer { return WithTemplateCache(&Render{}) }},
+	} {
+		b.Run(c.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				renderer := c.renderer()
+				for _, p := range params {
+					if _, err := renderer.RenderTemplateParams(tmpl, nil, p, true, nil); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
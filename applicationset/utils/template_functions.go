@@ -1,10 +1,17 @@
 package utils
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/netip"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
 )
 
 // SanitizeName sanitizes the name in accordance with the below rules
@@ -38,6 +45,22 @@ func toYAML(v any) (string, error) {
 	return strings.TrimSuffix(string(data), "\n"), nil
 }
 
+// dump renders the given value, typically the whole parameter map with `{{ dump . }}`, as indented JSON so that template
+// authors can inspect the parameters available at render time.
+func dump(v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// dumpDisabled replaces dump in the ApplicationSets without the debug annotation, so that the parameters are not exposed
+// unintentionally.
+func dumpDisabled(any) (string, error) {
+	return "", fmt.Errorf("the dump function is only available when the %s annotation of the ApplicationSet is set to \"true\"", common.AnnotationApplicationSetDebug)
+}
+
 // This has been copied from helm and may be removed as soon as it is retrofited in sprig
 // fromYAML converts a YAML document into a map[string]any.
 //
@@ -69,3 +92,102 @@ func fromYAMLArray(str string) ([]any, error) {
 	}
 	return a, nil
 }
+
+// cidrHost returns the IP address of the given host number in the given CIDR prefix, e.g. 10.0.0.5 for host 5 of
+// 10.0.0.0/24. A negative host number counts back from the last address of the prefix.
+func cidrHost(prefix string, hostnum any) (string, error) {
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR prefix %q: %w", prefix, err)
+	}
+	p = p.Masked()
+	n, err := templateInt(hostnum)
+	if err != nil {
+		return "", err
+	}
+	num := big.NewInt(n)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(p.Addr().BitLen()-p.Bits()))
+	if num.Sign() < 0 {
+		num.Add(num, size)
+	}
+	if num.Sign() < 0 || num.Cmp(size) >= 0 {
+		return "", fmt.Errorf("host number %d does not fit in %s", n, p)
+	}
+	return addToAddr(p.Addr(), num).String(), nil
+}
+
+// cidrSubnet returns the subnet of the given CIDR prefix with the given number of additional prefix bits and the given
+// network number, e.g. 10.1.2.0/24 for the network 2 with 8 additional bits of 10.1.0.0/16.
+func cidrSubnet(prefix string, newbits any, netnum any) (string, error) {
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR prefix %q: %w", prefix, err)
+	}
+	p = p.Masked()
+	bits, err := templateInt(newbits)
+	if err != nil {
+		return "", err
+	}
+	n, err := templateInt(netnum)
+	if err != nil {
+		return "", err
+	}
+	length := int64(p.Bits()) + bits
+	if bits < 0 || length > int64(p.Addr().BitLen()) {
+		return "", fmt.Errorf("cannot extend %s by %d bits", p, bits)
+	}
+	if n < 0 || big.NewInt(n).Cmp(new(big.Int).Lsh(big.NewInt(1), uint(bits))) >= 0 {
+		return "", fmt.Errorf("network number %d does not fit in %d bits", n, bits)
+	}
+	num := new(big.Int).Lsh(big.NewInt(n), uint(int64(p.Addr().BitLen())-length))
+	return netip.PrefixFrom(addToAddr(p.Addr(), num), int(length)).String(), nil
+}
+
+// ipFamily returns the family, IPv4 or IPv6, of the given IP address or CIDR prefix.
+func ipFamily(s string) (string, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		p, prefixErr := netip.ParsePrefix(s)
+		if prefixErr != nil {
+			return "", fmt.Errorf("invalid IP address or CIDR prefix %q", s)
+		}
+		addr = p.Addr()
+	}
+	if addr.Unmap().Is4() {
+		return "IPv4", nil
+	}
+	return "IPv6", nil
+}
+
+func addToAddr(addr netip.Addr, n *big.Int) netip.Addr {
+	b := new(big.Int).SetBytes(addr.AsSlice())
+	b.Add(b, n)
+	res, _ := netip.AddrFromSlice(b.FillBytes(make([]byte, addr.BitLen()/8)))
+	return res
+}
+
+// templateInt converts a number given to a template function, which is a float64 when it comes from a JSON parameter,
+// to an integer.
+func templateInt(v any) (int64, error) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), nil
+	case int32:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case float64:
+		if n != float64(int64(n)) {
+			return 0, fmt.Errorf("%v is not an integer", n)
+		}
+		return int64(n), nil
+	case string:
+		i, err := strconv.ParseInt(n, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not an integer", n)
+		}
+		return i, nil
+	default:
+		return 0, fmt.Errorf("%v is not an integer", v)
+	}
+}
@@ -1,7 +1,7 @@
 package utils
 
 import (
-	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -11,6 +11,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
@@ -19,15 +20,22 @@ import (
 	"github.com/Masterminds/sprig/v3"
 	"github.com/gosimple/slug"
 	"github.com/valyala/fasttemplate"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 )
 
-var sprigFuncMap = sprig.GenericFuncMap() // a singleton for better performance
+var (
+	sprigFuncMap = sprig.GenericFuncMap() // a singleton for better performance
+	// debugFuncMap holds the template functions of the ApplicationSets with the debug annotation.
+	debugFuncMap = map[string]any{}
+)
 
 func init() {
 	// Avoid allowing the user to learn things about the environment.
@@ -39,14 +47,160 @@ func init() {
 	sprigFuncMap["toYaml"] = toYAML
 	sprigFuncMap["fromYaml"] = fromYAML
 	sprigFuncMap["fromYamlArray"] = fromYAMLArray
+	sprigFuncMap["cidrhost"] = cidrHost
+	sprigFuncMap["cidrsubnet"] = cidrSubnet
+	sprigFuncMap["ipFamily"] = ipFamily
+	sprigFuncMap["dump"] = dumpDisabled
+	sprigFuncMap[lookupApplicationFunction] = lookupApplicationDisabled
+
+	for name, fn := range sprigFuncMap {
+		debugFuncMap[name] = fn
+	}
+	debugFuncMap["dump"] = dump
+}
+
+// FuncMap returns the functions available to the Go templates of the ApplicationSets: the Sprig functions, except for
+// env, expandenv and getHostByName which would expose the environment of the controller, and the functions added by
+// Argo CD:
+//   - normalize and slugify, which turn a string into a valid DNS name,
+//   - toYaml, fromYaml and fromYamlArray,
+//   - cidrhost, cidrsubnet and ipFamily,
+//   - dump, which fails the rendering unless the ApplicationSet has the debug annotation,
+//   - lookupApplication, which fails the rendering unless the application lookup is enabled, see WithApplicationLookup.
+//
+// The returned map is a copy, which the caller may modify.
+func FuncMap() template.FuncMap {
+	funcMap := make(template.FuncMap, len(sprigFuncMap))
+	for name, fn := range sprigFuncMap {
+		funcMap[name] = fn
+	}
+	return funcMap
 }
 
 type Renderer interface {
-	RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsv1.ApplicationSetSyncPolicy, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (*argoappsv1.Application, error)
-	Replace(tmpl string, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string) (string, error)
+	RenderTemplateParams(tmpl *argoappsv1.Application, appSet *argoappsv1.ApplicationSet, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (*argoappsv1.Application, error)
+	Replace(tmpl string, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string, allowUnresolved bool) (string, error)
+}
+
+// FunctionProvider provides the template functions registered for the ApplicationSets of a namespace, in addition to
+// the ones of FuncMap.
+type FunctionProvider interface {
+	Functions(ctx context.Context, namespace string, appSetName string) (template.FuncMap, error)
+}
+
+type Render struct {
+	// debug makes the dump template function available.
+	debug bool
+	// cache holds the parsed templates, when set by WithTemplateCache.
+	cache *templateCache
+	// functionProvider provides the additional template functions of the ApplicationSets, if any.
+	functionProvider FunctionProvider
+	// funcMap holds the template functions of the ApplicationSet the renderer is bound to, if any.
+	funcMap template.FuncMap
+	// boundTo is the namespace and name of the ApplicationSet the renderer is bound to.
+	boundTo string
+	// applicationReader reads the Applications returned by the lookupApplication template function, when set by
+	// WithApplicationLookup.
+	applicationReader client.Reader
+}
+
+// NewRender returns a Render making the template functions of the given provider available to the Go templates of the
+// ApplicationSets.
+func NewRender(functionProvider FunctionProvider) *Render {
+	return &Render{functionProvider: functionProvider}
+}
+
+// WithApplicationLookup returns a copy of the renderer making the lookupApplication template function available to the
+// ApplicationSets. The function returns the fields of an existing Application of the namespace of the ApplicationSet,
+// read with the given client.
+func (r *Render) WithApplicationLookup(c client.Reader) *Render {
+	return &Render{debug: r.debug, functionProvider: r.functionProvider, applicationReader: c}
+}
+
+// ForApplicationSet returns the renderer bound to the ApplicationSet, which renders its templates with the template
+// functions of the debug mode and of the function provider, as configured for the ApplicationSet. Renderers other than
+// Render are returned as is.
+func ForApplicationSet(ctx context.Context, renderer Renderer, appSet *argoappsv1.ApplicationSet) (Renderer, error) {
+	r, ok := renderer.(*Render)
+	if !ok {
+		return renderer, nil
+	}
+	return r.forApplicationSet(ctx, appSet)
+}
+
+func (r *Render) forApplicationSet(ctx context.Context, appSet *argoappsv1.ApplicationSet) (*Render, error) {
+	debug := r.debug || IsDebugEnabled(appSet)
+	if (r.functionProvider == nil && r.applicationReader == nil) || appSet == nil {
+		if debug == r.debug {
+			return r, nil
+		}
+		return &Render{debug: debug, cache: r.cache}, nil
+	}
+	boundTo := appSet.Namespace + "/" + appSet.Name
+	if boundTo == r.boundTo && debug == r.debug {
+		return r, nil
+	}
+	funcMap, err := r.applicationSetFuncMap(ctx, appSet, debug)
+	if err != nil {
+		return nil, err
+	}
+	return &Render{
+		debug:             debug,
+		cache:             r.cache,
+		functionProvider:  r.functionProvider,
+		funcMap:           funcMap,
+		boundTo:           boundTo,
+		applicationReader: r.applicationReader,
+	}, nil
 }
 
-type Render struct{}
+// applicationSetFuncMap returns the template functions of the ApplicationSet, along with the ones of the function
+// provider and the lookupApplication function if the application lookup is enabled. The provided functions cannot
+// override the built-in ones.
+func (r *Render) applicationSetFuncMap(ctx context.Context, appSet *argoappsv1.ApplicationSet, debug bool) (template.FuncMap, error) {
+	key := funcMapCacheKey{appSet: appSet.Namespace + "/" + appSet.Name, debug: debug}
+	if r.cache != nil {
+		r.cache.lock.Lock()
+		funcMap, ok := r.cache.funcMaps[key]
+		r.cache.lock.Unlock()
+		if ok {
+			return funcMap, nil
+		}
+	}
+
+	var functions template.FuncMap
+	if r.functionProvider != nil {
+		var err error
+		functions, err = r.functionProvider.Functions(ctx, appSet.Namespace, appSet.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the template functions of ApplicationSet %s: %w", appSet.Name, err)
+		}
+	}
+	builtinFuncMap := sprigFuncMap
+	if debug {
+		builtinFuncMap = debugFuncMap
+	}
+	funcMap := make(template.FuncMap, len(builtinFuncMap)+len(functions))
+	for name, fn := range builtinFuncMap {
+		funcMap[name] = fn
+	}
+	for name, fn := range functions {
+		if _, ok := funcMap[name]; ok {
+			return nil, fmt.Errorf("template function %q cannot override a built-in template function", name)
+		}
+		funcMap[name] = fn
+	}
+	if r.applicationReader != nil {
+		funcMap[lookupApplicationFunction] = newLookupApplication(ctx, r.applicationReader, appSet.Namespace)
+	}
+
+	if r.cache != nil {
+		r.cache.lock.Lock()
+		r.cache.funcMaps[key] = funcMap
+		r.cache.lock.Unlock()
+	}
+	return funcMap, nil
+}
 
 func IsNamespaceAllowed(namespaces []string, namespace string) bool {
 	return glob.MatchStringInList(namespaces, namespace, glob.REGEXP)
@@ -81,7 +235,7 @@ func ConvertYAMLToJSON(str string) (string, error) {
 
 // This function is in charge of searching all String fields of the object recursively and apply templating
 // thanks to https://gist.github.com/randallmlough/1fd78ec8a1034916ca52281e3b886dc7
-func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string) error {
+func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string, allowUnresolved bool) error {
 	switch original.Kind() {
 	// The first cases handle nested structures and translate them recursively
 	// If it is a pointer we need to unwrap and call once again
@@ -101,7 +255,7 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 			copyUnexported(copy, original)
 		}
 		// Unwrap the newly created pointer
-		if err := r.deeplyReplace(copy.Elem(), originalValue, replaceMap, useGoTemplate, goTemplateOptions); err != nil {
+		if err := r.deeplyReplace(copy.Elem(), originalValue, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved); err != nil {
 			// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 			return err
 		}
@@ -122,7 +276,7 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 			reflectValue := reflect.New(reflectType)
 
 			copyValue := reflectValue.Elem()
-			if err := r.deeplyReplace(copyValue, originalValue, replaceMap, useGoTemplate, goTemplateOptions); err != nil {
+			if err := r.deeplyReplace(copyValue, originalValue, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved); err != nil {
 				// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 				return err
 			}
@@ -137,19 +291,13 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 			if currentType == "time.Time" {
 				copy.Field(i).Set(original.Field(i))
 			} else if currentType == "Raw.k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1" || currentType == "Raw.k8s.io/apimachinery/pkg/runtime" {
-				var unmarshaled any
-				originalBytes := original.Field(i).Bytes()
-				convertedToJSON, err := ConvertYAMLToJSON(string(originalBytes))
-				if err != nil {
-					return fmt.Errorf("error while converting template to json %q: %w", convertedToJSON, err)
-				}
-				err = json.Unmarshal([]byte(convertedToJSON), &unmarshaled)
+				unmarshaled, err := r.decodeJSONField(original.Field(i).Bytes())
 				if err != nil {
-					return fmt.Errorf("failed to unmarshal JSON field: %w", err)
+					return err
 				}
 				jsonOriginal := reflect.ValueOf(&unmarshaled)
 				jsonCopy := reflect.New(jsonOriginal.Type()).Elem()
-				err = r.deeplyReplace(jsonCopy, jsonOriginal, replaceMap, useGoTemplate, goTemplateOptions)
+				err = r.deeplyReplace(jsonCopy, jsonOriginal, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 				if err != nil {
 					return fmt.Errorf("failed to deeply replace JSON field contents: %w", err)
 				}
@@ -159,7 +307,7 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 					return fmt.Errorf("failed to marshal templated JSON field: %w", err)
 				}
 				copy.Field(i).Set(reflect.ValueOf(data))
-			} else if err := r.deeplyReplace(copy.Field(i), original.Field(i), replaceMap, useGoTemplate, goTemplateOptions); err != nil {
+			} else if err := r.deeplyReplace(copy.Field(i), original.Field(i), replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved); err != nil {
 				// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 				return err
 			}
@@ -174,7 +322,7 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 		}
 
 		for i := 0; i < original.Len(); i++ {
-			if err := r.deeplyReplace(copy.Index(i), original.Index(i), replaceMap, useGoTemplate, goTemplateOptions); err != nil {
+			if err := r.deeplyReplace(copy.Index(i), original.Index(i), replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved); err != nil {
 				// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 				return err
 			}
@@ -195,14 +343,14 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 			// New gives us a pointer, but again we want the value
 			copyValue := reflect.New(originalValue.Type()).Elem()
 
-			if err := r.deeplyReplace(copyValue, originalValue, replaceMap, useGoTemplate, goTemplateOptions); err != nil {
+			if err := r.deeplyReplace(copyValue, originalValue, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved); err != nil {
 				// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 				return err
 			}
 
 			// Keys can be templated as well as values (e.g. to template something into an annotation).
 			if key.Kind() == reflect.String {
-				templatedKey, err := r.Replace(key.String(), replaceMap, useGoTemplate, goTemplateOptions)
+				templatedKey, err := r.Replace(key.String(), replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 				if err != nil {
 					// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 					return err
@@ -217,7 +365,7 @@ func (r *Render) deeplyReplace(copy, original reflect.Value, replaceMap map[stri
 	// If it is a string translate it (yay finally we're doing what we came for)
 	case reflect.String:
 		strToTemplate := original.String()
-		templated, err := r.Replace(strToTemplate, replaceMap, useGoTemplate, goTemplateOptions)
+		templated, err := r.Replace(strToTemplate, replaceMap, useGoTemplate, goTemplateOptions, allowUnresolved)
 		if err != nil {
 			// Not wrapping the error, since this is a recursive function. Avoids excessively long error messages.
 			return err
@@ -250,7 +398,7 @@ func isNillable(v reflect.Value) bool {
 	return false
 }
 
-func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsv1.ApplicationSetSyncPolicy, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (*argoappsv1.Application, error) {
+func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, appSet *argoappsv1.ApplicationSet, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (*argoappsv1.Application, error) {
 	if tmpl == nil {
 		return nil, errors.New("application template is empty")
 	}
@@ -262,25 +410,75 @@ func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *
 	original := reflect.ValueOf(tmpl)
 	copy := reflect.New(original.Type()).Elem()
 
-	if err := r.deeplyReplace(copy, original, params, useGoTemplate, goTemplateOptions); err != nil {
+	renderer, err := r.forApplicationSet(context.Background(), appSet)
+	if err != nil {
+		return nil, err
+	}
+	// The legacy templates keep the unresolved parameters, while the Go templates follow their missingkey option
+	if err := renderer.deeplyReplace(copy, original, params, useGoTemplate, goTemplateOptions, !useGoTemplate); err != nil {
 		return nil, err
 	}
 
 	replacedTmpl := copy.Interface().(*argoappsv1.Application)
 
+	if tmpl.Finalizers != nil {
+		finalizers, err := renderedFinalizers(replacedTmpl.Finalizers)
+		if err != nil {
+			return nil, err
+		}
+		replacedTmpl.Finalizers = finalizers
+	}
+
 	// Add the 'resources-finalizer' finalizer if:
-	// The template application doesn't have any finalizers, and:
-	// a) there is no syncPolicy, or
-	// b) there IS a syncPolicy, but preserveResourcesOnDeletion is set to false
+	// The template application doesn't declare any finalizers, not even an empty list, and:
+	// a) preserveApplicationFinalizers is not set to false, and
+	// b) there is no syncPolicy, or there IS a syncPolicy, but preserveResourcesOnDeletion is set to false
 	// See TestRenderTemplateParamsFinalizers in util_test.go for test-based definition of behaviour
-	if (syncPolicy == nil || !syncPolicy.PreserveResourcesOnDeletion) &&
-		len(replacedTmpl.Finalizers) == 0 {
+	if tmpl.Finalizers == nil && addResourcesFinalizer(appSet) {
 		replacedTmpl.Finalizers = []string{"resources-finalizer.argocd.argoproj.io"}
 	}
 
 	return replacedTmpl, nil
 }
 
+// renderedFinalizers returns the templated finalizers once rendered. The finalizers rendered to an empty string are
+// dropped, so that a template can only add a finalizer to some of the Applications, e.g. with
+// '{{ if .ephemeral }}resources-finalizer.argocd.argoproj.io/background{{ end }}', as well as the duplicated ones.
+// The remaining finalizers must be valid Kubernetes finalizer names.
+func renderedFinalizers(finalizers []string) ([]string, error) {
+	rendered := make([]string, 0, len(finalizers))
+	for _, finalizer := range finalizers {
+		finalizer = strings.TrimSpace(finalizer)
+		if finalizer == "" || slices.Contains(rendered, finalizer) {
+			continue
+		}
+		if errs := validation.IsQualifiedName(finalizer); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid finalizer %q: %s", finalizer, strings.Join(errs, "; "))
+		}
+		rendered = append(rendered, finalizer)
+	}
+	return rendered, nil
+}
+
+// IsDebugEnabled returns whether the debug annotation of the ApplicationSet is set, making the dump template function
+// available to its Go templates.
+func IsDebugEnabled(appSet *argoappsv1.ApplicationSet) bool {
+	return appSet != nil && appSet.Annotations[common.AnnotationApplicationSetDebug] == "true"
+}
+
+// addResourcesFinalizer returns whether the resources finalizer is added to the Applications of the ApplicationSet
+// whose template declares no finalizers.
+func addResourcesFinalizer(appSet *argoappsv1.ApplicationSet) bool {
+	if appSet == nil {
+		return true
+	}
+	appSetSpec := &appSet.Spec
+	if appSetSpec.PreserveApplicationFinalizers != nil && !*appSetSpec.PreserveApplicationFinalizers {
+		return false
+	}
+	return appSetSpec.SyncPolicy == nil || !appSetSpec.SyncPolicy.PreserveResourcesOnDeletion
+}
+
 func (r *Render) RenderGeneratorParams(gen *argoappsv1.ApplicationSetGenerator, params map[string]any, useGoTemplate bool, goTemplateOptions []string) (*argoappsv1.ApplicationSetGenerator, error) {
 	if gen == nil {
 		return nil, errors.New("generator is empty")
@@ -293,7 +491,8 @@ func (r *Render) RenderGeneratorParams(gen *argoappsv1.ApplicationSetGenerator,
 	original := reflect.ValueOf(gen)
 	copy := reflect.New(original.Type()).Elem()
 
-	if err := r.deeplyReplace(copy, original, params, useGoTemplate, goTemplateOptions); err != nil {
+	// The generator may reference parameters which are not available yet, such as its own ones in its values
+	if err := r.deeplyReplace(copy, original, params, useGoTemplate, goTemplateOptions, true); err != nil {
 		return nil, fmt.Errorf("failed to replace parameters in generator: %w", err)
 	}
 
@@ -304,20 +503,28 @@ func (r *Render) RenderGeneratorParams(gen *argoappsv1.ApplicationSetGenerator,
 
 var isTemplatedRegex = regexp.MustCompile(".*{{.*}}.*")
 
-// Replace executes basic string substitution of a template with replacement values.
-// remaining in the substituted template.
-func (r *Render) Replace(tmpl string, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string) (string, error) {
+// Replace executes basic string substitution of a template with replacement values. allowUnresolved indicates whether
+// it is acceptable to have unresolved parameters remaining in the substituted template: a legacy template then keeps
+// its unresolved '{{param}}' tags, and a Go template referencing a key missing from the replacement values is returned
+// verbatim, whatever its missingkey option. Otherwise, an unresolved tag of a legacy template is an error, and a missing
+// key of a Go template is handled according to its missingkey option. The errors of the execution of a Go template are
+// returned.
+func (r *Render) Replace(tmpl string, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string, allowUnresolved bool) (string, error) {
 	if useGoTemplate {
-		template, err := template.New("").Funcs(sprigFuncMap).Parse(tmpl)
-		if err != nil {
-			return "", fmt.Errorf("failed to parse template %s: %w", tmpl, err)
+		if allowUnresolved {
+			goTemplateOptions = append(slices.Clip(goTemplateOptions), "missingkey=error")
 		}
-		for _, option := range goTemplateOptions {
-			template = template.Option(option)
+		template, err := r.parseGoTemplate(tmpl, goTemplateOptions)
+		if err != nil {
+			return "", err
 		}
 
-		var replacedTmplBuffer bytes.Buffer
-		if err = template.Execute(&replacedTmplBuffer, replaceMap); err != nil {
+		replacedTmplBuffer := getBuffer()
+		defer putBuffer(replacedTmplBuffer)
+		if err = template.Execute(replacedTmplBuffer, replaceMap); err != nil {
+			if allowUnresolved && isMissingKeyError(err) {
+				return tmpl, nil
+			}
 			return "", fmt.Errorf("failed to execute go template %s: %w", tmpl, err)
 		}
 
@@ -332,17 +539,29 @@ func (r *Render) Replace(tmpl string, replaceMap map[string]any, useGoTemplate b
 	if err != nil {
 		return "", fmt.Errorf("invalid template: %w", err)
 	}
+	var unresolved []string
 	replacedTmpl := fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
 		trimmedTag := strings.TrimSpace(tag)
 		replacement, ok := replaceMap[trimmedTag].(string)
 		if len(trimmedTag) == 0 || !ok {
+			unresolved = append(unresolved, tag)
 			return fmt.Fprintf(w, "{{%s}}", tag)
 		}
 		return w.Write([]byte(replacement))
 	})
+	if !allowUnresolved && len(unresolved) > 0 {
+		return "", fmt.Errorf("failed to resolve {{%s}} in template %s", unresolved[0], tmpl)
+	}
 	return replacedTmpl, nil
 }
 
+// isMissingKeyError returns whether the error of the execution of a Go template is caused by a key missing from the
+// replacement values, with the missingkey=error option.
+func isMissingKeyError(err error) bool {
+	var execErr template.ExecError
+	return errors.As(err, &execErr) && strings.Contains(execErr.Err.Error(), "map has no entry for key")
+}
+
 // Log a warning if there are unrecognized generators
 func CheckInvalidGenerators(applicationSetInfo *argoappsv1.ApplicationSet) error {
 	hasInvalidGenerators, invalidGenerators := invalidGenerators(applicationSetInfo)
@@ -366,6 +585,97 @@ func CheckInvalidGenerators(applicationSetInfo *argoappsv1.ApplicationSet) error
 	return errorMessage
 }
 
+// withGoTemplateOptions sets the given options, such as "missingkey=error", on the Go template. An error is returned
+// for the options unknown to text/template, which would otherwise panic.
+func withGoTemplateOptions(tmpl *template.Template, goTemplateOptions []string) (res *template.Template, err error) {
+	for _, option := range goTemplateOptions {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("invalid Go template option %q: %v", option, r)
+				}
+			}()
+			tmpl = tmpl.Option(option)
+		}()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
+// CheckTemplateSyntax returns an error for each string of the template, or of the template patch, of the ApplicationSet
+// which is not a valid Go template, and for each unknown Go template option. The given functions, registered for the
+// ApplicationSet by a FunctionProvider, are available along with the built-in ones. ApplicationSets which do not use Go
+// templates are not checked.
+func CheckTemplateSyntax(applicationSetInfo *argoappsv1.ApplicationSet, functions template.FuncMap) error {
+	if !applicationSetInfo.Spec.GoTemplate {
+		return nil
+	}
+	data, err := json.Marshal(applicationSetInfo.Spec.Template)
+	if err != nil {
+		return fmt.Errorf("error marshaling the template: %w", err)
+	}
+	var tmpl any
+	if err := json.Unmarshal(data, &tmpl); err != nil {
+		return fmt.Errorf("error unmarshaling the template: %w", err)
+	}
+	tmplStrings := collectStrings(tmpl, nil)
+	if applicationSetInfo.Spec.TemplatePatch != nil {
+		tmplStrings = append(tmplStrings, *applicationSetInfo.Spec.TemplatePatch)
+	}
+	var errs []error
+	if _, err := withGoTemplateOptions(template.New(""), applicationSetInfo.Spec.GoTemplateOptions); err != nil {
+		errs = append(errs, err)
+	}
+	for _, tmplString := range tmplStrings {
+		if _, err := template.New("").Funcs(sprigFuncMap).Funcs(functions).Parse(tmplString); err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse template %s: %w", tmplString, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// collectStrings appends the strings of the given JSON value, including the keys of its objects, to res.
+func collectStrings(value any, res []string) []string {
+	switch v := value.(type) {
+	case string:
+		res = append(res, v)
+	case []any:
+		for _, item := range v {
+			res = collectStrings(item, res)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			res = collectStrings(v[key], append(res, key))
+		}
+	}
+	return res
+}
+
+// CheckPermittedGenerators returns an error if the ApplicationSet uses generators which are not permitted by the project
+func CheckPermittedGenerators(applicationSetInfo *argoappsv1.ApplicationSet, project *argoappsv1.AppProject) error {
+	generatorTypes, err := applicationSetInfo.GeneratorTypes()
+	if err != nil {
+		return err
+	}
+	var notPermitted []string
+	for _, generatorType := range generatorTypes {
+		if !project.IsApplicationSetGeneratorPermitted(generatorType) {
+			notPermitted = append(notPermitted, generatorType)
+		}
+	}
+	if len(notPermitted) > 0 {
+		return fmt.Errorf("ApplicationSet %s contains generators which are not permitted in project %s: %s", applicationSetInfo.Name, project.Name, strings.Join(notPermitted, ", "))
+	}
+	return nil
+}
+
 // Return true if there are unknown generators specified in the application set.  If we can discover the names
 // of these generators, return the names as the keys in a map
 func invalidGenerators(applicationSetInfo *argoappsv1.ApplicationSet) (bool, map[string]bool) {
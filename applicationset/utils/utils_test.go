@@ -1,11 +1,14 @@
 package utils
 
 import (
+	"context"
 	"crypto/x509"
 	"encoding/json"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -16,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -545,6 +549,16 @@ func TestRenderTemplateParamsGoTemplate(t *testing.T) {
 			templateOptions: []string{"missingkey=error"},
 			errorMessage:    `failed to execute go template --> {{.doesnotexist}} <--: template: :1:6: executing "" at <.doesnotexist>: map has no entry for key "doesnotexist"`,
 		},
+		{
+			name:        "unknown template option",
+			fieldVal:    `--> {{.doesnotexist}} <--`,
+			expectedVal: "",
+			params: map[string]any{
+				"unused": "this is not used",
+			},
+			templateOptions: []string{"missingkey=fail"},
+			errorMessage:    `invalid Go template option "missingkey=fail": unrecognized option: missingkey=fail`,
+		},
 		{
 			name:        "toYaml",
 			fieldVal:    `{{ toYaml . | indent 2 }}`,
@@ -603,6 +617,49 @@ func TestRenderTemplateParamsGoTemplate(t *testing.T) {
 				"value": "non\n compliant\n yaml",
 			},
 		},
+		{
+			name:        "cidrhost",
+			fieldVal:    `{{ cidrhost .cidr 5 }} {{ cidrhost .cidr .index }} {{ cidrhost .cidr -2 }} {{ cidrhost "fd00::/64" 16 }}`,
+			expectedVal: "10.0.0.5 10.0.0.10 10.0.0.254 fd00::10",
+			params: map[string]any{
+				"cidr":  "10.0.0.0/24",
+				"index": float64(10),
+			},
+		},
+		{
+			name:         "cidrhost error",
+			fieldVal:     `{{ cidrhost .cidr 256 }}`,
+			errorMessage: "failed to execute go template {{ cidrhost .cidr 256 }}: template: :1:3: executing \"\" at <cidrhost .cidr 256>: error calling cidrhost: host number 256 does not fit in 10.0.0.0/24",
+			params: map[string]any{
+				"cidr": "10.0.0.0/24",
+			},
+		},
+		{
+			name:        "cidrsubnet",
+			fieldVal:    `{{ cidrsubnet .cidr 8 .index }} {{ cidrsubnet "fd00::/48" 16 "3" }}`,
+			expectedVal: "10.1.2.0/24 fd00:0:0:3::/64",
+			params: map[string]any{
+				"cidr":  "10.1.0.0/16",
+				"index": float64(2),
+			},
+		},
+		{
+			name:         "cidrsubnet error",
+			fieldVal:     `{{ cidrsubnet .cidr 2 4 }}`,
+			errorMessage: "failed to execute go template {{ cidrsubnet .cidr 2 4 }}: template: :1:3: executing \"\" at <cidrsubnet .cidr 2 4>: error calling cidrsubnet: network number 4 does not fit in 2 bits",
+			params: map[string]any{
+				"cidr": "10.1.0.0/16",
+			},
+		},
+		{
+			name:        "ipFamily",
+			fieldVal:    `{{ ipFamily .v4 }} {{ ipFamily .v6 }} {{ ipFamily "10.0.0.1" }}`,
+			expectedVal: "IPv4 IPv6 IPv4",
+			params: map[string]any{
+				"v4": "10.96.0.0/12",
+				"v6": "fd00:10:96::/112",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -715,11 +772,44 @@ func TestRenderTemplateKeys(t *testing.T) {
 func Test_Render_Replace_no_panic_on_missing_closing_brace(t *testing.T) {
 	r := &Render{}
 	assert.NotPanics(t, func() {
-		_, err := r.Replace("{{properly.closed}} {{improperly.closed}", nil, false, []string{})
+		_, err := r.Replace("{{properly.closed}} {{improperly.closed}", nil, false, []string{}, true)
 		require.Error(t, err)
 	})
 }
 
+func TestRenderReplace(t *testing.T) {
+	params := map[string]any{"cluster": "dev", "nested": map[string]any{"path": "apps"}}
+	for _, c := range []struct {
+		name              string
+		tmpl              string
+		useGoTemplate     bool
+		goTemplateOptions []string
+		allowUnresolved   bool
+		expected          string
+		expectedError     string
+	}{
+		{name: "legacy template", tmpl: "{{cluster}}-{{ unknown }}", allowUnresolved: true, expected: "dev-{{ unknown }}"},
+		{name: "legacy template with unresolved parameter", tmpl: "{{cluster}}-{{ unknown }}", expectedError: "failed to resolve {{ unknown }} in template {{cluster}}-{{ unknown }}"},
+		{name: "Go template", tmpl: "{{.cluster}}-{{.nested.path}}", useGoTemplate: true, expected: "dev-apps"},
+		{name: "Go template with missing key", tmpl: "{{.cluster}}-{{.unknown}}", useGoTemplate: true, expected: "dev-<no value>"},
+		{name: "Go template with missing key and missingkey=error", tmpl: "{{.cluster}}-{{.unknown}}", useGoTemplate: true, goTemplateOptions: []string{"missingkey=error"}, expectedError: `map has no entry for key "unknown"`},
+		{name: "Go template with missing key kept verbatim", tmpl: "{{.cluster}}-{{.unknown}}", useGoTemplate: true, allowUnresolved: true, expected: "{{.cluster}}-{{.unknown}}"},
+		{name: "Go template with missing nested key kept verbatim", tmpl: "{{.nested.unknown}}", useGoTemplate: true, goTemplateOptions: []string{"missingkey=zero"}, allowUnresolved: true, expected: "{{.nested.unknown}}"},
+		{name: "Go template execution error", tmpl: `{{ fail "boom" }}`, useGoTemplate: true, allowUnresolved: true, expectedError: "failed to execute go template {{ fail \"boom\" }}"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := &Render{}
+			res, err := r.Replace(c.tmpl, params, c.useGoTemplate, c.goTemplateOptions, c.allowUnresolved)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
+
 func TestRenderTemplateParamsFinalizers(t *testing.T) {
 	emptyApplication := &argoappsv1.Application{
 		Spec: argoappsv1.ApplicationSpec{
@@ -739,10 +829,11 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 	}
 
 	for _, c := range []struct {
-		testName           string
-		syncPolicy         *argoappsv1.ApplicationSetSyncPolicy
-		existingFinalizers []string
-		expectedFinalizers []string
+		testName                      string
+		syncPolicy                    *argoappsv1.ApplicationSetSyncPolicy
+		preserveApplicationFinalizers *bool
+		existingFinalizers            []string
+		expectedFinalizers            []string
 	}{
 		{
 			testName:           "existing finalizer should be preserved",
@@ -771,10 +862,10 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 			expectedFinalizers: []string{"resources-finalizer.argocd.argoproj.io"},
 		},
 		{
-			testName:           "empty array finalizers should use standard finalizer",
+			testName:           "empty array finalizers should not have a finalizer",
 			existingFinalizers: []string{},
 			syncPolicy:         nil,
-			expectedFinalizers: []string{"resources-finalizer.argocd.argoproj.io"},
+			expectedFinalizers: []string{},
 		},
 		{
 			testName:           "non-nil sync policy should use standard finalizer",
@@ -798,6 +889,27 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 			},
 			expectedFinalizers: []string{"resources-finalizer.argocd.argoproj.io/background"},
 		},
+		{
+			testName:                      "preserveApplicationFinalizers false without sync policy should not have a finalizer",
+			existingFinalizers:            nil,
+			syncPolicy:                    nil,
+			preserveApplicationFinalizers: ptr.To(false),
+			expectedFinalizers:            nil,
+		},
+		{
+			testName:                      "preserveApplicationFinalizers true should use standard finalizer",
+			existingFinalizers:            nil,
+			syncPolicy:                    &argoappsv1.ApplicationSetSyncPolicy{},
+			preserveApplicationFinalizers: ptr.To(true),
+			expectedFinalizers:            []string{"resources-finalizer.argocd.argoproj.io"},
+		},
+		{
+			testName:                      "user-specified finalizer should overwrite preserveApplicationFinalizers",
+			existingFinalizers:            []string{"existing-finalizer"},
+			syncPolicy:                    nil,
+			preserveApplicationFinalizers: ptr.To(false),
+			expectedFinalizers:            []string{"existing-finalizer"},
+		},
 	} {
 		t.Run(c.testName, func(t *testing.T) {
 			// Clone the template application
@@ -811,7 +923,13 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 			// Render the cloned application, into a new application
 			render := Render{}
 
-			res, err := render.RenderTemplateParams(application, c.syncPolicy, params, true, nil)
+			appSet := &argoappsv1.ApplicationSet{
+				Spec: argoappsv1.ApplicationSetSpec{
+					SyncPolicy:                    c.syncPolicy,
+					PreserveApplicationFinalizers: c.preserveApplicationFinalizers,
+				},
+			}
+			res, err := render.RenderTemplateParams(application, appSet, params, true, nil)
 			require.NoError(t, err)
 
 			assert.ElementsMatch(t, res.Finalizers, c.expectedFinalizers)
@@ -819,6 +937,188 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 	}
 }
 
+func TestRenderTemplateParamsTemplatedFinalizers(t *testing.T) {
+	for _, c := range []struct {
+		testName           string
+		finalizers         []string
+		params             map[string]any
+		expectedFinalizers []string
+		expectedError      string
+	}{
+		{
+			testName:           "finalizer is rendered",
+			finalizers:         []string{"resources-finalizer.argocd.argoproj.io{{ .deletion }}"},
+			params:             map[string]any{"deletion": "/background"},
+			expectedFinalizers: []string{"resources-finalizer.argocd.argoproj.io/background"},
+		},
+		{
+			testName:           "finalizer rendered to an empty string is dropped",
+			finalizers:         []string{"existing-finalizer", "{{ if .ephemeral }}resources-finalizer.argocd.argoproj.io/background{{ end }}"},
+			params:             map[string]any{"ephemeral": false},
+			expectedFinalizers: []string{"existing-finalizer"},
+		},
+		{
+			testName:           "conditional finalizer is kept",
+			finalizers:         []string{"existing-finalizer", "{{ if .ephemeral }}resources-finalizer.argocd.argoproj.io/background{{ end }}"},
+			params:             map[string]any{"ephemeral": true},
+			expectedFinalizers: []string{"existing-finalizer", "resources-finalizer.argocd.argoproj.io/background"},
+		},
+		{
+			testName:           "all finalizers rendered to an empty string do not add the resources finalizer",
+			finalizers:         []string{"{{ if .ephemeral }}resources-finalizer.argocd.argoproj.io/background{{ end }}"},
+			params:             map[string]any{"ephemeral": false},
+			expectedFinalizers: []string{},
+		},
+		{
+			testName:           "duplicated finalizers are dropped",
+			finalizers:         []string{"resources-finalizer.argocd.argoproj.io", "resources-finalizer.argocd.argoproj.io{{ .deletion }}"},
+			params:             map[string]any{"deletion": ""},
+			expectedFinalizers: []string{"resources-finalizer.argocd.argoproj.io"},
+		},
+		{
+			testName:      "invalid finalizer is rejected",
+			finalizers:    []string{"resources-finalizer.argocd.argoproj.io/{{ .deletion }}"},
+			params:        map[string]any{"deletion": "in background"},
+			expectedError: `invalid finalizer "resources-finalizer.argocd.argoproj.io/in background"`,
+		},
+	} {
+		t.Run(c.testName, func(t *testing.T) {
+			application := &argoappsv1.Application{
+				ObjectMeta: metav1.ObjectMeta{Finalizers: c.finalizers},
+			}
+
+			render := Render{}
+			res, err := render.RenderTemplateParams(application, &argoappsv1.ApplicationSet{}, c.params, true, nil)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expectedFinalizers, res.Finalizers)
+		})
+	}
+}
+
+func TestRenderTemplateParamsDump(t *testing.T) {
+	application := &argoappsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "{{ .name }}",
+			Annotations: map[string]string{
+				"params": "{{ dump . }}",
+			},
+		},
+	}
+	params := map[string]any{
+		"name": "guestbook",
+		"cluster": map[string]any{
+			"server": "https://kubernetes.default.svc",
+		},
+	}
+
+	for _, c := range []struct {
+		testName      string
+		annotations   map[string]string
+		expectedDump  string
+		expectedError string
+	}{
+		{
+			testName:      "dump is rejected without the debug annotation",
+			expectedError: `the dump function is only available when the argocd.argoproj.io/application-set-debug annotation of the ApplicationSet is set to "true"`,
+		},
+		{
+			testName:      "dump is rejected when the debug annotation is not true",
+			annotations:   map[string]string{"argocd.argoproj.io/application-set-debug": "false"},
+			expectedError: `the dump function is only available`,
+		},
+		{
+			testName:    "dump renders the parameters with the debug annotation",
+			annotations: map[string]string{"argocd.argoproj.io/application-set-debug": "true"},
+			expectedDump: `{
+  "cluster": {
+    "server": "https://kubernetes.default.svc"
+  },
+  "name": "guestbook"
+}`,
+		},
+	} {
+		t.Run(c.testName, func(t *testing.T) {
+			appSet := &argoappsv1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Annotations: c.annotations},
+			}
+
+			render := Render{}
+			res, err := render.RenderTemplateParams(application, appSet, params, true, nil)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "guestbook", res.Name)
+			assert.Equal(t, c.expectedDump, res.Annotations["params"])
+		})
+	}
+}
+
+func TestCheckTemplateSyntax(t *testing.T) {
+	appSet := &argoappsv1.ApplicationSet{
+		Spec: argoappsv1.ApplicationSetSpec{
+			GoTemplate: true,
+			Template: argoappsv1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: argoappsv1.ApplicationSetTemplateMeta{
+					Name:   "{{ .name | normalize }}",
+					Labels: map[string]string{"{{ .labelKey }}": "{{ .labelValue | dump }}"},
+				},
+			},
+		},
+	}
+	require.NoError(t, CheckTemplateSyntax(appSet, nil))
+
+	appSet.Spec.Template.Labels = map[string]string{"{{ .labelKey": "value"}
+	appSet.Spec.TemplatePatch = ptr.To("{{ unknownFunction . }}")
+	err := CheckTemplateSyntax(appSet, nil)
+	require.ErrorContains(t, err, "failed to parse template {{ .labelKey: template: :1: unclosed action")
+	require.ErrorContains(t, err, `failed to parse template {{ unknownFunction . }}: template: :1: function "unknownFunction" not defined`)
+
+	// The functions registered for the ApplicationSet are known
+	appSet.Spec.Template.Labels = nil
+	require.NoError(t, CheckTemplateSyntax(appSet, template.FuncMap{"unknownFunction": func(any) string { return "" }}))
+
+	appSet.Spec.GoTemplateOptions = []string{"missingkey=error", "missingkey=fail"}
+	require.ErrorContains(t, CheckTemplateSyntax(appSet, nil), `invalid Go template option "missingkey=fail"`)
+
+	appSet.Spec.GoTemplate = false
+	require.NoError(t, CheckTemplateSyntax(appSet, nil))
+}
+
+func TestCheckPermittedGenerators(t *testing.T) {
+	appSet := &argoappsv1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app-set"},
+		Spec: argoappsv1.ApplicationSetSpec{
+			Generators: []argoappsv1.ApplicationSetGenerator{
+				{Git: &argoappsv1.GitGenerator{}},
+				{
+					Matrix: &argoappsv1.MatrixGenerator{
+						Generators: []argoappsv1.ApplicationSetNestedGenerator{
+							{Plugin: &argoappsv1.PluginGenerator{}},
+							{SCMProvider: &argoappsv1.SCMProviderGenerator{}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	project := &argoappsv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "tenant"}}
+	require.NoError(t, CheckPermittedGenerators(appSet, project))
+
+	project.Spec.ApplicationSetGeneratorBlacklist = []string{"plugin", "scmProvider"}
+	require.EqualError(t, CheckPermittedGenerators(appSet, project), "ApplicationSet test-app-set contains generators which are not permitted in project tenant: plugin, scmProvider")
+
+	project.Spec.ApplicationSetGeneratorBlacklist = nil
+	project.Spec.ApplicationSetGeneratorWhitelist = []string{"git", "matrix", "plugin"}
+	require.EqualError(t, CheckPermittedGenerators(appSet, project), "ApplicationSet test-app-set contains generators which are not permitted in project tenant: scmProvider")
+}
+
 func TestCheckInvalidGenerators(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := argoappsv1.AddToScheme(scheme)
@@ -1259,6 +1559,76 @@ func TestSlugify(t *testing.T) {
 	}
 }
 
+func TestFuncMap(t *testing.T) {
+	funcMap := FuncMap()
+	for _, name := range []string{"ternary", "default", "upper", "normalize", "slugify", "toYaml", "cidrhost", "dump"} {
+		assert.Contains(t, funcMap, name)
+	}
+	for _, name := range []string{"env", "expandenv", "getHostByName"} {
+		assert.NotContains(t, funcMap, name)
+	}
+
+	tmpl, err := template.New("").Funcs(funcMap).Parse(`{{ normalize .name }}/{{ slugify 10 false .branch }}/{{ ternary "prod" "dev" .prod }}`)
+	require.NoError(t, err)
+	out := &strings.Builder{}
+	require.NoError(t, tmpl.Execute(out, map[string]any{"name": "My_App", "branch": "feat/some-branch", "prod": true}))
+	assert.Equal(t, "my-app/feat-some/prod", out.String())
+
+	delete(funcMap, "normalize")
+	assert.Contains(t, FuncMap(), "normalize")
+}
+
+type fakeFunctionProvider struct {
+	calls     int
+	functions map[string]template.FuncMap
+}
+
+func (p *fakeFunctionProvider) Functions(_ context.Context, namespace string, _ string) (template.FuncMap, error) {
+	p.calls++
+	return p.functions[namespace], nil
+}
+
+func TestForApplicationSet(t *testing.T) {
+	provider := &fakeFunctionProvider{functions: map[string]template.FuncMap{
+		"team-a": {"teamOf": func(cluster string) string { return "team-a-" + cluster }},
+		"team-b": {"upper": func(s string) string { return s }},
+	}}
+	tmpl := &argoappsv1.Application{ObjectMeta: metav1.ObjectMeta{Name: `{{ teamOf .cluster | upper }}`}}
+	params := map[string]any{"cluster": "dev"}
+	newAppSet := func(namespace string) *argoappsv1.ApplicationSet {
+		return &argoappsv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: namespace}}
+	}
+
+	t.Run("the provided functions are available to the ApplicationSets", func(t *testing.T) {
+		renderer, err := ForApplicationSet(t.Context(), WithTemplateCache(NewRender(provider)), newAppSet("team-a"))
+		require.NoError(t, err)
+		provider.calls = 0
+		for range 2 {
+			app, err := renderer.RenderTemplateParams(tmpl, newAppSet("team-a"), params, true, nil)
+			require.NoError(t, err)
+			assert.Equal(t, "TEAM-A-DEV", app.Name)
+		}
+		assert.Zero(t, provider.calls, "the renderer is already bound to the ApplicationSet")
+	})
+
+	t.Run("the provided functions are scoped to the ApplicationSets", func(t *testing.T) {
+		_, err := NewRender(provider).RenderTemplateParams(tmpl, newAppSet("other"), params, true, nil)
+		require.ErrorContains(t, err, `function "teamOf" not defined`)
+	})
+
+	t.Run("the provided functions cannot override built-in ones", func(t *testing.T) {
+		_, err := ForApplicationSet(t.Context(), NewRender(provider), newAppSet("team-b"))
+		require.EqualError(t, err, `template function "upper" cannot override a built-in template function`)
+	})
+
+	t.Run("renderers without provider", func(t *testing.T) {
+		renderer := &Render{}
+		bound, err := ForApplicationSet(t.Context(), renderer, newAppSet("team-a"))
+		require.NoError(t, err)
+		assert.Same(t, renderer, bound)
+	})
+}
+
 func TestGetTLSConfig(t *testing.T) {
 	temppath := t.TempDir()
 	certFromFile := `
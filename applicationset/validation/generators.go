@@ -0,0 +1,344 @@
+// Package validation validates the generators of the ApplicationSets, both on admission and on reconciliation, so that
+// misconfigured generators are reported with the path of the faulty field rather than as a failure to generate the
+// parameters.
+package validation
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ValidateGenerators validates the generators of the ApplicationSet. It checks the required fields, the mutually
+// exclusive options, the regular expressions and the label selectors of each generator, including the child generators
+// of the Matrix and Merge generators. Fields holding a template are skipped, as their value is only known once the
+// parameters of a parent generator are rendered into them.
+func ValidateGenerators(appSet *argov1alpha1.ApplicationSet) field.ErrorList {
+	allErrs := field.ErrorList{}
+	basePath := field.NewPath("spec", "generators")
+	for i := range appSet.Spec.Generators {
+		generator := appSet.Spec.Generators[i]
+		path := basePath.Index(i)
+		allErrs = append(allErrs, validateGenerator(&argov1alpha1.ApplicationSetNestedGenerator{
+			List:                    generator.List,
+			Clusters:                generator.Clusters,
+			Git:                     generator.Git,
+			SCMProvider:             generator.SCMProvider,
+			ClusterDecisionResource: generator.ClusterDecisionResource,
+			PullRequest:             generator.PullRequest,
+			Selector:                generator.Selector,
+			Plugin:                  generator.Plugin,
+			OCI:                     generator.OCI,
+			AWSAccounts:             generator.AWSAccounts,
+			TerraformState:          generator.TerraformState,
+		}, path)...)
+		if generator.Matrix != nil {
+			allErrs = append(allErrs, validateMatrix(generator.Matrix, path.Child("matrix"))...)
+		}
+		if generator.Merge != nil {
+			allErrs = append(allErrs, validateMerge(generator.Merge, path.Child("merge"))...)
+		}
+	}
+	return allErrs
+}
+
+// validateGenerator validates the generators set in the given generator, but not the Matrix and Merge generators,
+// which are stored as JSON in the child generators.
+func validateGenerator(generator *argov1alpha1.ApplicationSetNestedGenerator, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if generator.Selector != nil {
+		// The selector filters the parameters, whose values are not restricted to the syntax of the label values.
+		if _, err := utils.LabelSelectorAsSelector(generator.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("selector"), field.OmitValueType{}, err.Error()))
+		}
+	}
+	if generator.Clusters != nil {
+		allErrs = append(allErrs, validateLabelSelector(&generator.Clusters.Selector, path.Child("clusters", "selector"))...)
+	}
+	if generator.Git != nil {
+		allErrs = append(allErrs, validateGit(generator.Git, path.Child("git"))...)
+	}
+	if generator.SCMProvider != nil {
+		allErrs = append(allErrs, validateSCMProvider(generator.SCMProvider, path.Child("scmProvider"))...)
+	}
+	if generator.ClusterDecisionResource != nil {
+		cdrPath := path.Child("clusterDecisionResource")
+		if generator.ClusterDecisionResource.ConfigMapRef == "" {
+			allErrs = append(allErrs, field.Required(cdrPath.Child("configMapRef"), ""))
+		}
+		allErrs = append(allErrs, validateLabelSelector(&generator.ClusterDecisionResource.LabelSelector, cdrPath.Child("labelSelector"))...)
+	}
+	if generator.PullRequest != nil {
+		allErrs = append(allErrs, validatePullRequest(generator.PullRequest, path.Child("pullRequest"))...)
+	}
+	if generator.Plugin != nil && generator.Plugin.ConfigMapRef.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("plugin", "configMapRef", "name"), ""))
+	}
+	if generator.OCI != nil {
+		ociPath := path.Child("oci")
+		if generator.OCI.RepoURL == "" {
+			allErrs = append(allErrs, field.Required(ociPath.Child("repoURL"), ""))
+		}
+		allErrs = append(allErrs, validateRegexp(generator.OCI.TagFilter, ociPath.Child("tagFilter"))...)
+	}
+	if generator.TerraformState != nil {
+		allErrs = append(allErrs, validateTerraformState(generator.TerraformState, path.Child("terraformState"))...)
+	}
+	return allErrs
+}
+
+func validateGit(git *argov1alpha1.GitGenerator, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if git.RepoURL == "" {
+		allErrs = append(allErrs, field.Required(path.Child("repoURL"), ""))
+	}
+	switch {
+	case len(git.Directories) == 0 && len(git.Files) == 0:
+		allErrs = append(allErrs, field.Required(path, "one of directories or files must be set"))
+	case len(git.Directories) != 0 && len(git.Files) != 0:
+		allErrs = append(allErrs, field.Forbidden(path.Child("files"), "directories and files are mutually exclusive"))
+	}
+	for i, directory := range git.Directories {
+		if directory.Path == "" {
+			allErrs = append(allErrs, field.Required(path.Child("directories").Index(i).Child("path"), ""))
+		}
+	}
+	for i, file := range git.Files {
+		if file.Path == "" {
+			allErrs = append(allErrs, field.Required(path.Child("files").Index(i).Child("path"), ""))
+		}
+	}
+	return allErrs
+}
+
+func validateSCMProvider(scmProvider *argov1alpha1.SCMProviderGenerator, path *field.Path) field.ErrorList {
+	allErrs := validateProviders(path, map[string]bool{
+		"github":          scmProvider.Github != nil,
+		"gitlab":          scmProvider.Gitlab != nil,
+		"bitbucket":       scmProvider.Bitbucket != nil,
+		"bitbucketServer": scmProvider.BitbucketServer != nil,
+		"gitea":           scmProvider.Gitea != nil,
+		"azureDevOps":     scmProvider.AzureDevOps != nil,
+		"awsCodeCommit":   scmProvider.AWSCodeCommit != nil,
+	})
+	for i, filter := range scmProvider.Filters {
+		filterPath := path.Child("filters").Index(i)
+		allErrs = append(allErrs, validateRegexpPtr(filter.RepositoryMatch, filterPath.Child("repositoryMatch"))...)
+		allErrs = append(allErrs, validateRegexpPtr(filter.LabelMatch, filterPath.Child("labelMatch"))...)
+		allErrs = append(allErrs, validateRegexpPtr(filter.BranchMatch, filterPath.Child("branchMatch"))...)
+	}
+	return allErrs
+}
+
+func validatePullRequest(pullRequest *argov1alpha1.PullRequestGenerator, path *field.Path) field.ErrorList {
+	allErrs := validateProviders(path, map[string]bool{
+		"github":          pullRequest.Github != nil,
+		"gitlab":          pullRequest.GitLab != nil,
+		"gitea":           pullRequest.Gitea != nil,
+		"bitbucketServer": pullRequest.BitbucketServer != nil,
+		"bitbucket":       pullRequest.Bitbucket != nil,
+		"azuredevops":     pullRequest.AzureDevOps != nil,
+	})
+	for i, filter := range pullRequest.Filters {
+		filterPath := path.Child("filters").Index(i)
+		allErrs = append(allErrs, validateRegexpPtr(filter.BranchMatch, filterPath.Child("branchMatch"))...)
+		allErrs = append(allErrs, validateRegexpPtr(filter.TargetBranchMatch, filterPath.Child("targetBranchMatch"))...)
+	}
+	return allErrs
+}
+
+// validateProviders returns an error unless exactly one of the given providers, indexed by the name of their field, is
+// set.
+func validateProviders(path *field.Path, providers map[string]bool) field.ErrorList {
+	var set []string
+	for name, isSet := range providers {
+		if isSet {
+			set = append(set, name)
+		}
+	}
+	switch len(set) {
+	case 0:
+		return field.ErrorList{field.Required(path, "a provider must be set")}
+	case 1:
+		return nil
+	default:
+		slices.Sort(set)
+		return field.ErrorList{field.Forbidden(path, "only one provider may be set, found "+strings.Join(set, ", "))}
+	}
+}
+
+func validateTerraformState(terraformState *argov1alpha1.TerraformStateGenerator, path *field.Path) field.ErrorList {
+	allErrs := validateProviders(path, map[string]bool{
+		"s3":    terraformState.S3 != nil,
+		"gcs":   terraformState.GCS != nil,
+		"http":  terraformState.HTTP != nil,
+		"cloud": terraformState.Cloud != nil,
+	})
+	if s3 := terraformState.S3; s3 != nil {
+		if s3.Bucket == "" {
+			allErrs = append(allErrs, field.Required(path.Child("s3", "bucket"), ""))
+		}
+		if s3.Key == "" {
+			allErrs = append(allErrs, field.Required(path.Child("s3", "key"), ""))
+		}
+		if (s3.AccessKeyIDRef == nil) != (s3.SecretAccessKeyRef == nil) {
+			allErrs = append(allErrs, field.Required(path.Child("s3"), "accessKeyIDRef and secretAccessKeyRef must be set together"))
+		}
+	}
+	if gcs := terraformState.GCS; gcs != nil {
+		if gcs.Bucket == "" {
+			allErrs = append(allErrs, field.Required(path.Child("gcs", "bucket"), ""))
+		}
+		if gcs.Object == "" {
+			allErrs = append(allErrs, field.Required(path.Child("gcs", "object"), ""))
+		}
+	}
+	if http := terraformState.HTTP; http != nil {
+		if http.URL == "" {
+			allErrs = append(allErrs, field.Required(path.Child("http", "url"), ""))
+		}
+		if (http.UsernameRef == nil) != (http.PasswordRef == nil) {
+			allErrs = append(allErrs, field.Required(path.Child("http"), "usernameRef and passwordRef must be set together"))
+		}
+	}
+	if cloud := terraformState.Cloud; cloud != nil {
+		if cloud.Organization == "" {
+			allErrs = append(allErrs, field.Required(path.Child("cloud", "organization"), ""))
+		}
+		if cloud.Workspace == "" {
+			allErrs = append(allErrs, field.Required(path.Child("cloud", "workspace"), ""))
+		}
+		if cloud.TokenRef == nil {
+			allErrs = append(allErrs, field.Required(path.Child("cloud", "tokenRef"), ""))
+		}
+	}
+	return allErrs
+}
+
+func validateMatrix(matrix *argov1alpha1.MatrixGenerator, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(matrix.Generators) != 2 {
+		allErrs = append(allErrs, field.Invalid(path.Child("generators"), len(matrix.Generators), "a Matrix generator requires exactly two child generators"))
+	}
+	return append(allErrs, validateChildGenerators(matrix.Generators, path.Child("generators"))...)
+}
+
+func validateMerge(merge *argov1alpha1.MergeGenerator, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(merge.Generators) < 2 {
+		allErrs = append(allErrs, field.Invalid(path.Child("generators"), len(merge.Generators), "a Merge generator requires two or more child generators"))
+	}
+	if len(merge.MergeKeys) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("mergeKeys"), "a Merge generator requires at least one merge key"))
+	}
+	return append(allErrs, validateChildGenerators(merge.Generators, path.Child("generators"))...)
+}
+
+// validateChildGenerators validates the child generators of a Matrix or Merge generator, each of them being made of
+// exactly one generator.
+func validateChildGenerators(generators []argov1alpha1.ApplicationSetNestedGenerator, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i := range generators {
+		generator := &generators[i]
+		childPath := path.Index(i)
+		if count := countGenerators(generator); count != 1 {
+			allErrs = append(allErrs, field.Invalid(childPath, count, "a child generator requires exactly one generator"))
+		}
+		allErrs = append(allErrs, validateGenerator(generator, childPath)...)
+		if generator.Matrix != nil {
+			matrix, err := argov1alpha1.ToNestedMatrixGenerator(generator.Matrix)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(childPath.Child("matrix"), string(generator.Matrix.Raw), err.Error()))
+			} else {
+				allErrs = append(allErrs, validateMatrix(matrix.ToMatrixGenerator(), childPath.Child("matrix"))...)
+			}
+		}
+		if generator.Merge != nil {
+			merge, err := argov1alpha1.ToNestedMergeGenerator(generator.Merge)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(childPath.Child("merge"), string(generator.Merge.Raw), err.Error()))
+			} else {
+				allErrs = append(allErrs, validateMerge(merge.ToMergeGenerator(), childPath.Child("merge"))...)
+			}
+		}
+	}
+	return allErrs
+}
+
+// countGenerators returns the number of generators set in the given child generator. The selector filters the
+// parameters of the generator and is not counted.
+func countGenerators(generator *argov1alpha1.ApplicationSetNestedGenerator) int {
+	count := 0
+	for _, isSet := range []bool{
+		generator.List != nil,
+		generator.Clusters != nil,
+		generator.Git != nil,
+		generator.SCMProvider != nil,
+		generator.ClusterDecisionResource != nil,
+		generator.PullRequest != nil,
+		generator.Matrix != nil,
+		generator.Merge != nil,
+		generator.Plugin != nil,
+		generator.OCI != nil,
+		generator.AWSAccounts != nil,
+		generator.TerraformState != nil,
+	} {
+		if isSet {
+			count++
+		}
+	}
+	return count
+}
+
+// validateLabelSelector validates a label selector matching Kubernetes resources.
+func validateLabelSelector(selector *metav1.LabelSelector, path *field.Path) field.ErrorList {
+	if isTemplatedLabelSelector(selector) {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+		return field.ErrorList{field.Invalid(path, field.OmitValueType{}, err.Error())}
+	}
+	return nil
+}
+
+func isTemplatedLabelSelector(selector *metav1.LabelSelector) bool {
+	for key, value := range selector.MatchLabels {
+		if isTemplated(key) || isTemplated(value) {
+			return true
+		}
+	}
+	for _, expr := range selector.MatchExpressions {
+		if isTemplated(expr.Key) || slices.ContainsFunc(expr.Values, isTemplated) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateRegexpPtr(expr *string, path *field.Path) field.ErrorList {
+	if expr == nil {
+		return nil
+	}
+	return validateRegexp(*expr, path)
+}
+
+func validateRegexp(expr string, path *field.Path) field.ErrorList {
+	if expr == "" || isTemplated(expr) {
+		return nil
+	}
+	if _, err := regexp.Compile(expr); err != nil {
+		return field.ErrorList{field.Invalid(path, expr, err.Error())}
+	}
+	return nil
+}
+
+// isTemplated returns whether the value holds a template, rendered with the parameters of a parent generator.
+func isTemplated(value string) bool {
+	return strings.Contains(value, "{{")
+}
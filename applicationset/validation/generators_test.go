@@ -0,0 +1,172 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestValidateGenerators(t *testing.T) {
+	list := &argov1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "dev"}`)}}}
+	git := &argov1alpha1.GitGenerator{
+		RepoURL:     "https://github.com/argoproj/argocd-example-apps",
+		Directories: []argov1alpha1.GitDirectoryGeneratorItem{{Path: "*"}},
+	}
+
+	for _, c := range []struct {
+		name           string
+		generators     []argov1alpha1.ApplicationSetGenerator
+		expectedErrors []string
+	}{
+		{
+			name: "valid generators",
+			generators: []argov1alpha1.ApplicationSetGenerator{
+				{List: list, Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"cluster": "dev"}}},
+				{Git: git},
+				{Clusters: &argov1alpha1.ClusterGenerator{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}}},
+				{SCMProvider: &argov1alpha1.SCMProviderGenerator{
+					Github:  &argov1alpha1.SCMProviderGeneratorGithub{Organization: "argoproj"},
+					Filters: []argov1alpha1.SCMProviderGeneratorFilter{{RepositoryMatch: ptr.To("^argo-.*")}},
+				}},
+				{PullRequest: &argov1alpha1.PullRequestGenerator{
+					Github:  &argov1alpha1.PullRequestGeneratorGithub{Owner: "argoproj", Repo: "argo-cd"},
+					Filters: []argov1alpha1.PullRequestGeneratorFilter{{BranchMatch: ptr.To("^feature-")}},
+				}},
+				{Plugin: &argov1alpha1.PluginGenerator{ConfigMapRef: argov1alpha1.PluginConfigMapRef{Name: "plugin"}}},
+				{OCI: &argov1alpha1.OCIGenerator{RepoURL: "oci://ghcr.io/argoproj/charts", TagFilter: "^v1\\."}},
+				{TerraformState: &argov1alpha1.TerraformStateGenerator{S3: &argov1alpha1.TerraformStateS3{Bucket: "states", Key: "network/terraform.tfstate"}}},
+			},
+		},
+		{
+			name: "missing required fields",
+			generators: []argov1alpha1.ApplicationSetGenerator{
+				{Git: &argov1alpha1.GitGenerator{Files: []argov1alpha1.GitFileGeneratorItem{{}}}},
+				{Plugin: &argov1alpha1.PluginGenerator{}},
+				{OCI: &argov1alpha1.OCIGenerator{}},
+				{ClusterDecisionResource: &argov1alpha1.DuckTypeGenerator{}},
+				{TerraformState: &argov1alpha1.TerraformStateGenerator{Cloud: &argov1alpha1.TerraformStateCloud{Organization: "argoproj"}}},
+			},
+			expectedErrors: []string{
+				"spec.generators[0].git.repoURL: Required value",
+				"spec.generators[0].git.files[0].path: Required value",
+				"spec.generators[1].plugin.configMapRef.name: Required value",
+				"spec.generators[2].oci.repoURL: Required value",
+				"spec.generators[3].clusterDecisionResource.configMapRef: Required value",
+				"spec.generators[4].terraformState.cloud.workspace: Required value",
+				"spec.generators[4].terraformState.cloud.tokenRef: Required value",
+			},
+		},
+		{
+			name: "mutually exclusive options",
+			generators: []argov1alpha1.ApplicationSetGenerator{
+				{Git: &argov1alpha1.GitGenerator{
+					RepoURL:     git.RepoURL,
+					Directories: git.Directories,
+					Files:       []argov1alpha1.GitFileGeneratorItem{{Path: "config.json"}},
+				}},
+				{Git: &argov1alpha1.GitGenerator{RepoURL: git.RepoURL}},
+				{SCMProvider: &argov1alpha1.SCMProviderGenerator{
+					Github: &argov1alpha1.SCMProviderGeneratorGithub{Organization: "argoproj"},
+					Gitlab: &argov1alpha1.SCMProviderGeneratorGitlab{Group: "argoproj"},
+				}},
+				{PullRequest: &argov1alpha1.PullRequestGenerator{}},
+				{TerraformState: &argov1alpha1.TerraformStateGenerator{
+					GCS:  &argov1alpha1.TerraformStateGCS{Bucket: "states", Object: "network/default.tfstate"},
+					HTTP: &argov1alpha1.TerraformStateHTTP{URL: "https://states.example.com/network", UsernameRef: &argov1alpha1.SecretRef{SecretName: "state", Key: "username"}},
+				}},
+			},
+			expectedErrors: []string{
+				"spec.generators[0].git.files: Forbidden: directories and files are mutually exclusive",
+				"spec.generators[1].git: Required value: one of directories or files must be set",
+				"spec.generators[2].scmProvider: Forbidden: only one provider may be set, found github, gitlab",
+				"spec.generators[3].pullRequest: Required value: a provider must be set",
+				"spec.generators[4].terraformState: Forbidden: only one provider may be set, found gcs, http",
+				"spec.generators[4].terraformState.http: Required value: usernameRef and passwordRef must be set together",
+			},
+		},
+		{
+			name: "invalid regular expressions",
+			generators: []argov1alpha1.ApplicationSetGenerator{
+				{SCMProvider: &argov1alpha1.SCMProviderGenerator{
+					Github:  &argov1alpha1.SCMProviderGeneratorGithub{Organization: "argoproj"},
+					Filters: []argov1alpha1.SCMProviderGeneratorFilter{{}, {BranchMatch: ptr.To("(main")}},
+				}},
+				{PullRequest: &argov1alpha1.PullRequestGenerator{
+					Github:  &argov1alpha1.PullRequestGeneratorGithub{Owner: "argoproj", Repo: "argo-cd"},
+					Filters: []argov1alpha1.PullRequestGeneratorFilter{{TargetBranchMatch: ptr.To("[main")}},
+				}},
+				{OCI: &argov1alpha1.OCIGenerator{RepoURL: "oci://ghcr.io/argoproj/charts", TagFilter: "v1("}},
+			},
+			expectedErrors: []string{
+				"spec.generators[0].scmProvider.filters[1].branchMatch: Invalid value: \"(main\": error parsing regexp: missing closing ): `(main`",
+				"spec.generators[1].pullRequest.filters[0].targetBranchMatch: Invalid value: \"[main\"",
+				"spec.generators[2].oci.tagFilter: Invalid value: \"v1(\"",
+			},
+		},
+		{
+			name: "invalid label selectors",
+			generators: []argov1alpha1.ApplicationSetGenerator{
+				{List: list, Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "cluster", Operator: "Equals"}}}},
+				{Clusters: &argov1alpha1.ClusterGenerator{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "not a label value"}}}},
+			},
+			expectedErrors: []string{
+				"spec.generators[0].selector: Invalid value: \"Equals\" is not a valid pod selector operator",
+				"spec.generators[1].clusters.selector: Invalid value: values[0][env]: Invalid value: \"not a label value\"",
+			},
+		},
+		{
+			name: "templated fields are not checked",
+			generators: []argov1alpha1.ApplicationSetGenerator{{Matrix: &argov1alpha1.MatrixGenerator{
+				Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+					{List: list},
+					{Clusters: &argov1alpha1.ClusterGenerator{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "{{.cluster}}"}}}},
+				},
+			}}},
+		},
+		{
+			name: "invalid Matrix and Merge generators",
+			generators: []argov1alpha1.ApplicationSetGenerator{
+				{Matrix: &argov1alpha1.MatrixGenerator{
+					Generators: []argov1alpha1.ApplicationSetNestedGenerator{{List: list, Git: git}},
+				}},
+				{Merge: &argov1alpha1.MergeGenerator{
+					Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+						{List: list},
+						{Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [{"git": {"directories": [{"path": "*"}]}}, {"list": {"elements": []}}]}`)}},
+					},
+				}},
+				{Matrix: &argov1alpha1.MatrixGenerator{
+					Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+						{List: list},
+						{Merge: &apiextensionsv1.JSON{Raw: []byte(`{"generators": "list"}`)}},
+					},
+				}},
+			},
+			expectedErrors: []string{
+				"spec.generators[0].matrix.generators: Invalid value: 1: a Matrix generator requires exactly two child generators",
+				"spec.generators[0].matrix.generators[0]: Invalid value: 2: a child generator requires exactly one generator",
+				"spec.generators[1].merge.mergeKeys: Required value",
+				"spec.generators[1].merge.generators[1].matrix.generators[0].git.repoURL: Required value",
+				"spec.generators[2].matrix.generators[1].merge: Invalid value",
+			},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := &argov1alpha1.ApplicationSet{Spec: argov1alpha1.ApplicationSetSpec{Generators: c.generators}}
+			errs := ValidateGenerators(appSet)
+			if len(c.expectedErrors) == 0 {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Len(t, errs, len(c.expectedErrors))
+			for _, expectedError := range c.expectedErrors {
+				assert.ErrorContains(t, errs.ToAggregate(), expectedError)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"html"
 	"net/http"
@@ -15,13 +16,18 @@ import (
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	appsetcache "github.com/argoproj/argo-cd/v3/applicationset/cache"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	argosettings "github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/webhook"
 
 	"github.com/go-playground/webhooks/v6/azuredevops"
+	"github.com/go-playground/webhooks/v6/bitbucket"
+	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
 	"github.com/go-playground/webhooks/v6/github"
 	"github.com/go-playground/webhooks/v6/gitlab"
 	log "github.com/sirupsen/logrus"
@@ -29,21 +35,66 @@ import (
 
 const payloadQueueSize = 50000
 
+const (
+	providerGitHub          = "github"
+	providerGitLab          = "gitlab"
+	providerAzureDevOps     = "azuredevops"
+	providerBitbucket       = "bitbucket"
+	providerBitbucketServer = "bitbucketserver"
+	providerUnknown         = "unknown"
+
+	webhookEventAccepted = "accepted"
+	webhookEventRejected = "rejected"
+)
+
 type WebhookHandler struct {
-	sync.WaitGroup // for testing
-	namespace      string
-	github         *github.Webhook
-	gitlab         *gitlab.Webhook
-	azuredevops    *azuredevops.Webhook
-	client         client.Client
-	generators     map[string]generators.Generator
+	sync.WaitGroup  // for testing
+	namespace       string
+	github          *github.Webhook
+	gitlab          *gitlab.Webhook
+	azuredevops     *azuredevops.Webhook
+	bitbucket       *bitbucket.Webhook
+	bitbucketserver *bitbucketserver.Webhook
+	client          client.Client
+	generators      map[string]generators.Generator
+	// generatorCache holds the parameters of the generators, which are invalidated for the refreshed ApplicationSets.
+	// It is nil when the generator cache is disabled.
+	generatorCache *appsetcache.Cache
 	queue          chan any
+	// configuredProviders holds the providers for which a secret is set in argocd-secret
+	configuredProviders map[string]bool
+	// requireAuthentication rejects payloads from providers without a configured secret
+	requireAuthentication bool
+	metrics               *appsetmetrics.ApplicationsetMetrics
 }
 
 type gitGeneratorInfo struct {
 	Revision    string
 	TouchedHead bool
 	RepoRegexp  *regexp.Regexp
+	// SCMProvider identifies the organization of the pushed repository, for the SCM Provider generators
+	SCMProvider *scmProviderGeneratorInfo
+}
+
+type scmProviderGeneratorInfo struct {
+	Github    *scmProviderGeneratorGithubInfo
+	Gitlab    *scmProviderGeneratorGitlabInfo
+	Bitbucket *scmProviderGeneratorBitbucketInfo
+}
+
+type scmProviderGeneratorGithubInfo struct {
+	Organization string
+	APIHostname  string
+}
+
+type scmProviderGeneratorGitlabInfo struct {
+	// Group is the full path of the group of the project
+	Group       string
+	APIHostname string
+}
+
+type scmProviderGeneratorBitbucketInfo struct {
+	Owner string
 }
 
 type prGeneratorInfo struct {
@@ -68,7 +119,7 @@ type prGeneratorGitlabInfo struct {
 	APIHostname string
 }
 
-func NewWebhookHandler(namespace string, webhookParallelism int, argocdSettingsMgr *argosettings.SettingsManager, client client.Client, generators map[string]generators.Generator) (*WebhookHandler, error) {
+func NewWebhookHandler(namespace string, webhookParallelism int, argocdSettingsMgr *argosettings.SettingsManager, client client.Client, generators map[string]generators.Generator, generatorCache *appsetcache.Cache, requireAuthentication bool, metrics *appsetmetrics.ApplicationsetMetrics) (*WebhookHandler, error) {
 	// register the webhook secrets stored under "argocd-secret" for verifying incoming payloads
 	argocdSettings, err := argocdSettingsMgr.GetSettings()
 	if err != nil {
@@ -86,15 +137,35 @@ func NewWebhookHandler(namespace string, webhookParallelism int, argocdSettingsM
 	if err != nil {
 		return nil, fmt.Errorf("unable to init Azure DevOps webhook: %w", err)
 	}
+	bitbucketHandler, err := bitbucket.New(bitbucket.Options.UUID(argocdSettings.WebhookBitbucketUUID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to init Bitbucket webhook: %w", err)
+	}
+	bitbucketserverHandler, err := bitbucketserver.New(bitbucketserver.Options.Secret(argocdSettings.WebhookBitbucketServerSecret))
+	if err != nil {
+		return nil, fmt.Errorf("unable to init Bitbucket Server webhook: %w", err)
+	}
 
 	webhookHandler := &WebhookHandler{
-		namespace:   namespace,
-		github:      githubHandler,
-		gitlab:      gitlabHandler,
-		azuredevops: azuredevopsHandler,
-		client:      client,
-		generators:  generators,
-		queue:       make(chan any, payloadQueueSize),
+		namespace:       namespace,
+		github:          githubHandler,
+		gitlab:          gitlabHandler,
+		azuredevops:     azuredevopsHandler,
+		bitbucket:       bitbucketHandler,
+		bitbucketserver: bitbucketserverHandler,
+		client:          client,
+		generators:      generators,
+		generatorCache:  generatorCache,
+		queue:           make(chan any, payloadQueueSize),
+		configuredProviders: map[string]bool{
+			providerGitHub:          argocdSettings.WebhookGitHubSecret != "",
+			providerGitLab:          argocdSettings.WebhookGitLabSecret != "",
+			providerAzureDevOps:     argocdSettings.WebhookAzureDevOpsUsername != "" || argocdSettings.WebhookAzureDevOpsPassword != "",
+			providerBitbucket:       argocdSettings.WebhookBitbucketUUID != "",
+			providerBitbucketServer: argocdSettings.WebhookBitbucketServerSecret != "",
+		},
+		requireAuthentication: requireAuthentication,
+		metrics:               metrics,
 	}
 
 	webhookHandler.startWorkerPool(webhookParallelism)
@@ -125,6 +196,15 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 		return
 	}
 
+	// The pull requests are updated before the ApplicationSets are refreshed, so that their reconciliation uses them.
+	// The payloads are only trusted when their signature was verified with the secret of argocd-secret, otherwise
+	// they only trigger the refresh, and the pull requests are listed from the SCM provider.
+	if prEvent := getPullRequestEvent(payload, prGenInfo); prEvent != nil && h.configuredProviders[providerGitHub] {
+		if handler, ok := h.generators["PullRequest"].(generators.PullRequestEventHandler); ok {
+			handler.HandlePullRequestEvent(prEvent)
+		}
+	}
+
 	appSetList := &v1alpha1.ApplicationSetList{}
 	err := h.client.List(context.Background(), appSetList, &client.ListOptions{})
 	if err != nil {
@@ -137,6 +217,7 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 		for _, gen := range appSet.Spec.Generators {
 			// check if the ApplicationSet uses any generator that is relevant to the payload
 			shouldRefresh = shouldRefreshGitGenerator(gen.Git, gitGenInfo) ||
+				shouldRefreshSCMProviderGenerator(gen.SCMProvider, gitGenInfo) ||
 				shouldRefreshPRGenerator(gen.PullRequest, prGenInfo) ||
 				shouldRefreshPluginGenerator(gen.Plugin) ||
 				h.shouldRefreshMatrixGenerator(gen.Matrix, &appSet, gitGenInfo, prGenInfo) ||
@@ -146,6 +227,12 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 			}
 		}
 		if shouldRefresh {
+			// The parameters cached before the event are stale, even for the reconciliations not triggered by the refresh
+			if h.generatorCache != nil {
+				if err := h.generatorCache.InvalidateGeneratorParams(&appSet); err != nil {
+					log.WithError(err).Warnf("Failed to invalidate the generator cache of ApplicationSet '%s'", appSet.Name)
+				}
+			}
 			err := refreshApplicationSet(h.client, &appSet)
 			if err != nil {
 				log.Errorf("Failed to refresh ApplicationSet '%s' for controller reprocessing", appSet.Name)
@@ -157,27 +244,34 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 }
 
 func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
-	var payload any
-	var err error
-
-	switch {
-	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
-	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = h.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.SystemHookEvents)
-	case r.Header.Get("X-Vss-Activityid") != "":
-		payload, err = h.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
-	default:
+	provider := webhookProvider(r)
+	if provider == providerUnknown {
 		log.Debug("Ignoring unknown webhook event")
+		h.recordWebhookEvent(provider, webhookEventRejected)
 		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
 		return
 	}
 
+	if h.requireAuthentication && !h.configuredProviders[provider] {
+		log.WithField(common.SecurityField, common.SecurityHigh).Warnf("Rejecting unauthenticated %s webhook: no secret is configured in argocd-secret", provider)
+		h.recordWebhookEvent(provider, webhookEventRejected)
+		http.Error(w, "Webhook processing failed: unauthenticated "+provider+" webhooks are not accepted", http.StatusUnauthorized)
+		return
+	}
+
+	payload, err := h.parse(provider, r)
 	if err != nil {
-		log.Infof("Webhook processing failed: %s", err)
+		h.recordWebhookEvent(provider, webhookEventRejected)
 		status := http.StatusBadRequest
-		if r.Method != http.MethodPost {
+		switch {
+		case isVerificationError(err):
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("%s webhook verification failed: %s", provider, err)
+			status = http.StatusUnauthorized
+		case r.Method != http.MethodPost:
+			log.Infof("Webhook processing failed: %s", err)
 			status = http.StatusMethodNotAllowed
+		default:
+			log.Infof("Webhook processing failed: %s", err)
 		}
 		http.Error(w, "Webhook processing failed: "+html.EscapeString(err.Error()), status)
 		return
@@ -185,33 +279,127 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 
 	select {
 	case h.queue <- payload:
+		h.recordWebhookEvent(provider, webhookEventAccepted)
 	default:
 		log.Info("Queue is full, discarding webhook payload")
+		h.recordWebhookEvent(provider, webhookEventRejected)
 		http.Error(w, "Queue is full, discarding webhook payload", http.StatusServiceUnavailable)
 	}
 }
 
+// webhookProvider returns the provider that sent the webhook request, based on its headers
+func webhookProvider(r *http.Request) string {
+	switch {
+	case r.Header.Get("X-GitHub-Event") != "":
+		return providerGitHub
+	case r.Header.Get("X-Gitlab-Event") != "":
+		return providerGitLab
+	case r.Header.Get("X-Vss-Activityid") != "":
+		return providerAzureDevOps
+	// Bitbucket Cloud also sets X-Event-Key, so it needs to be checked before Bitbucket Server
+	case r.Header.Get("X-Hook-UUID") != "":
+		return providerBitbucket
+	case r.Header.Get("X-Event-Key") != "":
+		return providerBitbucketServer
+	default:
+		return providerUnknown
+	}
+}
+
+func (h *WebhookHandler) parse(provider string, r *http.Request) (any, error) {
+	switch provider {
+	case providerGitHub:
+		return h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
+	case providerGitLab:
+		return h.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.SystemHookEvents)
+	case providerAzureDevOps:
+		return h.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
+	case providerBitbucket:
+		return h.bitbucket.Parse(r, bitbucket.RepoPushEvent)
+	case providerBitbucketServer:
+		return h.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
+	}
+	return nil, fmt.Errorf("unsupported webhook provider %q", provider)
+}
+
+// isVerificationError returns true if the payload was rejected because its signature, token or credentials
+// did not match the secret configured in argocd-secret
+func isVerificationError(err error) bool {
+	return errors.Is(err, github.ErrHMACVerificationFailed) ||
+		errors.Is(err, github.ErrMissingHubSignatureHeader) ||
+		errors.Is(err, gitlab.ErrGitLabTokenVerificationFailed) ||
+		errors.Is(err, azuredevops.ErrBasicAuthVerificationFailed) ||
+		errors.Is(err, bitbucket.ErrUUIDVerificationFailed) ||
+		errors.Is(err, bitbucket.ErrMissingHookUUIDHeader) ||
+		errors.Is(err, bitbucketserver.ErrHMACVerificationFailed) ||
+		errors.Is(err, bitbucketserver.ErrMissingHubSignatureHeader)
+}
+
+func (h *WebhookHandler) recordWebhookEvent(provider, result string) {
+	if h.metrics != nil {
+		h.metrics.IncWebhookEvent(provider, result)
+	}
+}
+
 func getGitGeneratorInfo(payload any) *gitGeneratorInfo {
 	var (
 		webURL      string
 		revision    string
 		touchedHead bool
+		scmProvider *scmProviderGeneratorInfo
 	)
 	switch payload := payload.(type) {
 	case github.PushPayload:
 		webURL = payload.Repository.HTMLURL
 		revision = webhook.ParseRevision(payload.Ref)
 		touchedHead = payload.Repository.DefaultBranch == revision
+		scmProvider = &scmProviderGeneratorInfo{Github: &scmProviderGeneratorGithubInfo{
+			Organization: payload.Repository.Owner.Login,
+			APIHostname:  githubAPIHostname(webURL),
+		}}
 	case gitlab.PushEventPayload:
 		webURL = payload.Project.WebURL
 		revision = webhook.ParseRevision(payload.Ref)
 		touchedHead = payload.Project.DefaultBranch == revision
+		if i := strings.LastIndex(payload.Project.PathWithNamespace, "/"); i > 0 {
+			scmProvider = &scmProviderGeneratorInfo{Gitlab: &scmProviderGeneratorGitlabInfo{
+				Group:       payload.Project.PathWithNamespace[:i],
+				APIHostname: hostname(webURL),
+			}}
+		}
 	case azuredevops.GitPushEvent:
 		// See: https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.push
 		webURL = payload.Resource.Repository.RemoteURL
 		revision = webhook.ParseRevision(payload.Resource.RefUpdates[0].Name)
 		touchedHead = payload.Resource.RefUpdates[0].Name == payload.Resource.Repository.DefaultBranch
 		// unfortunately, Azure DevOps doesn't provide a list of changed files
+	case bitbucket.RepoPushPayload:
+		// See: https://support.atlassian.com/bitbucket-cloud/docs/event-payloads/#Push
+		webURL = payload.Repository.Links.HTML.Href
+		for _, change := range payload.Push.Changes {
+			revision = change.New.Name
+			break
+		}
+		if owner, _, ok := strings.Cut(payload.Repository.FullName, "/"); ok {
+			scmProvider = &scmProviderGeneratorInfo{Bitbucket: &scmProviderGeneratorBitbucketInfo{Owner: owner}}
+		}
+		// the payload doesn't tell whether the default branch was updated, so let the generator check for itself
+		touchedHead = true
+	case bitbucketserver.RepositoryReferenceChangedPayload:
+		// the webhook module does not parse the inner links
+		if clone, ok := payload.Repository.Links["clone"].([]any); ok {
+			for _, l := range clone {
+				if link, ok := l.(map[string]any); ok && link["name"] == "http" {
+					webURL, _ = link["href"].(string)
+				}
+			}
+		}
+		for _, change := range payload.Changes {
+			revision = webhook.ParseRevision(change.Reference.ID)
+			break
+		}
+		// the payload doesn't tell whether the default branch was updated, so let the generator check for itself
+		touchedHead = true
 	default:
 		return nil
 	}
@@ -227,9 +415,28 @@ func getGitGeneratorInfo(payload any) *gitGeneratorInfo {
 		RepoRegexp:  repoRegexp,
 		TouchedHead: touchedHead,
 		Revision:    revision,
+		SCMProvider: scmProvider,
 	}
 }
 
+// githubAPIHostname returns the hostname of the API of the GitHub instance serving the given web URL.
+func githubAPIHostname(webURL string) string {
+	host := hostname(webURL)
+	if host == "github.com" {
+		return "api.github.com"
+	}
+	return host
+}
+
+func hostname(rawURL string) string {
+	urlObj, err := url.Parse(rawURL)
+	if err != nil {
+		log.Errorf("Failed to parse URL '%s'", rawURL)
+		return ""
+	}
+	return urlObj.Hostname()
+}
+
 func getPRGeneratorInfo(payload any) *prGeneratorInfo {
 	var info prGeneratorInfo
 	switch payload := payload.(type) {
@@ -284,6 +491,38 @@ func getPRGeneratorInfo(payload any) *prGeneratorInfo {
 	return &info
 }
 
+// getPullRequestEvent returns the pull request of the payload, as it would be listed by the pull request generators of
+// its repository. Only the GitHub payloads hold all the fields of the listed pull requests.
+func getPullRequestEvent(payload any, info *prGeneratorInfo) *generators.PullRequestEvent {
+	if info == nil || info.Github == nil {
+		return nil
+	}
+	githubPayload, ok := payload.(github.PullRequestPayload)
+	if !ok {
+		return nil
+	}
+	pull := githubPayload.PullRequest
+	labels := make([]string, 0, len(pull.Labels))
+	for _, label := range pull.Labels {
+		labels = append(labels, label.Name)
+	}
+	return &generators.PullRequestEvent{
+		Owner:     info.Github.Owner,
+		Repo:      info.Github.Repo,
+		APIRegexp: info.Github.APIRegexp,
+		PullRequest: &pullrequest.PullRequest{
+			Number:       int(pull.Number),
+			Title:        pull.Title,
+			Branch:       pull.Head.Ref,
+			TargetBranch: pull.Base.Ref,
+			HeadSHA:      pull.Head.Sha,
+			Labels:       labels,
+			Author:       pull.User.Login,
+		},
+		Closed: pull.State == "closed",
+	}
+}
+
 // githubAllowedPullRequestActions is a list of github actions that allow refresh
 var githubAllowedPullRequestActions = []string{
 	"opened",
@@ -352,6 +591,47 @@ func shouldRefreshGitGenerator(gen *v1alpha1.GitGenerator, info *gitGeneratorInf
 	return true
 }
 
+// shouldRefreshSCMProviderGenerator returns whether the push is to a repository of the organization of the generator, on
+// a branch the generator lists: the default branch, or any branch if the generator lists all of them.
+func shouldRefreshSCMProviderGenerator(gen *v1alpha1.SCMProviderGenerator, info *gitGeneratorInfo) bool {
+	if gen == nil || info == nil || info.SCMProvider == nil {
+		return false
+	}
+
+	switch {
+	case gen.Github != nil && info.SCMProvider.Github != nil:
+		api := gen.Github.API
+		if api == "" {
+			api = "https://api.github.com/"
+		}
+		if !strings.EqualFold(gen.Github.Organization, info.SCMProvider.Github.Organization) ||
+			!strings.EqualFold(hostname(api), info.SCMProvider.Github.APIHostname) {
+			return false
+		}
+		return info.TouchedHead || gen.Github.AllBranches
+	case gen.Gitlab != nil && info.SCMProvider.Gitlab != nil:
+		api := gen.Gitlab.API
+		if api == "" {
+			api = "https://gitlab.com/"
+		}
+		group := info.SCMProvider.Gitlab.Group
+		if !strings.EqualFold(gen.Gitlab.Group, group) &&
+			(!gen.Gitlab.IncludeSubgroups || !strings.HasPrefix(strings.ToLower(group), strings.ToLower(gen.Gitlab.Group)+"/")) {
+			return false
+		}
+		if !strings.EqualFold(hostname(api), info.SCMProvider.Gitlab.APIHostname) {
+			return false
+		}
+		return info.TouchedHead || gen.Gitlab.AllBranches
+	case gen.Bitbucket != nil && info.SCMProvider.Bitbucket != nil:
+		if !strings.EqualFold(gen.Bitbucket.Owner, info.SCMProvider.Bitbucket.Owner) {
+			return false
+		}
+		return info.TouchedHead || gen.Bitbucket.AllBranches
+	}
+	return false
+}
+
 func shouldRefreshPluginGenerator(gen *v1alpha1.PluginGenerator) bool {
 	return gen != nil
 }
@@ -405,24 +685,7 @@ func shouldRefreshPRGenerator(gen *v1alpha1.PullRequestGenerator, info *prGenera
 	}
 
 	if gen.Github != nil && info.Github != nil {
-		// repository owner and name are case-insensitive
-		// See https://docs.github.com/en/rest/pulls/pulls?apiVersion=2022-11-28#list-pull-requests
-		if !strings.EqualFold(gen.Github.Owner, info.Github.Owner) {
-			return false
-		}
-		if !strings.EqualFold(gen.Github.Repo, info.Github.Repo) {
-			return false
-		}
-		api := gen.Github.API
-		if api == "" {
-			api = "https://api.github.com/"
-		}
-		if !info.Github.APIRegexp.MatchString(api) {
-			log.Debugf("%s does not match %s", api, info.Github.APIRegexp.String())
-			return false
-		}
-
-		return true
+		return generators.GithubGeneratorMatches(gen.Github, info.Github.Owner, info.Github.Repo, info.Github.APIRegexp)
 	}
 
 	if gen.AzureDevOps != nil && info.Azuredevops != nil {
@@ -452,6 +715,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 
 	// Check first child generator for Git or Pull Request Generator
 	if shouldRefreshGitGenerator(g0.Git, gitGenInfo) ||
+		shouldRefreshSCMProviderGenerator(g0.SCMProvider, gitGenInfo) ||
 		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) {
 		return true
 	}
@@ -499,6 +763,9 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		OCI:                     g0.OCI,
+		AWSAccounts:             g0.AWSAccounts,
+		TerraformState:          g0.TerraformState,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -554,6 +821,9 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		OCI:                     g1.OCI,
+		AWSAccounts:             g1.AWSAccounts,
+		TerraformState:          g1.TerraformState,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
@@ -570,6 +840,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 
 			// Check all interpolated child generators
 			if shouldRefreshGitGenerator(interpolatedGenerator.Git, gitGenInfo) ||
+				shouldRefreshSCMProviderGenerator(interpolatedGenerator.SCMProvider, gitGenInfo) ||
 				shouldRefreshPRGenerator(interpolatedGenerator.PullRequest, prGenInfo) ||
 				shouldRefreshPluginGenerator(interpolatedGenerator.Plugin) ||
 				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo) ||
@@ -581,6 +852,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 
 	// First child generator didn't return any params, just check the second child generator
 	return shouldRefreshGitGenerator(requestedGenerator1.Git, gitGenInfo) ||
+		shouldRefreshSCMProviderGenerator(requestedGenerator1.SCMProvider, gitGenInfo) ||
 		shouldRefreshPRGenerator(requestedGenerator1.PullRequest, prGenInfo) ||
 		shouldRefreshPluginGenerator(requestedGenerator1.Plugin) ||
 		h.shouldRefreshMatrixGenerator(requestedGenerator1.Matrix, appSet, gitGenInfo, prGenInfo) ||
@@ -595,6 +867,7 @@ func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerato
 	for _, g := range gen.Generators {
 		// Check Git or Pull Request generator
 		if shouldRefreshGitGenerator(g.Git, gitGenInfo) ||
+			shouldRefreshSCMProviderGenerator(g.SCMProvider, gitGenInfo) ||
 			shouldRefreshPRGenerator(g.PullRequest, prGenInfo) {
 			return true
 		}
@@ -2,6 +2,10 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,6 +15,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-playground/webhooks/v6/github"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,13 +27,19 @@ import (
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
+	appsetcache "github.com/argoproj/argo-cd/v3/applicationset/cache"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_provider"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	argosettings "github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -52,6 +65,7 @@ func TestWebhookHandler(t *testing.T) {
 		desc               string
 		headerKey          string
 		headerValue        string
+		extraHeaders       map[string]string
 		effectedAppSets    []string
 		payloadFile        string
 		expectedStatusCode int
@@ -62,7 +76,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-GitHub-Event",
 			headerValue:        "push",
 			payloadFile:        "github-commit-event.json",
-			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "merge-nested-git-github", "plugin", "matrix-pull-request-github-plugin"},
+			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "merge-nested-git-github", "plugin", "matrix-pull-request-github-plugin", "scm-github", "scm-github-all-branches", "merge-scm-github"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -71,7 +85,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-GitHub-Event",
 			headerValue:        "push",
 			payloadFile:        "github-commit-event-feature-branch.json",
-			effectedAppSets:    []string{"github-shorthand", "matrix-pull-request-github-plugin", "plugin"},
+			effectedAppSets:    []string{"github-shorthand", "matrix-pull-request-github-plugin", "plugin", "scm-github-all-branches"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -80,7 +94,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-GitHub-Event",
 			headerValue:        "push",
 			payloadFile:        "github-commit-branch-event.json",
-			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "plugin", "matrix-pull-request-github-plugin"},
+			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "plugin", "matrix-pull-request-github-plugin", "scm-github-all-branches"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -98,7 +112,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-Gitlab-Event",
 			headerValue:        "Push Hook",
 			payloadFile:        "gitlab-event.json",
-			effectedAppSets:    []string{"git-gitlab", "git-gitlab-ssh", "git-gitlab-alt-ssh", "plugin", "matrix-pull-request-github-plugin"},
+			effectedAppSets:    []string{"git-gitlab", "git-gitlab-ssh", "git-gitlab-alt-ssh", "plugin", "matrix-pull-request-github-plugin", "scm-gitlab"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -107,7 +121,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-Gitlab-Event",
 			headerValue:        "System Hook",
 			payloadFile:        "gitlab-event.json",
-			effectedAppSets:    []string{"git-gitlab", "git-gitlab-ssh", "git-gitlab-alt-ssh", "plugin", "matrix-pull-request-github-plugin"},
+			effectedAppSets:    []string{"git-gitlab", "git-gitlab-ssh", "git-gitlab-alt-ssh", "plugin", "matrix-pull-request-github-plugin", "scm-gitlab"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -183,6 +197,25 @@ func TestWebhookHandler(t *testing.T) {
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
+		{
+			desc:               "WebHook from a Bitbucket repository via Commit",
+			headerKey:          "X-Hook-UUID",
+			headerValue:        "abcd-efgh-ijkl-mnop",
+			extraHeaders:       map[string]string{"X-Event-Key": "repo:push"},
+			payloadFile:        "bitbucket-push-event.json",
+			effectedAppSets:    []string{"git-bitbucket", "plugin", "matrix-pull-request-github-plugin", "scm-bitbucket"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
+		{
+			desc:               "WebHook from a Bitbucket Server repository via Commit",
+			headerKey:          "X-Event-Key",
+			headerValue:        "repo:refs_changed",
+			payloadFile:        "bitbucket-server-event.json",
+			effectedAppSets:    []string{"git-bitbucket-server", "plugin", "matrix-pull-request-github-plugin"},
+			expectedStatusCode: http.StatusOK,
+			expectedRefresh:    true,
+		},
 		{
 			desc:               "WebHook from a Azure DevOps repository via pull request event",
 			headerKey:          "X-Vss-Activityid",
@@ -214,6 +247,8 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithGitGenerator("git-gitlab-ssh", namespace, "ssh://git@gitlab.com/group/name"),
 				fakeAppWithGitGenerator("git-gitlab-alt-ssh", namespace, "ssh://git@altssh.gitlab.com:443/group/name"),
 				fakeAppWithGitGenerator("git-azure-devops", namespace, "https://dev.azure.com/fabrikam-fiber-inc/DefaultCollection/_git/Fabrikam-Fiber-Git"),
+				fakeAppWithGitGenerator("git-bitbucket", namespace, "https://bitbucket.org/org/repo.git"),
+				fakeAppWithGitGenerator("git-bitbucket-server", namespace, "https://bitbucketserver/scm/myproject/test-repo.git"),
 				fakeAppWithGitGeneratorWithRevision("github-shorthand", namespace, "https://github.com/org/repo", "env/dev"),
 				fakeAppWithGithubPullRequestGenerator("pull-request-github", namespace, "CodErTOcat", "Hello-World"),
 				fakeAppWithGitlabPullRequestGenerator("pull-request-gitlab", namespace, "100500"),
@@ -228,13 +263,22 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithMergeAndGitGenerator("merge-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMergeAndPullRequestGenerator("merge-pull-request-github", namespace, "Codertocat", "Hello-World"),
 				fakeAppWithMergeAndNestedGitGenerator("merge-nested-git-github", namespace, "https://github.com/org/repo"),
+				fakeAppWithSCMProviderGenerator("scm-github", namespace, &v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "ORG"}}),
+				fakeAppWithSCMProviderGenerator("scm-github-all-branches", namespace, &v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "org", AllBranches: true}}),
+				fakeAppWithSCMProviderGenerator("scm-github-enterprise", namespace, &v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "org", API: "https://github.example.com/api/v3"}}),
+				fakeAppWithSCMProviderGenerator("scm-gitlab", namespace, &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group"}}),
+				fakeAppWithSCMProviderGenerator("scm-bitbucket", namespace, &v1alpha1.SCMProviderGenerator{Bitbucket: &v1alpha1.SCMProviderGeneratorBitbucket{Owner: "org"}}),
+				fakeAppWithMergeAndSCMProviderGenerator("merge-scm-github", namespace, "org"),
 			).Build()
 			set := argosettings.NewSettingsManager(t.Context(), fakeClient, namespace)
-			h, err := NewWebhookHandler(namespace, webhookParallelism, set, fc, mockGenerators())
+			h, err := NewWebhookHandler(namespace, webhookParallelism, set, fc, mockGenerators(), nil, false, nil)
 			require.NoError(t, err)
 
 			req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
 			req.Header.Set(test.headerKey, test.headerValue)
+			for k, v := range test.extraHeaders {
+				req.Header.Set(k, v)
+			}
 			eventJSON, err := os.ReadFile(filepath.Join("testdata", test.payloadFile))
 			require.NoError(t, err)
 			req.Body = io.NopCloser(bytes.NewReader(eventJSON))
@@ -269,6 +313,108 @@ func TestWebhookHandler(t *testing.T) {
 	}
 }
 
+func TestWebhookHandlerAuthentication(t *testing.T) {
+	const githubSecret = "github-secret"
+
+	signGitHub := func(body []byte) string {
+		mac := hmac.New(sha256.New, []byte(githubSecret))
+		_, _ = mac.Write(body)
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	githubEvent, err := os.ReadFile(filepath.Join("testdata", "github-commit-event.json"))
+	require.NoError(t, err)
+	gitlabEvent, err := os.ReadFile(filepath.Join("testdata", "gitlab-event.json"))
+	require.NoError(t, err)
+
+	tt := []struct {
+		desc                  string
+		requireAuthentication bool
+		headers               map[string]string
+		payload               []byte
+		expectedStatusCode    int
+		expectedMetric        string
+	}{
+		{
+			desc:                  "GitHub payload with a valid signature is accepted",
+			requireAuthentication: true,
+			headers:               map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": signGitHub(githubEvent)},
+			payload:               githubEvent,
+			expectedStatusCode:    http.StatusOK,
+			expectedMetric:        `argocd_appset_webhook_events_total{provider="github",result="accepted"} 1`,
+		},
+		{
+			desc:                  "GitHub payload with an invalid signature is rejected",
+			requireAuthentication: false,
+			headers:               map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": signGitHub([]byte("tampered"))},
+			payload:               githubEvent,
+			expectedStatusCode:    http.StatusUnauthorized,
+			expectedMetric:        `argocd_appset_webhook_events_total{provider="github",result="rejected"} 1`,
+		},
+		{
+			desc:                  "GitHub payload without a signature is rejected",
+			requireAuthentication: false,
+			headers:               map[string]string{"X-GitHub-Event": "push"},
+			payload:               githubEvent,
+			expectedStatusCode:    http.StatusUnauthorized,
+			expectedMetric:        `argocd_appset_webhook_events_total{provider="github",result="rejected"} 1`,
+		},
+		{
+			desc:                  "GitLab payload is accepted when no secret is configured",
+			requireAuthentication: false,
+			headers:               map[string]string{"X-Gitlab-Event": "Push Hook"},
+			payload:               gitlabEvent,
+			expectedStatusCode:    http.StatusOK,
+			expectedMetric:        `argocd_appset_webhook_events_total{provider="gitlab",result="accepted"} 1`,
+		},
+		{
+			desc:                  "GitLab payload is rejected when no secret is configured and authentication is required",
+			requireAuthentication: true,
+			headers:               map[string]string{"X-Gitlab-Event": "Push Hook"},
+			payload:               gitlabEvent,
+			expectedStatusCode:    http.StatusUnauthorized,
+			expectedMetric:        `argocd_appset_webhook_events_total{provider="gitlab",result="rejected"} 1`,
+		},
+	}
+
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	err = v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	for _, test := range tt {
+		t.Run(test.desc, func(t *testing.T) {
+			fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				fakeAppWithGitGenerator("git-github", namespace, "https://github.com/org/repo"),
+			).Build()
+			kubeClient := newFakeClientWithSecretData(namespace, map[string][]byte{
+				"server.secretkey":      nil,
+				"webhook.github.secret": []byte(githubSecret),
+			})
+			set := argosettings.NewSettingsManager(t.Context(), kubeClient, namespace)
+			metrics.Registry = prometheus.NewRegistry()
+			appsetMetrics := appsetmetrics.NewApplicationsetMetrics(utils.NewAppsetLister(fc), []string{}, func(_ *v1alpha1.ApplicationSet) bool { return true })
+			h, err := NewWebhookHandler(namespace, 1, set, fc, mockGenerators(), nil, test.requireAuthentication, &appsetMetrics)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/webhook", bytes.NewReader(test.payload))
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			h.Handler(w, req)
+			close(h.queue)
+			h.Wait()
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+
+			rr := httptest.NewRecorder()
+			promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			assert.Contains(t, rr.Body.String(), test.expectedMetric)
+		})
+	}
+}
+
 func mockGenerators() map[string]generators.Generator {
 	// generatorMockList := generatorMock{}
 	generatorMockGit := &generatorMock{}
@@ -401,6 +547,52 @@ func fakeAppWithGitGenerator(name, namespace, repo string) *v1alpha1.Application
 	}
 }
 
+func fakeAppWithSCMProviderGenerator(name, namespace string, gen *v1alpha1.SCMProviderGenerator) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					SCMProvider: gen,
+				},
+			},
+		},
+	}
+}
+
+func fakeAppWithMergeAndSCMProviderGenerator(name, namespace, organization string) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					Merge: &v1alpha1.MergeGenerator{
+						MergeKeys: []string{"repository"},
+						Generators: []v1alpha1.ApplicationSetNestedGenerator{
+							{
+								List: &v1alpha1.ListGenerator{},
+							},
+							{
+								SCMProvider: &v1alpha1.SCMProviderGenerator{
+									Github: &v1alpha1.SCMProviderGeneratorGithub{
+										Organization: organization,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func fakeAppWithGitGeneratorWithRevision(name, namespace, repo, revision string) *v1alpha1.ApplicationSet {
 	appSet := fakeAppWithGitGenerator(name, namespace, repo)
 	appSet.Spec.Generators[0].Git.Revision = revision
@@ -785,6 +977,12 @@ func fakeAppWithMatrixAndPullRequestGeneratorWithPluginGenerator(name, namespace
 }
 
 func newFakeClient(ns string) *kubefake.Clientset {
+	return newFakeClientWithSecretData(ns, map[string][]byte{
+		"server.secretkey": nil,
+	})
+}
+
+func newFakeClientWithSecretData(ns string, secretData map[string][]byte) *kubefake.Clientset {
 	s := runtime.NewScheme()
 	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.ApplicationSet{})
 	return kubefake.NewClientset(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: ns, Labels: map[string]string{
@@ -797,8 +995,160 @@ func newFakeClient(ns string) *kubefake.Clientset {
 				"app.kubernetes.io/part-of": "argocd",
 			},
 		},
-		Data: map[string][]byte{
-			"server.secretkey": nil,
-		},
+		Data: secretData,
+	})
+}
+
+func TestGetPullRequestEvent(t *testing.T) {
+	readPayload := func(t *testing.T, file string) github.PullRequestPayload {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join("testdata", file))
+		require.NoError(t, err)
+		var payload github.PullRequestPayload
+		require.NoError(t, json.Unmarshal(data, &payload))
+		return payload
+	}
+
+	t.Run("opened", func(t *testing.T) {
+		payload := readPayload(t, "github-pull-request-opened-event.json")
+		event := getPullRequestEvent(payload, getPRGeneratorInfo(payload))
+		require.NotNil(t, event)
+		assert.Equal(t, &pullrequest.PullRequest{
+			Number:       2,
+			Title:        "Update the README with new information.",
+			Branch:       "changes",
+			TargetBranch: "master",
+			HeadSHA:      "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+			Labels:       []string{},
+			Author:       "Codertocat",
+		}, event.PullRequest)
+		assert.False(t, event.Closed)
+		assert.True(t, event.Matches(&v1alpha1.PullRequestGenerator{Github: &v1alpha1.PullRequestGeneratorGithub{Owner: "codertocat", Repo: "hello-world"}}))
+		assert.False(t, event.Matches(&v1alpha1.PullRequestGenerator{Github: &v1alpha1.PullRequestGeneratorGithub{Owner: "codertocat", Repo: "other"}}))
 	})
+
+	t.Run("labeled", func(t *testing.T) {
+		payload := readPayload(t, "github-pull-request-labeled-event.json")
+		event := getPullRequestEvent(payload, getPRGeneratorInfo(payload))
+		require.NotNil(t, event)
+		assert.Equal(t, []string{"deploy-preview"}, event.PullRequest.Labels)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		payload := readPayload(t, "github-pull-request-opened-event.json")
+		payload.Action = "closed"
+		payload.PullRequest.State = "closed"
+		event := getPullRequestEvent(payload, getPRGeneratorInfo(payload))
+		require.NotNil(t, event)
+		assert.True(t, event.Closed)
+	})
+
+	t.Run("ignored action", func(t *testing.T) {
+		payload := readPayload(t, "github-pull-request-assigned-event.json")
+		assert.Nil(t, getPullRequestEvent(payload, getPRGeneratorInfo(payload)))
+	})
+}
+
+type pullRequestEventRecorder struct {
+	generatorMock
+	events []*generators.PullRequestEvent
+}
+
+func (g *pullRequestEventRecorder) HandlePullRequestEvent(event *generators.PullRequestEvent) {
+	g.events = append(g.events, event)
+}
+
+func TestHandleEventPullRequest(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "github-pull-request-opened-event.json"))
+	require.NoError(t, err)
+	var payload github.PullRequestPayload
+	require.NoError(t, json.Unmarshal(data, &payload))
+
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	for _, c := range []struct {
+		name           string
+		secretData     map[string][]byte
+		expectedEvents int
+	}{
+		{name: "signed payloads update the pull requests", secretData: map[string][]byte{"server.secretkey": nil, "webhook.github.secret": []byte("secret")}, expectedEvents: 1},
+		{name: "unsigned payloads only trigger a refresh", secretData: map[string][]byte{"server.secretkey": nil}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			fc := fake.NewClientBuilder().WithScheme(scheme).Build()
+			set := argosettings.NewSettingsManager(t.Context(), newFakeClientWithSecretData(namespace, c.secretData), namespace)
+			recorder := &pullRequestEventRecorder{}
+			h, err := NewWebhookHandler(namespace, 1, set, fc, map[string]generators.Generator{"PullRequest": recorder}, nil, false, nil)
+			require.NoError(t, err)
+			close(h.queue)
+			h.Wait()
+
+			h.HandleEvent(payload)
+			assert.Len(t, recorder.events, c.expectedEvents)
+		})
+	}
+}
+
+func TestHandleEventInvalidatesGeneratorCache(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "github-commit-event.json"))
+	require.NoError(t, err)
+	var payload github.PushPayload
+	require.NoError(t, json.Unmarshal(data, &payload))
+
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	refreshed := fakeAppWithGitGenerator("git-github", namespace, "https://github.com/org/repo")
+	refreshed.UID = "refreshed-uid"
+	other := fakeAppWithGitGenerator("git-gitlab", namespace, "https://gitlab.com/group/name")
+	other.UID = "other-uid"
+	fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(refreshed, other).Build()
+
+	generatorCache := appsetcache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	for _, appSet := range []*v1alpha1.ApplicationSet{refreshed, other} {
+		require.NoError(t, generatorCache.SetGeneratorParams(appSet, "hash", []map[string]any{{"branch": "master"}}))
+	}
+
+	set := argosettings.NewSettingsManager(t.Context(), newFakeClient(namespace), namespace)
+	h, err := NewWebhookHandler(namespace, 1, set, fc, mockGenerators(), generatorCache, false, nil)
+	require.NoError(t, err)
+	close(h.queue)
+	h.Wait()
+
+	h.HandleEvent(payload)
+
+	_, err = generatorCache.GetGeneratorParams(refreshed, "hash")
+	require.ErrorIs(t, err, appsetcache.ErrCacheMiss)
+	_, err = generatorCache.GetGeneratorParams(other, "hash")
+	require.NoError(t, err)
+}
+
+func TestShouldRefreshSCMProviderGenerator(t *testing.T) {
+	gitlabInfo := &gitGeneratorInfo{
+		TouchedHead: true,
+		SCMProvider: &scmProviderGeneratorInfo{Gitlab: &scmProviderGeneratorGitlabInfo{Group: "group/subgroup", APIHostname: "gitlab.com"}},
+	}
+	for _, c := range []struct {
+		name string
+		gen  *v1alpha1.SCMProviderGenerator
+		info *gitGeneratorInfo
+		want bool
+	}{
+		{name: "same group", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group/subgroup"}}, info: gitlabInfo, want: true},
+		{name: "parent group", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group"}}, info: gitlabInfo},
+		{name: "parent group with subgroups", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group", IncludeSubgroups: true}}, info: gitlabInfo, want: true},
+		{name: "group with the same prefix", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "grou", IncludeSubgroups: true}}, info: gitlabInfo},
+		{name: "other instance", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group/subgroup", API: "https://gitlab.example.com"}}, info: gitlabInfo},
+		{name: "other provider", gen: &v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "group"}}, info: gitlabInfo},
+		{name: "push to another branch", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group/subgroup"}}, info: &gitGeneratorInfo{SCMProvider: gitlabInfo.SCMProvider}},
+		{name: "push to another branch with all branches", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group/subgroup", AllBranches: true}}, info: &gitGeneratorInfo{SCMProvider: gitlabInfo.SCMProvider}, want: true},
+		{name: "push without SCM provider info", gen: &v1alpha1.SCMProviderGenerator{Gitlab: &v1alpha1.SCMProviderGeneratorGitlab{Group: "group/subgroup"}}, info: &gitGeneratorInfo{TouchedHead: true}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, shouldRefreshSCMProviderGenerator(c.gen, c.info))
+		})
+	}
 }
@@ -1,23 +1,34 @@
 package command
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"net"
 	"net/http"
 	"os"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/argoproj/pkg/v2/stats"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/tls"
+	"github.com/argoproj/argo-cd/v3/util/trace"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/admission"
+	appsetcache "github.com/argoproj/argo-cd/v3/applicationset/cache"
 	"github.com/argoproj/argo-cd/v3/applicationset/controllers"
+	"github.com/argoproj/argo-cd/v3/applicationset/enrichers"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/generatorserver"
+	generatorapiclient "github.com/argoproj/argo-cd/v3/applicationset/generatorserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/applicationset/hooks"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/applicationset/webhook"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
@@ -34,10 +45,15 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_cache"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/secret_store"
 	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -70,14 +86,49 @@ func NewCommand() *cobra.Command {
 		repoServerStrictTLS          bool
 		repoServerTimeoutSeconds     int
 		maxConcurrentReconciliations int
+		repoConcurrencyLimit         int
 		scmRootCAPath                string
+		scmCacheTTL                  time.Duration
+		scmRequestsPerSecond         float64
+		scmRequestsBurst             int
 		allowedScmProviders          []string
 		globalPreservedAnnotations   []string
 		globalPreservedLabels        []string
 		metricsAplicationsetLabels   []string
 		enableScmProviders           bool
 		webhookParallelism           int
+		webhookRequireAuthentication bool
 		tokenRefStrictMode           bool
+		enableServerSideApply        bool
+		serverSideApplyFieldManager  string
+		paramEnrichersConfigPath     string
+		secretStoresConfigPath       string
+		clusterGeneratorStrict       bool
+		awsAccountsConfig            generators.AWSAccountsConfig
+		terraformStateConfig         generators.TerraformStateConfig
+		strictGenerators             bool
+		disableLegacyTemplates       bool
+		minRequeueAfter              time.Duration
+		maxPendingCreations          int
+		fullApplicationDiff          bool
+		namespaced                   bool
+		controllerInstance           string
+		otlpAddress                  string
+		otlpInsecure                 bool
+		otlpHeaders                  map[string]string
+		otlpAttrs                    []string
+		generatorServer              string
+		generatorServerPlaintext     bool
+		generatorServerStrictTLS     bool
+		serveGenerators              bool
+		generatorServerListenAddr    string
+		generatorServerDisableTLS    bool
+		tlsConfigCustomizerSrc       func() (tls.ConfigCustomizer, error)
+		enableAdmissionWebhook       bool
+		enableApplicationLookup      bool
+		admissionWebhookPort         int
+		admissionWebhookCertDir      string
+		cacheSrc                     func() (*appsetcache.Cache, error)
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -91,13 +142,24 @@ func NewCommand() *cobra.Command {
 
 			vers := common.GetVersion()
 			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			if namespaced {
+				for _, ns := range applicationSetNamespaces {
+					if ns != namespace {
+						log.Errorf("The controller cannot reconcile ApplicationSets of namespace %s when running with --namespaced, only ApplicationSets of namespace %s", ns, namespace)
+						os.Exit(1)
+					}
+				}
+				applicationSetNamespaces = nil
+			}
 			applicationSetNamespaces = append(applicationSetNamespaces, namespace)
 
-			errors.CheckError(err)
 			vers.LogStartupInfo(
 				"ArgoCD ApplicationSet Controller",
 				map[string]any{
-					"namespace": namespace,
+					"namespace":        namespace,
+					"namespaced":       namespaced,
+					"serve-generators": serveGenerators,
 				},
 			)
 
@@ -134,15 +196,33 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
-			var cacheOpt ctrlcache.Options
+			// Only the ApplicationSets of this controller instance are cached, so that several instances can split the
+			// ApplicationSets of the cluster
+			if controllerInstance != "" {
+				if errs := validation.IsDNS1123Label(controllerInstance); len(errs) > 0 {
+					log.Errorf("Invalid controller instance %q: %s", controllerInstance, strings.Join(errs, ", "))
+					os.Exit(1)
+				}
+			}
+			instanceSelector, err := utils.ControllerInstanceSelector(controllerInstance)
+			errors.CheckError(err)
+			cacheOpt := ctrlcache.Options{
+				ByObject: map[ctrlclient.Object]ctrlcache.ByObject{
+					&appv1alpha1.ApplicationSet{}: {Label: instanceSelector},
+				},
+			}
+			var leaderElectionNamespace string
 
 			if watchedNamespace != "" {
-				cacheOpt = ctrlcache.Options{
-					DefaultNamespaces: map[string]ctrlcache.Config{
-						watchedNamespace: {},
-					},
+				cacheOpt.DefaultNamespaces = map[string]ctrlcache.Config{
+					watchedNamespace: {},
 				}
 			}
+			// In namespaced mode the controller has no cluster role, so the leader election lease is always kept in
+			// its own namespace
+			if namespaced {
+				leaderElectionNamespace = namespace
+			}
 
 			cfg := ctrl.GetConfigOrDie()
 			err = appv1alpha1.SetK8SConfigDefaults(cfg)
@@ -151,15 +231,28 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			// The admission webhook is served by all the replicas, whether they are the leader or not
+			var admissionWebhookServer ctrlwebhook.Server
+			if enableAdmissionWebhook && !serveGenerators {
+				admissionWebhookServer = ctrlwebhook.NewServer(ctrlwebhook.Options{
+					Port:    admissionWebhookPort,
+					CertDir: admissionWebhookCertDir,
+				})
+			}
+
+			// The generator service is stateless and all its replicas serve requests, so it never elects a leader
 			mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 				Scheme: scheme,
 				Metrics: metricsserver.Options{
-					BindAddress: metricsAddr,
+					BindAddress:    metricsAddr,
+					FilterProvider: appsetmetrics.OpenMetricsFilterProvider,
 				},
-				Cache:                  cacheOpt,
-				HealthProbeBindAddress: probeBindAddr,
-				LeaderElection:         enableLeaderElection,
-				LeaderElectionID:       "58ac56fa.applicationsets.argoproj.io",
+				Cache:                   cacheOpt,
+				HealthProbeBindAddress:  probeBindAddr,
+				WebhookServer:           admissionWebhookServer,
+				LeaderElection:          enableLeaderElection && !serveGenerators,
+				LeaderElectionID:        leaderElectionID(controllerInstance),
+				LeaderElectionNamespace: leaderElectionNamespace,
 				Client: ctrlclient.Options{
 					DryRun: &dryRun,
 				},
@@ -173,9 +266,39 @@ func NewCommand() *cobra.Command {
 			k8sClient, err := kubernetes.NewForConfig(mgr.GetConfig())
 			errors.CheckError(err)
 
+			// Against an older ApplicationSet CRD, the features depending on the fields it does not know are disabled,
+			// since the API server would silently drop these fields. The namespaced controller cannot read the CRD.
+			var crdCapabilities *utils.CRDCapabilities
+			if !namespaced {
+				capabilities, err := utils.DetectCRDCapabilities(ctx, dynamicClient)
+				if err != nil {
+					log.Warnf("Unable to detect the fields supported by the ApplicationSet CRD, assuming it is up to date: %v", err)
+				} else if missing := capabilities.MissingFields(); len(missing) > 0 {
+					log.Warnf("The installed ApplicationSet CRD does not support %s: the ApplicationSets using these fields will not be reconciled until the CRD is upgraded and the controller restarted", strings.Join(missing, ", "))
+					if !capabilities.Strategy && enableProgressiveSyncs {
+						log.Warn("Disabling the progressive syncs, which are not supported by the installed ApplicationSet CRD")
+						enableProgressiveSyncs = false
+					}
+					crdCapabilities = &capabilities
+				}
+			}
+
 			argoSettingsMgr := argosettings.NewSettingsManager(ctx, k8sClient, namespace)
 			argoCDDB := db.NewDB(namespace, argoSettingsMgr, k8sClient)
 
+			// The SCM providers and the pull request services share their rate limiters and their cached responses
+			scm_cache.Default = scm_cache.New(scm_cache.Options{
+				TTL:               scmCacheTTL,
+				RequestsPerSecond: scmRequestsPerSecond,
+				Burst:             scmRequestsBurst,
+				Requests:          appsetmetrics.RegisterSCMAPIRequestsCounter(),
+			})
+			if secretStoresConfigPath != "" {
+				secretStoresConfig, err := secret_store.LoadConfig(secretStoresConfigPath)
+				errors.CheckError(err)
+				secret_store.Default, err = secret_store.NewFromConfig(secretStoresConfig, namespace)
+				errors.CheckError(err)
+			}
 			scmConfig := generators.NewSCMConfig(scmRootCAPath, allowedScmProviders, enableScmProviders, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), tokenRefStrictMode)
 
 			tlsConfig := apiclient.TLSConfiguration{
@@ -194,16 +317,65 @@ func NewCommand() *cobra.Command {
 
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, repoServerTimeoutSeconds, tlsConfig)
 			argoCDService := services.NewArgoCDService(argoCDDB, gitSubmoduleEnabled, repoClientset, enableNewGitFileGlobbing)
+			if repoConcurrencyLimit > 0 {
+				argoCDService = services.NewRepoLimitedService(argoCDService, repoConcurrencyLimit, appsetmetrics.RegisterRepoRequestsWaitingGauge())
+			}
 
-			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig)
+			recorder := mgr.GetEventRecorderFor("applicationset-controller")
 
-			// start a webhook server that listens to incoming webhook payloads
-			webhookHandler, err := webhook.NewWebhookHandler(namespace, webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators)
-			if err != nil {
-				log.Error(err, "failed to create webhook handler")
+			if serveGenerators {
+				// The parameters are cached by the controllers calling the generator service
+				serverGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, awsAccountsConfig, terraformStateConfig, false, nil, nil, clusterGeneratorStrict, recorder)
+				var tlsConfigCustomizer tls.ConfigCustomizer
+				if !generatorServerDisableTLS {
+					tlsConfigCustomizer, err = tlsConfigCustomizerSrc()
+					errors.CheckError(err)
+				}
+				server, err := generatorserver.NewServer(serverGenerators, mgr.GetClient(), os.Getenv(common.EnvApplicationSetGeneratorServerToken), tlsConfigCustomizer)
+				errors.CheckError(err)
+				errors.CheckError(mgr.Add(newGeneratorServerRunnable(server, generatorServerListenAddr)))
+
+				log.Info("Starting generator server")
+				if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+					log.Error(err, "problem running manager")
+					os.Exit(1)
+				}
+				return nil
 			}
-			if webhookHandler != nil {
-				startWebhookServer(webhookHandler, webhookAddr)
+
+			generatorCache, err := cacheSrc()
+			errors.CheckError(err)
+
+			var generatorClientset generatorapiclient.Clientset
+			if generatorServer != "" {
+				token := os.Getenv(common.EnvApplicationSetGeneratorServerToken)
+				if token == "" {
+					log.Errorf("The %s environment variable must hold the token of the generator service when running with --generator-server", common.EnvApplicationSetGeneratorServerToken)
+					os.Exit(1)
+				}
+				generatorTLSConfig := generatorapiclient.TLSConfiguration{
+					DisableTLS:       generatorServerPlaintext,
+					StrictValidation: generatorServerStrictTLS,
+				}
+				if !generatorServerPlaintext && generatorServerStrictTLS {
+					pool, err := tls.LoadX509CertPool(
+						env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/applicationset-generator-server/tls/tls.crt",
+						env.StringFromEnv(common.EnvAppConfigPath, common.DefaultAppConfigPath)+"/applicationset-generator-server/tls/ca.crt",
+					)
+					errors.CheckError(err)
+					generatorTLSConfig.Certificates = pool
+				}
+				generatorClientset = generatorapiclient.NewGeneratorServerClientset(generatorServer, generatorTLSConfig, token)
+			}
+
+			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, awsAccountsConfig, terraformStateConfig, true, generatorCache, generatorClientset, clusterGeneratorStrict, recorder)
+
+			var enricher enrichers.Enricher
+			if paramEnrichersConfigPath != "" {
+				enrichersConfig, err := enrichers.LoadConfig(paramEnrichersConfigPath)
+				errors.CheckError(err)
+				enricher, err = enrichers.NewChain(enrichersConfig, mgr.GetClient(), namespace, tokenRefStrictMode)
+				errors.CheckError(err)
 			}
 
 			metrics := appsetmetrics.NewApplicationsetMetrics(
@@ -212,29 +384,72 @@ func NewCommand() *cobra.Command {
 				func(appset *appv1alpha1.ApplicationSet) bool {
 					return utils.IsNamespaceAllowed(applicationSetNamespaces, appset.Namespace)
 				})
+			topLevelGenerators = generators.WithErrorObserver(topLevelGenerators, metrics.IncGeneratorError)
+
+			// start a webhook server that listens to incoming webhook payloads
+			webhookHandler, err := webhook.NewWebhookHandler(namespace, webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators, generatorCache, webhookRequireAuthentication, &metrics)
+			if err != nil {
+				log.Error(err, "failed to create webhook handler")
+			}
+			if webhookHandler != nil {
+				startWebhookServer(webhookHandler, webhookAddr)
+			}
+
+			renderer := utils.NewRender(plugin.NewFunctionRegistry(mgr.GetClient(), namespace, tokenRefStrictMode))
+			if enableApplicationLookup {
+				renderer = renderer.WithApplicationLookup(mgr.GetClient())
+			}
 
 			if err = (&controllers.ApplicationSetReconciler{
-				Generators:                 topLevelGenerators,
-				Client:                     mgr.GetClient(),
-				Scheme:                     mgr.GetScheme(),
-				Recorder:                   mgr.GetEventRecorderFor("applicationset-controller"),
-				Renderer:                   &utils.Render{},
-				Policy:                     policyObj,
-				EnablePolicyOverride:       enablePolicyOverride,
-				KubeClientset:              k8sClient,
-				ArgoDB:                     argoCDDB,
-				ArgoCDNamespace:            namespace,
-				ApplicationSetNamespaces:   applicationSetNamespaces,
-				EnableProgressiveSyncs:     enableProgressiveSyncs,
-				SCMRootCAPath:              scmRootCAPath,
-				GlobalPreservedAnnotations: globalPreservedAnnotations,
-				GlobalPreservedLabels:      globalPreservedLabels,
-				Metrics:                    &metrics,
+				Generators:                    topLevelGenerators,
+				Client:                        mgr.GetClient(),
+				APIReader:                     mgr.GetAPIReader(),
+				Scheme:                        mgr.GetScheme(),
+				Recorder:                      recorder,
+				Renderer:                      renderer,
+				Policy:                        policyObj,
+				EnablePolicyOverride:          enablePolicyOverride,
+				KubeClientset:                 k8sClient,
+				ArgoDB:                        argoCDDB,
+				ArgoCDNamespace:               namespace,
+				ApplicationSetNamespaces:      applicationSetNamespaces,
+				EnableProgressiveSyncs:        enableProgressiveSyncs,
+				SCMRootCAPath:                 scmRootCAPath,
+				GlobalPreservedAnnotations:    globalPreservedAnnotations,
+				GlobalPreservedLabels:         globalPreservedLabels,
+				Metrics:                       &metrics,
+				EnableServerSideApply:         enableServerSideApply,
+				ServerSideApplyFieldManager:   serverSideApplyFieldManager,
+				Enricher:                      enricher,
+				Hooks:                         hooks.NewRunner(mgr.GetClient(), namespace, tokenRefStrictMode),
+				StrictGenerators:              strictGenerators,
+				DisableLegacyTemplates:        disableLegacyTemplates,
+				Repos:                         argoCDService,
+				MinRequeueAfter:               minRequeueAfter,
+				CRDCapabilities:               crdCapabilities,
+				MaxPendingCreationsPerCluster: maxPendingCreations,
+				ApplicationSpecHash:           true,
+				FullApplicationDiff:           fullApplicationDiff,
 			}).SetupWithManager(mgr, enableProgressiveSyncs, maxConcurrentReconciliations); err != nil {
 				log.Error(err, "unable to create controller", "controller", "ApplicationSet")
 				os.Exit(1)
 			}
 
+			if enableAdmissionWebhook {
+				if err := admission.NewValidator(mgr.GetAPIReader(), namespace, argoCDService, tokenRefStrictMode).SetupWithManager(mgr); err != nil {
+					log.Error(err, "unable to create admission webhook", "webhook", "ApplicationSet")
+					os.Exit(1)
+				}
+			}
+
+			if otlpAddress != "" {
+				closeTracer, err := trace.InitTracer(ctx, "argocd-applicationset-controller", otlpAddress, otlpInsecure, otlpHeaders, otlpAttrs)
+				if err != nil {
+					log.Fatalf("failed to initialize tracing: %v", err)
+				}
+				defer closeTracer()
+			}
+
 			stats.StartStatsTicker(10 * time.Minute)
 			log.Info("Starting manager")
 			if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -245,7 +460,7 @@ func NewCommand() *cobra.Command {
 		},
 	}
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
-	command.Flags().StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
+	command.Flags().StringVar(&metricsAddr, "metrics-addr", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_METRICS_ADDR", ":8080"), "The address the metric endpoint binds to.")
 	command.Flags().StringVar(&probeBindAddr, "probe-addr", ":8081", "The address the probe endpoint binds to.")
 	command.Flags().StringVar(&webhookAddr, "webhook-addr", ":7000", "The address the webhook endpoint binds to.")
 	command.Flags().BoolVar(&enableLeaderElection, "enable-leader-election", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_LEADER_ELECTION", false),
@@ -268,14 +483,79 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_REPO_SERVER_TIMEOUT_SECONDS", 60, 0, math.MaxInt64), "Repo server RPC call timeout seconds.")
 	command.Flags().IntVar(&maxConcurrentReconciliations, "concurrent-reconciliations", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CONCURRENT_RECONCILIATIONS", 10, 1, 100), "Max concurrent reconciliations limit for the controller")
+	command.Flags().IntVar(&repoConcurrencyLimit, "repo-concurrency-limit", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_REPO_CONCURRENCY_LIMIT", 0, 0, math.MaxInt32), "Max number of concurrent repo server requests per repository, the other requests wait in queue. 0 disables the limit")
 	command.Flags().StringVar(&scmRootCAPath, "scm-root-ca-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_ROOT_CA_PATH", ""), "Provide Root CA Path for self-signed TLS Certificates")
+	command.Flags().DurationVar(&scmCacheTTL, "scm-cache-ttl", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_CACHE_TTL", 0, 0, math.MaxInt64), "How long the responses of the SCM APIs are served from the cache without calling the API. 0 means the cached responses are always revalidated with a conditional request")
+	command.Flags().Float64Var(&scmRequestsPerSecond, "scm-requests-per-second", env.ParseFloat64FromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_REQUESTS_PER_SECOND", 0, 0, math.MaxFloat64), "Maximum number of requests per second sent to each SCM API host. 0 means no limit")
+	command.Flags().IntVar(&scmRequestsBurst, "scm-requests-burst", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_REQUESTS_BURST", 10, 1, math.MaxInt32), "Number of requests which may be sent at once to each SCM API host above --scm-requests-per-second")
 	command.Flags().StringSliceVar(&globalPreservedAnnotations, "preserved-annotations", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_ANNOTATIONS", []string{}, ","), "Sets global preserved field values for annotations")
 	command.Flags().StringSliceVar(&globalPreservedLabels, "preserved-labels", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_LABELS", []string{}, ","), "Sets global preserved field values for labels")
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
+	command.Flags().BoolVar(&webhookRequireAuthentication, "webhook-require-authentication", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_WEBHOOK_REQUIRE_AUTHENTICATION", false), "Reject webhook payloads from providers that have no secret configured in argocd-secret")
+	command.Flags().BoolVar(&enableServerSideApply, "enable-server-side-apply", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SERVER_SIDE_APPLY", false), "Write the generated Applications with server-side apply, preserving the fields owned by other field managers")
+	command.Flags().StringVar(&serverSideApplyFieldManager, "server-side-apply-field-manager", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SERVER_SIDE_APPLY_FIELD_MANAGER", common.ApplicationSetController), "Field manager used to write the generated Applications with server-side apply")
+	command.Flags().StringVar(&paramEnrichersConfigPath, "param-enrichers-config-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_PARAM_ENRICHERS_CONFIG_PATH", ""), "Path to the configuration of the HTTP enrichers of the parameters produced by the generators")
+	command.Flags().StringVar(&secretStoresConfigPath, "secret-stores-config-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SECRET_STORES_CONFIG_PATH", ""), "Path to the configuration of the external secret stores, such as HashiCorp Vault or AWS Secrets Manager, holding the credentials of the generators")
+	command.Flags().BoolVar(&clusterGeneratorStrict, "cluster-generator-strict", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CLUSTER_GENERATOR_STRICT", false), "Fail the cluster generator on a malformed cluster secret instead of skipping the secret with a warning event")
+	command.Flags().BoolVar(&awsAccountsConfig.AllowPodCredentials, "aws-accounts-allow-pod-credentials", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_AWS_ACCOUNTS_ALLOW_POD_CREDENTIALS", false), "Allow the AWS Accounts generators to list the accounts with the identity of the pod, such as IRSA, and to assume AWS roles with it")
+	command.Flags().BoolVar(&terraformStateConfig.AllowPodCredentials, "terraform-state-allow-pod-credentials", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TERRAFORM_STATE_ALLOW_POD_CREDENTIALS", false), "Allow the Terraform State generators to read the states with the identity of the pod, such as IRSA or Workload Identity, and to assume AWS roles with it")
+	command.Flags().BoolVar(&terraformStateConfig.AllowSensitiveOutputs, "terraform-state-allow-sensitive-outputs", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TERRAFORM_STATE_ALLOW_SENSITIVE_OUTPUTS", false), "Allow the Terraform State generators to include the sensitive outputs with includeSensitive")
+	command.Flags().BoolVar(&terraformStateConfig.AllowInsecure, "terraform-state-allow-insecure", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TERRAFORM_STATE_ALLOW_INSECURE", false), "Allow the http backends of the Terraform State generators to skip the verification of the TLS certificates")
+	command.Flags().StringSliceVar(&terraformStateConfig.AllowedURLs, "terraform-state-allowed-urls", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TERRAFORM_STATE_ALLOWED_URLS", []string{}, ","), "The glob patterns of the URLs of the http backends, S3 endpoints and Terraform Enterprise instances allowed in the Terraform State generators (Default: Empty = none)")
+	command.Flags().BoolVar(&strictGenerators, "strict-generators", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_STRICT_GENERATORS", false), fmt.Sprintf("Block the reconciliation of the ApplicationSets with unrecognized generators with an error condition instead of logging a warning. The %s annotation overrides it per ApplicationSet", common.AnnotationApplicationSetStrictGenerators))
+	command.Flags().DurationVar(&minRequeueAfter, "min-requeue-after", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_MIN_REQUEUE_AFTER", 0, 0, math.MaxInt64), "Minimum interval between the periodic reconciliations of an ApplicationSet requested by its generators, overriding the shorter requeueAfterSeconds of the generators to protect the SCM APIs. 0 means no minimum")
+	command.Flags().IntVar(&maxPendingCreations, "max-pending-creations-per-cluster", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_MAX_PENDING_CREATIONS_PER_CLUSTER", 0, 0, math.MaxInt32), "Maximum number of Applications of an ApplicationSet targeting the same destination cluster which are created but not yet reconciled by the application controller. The other creations are postponed. 0 means no limit")
+	command.Flags().BoolVar(&fullApplicationDiff, "full-application-diff", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_FULL_APPLICATION_DIFF", false), fmt.Sprintf("Compare every generated Application with its live state at each reconciliation, instead of skipping the Applications whose %s annotation is unchanged and which were not modified since they were last compared. For debugging", common.AnnotationApplicationSetSpecHash))
+	command.Flags().BoolVar(&disableLegacyTemplates, "disable-legacy-templates", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_DISABLE_LEGACY_TEMPLATES", false), "Block the reconciliation of the ApplicationSets which do not use Go templates with an error condition")
+	command.Flags().BoolVar(&namespaced, "namespaced", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_NAMESPACED", false), "Run the controller scoped to its own namespace: only the ApplicationSets, Applications and Secrets of that namespace are watched and no cluster-scoped permission is required")
+	command.Flags().StringVar(&controllerInstance, "controller-instance", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_INSTANCE", ""), fmt.Sprintf("Name of this controller instance: only the ApplicationSets with the %s label set to this name are reconciled. If empty, only the ApplicationSets without this label are reconciled", common.LabelKeyApplicationSetControllerInstance))
+	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
+	command.Flags().BoolVar(&otlpInsecure, "otlp-insecure", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_OTLP_INSECURE", true), "OpenTelemetry collector insecure mode")
+	command.Flags().StringToStringVar(&otlpHeaders, "otlp-headers", env.ParseStringToStringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_OTLP_HEADERS", map[string]string{}, ","), "List of OpenTelemetry collector extra headers sent with traces, headers are comma-separated key-value pairs(e.g. key1=value1,key2=value2)")
+	command.Flags().StringSliceVar(&otlpAttrs, "otlp-attrs", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_OTLP_ATTRS", []string{}, ","), "List of OpenTelemetry collector extra attrs when send traces, each attribute is separated by a colon(e.g. key:value)")
+	command.Flags().StringVar(&generatorServer, "generator-server", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATOR_SERVER", ""), "Address of the generator service running the Git, SCM provider, pull request and plugin generators. If empty, the generators run in the controller")
+	command.Flags().BoolVar(&generatorServerPlaintext, "generator-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATOR_SERVER_PLAINTEXT", false), "Disable TLS on connections to the generator service")
+	command.Flags().BoolVar(&generatorServerStrictTLS, "generator-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATOR_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the generator service")
+	command.Flags().BoolVar(&serveGenerators, "serve-generators", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SERVE_GENERATORS", false), "Run as the generator service called by the controllers configured with --generator-server, instead of reconciling the ApplicationSets")
+	command.Flags().StringVar(&generatorServerListenAddr, "generator-server-listen-addr", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATOR_SERVER_LISTEN_ADDR", ":8090"), "The address the generator service binds to when running with --serve-generators")
+	command.Flags().BoolVar(&generatorServerDisableTLS, "generator-server-disable-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATOR_SERVER_DISABLE_TLS", false), "Disable TLS on the gRPC endpoint of the generator service when running with --serve-generators")
 	command.Flags().StringSliceVar(&metricsAplicationsetLabels, "metrics-applicationset-labels", []string{}, "List of Application labels that will be added to the argocd_applicationset_labels metric")
+	command.Flags().BoolVar(&enableAdmissionWebhook, "enable-admission-webhook", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_ADMISSION_WEBHOOK", false), "Serve a validating admission webhook rejecting the invalid ApplicationSets on creation and update")
+	command.Flags().BoolVar(&enableApplicationLookup, "enable-application-lookup", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_APPLICATION_LOOKUP", false), "Make the lookupApplication template function available, returning the fields of an existing Application of the namespace of the ApplicationSet")
+	command.Flags().IntVar(&admissionWebhookPort, "admission-webhook-port", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ADMISSION_WEBHOOK_PORT", ctrlwebhook.DefaultPort, 1, math.MaxUint16), "The port the admission webhook binds to")
+	command.Flags().StringVar(&admissionWebhookCertDir, "admission-webhook-cert-dir", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ADMISSION_WEBHOOK_CERT_DIR", "/app/config/admission-webhook/tls"), "The directory holding the tls.crt and tls.key files served by the admission webhook")
+	cacheSrc = appsetcache.AddCacheFlagsToCmd(&command)
+	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	return &command
 }
 
+// leaderElectionID returns the leader election ID of the given controller instance, so that the replicas of each
+// instance elect their own leader
+func leaderElectionID(controllerInstance string) string {
+	if controllerInstance == "" {
+		return "58ac56fa.applicationsets.argoproj.io"
+	}
+	return controllerInstance + ".58ac56fa.applicationsets.argoproj.io"
+}
+
+// newGeneratorServerRunnable returns a runnable serving the generator service on the given address until the manager
+// stops.
+func newGeneratorServerRunnable(server *generatorserver.Server, listenAddr string) manager.RunnableFunc {
+	return func(ctx context.Context) error {
+		listener, err := net.Listen("tcp", listenAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", listenAddr, err)
+		}
+		grpcServer := server.CreateGRPC()
+		go func() {
+			<-ctx.Done()
+			grpcServer.GracefulStop()
+		}()
+		log.Infof("Starting generator server %s", listenAddr)
+		return grpcServer.Serve(listener)
+	}
+}
+
 func startWebhookServer(webhookHandler *webhook.WebhookHandler, webhookAddr string) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/api/webhook", webhookHandler.Handler)
@@ -95,6 +95,8 @@ func NewCommand() *cobra.Command {
 		scmRootCAPath            string
 		allowedScmProviders      []string
 		enableScmProviders       bool
+		appsetGenerateRateLimit  int
+		appsetGenerateCacheExp   time.Duration
 
 		// argocd k8s event logging flag
 		enableK8sEvent []string
@@ -255,6 +257,8 @@ func NewCommand() *cobra.Command {
 				ScmRootCAPath:            scmRootCAPath,
 				AllowedScmProviders:      allowedScmProviders,
 				EnableScmProviders:       enableScmProviders,
+				GenerateRateLimit:        appsetGenerateRateLimit,
+				GenerateCacheExpiration:  appsetGenerateCacheExp,
 			}
 
 			stats.RegisterStackDumper()
@@ -333,6 +337,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&enableScmProviders, "appset-enable-scm-providers", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDERS", true), "Enable retrieving information from SCM providers, used by the SCM and PR generators (Default: true)")
 	command.Flags().StringSliceVar(&allowedScmProviders, "appset-allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
 	command.Flags().BoolVar(&enableNewGitFileGlobbing, "appset-enable-new-git-file-globbing", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_NEW_GIT_FILE_GLOBBING", false), "Enable new globbing in Git files generator.")
+	command.Flags().IntVar(&appsetGenerateRateLimit, "appset-generate-rate-limit", env.ParseNumFromEnv("ARGOCD_SERVER_APPSET_GENERATE_RATE_LIMIT", 0, 0, math.MaxInt32), "Maximum number of ApplicationSet generate and dry-run requests per minute per user. 0 disables the limit")
+	command.Flags().DurationVar(&appsetGenerateCacheExp, "appset-generate-cache-expiration", env.ParseDurationFromEnv("ARGOCD_SERVER_APPSET_GENERATE_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Expiration of the Applications generated for a submitted ApplicationSet by the generate and dry-run requests. 0 disables the cache")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, cacheutil.Options{
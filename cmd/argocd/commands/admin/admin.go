@@ -74,6 +74,7 @@ $ argocd admin initial-password reset
 	command.AddCommand(NewProjectsCommand())
 	command.AddCommand(NewSettingsCommand())
 	command.AddCommand(NewAppCommand(clientOpts))
+	command.AddCommand(NewAppSetCommand())
 	command.AddCommand(NewRepoCommand())
 	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewExportCommand())
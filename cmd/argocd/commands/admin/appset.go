@@ -0,0 +1,198 @@
+package admin
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+)
+
+var (
+	// legacyPlaceholderRegex matches the placeholders of the legacy fasttemplate syntax, such as {{ path.basename }}
+	legacyPlaceholderRegex = regexp.MustCompile(`{{([^{}]*)}}`)
+	// legacyParamNameRegex matches the parameter names which may be used as placeholders of the legacy syntax
+	legacyParamNameRegex = regexp.MustCompile(`^[^\s.|(){}"'][^\s|(){}"']*$`)
+	// goTemplateFieldRegex matches the names which may be used as fields in Go templates
+	goTemplateFieldRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// segmentParamRegex matches the legacy parameters of the Git generators holding a segment of a path, such as path[0]
+	segmentParamRegex = regexp.MustCompile(`^(.+)\[([0-9]+)\]$`)
+)
+
+// NewAppSetCommand returns a new instance of an `argocd admin appset` command
+func NewAppSetCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "appset",
+		Short: "Manage ApplicationSets configuration",
+		Example: `
+# Rewrite the ApplicationSets of a file from the legacy template syntax to Go templates
+argocd admin appset migrate-template -f appset.yaml
+`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+
+	command.AddCommand(NewMigrateAppSetTemplateCommand())
+	return command
+}
+
+// NewMigrateAppSetTemplateCommand returns a new instance of an `argocd admin appset migrate-template` command
+func NewMigrateAppSetTemplateCommand() *cobra.Command {
+	var file string
+	command := &cobra.Command{
+		Use:   "migrate-template",
+		Short: "Rewrite ApplicationSets from the legacy template syntax to Go templates",
+		Long: "Rewrite the ApplicationSets of a file which do not use Go templates: goTemplate is enabled and the placeholders " +
+			"of the legacy syntax, such as {{path.basename}}, are rewritten to their Go template equivalent, such as " +
+			"{{.path.basename}}. The rewritten ApplicationSets are printed, along with warnings on the standard error for " +
+			"the placeholders which could not be rewritten.",
+		Example: `
+# Rewrite the ApplicationSets of a file
+argocd admin appset migrate-template -f appset.yaml > appset-migrated.yaml
+`,
+		Run: func(c *cobra.Command, args []string) {
+			if file == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			data, err := os.ReadFile(file)
+			errors.CheckError(err)
+			objs, err := kube.SplitYAML(data)
+			errors.CheckError(err)
+
+			var docs []string
+			for _, obj := range objs {
+				if obj.GetKind() == application.ApplicationSetKind {
+					warnings, err := migrateAppSetTemplate(obj)
+					errors.CheckError(err)
+					for _, warning := range warnings {
+						_, _ = fmt.Fprintf(os.Stderr, "WARNING: ApplicationSet '%s': %s\n", obj.GetName(), warning)
+					}
+				}
+				out, err := yaml.Marshal(obj.Object)
+				errors.CheckError(err)
+				docs = append(docs, string(out))
+			}
+			fmt.Print(strings.Join(docs, "---\n"))
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "", "File containing the ApplicationSets")
+	return command
+}
+
+// migrateAppSetTemplate rewrites the ApplicationSet from the legacy template syntax to Go templates, and returns
+// warnings about the placeholders which could not be rewritten. ApplicationSets which already use Go templates are left
+// untouched.
+func migrateAppSetTemplate(obj *unstructured.Unstructured) ([]string, error) {
+	spec, ok := obj.Object["spec"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("ApplicationSet '%s' has no spec", obj.GetName())
+	}
+	if goTemplate, _ := spec["goTemplate"].(bool); goTemplate {
+		return nil, nil
+	}
+
+	m := &templateMigrator{pathParams: map[string]bool{}}
+	m.collectGitPathParams(spec["generators"])
+	m.migrate(spec)
+	spec["goTemplate"] = true
+	return m.warnings, nil
+}
+
+// templateMigrator rewrites the placeholders of the legacy template syntax to Go templates.
+type templateMigrator struct {
+	// pathParams are the names of the path parameters of the Git generators, which are strings with the legacy syntax
+	// but objects with Go templates.
+	pathParams map[string]bool
+	warnings   []string
+}
+
+// collectGitPathParams collects the names of the path parameters of the Git generators, including the nested ones.
+func (m *templateMigrator) collectGitPathParams(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if git, ok := v["git"].(map[string]any); ok {
+			name := "path"
+			if prefix, _ := git["pathParamPrefix"].(string); prefix != "" {
+				name = prefix + "." + name
+			}
+			m.pathParams[name] = true
+		}
+		for _, element := range v {
+			m.collectGitPathParams(element)
+		}
+	case []any:
+		for _, element := range v {
+			m.collectGitPathParams(element)
+		}
+	}
+}
+
+// migrate rewrites the legacy placeholders of the strings of the value, recursing into its maps and slices.
+func (m *templateMigrator) migrate(value any) any {
+	switch v := value.(type) {
+	case string:
+		return legacyPlaceholderRegex.ReplaceAllStringFunc(v, func(placeholder string) string {
+			name := strings.TrimSpace(placeholder[2 : len(placeholder)-2])
+			if !legacyParamNameRegex.MatchString(name) {
+				m.warnings = append(m.warnings, fmt.Sprintf("placeholder %s left as is", placeholder))
+				return placeholder
+			}
+			return "{{" + m.goTemplateExpression(name) + "}}"
+		})
+	case map[string]any:
+		// The keys are sorted for the warnings to be reported in a stable order
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			v[key] = m.migrate(v[key])
+		}
+		return v
+	case []any:
+		for i, element := range v {
+			v[i] = m.migrate(element)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// goTemplateExpression returns the Go template expression of a parameter of the legacy syntax. The parameters holding
+// labels and annotations, whose keys may contain dots, and the segments of the paths of the Git generators, which are
+// lists with Go templates, are looked up with index.
+func (m *templateMigrator) goTemplateExpression(name string) string {
+	if m.pathParams[name] {
+		return "." + name + ".path"
+	}
+	if matches := segmentParamRegex.FindStringSubmatch(name); matches != nil && m.pathParams[matches[1]] {
+		index, _ := strconv.Atoi(matches[2])
+		return fmt.Sprintf("index .%s.segments %d", matches[1], index)
+	}
+	for _, prefix := range []string{"metadata.labels.", "metadata.annotations."} {
+		if key, ok := strings.CutPrefix(name, prefix); ok {
+			return fmt.Sprintf("index .%s %s", strings.TrimSuffix(prefix, "."), strconv.Quote(key))
+		}
+	}
+
+	fields := strings.Split(name, ".")
+	for _, field := range fields {
+		if !goTemplateFieldRegex.MatchString(field) {
+			quoted := make([]string, len(fields))
+			for i, f := range fields {
+				quoted[i] = strconv.Quote(f)
+			}
+			return "index . " + strings.Join(quoted, " ")
+		}
+	}
+	return "." + name
+}
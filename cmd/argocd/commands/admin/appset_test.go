@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMigrateAppSetTemplate(t *testing.T) {
+	objs, err := kube.SplitYAML([]byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  name: guestbook
+spec:
+  generators:
+  - matrix:
+      generators:
+      - clusters:
+          selector:
+            matchLabels:
+              env: '{{ metadata.labels.env }}'
+      - git:
+          repoURL: https://github.com/argoproj/argocd-example-apps
+          pathParamPrefix: app
+          directories:
+          - path: '*'
+  template:
+    metadata:
+      name: '{{name}}-{{app.path.basename}}'
+      labels:
+        cluster: '{{metadata.labels.kubernetes.io/cluster}}'
+    spec:
+      project: default
+      source:
+        repoURL: https://github.com/argoproj/argocd-example-apps
+        path: '{{app.path}}'
+        chart: '{{app.path[1]}}'
+        helm:
+          valueFiles:
+          - '{{values.env-name}}.yaml'
+          - '{{ }}.yaml'
+      destination:
+        server: '{{server}}'
+        namespace: '{{ .already.go }}'
+`))
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+
+	warnings, err := migrateAppSetTemplate(objs[0])
+	require.NoError(t, err)
+	assert.Equal(t, []string{"placeholder {{ .already.go }} left as is", "placeholder {{ }} left as is"}, warnings)
+
+	spec := objs[0].Object["spec"].(map[string]any)
+	assert.Equal(t, true, spec["goTemplate"])
+	get := func(fields ...string) string {
+		value, _, err := unstructured.NestedString(spec, fields...)
+		require.NoError(t, err)
+		return value
+	}
+	assert.Equal(t, "{{.name}}-{{.app.path.basename}}", get("template", "metadata", "name"))
+	assert.Equal(t, `{{index .metadata.labels "kubernetes.io/cluster"}}`, get("template", "metadata", "labels", "cluster"))
+	assert.Equal(t, "{{.app.path.path}}", get("template", "spec", "source", "path"))
+	assert.Equal(t, "{{index .app.path.segments 1}}", get("template", "spec", "source", "chart"))
+	assert.Equal(t, "{{.server}}", get("template", "spec", "destination", "server"))
+	assert.Equal(t, "{{ .already.go }}", get("template", "spec", "destination", "namespace"))
+	valueFiles, _, _ := unstructured.NestedStringSlice(spec, "template", "spec", "source", "helm", "valueFiles")
+	assert.Equal(t, []string{`{{index . "values" "env-name"}}.yaml`, "{{ }}.yaml"}, valueFiles)
+	generators := spec["generators"].([]any)
+	matrixGenerators, _, _ := unstructured.NestedSlice(generators[0].(map[string]any), "matrix", "generators")
+	labels, _, _ := unstructured.NestedStringMap(matrixGenerators[0].(map[string]any), "clusters", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{"env": `{{index .metadata.labels "env"}}`}, labels)
+}
+
+func TestMigrateAppSetTemplateGoTemplate(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "ApplicationSet",
+		"metadata": map[string]any{"name": "guestbook"},
+		"spec": map[string]any{
+			"goTemplate": true,
+			"template":   map[string]any{"metadata": map[string]any{"name": "{{name}}"}},
+		},
+	}}
+
+	warnings, err := migrateAppSetTemplate(obj)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "template", "metadata", "name")
+	assert.Equal(t, "{{name}}", name)
+}
+
+func TestMigrateAppSetTemplateNoSpec(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "ApplicationSet",
+		"metadata": map[string]any{"name": "guestbook"},
+	}}
+
+	_, err := migrateAppSetTemplate(obj)
+	require.EqualError(t, err, "ApplicationSet 'guestbook' has no spec")
+}
@@ -1,24 +1,39 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 
+	appsettemplate "github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
+	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/admin"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
 	argoio "github.com/argoproj/argo-cd/v3/util/io"
@@ -37,6 +52,21 @@ var appSetExample = templates.Examples(`
 
 	# Delete an ApplicationSet
 	argocd appset delete APPSETNAME (APPSETNAME...)
+
+	# Validate the Applications generated by an ApplicationSet against their destination clusters
+	argocd appset validate APPSETNAME --server-side
+
+	# Render the template of an ApplicationSet locally against the given parameters
+	argocd appset template -f appset.yaml --params params.json
+
+	# Print the JSON Schema of the ApplicationSet resource
+	argocd appset print-schema
+
+	# Suggest an ApplicationSet generating existing Applications
+	argocd appset convert APPNAME (APPNAME...) --name APPSETNAME
+
+	# Remove an annotation from the template of an ApplicationSet
+	argocd appset unset APPSETNAME --remove-template-annotation ANNOTATION
 	`)
 
 // NewAppSetCommand returns a new instance of an `argocd appset` command
@@ -55,14 +85,29 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetValidateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetParamsCommand(clientOpts))
+	command.AddCommand(NewApplicationSetMetricsCommand(clientOpts))
+	command.AddCommand(NewApplicationSetTemplateCommand())
+	command.AddCommand(NewApplicationSetPrintSchemaCommand())
+	command.AddCommand(NewApplicationSetConvertCommand(clientOpts))
+	command.AddCommand(NewApplicationSetUnsetCommand(clientOpts))
+	command.AddCommand(NewApplicationSetAnnotateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetLabelCommand(clientOpts))
+	command.AddCommand(NewApplicationSetStatusCommand(clientOpts))
+	command.AddCommand(NewApplicationSetExportCommand(clientOpts))
+	command.AddCommand(NewApplicationSetCreatePRCommand(clientOpts))
 	return command
 }
 
 // NewApplicationSetGetCommand returns a new instance of an `argocd appset get` command
 func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output     string
-		showParams bool
+		output          string
+		showParams      bool
+		showConditions  bool
+		showApps        bool
+		appSetNamespace string
 	)
 	command := &cobra.Command{
 		Use:   "get APPSETNAME",
@@ -70,6 +115,15 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		Example: templates.Examples(`
 	# Get ApplicationSets
 	argocd appset get APPSETNAME
+
+	# Get the conditions of an ApplicationSet, with their reason, age and message
+	argocd appset get APPSETNAME --show-conditions
+
+	# Get the conditions of an ApplicationSet as JSON
+	argocd appset get APPSETNAME --show-conditions -o json
+
+	# Get the Applications of an ApplicationSet with their sync and health status
+	argocd appset get APPSETNAME --show-applications
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -82,24 +136,55 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			conn, appIf := acdClient.NewApplicationSetClientOrDie()
 			defer argoio.Close(conn)
 
-			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
 
 			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
 			errors.CheckError(err)
+			printAppSetDeprecationWarnings(c, appSet)
+
+			var apps appSetApplications
+			if showApps {
+				appConn, appClient := acdClient.NewApplicationClientOrDie()
+				defer argoio.Close(appConn)
+				list, err := appClient.List(ctx, &applicationpkg.ApplicationQuery{AppNamespace: &appSet.Namespace})
+				errors.CheckError(err)
+				apps = newAppSetApplications(appSet, list.Items)
+			}
 
 			switch output {
 			case "yaml", "json":
+				if showApps {
+					err := PrintResource(apps, output)
+					errors.CheckError(err)
+					return
+				}
+				if showConditions {
+					err := PrintResourceList(appSet.Status.Conditions, output, false)
+					errors.CheckError(err)
+					return
+				}
 				err := PrintResource(appSet, output)
 				errors.CheckError(err)
 			case "wide", "":
 				printAppSetSummaryTable(appSet)
-				if len(appSet.Status.Conditions) > 0 {
+				fmt.Printf(printOpFmtStr, "Conditions:", formatAppSetConditionTypes(appSet.Status.Conditions))
+				if showConditions && len(appSet.Status.Conditions) > 0 {
 					fmt.Println()
 					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-					printAppSetConditions(w, appSet)
+					printAppSetConditions(w, appSet, time.Now())
 					_ = w.Flush()
 					fmt.Println()
 				}
+				if showApps {
+					fmt.Printf(printOpFmtStr, "Applications:", formatAppSetApplicationsSummary(apps.Summary))
+					if len(apps.Applications) > 0 {
+						fmt.Println()
+						w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+						printAppSetApplications(w, apps.Applications)
+						_ = w.Flush()
+						fmt.Println()
+					}
+				}
 				if showParams {
 					printHelmParams(appSet.Spec.Template.Spec.GetSource().Helm)
 				}
@@ -110,13 +195,17 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().BoolVar(&showParams, "show-params", false, "Show ApplicationSet parameters and overrides")
+	command.Flags().BoolVar(&showConditions, "show-conditions", false, "Show the conditions of the ApplicationSet with their reason, age and message. Only the conditions are printed with the json and yaml output formats")
+	command.Flags().BoolVar(&showApps, "show-applications", false, "Show the Applications of the ApplicationSet with their sync and health status, and a summary of their statuses. Only the Applications and the summary are printed with the json and yaml output formats")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only get ApplicationSet from namespace")
 	return command
 }
 
 // NewApplicationSetCreateCommand returns a new instance of an `argocd appset create` command
 func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
-	var upsert, dryRun bool
+	var upsert, dryRun, previewCount, noPrompt, showApplications bool
+	var appSetNamespace string
 	command := &cobra.Command{
 		Use:   "create",
 		Short: "Create one or more ApplicationSets",
@@ -125,7 +214,13 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 	argocd appset create <filename or URL> (<filename or URL>...)
 
 	# Dry-run AppSet creation to see what applications would be managed
-	argocd appset create --dry-run <filename or URL> -o json | jq -r '.status.resources[].name' 
+	argocd appset create --dry-run <filename or URL> -o json | jq -r '.status.resources[].name'
+
+	# Dry-run AppSet creation and print the applications that would be generated, with their destinations and sources
+	argocd appset create --dry-run --show-applications <filename or URL>
+
+	# Print the number of Applications the ApplicationSets would create, update and delete, and ask for confirmation
+	argocd appset create --upsert --preview-count <filename or URL>
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -134,6 +229,9 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if showApplications && !dryRun {
+				errors.Fatal(errors.ErrorGeneric, "--show-applications can only be used with --dry-run")
+			}
 			argocdClient := headless.NewClientOrDie(clientOpts, c)
 			fileURL := args[0]
 			appsets, err := cmdutil.ConstructApplicationSet(fileURL)
@@ -144,10 +242,16 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 				os.Exit(1)
 			}
 
+			isTerminal := isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+			promptUtil := utils.NewPrompt(isTerminal && !noPrompt && !dryRun)
+
 			for _, appset := range appsets {
 				if appset.Name == "" {
 					errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Error creating ApplicationSet %s. ApplicationSet does not have Name field set", appset))
 				}
+				if appSetNamespace != "" {
+					appset.Namespace = appSetNamespace
+				}
 
 				conn, appIf := argocdClient.NewApplicationSetClientOrDie()
 				defer argoio.Close(conn)
@@ -158,6 +262,31 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 					errors.CheckError(err)
 				}
 
+				var generated []*arogappsetv1.Application
+				if previewCount || showApplications {
+					resp, err := appIf.Generate(ctx, &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+					errors.CheckError(err)
+					generated = resp.Applications
+				}
+
+				if previewCount {
+					// The live Applications tell the generated Applications which would actually be updated
+					var live []arogappsetv1.Application
+					if existing != nil {
+						appConn, appClient := argocdClient.NewApplicationClientOrDie()
+						list, err := appClient.List(ctx, &applicationpkg.ApplicationQuery{AppNamespace: &existing.Namespace})
+						argoio.Close(appConn)
+						errors.CheckError(err)
+						live = list.Items
+					}
+					preview := previewAppSetChanges(appset, existing, generated, live)
+					c.PrintErrf("ApplicationSet '%s' would create %d, update %d and delete %d Applications\n", appset.Name, preview.create, preview.update, preview.delete)
+					if !promptUtil.Confirm(fmt.Sprintf("Are you sure you want to apply ApplicationSet '%s'? [y/n] ", appset.Name)) {
+						fmt.Printf("The command to apply ApplicationSet '%s' was cancelled.\n", appset.Name)
+						continue
+					}
+				}
+
 				appSetCreateRequest := applicationset.ApplicationSetCreateRequest{
 					Applicationset: appset,
 					Upsert:         upsert,
@@ -182,6 +311,12 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 				}
 
 				c.PrintErrf("ApplicationSet '%s' %s%s\n", created.Name, action, dryRunMsg)
+				printAppSetDeprecationWarnings(c, created)
+
+				if showApplications {
+					printGeneratedApplications(generated, output)
+					continue
+				}
 
 				switch output {
 				case "yaml", "json":
@@ -193,7 +328,7 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 					if len(created.Status.Conditions) > 0 {
 						fmt.Println()
 						w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-						printAppSetConditions(w, created)
+						printAppSetConditions(w, created, time.Now())
 						_ = w.Flush()
 						fmt.Println()
 					}
@@ -206,12 +341,271 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override ApplicationSet with the same name even if supplied ApplicationSet spec is different from existing spec")
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Allows to evaluate the ApplicationSet template on the server to get a preview of the applications that would be created")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&previewCount, "preview-count", false, "Print the number of Applications the ApplicationSets would create, update and delete, and ask for confirmation before applying them")
+	command.Flags().BoolVarP(&noPrompt, "yes", "y", false, "Turn off prompting to confirm the changes printed by --preview-count")
+	command.Flags().BoolVar(&showApplications, "show-applications", false, "With --dry-run, print the applications the ApplicationSet would generate, in the --output format, instead of the ApplicationSet")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Namespace where the ApplicationSets will be created in, overriding the namespace of their manifests")
 	return command
 }
 
+// appSetPreview is the number of Applications an ApplicationSet would create, update and delete.
+type appSetPreview struct {
+	create int
+	update int
+	delete int
+}
+
+// previewAppSetChanges compares the Applications generated for the given ApplicationSet to the Applications managed by
+// its existing version, if any, honoring the applications sync policy of the ApplicationSet. A managed Application is
+// only counted as updated when its spec, labels or annotations differ from the live ones, like the controller compares
+// them. The managed Applications missing from the live ones are counted as updated.
+func previewAppSetChanges(appset, existing *arogappsetv1.ApplicationSet, generated []*arogappsetv1.Application, live []arogappsetv1.Application) appSetPreview {
+	managed := map[string]bool{}
+	if existing != nil {
+		for _, resource := range existing.Status.Resources {
+			managed[resource.Name] = true
+		}
+	}
+
+	allowUpdate, allowDelete := true, true
+	if appset.Spec.SyncPolicy != nil && appset.Spec.SyncPolicy.ApplicationsSync != nil {
+		allowUpdate = appset.Spec.SyncPolicy.ApplicationsSync.AllowUpdate()
+		allowDelete = appset.Spec.SyncPolicy.ApplicationsSync.AllowDelete()
+	}
+
+	liveApps := make(map[string]*arogappsetv1.Application, len(live))
+	for i := range live {
+		liveApps[live[i].Name] = &live[i]
+	}
+
+	preview := appSetPreview{}
+	for _, app := range generated {
+		if !managed[app.Name] {
+			preview.create++
+			continue
+		}
+		delete(managed, app.Name)
+		if allowUpdate && isAppSetApplicationChanged(appset, liveApps[app.Name], app) {
+			preview.update++
+		}
+	}
+	if allowDelete {
+		preview.delete = len(managed)
+	}
+	return preview
+}
+
+// isAppSetApplicationChanged returns whether the generated Application would update the live one, if any. The
+// preserved labels and annotations of the ApplicationSet are not compared, nor are the fields matched by its
+// ignoreApplicationDifferences rules.
+func isAppSetApplicationChanged(appset *arogappsetv1.ApplicationSet, live, generated *arogappsetv1.Application) bool {
+	if live == nil {
+		return true
+	}
+	preservedAnnotations := []string{arogappsetv1.AnnotationKeyRefresh}
+	var preservedLabels []string
+	if appset.Spec.PreservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, appset.Spec.PreservedFields.Annotations...)
+		preservedLabels = appset.Spec.PreservedFields.Labels
+	}
+	changed, err := appsetutils.IsApplicationDrifted(appset.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, live, generated, preservedAnnotations, preservedLabels)
+	return err != nil || changed
+}
+
+// NewApplicationSetUnsetCommand returns a new instance of an `argocd appset unset` command
+func NewApplicationSetUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var templateAnnotations, templateLabels []string
+	var templatePatch bool
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:   "unset APPSETNAME",
+		Short: "Unset ApplicationSet template fields",
+		Example: templates.Examples(`
+	# Remove an annotation from the template of the Applications
+	argocd appset unset APPSETNAME --remove-template-annotation notifications.argoproj.io/subscribe.on-sync-failed.slack
+
+	# Remove labels and the template patch
+	argocd appset unset APPSETNAME --remove-template-label team --remove-template-label env --template-patch
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			existing, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			patch := unsetAppSetPatch(existing, templateAnnotations, templateLabels, templatePatch)
+			if len(patch) == 0 {
+				fmt.Printf("ApplicationSet '%s' unchanged\n", args[0])
+				return
+			}
+			updated, err := applyAppSetPatch(existing, patch)
+			errors.CheckError(err)
+
+			live, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+			errors.CheckError(err)
+			target, err := runtime.DefaultUnstructuredConverter.ToUnstructured(updated)
+			errors.CheckError(err)
+			_ = cli.PrintDiff(appSetName, &unstructured.Unstructured{Object: live}, &unstructured.Unstructured{Object: target})
+
+			// Only the removals are sent, so that the changes made since the ApplicationSet was read are kept
+			patchData, err := json.Marshal(patch)
+			errors.CheckError(err)
+			_, err = appIf.Patch(ctx, &applicationset.ApplicationSetPatchRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Patch:           string(patchData),
+				PatchType:       "json",
+			})
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' updated\n", args[0])
+		},
+	}
+	command.Flags().StringArrayVar(&templateAnnotations, "remove-template-annotation", []string{}, "Remove an annotation from the template of the Applications")
+	command.Flags().StringArrayVar(&templateLabels, "remove-template-label", []string{}, "Remove a label from the template of the Applications")
+	command.Flags().BoolVar(&templatePatch, "template-patch", false, "Remove the template patch")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Unset ApplicationSet template fields in namespace")
+	return command
+}
+
+// unsetAppSetPatch returns the JSON patch removing the given template annotations and labels, and the template patch
+// if requested, from the ApplicationSet. Fields which are not set are skipped.
+func unsetAppSetPatch(appset *arogappsetv1.ApplicationSet, templateAnnotations, templateLabels []string, templatePatch bool) []map[string]string {
+	var patch []map[string]string
+	for _, key := range templateAnnotations {
+		if _, ok := appset.Spec.Template.Annotations[key]; ok {
+			patch = append(patch, map[string]string{"op": "remove", "path": "/spec/template/metadata/annotations/" + escapeJSONPointer(key)})
+		}
+	}
+	for _, key := range templateLabels {
+		if _, ok := appset.Spec.Template.Labels[key]; ok {
+			patch = append(patch, map[string]string{"op": "remove", "path": "/spec/template/metadata/labels/" + escapeJSONPointer(key)})
+		}
+	}
+	if templatePatch && appset.Spec.TemplatePatch != nil {
+		patch = append(patch, map[string]string{"op": "remove", "path": "/spec/templatePatch"})
+	}
+	return patch
+}
+
+// escapeJSONPointer escapes a key to be used as a reference token of a JSON pointer, as defined by RFC 6901.
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// applyAppSetPatch returns a copy of the ApplicationSet with the given JSON patch applied.
+func applyAppSetPatch(appset *arogappsetv1.ApplicationSet, patch []map[string]string) (*arogappsetv1.ApplicationSet, error) {
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling patch: %w", err)
+	}
+	jsonPatch, err := jsonpatch.DecodePatch(patchData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding patch: %w", err)
+	}
+	appsetData, err := json.Marshal(appset)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet: %w", err)
+	}
+	patchedData, err := jsonPatch.Apply(appsetData)
+	if err != nil {
+		return nil, fmt.Errorf("error applying patch: %w", err)
+	}
+	var patched arogappsetv1.ApplicationSet
+	if err := json.Unmarshal(patchedData, &patched); err != nil {
+		return nil, fmt.Errorf("error unmarshaling patched ApplicationSet: %w", err)
+	}
+	return &patched, nil
+}
+
+// NewApplicationSetAnnotateCommand returns a new instance of an `argocd appset annotate` command
+func NewApplicationSetAnnotateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newApplicationSetMetadataCommand(clientOpts, "annotate", "annotations", templates.Examples(`
+	# Refresh an ApplicationSet
+	argocd appset annotate APPSETNAME argocd.argoproj.io/application-set-refresh=true
+
+	# Enable the debug mode of an ApplicationSet, and remove an annotation
+	argocd appset annotate APPSETNAME argocd.argoproj.io/application-set-debug=true team-
+		`))
+}
+
+// NewApplicationSetLabelCommand returns a new instance of an `argocd appset label` command
+func NewApplicationSetLabelCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newApplicationSetMetadataCommand(clientOpts, "label", "labels", templates.Examples(`
+	# Assign an ApplicationSet to a controller instance
+	argocd appset label APPSETNAME applicationset.argoproj.io/controller-instance=shard-1
+
+	# Set a label, and remove another one
+	argocd appset label APPSETNAME env=prod team-
+		`))
+}
+
+// newApplicationSetMetadataCommand returns a command setting, with KEY=VALUE arguments, and removing, with KEY-
+// arguments, the annotations or the labels of an ApplicationSet.
+func newApplicationSetMetadataCommand(clientOpts *argocdclient.ClientOptions, use string, field string, example string) *cobra.Command {
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:     use + " APPSETNAME KEY=VALUE... [KEY-...]",
+		Short:   fmt.Sprintf("Set or remove %s of an ApplicationSet", field),
+		Example: example,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) < 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			set, remove, err := parseMetadataArgs(args[1:])
+			errors.CheckError(err)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			req := &applicationset.ApplicationSetMetadataRequest{Name: appSetName, AppsetNamespace: appSetNs}
+			if field == "annotations" {
+				req.Annotations, req.RemoveAnnotations = set, remove
+			} else {
+				req.Labels, req.RemoveLabels = set, remove
+			}
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+			_, err = appIf.UpdateMetadata(ctx, req)
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' updated\n", args[0])
+		},
+	}
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", fmt.Sprintf("Only set or remove %s of ApplicationSet from namespace", field))
+	return command
+}
+
+// parseMetadataArgs parses the KEY=VALUE arguments setting annotations or labels, and the KEY- arguments removing them.
+func parseMetadataArgs(args []string) (map[string]string, []string, error) {
+	set := map[string]string{}
+	var remove []string
+	for _, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok {
+			if key == "" {
+				return nil, nil, fmt.Errorf("invalid argument %q: the key is empty", arg)
+			}
+			set[key] = value
+		} else if key, ok := strings.CutSuffix(arg, "-"); ok && key != "" {
+			remove = append(remove, key)
+		} else {
+			return nil, nil, fmt.Errorf("invalid argument %q: expected KEY=VALUE or KEY-", arg)
+		}
+	}
+	return set, remove, nil
+}
+
 // NewApplicationSetGenerateCommand returns a new instance of an `argocd appset generate` command
 func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var output, appSetNamespace string
 	command := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate apps of ApplicationSet rendered templates",
@@ -239,6 +633,9 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 			if appset.Name == "" {
 				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Error generating apps for ApplicationSet %s. ApplicationSet does not have Name field set", appset))
 			}
+			if appSetNamespace != "" {
+				appset.Namespace = appSetNamespace
+			}
 
 			conn, appIf := argocdClient.NewApplicationSetClientOrDie()
 			defer argoio.Close(conn)
@@ -249,31 +646,249 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 			resp, err := appIf.Generate(ctx, &req)
 			errors.CheckError(err)
 
-			var appsList []arogappsetv1.Application
-			for i := range resp.Applications {
-				appsList = append(appsList, *resp.Applications[i])
+			printGeneratedApplications(resp.Applications, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Namespace of the ApplicationSet, overriding the namespace of its manifest")
+	return command
+}
+
+// printGeneratedApplications prints the Applications generated by an ApplicationSet as a table, or as a list of
+// resources with the yaml and json output formats.
+func printGeneratedApplications(apps []*arogappsetv1.Application, output string) {
+	var appsList []arogappsetv1.Application
+	for i := range apps {
+		appsList = append(appsList, *apps[i])
+	}
+
+	switch output {
+	case "yaml", "json":
+		var resources []any
+		for i := range appsList {
+			app := appsList[i]
+			// backfill api version and kind because k8s client always return empty values for these fields
+			app.APIVersion = arogappsetv1.ApplicationSchemaGroupVersionKind.GroupVersion().String()
+			app.Kind = arogappsetv1.ApplicationSchemaGroupVersionKind.Kind
+			resources = append(resources, app)
+		}
+
+		cobra.CheckErr(admin.PrintResources(output, os.Stdout, resources...))
+	case "wide", "":
+		printApplicationTable(appsList, &output)
+	default:
+		errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+	}
+}
+
+// NewApplicationSetValidateCommand returns a new instance of an `argocd appset validate` command
+func NewApplicationSetValidateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		serverSide      bool
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "validate APPSETNAME",
+		Short: "Validate the Applications generated by an ApplicationSet",
+		Example: templates.Examples(`
+	# Validate the Applications generated by an ApplicationSet against their projects and sources
+	argocd appset validate APPSETNAME
+
+	# Also dry-run apply the manifests of every generated Application to its destination cluster
+	argocd appset validate APPSETNAME --server-side
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			resp, err := appIf.Validate(ctx, &applicationset.ApplicationSetValidateQuery{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				ServerSide:      serverSide,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(resp, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetValidationResults(resp.Results)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+
+			for _, result := range resp.Results {
+				if len(result.Conditions) > 0 {
+					os.Exit(1)
+				}
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&serverSide, "server-side", false, "Dry-run apply the manifests of every generated Application to its destination cluster")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only validate ApplicationSet from namespace")
+	return command
+}
+
+// NewApplicationSetTemplateCommand returns a new instance of an `argocd appset template` command
+func NewApplicationSetTemplateCommand() *cobra.Command {
+	var (
+		file       string
+		paramsFile string
+		output     string
+	)
+	command := &cobra.Command{
+		Use:   "template",
+		Short: "Render the template of an ApplicationSet locally against the given parameters",
+		Long:  "Render the template of an ApplicationSet locally against the given parameter sets. Generators are not evaluated, which makes it possible to test templates without access to an Argo CD server.",
+		Example: templates.Examples(`
+	# Render the template of an ApplicationSet against the parameter sets stored in a JSON or YAML file
+	argocd appset template -f appset.yaml --params params.json
+
+	# Render the template as JSON
+	argocd appset template -f appset.yaml --params params.yaml -o json
+`),
+		Run: func(c *cobra.Command, _ []string) {
+			if file == "" || paramsFile == "" {
+				c.HelpFunc()(c, []string{})
+				os.Exit(1)
 			}
+			appsets, err := cmdutil.ConstructApplicationSet(file)
+			errors.CheckError(err)
+
+			if len(appsets) != 1 {
+				fmt.Printf("Input file must contain one ApplicationSet")
+				os.Exit(1)
+			}
+			params, err := cmdutil.ReadApplicationSetParams(paramsFile)
+			errors.CheckError(err)
+
+			apps, err := appsettemplate.RenderApplications(c.Context(), nil, *appsets[0], params, &appsetutils.Render{})
+			errors.CheckError(err)
 
 			switch output {
 			case "yaml", "json":
 				var resources []any
-				for i := range appsList {
-					app := appsList[i]
-					// backfill api version and kind because k8s client always return empty values for these fields
+				for i := range apps {
+					app := apps[i]
 					app.APIVersion = arogappsetv1.ApplicationSchemaGroupVersionKind.GroupVersion().String()
 					app.Kind = arogappsetv1.ApplicationSchemaGroupVersionKind.Kind
 					resources = append(resources, app)
 				}
 
 				cobra.CheckErr(admin.PrintResources(output, os.Stdout, resources...))
-			case "wide", "":
-				printApplicationTable(appsList, &output)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&file, "file", "f", "", "Filename or URL of the ApplicationSet to render")
+	command.Flags().StringVar(&paramsFile, "params", "", "Filename or URL of a JSON or YAML file containing a parameter set, or a list of parameter sets, to render the template with")
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: json|yaml")
+	return command
+}
+
+// NewApplicationSetPrintSchemaCommand returns a new instance of an `argocd appset print-schema` command
+func NewApplicationSetPrintSchemaCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "print-schema",
+		Short: "Print the JSON Schema of the ApplicationSet resource",
+		Long:  "Print the JSON Schema of the ApplicationSet resource, for use by editors and validation tools. The schema is built from the ApplicationSet types of this version of the CLI.",
+		Example: templates.Examples(`
+	# Print the JSON Schema of the ApplicationSet resource
+	argocd appset print-schema > applicationset.schema.json
+
+	# Print the schema as YAML
+	argocd appset print-schema -o yaml
+`),
+		Run: func(_ *cobra.Command, _ []string) {
+			schema, err := appsetutils.ApplicationSetJSONSchema()
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				cobra.CheckErr(admin.PrintResources(output, os.Stdout, schema))
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "json", "Output format. One of: json|yaml")
+	return command
+}
+
+// NewApplicationSetConvertCommand returns a new instance of an `argocd appset convert` command
+func NewApplicationSetConvertCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		name   string
+		file   string
+		output string
+	)
+	command := &cobra.Command{
+		Use:   "convert [APPNAME...]",
+		Short: "Suggest an ApplicationSet generating existing Applications",
+		Long:  "Suggest an ApplicationSet generating the given Applications with a List generator. The fields with the same value in all the Applications are kept in the template, while the string fields with different values are replaced by parameters listed in the elements of the generator. The fields which cannot be templated this way are reported as warnings and must be added manually, e.g. with a templatePatch.",
+		Example: templates.Examples(`
+	# Suggest an ApplicationSet generating existing Applications
+	argocd appset convert guestbook-dev guestbook-prod --name guestbook
+
+	# Suggest an ApplicationSet generating the Applications of a YAML file, without access to an Argo CD server
+	argocd appset convert -f applications.yaml --name guestbook > appset.yaml
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if name == "" || (len(args) == 0) == (file == "") {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			var apps []arogappsetv1.Application
+			if file != "" {
+				fileApps, err := cmdutil.ReadApps(file)
+				errors.CheckError(err)
+				for _, app := range fileApps {
+					apps = append(apps, *app)
+				}
+			} else {
+				conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+				defer argoio.Close(conn)
+				for _, arg := range args {
+					appName, appNs := argo.ParseFromQualifiedName(arg, "")
+					app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+					errors.CheckError(err)
+					apps = append(apps, *app)
+				}
+			}
+
+			appSet, warnings, err := appsetutils.ApplicationsToApplicationSet(name, apps)
+			errors.CheckError(err)
+			for _, warning := range warnings {
+				c.PrintErrf("Warning: %s\n", warning)
+			}
+
+			switch output {
+			case "yaml", "json":
+				cobra.CheckErr(admin.PrintResources(output, os.Stdout, appSet))
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&name, "name", "", "Name of the ApplicationSet")
+	command.Flags().StringVarP(&file, "file", "f", "", "Filename or URL of the Applications to convert, instead of existing Applications")
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: json|yaml")
 	return command
 }
 
@@ -325,13 +940,17 @@ func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra
 
 // NewApplicationSetDeleteCommand returns a new instance of an `argocd appset delete` command
 func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var noPrompt bool
+	var noPrompt, preserveChildren bool
+	var appSetNamespace string
 	command := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete one or more ApplicationSets",
 		Example: templates.Examples(`
 	# Delete an applicationset
 	argocd appset delete APPSETNAME (APPSETNAME...)
+
+	# Delete an applicationset, leaving its Applications and their resources in place
+	argocd appset delete APPSETNAME --preserve-children
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -359,14 +978,19 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 			promptUtil := utils.NewPrompt(isTerminal && !noPrompt)
 
 			for _, appSetQualifiedName := range args {
-				appSetName, appSetNs := argo.ParseFromQualifiedName(appSetQualifiedName, "")
+				appSetName, appSetNs := argo.ParseFromQualifiedName(appSetQualifiedName, appSetNamespace)
 
 				appsetDeleteReq := applicationset.ApplicationSetDeleteRequest{
-					Name:            appSetName,
-					AppsetNamespace: appSetNs,
+					Name:             appSetName,
+					AppsetNamespace:  appSetNs,
+					PreserveChildren: preserveChildren,
 				}
 				messageForSingle := "Are you sure you want to delete '" + appSetQualifiedName + "' and all its Applications? [y/n] "
 				messageForAll := "Are you sure you want to delete '" + appSetQualifiedName + "' and all its Applications? [y/n/a] where 'a' is to delete all specified ApplicationSets and their Applications without prompting"
+				if preserveChildren {
+					messageForSingle = "Are you sure you want to delete '" + appSetQualifiedName + "' and leave its Applications in place? [y/n] "
+					messageForAll = "Are you sure you want to delete '" + appSetQualifiedName + "' and leave its Applications in place? [y/n/a] where 'a' is to delete all specified ApplicationSets without prompting"
+				}
 				if !confirmAll {
 					confirm, confirmAll = promptUtil.ConfirmBaseOnCount(messageForSingle, messageForAll, numOfApps)
 				}
@@ -381,6 +1005,8 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 		},
 	}
 	command.Flags().BoolVarP(&noPrompt, "yes", "y", false, "Turn off prompting to confirm cascaded deletion of Application resources")
+	command.Flags().BoolVar(&preserveChildren, "preserve-children", false, "Leave the Applications of the ApplicationSets in place, removing their ownership by the ApplicationSets, instead of deleting them")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only delete ApplicationSets from namespace")
 	return command
 }
 
@@ -397,33 +1023,37 @@ func printApplicationSetTable(apps []arogappsetv1.ApplicationSet, output *string
 	var fmtStr string
 	headers := []any{"NAME", "PROJECT", "SYNCPOLICY", "CONDITIONS"}
 	if *output == "wide" {
-		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
-		headers = append(headers, "REPO", "PATH", "TARGET")
+		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+		headers = append(headers, "REPO", "PATH", "TARGET", "PARAMS")
 	} else {
 		fmtStr = "%s\t%s\t%s\t%s\n"
 	}
 	_, _ = fmt.Fprintf(w, fmtStr, headers...)
 	for _, app := range apps {
-		conditions := make([]arogappsetv1.ApplicationSetCondition, 0)
-		for _, condition := range app.Status.Conditions {
-			if condition.Status == arogappsetv1.ApplicationSetConditionStatusTrue {
-				conditions = append(conditions, condition)
-			}
-		}
 		vals := []any{
 			app.QualifiedName(),
 			app.Spec.Template.Spec.Project,
 			app.Spec.SyncPolicy,
-			conditions,
+			formatAppSetConditionTypes(app.Status.Conditions),
 		}
 		if *output == "wide" {
-			vals = append(vals, app.Spec.Template.Spec.GetSource().RepoURL, app.Spec.Template.Spec.GetSource().Path, app.Spec.Template.Spec.GetSource().TargetRevision)
+			vals = append(vals, app.Spec.Template.Spec.GetSource().RepoURL, app.Spec.Template.Spec.GetSource().Path, app.Spec.Template.Spec.GetSource().TargetRevision, formatGeneratedParameterSets(app.Status.GeneratedParameterSets))
 		}
 		_, _ = fmt.Fprintf(w, fmtStr, vals...)
 	}
 	_ = w.Flush()
 }
 
+// formatGeneratedParameterSets returns the number of parameter sets produced by each generator, separated by commas,
+// or an empty string if the ApplicationSet was not reconciled yet.
+func formatGeneratedParameterSets(parameterSets []int64) string {
+	counts := make([]string, len(parameterSets))
+	for i, count := range parameterSets {
+		counts[i] = strconv.FormatInt(count, 10)
+	}
+	return strings.Join(counts, ",")
+}
+
 func getServerForAppSet(appSet *arogappsetv1.ApplicationSet) string {
 	if appSet.Spec.Template.Spec.Destination.Server == "" {
 		return appSet.Spec.Template.Spec.Destination.Name
@@ -469,11 +1099,192 @@ func printAppSetSummaryTable(appSet *arogappsetv1.ApplicationSet) {
 	fmt.Printf(printOpFmtStr, "SyncPolicy:", syncPolicyStr)
 }
 
-func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
-	_, _ = fmt.Fprintf(w, "CONDITION\tSTATUS\tMESSAGE\tLAST TRANSITION\n")
+// formatAppSetConditionTypes returns the comma-separated types of the conditions which are true, or <none>
+func formatAppSetConditionTypes(conditions []arogappsetv1.ApplicationSetCondition) string {
+	var types []string
+	for _, condition := range conditions {
+		if condition.Status == arogappsetv1.ApplicationSetConditionStatusTrue {
+			types = append(types, string(condition.Type))
+		}
+	}
+	if len(types) == 0 {
+		return "<none>"
+	}
+	return strings.Join(types, ", ")
+}
+
+// printAppSetDeprecationWarnings prints to stderr a warning for each deprecated field or legacy template syntax used by
+// the ApplicationSet, so that it can be migrated before support is removed
+func printAppSetDeprecationWarnings(c *cobra.Command, appSet *arogappsetv1.ApplicationSet) {
+	for _, warning := range appsetutils.GetDeprecationWarnings(appSet) {
+		c.PrintErrf("Warning: ApplicationSet '%s': %s\n", appSet.Name, warning)
+	}
+}
+
+// printAppSetValidationResults prints one line per generated Application, and one line per condition of an invalid one
+func printAppSetValidationResults(results []*applicationset.ApplicationSetValidationResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "APPLICATION\tSTATUS\tMESSAGE\n")
+	for _, result := range results {
+		if len(result.Conditions) == 0 {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", result.Application, "Valid", "")
+			continue
+		}
+		for _, condition := range result.Conditions {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", result.Application, "Invalid", condition.Message)
+		}
+	}
+	_ = w.Flush()
+}
+
+// appSetConditionMessageWidth is the width the messages of the conditions are wrapped at
+const appSetConditionMessageWidth = 80
+
+// printAppSetConditions prints one row per condition, with its age relative to now and its message wrapped over
+// several lines
+func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet, now time.Time) {
+	_, _ = fmt.Fprintf(w, "TYPE\tSTATUS\tREASON\tAGE\tMESSAGE\n")
 	for _, item := range appSet.Status.Conditions {
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Type, item.Status, item.Message, item.LastTransitionTime)
+		age := "<unknown>"
+		if item.LastTransitionTime != nil {
+			age = duration.HumanDuration(now.Sub(item.LastTransitionTime.Time))
+		}
+		lines := wrapText(item.Message, appSetConditionMessageWidth)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.Type, item.Status, item.Reason, age, lines[0])
+		for _, line := range lines[1:] {
+			_, _ = fmt.Fprintf(w, "\t\t\t\t%s\n", line)
+		}
+	}
+}
+
+// appSetApplications are the Applications of an ApplicationSet printed by `argocd appset get --show-applications`.
+type appSetApplications struct {
+	Applications []appSetApplication       `json:"applications"`
+	Summary      appSetApplicationsSummary `json:"summary"`
+}
+
+// appSetApplication is the sync and health status of an Application of an ApplicationSet.
+type appSetApplication struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Server    string `json:"server"`
+	// DestinationNamespace is the namespace the Application is deployed to.
+	DestinationNamespace string `json:"destinationNamespace"`
+	Sync                 string `json:"sync"`
+	Health               string `json:"health"`
+	Revision             string `json:"revision,omitempty"`
+}
+
+// appSetApplicationsSummary counts the Applications of an ApplicationSet by health and sync status.
+type appSetApplicationsSummary struct {
+	Applications int            `json:"applications"`
+	Health       map[string]int `json:"health"`
+	Sync         map[string]int `json:"sync"`
+}
+
+// newAppSetApplications returns the Applications of apps owned by the ApplicationSet, sorted by name, with the summary
+// of their statuses.
+func newAppSetApplications(appSet *arogappsetv1.ApplicationSet, apps []arogappsetv1.Application) appSetApplications {
+	res := appSetApplications{
+		Applications: []appSetApplication{},
+		Summary:      appSetApplicationsSummary{Health: map[string]int{}, Sync: map[string]int{}},
+	}
+	for _, app := range apps {
+		if !isOwnedByAppSet(&app, appSet) {
+			continue
+		}
+		healthStatus := string(health.HealthStatusUnknown)
+		if app.Status.Health.Status != "" {
+			healthStatus = string(app.Status.Health.Status)
+		}
+		syncStatus := string(arogappsetv1.SyncStatusCodeUnknown)
+		if app.Status.Sync.Status != "" {
+			syncStatus = string(app.Status.Sync.Status)
+		}
+		server := app.Spec.Destination.Server
+		if server == "" {
+			server = app.Spec.Destination.Name
+		}
+		res.Applications = append(res.Applications, appSetApplication{
+			Name:                 app.Name,
+			Namespace:            app.Namespace,
+			Project:              app.Spec.GetProject(),
+			Server:               server,
+			DestinationNamespace: app.Spec.Destination.Namespace,
+			Sync:                 syncStatus,
+			Health:               healthStatus,
+			Revision:             app.Status.Sync.Revision,
+		})
+		res.Summary.Applications++
+		res.Summary.Health[healthStatus]++
+		res.Summary.Sync[syncStatus]++
+	}
+	sort.Slice(res.Applications, func(i, j int) bool {
+		return res.Applications[i].Name < res.Applications[j].Name
+	})
+	return res
+}
+
+// isOwnedByAppSet returns whether the ApplicationSet is the owner of the Application.
+func isOwnedByAppSet(app *arogappsetv1.Application, appSet *arogappsetv1.ApplicationSet) bool {
+	if app.Namespace != appSet.Namespace {
+		return false
+	}
+	for _, ref := range app.OwnerReferences {
+		if ref.Kind == arogappsetv1.ApplicationSetSchemaGroupVersionKind.Kind && ref.Name == appSet.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// formatAppSetApplicationsSummary returns the number of Applications followed by their counts by health and sync status
+func formatAppSetApplicationsSummary(summary appSetApplicationsSummary) string {
+	if summary.Applications == 0 {
+		return "<none>"
+	}
+	return fmt.Sprintf("%d (Health: %s; Sync: %s)", summary.Applications, formatStatusCounts(summary.Health), formatStatusCounts(summary.Sync))
+}
+
+// formatStatusCounts returns the counts by status sorted by status, e.g. `Degraded=1, Healthy=2`
+func formatStatusCounts(counts map[string]int) string {
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	for i, status := range statuses {
+		statuses[i] = fmt.Sprintf("%s=%d", status, counts[status])
+	}
+	return strings.Join(statuses, ", ")
+}
+
+// printAppSetApplications prints a table with the sync and health status of the Applications of an ApplicationSet
+func printAppSetApplications(w io.Writer, apps []appSetApplication) {
+	_, _ = fmt.Fprintf(w, "NAME\tPROJECT\tCLUSTER\tNAMESPACE\tSYNC\tHEALTH\tREVISION\n")
+	for _, app := range apps {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", app.Name, app.Project, app.Server, app.DestinationNamespace, app.Sync, app.Health, app.Revision)
+	}
+}
+
+// wrapText splits the text into lines of at most width characters, breaking at spaces. Words longer than width are
+// not broken. At least one line is returned.
+func wrapText(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
 	}
+	return append(lines, line)
 }
 
 func hasAppSetChanged(appReq, appRes *arogappsetv1.ApplicationSet, upsert bool) bool {
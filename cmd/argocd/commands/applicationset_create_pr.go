@@ -0,0 +1,285 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// appSetPins is the pin file written by `argocd appset create-pr`. It records the revisions of the sources of the
+// Applications of an ApplicationSet, and is meant to be reviewed and kept in Git: fields may be added, but they are
+// neither renamed nor removed.
+type appSetPins struct {
+	ApplicationSet string    `json:"applicationSet"`
+	Namespace      string    `json:"namespace,omitempty"`
+	Applications   []appPins `json:"applications"`
+}
+
+// appPins are the revisions of the sources of an Application.
+type appPins struct {
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Sources   []sourcePin `json:"sources"`
+}
+
+// sourcePin is the revision of a source of an Application.
+type sourcePin struct {
+	RepoURL string `json:"repoURL"`
+	Path    string `json:"path,omitempty"`
+	Chart   string `json:"chart,omitempty"`
+	// TargetRevision is the revision requested by the Application, as rendered from the parameters of the generators.
+	TargetRevision string `json:"targetRevision,omitempty"`
+	// Revision is the revision the source resolved to when the Application was last synced: a commit SHA, or a chart
+	// version.
+	Revision string `json:"revision,omitempty"`
+}
+
+// NewApplicationSetCreatePRCommand returns a new instance of an `argocd appset create-pr` command
+func NewApplicationSetCreatePRCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		repoURL         string
+		pinPath         string
+		base            string
+		branch          string
+		title           string
+		token           string
+		githubAPI       string
+		dryRun          bool
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "create-pr APPSETNAME",
+		Short: "Open a pull request writing the revisions resolved for the Applications of an ApplicationSet to a pin file",
+		Long: "Write the revisions resolved for the sources of the Applications of an ApplicationSet, such as the chart " +
+			"versions selected by a semver constraint, to a pin file of a Git repository, and open a pull request on " +
+			"GitHub updating it. Merging the pull requests keeps an auditable trail of the promotions in Git. The pin " +
+			"file is written to a branch of a temporary clone of the repository, which is pushed with the given token, " +
+			"or with the Git credentials of the user if none is given. No pull request is opened if the pin file is up " +
+			"to date, and the pull request of the branch is updated if it is already open.",
+		Example: templates.Examples(`
+	# Open a pull request updating pins/guestbook.yaml with the revisions of the Applications of the guestbook ApplicationSet
+	GITHUB_TOKEN=... argocd appset create-pr guestbook --repo https://github.com/org/deployments --path pins/guestbook.yaml
+
+	# Print the pin file without opening a pull request
+	argocd appset create-pr guestbook --repo https://github.com/org/deployments --path pins/guestbook.yaml --dry-run
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if repoURL == "" || pinPath == "" {
+				errors.Fatal(errors.ErrorGeneric, "--repo and --path are required")
+			}
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appSetIf := argocdClient.NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			appSet, err := appSetIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			appConn, appIf := argocdClient.NewApplicationClientOrDie()
+			defer argoio.Close(appConn)
+			var apps []arogappsetv1.Application
+			for _, resource := range appSet.Status.Resources {
+				app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &resource.Name, AppNamespace: &resource.Namespace})
+				errors.CheckError(err)
+				apps = append(apps, *app)
+			}
+
+			data, err := yaml.Marshal(buildAppSetPins(appSet, apps))
+			errors.CheckError(err)
+			if dryRun {
+				fmt.Print(string(data))
+				return
+			}
+
+			if token == "" {
+				token = os.Getenv("GITHUB_TOKEN")
+			}
+			if branch == "" {
+				branch = "argocd-pins/" + appSet.Name
+			}
+			if title == "" {
+				title = fmt.Sprintf("Pin the revisions of the Applications of ApplicationSet %s", appSet.Name)
+			}
+			changed, err := pushPinFile(repoURL, token, base, branch, pinPath, data, title)
+			errors.CheckError(err)
+			if !changed {
+				fmt.Printf("The pin file %s is up to date\n", pinPath)
+				return
+			}
+			prURL, err := openPinPullRequest(ctx, repoURL, githubAPI, token, base, branch, title)
+			errors.CheckError(err)
+			fmt.Println(prURL)
+		},
+	}
+	command.Flags().StringVar(&repoURL, "repo", "", "URL of the GitHub repository holding the pin file")
+	command.Flags().StringVar(&pinPath, "path", "", "Path of the pin file in the repository")
+	command.Flags().StringVar(&base, "base", "main", "Branch the pull request is opened against")
+	command.Flags().StringVar(&branch, "branch", "", "Branch the pin file is pushed to (default \"argocd-pins/APPSETNAME\")")
+	command.Flags().StringVar(&title, "title", "", "Title of the commit and of the pull request")
+	command.Flags().StringVar(&token, "token", "", "GitHub token used to push the branch and to open the pull request (default $GITHUB_TOKEN)")
+	command.Flags().StringVar(&githubAPI, "github-api", "", "URL of the API of the GitHub Enterprise instance of the repository")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the pin file instead of opening a pull request")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only open a pull request for ApplicationSet from namespace")
+	return command
+}
+
+// buildAppSetPins returns the pins of the sources of the given Applications of the ApplicationSet, ordered by
+// Application, so that the pin file only changes along with the revisions.
+func buildAppSetPins(appSet *arogappsetv1.ApplicationSet, apps []arogappsetv1.Application) *appSetPins {
+	pins := &appSetPins{
+		ApplicationSet: appSet.Name,
+		Namespace:      appSet.Namespace,
+		Applications:   []appPins{},
+	}
+	for _, app := range apps {
+		revisions := app.Status.Sync.Revisions
+		if !app.Spec.HasMultipleSources() {
+			revisions = []string{app.Status.Sync.Revision}
+		}
+		appPin := appPins{Name: app.Name, Namespace: app.Namespace, Sources: []sourcePin{}}
+		for i, source := range app.Spec.GetSources() {
+			pin := sourcePin{
+				RepoURL:        source.RepoURL,
+				Path:           source.Path,
+				Chart:          source.Chart,
+				TargetRevision: source.TargetRevision,
+			}
+			if i < len(revisions) {
+				pin.Revision = revisions[i]
+			}
+			appPin.Sources = append(appPin.Sources, pin)
+		}
+		pins.Applications = append(pins.Applications, appPin)
+	}
+	slices.SortFunc(pins.Applications, func(a, b appPins) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	return pins
+}
+
+// pushPinFile writes the pin file to the given branch of a temporary clone of the repository, created from the base
+// branch if it does not exist, and pushes it. It returns whether the pin file changed.
+func pushPinFile(repoURL, token, base, branch, pinPath string, data []byte, message string) (bool, error) {
+	root, err := os.MkdirTemp("", "argocd-appset-pins")
+	if err != nil {
+		return false, fmt.Errorf("error creating the directory of the clone: %w", err)
+	}
+	defer os.RemoveAll(root)
+
+	var creds git.Creds = git.NopCreds{}
+	if token != "" {
+		creds = git.NewHTTPSCreds("x-access-token", token, "", "", "", false, "", "", git.NoopCredsStore{}, true)
+	}
+	gitClient, err := git.NewClientExt(repoURL, root, creds, false, false, "", "")
+	if err != nil {
+		return false, fmt.Errorf("error creating the git client: %w", err)
+	}
+	if err := gitClient.Init(); err != nil {
+		return false, fmt.Errorf("error initializing the clone: %w", err)
+	}
+	if err := gitClient.Fetch(""); err != nil {
+		return false, fmt.Errorf("error fetching %s: %w", repoURL, err)
+	}
+	if out, err := gitClient.CheckoutOrNew(branch, base, false); err != nil {
+		return false, fmt.Errorf("error checking out branch %s: %w: %s", branch, err, out)
+	}
+
+	fullPath := filepath.Join(root, filepath.Clean("/"+pinPath))
+	if existing, err := os.ReadFile(fullPath); err == nil && string(existing) == string(data) {
+		return false, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return false, fmt.Errorf("error creating the directory of the pin file: %w", err)
+	}
+	if err := os.WriteFile(fullPath, data, 0o644); err != nil {
+		return false, fmt.Errorf("error writing the pin file: %w", err)
+	}
+	if out, err := gitClient.CommitAndPush(branch, message); err != nil {
+		return false, fmt.Errorf("error pushing branch %s: %w: %s", branch, err, out)
+	}
+	return true, nil
+}
+
+// openPinPullRequest opens a pull request of the branch against the base branch, and returns its URL. The URL of the
+// pull request of the branch is returned if it is already open.
+func openPinPullRequest(ctx context.Context, repoURL, githubAPI, token, base, branch, title string) (string, error) {
+	host, owner, repo, err := parseGitHubRepoURL(repoURL)
+	if err != nil {
+		return "", err
+	}
+	client := github.NewClient(nil)
+	if githubAPI != "" {
+		client, err = client.WithEnterpriseURLs(githubAPI, githubAPI)
+		if err != nil {
+			return "", fmt.Errorf("error creating the GitHub client: %w", err)
+		}
+	} else if host != "github.com" {
+		return "", fmt.Errorf("--github-api is required for the repositories which are not hosted on github.com: %s", repoURL)
+	}
+	if token != "" {
+		client = client.WithAuthToken(token)
+	}
+
+	existing, _, err := client.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{State: "open", Head: owner + ":" + branch, Base: base})
+	if err != nil {
+		return "", fmt.Errorf("error listing the pull requests of %s/%s: %w", owner, repo, err)
+	}
+	if len(existing) > 0 {
+		return existing[0].GetHTMLURL(), nil
+	}
+	pr, _, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+		Title: github.Ptr(title),
+		Head:  github.Ptr(branch),
+		Base:  github.Ptr(base),
+		Body:  github.Ptr("Generated by `argocd appset create-pr`."),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error opening the pull request: %w", err)
+	}
+	return pr.GetHTMLURL(), nil
+}
+
+// parseGitHubRepoURL returns the host, the owner and the name of the GitHub repository of the given HTTPS or SSH URL.
+func parseGitHubRepoURL(repoURL string) (host string, owner string, repo string, err error) {
+	normalized := git.NormalizeGitURL(repoURL)
+	if normalized == "" {
+		return "", "", "", fmt.Errorf("invalid repository URL: %s", repoURL)
+	}
+	if !strings.Contains(normalized, "://") {
+		normalized = "ssh://" + normalized
+	}
+	u, err := url.Parse(normalized)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid repository URL %s: %w", repoURL, err)
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", "", fmt.Errorf("the repository URL must be of the form https://HOST/OWNER/REPO: %s", repoURL)
+	}
+	return u.Hostname(), segments[0], segments[1], nil
+}
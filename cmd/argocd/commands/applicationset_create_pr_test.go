@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestBuildAppSetPins(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}
+	apps := []v1alpha1.Application{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-guestbook", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Sources: v1alpha1.ApplicationSources{
+					{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "1.*"},
+					{RepoURL: "https://github.com/org/values", Path: "prod", TargetRevision: "HEAD"},
+				},
+			},
+			Status: v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Revisions: []string{"1.4.2", "a1b2c3"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-guestbook", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "2.*"},
+			},
+			Status: v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Revision: "2.0.1"}},
+		},
+	}
+
+	data, err := yaml.Marshal(buildAppSetPins(appSet, apps))
+	require.NoError(t, err)
+	assert.Equal(t, `applicationSet: guestbook
+applications:
+- name: dev-guestbook
+  namespace: argocd
+  sources:
+  - chart: guestbook
+    repoURL: https://charts.example.com
+    revision: 2.0.1
+    targetRevision: 2.*
+- name: prod-guestbook
+  namespace: argocd
+  sources:
+  - chart: guestbook
+    repoURL: https://charts.example.com
+    revision: 1.4.2
+    targetRevision: 1.*
+  - path: prod
+    repoURL: https://github.com/org/values
+    revision: a1b2c3
+    targetRevision: HEAD
+namespace: argocd
+`, string(data))
+
+	data, err = yaml.Marshal(buildAppSetPins(appSet, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "applicationSet: guestbook\napplications: []\nnamespace: argocd\n", string(data))
+}
+
+func TestParseGitHubRepoURL(t *testing.T) {
+	for _, c := range []struct {
+		repoURL       string
+		expectedHost  string
+		expectedOwner string
+		expectedRepo  string
+		expectedError string
+	}{
+		{repoURL: "https://github.com/org/deployments", expectedHost: "github.com", expectedOwner: "org", expectedRepo: "deployments"},
+		{repoURL: "https://github.com/org/deployments.git", expectedHost: "github.com", expectedOwner: "org", expectedRepo: "deployments"},
+		{repoURL: "git@github.com:org/deployments.git", expectedHost: "github.com", expectedOwner: "org", expectedRepo: "deployments"},
+		{repoURL: "ssh://git@github.example.com/org/deployments", expectedHost: "github.example.com", expectedOwner: "org", expectedRepo: "deployments"},
+		{repoURL: "https://github.com/org", expectedError: "the repository URL must be of the form https://HOST/OWNER/REPO: https://github.com/org"},
+	} {
+		t.Run(c.repoURL, func(t *testing.T) {
+			host, owner, repo, err := parseGitHubRepoURL(c.repoURL)
+			if c.expectedError != "" {
+				require.EqualError(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expectedHost, host)
+			assert.Equal(t, c.expectedOwner, owner)
+			assert.Equal(t, c.expectedRepo, repo)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewApplicationSetExportCommand returns a new instance of an `argocd appset export` command
+func NewApplicationSetExportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var includeApps bool
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:   "export APPSETNAME",
+		Short: "Export an ApplicationSet, and optionally its Applications, as a YAML stream",
+		Long: "Export an ApplicationSet, and with --include-apps the Applications it generated, as a stream of YAML " +
+			"documents, e.g. to keep an audit snapshot or to migrate the ApplicationSet to another Argo CD instance. " +
+			"The status and the fields set by Kubernetes, including the owner references of the Applications, are " +
+			"omitted so that the bundle can be applied as is. The parameters produced by the generators are not " +
+			"stored by Argo CD and are not exported: the Applications hold the result of rendering them.",
+		Example: templates.Examples(`
+	# Export an ApplicationSet
+	argocd appset export APPSETNAME > appset.yaml
+
+	# Export an ApplicationSet with its Applications
+	argocd appset export APPSETNAME --include-apps > bundle.yaml
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appSetIf := argocdClient.NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			appSet, err := appSetIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			var apps []arogappsetv1.Application
+			if includeApps {
+				appConn, appIf := argocdClient.NewApplicationClientOrDie()
+				defer argoio.Close(appConn)
+				for _, resource := range appSet.Status.Resources {
+					app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &resource.Name, AppNamespace: &resource.Namespace})
+					errors.CheckError(err)
+					apps = append(apps, *app)
+				}
+			}
+
+			errors.CheckError(writeAppSetExport(os.Stdout, appSet, apps))
+		},
+	}
+	command.Flags().BoolVar(&includeApps, "include-apps", false, "Also export the Applications generated by the ApplicationSet")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only export ApplicationSet from namespace")
+	return command
+}
+
+// writeAppSetExport writes the ApplicationSet, followed by the given Applications, as a stream of YAML documents,
+// without their status and the fields set by Kubernetes.
+func writeAppSetExport(out io.Writer, appSet *arogappsetv1.ApplicationSet, apps []arogappsetv1.Application) error {
+	exportedAppSet := &arogappsetv1.ApplicationSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: arogappsetv1.ApplicationSetSchemaGroupVersionKind.GroupVersion().String(),
+			Kind:       arogappsetv1.ApplicationSetSchemaGroupVersionKind.Kind,
+		},
+		ObjectMeta: exportedObjectMeta(appSet.ObjectMeta),
+		Spec:       appSet.Spec,
+	}
+	objects := []any{exportedAppSet}
+	for _, app := range apps {
+		objects = append(objects, &arogappsetv1.Application{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: arogappsetv1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+				Kind:       arogappsetv1.ApplicationSchemaGroupVersionKind.Kind,
+			},
+			ObjectMeta: exportedObjectMeta(app.ObjectMeta),
+			Spec:       app.Spec,
+		})
+	}
+
+	for i, obj := range objects {
+		data, err := marshalExportedObject(obj)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := fmt.Fprintln(out, "---"); err != nil {
+				return err
+			}
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalExportedObject marshals the object to YAML without its status and creation timestamp, which are always
+// serialized as they are not pointers.
+func marshalExportedObject(obj any) ([]byte, error) {
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling json: %w", err)
+	}
+	toMap := make(map[string]any)
+	if err := json.Unmarshal(jsonBytes, &toMap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling json: %w", err)
+	}
+	delete(toMap, "status")
+	if metadata, ok := toMap["metadata"].(map[string]any); ok {
+		delete(metadata, "creationTimestamp")
+	}
+	data, err := yaml.Marshal(toMap)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling yaml: %w", err)
+	}
+	return data, nil
+}
+
+// exportedObjectMeta returns the metadata of an exported resource, without the fields set by Kubernetes. The owner
+// references are dropped as the owners get a different UID once applied elsewhere.
+func exportedObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+		Finalizers:  meta.Finalizers,
+	}
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestWriteAppSetExport(t *testing.T) {
+	now := metav1.Now()
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "guestbook",
+			Namespace:         "argocd",
+			UID:               types.UID("appset-uid"),
+			ResourceVersion:   "42",
+			Generation:        3,
+			CreationTimestamp: now,
+			Labels:            map[string]string{"team": "a"},
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{.cluster}}-guestbook"},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			Resources: []v1alpha1.ResourceStatus{{Name: "dev-guestbook"}},
+		},
+	}
+	apps := []v1alpha1.Application{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "dev-guestbook",
+			Namespace:       "argocd",
+			UID:             types.UID("app-uid"),
+			ResourceVersion: "43",
+			Finalizers:      []string{"resources-finalizer.argocd.argoproj.io"},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ApplicationSet", Name: "guestbook", UID: types.UID("appset-uid")}},
+		},
+		Spec: v1alpha1.ApplicationSpec{Project: "default"},
+		Status: v1alpha1.ApplicationStatus{
+			Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+		},
+	}}
+
+	t.Run("ApplicationSet only", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		require.NoError(t, writeAppSetExport(out, appSet, nil))
+		assert.Equal(t, `apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  labels:
+    team: a
+  name: guestbook
+  namespace: argocd
+spec:
+  generators: null
+  goTemplate: true
+  template:
+    metadata:
+      name: '{{.cluster}}-guestbook'
+    spec:
+      destination: {}
+      project: ""
+`, out.String())
+	})
+
+	t.Run("ApplicationSet with its Applications", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		require.NoError(t, writeAppSetExport(out, appSet, apps))
+		assert.Contains(t, out.String(), `      project: ""
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  finalizers:
+  - resources-finalizer.argocd.argoproj.io
+  name: dev-guestbook
+  namespace: argocd
+spec:
+  destination: {}
+  project: default
+`)
+		assert.NotContains(t, out.String(), "uid")
+		assert.NotContains(t, out.String(), "resourceVersion")
+		assert.NotContains(t, out.String(), "ownerReferences")
+		assert.NotContains(t, out.String(), "Synced")
+	})
+}
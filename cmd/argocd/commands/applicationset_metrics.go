@@ -0,0 +1,246 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// appSetControllerMetricsPort is the port the ApplicationSet controller serves its metrics on by default.
+const appSetControllerMetricsPort = 8080
+
+// appSetMetrics holds the metrics of an ApplicationSet printed by `argocd appset metrics`.
+type appSetMetrics struct {
+	Namespace                 string           `json:"namespace"`
+	Name                      string           `json:"name"`
+	ResourcesUpToDate         string           `json:"resourcesUpToDate,omitempty"`
+	OwnedApplications         int64            `json:"ownedApplications"`
+	DriftedApplications       int64            `json:"driftedApplications"`
+	GeneratedParameterSets    int64            `json:"generatedParameterSets"`
+	LegacyTemplate            bool             `json:"legacyTemplate"`
+	Reconciles                uint64           `json:"reconciles"`
+	ReconcileSeconds          float64          `json:"reconcileSeconds"`
+	LastSuccessfulReconcileAt *time.Time       `json:"lastSuccessfulReconcileAt,omitempty"`
+	RequeueIntervalSeconds    float64          `json:"requeueIntervalSeconds,omitempty"`
+	ApplicationChanges        map[string]int64 `json:"applicationChanges,omitempty"`
+	GeneratorErrors           map[string]int64 `json:"generatorErrors,omitempty"`
+}
+
+// NewApplicationSetMetricsCommand returns a new instance of an `argocd appset metrics` command
+func NewApplicationSetMetricsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		metricsURL      string
+		controllerName  string
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "metrics APPSETNAME",
+		Short: "Print the metrics of an ApplicationSet exposed by the ApplicationSet controller",
+		Long: "Print the metrics of an ApplicationSet exposed by the ApplicationSet controller: its reconciliations, " +
+			"the Applications it owns, creates, updates and deletes, and the errors of its generators. The metrics are " +
+			"scraped from the URL given by --metrics-url, or from the ApplicationSet controller pod through a port " +
+			"forward, which requires access to the Kubernetes cluster. The counters are those of the running controller, " +
+			"and start from zero when it restarts. An ApplicationSet name without namespace matches the ApplicationSets " +
+			"of that name in all namespaces.",
+		Example: templates.Examples(`
+	# Print the metrics of an ApplicationSet, port forwarding to the ApplicationSet controller
+	argocd appset metrics APPSETNAME --port-forward-namespace argocd
+
+	# Print the metrics of an ApplicationSet of another namespace as JSON, from a metrics URL
+	argocd appset metrics team-a/APPSETNAME --metrics-url http://localhost:8080/metrics -o json
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			if metricsURL == "" {
+				port, err := kubeutil.PortForward(appSetControllerMetricsPort, clientOpts.PortForwardNamespace, clientOpts.KubeOverrides, common.LabelKeyAppName+"="+controllerName)
+				errors.CheckError(err)
+				metricsURL = fmt.Sprintf("http://localhost:%d/metrics", port)
+			}
+			families, err := scrapeMetrics(ctx, metricsURL)
+			errors.CheckError(err)
+
+			metrics := collectAppSetMetrics(families, appSetNs, appSetName)
+			if len(metrics) == 0 {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("no metrics found for ApplicationSet %s", args[0]))
+			}
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(metrics, output, len(metrics) == 1)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetMetrics(metrics, time.Now())
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&metricsURL, "metrics-url", "", "URL of the metrics endpoint of the ApplicationSet controller. Defaults to port forwarding to the ApplicationSet controller pod")
+	command.Flags().StringVar(&controllerName, "applicationset-controller-name", common.ApplicationSetController, "Name of the ApplicationSet controller, as specified by its app.kubernetes.io/name label, to port forward to")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only print the metrics of ApplicationSet from namespace")
+	return command
+}
+
+// scrapeMetrics returns the metric families served in the Prometheus text format at the given URL.
+func scrapeMetrics(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error scraping metrics from %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("error scraping metrics from %s: status %d: %s", url, resp.StatusCode, body)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing metrics from %s: %w", url, err)
+	}
+	return families, nil
+}
+
+// collectAppSetMetrics returns the metrics of the ApplicationSets of the given name, in the given namespace or in all
+// namespaces if it is empty, sorted by namespace.
+func collectAppSetMetrics(families map[string]*dto.MetricFamily, namespace string, name string) []appSetMetrics {
+	byNamespace := map[string]*appSetMetrics{}
+	forEach := func(family string, f func(m *appSetMetrics, labels map[string]string, metric *dto.Metric)) {
+		if families[family] == nil {
+			return
+		}
+		for _, metric := range families[family].GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["name"] != name || (namespace != "" && labels["namespace"] != namespace) {
+				continue
+			}
+			m, ok := byNamespace[labels["namespace"]]
+			if !ok {
+				m = &appSetMetrics{Namespace: labels["namespace"], Name: name}
+				byNamespace[labels["namespace"]] = m
+			}
+			f(m, labels, metric)
+		}
+	}
+
+	forEach("argocd_appset_info", func(m *appSetMetrics, labels map[string]string, _ *dto.Metric) {
+		m.ResourcesUpToDate = labels["resource_update_status"]
+	})
+	forEach("argocd_appset_owned_applications", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		m.OwnedApplications = int64(metric.GetGauge().GetValue())
+	})
+	forEach("argocd_appset_drifted_applications", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		m.DriftedApplications = int64(metric.GetGauge().GetValue())
+	})
+	forEach("argocd_appset_generated_parameter_sets", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		m.GeneratedParameterSets = int64(metric.GetGauge().GetValue())
+	})
+	forEach("argocd_appset_legacy_template", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		m.LegacyTemplate = metric.GetGauge().GetValue() == 1
+	})
+	forEach("argocd_appset_reconcile", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		m.Reconciles = metric.GetHistogram().GetSampleCount()
+		m.ReconcileSeconds = metric.GetHistogram().GetSampleSum()
+	})
+	forEach("argocd_appset_last_successful_reconcile_timestamp_seconds", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		at := time.Unix(int64(metric.GetGauge().GetValue()), 0).UTC()
+		m.LastSuccessfulReconcileAt = &at
+	})
+	forEach("argocd_appset_requeue_interval_seconds", func(m *appSetMetrics, _ map[string]string, metric *dto.Metric) {
+		m.RequeueIntervalSeconds = metric.GetGauge().GetValue()
+	})
+	forEach("argocd_appset_application_changes_total", func(m *appSetMetrics, labels map[string]string, metric *dto.Metric) {
+		if m.ApplicationChanges == nil {
+			m.ApplicationChanges = map[string]int64{}
+		}
+		m.ApplicationChanges[labels["action"]] = int64(metric.GetCounter().GetValue())
+	})
+	forEach("argocd_appset_generator_errors_total", func(m *appSetMetrics, labels map[string]string, metric *dto.Metric) {
+		if m.GeneratorErrors == nil {
+			m.GeneratorErrors = map[string]int64{}
+		}
+		m.GeneratorErrors[labels["generator"]] = int64(metric.GetCounter().GetValue())
+	})
+
+	res := make([]appSetMetrics, 0, len(byNamespace))
+	for _, m := range byNamespace {
+		res = append(res, *m)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Namespace < res[j].Namespace
+	})
+	return res
+}
+
+// printAppSetMetrics prints the metrics of the ApplicationSets as tables.
+func printAppSetMetrics(metrics []appSetMetrics, now time.Time) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for i, m := range metrics {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		_, _ = fmt.Fprintf(w, "Name:\t%s/%s\n", m.Namespace, m.Name)
+		if m.ResourcesUpToDate != "" {
+			_, _ = fmt.Fprintf(w, "Resources Up To Date:\t%s\n", m.ResourcesUpToDate)
+		}
+		_, _ = fmt.Fprintf(w, "Owned Applications:\t%d\n", m.OwnedApplications)
+		_, _ = fmt.Fprintf(w, "Drifted Applications:\t%d\n", m.DriftedApplications)
+		_, _ = fmt.Fprintf(w, "Generated Parameter Sets:\t%d\n", m.GeneratedParameterSets)
+		_, _ = fmt.Fprintf(w, "Legacy Template:\t%t\n", m.LegacyTemplate)
+		_, _ = fmt.Fprintf(w, "Reconciles:\t%d\n", m.Reconciles)
+		if m.Reconciles > 0 {
+			average := time.Duration(m.ReconcileSeconds / float64(m.Reconciles) * float64(time.Second))
+			_, _ = fmt.Fprintf(w, "Average Reconcile Duration:\t%s\n", average.Round(time.Millisecond))
+		}
+		if m.LastSuccessfulReconcileAt != nil {
+			_, _ = fmt.Fprintf(w, "Last Successful Reconcile:\t%s ago\n", duration.HumanDuration(now.Sub(*m.LastSuccessfulReconcileAt)))
+		}
+		if m.RequeueIntervalSeconds > 0 {
+			_, _ = fmt.Fprintf(w, "Requeue Interval:\t%s\n", time.Duration(m.RequeueIntervalSeconds*float64(time.Second)))
+		}
+		_, _ = fmt.Fprintf(w, "Applications Created:\t%d\n", m.ApplicationChanges["created"])
+		_, _ = fmt.Fprintf(w, "Applications Updated:\t%d\n", m.ApplicationChanges["updated"])
+		_, _ = fmt.Fprintf(w, "Applications Deleted:\t%d\n", m.ApplicationChanges["deleted"])
+		generators := make([]string, 0, len(m.GeneratorErrors))
+		for generator := range m.GeneratorErrors {
+			generators = append(generators, generator)
+		}
+		sort.Strings(generators)
+		for _, generator := range generators {
+			_, _ = fmt.Fprintf(w, "Generator Errors (%s):\t%d\n", generator, m.GeneratorErrors[generator])
+		}
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const appSetMetricsText = `# TYPE argocd_appset_info gauge
+argocd_appset_info{name="guestbook",namespace="argocd",resource_update_status="ApplicationSetUpToDate"} 1
+argocd_appset_info{name="guestbook",namespace="team-a",resource_update_status="ErrorOccurred"} 1
+argocd_appset_info{name="other",namespace="argocd",resource_update_status="ApplicationSetUpToDate"} 1
+# TYPE argocd_appset_owned_applications gauge
+argocd_appset_owned_applications{name="guestbook",namespace="argocd"} 3
+argocd_appset_owned_applications{name="other",namespace="argocd"} 7
+# TYPE argocd_appset_drifted_applications gauge
+argocd_appset_drifted_applications{name="guestbook",namespace="argocd"} 1
+# TYPE argocd_appset_generated_parameter_sets gauge
+argocd_appset_generated_parameter_sets{name="guestbook",namespace="argocd"} 3
+# TYPE argocd_appset_reconcile histogram
+argocd_appset_reconcile_bucket{name="guestbook",namespace="argocd",le="+Inf"} 4
+argocd_appset_reconcile_sum{name="guestbook",namespace="argocd"} 1
+argocd_appset_reconcile_count{name="guestbook",namespace="argocd"} 4
+# TYPE argocd_appset_last_successful_reconcile_timestamp_seconds gauge
+argocd_appset_last_successful_reconcile_timestamp_seconds{name="guestbook",namespace="argocd"} 1.7e+09
+# TYPE argocd_appset_requeue_interval_seconds gauge
+argocd_appset_requeue_interval_seconds{name="guestbook",namespace="argocd"} 180
+# TYPE argocd_appset_application_changes_total counter
+argocd_appset_application_changes_total{action="created",name="guestbook",namespace="argocd"} 3
+argocd_appset_application_changes_total{action="updated",name="guestbook",namespace="argocd"} 2
+# TYPE argocd_appset_generator_errors_total counter
+argocd_appset_generator_errors_total{generator="Git",name="guestbook",namespace="argocd"} 5
+`
+
+func newMetricsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(appSetMetricsText))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestCollectAppSetMetrics(t *testing.T) {
+	ts := newMetricsServer(t)
+	families, err := scrapeMetrics(t.Context(), ts.URL+"/metrics")
+	require.NoError(t, err)
+
+	t.Run("qualified name", func(t *testing.T) {
+		metrics := collectAppSetMetrics(families, "argocd", "guestbook")
+		require.Len(t, metrics, 1)
+		lastReconcile := time.Unix(1700000000, 0).UTC()
+		assert.Equal(t, appSetMetrics{
+			Namespace:                 "argocd",
+			Name:                      "guestbook",
+			ResourcesUpToDate:         "ApplicationSetUpToDate",
+			OwnedApplications:         3,
+			DriftedApplications:       1,
+			GeneratedParameterSets:    3,
+			Reconciles:                4,
+			ReconcileSeconds:          1,
+			LastSuccessfulReconcileAt: &lastReconcile,
+			RequeueIntervalSeconds:    180,
+			ApplicationChanges:        map[string]int64{"created": 3, "updated": 2},
+			GeneratorErrors:           map[string]int64{"Git": 5},
+		}, metrics[0])
+	})
+
+	t.Run("name in all namespaces", func(t *testing.T) {
+		metrics := collectAppSetMetrics(families, "", "guestbook")
+		require.Len(t, metrics, 2)
+		assert.Equal(t, "argocd", metrics[0].Namespace)
+		assert.Equal(t, "team-a", metrics[1].Namespace)
+		assert.Equal(t, "ErrorOccurred", metrics[1].ResourcesUpToDate)
+	})
+
+	t.Run("unknown ApplicationSet", func(t *testing.T) {
+		assert.Empty(t, collectAppSetMetrics(families, "argocd", "missing"))
+	})
+}
+
+func TestScrapeMetricsError(t *testing.T) {
+	ts := newMetricsServer(t)
+	_, err := scrapeMetrics(t.Context(), ts.URL+"/other")
+	require.ErrorContains(t, err, "status 404")
+}
+
+func TestPrintAppSetMetrics(t *testing.T) {
+	lastReconcile := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	output, err := captureOutput(func() error {
+		printAppSetMetrics([]appSetMetrics{{
+			Namespace:                 "argocd",
+			Name:                      "guestbook",
+			ResourcesUpToDate:         "ApplicationSetUpToDate",
+			OwnedApplications:         3,
+			GeneratedParameterSets:    3,
+			Reconciles:                4,
+			ReconcileSeconds:          1,
+			LastSuccessfulReconcileAt: &lastReconcile,
+			RequeueIntervalSeconds:    180,
+			ApplicationChanges:        map[string]int64{"created": 3},
+			GeneratorErrors:           map[string]int64{"Matrix": 1, "Git": 5},
+		}}, lastReconcile.Add(2*time.Minute))
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := `Name:                        argocd/guestbook
+Resources Up To Date:        ApplicationSetUpToDate
+Owned Applications:          3
+Drifted Applications:        0
+Generated Parameter Sets:    3
+Legacy Template:             false
+Reconciles:                  4
+Average Reconcile Duration:  250ms
+Last Successful Reconcile:   2m ago
+Requeue Interval:            3m0s
+Applications Created:        3
+Applications Updated:        0
+Applications Deleted:        0
+Generator Errors (Git):      5
+Generator Errors (Matrix):   1
+`
+	assert.Equal(t, expectation, output)
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/itchyny/gojq"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// appSetParameterSet is a parameter set printed by `argocd appset params`.
+type appSetParameterSet struct {
+	Generator   int32          `json:"generator"`
+	Application string         `json:"application"`
+	Params      map[string]any `json:"params"`
+}
+
+// NewApplicationSetParamsCommand returns a new instance of an `argocd appset params` command
+func NewApplicationSetParamsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		query           string
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "params APPSETNAME",
+		Short: "Print the parameter sets produced by the generators of an ApplicationSet",
+		Long: "Print the parameter sets produced by the generators of an ApplicationSet, along with the names of the " +
+			"Applications rendered from them. With --query, only the parameter sets for which the JQ expression is " +
+			"neither false nor null are printed.",
+		Example: templates.Examples(`
+	# Print the parameter sets of an ApplicationSet
+	argocd appset params APPSETNAME
+
+	# Print the parameter sets of the Applications deployed to a given cluster
+	argocd appset params APPSETNAME --query '.cluster == "dev-01"'
+
+	# Print the parameter sets with a nested parameter as JSON
+	argocd appset params APPSETNAME --query '.values.chartVersion | startswith("1.")' -o json
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var code *gojq.Code
+			if query != "" {
+				var err error
+				code, err = compileAppSetParamsQuery(query)
+				errors.CheckError(err)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			resp, err := appIf.Params(ctx, &applicationset.ApplicationSetParamsQuery{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+			})
+			errors.CheckError(err)
+
+			parameterSets, err := filterAppSetParameterSets(resp.ParameterSets, code)
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(parameterSets, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetParameterSets(parameterSets)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&query, "query", "", "JQ expression evaluated against each parameter set. Only the parameter sets for which it is neither false nor null are printed")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only get the parameter sets of ApplicationSet from namespace")
+	return command
+}
+
+// compileAppSetParamsQuery compiles the JQ expression of the --query flag.
+func compileAppSetParamsQuery(query string) (*gojq.Code, error) {
+	parsed, err := gojq.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query %q: %w", query, err)
+	}
+	code, err := gojq.Compile(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling query %q: %w", query, err)
+	}
+	return code, nil
+}
+
+// filterAppSetParameterSets decodes the parameter sets, and returns the ones matching the query. All the parameter
+// sets match a nil query.
+func filterAppSetParameterSets(parameterSets []*applicationset.ApplicationSetParameterSet, code *gojq.Code) ([]appSetParameterSet, error) {
+	res := make([]appSetParameterSet, 0, len(parameterSets))
+	for _, p := range parameterSets {
+		params := map[string]any{}
+		if err := json.Unmarshal([]byte(p.Params), &params); err != nil {
+			return nil, fmt.Errorf("error unmarshaling parameter set of Application %s: %w", p.Application, err)
+		}
+		if code != nil {
+			matches, err := appSetParamsQueryMatches(code, params)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating query against parameter set of Application %s: %w", p.Application, err)
+			}
+			if !matches {
+				continue
+			}
+		}
+		res = append(res, appSetParameterSet{Generator: p.Generator, Application: p.Application, Params: params})
+	}
+	return res, nil
+}
+
+// appSetParamsQueryMatches returns whether the first value output by the query is neither false nor null, the way JQ
+// evaluates a condition.
+func appSetParamsQueryMatches(code *gojq.Code, params map[string]any) (bool, error) {
+	iter := code.Run(params)
+	v, ok := iter.Next()
+	if !ok {
+		return false, nil
+	}
+	if err, ok := v.(error); ok {
+		return false, err
+	}
+	return v != nil && v != false, nil
+}
+
+func printAppSetParameterSets(parameterSets []appSetParameterSet) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GENERATOR\tAPPLICATION\tPARAMS\n")
+	for _, p := range parameterSets {
+		params, _ := json.Marshal(p.Params)
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", p.Generator, p.Application, params)
+	}
+	_ = w.Flush()
+}
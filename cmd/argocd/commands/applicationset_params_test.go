@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/itchyny/gojq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+)
+
+func TestFilterAppSetParameterSets(t *testing.T) {
+	parameterSets := []*applicationset.ApplicationSetParameterSet{
+		{Generator: 0, Application: "guestbook-dev-01", Params: `{"cluster": "dev-01", "chart": {"version": "1.2.0"}}`},
+		{Generator: 0, Application: "guestbook-prod-01", Params: `{"cluster": "prod-01", "chart": {"version": "2.0.0"}}`},
+		{Generator: 1, Application: "guestbook-qa", Params: `{"cluster": "qa"}`},
+	}
+
+	applications := func(res []appSetParameterSet) []string {
+		var names []string
+		for _, p := range res {
+			names = append(names, p.Application)
+		}
+		return names
+	}
+
+	for _, c := range []struct {
+		name         string
+		query        string
+		expected     []string
+		expectedErr  string
+		compileError string
+	}{
+		{name: "no query", expected: []string{"guestbook-dev-01", "guestbook-prod-01", "guestbook-qa"}},
+		{name: "equality", query: `.cluster == "dev-01"`, expected: []string{"guestbook-dev-01"}},
+		{name: "nested parameter", query: `.chart.version | startswith("2.")?`, expected: []string{"guestbook-prod-01"}},
+		{name: "missing parameter is null", query: `.chart`, expected: []string{"guestbook-dev-01", "guestbook-prod-01"}},
+		{name: "no match", query: `.cluster == "staging"`},
+		{name: "evaluation error", query: `.cluster + 1`, expectedErr: "error evaluating query against parameter set of Application guestbook-dev-01"},
+		{name: "invalid query", query: `.cluster ==`, compileError: "error parsing query"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var code *gojq.Code
+			if c.query != "" {
+				var err error
+				code, err = compileAppSetParamsQuery(c.query)
+				if c.compileError != "" {
+					require.ErrorContains(t, err, c.compileError)
+					return
+				}
+				require.NoError(t, err)
+			}
+			res, err := filterAppSetParameterSets(parameterSets, code)
+			if c.expectedErr != "" {
+				require.ErrorContains(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, applications(res))
+		})
+	}
+}
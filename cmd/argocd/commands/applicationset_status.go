@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+
+	argoio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// appSetFailOnDegraded fails `argocd appset status` if an Application of the ApplicationSet is degraded.
+	appSetFailOnDegraded = "degraded"
+	// appSetFailOnOutOfSync fails `argocd appset status` if an Application of the ApplicationSet is out of sync.
+	appSetFailOnOutOfSync = "outofsync"
+)
+
+// appSetStatusSummary is the status of an ApplicationSet printed by `argocd appset status`. It is meant to be consumed
+// by CI pipelines: fields may be added, but they are neither renamed nor removed.
+type appSetStatusSummary struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+	Generation int64  `json:"generation"`
+	// Applications is the number of Applications of the ApplicationSet.
+	Applications int `json:"applications"`
+	// Health and Sync count the Applications by health and sync status.
+	Health map[string]int `json:"health"`
+	Sync   map[string]int `json:"sync"`
+	// RolloutStep is the first step of the progressive sync with Applications which are not healthy yet, if any.
+	RolloutStep string `json:"rolloutStep,omitempty"`
+	// Errors are the messages of the error conditions of the ApplicationSet.
+	Errors                    []string                   `json:"errors"`
+	FailingApplications       []appSetFailingApplication `json:"failingApplications"`
+	LastSuccessfulReconcileAt *metav1.Time               `json:"lastSuccessfulReconcileAt,omitempty"`
+}
+
+// appSetFailingApplication is an Application of an ApplicationSet which is degraded or out of sync.
+type appSetFailingApplication struct {
+	Name   string `json:"name"`
+	Health string `json:"health"`
+	Sync   string `json:"sync"`
+}
+
+// NewApplicationSetStatusCommand returns a new instance of an `argocd appset status` command
+func NewApplicationSetStatusCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var failOn []string
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:   "status APPSETNAME",
+		Short: "Print a machine-readable summary of the status of an ApplicationSet",
+		Long: "Print a JSON summary of the status of an ApplicationSet, with a stable schema meant for CI pipelines. " +
+			"With --fail-on, the command exits with code 1 when an Application is in one of the given states.",
+		Example: templates.Examples(`
+	# Print the status of an ApplicationSet
+	argocd appset status APPSETNAME
+
+	# Fail if an Application of the ApplicationSet is degraded or out of sync
+	argocd appset status APPSETNAME --fail-on degraded,outofsync
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			for _, state := range failOn {
+				if state != appSetFailOnDegraded && state != appSetFailOnOutOfSync {
+					errors.CheckError(fmt.Errorf("unknown --fail-on state %q: must be one of %s, %s", state, appSetFailOnDegraded, appSetFailOnOutOfSync))
+				}
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			summary := newAppSetStatusSummary(appSet)
+			data, err := json.MarshalIndent(summary, "", "  ")
+			errors.CheckError(err)
+			fmt.Println(string(data))
+
+			if failures := appSetStatusFailures(summary, failOn); len(failures) > 0 {
+				fmt.Fprintf(os.Stderr, "ApplicationSet '%s' has %s\n", appSetName, strings.Join(failures, " and "))
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringSliceVar(&failOn, "fail-on", []string{}, fmt.Sprintf("Exit with code 1 if an Application is in one of the given states: %s, %s", appSetFailOnDegraded, appSetFailOnOutOfSync))
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only get the status of ApplicationSet from namespace")
+	return command
+}
+
+// newAppSetStatusSummary returns the status summary of the ApplicationSet.
+func newAppSetStatusSummary(appSet *arogappsetv1.ApplicationSet) appSetStatusSummary {
+	summary := appSetStatusSummary{
+		Name:                      appSet.Name,
+		Namespace:                 appSet.Namespace,
+		Generation:                appSet.Generation,
+		Applications:              len(appSet.Status.Resources),
+		Health:                    map[string]int{},
+		Sync:                      map[string]int{},
+		Errors:                    []string{},
+		FailingApplications:       []appSetFailingApplication{},
+		LastSuccessfulReconcileAt: appSet.Status.LastSuccessfulReconcileAt,
+	}
+
+	for _, resource := range appSet.Status.Resources {
+		healthStatus := string(health.HealthStatusUnknown)
+		if resource.Health != nil && resource.Health.Status != "" {
+			healthStatus = string(resource.Health.Status)
+		}
+		syncStatus := string(arogappsetv1.SyncStatusCodeUnknown)
+		if resource.Status != "" {
+			syncStatus = string(resource.Status)
+		}
+		summary.Health[healthStatus]++
+		summary.Sync[syncStatus]++
+		if healthStatus == string(health.HealthStatusDegraded) || syncStatus == string(arogappsetv1.SyncStatusCodeOutOfSync) {
+			summary.FailingApplications = append(summary.FailingApplications, appSetFailingApplication{
+				Name:   resource.Name,
+				Health: healthStatus,
+				Sync:   syncStatus,
+			})
+		}
+	}
+
+	for _, condition := range appSet.Status.Conditions {
+		if condition.Type == arogappsetv1.ApplicationSetConditionErrorOccurred && condition.Status == arogappsetv1.ApplicationSetConditionStatusTrue {
+			summary.Errors = append(summary.Errors, condition.Message)
+		}
+	}
+
+	var pendingSteps []string
+	for _, status := range appSet.Status.ApplicationStatus {
+		if status.Status != "Healthy" {
+			pendingSteps = append(pendingSteps, status.Step)
+		}
+	}
+	if len(pendingSteps) > 0 {
+		summary.RolloutStep = slices.MinFunc(pendingSteps, compareRolloutSteps)
+	}
+
+	return summary
+}
+
+// compareRolloutSteps compares the steps of a progressive sync, which are numbers stored as strings.
+func compareRolloutSteps(a, b string) int {
+	stepA, errA := strconv.Atoi(a)
+	stepB, errB := strconv.Atoi(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return stepA - stepB
+}
+
+// appSetStatusFailures returns the descriptions of the states of failOn some Applications of the summary are in.
+func appSetStatusFailures(summary appSetStatusSummary, failOn []string) []string {
+	var failures []string
+	if slices.Contains(failOn, appSetFailOnDegraded) && summary.Health[string(health.HealthStatusDegraded)] > 0 {
+		failures = append(failures, fmt.Sprintf("%d degraded Application(s)", summary.Health[string(health.HealthStatusDegraded)]))
+	}
+	if slices.Contains(failOn, appSetFailOnOutOfSync) && summary.Sync[string(arogappsetv1.SyncStatusCodeOutOfSync)] > 0 {
+		failures = append(failures, fmt.Sprintf("%d out of sync Application(s)", summary.Sync[string(arogappsetv1.SyncStatusCodeOutOfSync)]))
+	}
+	return failures
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestNewAppSetStatusSummary(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", Generation: 4},
+		Status: v1alpha1.ApplicationSetStatus{
+			Conditions: []v1alpha1.ApplicationSetCondition{
+				{Type: v1alpha1.ApplicationSetConditionErrorOccurred, Status: v1alpha1.ApplicationSetConditionStatusTrue, Message: "application validation failed"},
+				{Type: v1alpha1.ApplicationSetConditionResourcesUpToDate, Status: v1alpha1.ApplicationSetConditionStatusFalse, Message: "not up to date"},
+			},
+			Resources: []v1alpha1.ResourceStatus{
+				{Name: "guestbook-dev", Status: v1alpha1.SyncStatusCodeSynced, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+				{Name: "guestbook-staging", Status: v1alpha1.SyncStatusCodeOutOfSync, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+				{Name: "guestbook-prod", Status: v1alpha1.SyncStatusCodeSynced, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded}},
+				{Name: "guestbook-qa"},
+			},
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "guestbook-dev", Step: "1", Status: "Healthy"},
+				{Application: "guestbook-staging", Step: "2", Status: "Progressing"},
+				{Application: "guestbook-prod", Step: "10", Status: "Waiting"},
+			},
+		},
+	}
+
+	summary := newAppSetStatusSummary(appSet)
+	assert.Equal(t, appSetStatusSummary{
+		Name:         "guestbook",
+		Namespace:    "argocd",
+		Generation:   4,
+		Applications: 4,
+		Health:       map[string]int{"Healthy": 2, "Degraded": 1, "Unknown": 1},
+		Sync:         map[string]int{"Synced": 2, "OutOfSync": 1, "Unknown": 1},
+		RolloutStep:  "2",
+		Errors:       []string{"application validation failed"},
+		FailingApplications: []appSetFailingApplication{
+			{Name: "guestbook-staging", Health: "Healthy", Sync: "OutOfSync"},
+			{Name: "guestbook-prod", Health: "Degraded", Sync: "Synced"},
+		},
+	}, summary)
+
+	assert.Empty(t, appSetStatusFailures(summary, nil))
+	assert.Equal(t, []string{"1 degraded Application(s)"}, appSetStatusFailures(summary, []string{"degraded"}))
+	assert.Equal(t, []string{"1 degraded Application(s)", "1 out of sync Application(s)"}, appSetStatusFailures(summary, []string{"outofsync", "degraded"}))
+}
+
+func TestNewAppSetStatusSummaryEmpty(t *testing.T) {
+	summary := newAppSetStatusSummary(&v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook"}})
+	// The collections are never null in the JSON document
+	assert.NotNil(t, summary.Health)
+	assert.NotNil(t, summary.Sync)
+	assert.NotNil(t, summary.Errors)
+	assert.NotNil(t, summary.FailingApplications)
+	assert.Empty(t, summary.RolloutStep)
+	assert.Empty(t, appSetStatusFailures(summary, []string{"degraded", "outofsync"}))
+}
@@ -1,14 +1,23 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
 	"io"
 	"os"
 	"testing"
+	"text/tabwriter"
+	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -107,10 +116,141 @@ func TestPrintApplicationSetTable(t *testing.T) {
 		return nil
 	})
 	require.NoError(t, err)
-	expectation := "NAME               PROJECT  SYNCPOLICY  CONDITIONS\napp-name           default  nil         [{ResourcesUpToDate  <nil> True }]\nteam-two/app-name  default  nil         [{ResourcesUpToDate  <nil> True }]\n"
+	expectation := "NAME               PROJECT  SYNCPOLICY  CONDITIONS\napp-name           default  nil         ResourcesUpToDate\nteam-two/app-name  default  nil         ResourcesUpToDate\n"
 	assert.Equal(t, expectation, output)
 }
 
+func TestPrintApplicationSetTableWide(t *testing.T) {
+	output, err := captureOutput(func() error {
+		appSet := &v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "app-name",
+			},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Template: v1alpha1.ApplicationSetTemplate{
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "default",
+						Source: &v1alpha1.ApplicationSource{
+							RepoURL:        "https://github.com/argoproj/argo-cd.git",
+							Path:           "guestbook",
+							TargetRevision: "HEAD",
+						},
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				GeneratedParameterSets: []int64{3, 12},
+			},
+		}
+		notReconciled := appSet.DeepCopy()
+		notReconciled.Name = "not-reconciled"
+		notReconciled.Status.GeneratedParameterSets = nil
+		output := "wide"
+		printApplicationSetTable([]v1alpha1.ApplicationSet{*appSet, *notReconciled}, &output)
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := "NAME            PROJECT  SYNCPOLICY  CONDITIONS  REPO                                     PATH       TARGET  PARAMS\n" +
+		"app-name        default  nil         <none>      https://github.com/argoproj/argo-cd.git  guestbook  HEAD    3,12\n" +
+		"not-reconciled  default  nil         <none>      https://github.com/argoproj/argo-cd.git  guestbook  HEAD    \n"
+	assert.Equal(t, expectation, output)
+}
+
+func TestFormatAppSetConditionTypes(t *testing.T) {
+	assert.Equal(t, "<none>", formatAppSetConditionTypes(nil))
+	assert.Equal(t, "ErrorOccurred, ResourcesUpToDate", formatAppSetConditionTypes([]v1alpha1.ApplicationSetCondition{
+		{Type: v1alpha1.ApplicationSetConditionErrorOccurred, Status: v1alpha1.ApplicationSetConditionStatusTrue},
+		{Type: v1alpha1.ApplicationSetConditionParametersGenerated, Status: v1alpha1.ApplicationSetConditionStatusFalse},
+		{Type: v1alpha1.ApplicationSetConditionResourcesUpToDate, Status: v1alpha1.ApplicationSetConditionStatusTrue},
+	}))
+}
+
+func TestPrintAppSetConditions(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	appSet := &v1alpha1.ApplicationSet{
+		Status: v1alpha1.ApplicationSetStatus{
+			Conditions: []v1alpha1.ApplicationSetCondition{
+				{
+					Type:               v1alpha1.ApplicationSetConditionErrorOccurred,
+					Status:             v1alpha1.ApplicationSetConditionStatusTrue,
+					Reason:             v1alpha1.ApplicationSetReasonApplicationParamsGenerationError,
+					Message:            "failed to get params for first generator in matrix generator: error generating params from git: unable to process file 'clusters/production/config.json'",
+					LastTransitionTime: &metav1.Time{Time: now.Add(-90 * time.Second)},
+				},
+				{
+					Type:   v1alpha1.ApplicationSetConditionResourcesUpToDate,
+					Status: v1alpha1.ApplicationSetConditionStatusFalse,
+					Reason: v1alpha1.ApplicationSetReasonErrorOccurred,
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	printAppSetConditions(w, appSet, now)
+	require.NoError(t, w.Flush())
+	assert.Equal(t, `TYPE               STATUS  REASON                                AGE        MESSAGE
+ErrorOccurred      True    ApplicationGenerationFromParamsError  90s        failed to get params for first generator in matrix generator: error generating
+                                                                            params from git: unable to process file 'clusters/production/config.json'
+ResourcesUpToDate  False   ErrorOccurred                         <unknown>  
+`, buf.String())
+}
+
+func TestAppSetApplications(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}
+	newApp := func(name, namespace, owner string, sync v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) v1alpha1.Application {
+		app := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "default",
+				Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: name},
+			},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: sync, Revision: "abc123"},
+				Health: v1alpha1.HealthStatus{Status: healthStatus},
+			},
+		}
+		if owner != "" {
+			app.OwnerReferences = []metav1.OwnerReference{{Kind: "ApplicationSet", Name: owner}}
+		}
+		return app
+	}
+	apps := newAppSetApplications(appSet, []v1alpha1.Application{
+		newApp("guestbook-prod", "argocd", "guestbook", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusDegraded),
+		newApp("guestbook-dev", "argocd", "guestbook", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newApp("guestbook-staging", "argocd", "guestbook", v1alpha1.SyncStatusCodeSynced, ""),
+		newApp("other-dev", "argocd", "other", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newApp("guestbook-dev", "team-a", "guestbook", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newApp("standalone", "argocd", "", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+	})
+
+	require.Len(t, apps.Applications, 3)
+	assert.Equal(t, []string{"guestbook-dev", "guestbook-prod", "guestbook-staging"}, []string{apps.Applications[0].Name, apps.Applications[1].Name, apps.Applications[2].Name})
+	assert.Equal(t, string(health.HealthStatusUnknown), apps.Applications[2].Health)
+	assert.Equal(t, appSetApplicationsSummary{
+		Applications: 3,
+		Health:       map[string]int{"Healthy": 1, "Degraded": 1, "Unknown": 1},
+		Sync:         map[string]int{"Synced": 2, "OutOfSync": 1},
+	}, apps.Summary)
+	assert.Equal(t, "3 (Health: Degraded=1, Healthy=1, Unknown=1; Sync: OutOfSync=1, Synced=2)", formatAppSetApplicationsSummary(apps.Summary))
+	assert.Equal(t, "<none>", formatAppSetApplicationsSummary(newAppSetApplications(appSet, nil).Summary))
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	printAppSetApplications(w, apps.Applications[:2])
+	require.NoError(t, w.Flush())
+	assert.Equal(t, `NAME            PROJECT  CLUSTER                         NAMESPACE       SYNC       HEALTH    REVISION
+guestbook-dev   default  https://kubernetes.default.svc  guestbook-dev   Synced     Healthy   abc123
+guestbook-prod  default  https://kubernetes.default.svc  guestbook-prod  OutOfSync  Degraded  abc123
+`, buf.String())
+}
+
+func TestWrapText(t *testing.T) {
+	assert.Equal(t, []string{""}, wrapText("", 10))
+	assert.Equal(t, []string{"one two", "three", "averyveryverylongword", "four"}, wrapText("one two  three averyveryverylongword four", 10))
+}
+
 func TestPrintAppSetSummaryTable(t *testing.T) {
 	baseAppSet := &v1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -282,3 +422,218 @@ SyncPolicy:         <none>
 		})
 	}
 }
+
+func TestPrintAppSetValidationResults(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printAppSetValidationResults([]*applicationset.ApplicationSetValidationResult{
+			{Application: "guestbook-dev"},
+			{Application: "guestbook-prod", Conditions: []*v1alpha1.ApplicationCondition{
+				{Type: v1alpha1.ApplicationConditionInvalidSpecError, Message: `no matches for kind "CronJob" in version "batch/v1beta1"`},
+			}},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := `APPLICATION     STATUS   MESSAGE
+guestbook-dev   Valid    
+guestbook-prod  Invalid  no matches for kind "CronJob" in version "batch/v1beta1"
+`
+	assert.Equal(t, expectation, output)
+}
+
+func TestPrintAppSetDeprecationWarnings(t *testing.T) {
+	var stderr bytes.Buffer
+	c := &cobra.Command{}
+	c.SetErr(&stderr)
+	printAppSetDeprecationWarnings(c, &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{cluster}}-guestbook"},
+			},
+		},
+	})
+	assert.Equal(t, "Warning: ApplicationSet 'guestbook': {{cluster}} uses the legacy template syntax: set spec.goTemplate to true and use {{ .cluster }} instead\n", stderr.String())
+}
+
+func TestPreviewAppSetChanges(t *testing.T) {
+	existing := &v1alpha1.ApplicationSet{
+		Status: v1alpha1.ApplicationSetStatus{
+			Resources: []v1alpha1.ResourceStatus{
+				{Name: "guestbook-dev"},
+				{Name: "guestbook-staging"},
+				{Name: "guestbook-prod"},
+			},
+		},
+	}
+	generated := []*v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-dev"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-prod"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-qa"}},
+	}
+
+	t.Run("new ApplicationSet", func(t *testing.T) {
+		preview := previewAppSetChanges(&v1alpha1.ApplicationSet{}, nil, generated, nil)
+		assert.Equal(t, appSetPreview{create: 3}, preview)
+	})
+
+	t.Run("existing ApplicationSet", func(t *testing.T) {
+		preview := previewAppSetChanges(&v1alpha1.ApplicationSet{}, existing, generated, nil)
+		assert.Equal(t, appSetPreview{create: 1, update: 2, delete: 1}, preview)
+	})
+
+	t.Run("unchanged Applications are not updated", func(t *testing.T) {
+		generated := []*v1alpha1.Application{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "guestbook-dev", Labels: map[string]string{"env": "dev"}},
+				Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "guestbook-staging", Annotations: map[string]string{"team": "b"}},
+				Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "guestbook-prod"},
+				Spec:       v1alpha1.ApplicationSpec{Project: "prod"},
+			},
+		}
+		live := []v1alpha1.Application{
+			{
+				// the labels and annotations added to the live Application are kept
+				ObjectMeta: metav1.ObjectMeta{Name: "guestbook-dev", Labels: map[string]string{"env": "dev", "extra": "true"}, Annotations: map[string]string{"extra": "true"}},
+				Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "guestbook-staging", Annotations: map[string]string{"team": "a"}},
+				Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "guestbook-prod"},
+				Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+		}
+		preview := previewAppSetChanges(&v1alpha1.ApplicationSet{}, existing, generated, live)
+		assert.Equal(t, appSetPreview{update: 2}, preview)
+
+		appset := &v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				PreservedFields: &v1alpha1.ApplicationPreservedFields{Annotations: []string{"team"}},
+				IgnoreApplicationDifferences: v1alpha1.ApplicationSetIgnoreDifferences{
+					{JSONPointers: []string{"/spec/project"}},
+				},
+			},
+		}
+		preview = previewAppSetChanges(appset, existing, generated, live)
+		assert.Equal(t, appSetPreview{}, preview)
+	})
+
+	t.Run("create-only policy", func(t *testing.T) {
+		policy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+		appset := &v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &policy},
+			},
+		}
+		preview := previewAppSetChanges(appset, existing, generated, nil)
+		assert.Equal(t, appSetPreview{create: 1}, preview)
+	})
+}
+
+func TestPrintGeneratedApplications(t *testing.T) {
+	apps := []*v1alpha1.Application{{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-dev", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "HEAD"},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+		},
+	}}
+
+	t.Run("wide", func(t *testing.T) {
+		output, err := captureOutput(func() error {
+			printGeneratedApplications(apps, "wide")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "argocd/guestbook-dev  https://kubernetes.default.svc  guestbook  default")
+		assert.Contains(t, output, "https://github.com/argoproj/argocd-example-apps  guestbook  HEAD")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output, err := captureOutput(func() error {
+			printGeneratedApplications(apps, "json")
+			return nil
+		})
+		require.NoError(t, err)
+		var app v1alpha1.Application
+		require.NoError(t, json.Unmarshal([]byte(output), &app))
+		assert.Equal(t, "Application", app.Kind)
+		assert.Equal(t, "guestbook-dev", app.Name)
+		assert.Equal(t, "guestbook", app.Spec.Destination.Namespace)
+	})
+}
+
+func TestAppSetNamespaceFlag(t *testing.T) {
+	command := NewAppSetCommand(&argocdclient.ClientOptions{})
+	for _, name := range []string{"get", "create", "generate", "unset", "annotate", "label", "validate", "delete", "create-pr", "export", "metrics", "params", "status", "list"} {
+		sub, _, err := command.Find([]string{name})
+		require.NoError(t, err, name)
+		flag := sub.Flags().Lookup("appset-namespace")
+		require.NotNil(t, flag, name)
+		assert.Equal(t, "N", flag.Shorthand, name)
+	}
+}
+
+func TestUnsetAppSetPatch(t *testing.T) {
+	appset := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{.cluster}}-guestbook",
+					Annotations: map[string]string{
+						"notifications.argoproj.io/subscribe.on-sync-failed.slack": "alerts",
+						"owner": "team-a",
+					},
+					Labels: map[string]string{"env": "dev"},
+				},
+			},
+			TemplatePatch: ptr.To(`{"spec": {"project": "{{.project}}"}}`),
+		},
+	}
+
+	t.Run("nothing to remove", func(t *testing.T) {
+		patch := unsetAppSetPatch(appset, []string{"missing"}, []string{"missing"}, false)
+		assert.Empty(t, patch)
+	})
+
+	t.Run("remove template fields", func(t *testing.T) {
+		patch := unsetAppSetPatch(appset, []string{"notifications.argoproj.io/subscribe.on-sync-failed.slack"}, []string{"env"}, true)
+		assert.Equal(t, []map[string]string{
+			{"op": "remove", "path": "/spec/template/metadata/annotations/notifications.argoproj.io~1subscribe.on-sync-failed.slack"},
+			{"op": "remove", "path": "/spec/template/metadata/labels/env"},
+			{"op": "remove", "path": "/spec/templatePatch"},
+		}, patch)
+
+		updated, err := applyAppSetPatch(appset, patch)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"owner": "team-a"}, updated.Spec.Template.Annotations)
+		assert.Empty(t, updated.Spec.Template.Labels)
+		assert.Nil(t, updated.Spec.TemplatePatch)
+		assert.Equal(t, "{{.cluster}}-guestbook", updated.Spec.Template.Name)
+		// The ApplicationSet itself is left untouched
+		assert.Len(t, appset.Spec.Template.Annotations, 2)
+	})
+}
+
+func TestParseMetadataArgs(t *testing.T) {
+	set, remove, err := parseMetadataArgs([]string{"env=prod", "link=https://example.com/?a=b", "empty=", "team-"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "link": "https://example.com/?a=b", "empty": ""}, set)
+	assert.Equal(t, []string{"team"}, remove)
+
+	for _, arg := range []string{"env", "=prod", "-"} {
+		_, _, err := parseMetadataArgs([]string{arg})
+		require.ErrorContains(t, err, "invalid argument")
+	}
+}
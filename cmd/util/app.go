@@ -666,6 +666,18 @@ func constructAppsFromFileURL(fileURL, appName string, labels, annotations, args
 	return apps, nil
 }
 
+// ReadApps reads the Applications of the given file or URL, or of the standard input if fileURL is "-".
+func ReadApps(fileURL string) ([]*argoappv1.Application, error) {
+	if fileURL == "-" {
+		return constructAppsFromStdin()
+	}
+	apps := make([]*argoappv1.Application, 0)
+	if err := readAppsFromURI(fileURL, &apps); err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
 func ConstructApps(fileURL, appName string, labels, annotations, args []string, appOpts AppOptions, flags *pflag.FlagSet) ([]*argoappv1.Application, error) {
 	if fileURL == "-" {
 		return constructAppsFromStdin()
@@ -1,11 +1,13 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"sigs.k8s.io/yaml"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/config"
@@ -29,16 +31,16 @@ func constructAppsetFromFileURL(fileURL string) ([]*argoprojiov1alpha1.Applicati
 	return appset, nil
 }
 
-func readAppsetFromURI(fileURL string, appset *[]*argoprojiov1alpha1.ApplicationSet) error {
-	readFilePayload := func() ([]byte, error) {
-		parsedURL, err := url.ParseRequestURI(fileURL)
-		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
-			return os.ReadFile(fileURL)
-		}
-		return config.ReadRemoteFile(fileURL)
+func readFilePayload(fileURL string) ([]byte, error) {
+	parsedURL, err := url.ParseRequestURI(fileURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return os.ReadFile(fileURL)
 	}
+	return config.ReadRemoteFile(fileURL)
+}
 
-	yml, err := readFilePayload()
+func readAppsetFromURI(fileURL string, appset *[]*argoprojiov1alpha1.ApplicationSet) error {
+	yml, err := readFilePayload(fileURL)
 	if err != nil {
 		return fmt.Errorf("error reading file payload: %w", err)
 	}
@@ -63,3 +65,31 @@ func readAppset(yml []byte, appsets *[]*argoprojiov1alpha1.ApplicationSet) error
 	// we reach here if there is no error found while reading the Application Set
 	return nil
 }
+
+// ReadApplicationSetParams reads the parameter sets used to render an ApplicationSet template from a file or URL.
+// The payload may be JSON or YAML, and contain either a list of parameter sets or a single parameter set.
+func ReadApplicationSetParams(fileURL string) ([]map[string]any, error) {
+	data, err := readFilePayload(fileURL)
+	if err != nil {
+		return nil, fmt.Errorf("error reading params from file %s: %w", fileURL, err)
+	}
+	return readAppsetParams(data)
+}
+
+func readAppsetParams(data []byte) ([]map[string]any, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting params to JSON: %w", err)
+	}
+
+	var params []map[string]any
+	if err := json.Unmarshal(jsonData, &params); err == nil {
+		return params, nil
+	}
+
+	var param map[string]any
+	if err := json.Unmarshal(jsonData, &param); err != nil {
+		return nil, fmt.Errorf("params must be an object or a list of objects: %w", err)
+	}
+	return []map[string]any{param}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -39,3 +40,22 @@ func TestReadAppSet(t *testing.T) {
 	}
 	assert.Len(t, appSets, 1)
 }
+
+func TestReadAppsetParams(t *testing.T) {
+	t.Run("list of parameter sets", func(t *testing.T) {
+		params, err := readAppsetParams([]byte(`[{"cluster": "dev"}, {"cluster": "prod"}]`))
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"cluster": "dev"}, {"cluster": "prod"}}, params)
+	})
+
+	t.Run("single parameter set in YAML", func(t *testing.T) {
+		params, err := readAppsetParams([]byte("cluster: dev\nvalues:\n  replicas: 2\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"cluster": "dev", "values": map[string]any{"replicas": float64(2)}}}, params)
+	})
+
+	t.Run("invalid parameter set", func(t *testing.T) {
+		_, err := readAppsetParams([]byte(`"cluster"`))
+		require.Error(t, err)
+	})
+}
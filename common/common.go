@@ -272,6 +272,8 @@ const (
 	EnvHelmIndexCacheDuration = "ARGOCD_HELM_INDEX_CACHE_DURATION"
 	// EnvAppConfigPath allows to override the configuration path for repo server
 	EnvAppConfigPath = "ARGOCD_APP_CONF_PATH"
+	// EnvApplicationSetGeneratorServerToken is the token authenticating the ApplicationSet controllers with the generator service
+	EnvApplicationSetGeneratorServerToken = "ARGOCD_APPLICATIONSET_GENERATOR_SERVER_TOKEN"
 	// EnvAuthToken is the environment variable name for the auth token used by the CLI
 	EnvAuthToken = "ARGOCD_AUTH_TOKEN"
 	// EnvLogFormat log format that is defined by `--logformat` option
@@ -403,6 +405,16 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetStrictGenerators is an annotation overriding, with "true" or "false", whether the unrecognized generators of an ApplicationSet block its reconciliation instead of only being logged.
+	AnnotationApplicationSetStrictGenerators = "argocd.argoproj.io/application-set-strict-generators"
+	// AnnotationApplicationSetDebug is an annotation which, when set to "true", makes the `dump` template function available to the Go templates of an ApplicationSet.
+	AnnotationApplicationSetDebug = "argocd.argoproj.io/application-set-debug"
+	// AnnotationApplicationSetSpecHash is the annotation of the Applications generated by an ApplicationSet holding the hash of their desired state, used by the ApplicationSet controller to skip the comparison of the unchanged Applications.
+	AnnotationApplicationSetSpecHash = "argocd.argoproj.io/application-set-spec-hash"
+	// LabelKeyApplicationSetControllerInstance is the label selecting the ApplicationSet controller instance which reconciles an ApplicationSet. ApplicationSets without this label are reconciled by the controller instances started without an instance name.
+	LabelKeyApplicationSetControllerInstance = "applicationset.argoproj.io/controller-instance"
+	// LabelKeyApplicationSetTemplateFunctions is the label, set to "true", of the ConfigMaps of the Argo CD namespace registering the template functions of a plugin.
+	LabelKeyApplicationSetTemplateFunctions = "applicationset.argoproj.io/template-functions"
 )
 
 // gRPC settings
@@ -182,7 +182,10 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		registry,
 		// contains workqueue metrics, process and golang metrics
 		ctrlmetrics.Registry,
-	}, promhttp.HandlerOpts{}))
+	}, promhttp.HandlerOpts{
+		// OpenMetrics is required to expose the exemplars linking the reconcile metrics to the traces
+		EnableOpenMetrics: true,
+	}))
 	profile.RegisterProfiler(mux)
 	healthz.ServeHealthCheck(mux, healthCheck)
 
@@ -295,9 +298,10 @@ func (m *MetricsServer) ObserveResourceEventsProcessingDuration(server string, d
 	m.resourceEventsNumberGauge.WithLabelValues(server).Set(float64(processedEventsNumber))
 }
 
-// IncReconcile increments the reconcile counter for an application
-func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.Duration) {
-	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
+// IncReconcile increments the reconcile counter for an application. If the context holds a sampled span, the ID of its
+// trace is attached to the observation as an exemplar.
+func (m *MetricsServer) IncReconcile(ctx context.Context, app *argoappv1.Application, duration time.Duration) {
+	metricsutil.ObserveWithTraceExemplar(ctx, m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server), duration.Seconds())
 }
 
 // HasExpiration return true if expiration is set
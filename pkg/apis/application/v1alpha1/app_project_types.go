@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -271,6 +272,12 @@ func (proj *AppProject) ValidateProject() error {
 		}
 	}
 
+	for _, generatorType := range slices.Concat(proj.Spec.ApplicationSetGeneratorWhitelist, proj.Spec.ApplicationSetGeneratorBlacklist) {
+		if !IsApplicationSetGeneratorType(generatorType) {
+			return status.Errorf(codes.InvalidArgument, "unknown ApplicationSet generator type '%s'", generatorType)
+		}
+	}
+
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range proj.Spec.DestinationServiceAccounts {
 		if strings.Contains(destServiceAcct.Server, "!") {
@@ -398,6 +405,17 @@ func (proj AppProject) IsGroupKindPermitted(gk schema.GroupKind, namespaced bool
 	return isWhiteListed && !isBlackListed
 }
 
+// IsApplicationSetGeneratorPermitted returns whether the ApplicationSets of the project may use the given generator
+// type, such as "git" or "scmProvider"
+func (proj AppProject) IsApplicationSetGeneratorPermitted(generatorType string) bool {
+	generatorWhitelist := proj.Spec.ApplicationSetGeneratorWhitelist
+	generatorBlacklist := proj.Spec.ApplicationSetGeneratorBlacklist
+
+	isWhiteListed := len(generatorWhitelist) == 0 || slices.Contains(generatorWhitelist, generatorType)
+	isBlackListed := slices.Contains(generatorBlacklist, generatorType)
+	return isWhiteListed && !isBlackListed
+}
+
 // IsLiveResourcePermitted returns whether a live resource found in the cluster is permitted by an AppProject
 func (proj AppProject) IsLiveResourcePermitted(un *unstructured.Unstructured, destCluster *Cluster, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
 	return proj.IsResourcePermitted(un.GroupVersionKind().GroupKind(), un.GetNamespace(), destCluster, projectClusters)
@@ -19,11 +19,15 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/security"
 
+	"github.com/robfig/cron/v3"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -33,6 +37,10 @@ import (
 type SecretRef struct {
 	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
 	Key        string `json:"key" protobuf:"bytes,2,opt,name=key"`
+	// Store is the name of the external secret store, configured in the ApplicationSet controller, holding the secret.
+	// SecretName is then the path of the secret in the store. The secret is read from a Kubernetes Secret if empty.
+	// +optional
+	Store string `json:"store,omitempty" protobuf:"bytes,3,opt,name=store"`
 }
 
 // Utility struct for a reference to a configmap key.
@@ -61,8 +69,10 @@ func (a *ApplicationSet) RBACName(defaultNS string) string {
 
 // ApplicationSetSpec represents a class of application set state.
 type ApplicationSetSpec struct {
-	GoTemplate        bool                        `json:"goTemplate,omitempty" protobuf:"bytes,1,name=goTemplate"`
-	Generators        []ApplicationSetGenerator   `json:"generators" protobuf:"bytes,2,name=generators"`
+	GoTemplate bool                      `json:"goTemplate,omitempty" protobuf:"bytes,1,name=goTemplate"`
+	Generators []ApplicationSetGenerator `json:"generators" protobuf:"bytes,2,name=generators"`
+	// Template is the template of the generated Applications. It may be omitted when TemplateRef is set.
+	// +optional
 	Template          ApplicationSetTemplate      `json:"template" protobuf:"bytes,3,name=template"`
 	SyncPolicy        *ApplicationSetSyncPolicy   `json:"syncPolicy,omitempty" protobuf:"bytes,4,name=syncPolicy"`
 	Strategy          *ApplicationSetStrategy     `json:"strategy,omitempty" protobuf:"bytes,5,opt,name=strategy"`
@@ -74,6 +84,79 @@ type ApplicationSetSpec struct {
 	ApplyNestedSelectors         bool                            `json:"applyNestedSelectors,omitempty" protobuf:"bytes,8,name=applyNestedSelectors"`
 	IgnoreApplicationDifferences ApplicationSetIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,9,name=ignoreApplicationDifferences"`
 	TemplatePatch                *string                         `json:"templatePatch,omitempty" protobuf:"bytes,10,name=templatePatch"`
+	// RevisionHistoryLimit is the default revision history limit of the generated Applications. It is only applied
+	// to the Applications whose template does not set a revision history limit of its own.
+	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,11,name=revisionHistoryLimit"`
+	// Hooks are HTTP services invoked while the Applications of the ApplicationSet are generated.
+	Hooks *ApplicationSetHooks `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
+	// ManagedNamespace makes the generated Applications create their destination namespace, with the given metadata.
+	// It is only applied to the Applications whose template does not configure the namespace creation itself.
+	ManagedNamespace *ApplicationSetManagedNamespace `json:"managedNamespace,omitempty" protobuf:"bytes,13,opt,name=managedNamespace"`
+	// TemplateRef references a template stored outside of the ApplicationSet. The fields set in Template take
+	// precedence over the ones of the referenced template.
+	TemplateRef *ApplicationSetTemplateRef `json:"templateRef,omitempty" protobuf:"bytes,14,opt,name=templateRef"`
+	// PreserveApplicationFinalizers controls whether the resources finalizer is added to the generated Applications
+	// whose template declares no finalizers. It defaults to true. When set to false, the finalizer is never added,
+	// whatever the sync policy, so that deleting an Application leaves its resources in place.
+	PreserveApplicationFinalizers *bool `json:"preserveApplicationFinalizers,omitempty" protobuf:"varint,15,opt,name=preserveApplicationFinalizers"`
+	// FreezeWindows are the periods during which the Applications of the ApplicationSet are neither created, updated
+	// nor deleted. The changes the controller would make are only reported in the conditions of the ApplicationSet.
+	FreezeWindows []ApplicationSetFreezeWindow `json:"freezeWindows,omitempty" protobuf:"bytes,16,rep,name=freezeWindows"`
+}
+
+// ApplicationSetFreezeWindow is a period during which the Applications of an ApplicationSet are not modified.
+type ApplicationSetFreezeWindow struct {
+	// Schedule is the time the window begins, specified in cron format
+	Schedule string `json:"schedule" protobuf:"bytes,1,name=schedule"`
+	// Duration is the amount of time the window lasts, such as 2h
+	Duration string `json:"duration" protobuf:"bytes,2,name=duration"`
+	// TimeZone of the schedule. Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,3,opt,name=timeZone"`
+}
+
+// ApplicationSetTemplateRef references a template stored outside of the ApplicationSet.
+type ApplicationSetTemplateRef struct {
+	// Git references a file of a Git repository holding the template.
+	Git *ApplicationSetTemplateGitRef `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
+}
+
+// ApplicationSetTemplateGitRef references a file of a Git repository holding an ApplicationSet template, with the
+// metadata and spec fields of the template at its root.
+type ApplicationSetTemplateGitRef struct {
+	// RepoURL is the URL of the Git repository.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
+	// Revision is the revision of the Git repository. Defaults to HEAD.
+	Revision string `json:"revision,omitempty" protobuf:"bytes,2,opt,name=revision"`
+	// Path is the path of the file within the Git repository.
+	Path string `json:"path" protobuf:"bytes,3,name=path"`
+}
+
+// ApplicationSetManagedNamespace configures the destination namespaces created by the generated Applications.
+type ApplicationSetManagedNamespace struct {
+	// Metadata holds the labels and annotations set on the namespaces. Its values are rendered with the parameters of
+	// the generators, like the template.
+	Metadata *ManagedNamespaceMetadata `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+}
+
+// ApplicationSetHooks configures the HTTP services invoked while the Applications of an ApplicationSet are generated.
+type ApplicationSetHooks struct {
+	// PreRender hooks are invoked in order with the parameter sets produced by each generator, before the template is
+	// rendered against them, and may modify them.
+	PreRender []ApplicationSetHook `json:"preRender,omitempty" protobuf:"bytes,1,rep,name=preRender"`
+	// PostRender hooks are invoked in order with the rendered Applications, and may veto some of them. Vetoed
+	// Applications are neither created nor updated.
+	PostRender []ApplicationSetHook `json:"postRender,omitempty" protobuf:"bytes,2,rep,name=postRender"`
+}
+
+// ApplicationSetHook is an HTTP service invoked while the Applications of an ApplicationSet are generated.
+type ApplicationSetHook struct {
+	// Name identifies the hook in logs and conditions.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ConfigMapRef references the ConfigMap of the Argo CD namespace holding the `baseUrl` of the service, and
+	// optionally the `token` and `requestTimeout`, in the same format as for the plugin generator.
+	ConfigMapRef PluginConfigMapRef `json:"configMapRef" protobuf:"bytes,2,opt,name=configMapRef"`
+	// FailurePolicy is the behaviour when the hook fails. One of Fail (default) or Ignore.
+	FailurePolicy string `json:"failurePolicy,omitempty" protobuf:"bytes,3,opt,name=failurePolicy"`
 }
 
 type ApplicationPreservedFields struct {
@@ -135,6 +218,18 @@ type ApplicationSetSyncPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=create-only;create-update;create-delete;sync
 	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
+	// PruneAfterHealthy only deletes the Applications which are no longer generated once the generated Applications with
+	// the same destination are Healthy, e.g. when a change of the parameters renames the Applications.
+	PruneAfterHealthy *ApplicationSetPruneAfterHealthy `json:"pruneAfterHealthy,omitempty" protobuf:"bytes,3,opt,name=pruneAfterHealthy"`
+}
+
+// ApplicationSetPruneAfterHealthy configures the deletion of the Applications which are no longer generated, and are
+// replaced by generated Applications with the same destination.
+type ApplicationSetPruneAfterHealthy struct {
+	// TimeoutSeconds is how long to wait, from the creation of the replacing Applications, for them to be Healthy. The
+	// replaced Applications are deleted once it expires, even though the replacing Applications are not Healthy.
+	// Defaults to 600.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,1,opt,name=timeoutSeconds"`
 }
 
 // ApplicationSetIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
@@ -200,7 +295,10 @@ type ApplicationSetGenerator struct {
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
-	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+	Plugin         *PluginGenerator         `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+	OCI            *OCIGenerator            `json:"oci,omitempty" protobuf:"bytes,11,name=oci"`
+	AWSAccounts    *AWSAccountsGenerator    `json:"awsAccounts,omitempty" protobuf:"bytes,12,name=awsAccounts"`
+	TerraformState *TerraformStateGenerator `json:"terraformState,omitempty" protobuf:"bytes,13,name=terraformState"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -223,6 +321,15 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+	OCI    *OCIGenerator    `json:"oci,omitempty" protobuf:"bytes,11,name=oci"`
+
+	// Priority orders the generators of a MergeGenerator: the generator with the lowest priority is the base
+	// generator, and the parameters of the generators with higher priorities override it. Either every generator of
+	// the MergeGenerator or none of them must have a priority, and priorities must be unique.
+	Priority *int64 `json:"priority,omitempty" protobuf:"varint,12,opt,name=priority"`
+
+	AWSAccounts    *AWSAccountsGenerator    `json:"awsAccounts,omitempty" protobuf:"bytes,13,name=awsAccounts"`
+	TerraformState *TerraformStateGenerator `json:"terraformState,omitempty" protobuf:"bytes,14,name=terraformState"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -242,6 +349,14 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,9,name=oci"`
+
+	// Priority orders the generators of a nested MergeGenerator, see ApplicationSetNestedGenerator.
+	Priority *int64 `json:"priority,omitempty" protobuf:"varint,10,opt,name=priority"`
+
+	AWSAccounts    *AWSAccountsGenerator    `json:"awsAccounts,omitempty" protobuf:"bytes,11,name=awsAccounts"`
+	TerraformState *TerraformStateGenerator `json:"terraformState,omitempty" protobuf:"bytes,12,name=terraformState"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -261,6 +376,10 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
 			Selector:                terminalGenerator.Selector,
+			OCI:                     terminalGenerator.OCI,
+			AWSAccounts:             terminalGenerator.AWSAccounts,
+			TerraformState:          terminalGenerator.TerraformState,
+			Priority:                terminalGenerator.Priority,
 		}
 	}
 	return nestedGenerators
@@ -384,6 +503,14 @@ type ClusterGenerator struct {
 
 	// returns the clusters a single 'clusters' value in the template
 	FlatList bool `json:"flatList,omitempty" protobuf:"bytes,4,name=flatList"`
+
+	// ValuesObject contains nested values which are passed as parameters to the template, along with Values. Their
+	// strings are templated with the parameters of the cluster. This takes precedence over Values.
+	ValuesObject *apiextensionsv1.JSON `json:"valuesObject,omitempty" protobuf:"bytes,5,opt,name=valuesObject"`
+
+	// RequeueAfterSeconds is how long before the clusters are listed again. By default, the ApplicationSet is only
+	// reconciled again when the cluster secrets change.
+	RequeueAfterSeconds *int64 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,6,opt,name=requeueAfterSeconds"`
 }
 
 // DuckType defines a generator to match against clusters registered with ArgoCD.
@@ -413,6 +540,13 @@ type GitGenerator struct {
 
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,8,name=values"`
+	// ContentParamName is the name of the parameter holding the content of the files found by the files generator,
+	// instead of exposing each field of the content as a top-level parameter. With goTemplate, the parameter holds
+	// the parsed content, including its nested objects and arrays.
+	ContentParamName string `json:"contentParamName,omitempty" protobuf:"bytes,9,name=contentParamName"`
+	// CommitParams adds the commitSha, commitAuthor and commitTimestamp parameters of the last commit modifying each
+	// file found by the files generator. Looking up the commits walks the history of the repository.
+	CommitParams bool `json:"commitParams,omitempty" protobuf:"varint,10,opt,name=commitParams"`
 }
 
 type GitDirectoryGeneratorItem struct {
@@ -789,6 +923,156 @@ type PluginGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
 }
 
+// OCIGenerator defines a generator producing a parameter set for each tag of an OCI repository, for example the
+// versions of a Helm chart or of an image pushed to a registry, or for each version of a chart of a Helm repository.
+type OCIGenerator struct {
+	// RepoURL is the OCI repository to list the tags of, for example ghcr.io/argoproj/argo-helm/argo-cd. The oci://
+	// prefix is optional.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// TagFilter is a regular expression the tags must match. All the tags are kept if it is empty.
+	TagFilter string `json:"tagFilter,omitempty" protobuf:"bytes,2,opt,name=tagFilter"`
+	// SemverConstraint keeps only the tags which are semantic versions satisfying the constraint, for example
+	// ">=1.2.0 <2.0.0".
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,3,opt,name=semverConstraint"`
+	// Username to authenticate to the registry with, along with the password referenced by PasswordRef.
+	Username string `json:"username,omitempty" protobuf:"bytes,4,opt,name=username"`
+	// PasswordRef is a reference to the password or access token to authenticate to the registry with.
+	PasswordRef *SecretRef `json:"passwordRef,omitempty" protobuf:"bytes,5,opt,name=passwordRef"`
+	// AWSECR authenticates to an Amazon ECR registry with the AWS identity of the ApplicationSet controller.
+	AWSECR *OCIGeneratorAWSECR `json:"awsECR,omitempty" protobuf:"bytes,6,opt,name=awsECR"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,7,opt,name=insecure"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,8,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,9,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
+	// Chart is the name of a chart of the Helm repository RepoURL, to generate a parameter set for each version of the
+	// chart. The versions are read from the index.yaml of HTTP(S) repositories, with the credentials of the matching Argo
+	// CD repository, and from the tags of RepoURL/Chart for OCI registries.
+	Chart string `json:"chart,omitempty" protobuf:"bytes,11,opt,name=chart"`
+}
+
+// OCIGeneratorAWSECR defines the authentication to an Amazon ECR registry.
+type OCIGeneratorAWSECR struct {
+	// Region of the registry. The region of the ApplicationSet controller is used if it is empty.
+	Region string `json:"region,omitempty" protobuf:"bytes,1,opt,name=region"`
+	// Role provides the AWS IAM role to assume, for cross-account registries.
+	// if not provided, AppSet controller will use its pod/node identity.
+	Role string `json:"role,omitempty" protobuf:"bytes,2,opt,name=role"`
+}
+
+// AWSAccountsGenerator defines a generator producing a parameter set for each account of an AWS Organization, for
+// example to bootstrap every AWS account with an Application.
+type AWSAccountsGenerator struct {
+	// OrganizationalUnits keeps only the accounts of the given organizational units, for example ou-abcd-12345678. All
+	// the accounts of the organization are listed if it is empty.
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty" protobuf:"bytes,1,opt,name=organizationalUnits"`
+	// Recursive also lists the accounts of the organizational units nested in OrganizationalUnits.
+	Recursive bool `json:"recursive,omitempty" protobuf:"varint,2,opt,name=recursive"`
+	// TagFilters keeps only the accounts with the given tags. An account must match the filters of every key, and one
+	// of the values of a key if any.
+	TagFilters []*TagFilter `json:"tagFilters,omitempty" protobuf:"bytes,3,opt,name=tagFilters"`
+	// IncludeInactive also lists the accounts which are not active, such as the suspended accounts.
+	IncludeInactive bool `json:"includeInactive,omitempty" protobuf:"varint,4,opt,name=includeInactive"`
+	// Region of the AWS Organizations endpoint. The region of the ApplicationSet controller, or us-east-1, is used if
+	// it is empty.
+	Region string `json:"region,omitempty" protobuf:"bytes,5,opt,name=region"`
+	// Role provides the AWS IAM role to assume, for example a role of the management account of the organization.
+	// If not provided, the ApplicationSet controller uses its pod identity (IRSA), or the access key of AccessKeyIDRef.
+	Role string `json:"role,omitempty" protobuf:"bytes,6,opt,name=role"`
+	// AccessKeyIDRef is a reference to the AWS access key ID to authenticate with, along with SecretAccessKeyRef.
+	AccessKeyIDRef *SecretRef `json:"accessKeyIDRef,omitempty" protobuf:"bytes,7,opt,name=accessKeyIDRef"`
+	// SecretAccessKeyRef is a reference to the AWS secret access key of AccessKeyIDRef.
+	SecretAccessKeyRef *SecretRef `json:"secretAccessKeyRef,omitempty" protobuf:"bytes,8,opt,name=secretAccessKeyRef"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,9,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,10,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,11,name=values"`
+}
+
+// TerraformStateGenerator defines a generator producing parameter sets from the outputs of a Terraform or OpenTofu
+// state, so that the infrastructure provisioned outside of Kubernetes, such as clusters or databases, drives the
+// generation of Applications. Exactly one of S3, GCS, HTTP and Cloud must be set.
+type TerraformStateGenerator struct {
+	// S3 reads the state from an AWS S3 bucket, like the s3 backend.
+	S3 *TerraformStateS3 `json:"s3,omitempty" protobuf:"bytes,1,opt,name=s3"`
+	// GCS reads the state from a Google Cloud Storage bucket, like the gcs backend.
+	GCS *TerraformStateGCS `json:"gcs,omitempty" protobuf:"bytes,2,opt,name=gcs"`
+	// HTTP reads the state from an HTTP endpoint, like the http backend.
+	HTTP *TerraformStateHTTP `json:"http,omitempty" protobuf:"bytes,3,opt,name=http"`
+	// Cloud reads the outputs of a workspace of HCP Terraform (formerly Terraform Cloud) or Terraform Enterprise.
+	Cloud *TerraformStateCloud `json:"cloud,omitempty" protobuf:"bytes,4,opt,name=cloud"`
+	// ElementsFrom is the name of an output holding a list or a map: a parameter set is generated for each of its
+	// elements, instead of a single parameter set with all the outputs.
+	ElementsFrom string `json:"elementsFrom,omitempty" protobuf:"bytes,5,opt,name=elementsFrom"`
+	// IncludeSensitive also passes the sensitive outputs as parameters. They are skipped by default, since the
+	// parameters are usually rendered into the generated Applications.
+	IncludeSensitive bool `json:"includeSensitive,omitempty" protobuf:"varint,6,opt,name=includeSensitive"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,7,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,8,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,9,name=values"`
+}
+
+// TerraformStateS3 locates a Terraform state stored in an AWS S3 bucket.
+type TerraformStateS3 struct {
+	// Bucket is the name of the S3 bucket.
+	Bucket string `json:"bucket" protobuf:"bytes,1,name=bucket"`
+	// Key is the path of the state in the bucket, for example network/terraform.tfstate, or
+	// env:/production/network/terraform.tfstate for the production workspace.
+	Key string `json:"key" protobuf:"bytes,2,name=key"`
+	// Region of the bucket. The region of the ApplicationSet controller is used if it is empty.
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+	// Endpoint overrides the S3 endpoint, for example to read the state from an S3 compatible storage such as MinIO.
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,4,opt,name=endpoint"`
+	// Role provides the AWS IAM role to assume to read the state.
+	// If not provided, the ApplicationSet controller uses its pod identity (IRSA), or the access key of AccessKeyIDRef.
+	Role string `json:"role,omitempty" protobuf:"bytes,5,opt,name=role"`
+	// AccessKeyIDRef is a reference to the AWS access key ID to authenticate with, along with SecretAccessKeyRef.
+	AccessKeyIDRef *SecretRef `json:"accessKeyIDRef,omitempty" protobuf:"bytes,6,opt,name=accessKeyIDRef"`
+	// SecretAccessKeyRef is a reference to the AWS secret access key of AccessKeyIDRef.
+	SecretAccessKeyRef *SecretRef `json:"secretAccessKeyRef,omitempty" protobuf:"bytes,7,opt,name=secretAccessKeyRef"`
+}
+
+// TerraformStateGCS locates a Terraform state stored in a Google Cloud Storage bucket.
+type TerraformStateGCS struct {
+	// Bucket is the name of the GCS bucket.
+	Bucket string `json:"bucket" protobuf:"bytes,1,name=bucket"`
+	// Object is the path of the state in the bucket, for example network/default.tfstate.
+	Object string `json:"object" protobuf:"bytes,2,name=object"`
+	// CredentialsRef is a reference to the JSON key of a service account to authenticate with. If not provided, the
+	// ApplicationSet controller uses its pod identity (Workload Identity).
+	CredentialsRef *SecretRef `json:"credentialsRef,omitempty" protobuf:"bytes,3,opt,name=credentialsRef"`
+}
+
+// TerraformStateHTTP locates a Terraform state served by an HTTP endpoint.
+type TerraformStateHTTP struct {
+	// URL of the state, fetched with a GET request.
+	URL string `json:"url" protobuf:"bytes,1,name=url"`
+	// UsernameRef is a reference to the username to authenticate with basic authentication, along with PasswordRef.
+	UsernameRef *SecretRef `json:"usernameRef,omitempty" protobuf:"bytes,2,opt,name=usernameRef"`
+	// PasswordRef is a reference to the password of UsernameRef.
+	PasswordRef *SecretRef `json:"passwordRef,omitempty" protobuf:"bytes,3,opt,name=passwordRef"`
+	// Insecure skips the verification of the TLS certificate of the endpoint.
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,4,opt,name=insecure"`
+}
+
+// TerraformStateCloud locates the outputs of a workspace of HCP Terraform or Terraform Enterprise.
+type TerraformStateCloud struct {
+	// Hostname of the HCP Terraform or Terraform Enterprise instance. Defaults to app.terraform.io.
+	Hostname string `json:"hostname,omitempty" protobuf:"bytes,1,opt,name=hostname"`
+	// Organization is the name of the organization of the workspace.
+	Organization string `json:"organization" protobuf:"bytes,2,name=organization"`
+	// Workspace is the name of the workspace.
+	Workspace string `json:"workspace" protobuf:"bytes,3,name=workspace"`
+	// TokenRef is a reference to the API token to authenticate with, which must be allowed to read the state
+	// versions of the workspace.
+	TokenRef *SecretRef `json:"tokenRef" protobuf:"bytes,4,name=tokenRef"`
+}
+
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -797,6 +1081,15 @@ type ApplicationSetStatus struct {
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
 	// Resources is a list of Applications resources managed by this application set.
 	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+	// LastSuccessfulReconcileAt is the time of the last reconciliation which generated and applied all the Applications
+	// of this application set without error.
+	LastSuccessfulReconcileAt *metav1.Time `json:"lastSuccessfulReconcileAt,omitempty" protobuf:"bytes,4,opt,name=lastSuccessfulReconcileAt"`
+	// DriftedApplications is the sorted list of the names of the Applications which differ from the Applications
+	// generated by this application set, and are not updated because the sync policy does not allow it.
+	DriftedApplications []string `json:"driftedApplications,omitempty" protobuf:"bytes,5,rep,name=driftedApplications"`
+	// GeneratedParameterSets is the number of parameter sets produced by each of the generators of this application set,
+	// in the order of the generators, during the last reconciliation which generated the Applications without error.
+	GeneratedParameterSets []int64 `json:"generatedParameterSets,omitempty" protobuf:"varint,6,rep,name=generatedParameterSets"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -857,6 +1150,13 @@ const (
 	ApplicationSetReasonApplicationSetModified           = "ApplicationSetModified"
 	ApplicationSetReasonApplicationSetRolloutComplete    = "ApplicationSetRolloutComplete"
 	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
+	ApplicationSetReasonInvalidGenerators                = "InvalidGenerators"
+	ApplicationSetReasonTemplateRefError                 = "TemplateRefError"
+	ApplicationSetReasonLegacyTemplateDisabled           = "LegacyTemplateDisabled"
+	ApplicationSetReasonGeneratorNotPermitted            = "GeneratorNotPermitted"
+	ApplicationSetReasonUnsupportedByCRD                 = "UnsupportedByCRD"
+	ApplicationSetReasonFreezeWindowActive               = "FreezeWindowActive"
+	ApplicationSetReasonInvalidFreezeWindow              = "InvalidFreezeWindow"
 )
 
 // ApplicationSetApplicationStatus contains details about each Application managed by the ApplicationSet
@@ -909,6 +1209,56 @@ func (a *ApplicationSet) RefreshRequired() bool {
 	return found
 }
 
+// activeUntil returns whether the freeze window is active at the given time and, if so, the time its latest
+// occurrence ends.
+func (w ApplicationSetFreezeWindow) activeUntil(now time.Time) (time.Time, bool, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(w.Schedule)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	}
+	loc := time.UTC
+	if w.TimeZone != "" {
+		if loc, err = time.LoadLocation(w.TimeZone); err != nil {
+			return time.Time{}, false, fmt.Errorf("cannot load time zone '%s': %w", w.TimeZone, err)
+		}
+	}
+	// The window is active if it began within the last duration
+	start := schedule.Next(now.In(loc).Add(-duration))
+	if start.After(now) {
+		return time.Time{}, false, nil
+	}
+	// Overlapping occurrences extend the window
+	for next := schedule.Next(start); !next.After(now); next = schedule.Next(next) {
+		start = next
+	}
+	return start.Add(duration), true, nil
+}
+
+// ActiveFreezeWindowEnd returns whether a freeze window of the ApplicationSet is active at the given time and, if so,
+// the time all the active freeze windows end. An error is returned if a freeze window is invalid.
+func (spec *ApplicationSetSpec) ActiveFreezeWindowEnd(now time.Time) (time.Time, bool, error) {
+	var end time.Time
+	active := false
+	for i, window := range spec.FreezeWindows {
+		windowEnd, windowActive, err := window.activeUntil(now)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid freeze window %d: %w", i, err)
+		}
+		if windowActive {
+			active = true
+			if windowEnd.After(end) {
+				end = windowEnd
+			}
+		}
+	}
+	return end, active, nil
+}
+
 // SetConditions updates the applicationset status conditions for a subset of evaluated types.
 // If the applicationset has a pre-existing condition of a type that is not in the evaluated list,
 // it will be preserved. If the applicationset has a pre-existing condition of a type, status, reason that
@@ -968,3 +1318,68 @@ func (a *ApplicationSet) QualifiedName() string {
 	}
 	return a.Namespace + "/" + a.Name
 }
+
+// applicationSetGeneratorTypes are the types of the generators, which are the names of the fields of
+// ApplicationSetGenerator, such as "git" or "scmProvider".
+var applicationSetGeneratorTypes = func() map[string]bool {
+	generatorTypes := map[string]bool{}
+	t := reflect.TypeOf(ApplicationSetGenerator{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "selector" {
+			generatorTypes[name] = true
+		}
+	}
+	return generatorTypes
+}()
+
+// IsApplicationSetGeneratorType returns whether the name is the type of a generator, such as "git" or "scmProvider".
+func IsApplicationSetGeneratorType(name string) bool {
+	return applicationSetGeneratorTypes[name]
+}
+
+// GeneratorTypes returns the sorted types of the generators of the ApplicationSet, such as "git" or "scmProvider",
+// including the generators nested in the Matrix and Merge generators.
+func (a *ApplicationSet) GeneratorTypes() ([]string, error) {
+	// The nested Matrix and Merge generators are only available as JSON
+	data, err := json.Marshal(a.Spec.Generators)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the generators: %w", err)
+	}
+	var generators []map[string]any
+	if err := json.Unmarshal(data, &generators); err != nil {
+		return nil, fmt.Errorf("error unmarshaling the generators: %w", err)
+	}
+
+	found := map[string]bool{}
+	addGeneratorTypes(generators, found)
+	generatorTypes := make([]string, 0, len(found))
+	for generatorType := range found {
+		generatorTypes = append(generatorTypes, generatorType)
+	}
+	sort.Strings(generatorTypes)
+	return generatorTypes, nil
+}
+
+func addGeneratorTypes(generators []map[string]any, found map[string]bool) {
+	for _, generator := range generators {
+		for key, value := range generator {
+			if !IsApplicationSetGeneratorType(key) {
+				continue
+			}
+			found[key] = true
+			combination, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			nested, _ := combination["generators"].([]any)
+			var nestedGenerators []map[string]any
+			for _, n := range nested {
+				if nestedGenerator, ok := n.(map[string]any); ok {
+					nestedGenerators = append(nestedGenerators, nestedGenerator)
+				}
+			}
+			addGeneratorTypes(nestedGenerators, found)
+		}
+	}
+}
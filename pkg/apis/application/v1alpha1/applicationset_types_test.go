@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
@@ -50,6 +52,44 @@ func TestApplicationsSyncPolicy(t *testing.T) {
 	assert.True(t, ApplicationsSyncPolicySync.AllowUpdate())
 }
 
+func TestApplicationSetSpec_ActiveFreezeWindowEnd(t *testing.T) {
+	// Friday, 2026-01-02 at 18:30 UTC
+	now := time.Date(2026, 1, 2, 18, 30, 0, 0, time.UTC)
+	for _, c := range []struct {
+		name          string
+		windows       []ApplicationSetFreezeWindow
+		expectedEnd   time.Time
+		expectedError string
+	}{
+		{name: "no window"},
+		{name: "active window", windows: []ApplicationSetFreezeWindow{{Schedule: "0 18 * * *", Duration: "1h"}}, expectedEnd: time.Date(2026, 1, 2, 19, 0, 0, 0, time.UTC)},
+		{name: "inactive window", windows: []ApplicationSetFreezeWindow{{Schedule: "0 17 * * *", Duration: "1h"}}},
+		{name: "window over the weekend", windows: []ApplicationSetFreezeWindow{{Schedule: "0 18 * * 5", Duration: "62h"}}, expectedEnd: time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC)},
+		{name: "time zone", windows: []ApplicationSetFreezeWindow{{Schedule: "0 19 * * *", Duration: "1h", TimeZone: "Europe/Paris"}}, expectedEnd: time.Date(2026, 1, 2, 19, 0, 0, 0, time.UTC)},
+		{name: "overlapping occurrences", windows: []ApplicationSetFreezeWindow{{Schedule: "*/10 * * * *", Duration: "1h"}}, expectedEnd: time.Date(2026, 1, 2, 19, 30, 0, 0, time.UTC)},
+		{name: "latest end of the active windows", windows: []ApplicationSetFreezeWindow{
+			{Schedule: "0 18 * * *", Duration: "2h"},
+			{Schedule: "0 17 * * *", Duration: "1h"},
+			{Schedule: "0 18 * * *", Duration: "1h"},
+		}, expectedEnd: time.Date(2026, 1, 2, 20, 0, 0, 0, time.UTC)},
+		{name: "invalid schedule", windows: []ApplicationSetFreezeWindow{{Schedule: "daily", Duration: "1h"}}, expectedError: "invalid freeze window 0: cannot parse schedule 'daily'"},
+		{name: "invalid duration", windows: []ApplicationSetFreezeWindow{{Schedule: "0 18 * * *", Duration: "1 hour"}}, expectedError: "invalid freeze window 0: cannot parse duration '1 hour'"},
+		{name: "invalid time zone", windows: []ApplicationSetFreezeWindow{{Schedule: "0 18 * * *", Duration: "1h", TimeZone: "Mars/Olympus"}}, expectedError: "invalid freeze window 0: cannot load time zone 'Mars/Olympus'"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			spec := ApplicationSetSpec{FreezeWindows: c.windows}
+			end, active, err := spec.ActiveFreezeWindowEnd(now)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, !c.expectedEnd.IsZero(), active)
+			assert.True(t, c.expectedEnd.Equal(end), "expected %s, got %s", c.expectedEnd, end)
+		})
+	}
+}
+
 func TestApplicationSetRBACName(t *testing.T) {
 	testRepo := "https://github.com/org/repo"
 
@@ -182,3 +222,30 @@ func TestSCMProviderGeneratorGitlab_WillIncludeSharedProjects(t *testing.T) {
 	settings.IncludeSharedProjects = ptr.To(true)
 	assert.True(t, settings.WillIncludeSharedProjects())
 }
+
+func TestApplicationSet_GeneratorTypes(t *testing.T) {
+	appSet := &ApplicationSet{
+		Spec: ApplicationSetSpec{
+			Generators: []ApplicationSetGenerator{
+				{List: &ListGenerator{}},
+				{
+					Matrix: &MatrixGenerator{
+						Generators: []ApplicationSetNestedGenerator{
+							{Git: &GitGenerator{}},
+							{Merge: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [{"plugin": {}}, {"clusters": {}, "selector": {}}], "mergeKeys": ["server"]}`)}},
+						},
+					},
+				},
+				{List: &ListGenerator{}, Selector: &metav1.LabelSelector{}},
+			},
+		},
+	}
+
+	generatorTypes, err := appSet.GeneratorTypes()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"clusters", "git", "list", "matrix", "merge", "plugin"}, generatorTypes)
+
+	generatorTypes, err = (&ApplicationSet{}).GeneratorTypes()
+	require.NoError(t, err)
+	assert.Empty(t, generatorTypes)
+}
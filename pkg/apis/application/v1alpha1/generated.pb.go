@@ -39,10 +39,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AWSAccountsGenerator) Reset()      { *m = AWSAccountsGenerator{} }
+func (*AWSAccountsGenerator) ProtoMessage() {}
+func (*AWSAccountsGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{0}
+}
+func (m *AWSAccountsGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSAccountsGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSAccountsGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSAccountsGenerator.Merge(m, src)
+}
+func (m *AWSAccountsGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSAccountsGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSAccountsGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSAccountsGenerator proto.InternalMessageInfo
+
 func (m *AWSAuthConfig) Reset()      { *m = AWSAuthConfig{} }
 func (*AWSAuthConfig) ProtoMessage() {}
 func (*AWSAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{0}
+	return fileDescriptor_c078c3c476799f44, []int{1}
 }
 func (m *AWSAuthConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -70,7 +98,7 @@ var xxx_messageInfo_AWSAuthConfig proto.InternalMessageInfo
 func (m *AppProject) Reset()      { *m = AppProject{} }
 func (*AppProject) ProtoMessage() {}
 func (*AppProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{1}
+	return fileDescriptor_c078c3c476799f44, []int{2}
 }
 func (m *AppProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,7 +126,7 @@ var xxx_messageInfo_AppProject proto.InternalMessageInfo
 func (m *AppProjectList) Reset()      { *m = AppProjectList{} }
 func (*AppProjectList) ProtoMessage() {}
 func (*AppProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{2}
+	return fileDescriptor_c078c3c476799f44, []int{3}
 }
 func (m *AppProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +154,7 @@ var xxx_messageInfo_AppProjectList proto.InternalMessageInfo
 func (m *AppProjectSpec) Reset()      { *m = AppProjectSpec{} }
 func (*AppProjectSpec) ProtoMessage() {}
 func (*AppProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{3}
+	return fileDescriptor_c078c3c476799f44, []int{4}
 }
 func (m *AppProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +182,7 @@ var xxx_messageInfo_AppProjectSpec proto.InternalMessageInfo
 func (m *AppProjectStatus) Reset()      { *m = AppProjectStatus{} }
 func (*AppProjectStatus) ProtoMessage() {}
 func (*AppProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{4}
+	return fileDescriptor_c078c3c476799f44, []int{5}
 }
 func (m *AppProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +210,7 @@ var xxx_messageInfo_AppProjectStatus proto.InternalMessageInfo
 func (m *Application) Reset()      { *m = Application{} }
 func (*Application) ProtoMessage() {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{5}
+	return fileDescriptor_c078c3c476799f44, []int{6}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +238,7 @@ var xxx_messageInfo_Application proto.InternalMessageInfo
 func (m *ApplicationCondition) Reset()      { *m = ApplicationCondition{} }
 func (*ApplicationCondition) ProtoMessage() {}
 func (*ApplicationCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{6}
+	return fileDescriptor_c078c3c476799f44, []int{7}
 }
 func (m *ApplicationCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +266,7 @@ var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{7}
+	return fileDescriptor_c078c3c476799f44, []int{8}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +294,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,10 +487,38 @@ func (m *ApplicationSetCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 
+func (m *ApplicationSetFreezeWindow) Reset()      { *m = ApplicationSetFreezeWindow{} }
+func (*ApplicationSetFreezeWindow) ProtoMessage() {}
+func (*ApplicationSetFreezeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{16}
+}
+func (m *ApplicationSetFreezeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetFreezeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetFreezeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetFreezeWindow.Merge(m, src)
+}
+func (m *ApplicationSetFreezeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetFreezeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetFreezeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetFreezeWindow proto.InternalMessageInfo
+
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,10 +543,66 @@ func (m *ApplicationSetGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 
+func (m *ApplicationSetHook) Reset()      { *m = ApplicationSetHook{} }
+func (*ApplicationSetHook) ProtoMessage() {}
+func (*ApplicationSetHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{18}
+}
+func (m *ApplicationSetHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetHook.Merge(m, src)
+}
+func (m *ApplicationSetHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetHook proto.InternalMessageInfo
+
+func (m *ApplicationSetHooks) Reset()      { *m = ApplicationSetHooks{} }
+func (*ApplicationSetHooks) ProtoMessage() {}
+func (*ApplicationSetHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{19}
+}
+func (m *ApplicationSetHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetHooks.Merge(m, src)
+}
+func (m *ApplicationSetHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetHooks proto.InternalMessageInfo
+
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,10 +627,38 @@ func (m *ApplicationSetList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 
+func (m *ApplicationSetManagedNamespace) Reset()      { *m = ApplicationSetManagedNamespace{} }
+func (*ApplicationSetManagedNamespace) ProtoMessage() {}
+func (*ApplicationSetManagedNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{21}
+}
+func (m *ApplicationSetManagedNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetManagedNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetManagedNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetManagedNamespace.Merge(m, src)
+}
+func (m *ApplicationSetManagedNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetManagedNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetManagedNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetManagedNamespace proto.InternalMessageInfo
+
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,12 +683,40 @@ func (m *ApplicationSetNestedGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetNestedGenerator proto.InternalMessageInfo
 
+func (m *ApplicationSetPruneAfterHealthy) Reset()      { *m = ApplicationSetPruneAfterHealthy{} }
+func (*ApplicationSetPruneAfterHealthy) ProtoMessage() {}
+func (*ApplicationSetPruneAfterHealthy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{23}
+}
+func (m *ApplicationSetPruneAfterHealthy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetPruneAfterHealthy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetPruneAfterHealthy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetPruneAfterHealthy.Merge(m, src)
+}
+func (m *ApplicationSetPruneAfterHealthy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetPruneAfterHealthy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetPruneAfterHealthy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetPruneAfterHealthy proto.InternalMessageInfo
+
 func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 	*m = ApplicationSetResourceIgnoreDifferences{}
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +744,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +772,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +800,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +828,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +856,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +884,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +912,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,10 +937,38 @@ func (m *ApplicationSetTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 
+func (m *ApplicationSetTemplateGitRef) Reset()      { *m = ApplicationSetTemplateGitRef{} }
+func (*ApplicationSetTemplateGitRef) ProtoMessage() {}
+func (*ApplicationSetTemplateGitRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{32}
+}
+func (m *ApplicationSetTemplateGitRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetTemplateGitRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetTemplateGitRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetTemplateGitRef.Merge(m, src)
+}
+func (m *ApplicationSetTemplateGitRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetTemplateGitRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetTemplateGitRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetTemplateGitRef proto.InternalMessageInfo
+
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,10 +993,38 @@ func (m *ApplicationSetTemplateMeta) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 
+func (m *ApplicationSetTemplateRef) Reset()      { *m = ApplicationSetTemplateRef{} }
+func (*ApplicationSetTemplateRef) ProtoMessage() {}
+func (*ApplicationSetTemplateRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{34}
+}
+func (m *ApplicationSetTemplateRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetTemplateRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetTemplateRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetTemplateRef.Merge(m, src)
+}
+func (m *ApplicationSetTemplateRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetTemplateRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetTemplateRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetTemplateRef proto.InternalMessageInfo
+
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1052,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +1080,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1108,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1136,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1164,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1192,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1220,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1248,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1276,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1304,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1332,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1360,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1388,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1416,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1444,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1472,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1500,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1528,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1556,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1584,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1612,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1640,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1668,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1696,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1724,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1752,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1780,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1808,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1836,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1864,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1892,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1920,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1948,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1976,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +2004,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +2032,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +2060,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2088,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2116,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2144,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2172,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2200,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{77}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2228,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2256,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2284,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2312,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{81}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2340,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2368,7 @@ var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2396,7 @@ var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2424,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2452,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2480,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2508,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2536,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2564,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2592,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2620,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2648,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2676,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2704,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2732,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2760,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2788,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2816,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2844,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2872,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2673,10 +2897,66 @@ func (m *NestedMergeGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 
+func (m *OCIGenerator) Reset()      { *m = OCIGenerator{} }
+func (*OCIGenerator) ProtoMessage() {}
+func (*OCIGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{102}
+}
+func (m *OCIGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIGenerator.Merge(m, src)
+}
+func (m *OCIGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIGenerator proto.InternalMessageInfo
+
+func (m *OCIGeneratorAWSECR) Reset()      { *m = OCIGeneratorAWSECR{} }
+func (*OCIGeneratorAWSECR) ProtoMessage() {}
+func (*OCIGeneratorAWSECR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{103}
+}
+func (m *OCIGeneratorAWSECR) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIGeneratorAWSECR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIGeneratorAWSECR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIGeneratorAWSECR.Merge(m, src)
+}
+func (m *OCIGeneratorAWSECR) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIGeneratorAWSECR) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIGeneratorAWSECR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIGeneratorAWSECR proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2984,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +3012,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +3040,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3068,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3096,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3124,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3152,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3180,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3208,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3236,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3264,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3292,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3320,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3348,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3460,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3488,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3516,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3544,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3572,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3600,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3628,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3656,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3684,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3712,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3740,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3768,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3796,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3824,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3852,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3880,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3908,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3936,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +3964,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3992,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4020,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4048,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4076,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4104,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4132,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4160,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4188,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4412,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4440,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4468,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4496,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4524,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4552,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4580,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4608,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4636,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4664,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4692,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4720,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4748,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4776,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4804,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4832,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4860,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4605,7 +4885,149 @@ func (m *TagFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TagFilter proto.InternalMessageInfo
 
+func (m *TerraformStateCloud) Reset()      { *m = TerraformStateCloud{} }
+func (*TerraformStateCloud) ProtoMessage() {}
+func (*TerraformStateCloud) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{173}
+}
+func (m *TerraformStateCloud) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraformStateCloud) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TerraformStateCloud) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraformStateCloud.Merge(m, src)
+}
+func (m *TerraformStateCloud) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraformStateCloud) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraformStateCloud.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraformStateCloud proto.InternalMessageInfo
+
+func (m *TerraformStateGCS) Reset()      { *m = TerraformStateGCS{} }
+func (*TerraformStateGCS) ProtoMessage() {}
+func (*TerraformStateGCS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{174}
+}
+func (m *TerraformStateGCS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraformStateGCS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TerraformStateGCS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraformStateGCS.Merge(m, src)
+}
+func (m *TerraformStateGCS) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraformStateGCS) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraformStateGCS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraformStateGCS proto.InternalMessageInfo
+
+func (m *TerraformStateGenerator) Reset()      { *m = TerraformStateGenerator{} }
+func (*TerraformStateGenerator) ProtoMessage() {}
+func (*TerraformStateGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{175}
+}
+func (m *TerraformStateGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraformStateGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TerraformStateGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraformStateGenerator.Merge(m, src)
+}
+func (m *TerraformStateGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraformStateGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraformStateGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraformStateGenerator proto.InternalMessageInfo
+
+func (m *TerraformStateHTTP) Reset()      { *m = TerraformStateHTTP{} }
+func (*TerraformStateHTTP) ProtoMessage() {}
+func (*TerraformStateHTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{176}
+}
+func (m *TerraformStateHTTP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraformStateHTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TerraformStateHTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraformStateHTTP.Merge(m, src)
+}
+func (m *TerraformStateHTTP) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraformStateHTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraformStateHTTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraformStateHTTP proto.InternalMessageInfo
+
+func (m *TerraformStateS3) Reset()      { *m = TerraformStateS3{} }
+func (*TerraformStateS3) ProtoMessage() {}
+func (*TerraformStateS3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{177}
+}
+func (m *TerraformStateS3) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerraformStateS3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TerraformStateS3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerraformStateS3.Merge(m, src)
+}
+func (m *TerraformStateS3) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerraformStateS3) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerraformStateS3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerraformStateS3 proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*AWSAccountsGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AWSAccountsGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AWSAccountsGenerator.ValuesEntry")
 	proto.RegisterType((*AWSAuthConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AWSAuthConfig")
 	proto.RegisterType((*AppProject)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProject")
 	proto.RegisterType((*AppProjectList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectList")
@@ -4622,9 +5044,14 @@ func init() {
 	proto.RegisterType((*ApplicationSet)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSet")
 	proto.RegisterType((*ApplicationSetApplicationStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetApplicationStatus")
 	proto.RegisterType((*ApplicationSetCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetCondition")
+	proto.RegisterType((*ApplicationSetFreezeWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetFreezeWindow")
 	proto.RegisterType((*ApplicationSetGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetGenerator")
+	proto.RegisterType((*ApplicationSetHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetHook")
+	proto.RegisterType((*ApplicationSetHooks)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetHooks")
 	proto.RegisterType((*ApplicationSetList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetList")
+	proto.RegisterType((*ApplicationSetManagedNamespace)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetManagedNamespace")
 	proto.RegisterType((*ApplicationSetNestedGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetNestedGenerator")
+	proto.RegisterType((*ApplicationSetPruneAfterHealthy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetPruneAfterHealthy")
 	proto.RegisterType((*ApplicationSetResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetResourceIgnoreDifferences")
 	proto.RegisterType((*ApplicationSetRolloutStep)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStep")
 	proto.RegisterType((*ApplicationSetRolloutStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStrategy")
@@ -4633,9 +5060,11 @@ func init() {
 	proto.RegisterType((*ApplicationSetStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetStrategy")
 	proto.RegisterType((*ApplicationSetSyncPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetSyncPolicy")
 	proto.RegisterType((*ApplicationSetTemplate)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTemplate")
+	proto.RegisterType((*ApplicationSetTemplateGitRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTemplateGitRef")
 	proto.RegisterType((*ApplicationSetTemplateMeta)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTemplateMeta")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTemplateMeta.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTemplateMeta.LabelsEntry")
+	proto.RegisterType((*ApplicationSetTemplateRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTemplateRef")
 	proto.RegisterType((*ApplicationSetTerminalGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTerminalGenerator")
 	proto.RegisterType((*ApplicationSetTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTree")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSource")
@@ -4714,6 +5143,9 @@ func init() {
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MergeGenerator")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
+	proto.RegisterType((*OCIGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGenerator.ValuesEntry")
+	proto.RegisterType((*OCIGeneratorAWSECR)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGeneratorAWSECR")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationState")
@@ -4790,6 +5222,12 @@ func init() {
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
+	proto.RegisterType((*TerraformStateCloud)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TerraformStateCloud")
+	proto.RegisterType((*TerraformStateGCS)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TerraformStateGCS")
+	proto.RegisterType((*TerraformStateGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TerraformStateGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TerraformStateGenerator.ValuesEntry")
+	proto.RegisterType((*TerraformStateHTTP)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TerraformStateHTTP")
+	proto.RegisterType((*TerraformStateS3)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TerraformStateS3")
 }
 
 func init() {
@@ -4797,757 +5235,979 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 11997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x24, 0xc9,
-	0x75, 0x18, 0xae, 0x9e, 0xe1, 0x90, 0x33, 0x8f, 0x5f, 0xbb, 0xb5, 0xbb, 0x77, 0xdc, 0xbd, 0x0f,
-	0xae, 0xfb, 0xe4, 0xd3, 0xf9, 0xa7, 0x3b, 0xd2, 0xf7, 0x25, 0xef, 0xcf, 0x67, 0x4b, 0xe6, 0xc7,
-	0x7e, 0x70, 0x97, 0x5c, 0xf2, 0x6a, 0xb8, 0xbb, 0xd2, 0x49, 0xa7, 0x53, 0x73, 0xa6, 0x38, 0xec,
-	0x65, 0x4f, 0xf7, 0x5c, 0x77, 0x0f, 0x97, 0x73, 0x96, 0x64, 0xc9, 0x92, 0x6c, 0xd9, 0xfa, 0x8c,
-	0x64, 0xc4, 0xe7, 0x24, 0x52, 0x64, 0x5b, 0x09, 0x12, 0x04, 0x82, 0x95, 0x18, 0x48, 0x1c, 0xd8,
-	0x86, 0x61, 0x3b, 0x31, 0x94, 0x38, 0x81, 0x1d, 0x41, 0xb0, 0x9c, 0xd8, 0x66, 0xa4, 0x4d, 0x02,
-	0x19, 0x01, 0x62, 0x20, 0x4e, 0xfe, 0x08, 0x36, 0x41, 0x10, 0xd4, 0x77, 0x75, 0x4f, 0x0f, 0x39,
-	0x5c, 0x36, 0xb9, 0x2b, 0xe9, 0xfe, 0x9b, 0xa9, 0xf7, 0xba, 0xde, 0xeb, 0xea, 0xaa, 0xf7, 0x5e,
-	0xbd, 0x7a, 0xef, 0x15, 0x2c, 0x36, 0xdc, 0x78, 0xa3, 0xbd, 0x36, 0x55, 0x0b, 0x9a, 0xd3, 0x4e,
-	0xd8, 0x08, 0x5a, 0x61, 0x70, 0x93, 0xfd, 0x78, 0xaa, 0x56, 0x9f, 0xde, 0x7a, 0x76, 0xba, 0xb5,
-	0xd9, 0x98, 0x76, 0x5a, 0x6e, 0x34, 0xed, 0xb4, 0x5a, 0x9e, 0x5b, 0x73, 0x62, 0x37, 0xf0, 0xa7,
-	0xb7, 0x9e, 0x76, 0xbc, 0xd6, 0x86, 0xf3, 0xf4, 0x74, 0x83, 0xf8, 0x24, 0x74, 0x62, 0x52, 0x9f,
-	0x6a, 0x85, 0x41, 0x1c, 0xa0, 0x1f, 0xd3, 0xbd, 0x4d, 0xc9, 0xde, 0xd8, 0x8f, 0x57, 0x6a, 0xf5,
-	0xa9, 0xad, 0x67, 0xa7, 0x5a, 0x9b, 0x8d, 0x29, 0xda, 0xdb, 0x94, 0xd1, 0xdb, 0x94, 0xec, 0xed,
-	0xcc, 0x53, 0x06, 0x2f, 0x8d, 0xa0, 0x11, 0x4c, 0xb3, 0x4e, 0xd7, 0xda, 0xeb, 0xec, 0x1f, 0xfb,
-	0xc3, 0x7e, 0x71, 0x62, 0x67, 0xec, 0xcd, 0x73, 0xd1, 0x94, 0x1b, 0x50, 0xf6, 0xa6, 0x6b, 0x41,
-	0x48, 0xa6, 0xb7, 0xba, 0x18, 0x3a, 0x73, 0x49, 0xe3, 0x90, 0xed, 0x98, 0xf8, 0x91, 0x1b, 0xf8,
-	0xd1, 0x53, 0x94, 0x05, 0x12, 0x6e, 0x91, 0xd0, 0x7c, 0x3d, 0x03, 0x21, 0xab, 0xa7, 0xe7, 0x74,
-	0x4f, 0x4d, 0xa7, 0xb6, 0xe1, 0xfa, 0x24, 0xec, 0xe8, 0xc7, 0x9b, 0x24, 0x76, 0xb2, 0x9e, 0x9a,
-	0xee, 0xf5, 0x54, 0xd8, 0xf6, 0x63, 0xb7, 0x49, 0xba, 0x1e, 0x78, 0xdb, 0x5e, 0x0f, 0x44, 0xb5,
-	0x0d, 0xd2, 0x74, 0xba, 0x9e, 0x7b, 0xb6, 0xd7, 0x73, 0xed, 0xd8, 0xf5, 0xa6, 0x5d, 0x3f, 0x8e,
-	0xe2, 0x30, 0xfd, 0x90, 0xfd, 0x77, 0x2c, 0x18, 0x9d, 0xb9, 0x51, 0x9d, 0x69, 0xc7, 0x1b, 0x73,
-	0x81, 0xbf, 0xee, 0x36, 0xd0, 0xf3, 0x30, 0x5c, 0xf3, 0xda, 0x51, 0x4c, 0xc2, 0xab, 0x4e, 0x93,
-	0x4c, 0x58, 0x67, 0xad, 0x27, 0x2a, 0xb3, 0x27, 0xbe, 0xb6, 0x33, 0xf9, 0xa6, 0xdb, 0x3b, 0x93,
-	0xc3, 0x73, 0x1a, 0x84, 0x4d, 0x3c, 0xf4, 0x43, 0x30, 0x14, 0x06, 0x1e, 0x99, 0xc1, 0x57, 0x27,
-	0x0a, 0xec, 0x91, 0x71, 0xf1, 0xc8, 0x10, 0xe6, 0xcd, 0x58, 0xc2, 0x29, 0x6a, 0x2b, 0x0c, 0xd6,
-	0x5d, 0x8f, 0x4c, 0x14, 0x93, 0xa8, 0x2b, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0x27, 0x05, 0x80, 0x99,
-	0x56, 0x6b, 0x25, 0x0c, 0x6e, 0x92, 0x5a, 0x8c, 0xde, 0x07, 0x65, 0x3a, 0xcc, 0x75, 0x27, 0x76,
-	0x18, 0x63, 0xc3, 0xcf, 0xfc, 0xf0, 0x14, 0x7f, 0xeb, 0x29, 0xf3, 0xad, 0xf5, 0x24, 0xa3, 0xd8,
-	0x53, 0x5b, 0x4f, 0x4f, 0x2d, 0xaf, 0xd1, 0xe7, 0x97, 0x48, 0xec, 0xcc, 0x22, 0x41, 0x0c, 0x74,
-	0x1b, 0x56, 0xbd, 0x22, 0x1f, 0x06, 0xa2, 0x16, 0xa9, 0xb1, 0x77, 0x18, 0x7e, 0x66, 0x71, 0xea,
-	0x20, 0xb3, 0x79, 0x4a, 0x73, 0x5e, 0x6d, 0x91, 0xda, 0xec, 0x88, 0xa0, 0x3c, 0x40, 0xff, 0x61,
-	0x46, 0x07, 0x6d, 0xc1, 0x60, 0x14, 0x3b, 0x71, 0x3b, 0x62, 0x43, 0x31, 0xfc, 0xcc, 0xd5, 0xdc,
-	0x28, 0xb2, 0x5e, 0x67, 0xc7, 0x04, 0xcd, 0x41, 0xfe, 0x1f, 0x0b, 0x6a, 0xf6, 0x5f, 0x58, 0x30,
-	0xa6, 0x91, 0x17, 0xdd, 0x28, 0x46, 0xef, 0xe9, 0x1a, 0xdc, 0xa9, 0xfe, 0x06, 0x97, 0x3e, 0xcd,
-	0x86, 0xf6, 0x98, 0x20, 0x56, 0x96, 0x2d, 0xc6, 0xc0, 0x36, 0xa1, 0xe4, 0xc6, 0xa4, 0x19, 0x4d,
-	0x14, 0xce, 0x16, 0x9f, 0x18, 0x7e, 0xe6, 0x52, 0x5e, 0xef, 0x39, 0x3b, 0x2a, 0x88, 0x96, 0x16,
-	0x68, 0xf7, 0x98, 0x53, 0xb1, 0xff, 0x7a, 0xd4, 0x7c, 0x3f, 0x3a, 0xe0, 0xe8, 0x69, 0x18, 0x8e,
-	0x82, 0x76, 0x58, 0x23, 0x98, 0xb4, 0x82, 0x68, 0xc2, 0x3a, 0x5b, 0xa4, 0x53, 0x8f, 0x4e, 0xea,
-	0xaa, 0x6e, 0xc6, 0x26, 0x0e, 0xfa, 0xb4, 0x05, 0x23, 0x75, 0x12, 0xc5, 0xae, 0xcf, 0xe8, 0x4b,
-	0xe6, 0x57, 0x0f, 0xcc, 0xbc, 0x6c, 0x9c, 0xd7, 0x9d, 0xcf, 0x9e, 0x14, 0x2f, 0x32, 0x62, 0x34,
-	0x46, 0x38, 0x41, 0x9f, 0x2e, 0xce, 0x3a, 0x89, 0x6a, 0xa1, 0xdb, 0xa2, 0xff, 0xc5, 0xf2, 0x51,
-	0x8b, 0x73, 0x5e, 0x83, 0xb0, 0x89, 0x87, 0x7c, 0x28, 0xd1, 0xc5, 0x17, 0x4d, 0x0c, 0x30, 0xfe,
-	0x17, 0x0e, 0xc6, 0xbf, 0x18, 0x54, 0xba, 0xae, 0xf5, 0xe8, 0xd3, 0x7f, 0x11, 0xe6, 0x64, 0xd0,
-	0xa7, 0x2c, 0x98, 0x10, 0xc2, 0x01, 0x13, 0x3e, 0xa0, 0x37, 0x36, 0xdc, 0x98, 0x78, 0x6e, 0x14,
-	0x4f, 0x94, 0x18, 0x0f, 0xd3, 0xfd, 0xcd, 0xad, 0x8b, 0x61, 0xd0, 0x6e, 0x5d, 0x71, 0xfd, 0xfa,
-	0xec, 0x59, 0x41, 0x69, 0x62, 0xae, 0x47, 0xc7, 0xb8, 0x27, 0x49, 0xf4, 0x79, 0x0b, 0xce, 0xf8,
-	0x4e, 0x93, 0x44, 0x2d, 0x87, 0x7e, 0x5a, 0x0e, 0x9e, 0xf5, 0x9c, 0xda, 0x26, 0xe3, 0x68, 0xf0,
-	0xee, 0x38, 0xb2, 0x05, 0x47, 0x67, 0xae, 0xf6, 0xec, 0x1a, 0xef, 0x42, 0x16, 0xfd, 0xaa, 0x05,
-	0xc7, 0x83, 0xb0, 0xb5, 0xe1, 0xf8, 0xa4, 0x2e, 0xa1, 0xd1, 0xc4, 0x10, 0x5b, 0x7a, 0xef, 0x3d,
-	0xd8, 0x27, 0x5a, 0x4e, 0x77, 0xbb, 0x14, 0xf8, 0x6e, 0x1c, 0x84, 0x55, 0x12, 0xc7, 0xae, 0xdf,
-	0x88, 0x66, 0x4f, 0xdd, 0xde, 0x99, 0x3c, 0xde, 0x85, 0x85, 0xbb, 0xf9, 0x41, 0x3f, 0x09, 0xc3,
-	0x51, 0xc7, 0xaf, 0xdd, 0x70, 0xfd, 0x7a, 0x70, 0x2b, 0x9a, 0x28, 0xe7, 0xb1, 0x7c, 0xab, 0xaa,
-	0x43, 0xb1, 0x00, 0x35, 0x01, 0x6c, 0x52, 0xcb, 0xfe, 0x70, 0x7a, 0x2a, 0x55, 0xf2, 0xfe, 0x70,
-	0x7a, 0x32, 0xed, 0x42, 0x16, 0xfd, 0xac, 0x05, 0xa3, 0x91, 0xdb, 0xf0, 0x9d, 0xb8, 0x1d, 0x92,
-	0x2b, 0xa4, 0x13, 0x4d, 0x00, 0x63, 0xe4, 0xf2, 0x01, 0x47, 0xc5, 0xe8, 0x72, 0xf6, 0x94, 0xe0,
-	0x71, 0xd4, 0x6c, 0x8d, 0x70, 0x92, 0x6e, 0xd6, 0x42, 0xd3, 0xd3, 0x7a, 0x38, 0xdf, 0x85, 0xa6,
-	0x27, 0x75, 0x4f, 0x92, 0xe8, 0x27, 0xe0, 0x18, 0x6f, 0x52, 0x23, 0x1b, 0x4d, 0x8c, 0x30, 0x41,
-	0x7b, 0xf2, 0xf6, 0xce, 0xe4, 0xb1, 0x6a, 0x0a, 0x86, 0xbb, 0xb0, 0xd1, 0xab, 0x30, 0xd9, 0x22,
-	0x61, 0xd3, 0x8d, 0x97, 0x7d, 0xaf, 0x23, 0xc5, 0x77, 0x2d, 0x68, 0x91, 0xba, 0x60, 0x27, 0x9a,
-	0x18, 0x3d, 0x6b, 0x3d, 0x51, 0x9e, 0x7d, 0x8b, 0x60, 0x73, 0x72, 0x65, 0x77, 0x74, 0xbc, 0x57,
-	0x7f, 0xe8, 0x0f, 0x2c, 0x38, 0x63, 0x48, 0xd9, 0x2a, 0x09, 0xb7, 0xdc, 0x1a, 0x99, 0xa9, 0xd5,
-	0x82, 0xb6, 0x1f, 0x47, 0x13, 0x63, 0x6c, 0x18, 0xd7, 0x0e, 0x43, 0xe6, 0x27, 0x49, 0xe9, 0x79,
-	0xd9, 0x13, 0x25, 0xc2, 0xbb, 0x70, 0x6a, 0xff, 0xab, 0x02, 0x1c, 0x4b, 0x5b, 0x00, 0xe8, 0xef,
-	0x5b, 0x30, 0x7e, 0xf3, 0x56, 0xbc, 0x1a, 0x6c, 0x12, 0x3f, 0x9a, 0xed, 0x50, 0x39, 0xcd, 0x74,
-	0xdf, 0xf0, 0x33, 0xb5, 0x7c, 0x6d, 0x8d, 0xa9, 0xcb, 0x49, 0x2a, 0xe7, 0xfd, 0x38, 0xec, 0xcc,
-	0x3e, 0x28, 0xde, 0x69, 0xfc, 0xf2, 0x8d, 0x55, 0x13, 0x8a, 0xd3, 0x4c, 0x9d, 0xf9, 0x84, 0x05,
-	0x27, 0xb3, 0xba, 0x40, 0xc7, 0xa0, 0xb8, 0x49, 0x3a, 0xdc, 0x12, 0xc5, 0xf4, 0x27, 0x7a, 0x19,
-	0x4a, 0x5b, 0x8e, 0xd7, 0x26, 0xc2, 0x4c, 0xbb, 0x78, 0xb0, 0x17, 0x51, 0x9c, 0x61, 0xde, 0xeb,
-	0x8f, 0x16, 0xce, 0x59, 0xf6, 0x1f, 0x15, 0x61, 0xd8, 0xf8, 0x68, 0x47, 0x60, 0x7a, 0x06, 0x09,
-	0xd3, 0x73, 0x29, 0xb7, 0xf9, 0xd6, 0xd3, 0xf6, 0xbc, 0x95, 0xb2, 0x3d, 0x97, 0xf3, 0x23, 0xb9,
-	0xab, 0xf1, 0x89, 0x62, 0xa8, 0x04, 0x2d, 0xba, 0x0d, 0xa1, 0x36, 0xcc, 0x40, 0x1e, 0x9f, 0x70,
-	0x59, 0x76, 0x37, 0x3b, 0x7a, 0x7b, 0x67, 0xb2, 0xa2, 0xfe, 0x62, 0x4d, 0xc8, 0xfe, 0xa6, 0x05,
-	0x27, 0x0d, 0x1e, 0xe7, 0x02, 0xbf, 0xee, 0xb2, 0x4f, 0x7b, 0x16, 0x06, 0xe2, 0x4e, 0x4b, 0x6e,
-	0x75, 0xd4, 0x48, 0xad, 0x76, 0x5a, 0x04, 0x33, 0x08, 0xdd, 0xb1, 0x34, 0x49, 0x14, 0x39, 0x0d,
-	0x92, 0xde, 0xdc, 0x2c, 0xf1, 0x66, 0x2c, 0xe1, 0x28, 0x04, 0xe4, 0x39, 0x51, 0xbc, 0x1a, 0x3a,
-	0x7e, 0xc4, 0xba, 0x5f, 0x75, 0x9b, 0x44, 0x0c, 0xf0, 0xff, 0xd7, 0xdf, 0x8c, 0xa1, 0x4f, 0xcc,
-	0x3e, 0x70, 0x7b, 0x67, 0x12, 0x2d, 0x76, 0xf5, 0x84, 0x33, 0x7a, 0xb7, 0x3f, 0x6f, 0xc1, 0x03,
-	0xd9, 0x02, 0x06, 0x3d, 0x0e, 0x83, 0x7c, 0x9f, 0x2b, 0xde, 0x4e, 0x7f, 0x12, 0xd6, 0x8a, 0x05,
-	0x14, 0x4d, 0x43, 0x45, 0x29, 0x3c, 0xf1, 0x8e, 0xc7, 0x05, 0x6a, 0x45, 0x6b, 0x49, 0x8d, 0x43,
-	0x07, 0x8d, 0xfe, 0x11, 0x26, 0xa8, 0x1a, 0x34, 0xb6, 0x31, 0x64, 0x10, 0xfb, 0x1b, 0x16, 0xbc,
-	0xb9, 0x1f, 0xb1, 0x77, 0x78, 0x3c, 0x56, 0xe1, 0x54, 0x9d, 0xac, 0x3b, 0x6d, 0x2f, 0x4e, 0x52,
-	0x14, 0x4c, 0x3f, 0x22, 0x1e, 0x3e, 0x35, 0x9f, 0x85, 0x84, 0xb3, 0x9f, 0xb5, 0xff, 0xa3, 0x05,
-	0xe3, 0xc6, 0x6b, 0x1d, 0xc1, 0xd6, 0xc9, 0x4f, 0x6e, 0x9d, 0x16, 0x72, 0x5b, 0xa6, 0x3d, 0xf6,
-	0x4e, 0x9f, 0xb2, 0xe0, 0x8c, 0x81, 0xb5, 0xe4, 0xc4, 0xb5, 0x8d, 0xf3, 0xdb, 0xad, 0x90, 0x44,
-	0x11, 0x9d, 0x52, 0x8f, 0x18, 0xe2, 0x78, 0x76, 0x58, 0xf4, 0x50, 0xbc, 0x42, 0x3a, 0x5c, 0x36,
-	0x3f, 0x09, 0x65, 0xbe, 0xe6, 0x82, 0x50, 0x7c, 0x24, 0xf5, 0x6e, 0xcb, 0xa2, 0x1d, 0x2b, 0x0c,
-	0x64, 0xc3, 0x20, 0x93, 0xb9, 0x54, 0x06, 0x51, 0x33, 0x01, 0xe8, 0x77, 0xbf, 0xce, 0x5a, 0xb0,
-	0x80, 0xd8, 0x51, 0x82, 0x9d, 0x95, 0x90, 0xb0, 0xf9, 0x50, 0xbf, 0xe0, 0x12, 0xaf, 0x1e, 0xd1,
-	0x6d, 0x9d, 0xe3, 0xfb, 0x41, 0x2c, 0x76, 0x68, 0xc6, 0xb6, 0x6e, 0x46, 0x37, 0x63, 0x13, 0x87,
-	0x12, 0xf5, 0x9c, 0x35, 0xe2, 0xf1, 0x11, 0x15, 0x44, 0x17, 0x59, 0x0b, 0x16, 0x10, 0xfb, 0x76,
-	0x81, 0x6d, 0x20, 0x95, 0x44, 0x23, 0x47, 0xe1, 0x7d, 0x08, 0x13, 0x2a, 0x60, 0x25, 0x3f, 0x79,
-	0x4c, 0x7a, 0x7b, 0x20, 0x5e, 0x4b, 0x69, 0x01, 0x9c, 0x2b, 0xd5, 0xdd, 0xbd, 0x10, 0x1f, 0x2a,
-	0xc2, 0x64, 0xf2, 0x81, 0x2e, 0x25, 0x42, 0xb7, 0xbc, 0x06, 0xa1, 0xb4, 0x3f, 0xca, 0xc0, 0xc7,
-	0x26, 0x5e, 0x0f, 0x39, 0x5c, 0x38, 0x4c, 0x39, 0x6c, 0xaa, 0x89, 0xe2, 0x1e, 0x6a, 0xe2, 0x71,
-	0x35, 0xea, 0x03, 0x29, 0x99, 0x97, 0x54, 0x95, 0x67, 0x61, 0x20, 0x8a, 0x49, 0x6b, 0xa2, 0x94,
-	0x14, 0xb3, 0xd5, 0x98, 0xb4, 0x30, 0x83, 0xa0, 0x1f, 0x87, 0xf1, 0xd8, 0x09, 0x1b, 0x24, 0x0e,
-	0xc9, 0x96, 0xcb, 0x7c, 0x97, 0x6c, 0x3f, 0x5b, 0x99, 0x3d, 0x41, 0xad, 0xae, 0x55, 0x06, 0xc2,
-	0x12, 0x84, 0xd3, 0xb8, 0xf6, 0x7f, 0x2d, 0xc0, 0x83, 0xc9, 0x4f, 0xa0, 0x15, 0xe3, 0x3b, 0x12,
-	0x8a, 0xf1, 0xad, 0xa6, 0x62, 0xbc, 0xb3, 0x33, 0xf9, 0x50, 0x8f, 0xc7, 0xbe, 0x6b, 0xf4, 0x26,
-	0xba, 0x98, 0xfa, 0x08, 0xd3, 0xc9, 0x8f, 0x70, 0x67, 0x67, 0xf2, 0x91, 0x1e, 0xef, 0x98, 0xfa,
-	0x4a, 0x8f, 0xc3, 0x60, 0x48, 0x9c, 0x28, 0xf0, 0xc5, 0x77, 0x52, 0x5f, 0x13, 0xb3, 0x56, 0x2c,
-	0xa0, 0xf6, 0xd7, 0x2b, 0xe9, 0xc1, 0xbe, 0xc8, 0xfd, 0xb1, 0x41, 0x88, 0x5c, 0x18, 0x60, 0xbb,
-	0x36, 0x2e, 0x59, 0xae, 0x1c, 0x6c, 0x15, 0x52, 0x2d, 0xa2, 0xba, 0x9e, 0x2d, 0xd3, 0xaf, 0x46,
-	0x9b, 0x30, 0x23, 0x81, 0xb6, 0xa1, 0x5c, 0x93, 0x9b, 0xa9, 0x42, 0x1e, 0x6e, 0x47, 0xb1, 0x95,
-	0xd2, 0x14, 0x47, 0xa8, 0xb8, 0x57, 0x3b, 0x30, 0x45, 0x0d, 0x11, 0x28, 0x36, 0xdc, 0x58, 0x7c,
-	0xd6, 0x03, 0x6e, 0x97, 0x2f, 0xba, 0xc6, 0x2b, 0x0e, 0x51, 0x1d, 0x74, 0xd1, 0x8d, 0x31, 0xed,
-	0x1f, 0x7d, 0xcc, 0x82, 0xe1, 0xa8, 0xd6, 0x5c, 0x09, 0x83, 0x2d, 0xb7, 0x4e, 0x42, 0x61, 0x63,
-	0x1e, 0x50, 0xb2, 0x55, 0xe7, 0x96, 0x64, 0x87, 0x9a, 0x2e, 0x77, 0x5f, 0x68, 0x08, 0x36, 0xe9,
-	0xd2, 0xbd, 0xd7, 0x83, 0xe2, 0xdd, 0xe7, 0x49, 0x8d, 0xad, 0x38, 0xb9, 0x67, 0x66, 0x33, 0xe5,
-	0xc0, 0x36, 0xf7, 0x7c, 0xbb, 0xb6, 0x49, 0xd7, 0x9b, 0x66, 0xe8, 0xa1, 0xdb, 0x3b, 0x93, 0x0f,
-	0xce, 0x65, 0xd3, 0xc4, 0xbd, 0x98, 0x61, 0x03, 0xd6, 0x6a, 0x7b, 0x1e, 0x26, 0xaf, 0xb6, 0x09,
-	0xf3, 0x88, 0xe5, 0x30, 0x60, 0x2b, 0xba, 0xc3, 0xd4, 0x80, 0x19, 0x10, 0x6c, 0xd2, 0x45, 0xaf,
-	0xc2, 0x60, 0xd3, 0x89, 0x43, 0x77, 0x5b, 0xb8, 0xc1, 0x0e, 0xb8, 0x0b, 0x5a, 0x62, 0x7d, 0x69,
-	0xe2, 0x4c, 0xd1, 0xf3, 0x46, 0x2c, 0x08, 0xa1, 0x26, 0x94, 0x9a, 0x24, 0x6c, 0x90, 0x89, 0x72,
-	0x1e, 0x2e, 0xff, 0x25, 0xda, 0x95, 0x26, 0x58, 0xa1, 0xc6, 0x15, 0x6b, 0xc3, 0x9c, 0x0a, 0x7a,
-	0x19, 0xca, 0x11, 0xf1, 0x48, 0x8d, 0x9a, 0x47, 0x15, 0x46, 0xf1, 0xd9, 0x3e, 0x4d, 0x45, 0x6a,
-	0x97, 0x54, 0xc5, 0xa3, 0x7c, 0x81, 0xc9, 0x7f, 0x58, 0x75, 0x49, 0x07, 0xb0, 0xe5, 0xb5, 0x1b,
-	0xae, 0x3f, 0x01, 0x79, 0x0c, 0xe0, 0x0a, 0xeb, 0x2b, 0x35, 0x80, 0xbc, 0x11, 0x0b, 0x42, 0xf6,
-	0x7f, 0xb1, 0x00, 0x25, 0x85, 0xda, 0x11, 0xd8, 0xc4, 0xaf, 0x26, 0x6d, 0xe2, 0xc5, 0x3c, 0x8d,
-	0x96, 0x1e, 0x66, 0xf1, 0x6f, 0x56, 0x20, 0xa5, 0x0e, 0xae, 0x92, 0x28, 0x26, 0xf5, 0x37, 0x44,
-	0xf8, 0x1b, 0x22, 0xfc, 0x0d, 0x11, 0xae, 0x44, 0xf8, 0x5a, 0x4a, 0x84, 0xbf, 0xdd, 0x58, 0xf5,
-	0xfa, 0x7c, 0xfd, 0x15, 0x75, 0x00, 0x6f, 0x72, 0x60, 0x20, 0x50, 0x49, 0x70, 0xb9, 0xba, 0x7c,
-	0x35, 0x53, 0x66, 0xbf, 0x92, 0x94, 0xd9, 0x07, 0x25, 0xf1, 0xfd, 0x20, 0xa5, 0xff, 0xc0, 0x82,
-	0xb7, 0x24, 0xa5, 0x97, 0x9c, 0x39, 0x0b, 0x0d, 0x3f, 0x08, 0xc9, 0xbc, 0xbb, 0xbe, 0x4e, 0x42,
-	0xe2, 0xd7, 0x48, 0xa4, 0x7c, 0x3b, 0x56, 0x2f, 0xdf, 0x0e, 0x7a, 0x0e, 0x46, 0x6e, 0x46, 0x81,
-	0xbf, 0x12, 0xb8, 0xbe, 0x10, 0x41, 0x74, 0xc7, 0x71, 0xec, 0xf6, 0xce, 0xe4, 0x08, 0x1d, 0x51,
-	0xd9, 0x8e, 0x13, 0x58, 0x68, 0x0e, 0x8e, 0xdf, 0x7c, 0x75, 0xc5, 0x89, 0x0d, 0x6f, 0x82, 0xdc,
-	0xf7, 0xb3, 0xf3, 0xa8, 0xcb, 0x2f, 0xa6, 0x80, 0xb8, 0x1b, 0xdf, 0xfe, 0xdb, 0x05, 0x38, 0x9d,
-	0x7a, 0x91, 0xc0, 0xf3, 0x82, 0x76, 0x4c, 0xf7, 0x44, 0xe8, 0x8b, 0x16, 0x1c, 0x6b, 0x26, 0x1d,
-	0x16, 0x91, 0x70, 0x77, 0xbf, 0x33, 0x37, 0x1d, 0x91, 0xf2, 0x88, 0xcc, 0x4e, 0x88, 0x11, 0x3a,
-	0x96, 0x02, 0x44, 0xb8, 0x8b, 0x17, 0xf4, 0x32, 0x54, 0x9a, 0xce, 0xf6, 0xb5, 0x56, 0xdd, 0x89,
-	0xe5, 0x76, 0xb4, 0xb7, 0x17, 0xa1, 0x1d, 0xbb, 0xde, 0x14, 0x8f, 0xdc, 0x98, 0x5a, 0xf0, 0xe3,
-	0xe5, 0xb0, 0x1a, 0x87, 0xae, 0xdf, 0xe0, 0x4e, 0xce, 0x25, 0xd9, 0x0d, 0xd6, 0x3d, 0xda, 0x5f,
-	0xb0, 0xd2, 0x4a, 0x4a, 0x8d, 0x4e, 0xe8, 0xc4, 0xa4, 0xd1, 0x41, 0xef, 0x87, 0x12, 0xdd, 0x37,
-	0xca, 0x51, 0xb9, 0x91, 0xa7, 0xe6, 0x34, 0xbe, 0x84, 0x56, 0xa2, 0xf4, 0x5f, 0x84, 0x39, 0x51,
-	0xfb, 0x8b, 0x95, 0xb4, 0xb1, 0xc0, 0xce, 0xe6, 0x9f, 0x01, 0x68, 0x04, 0xab, 0xa4, 0xd9, 0xf2,
-	0xe8, 0xb0, 0x58, 0xec, 0x80, 0x47, 0xb9, 0x4a, 0x2e, 0x2a, 0x08, 0x36, 0xb0, 0xd0, 0xcf, 0x59,
-	0x00, 0x0d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0x2d, 0xcf, 0xd7, 0xd1, 0x2b, 0x4a, 0xf3, 0xa2, 0x08,
-	0x62, 0x83, 0x38, 0xfa, 0x69, 0x0b, 0xca, 0xb1, 0x64, 0x9f, 0xab, 0xc6, 0xd5, 0x3c, 0x39, 0x91,
-	0x2f, 0xad, 0x6d, 0x22, 0x35, 0x24, 0x8a, 0x2e, 0xfa, 0x19, 0x0b, 0x20, 0xea, 0xf8, 0xb5, 0x95,
-	0xc0, 0x73, 0x6b, 0x1d, 0xa1, 0x31, 0xaf, 0xe7, 0xea, 0xce, 0x51, 0xbd, 0xcf, 0x8e, 0xd1, 0xd1,
-	0xd0, 0xff, 0xb1, 0x41, 0x19, 0x7d, 0x10, 0xca, 0x91, 0x98, 0x6e, 0x42, 0x47, 0xae, 0xe6, 0xeb,
-	0x54, 0xe2, 0x7d, 0x0b, 0xf1, 0x2a, 0xfe, 0x61, 0x45, 0x13, 0xfd, 0xa2, 0x05, 0xe3, 0xad, 0xa4,
-	0x9b, 0x50, 0xa8, 0xc3, 0xfc, 0x64, 0x40, 0xca, 0x0d, 0xc9, 0xbd, 0x2d, 0xa9, 0x46, 0x9c, 0xe6,
-	0x82, 0x4a, 0x40, 0x3d, 0x83, 0x97, 0x5b, 0xdc, 0x65, 0x39, 0xa4, 0x25, 0xe0, 0xc5, 0x34, 0x10,
-	0x77, 0xe3, 0xa3, 0x15, 0x38, 0x49, 0xb9, 0xeb, 0x70, 0xf3, 0x53, 0xaa, 0x97, 0x88, 0x29, 0xc3,
-	0xf2, 0xec, 0xc3, 0x62, 0x86, 0xb0, 0xb3, 0x8e, 0x34, 0x0e, 0xce, 0x7c, 0x12, 0xfd, 0x91, 0x05,
-	0x0f, 0xbb, 0x4c, 0x0d, 0x98, 0x0e, 0x7b, 0xad, 0x11, 0xc4, 0x41, 0x3b, 0xc9, 0x55, 0x56, 0xf4,
-	0x52, 0x3f, 0xb3, 0x6f, 0x16, 0x6f, 0xf0, 0xf0, 0xc2, 0x2e, 0x2c, 0xe1, 0x5d, 0x19, 0x46, 0x3f,
-	0x02, 0xa3, 0x72, 0x5d, 0xac, 0x50, 0x11, 0xcc, 0x14, 0x6d, 0x65, 0xf6, 0xf8, 0xed, 0x9d, 0xc9,
-	0xd1, 0x55, 0x13, 0x80, 0x93, 0x78, 0xf6, 0xbf, 0x2e, 0x26, 0x4e, 0x89, 0x94, 0x0f, 0x93, 0x89,
-	0x9b, 0x9a, 0xf4, 0xff, 0x48, 0xe9, 0x99, 0xab, 0xb8, 0x51, 0xde, 0x25, 0x2d, 0x6e, 0x54, 0x53,
-	0x84, 0x0d, 0xe2, 0xd4, 0x28, 0x3d, 0xee, 0xa4, 0x3d, 0xa5, 0x42, 0x02, 0xbe, 0x9c, 0x27, 0x4b,
-	0xdd, 0x67, 0x7a, 0xa7, 0x05, 0x6b, 0xc7, 0xbb, 0x40, 0xb8, 0x9b, 0x25, 0xf4, 0x01, 0xa8, 0x84,
-	0x2a, 0xb2, 0xa5, 0x98, 0xc7, 0x56, 0x4d, 0x4e, 0x1b, 0xc1, 0x8e, 0x3a, 0x00, 0xd2, 0x31, 0x2c,
-	0x9a, 0xa2, 0xfd, 0x87, 0xc9, 0x83, 0x31, 0x43, 0x76, 0xf4, 0x71, 0xe8, 0xf7, 0x69, 0x0b, 0x86,
-	0xc3, 0xc0, 0xf3, 0x5c, 0xbf, 0x41, 0xe5, 0x9c, 0x50, 0xd6, 0xef, 0x3e, 0x14, 0x7d, 0x29, 0x04,
-	0x1a, 0xb3, 0xac, 0xb1, 0xa6, 0x89, 0x4d, 0x06, 0xec, 0xbf, 0xb0, 0x60, 0xa2, 0x97, 0x3c, 0x46,
-	0x04, 0x1e, 0x92, 0xc2, 0x46, 0x0d, 0xc5, 0xb2, 0x3f, 0x4f, 0x3c, 0xa2, 0xdc, 0xe6, 0xe5, 0xd9,
-	0xc7, 0xc4, 0x6b, 0x3e, 0xb4, 0xd2, 0x1b, 0x15, 0xef, 0xd6, 0x0f, 0x7a, 0x09, 0x8e, 0x19, 0xef,
-	0x15, 0xa9, 0x81, 0xa9, 0xcc, 0x4e, 0x51, 0x03, 0x68, 0x26, 0x05, 0xbb, 0xb3, 0x33, 0xf9, 0x40,
-	0xba, 0x4d, 0x28, 0x8c, 0xae, 0x7e, 0xec, 0x2f, 0x17, 0xd2, 0x5f, 0x4b, 0xe9, 0xfa, 0xd7, 0xad,
-	0x2e, 0x6f, 0xc2, 0x3b, 0x0f, 0x43, 0xbf, 0x32, 0xbf, 0x83, 0x0a, 0xc3, 0xe8, 0x8d, 0x73, 0x0f,
-	0x8f, 0xed, 0xed, 0x7f, 0x33, 0x00, 0xbb, 0x70, 0xd6, 0x87, 0xf1, 0xbe, 0xef, 0x73, 0xd4, 0x4f,
-	0x5a, 0xea, 0xc0, 0x8c, 0xaf, 0xe1, 0xfa, 0x61, 0x8d, 0x3d, 0xdf, 0x3f, 0x45, 0x3c, 0x74, 0x44,
-	0x79, 0xd1, 0x93, 0x47, 0x73, 0xe8, 0x4b, 0x56, 0xf2, 0xc8, 0x8f, 0x07, 0x35, 0xba, 0x87, 0xc6,
-	0x93, 0x71, 0x8e, 0xc8, 0x19, 0xd3, 0xa7, 0x4f, 0xbd, 0x4e, 0x18, 0xa7, 0x00, 0xd6, 0x5d, 0xdf,
-	0xf1, 0xdc, 0xd7, 0xe8, 0xee, 0xa8, 0xc4, 0x14, 0x3c, 0xb3, 0x98, 0x2e, 0xa8, 0x56, 0x6c, 0x60,
-	0x9c, 0xf9, 0xff, 0x61, 0xd8, 0x78, 0xf3, 0x8c, 0x88, 0x97, 0x93, 0x66, 0xc4, 0x4b, 0xc5, 0x08,
-	0x54, 0x39, 0xf3, 0x76, 0x38, 0x96, 0x66, 0x70, 0x3f, 0xcf, 0xdb, 0xff, 0x6b, 0x28, 0x7d, 0x06,
-	0xb7, 0x4a, 0xc2, 0x26, 0x65, 0xed, 0x0d, 0xc7, 0xd6, 0x1b, 0x8e, 0xad, 0x37, 0x1c, 0x5b, 0xe6,
-	0xd9, 0x84, 0x70, 0xda, 0x0c, 0x1d, 0x91, 0xd3, 0x26, 0xe1, 0x86, 0x2a, 0xe7, 0xee, 0x86, 0xb2,
-	0x3f, 0xd6, 0xe5, 0xb9, 0x5f, 0x0d, 0x09, 0x41, 0x01, 0x94, 0xfc, 0xa0, 0x4e, 0xa4, 0x8d, 0x7b,
-	0x39, 0x1f, 0x83, 0xed, 0x6a, 0x50, 0x37, 0xc2, 0xc5, 0xe9, 0xbf, 0x08, 0x73, 0x3a, 0xf6, 0x47,
-	0x07, 0x21, 0x61, 0x4e, 0xf2, 0xef, 0xfe, 0x43, 0x30, 0x14, 0x92, 0x56, 0x70, 0x0d, 0x2f, 0x0a,
-	0x5d, 0xa6, 0x33, 0x4a, 0x78, 0x33, 0x96, 0x70, 0xaa, 0xf3, 0x5a, 0x4e, 0xbc, 0x21, 0x94, 0x99,
-	0xd2, 0x79, 0x2b, 0x4e, 0xbc, 0x81, 0x19, 0x04, 0xbd, 0x1d, 0xc6, 0xe2, 0xc4, 0x51, 0xb8, 0x38,
-	0xf2, 0x7d, 0x40, 0xe0, 0x8e, 0x25, 0x0f, 0xca, 0x71, 0x0a, 0x1b, 0xbd, 0x0a, 0x03, 0x1b, 0xc4,
-	0x6b, 0x8a, 0x4f, 0x5f, 0xcd, 0x4f, 0xd7, 0xb0, 0x77, 0xbd, 0x44, 0xbc, 0x26, 0x97, 0x84, 0xf4,
-	0x17, 0x66, 0xa4, 0xe8, 0xbc, 0xaf, 0x6c, 0xb6, 0xa3, 0x38, 0x68, 0xba, 0xaf, 0x49, 0x4f, 0xe7,
-	0x3b, 0x73, 0x26, 0x7c, 0x45, 0xf6, 0xcf, 0x5d, 0x4a, 0xea, 0x2f, 0xd6, 0x94, 0x19, 0x1f, 0x75,
-	0x37, 0x64, 0x53, 0xa6, 0x23, 0x1c, 0x96, 0x79, 0xf3, 0x31, 0x2f, 0xfb, 0xe7, 0x7c, 0xa8, 0xbf,
-	0x58, 0x53, 0x46, 0x1d, 0xb5, 0xfe, 0x86, 0x19, 0x0f, 0xd7, 0x72, 0xe6, 0x81, 0xaf, 0xbd, 0xcc,
-	0x75, 0xf8, 0x18, 0x94, 0x6a, 0x1b, 0x4e, 0x18, 0x4f, 0x8c, 0xb0, 0x49, 0xa3, 0x66, 0xf1, 0x1c,
-	0x6d, 0xc4, 0x1c, 0x86, 0x1e, 0x81, 0x62, 0x48, 0xd6, 0x59, 0x74, 0xb2, 0x11, 0x17, 0x85, 0xc9,
-	0x3a, 0xa6, 0xed, 0xca, 0x2e, 0x1b, 0xeb, 0x19, 0x30, 0xf7, 0xcb, 0x85, 0xa4, 0x61, 0x97, 0x1c,
-	0x19, 0xbe, 0x1e, 0x6a, 0xed, 0x30, 0x92, 0x0e, 0x32, 0x63, 0x3d, 0xb0, 0x66, 0x2c, 0xe1, 0xe8,
-	0xc3, 0x16, 0x0c, 0xdd, 0x8c, 0x02, 0xdf, 0x27, 0xb1, 0x50, 0xa2, 0xd7, 0x73, 0x1e, 0xac, 0xcb,
-	0xbc, 0x77, 0xcd, 0x83, 0x68, 0xc0, 0x92, 0x2e, 0x65, 0x97, 0x6c, 0xd7, 0xbc, 0x76, 0xbd, 0x2b,
-	0x18, 0xe6, 0x3c, 0x6f, 0xc6, 0x12, 0x4e, 0x51, 0x5d, 0x9f, 0xa3, 0x0e, 0x24, 0x51, 0x17, 0x7c,
-	0x81, 0x2a, 0xe0, 0xf6, 0xaf, 0x97, 0xe1, 0x54, 0xe6, 0xf2, 0xa1, 0x26, 0x17, 0x33, 0x6a, 0x2e,
-	0xb8, 0x1e, 0x91, 0x61, 0x60, 0xcc, 0xe4, 0xba, 0xae, 0x5a, 0xb1, 0x81, 0x81, 0x7e, 0x0a, 0xa0,
-	0xe5, 0x84, 0x4e, 0x93, 0x28, 0x07, 0xf6, 0x81, 0x2d, 0x1b, 0xca, 0xc7, 0x8a, 0xec, 0x53, 0x6f,
-	0xe2, 0x55, 0x53, 0x84, 0x0d, 0x92, 0xe8, 0x79, 0x18, 0x0e, 0x89, 0x47, 0x9c, 0x88, 0x85, 0xbf,
-	0xa7, 0x73, 0x79, 0xb0, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xae, 0x22, 0xe6, 0x52, 0x91, 0x43, 0xc9,
-	0xa8, 0x39, 0xf4, 0x19, 0x0b, 0xc6, 0xd6, 0x5d, 0x8f, 0x68, 0xea, 0x22, 0xf3, 0x66, 0xf9, 0xe0,
-	0x2f, 0x79, 0xc1, 0xec, 0x57, 0xcb, 0xd0, 0x44, 0x73, 0x84, 0x53, 0xe4, 0xe9, 0x67, 0xde, 0x22,
-	0x21, 0x13, 0xbe, 0x83, 0xc9, 0xcf, 0x7c, 0x9d, 0x37, 0x63, 0x09, 0x47, 0x33, 0x30, 0xde, 0x72,
-	0xa2, 0x68, 0x2e, 0x24, 0x75, 0xe2, 0xc7, 0xae, 0xe3, 0xf1, 0xbc, 0x98, 0xb2, 0x0e, 0x27, 0x5f,
-	0x49, 0x82, 0x71, 0x1a, 0x1f, 0xbd, 0x0b, 0x1e, 0xe4, 0x1e, 0xa2, 0x25, 0x37, 0x8a, 0x5c, 0xbf,
-	0xa1, 0xa7, 0x81, 0x70, 0x94, 0x4d, 0x8a, 0xae, 0x1e, 0x5c, 0xc8, 0x46, 0xc3, 0xbd, 0x9e, 0x47,
-	0x4f, 0x42, 0x39, 0xda, 0x74, 0x5b, 0x73, 0x61, 0x3d, 0x62, 0xa7, 0x43, 0x65, 0xed, 0x96, 0xad,
-	0x8a, 0x76, 0xac, 0x30, 0x50, 0x0d, 0x46, 0xf8, 0x27, 0xe1, 0x21, 0x7f, 0x42, 0x82, 0x3e, 0xd5,
-	0x53, 0x91, 0x8b, 0x34, 0xcf, 0x29, 0xec, 0xdc, 0x3a, 0x2f, 0xcf, 0xaa, 0xf8, 0xd1, 0xca, 0x75,
-	0xa3, 0x1b, 0x9c, 0xe8, 0x34, 0xb9, 0xa7, 0x1b, 0xee, 0x63, 0x4f, 0xf7, 0x3c, 0x0c, 0x6f, 0xb6,
-	0xd7, 0x88, 0x18, 0x79, 0x21, 0xd8, 0xd4, 0xec, 0xbb, 0xa2, 0x41, 0xd8, 0xc4, 0x63, 0xd1, 0x96,
-	0x2d, 0x57, 0xfc, 0x8b, 0x26, 0x46, 0x8d, 0x68, 0xcb, 0x95, 0x05, 0xd9, 0x8c, 0x4d, 0x1c, 0xca,
-	0x1a, 0x1d, 0x8b, 0x55, 0x12, 0xb1, 0x64, 0x0a, 0x3a, 0x5c, 0x8a, 0xb5, 0xaa, 0x04, 0x60, 0x8d,
-	0x83, 0x56, 0xe0, 0x24, 0xfd, 0x53, 0x65, 0x69, 0xae, 0xd7, 0x1d, 0xcf, 0xad, 0xf3, 0xd0, 0xbf,
-	0xf1, 0xa4, 0x7f, 0xb3, 0x9a, 0x81, 0x83, 0x33, 0x9f, 0xb4, 0x7f, 0xa9, 0x90, 0xf4, 0x9c, 0x98,
-	0x22, 0x0c, 0x45, 0x54, 0x50, 0xc5, 0xd7, 0x9d, 0x50, 0x1a, 0x3c, 0x07, 0x4c, 0x6e, 0x12, 0xfd,
-	0x5e, 0x77, 0x42, 0x53, 0xe4, 0x31, 0x02, 0x58, 0x52, 0x42, 0x37, 0x61, 0x20, 0xf6, 0x9c, 0x9c,
-	0xb2, 0x21, 0x0d, 0x8a, 0xda, 0x91, 0xb5, 0x38, 0x13, 0x61, 0x46, 0x03, 0x3d, 0x4c, 0x77, 0x6f,
-	0x6b, 0xf2, 0xa4, 0x4d, 0x6c, 0xb8, 0xd6, 0x22, 0xcc, 0x5a, 0xed, 0x5f, 0x18, 0xcd, 0xd0, 0x3a,
-	0xca, 0x10, 0x40, 0xcf, 0x00, 0xd0, 0x49, 0xb3, 0x12, 0x92, 0x75, 0x77, 0x5b, 0x18, 0x62, 0x4a,
-	0xb2, 0x5d, 0x55, 0x10, 0x6c, 0x60, 0xc9, 0x67, 0xaa, 0xed, 0x75, 0xfa, 0x4c, 0xa1, 0xfb, 0x19,
-	0x0e, 0xc1, 0x06, 0x16, 0x7a, 0x0e, 0x06, 0xdd, 0xa6, 0xd3, 0x50, 0x81, 0xc0, 0x0f, 0x53, 0x91,
-	0xb6, 0xc0, 0x5a, 0xee, 0xec, 0x4c, 0x8e, 0x29, 0x86, 0x58, 0x13, 0x16, 0xb8, 0xe8, 0xcb, 0x16,
-	0x8c, 0xd4, 0x82, 0x66, 0x33, 0xf0, 0xf9, 0xf6, 0x59, 0xf8, 0x02, 0x6e, 0x1e, 0x96, 0x99, 0x34,
-	0x35, 0x67, 0x10, 0xe3, 0xce, 0x00, 0x95, 0xb6, 0x69, 0x82, 0x70, 0x82, 0x2b, 0x53, 0xf2, 0x95,
-	0xf6, 0x90, 0x7c, 0xbf, 0x61, 0xc1, 0x71, 0xfe, 0xac, 0xb1, 0xab, 0x17, 0x19, 0x8a, 0xc1, 0x21,
-	0xbf, 0x56, 0x97, 0xa3, 0x43, 0x39, 0x7b, 0xbb, 0xe0, 0xb8, 0x9b, 0x49, 0x74, 0x11, 0x8e, 0xaf,
-	0x07, 0x61, 0x8d, 0x98, 0x03, 0x21, 0xc4, 0xb6, 0xea, 0xe8, 0x42, 0x1a, 0x01, 0x77, 0x3f, 0x83,
-	0xae, 0xc3, 0x03, 0x46, 0xa3, 0x39, 0x0e, 0x5c, 0x72, 0x3f, 0x2a, 0x7a, 0x7b, 0xe0, 0x42, 0x26,
-	0x16, 0xee, 0xf1, 0x74, 0x52, 0x48, 0x56, 0xfa, 0x10, 0x92, 0xaf, 0xc0, 0xe9, 0x5a, 0xf7, 0xc8,
-	0x6c, 0x45, 0xed, 0xb5, 0x88, 0xcb, 0xf1, 0xf2, 0xec, 0x0f, 0x88, 0x0e, 0x4e, 0xcf, 0xf5, 0x42,
-	0xc4, 0xbd, 0xfb, 0x40, 0xef, 0x87, 0x72, 0x48, 0xd8, 0x57, 0x89, 0x44, 0xba, 0xde, 0x01, 0xbd,
-	0x1d, 0xda, 0x82, 0xe7, 0xdd, 0x6a, 0xcd, 0x24, 0x1a, 0x22, 0xac, 0x28, 0xa2, 0x5b, 0x30, 0xd4,
-	0x72, 0xe2, 0xda, 0x86, 0x48, 0xd2, 0x3b, 0xb0, 0x6f, 0x5e, 0x11, 0x67, 0x47, 0x29, 0x46, 0x5a,
-	0x3f, 0x27, 0x82, 0x25, 0x35, 0x6a, 0xab, 0xd5, 0x82, 0x66, 0x2b, 0xf0, 0x89, 0x1f, 0x4b, 0x25,
-	0x32, 0xc6, 0xcf, 0x3b, 0x64, 0x2b, 0x36, 0x30, 0xba, 0x74, 0xb9, 0x46, 0x9b, 0x38, 0xbe, 0x8b,
-	0x2e, 0x37, 0x7a, 0xeb, 0xf5, 0x3c, 0x55, 0x36, 0xcc, 0xad, 0x78, 0xc3, 0x8d, 0x37, 0x82, 0x76,
-	0x2c, 0x77, 0xc9, 0x42, 0x51, 0x29, 0x65, 0xb3, 0x98, 0x81, 0x83, 0x33, 0x9f, 0x4c, 0x6b, 0xd6,
-	0xf1, 0xbb, 0xd3, 0xac, 0xc7, 0xfa, 0xd0, 0xac, 0x55, 0x38, 0xc5, 0x38, 0x10, 0x56, 0xb2, 0x74,
-	0x5a, 0x46, 0x13, 0x88, 0x31, 0xaf, 0xf2, 0x5b, 0x16, 0xb3, 0x90, 0x70, 0xf6, 0xb3, 0x67, 0xde,
-	0x01, 0xc7, 0xbb, 0x84, 0xdc, 0xbe, 0x1c, 0x92, 0xf3, 0xf0, 0x40, 0xb6, 0x38, 0xd9, 0x97, 0x5b,
-	0xf2, 0xd7, 0x53, 0x71, 0xe9, 0xc6, 0x16, 0xad, 0x0f, 0x17, 0xb7, 0x03, 0x45, 0xe2, 0x6f, 0x09,
-	0xed, 0x7a, 0xe1, 0x60, 0xb3, 0xfa, 0xbc, 0xbf, 0xc5, 0xa5, 0x21, 0xf3, 0xe3, 0x9d, 0xf7, 0xb7,
-	0x30, 0xed, 0x1b, 0x7d, 0xce, 0x4a, 0x6c, 0x20, 0xb8, 0x63, 0xfc, 0xbd, 0x87, 0xb2, 0x27, 0xed,
-	0x7b, 0x4f, 0x61, 0xff, 0xdb, 0x02, 0x9c, 0xdd, 0xab, 0x93, 0x3e, 0x86, 0xef, 0x31, 0x18, 0x8c,
-	0x58, 0xa4, 0x89, 0x50, 0x57, 0xc3, 0x74, 0x15, 0xf3, 0xd8, 0x93, 0x57, 0xb0, 0x00, 0x21, 0x0f,
-	0x8a, 0x4d, 0xa7, 0x25, 0xfc, 0xa5, 0x0b, 0x07, 0xcd, 0xdf, 0xa3, 0xff, 0x1d, 0x6f, 0xc9, 0x69,
-	0xf1, 0x39, 0x6f, 0x34, 0x60, 0x4a, 0x06, 0xc5, 0x50, 0x72, 0xc2, 0xd0, 0x91, 0x61, 0x0d, 0x57,
-	0xf2, 0xa1, 0x37, 0x43, 0xbb, 0xe4, 0xa7, 0xc2, 0x89, 0x26, 0xcc, 0x89, 0xd9, 0xbf, 0x58, 0x4e,
-	0x24, 0x7b, 0xb1, 0x58, 0x95, 0x08, 0x06, 0x85, 0x9b, 0xd4, 0xca, 0x3b, 0x6d, 0x92, 0x67, 0x53,
-	0x33, 0x0f, 0x84, 0xa8, 0x49, 0x21, 0x48, 0xa1, 0x4f, 0x58, 0xac, 0xf2, 0x83, 0xcc, 0xa0, 0x13,
-	0xbb, 0xfa, 0xc3, 0x29, 0x44, 0x61, 0xd6, 0x93, 0x90, 0x8d, 0xd8, 0xa4, 0x2e, 0x2a, 0xb8, 0xb0,
-	0xdd, 0x4c, 0x77, 0x05, 0x17, 0xb6, 0x3b, 0x91, 0x70, 0xb4, 0x9d, 0x11, 0x93, 0x92, 0x43, 0xf5,
-	0x80, 0x3e, 0xa2, 0x50, 0xbe, 0x64, 0xc1, 0x71, 0x37, 0x1d, 0x5c, 0x20, 0xf6, 0xc0, 0x37, 0xf2,
-	0xf1, 0x69, 0x76, 0xc7, 0x2e, 0x28, 0x43, 0xa7, 0x0b, 0x84, 0xbb, 0x99, 0x41, 0x75, 0x18, 0x70,
-	0xfd, 0xf5, 0x40, 0x98, 0x77, 0xb3, 0x07, 0x63, 0x6a, 0xc1, 0x5f, 0x0f, 0xf4, 0x6a, 0xa6, 0xff,
-	0x30, 0xeb, 0x1d, 0x2d, 0xc2, 0x49, 0x99, 0xef, 0x73, 0xc9, 0x8d, 0xe2, 0x20, 0xec, 0x2c, 0xba,
-	0x4d, 0x37, 0x66, 0xa6, 0x59, 0x71, 0x76, 0x82, 0xaa, 0x37, 0x9c, 0x01, 0xc7, 0x99, 0x4f, 0xa1,
-	0xd7, 0x60, 0x48, 0x1e, 0xe8, 0x97, 0xf3, 0xf0, 0x27, 0x74, 0xcf, 0x7f, 0x35, 0x99, 0xaa, 0xe2,
-	0x44, 0x5f, 0x12, 0x44, 0x1f, 0xb7, 0x60, 0x8c, 0xff, 0xbe, 0xd4, 0xa9, 0xf3, 0x14, 0xc3, 0x4a,
-	0x1e, 0x51, 0xfb, 0xd5, 0x44, 0x9f, 0xb3, 0xe8, 0xf6, 0xce, 0xe4, 0x58, 0xb2, 0x0d, 0xa7, 0xe8,
-	0xda, 0x5f, 0x1e, 0x81, 0xee, 0x10, 0x88, 0x64, 0xbc, 0x83, 0x75, 0xd4, 0xf1, 0x0e, 0x74, 0x57,
-	0x19, 0xe9, 0x50, 0x85, 0x1c, 0x96, 0x99, 0xa0, 0xaa, 0x8f, 0xa1, 0x3b, 0x7e, 0x0d, 0x33, 0x1a,
-	0x28, 0x84, 0xc1, 0x0d, 0xe2, 0x78, 0xf1, 0x46, 0x3e, 0x27, 0x66, 0x97, 0x58, 0x5f, 0xe9, 0x7c,
-	0x41, 0xde, 0x8a, 0x05, 0x25, 0xb4, 0x0d, 0x43, 0x1b, 0x7c, 0x2e, 0x8a, 0x8d, 0xde, 0xd2, 0x41,
-	0x07, 0x37, 0x31, 0xc1, 0xf5, 0xcc, 0x13, 0x0d, 0x58, 0x92, 0x63, 0xb1, 0x75, 0x46, 0xf4, 0x0f,
-	0x97, 0x22, 0xf9, 0xa5, 0x4a, 0xf6, 0x1f, 0xfa, 0xf3, 0x3e, 0x18, 0x09, 0x49, 0x2d, 0xf0, 0x6b,
-	0xae, 0x47, 0xea, 0x33, 0xf2, 0x34, 0x6c, 0x3f, 0x19, 0x72, 0xcc, 0x95, 0x84, 0x8d, 0x3e, 0x70,
-	0xa2, 0x47, 0xb6, 0xc8, 0x54, 0xd6, 0x3c, 0xfd, 0x20, 0x44, 0x9c, 0x7a, 0x2c, 0xe6, 0x94, 0xa3,
-	0xcf, 0xfa, 0xe4, 0x8b, 0x2c, 0xd9, 0x86, 0x53, 0x74, 0xd1, 0x4b, 0x00, 0xc1, 0x1a, 0x0f, 0xa0,
-	0x9b, 0x89, 0xc5, 0x11, 0xc8, 0x7e, 0x5e, 0x75, 0x8c, 0x67, 0xda, 0xca, 0x1e, 0xb0, 0xd1, 0x1b,
-	0xba, 0x02, 0xc0, 0x97, 0xcd, 0x6a, 0xa7, 0x25, 0x77, 0x83, 0x32, 0xc5, 0x11, 0xaa, 0x0a, 0x72,
-	0x67, 0x67, 0xb2, 0xdb, 0xe1, 0xcc, 0xa2, 0x84, 0x8c, 0xc7, 0xd1, 0x4f, 0xc2, 0x50, 0xd4, 0x6e,
-	0x36, 0x1d, 0x75, 0x40, 0x92, 0x63, 0xee, 0x2e, 0xef, 0xd7, 0x90, 0x8a, 0xbc, 0x01, 0x4b, 0x8a,
-	0xe8, 0x26, 0x95, 0xef, 0x42, 0x3c, 0xf1, 0x55, 0xc4, 0xcd, 0x13, 0xee, 0x06, 0x7c, 0x9b, 0xdc,
-	0xc2, 0xe0, 0x0c, 0x9c, 0x3b, 0x3b, 0x93, 0x0f, 0x24, 0xdb, 0x17, 0x03, 0x91, 0x4d, 0x9b, 0xd9,
-	0x27, 0xba, 0x2c, 0x8b, 0x68, 0xd1, 0xd7, 0x96, 0xb5, 0x5d, 0x9e, 0xd0, 0x45, 0xb4, 0x58, 0x73,
-	0xef, 0x31, 0x33, 0x1f, 0x46, 0x4b, 0x70, 0xa2, 0x16, 0xf8, 0x71, 0x18, 0x78, 0x1e, 0x2f, 0x22,
-	0xc7, 0x37, 0xe6, 0xfc, 0x00, 0xe5, 0x21, 0xc1, 0xf6, 0x89, 0xb9, 0x6e, 0x14, 0x9c, 0xf5, 0x1c,
-	0x35, 0xc8, 0xd3, 0xca, 0x61, 0x2c, 0x97, 0xb3, 0xf5, 0x44, 0x9f, 0x42, 0x42, 0x29, 0x9f, 0xf7,
-	0x1e, 0x6a, 0xc2, 0x4f, 0x9e, 0xb0, 0x8a, 0x2f, 0xf6, 0x1c, 0x8c, 0x90, 0xed, 0x98, 0x84, 0xbe,
-	0xe3, 0x5d, 0xc3, 0x8b, 0xf2, 0xb4, 0x82, 0x2d, 0xcc, 0xf3, 0x46, 0x3b, 0x4e, 0x60, 0x21, 0x5b,
-	0xb9, 0xc8, 0x8c, 0xb4, 0x75, 0xee, 0x22, 0x93, 0x0e, 0x31, 0xfb, 0xab, 0xc5, 0x84, 0xc1, 0x7a,
-	0x4f, 0xce, 0x73, 0x59, 0x7d, 0x24, 0x59, 0x48, 0x8a, 0x01, 0xc4, 0x46, 0x2c, 0x4f, 0xca, 0xaa,
-	0x3e, 0xd2, 0xb2, 0x49, 0x08, 0x27, 0xe9, 0xa2, 0x4d, 0x28, 0x6d, 0x04, 0x51, 0x2c, 0xb7, 0x67,
-	0x07, 0xdc, 0x09, 0x5e, 0x0a, 0xa2, 0x98, 0x59, 0x59, 0xea, 0xb5, 0x69, 0x4b, 0x84, 0x39, 0x0d,
-	0xba, 0xf1, 0x8f, 0x36, 0x9c, 0xb0, 0x1e, 0xcd, 0xb1, 0x22, 0x13, 0x03, 0xcc, 0xbc, 0x52, 0xc6,
-	0x74, 0x55, 0x83, 0xb0, 0x89, 0x67, 0x7f, 0xc7, 0x4a, 0x1c, 0x69, 0xdd, 0x60, 0x19, 0x03, 0x5b,
-	0xc4, 0xa7, 0x22, 0xca, 0x8c, 0x51, 0xfc, 0x91, 0x54, 0xfe, 0xf5, 0x5b, 0x7a, 0xd5, 0x7b, 0xbc,
-	0x45, 0x7b, 0x98, 0x62, 0x5d, 0x18, 0xe1, 0x8c, 0x1f, 0xb2, 0x92, 0x89, 0xf4, 0x85, 0x3c, 0xf6,
-	0x6d, 0x66, 0x31, 0x89, 0x3d, 0x73, 0xf2, 0xed, 0xcf, 0x59, 0x30, 0x34, 0xeb, 0xd4, 0x36, 0x83,
-	0xf5, 0x75, 0xf4, 0x24, 0x94, 0xeb, 0xed, 0xd0, 0xcc, 0xe9, 0x57, 0x9e, 0xaa, 0x79, 0xd1, 0x8e,
-	0x15, 0x06, 0x9d, 0xfa, 0xeb, 0x4e, 0x4d, 0x96, 0x94, 0x28, 0xf2, 0xa9, 0x7f, 0x81, 0xb5, 0x60,
-	0x01, 0xa1, 0xc3, 0xdf, 0x74, 0xb6, 0xe5, 0xc3, 0xe9, 0xf3, 0xb4, 0x25, 0x0d, 0xc2, 0x26, 0x9e,
-	0xfd, 0x2f, 0x2c, 0x98, 0x98, 0x75, 0x22, 0xb7, 0x36, 0xd3, 0x8e, 0x37, 0x66, 0xdd, 0x78, 0xad,
-	0x5d, 0xdb, 0x24, 0x31, 0x2f, 0x3d, 0x42, 0xb9, 0x6c, 0x47, 0x74, 0x05, 0xaa, 0xed, 0xb2, 0xe2,
-	0xf2, 0x9a, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x06, 0xc3, 0x2d, 0x27, 0x8a, 0x6e, 0x05, 0x61, 0x1d,
-	0x93, 0xf5, 0x7c, 0x8a, 0x13, 0x55, 0x49, 0x2d, 0x24, 0x31, 0x26, 0xeb, 0x22, 0x3a, 0x45, 0xf7,
-	0x8f, 0x4d, 0x62, 0xf6, 0xcf, 0x59, 0x70, 0x72, 0x96, 0x38, 0x21, 0x09, 0x59, 0x2d, 0x23, 0xf5,
-	0x22, 0xe8, 0x55, 0x28, 0xc7, 0xb4, 0x85, 0x72, 0x64, 0xe5, 0xcb, 0x11, 0x8b, 0x2b, 0x59, 0x15,
-	0x9d, 0x63, 0x45, 0xc6, 0xfe, 0xb4, 0x05, 0xa7, 0xb3, 0x78, 0x99, 0xf3, 0x82, 0x76, 0xfd, 0x5e,
-	0x30, 0xf4, 0xb7, 0x2c, 0x18, 0x61, 0x67, 0xf5, 0xf3, 0x24, 0x76, 0x5c, 0xaf, 0xab, 0x8e, 0xa2,
-	0xd5, 0x67, 0x1d, 0xc5, 0xb3, 0x30, 0xb0, 0x11, 0x34, 0x49, 0x3a, 0xce, 0xe4, 0x52, 0xd0, 0x24,
-	0x98, 0x41, 0xd0, 0xd3, 0x74, 0x12, 0xba, 0x7e, 0xec, 0xd0, 0xe5, 0x28, 0xcf, 0x32, 0xc6, 0xf9,
-	0x04, 0x54, 0xcd, 0xd8, 0xc4, 0xb1, 0x7f, 0xb7, 0x02, 0x43, 0x22, 0x28, 0xaa, 0xef, 0x52, 0x38,
-	0xd2, 0x85, 0x53, 0xe8, 0xe9, 0xc2, 0x89, 0x60, 0xb0, 0xc6, 0x0a, 0xba, 0x0a, 0xf3, 0xfc, 0x4a,
-	0x2e, 0x51, 0x74, 0xbc, 0x46, 0xac, 0x66, 0x8b, 0xff, 0xc7, 0x82, 0x14, 0xfa, 0xac, 0x05, 0xe3,
-	0xb5, 0xc0, 0xf7, 0x49, 0x4d, 0xdb, 0x8e, 0x03, 0x79, 0x04, 0x4b, 0xcd, 0x25, 0x3b, 0xd5, 0xc7,
-	0xc0, 0x29, 0x00, 0x4e, 0x93, 0x47, 0x2f, 0xc0, 0x28, 0x1f, 0xb3, 0xeb, 0x89, 0x03, 0x18, 0x5d,
-	0x5e, 0xcf, 0x04, 0xe2, 0x24, 0x2e, 0x9a, 0xe2, 0x07, 0x59, 0xa2, 0x90, 0xdd, 0xa0, 0xf6, 0x53,
-	0x1b, 0x25, 0xec, 0x0c, 0x0c, 0x14, 0x02, 0x0a, 0xc9, 0x7a, 0x48, 0xa2, 0x0d, 0x11, 0x34, 0xc6,
-	0xec, 0xd6, 0xa1, 0xbb, 0x2b, 0x62, 0x81, 0xbb, 0x7a, 0xc2, 0x19, 0xbd, 0xa3, 0x4d, 0xe1, 0x43,
-	0x28, 0xe7, 0x21, 0xcf, 0xc5, 0x67, 0xee, 0xe9, 0x4a, 0x98, 0x84, 0x12, 0x53, 0x5d, 0xcc, 0x5e,
-	0x2e, 0xf2, 0xc4, 0x49, 0xa6, 0xd8, 0x30, 0x6f, 0x47, 0xf3, 0x70, 0x2c, 0x55, 0x1c, 0x30, 0x12,
-	0x07, 0x25, 0x2a, 0x49, 0x2e, 0x55, 0x56, 0x30, 0xc2, 0x5d, 0x4f, 0x98, 0xfe, 0xa5, 0xe1, 0x3d,
-	0xfc, 0x4b, 0x1d, 0x15, 0x9a, 0xcc, 0x8f, 0x30, 0x5e, 0xcc, 0x65, 0x00, 0xfa, 0x8a, 0x43, 0xfe,
-	0x54, 0x2a, 0x0e, 0x79, 0x94, 0x31, 0x70, 0x3d, 0x1f, 0x06, 0xf6, 0x1f, 0x74, 0x7c, 0x2f, 0x83,
-	0x88, 0xff, 0xa7, 0x05, 0xf2, 0xbb, 0xce, 0x39, 0xb5, 0x0d, 0x42, 0xa7, 0x0c, 0x7a, 0x3b, 0x8c,
-	0x29, 0xd7, 0x04, 0x37, 0x89, 0x2c, 0x36, 0x6b, 0x94, 0xed, 0x8c, 0x13, 0x50, 0x9c, 0xc2, 0x46,
-	0xd3, 0x50, 0xa1, 0xe3, 0xc4, 0x1f, 0xe5, 0x7a, 0x5f, 0xb9, 0x3f, 0x66, 0x56, 0x16, 0xc4, 0x53,
-	0x1a, 0x07, 0x05, 0x70, 0xdc, 0x73, 0xa2, 0x98, 0x71, 0x50, 0xed, 0xf8, 0xb5, 0xbb, 0x2c, 0x21,
-	0xc3, 0x32, 0xb1, 0x16, 0xd3, 0x1d, 0xe1, 0xee, 0xbe, 0xed, 0x7f, 0x57, 0x82, 0xd1, 0x84, 0x64,
-	0xdc, 0xa7, 0xc1, 0xf0, 0x24, 0x94, 0xa5, 0x0e, 0x4f, 0xd7, 0xca, 0x52, 0x8a, 0x5e, 0x61, 0x50,
-	0xa5, 0xb5, 0xa6, 0xb5, 0x6a, 0xda, 0xc0, 0x31, 0x14, 0x2e, 0x36, 0xf1, 0x98, 0x50, 0x8e, 0xbd,
-	0x68, 0xce, 0x73, 0x89, 0x1f, 0x73, 0x36, 0xf3, 0x11, 0xca, 0xab, 0x8b, 0x55, 0xb3, 0x53, 0x2d,
-	0x94, 0x53, 0x00, 0x9c, 0x26, 0x8f, 0x3e, 0x6a, 0xc1, 0xa8, 0x73, 0x2b, 0xd2, 0x55, 0xc7, 0x45,
-	0xc4, 0xf1, 0x01, 0x95, 0x54, 0xa2, 0x90, 0x39, 0xf7, 0xea, 0x27, 0x9a, 0x70, 0x92, 0x28, 0x7a,
-	0xdd, 0x02, 0x44, 0xb6, 0x49, 0x4d, 0xc6, 0x44, 0x0b, 0x5e, 0x06, 0xf3, 0xd8, 0xc1, 0x9f, 0xef,
-	0xea, 0x97, 0x4b, 0xf5, 0xee, 0x76, 0x9c, 0xc1, 0x03, 0xba, 0x0c, 0xa8, 0xee, 0x46, 0xce, 0x9a,
-	0x47, 0xe6, 0x82, 0xa6, 0xcc, 0x1e, 0x16, 0x87, 0xe9, 0x67, 0xc4, 0x38, 0xa3, 0xf9, 0x2e, 0x0c,
-	0x9c, 0xf1, 0x14, 0x9b, 0x65, 0x61, 0xb0, 0xdd, 0xb9, 0x16, 0x7a, 0x4c, 0x4b, 0x98, 0xb3, 0x4c,
-	0xb4, 0x63, 0x85, 0x61, 0xff, 0x65, 0x51, 0x2d, 0x65, 0x9d, 0x00, 0xe0, 0x18, 0x81, 0xc8, 0xd6,
-	0xdd, 0x07, 0x22, 0xeb, 0x30, 0xa9, 0xee, 0x9c, 0xf8, 0x44, 0x0a, 0x6d, 0xe1, 0x1e, 0xa5, 0xd0,
-	0xfe, 0xb4, 0x95, 0xa8, 0x47, 0x37, 0xfc, 0xcc, 0x4b, 0xf9, 0x26, 0x1f, 0x4c, 0xf1, 0x10, 0xae,
-	0x94, 0x5e, 0x49, 0x45, 0xee, 0x3d, 0x09, 0xe5, 0x75, 0xcf, 0x61, 0x55, 0x54, 0xd8, 0x42, 0x35,
-	0xc2, 0xcb, 0x2e, 0x88, 0x76, 0xac, 0x30, 0xa8, 0xd4, 0x37, 0x3a, 0xdd, 0x97, 0xd4, 0xfe, 0x0f,
-	0x45, 0x18, 0x36, 0x34, 0x7e, 0xa6, 0xf9, 0x66, 0xdd, 0x67, 0xe6, 0x5b, 0x61, 0x1f, 0xe6, 0xdb,
-	0x4f, 0x41, 0xa5, 0x26, 0xb5, 0x51, 0x3e, 0xf5, 0xf5, 0xd3, 0x3a, 0x4e, 0x2b, 0x24, 0xd5, 0x84,
-	0x35, 0x4d, 0x74, 0x31, 0x91, 0xa6, 0x99, 0xf0, 0x0b, 0x64, 0xe5, 0x51, 0x0a, 0x8d, 0xd6, 0xfd,
-	0x4c, 0x3a, 0x38, 0xa0, 0xb4, 0x77, 0x70, 0x80, 0xfd, 0x4d, 0x4b, 0x7d, 0xdc, 0x23, 0xa8, 0xc7,
-	0x73, 0x33, 0x59, 0x8f, 0xe7, 0x7c, 0x2e, 0xc3, 0xdc, 0xa3, 0x10, 0xcf, 0x55, 0x18, 0x9a, 0x0b,
-	0x9a, 0x4d, 0xc7, 0xaf, 0xa3, 0x1f, 0x84, 0xa1, 0x1a, 0xff, 0x29, 0x7c, 0x68, 0xec, 0xa4, 0x5a,
-	0x40, 0xb1, 0x84, 0xa1, 0x87, 0x61, 0xc0, 0x09, 0x1b, 0xd2, 0x6f, 0xc6, 0x22, 0xe0, 0x66, 0xc2,
-	0x46, 0x84, 0x59, 0xab, 0xfd, 0x4f, 0x06, 0x80, 0x05, 0x9e, 0x38, 0x21, 0xa9, 0xaf, 0x06, 0xac,
-	0x2c, 0xee, 0xa1, 0x9e, 0xef, 0xea, 0x4d, 0xdd, 0xfd, 0x7c, 0xc6, 0x6b, 0x9c, 0xf3, 0x15, 0x8f,
-	0xfa, 0x9c, 0x2f, 0xfb, 0xe8, 0x76, 0xe0, 0x3e, 0x3a, 0xba, 0xb5, 0x3f, 0x69, 0x01, 0x52, 0x61,
-	0x44, 0x3a, 0xb6, 0x62, 0x1a, 0x2a, 0x2a, 0x6e, 0x49, 0x18, 0x80, 0x5a, 0x44, 0x48, 0x00, 0xd6,
-	0x38, 0x7d, 0xec, 0xe4, 0x1f, 0x93, 0xf2, 0xbb, 0x98, 0x4c, 0x3e, 0x60, 0x52, 0x5f, 0x88, 0x73,
-	0xfb, 0xf7, 0x0a, 0xf0, 0x00, 0x37, 0x1d, 0x96, 0x1c, 0xdf, 0x69, 0x90, 0x26, 0xe5, 0xaa, 0xdf,
-	0x68, 0x99, 0x1a, 0xdd, 0x42, 0xba, 0x32, 0x55, 0xe0, 0xa0, 0x6b, 0x97, 0xaf, 0x39, 0xbe, 0xca,
-	0x16, 0x7c, 0x37, 0xc6, 0xac, 0x73, 0x14, 0x41, 0x59, 0x5e, 0x3e, 0x23, 0x64, 0x71, 0x4e, 0x84,
-	0x94, 0x58, 0x12, 0x5a, 0x96, 0x60, 0x45, 0x88, 0xaa, 0x52, 0x2f, 0xa8, 0x6d, 0x62, 0xd2, 0x0a,
-	0xd2, 0xaa, 0x74, 0x51, 0xb4, 0x63, 0x85, 0x61, 0x37, 0x61, 0x5c, 0x8e, 0x61, 0xeb, 0x0a, 0xe9,
-	0x60, 0xb2, 0x4e, 0xf5, 0x4f, 0x4d, 0x36, 0x19, 0xf7, 0xe1, 0x28, 0xfd, 0x33, 0x67, 0x02, 0x71,
-	0x12, 0x57, 0x56, 0xca, 0x2d, 0x64, 0x57, 0xca, 0xb5, 0x7f, 0xcf, 0x82, 0xb4, 0x02, 0x34, 0xea,
-	0x82, 0x5a, 0xbb, 0xd6, 0x05, 0xdd, 0x47, 0x65, 0xcd, 0xf7, 0xc0, 0xb0, 0x13, 0x53, 0x0b, 0x87,
-	0x7b, 0x23, 0x8a, 0x77, 0x77, 0x8a, 0xb6, 0x14, 0xd4, 0xdd, 0x75, 0x97, 0x79, 0x21, 0xcc, 0xee,
-	0xec, 0xd7, 0x2d, 0xa8, 0xcc, 0x87, 0x9d, 0xfd, 0xe7, 0x6c, 0x75, 0x67, 0x64, 0x15, 0xf6, 0x95,
-	0x91, 0x25, 0x73, 0xbe, 0x8a, 0xbd, 0x72, 0xbe, 0xec, 0xbf, 0x1e, 0x80, 0xe3, 0x5d, 0x49, 0x88,
-	0xe8, 0x1c, 0x8c, 0xa8, 0xaf, 0x24, 0x5d, 0x90, 0x15, 0x33, 0x8a, 0x57, 0xc3, 0x70, 0x02, 0xb3,
-	0x8f, 0xa5, 0xba, 0x00, 0x27, 0x42, 0xf2, 0x6a, 0x9b, 0xb4, 0xc9, 0xcc, 0x7a, 0x4c, 0xc2, 0x2a,
-	0xa9, 0x05, 0x7e, 0x9d, 0x17, 0xd6, 0x2d, 0xce, 0x3e, 0x78, 0x7b, 0x67, 0xf2, 0x04, 0xee, 0x06,
-	0xe3, 0xac, 0x67, 0x50, 0x0b, 0x46, 0x3d, 0xd3, 0x76, 0x16, 0x5b, 0xb6, 0xbb, 0x32, 0xbb, 0xd5,
-	0x6c, 0x4d, 0x34, 0xe3, 0x24, 0x81, 0xa4, 0x01, 0x5e, 0xba, 0x47, 0x06, 0xf8, 0x47, 0xb4, 0x01,
-	0xce, 0x83, 0x62, 0xde, 0x9d, 0x73, 0x12, 0x6a, 0x3f, 0x16, 0xf8, 0x41, 0x6c, 0xea, 0x17, 0xa1,
-	0x2c, 0x03, 0x06, 0xfb, 0x0a, 0xb4, 0x33, 0xfb, 0xe9, 0x21, 0xdb, 0x1f, 0x87, 0x37, 0x9f, 0x0f,
-	0x43, 0x63, 0x30, 0xaf, 0x06, 0xf1, 0x8c, 0xe7, 0x05, 0xb7, 0xa8, 0xb9, 0x72, 0x2d, 0x22, 0xc2,
-	0x27, 0x66, 0xdf, 0x29, 0x40, 0xc6, 0xf6, 0x92, 0xae, 0x49, 0x6d, 0x23, 0x25, 0xd6, 0xe4, 0xfe,
-	0xec, 0x24, 0xb4, 0xcd, 0x83, 0x2a, 0xb9, 0x35, 0xf0, 0xae, 0xbc, 0xb7, 0xc7, 0x3a, 0xce, 0x52,
-	0x49, 0x4a, 0x15, 0x6b, 0xf9, 0x0c, 0x80, 0x36, 0x6d, 0x45, 0xde, 0x93, 0x0a, 0x94, 0xd0, 0x16,
-	0x30, 0x36, 0xb0, 0xd0, 0xf3, 0x30, 0xec, 0xfa, 0x51, 0xec, 0x78, 0xde, 0x25, 0xd7, 0x8f, 0x85,
-	0xdb, 0x57, 0x99, 0x3d, 0x0b, 0x1a, 0x84, 0x4d, 0xbc, 0x33, 0x6f, 0x33, 0xbe, 0xdf, 0x7e, 0xbe,
-	0xfb, 0x06, 0x9c, 0xbe, 0xe8, 0xc6, 0x2a, 0x5b, 0x4f, 0xcd, 0x37, 0x6a, 0xb9, 0x2a, 0x59, 0x65,
-	0xf5, 0xcc, 0x4f, 0x35, 0xb2, 0xe5, 0x0a, 0xc9, 0xe4, 0xbe, 0x74, 0xb6, 0x9c, 0x7d, 0x0e, 0x4e,
-	0x5e, 0x74, 0xe3, 0x0b, 0xae, 0x47, 0xf6, 0x49, 0xc4, 0xfe, 0x9d, 0x41, 0x18, 0x31, 0x33, 0xd3,
-	0xf7, 0x23, 0xae, 0x3f, 0x4d, 0x8d, 0x53, 0xf1, 0x76, 0xae, 0x3a, 0xd1, 0xbd, 0x71, 0xe0, 0x34,
-	0xf9, 0xec, 0x11, 0x33, 0xec, 0x53, 0x4d, 0x13, 0x9b, 0x0c, 0xa0, 0x5b, 0x50, 0x5a, 0x67, 0xd9,
-	0x5c, 0xc5, 0x3c, 0x62, 0x71, 0xb2, 0x46, 0x54, 0x2f, 0x47, 0x9e, 0x0f, 0xc6, 0xe9, 0x51, 0x9b,
-	0x22, 0x4c, 0x26, 0x11, 0x1b, 0x31, 0xf6, 0x42, 0x59, 0x29, 0x8c, 0x5e, 0x2a, 0xa1, 0x74, 0x17,
-	0x2a, 0x21, 0x21, 0xa0, 0x07, 0xef, 0x91, 0x80, 0x66, 0x99, 0x79, 0xf1, 0x06, 0xb3, 0x78, 0x45,
-	0x52, 0xd0, 0x10, 0x1b, 0x04, 0x23, 0x33, 0x2f, 0x01, 0xc6, 0x69, 0x7c, 0xf4, 0x41, 0x25, 0xe2,
-	0xcb, 0x79, 0x78, 0xcc, 0xcd, 0x19, 0x7d, 0xd8, 0xd2, 0xfd, 0x93, 0x05, 0x18, 0xbb, 0xe8, 0xb7,
-	0x57, 0x2e, 0xae, 0xb4, 0xd7, 0x3c, 0xb7, 0x76, 0x85, 0x74, 0xa8, 0x08, 0xdf, 0x24, 0x9d, 0x85,
-	0x79, 0xb1, 0x82, 0xd4, 0x9c, 0xb9, 0x42, 0x1b, 0x31, 0x87, 0x51, 0x61, 0xb4, 0xee, 0xfa, 0x0d,
-	0x12, 0xb6, 0x42, 0x57, 0x38, 0xb3, 0x0d, 0x61, 0x74, 0x41, 0x83, 0xb0, 0x89, 0x47, 0xfb, 0x0e,
-	0x6e, 0xf9, 0x24, 0x4c, 0x9b, 0xfe, 0xcb, 0xb4, 0x11, 0x73, 0x18, 0x45, 0x8a, 0xc3, 0xb6, 0xf0,
-	0x15, 0x19, 0x48, 0xab, 0xb4, 0x11, 0x73, 0x18, 0x5d, 0xe9, 0x51, 0x7b, 0x8d, 0x85, 0x3a, 0xa5,
-	0x32, 0x90, 0xaa, 0xbc, 0x19, 0x4b, 0x38, 0x45, 0xdd, 0x24, 0x9d, 0x79, 0x27, 0x76, 0xd2, 0x69,
-	0x9a, 0x57, 0x78, 0x33, 0x96, 0x70, 0x56, 0xfa, 0x37, 0x39, 0x1c, 0xdf, 0x75, 0xa5, 0x7f, 0x93,
-	0xec, 0xf7, 0xf0, 0x38, 0xfc, 0xcd, 0x02, 0x8c, 0x98, 0x01, 0x8a, 0xa8, 0x91, 0x32, 0xd3, 0x97,
-	0xbb, 0x2a, 0xc7, 0xff, 0x78, 0xd6, 0xad, 0xaa, 0x0d, 0x37, 0x0e, 0x5a, 0xd1, 0x53, 0xc4, 0x6f,
-	0xb8, 0x3e, 0x61, 0xb1, 0x1a, 0x3c, 0xb0, 0x31, 0x11, 0xfd, 0x38, 0x17, 0xd4, 0xc9, 0xdd, 0xd8,
-	0xf9, 0xf7, 0xe2, 0xe6, 0x99, 0x1b, 0x70, 0xbc, 0x2b, 0x1f, 0xb8, 0x0f, 0xb3, 0x67, 0xcf, 0x7a,
-	0x0d, 0x36, 0x86, 0x61, 0xda, 0xb1, 0x2c, 0x79, 0x37, 0x07, 0xc7, 0xf9, 0xe2, 0xa5, 0x94, 0x58,
-	0x7a, 0xa7, 0xca, 0xf1, 0x66, 0xa7, 0x35, 0xd7, 0xd3, 0x40, 0xdc, 0x8d, 0x6f, 0x7f, 0xca, 0x82,
-	0xd1, 0x44, 0x8a, 0x76, 0x4e, 0x06, 0x1a, 0x5b, 0xdd, 0x01, 0x8b, 0xd1, 0x65, 0x39, 0x13, 0x45,
-	0xa6, 0xc0, 0xf5, 0xea, 0xd6, 0x20, 0x6c, 0xe2, 0xd9, 0x9f, 0x2b, 0x40, 0x59, 0x86, 0x14, 0xf5,
-	0xc1, 0xca, 0x27, 0x2c, 0x18, 0x55, 0x27, 0x64, 0xcc, 0xa5, 0x59, 0xc8, 0x23, 0x63, 0x8c, 0x72,
-	0xa0, 0x9c, 0x22, 0xfe, 0x7a, 0xa0, 0x77, 0x0b, 0xd8, 0x24, 0x86, 0x93, 0xb4, 0xd1, 0x75, 0x80,
-	0xa8, 0x13, 0xc5, 0xa4, 0x69, 0x38, 0x57, 0x6d, 0x63, 0x96, 0x4d, 0xd5, 0x82, 0x90, 0xd0, 0x39,
-	0x75, 0x35, 0xa8, 0x93, 0xaa, 0xc2, 0xd4, 0x66, 0x9b, 0x6e, 0xc3, 0x46, 0x4f, 0xf6, 0xaf, 0x15,
-	0xe0, 0x58, 0x9a, 0x25, 0xf4, 0x6e, 0x18, 0x91, 0xd4, 0x8d, 0x4d, 0xb8, 0x0c, 0x88, 0x1a, 0xc1,
-	0x06, 0xec, 0xce, 0xce, 0xe4, 0x64, 0xf7, 0xad, 0xc0, 0x53, 0x26, 0x0a, 0x4e, 0x74, 0xc6, 0x8f,
-	0x29, 0xc5, 0x79, 0xfa, 0x6c, 0x67, 0xa6, 0xd5, 0x12, 0x67, 0x8d, 0xc6, 0x31, 0xa5, 0x09, 0xc5,
-	0x29, 0x6c, 0xb4, 0x02, 0x27, 0x8d, 0x96, 0xab, 0xc4, 0x6d, 0x6c, 0xac, 0x05, 0xa1, 0xdc, 0xf5,
-	0x3d, 0xac, 0xc3, 0x2f, 0xbb, 0x71, 0x70, 0xe6, 0x93, 0xd4, 0xc2, 0xa8, 0x39, 0x2d, 0xa7, 0xe6,
-	0xc6, 0x1d, 0xe1, 0x2d, 0x56, 0xf2, 0x70, 0x4e, 0xb4, 0x63, 0x85, 0x61, 0xff, 0xca, 0x00, 0x1c,
-	0xe3, 0xf1, 0x86, 0x44, 0x85, 0xd3, 0xa2, 0x77, 0x43, 0x25, 0x8a, 0x9d, 0x90, 0x6f, 0xf9, 0xad,
-	0x7d, 0xcb, 0x00, 0x9d, 0xa0, 0x2d, 0x3b, 0xc1, 0xba, 0x3f, 0xf4, 0x12, 0xab, 0x6e, 0xe5, 0x46,
-	0x1b, 0xac, 0xf7, 0xc2, 0xdd, 0x39, 0x14, 0x2e, 0xa8, 0x1e, 0xb0, 0xd1, 0x1b, 0xfa, 0x31, 0x28,
-	0xb5, 0x36, 0x9c, 0x48, 0x7a, 0xbb, 0x1e, 0x97, 0x0b, 0x6e, 0x85, 0x36, 0xde, 0xd9, 0x99, 0x3c,
-	0x95, 0x7e, 0x55, 0x06, 0xc0, 0xfc, 0x21, 0x53, 0x5c, 0x0e, 0xec, 0x7d, 0x03, 0x4b, 0x3d, 0xec,
-	0x54, 0x2f, 0xcd, 0xa4, 0xef, 0xec, 0x98, 0x67, 0xad, 0x58, 0x40, 0xe9, 0xe2, 0xde, 0xe0, 0x24,
-	0xeb, 0x14, 0x79, 0x30, 0xa9, 0xba, 0x2f, 0x69, 0x10, 0x36, 0xf1, 0xd0, 0x27, 0xbb, 0xa3, 0x51,
-	0x87, 0x0e, 0x21, 0x55, 0xa1, 0xdf, 0x38, 0xd4, 0xf3, 0x50, 0x11, 0xac, 0xae, 0x06, 0xe8, 0x1c,
-	0x8c, 0x70, 0x67, 0xca, 0x6c, 0xe8, 0xf8, 0xb5, 0x8d, 0xb4, 0x0b, 0x64, 0xd5, 0x80, 0xe1, 0x04,
-	0xa6, 0xbd, 0x04, 0x03, 0x7d, 0x4a, 0xab, 0xbe, 0x76, 0xb6, 0x2f, 0x42, 0x99, 0x76, 0x27, 0xb7,
-	0x2f, 0x79, 0x74, 0x19, 0x40, 0x59, 0xde, 0xe7, 0x87, 0x6c, 0x28, 0xba, 0x8e, 0x8c, 0x3a, 0x50,
-	0x4b, 0x68, 0x21, 0x8a, 0xda, 0x6c, 0xda, 0x51, 0x20, 0x7a, 0x0c, 0x8a, 0x64, 0xbb, 0x95, 0x0e,
-	0x2f, 0x38, 0xbf, 0xdd, 0x72, 0x43, 0x12, 0x51, 0x24, 0xb2, 0xdd, 0x42, 0x67, 0xa0, 0xe0, 0xd6,
-	0xc5, 0x8c, 0x04, 0x81, 0x53, 0x58, 0x98, 0xc7, 0x05, 0xb7, 0x6e, 0x6f, 0x43, 0x45, 0x5d, 0x20,
-	0x88, 0x36, 0xa5, 0x6d, 0x62, 0xe5, 0x11, 0x6f, 0x2a, 0xfb, 0xed, 0x61, 0x95, 0xb4, 0x01, 0x74,
-	0xe6, 0x7f, 0x5e, 0xba, 0xec, 0x2c, 0x0c, 0xd4, 0x02, 0x51, 0xb3, 0xa5, 0xac, 0xbb, 0x61, 0x46,
-	0x09, 0x83, 0xd8, 0x37, 0x60, 0xec, 0x8a, 0x1f, 0xdc, 0x62, 0xf7, 0xfc, 0xb0, 0xb2, 0xb6, 0xb4,
-	0xe3, 0x75, 0xfa, 0x23, 0x6d, 0x02, 0x33, 0x28, 0xe6, 0x30, 0x55, 0x70, 0xb3, 0xd0, 0xab, 0xe0,
-	0xa6, 0xfd, 0x21, 0x0b, 0x46, 0x54, 0x0a, 0xf1, 0xc5, 0xad, 0x4d, 0xda, 0x6f, 0x23, 0x0c, 0xda,
-	0xad, 0x74, 0xbf, 0xec, 0xae, 0x52, 0xcc, 0x61, 0x66, 0x6e, 0x7d, 0x61, 0x8f, 0xdc, 0xfa, 0xb3,
-	0x30, 0xb0, 0xe9, 0xfa, 0xf5, 0xb4, 0xcb, 0xf0, 0x8a, 0xeb, 0xd7, 0x31, 0x83, 0x50, 0x16, 0x8e,
-	0x29, 0x16, 0xa4, 0xf1, 0x71, 0x0e, 0x46, 0xd6, 0xda, 0xae, 0x57, 0x97, 0xf5, 0x7a, 0x53, 0xcb,
-	0x65, 0xd6, 0x80, 0xe1, 0x04, 0x26, 0x7a, 0x06, 0x60, 0xcd, 0xf5, 0x9d, 0xb0, 0xb3, 0xa2, 0xad,
-	0x1d, 0xa5, 0x00, 0x67, 0x15, 0x04, 0x1b, 0x58, 0xf6, 0x67, 0x8a, 0x30, 0x96, 0x4c, 0xa4, 0xee,
-	0xc3, 0x7d, 0xf0, 0x18, 0x94, 0x58, 0x6e, 0x75, 0xfa, 0xd3, 0xf2, 0x12, 0xb7, 0x1c, 0x86, 0x22,
-	0x18, 0xe4, 0x8b, 0x39, 0x9f, 0xfb, 0x1e, 0x15, 0x93, 0xca, 0xcf, 0xc8, 0xa2, 0x72, 0x85, 0xdb,
-	0x56, 0x90, 0x42, 0x1f, 0xb5, 0x60, 0x28, 0x68, 0x99, 0x85, 0x1a, 0xdf, 0x95, 0x67, 0x92, 0xb9,
-	0xc8, 0xe4, 0x14, 0x3b, 0x3e, 0xf5, 0xe9, 0xe5, 0xe7, 0x90, 0xa4, 0xcf, 0xfc, 0x28, 0x8c, 0x98,
-	0x98, 0x7b, 0x6d, 0xfa, 0xca, 0xe6, 0xa6, 0xef, 0x13, 0xe6, 0xa4, 0x10, 0x69, 0xf4, 0x7d, 0x2c,
-	0xb7, 0x6b, 0x50, 0xaa, 0xa9, 0xd0, 0xa5, 0xbb, 0xaa, 0xf2, 0xae, 0xca, 0x4c, 0xb1, 0x63, 0x61,
-	0xde, 0x9b, 0xfd, 0x4d, 0xcb, 0x98, 0x1f, 0x98, 0x44, 0x0b, 0x75, 0x14, 0x42, 0xb1, 0xb1, 0xb5,
-	0x29, 0xd4, 0xfc, 0xe5, 0x9c, 0x86, 0xf7, 0xe2, 0xd6, 0xa6, 0x9e, 0xe3, 0x66, 0x2b, 0xa6, 0xc4,
-	0xfa, 0x70, 0x86, 0x27, 0xaa, 0x2d, 0x14, 0xf7, 0xae, 0xb6, 0x60, 0xbf, 0x5e, 0x80, 0xe3, 0x5d,
-	0x93, 0x0a, 0xbd, 0x06, 0xa5, 0x90, 0xbe, 0xa5, 0x78, 0xbd, 0xc5, 0xdc, 0xea, 0x23, 0x44, 0x0b,
-	0x75, 0xad, 0x3e, 0x93, 0xed, 0x98, 0x93, 0x44, 0x97, 0x01, 0xe9, 0x00, 0x3b, 0xe5, 0x89, 0xe7,
-	0xaf, 0xac, 0xa2, 0x70, 0x66, 0xba, 0x30, 0x70, 0xc6, 0x53, 0xe8, 0x85, 0xb4, 0x43, 0xbf, 0x98,
-	0x3c, 0x49, 0xda, 0xcd, 0x37, 0x6f, 0xff, 0x56, 0x01, 0x46, 0x13, 0x75, 0x33, 0x91, 0x07, 0x65,
-	0xe2, 0xb1, 0x63, 0x3e, 0xa9, 0x6c, 0x0e, 0x7a, 0x0b, 0x86, 0x52, 0x90, 0xe7, 0x45, 0xbf, 0x58,
-	0x51, 0xb8, 0x3f, 0x82, 0x73, 0xce, 0xc1, 0x88, 0x64, 0xe8, 0x5d, 0x4e, 0xd3, 0x13, 0x03, 0xa8,
-	0xe6, 0xe8, 0x79, 0x03, 0x86, 0x13, 0x98, 0xf6, 0xef, 0x17, 0x61, 0x82, 0x9f, 0x8b, 0xd6, 0xd5,
-	0xcc, 0x5b, 0x92, 0xfe, 0x84, 0x9f, 0xd7, 0xd5, 0x6d, 0xad, 0x3c, 0xae, 0x7a, 0xee, 0x45, 0xa8,
-	0xaf, 0x98, 0xd2, 0x2f, 0xa6, 0x62, 0x4a, 0xf9, 0x16, 0xaf, 0x71, 0x48, 0x1c, 0x7d, 0x77, 0x05,
-	0x99, 0xfe, 0x83, 0x02, 0x8c, 0xa7, 0x6e, 0xf4, 0x42, 0x9f, 0x49, 0x5e, 0x02, 0x61, 0xe5, 0x71,
-	0x66, 0xb4, 0xeb, 0x25, 0x4f, 0xfb, 0xbb, 0x0a, 0xe2, 0x1e, 0x2d, 0x15, 0xfb, 0x1b, 0x05, 0x18,
-	0x4b, 0x5e, 0x45, 0x76, 0x1f, 0x8e, 0xd4, 0x5b, 0xa1, 0xc2, 0x6e, 0xdb, 0x61, 0x37, 0xe8, 0xf3,
-	0x23, 0x27, 0x7e, 0xb1, 0x89, 0x6c, 0xc4, 0x1a, 0x7e, 0x5f, 0xdc, 0xb0, 0x61, 0xff, 0x23, 0x0b,
-	0x4e, 0xf1, 0xb7, 0x4c, 0xcf, 0xc3, 0xbf, 0x91, 0x35, 0xba, 0x2f, 0xe7, 0xcb, 0x60, 0xaa, 0x2a,
-	0xf3, 0x5e, 0xe3, 0xcb, 0x2e, 0xbc, 0x16, 0xdc, 0x26, 0xa7, 0xc2, 0x7d, 0xc8, 0xec, 0xbe, 0x26,
-	0x83, 0xfd, 0x8d, 0x22, 0xe8, 0x3b, 0xbe, 0x91, 0x2b, 0xb2, 0xde, 0x73, 0xa9, 0x4e, 0x5d, 0xed,
-	0xf8, 0x35, 0x7d, 0x9b, 0x78, 0x39, 0x95, 0xf4, 0xfe, 0xb3, 0x16, 0x0c, 0xbb, 0xbe, 0x1b, 0xbb,
-	0x0e, 0x73, 0xd9, 0xe4, 0x73, 0x51, 0xaf, 0x22, 0xb7, 0xc0, 0x7b, 0x0e, 0x42, 0xf3, 0x9c, 0x52,
-	0x11, 0xc3, 0x26, 0x65, 0xf4, 0x3e, 0x91, 0xf6, 0x51, 0xcc, 0xad, 0x74, 0x44, 0x39, 0x95, 0xeb,
-	0xd1, 0xa2, 0x86, 0x57, 0x1c, 0xe6, 0x54, 0x71, 0x05, 0xd3, 0xae, 0xd4, 0x45, 0x07, 0xca, 0xb4,
-	0x65, 0xcd, 0x98, 0x13, 0xb2, 0x23, 0x40, 0xdd, 0x63, 0xb1, 0xcf, 0x90, 0xfa, 0x69, 0xa8, 0x38,
-	0xed, 0x38, 0x68, 0xd2, 0x61, 0x12, 0x47, 0xa9, 0x3a, 0x69, 0x40, 0x02, 0xb0, 0xc6, 0xb1, 0x3f,
-	0x53, 0x82, 0x54, 0x1a, 0x3a, 0xda, 0x36, 0xef, 0xa7, 0xb7, 0xf2, 0xbd, 0x9f, 0x5e, 0x31, 0x93,
-	0x75, 0x47, 0x3d, 0x6a, 0x48, 0xef, 0x17, 0xb7, 0x31, 0x5f, 0x4c, 0x7b, 0xbf, 0x7e, 0xa2, 0xbf,
-	0x53, 0x05, 0x3a, 0x57, 0xa7, 0x79, 0xd5, 0xb1, 0xa9, 0x3d, 0x1d, 0x65, 0x7b, 0x5d, 0x55, 0xfc,
-	0x61, 0x71, 0xad, 0x10, 0x26, 0x51, 0xdb, 0x8b, 0xc5, 0x6c, 0x78, 0x31, 0xc7, 0x55, 0xc6, 0x3b,
-	0xd6, 0xb5, 0x5c, 0xf8, 0x7f, 0x6c, 0x10, 0x4d, 0xba, 0x33, 0x07, 0x0f, 0xd5, 0x9d, 0x39, 0x94,
-	0xab, 0x3b, 0xf3, 0x19, 0x00, 0x36, 0xb7, 0x79, 0xe8, 0x6f, 0x99, 0x79, 0x99, 0x94, 0x28, 0xc4,
-	0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x87, 0x21, 0x59, 0x8c, 0x08, 0x4d, 0xca, 0xda, 0x47, 0xfc, 0xc4,
-	0x83, 0x65, 0x5d, 0x25, 0xca, 0x14, 0xfd, 0x86, 0x05, 0x66, 0xc5, 0x24, 0xf4, 0x2a, 0x2f, 0xcd,
-	0x64, 0xe5, 0x71, 0x32, 0x6e, 0xf4, 0x3b, 0xb5, 0xe4, 0xb4, 0x52, 0x21, 0x1a, 0xb2, 0x3e, 0xd3,
-	0x99, 0xb7, 0x41, 0x59, 0x42, 0xf7, 0x65, 0xd4, 0x7d, 0x10, 0x4e, 0xc8, 0x0c, 0x6e, 0xe9, 0xa3,
-	0x17, 0xa7, 0xaa, 0x7b, 0xbb, 0x7e, 0xa4, 0x3f, 0xa7, 0xd0, 0xcb, 0x9f, 0xa3, 0x76, 0xa9, 0xc5,
-	0x9e, 0x45, 0x97, 0x7f, 0xd3, 0x82, 0xb3, 0x69, 0x06, 0xa2, 0xa5, 0xc0, 0x77, 0xe3, 0x20, 0xac,
-	0x92, 0x38, 0x76, 0xfd, 0x06, 0xab, 0xa0, 0x79, 0xcb, 0x09, 0xe5, 0x2d, 0x2a, 0x4c, 0x50, 0xde,
-	0x70, 0x42, 0x1f, 0xb3, 0x56, 0xd4, 0x81, 0x41, 0x1e, 0x1f, 0x2a, 0xac, 0xf5, 0x03, 0xae, 0x8d,
-	0x8c, 0xe1, 0xd0, 0xdb, 0x05, 0x1e, 0x9b, 0x8a, 0x05, 0x41, 0xfb, 0x5b, 0x16, 0xa0, 0xe5, 0x2d,
-	0x12, 0x86, 0x6e, 0xdd, 0x88, 0x68, 0x65, 0xd7, 0xf3, 0x19, 0xd7, 0xf0, 0x99, 0xf5, 0x05, 0x52,
-	0xd7, 0xf3, 0x19, 0xff, 0xb2, 0xaf, 0xe7, 0x2b, 0xec, 0xef, 0x7a, 0x3e, 0xb4, 0x0c, 0xa7, 0x9a,
-	0x7c, 0xbb, 0xc1, 0xaf, 0xbc, 0xe2, 0x7b, 0x0f, 0x95, 0x0a, 0x7b, 0xfa, 0xf6, 0xce, 0xe4, 0xa9,
-	0xa5, 0x2c, 0x04, 0x9c, 0xfd, 0x9c, 0xfd, 0x36, 0x40, 0x3c, 0x90, 0x75, 0x2e, 0x2b, 0x16, 0xaf,
-	0xa7, 0xfb, 0xc5, 0xfe, 0x42, 0x09, 0xc6, 0x53, 0x35, 0xf6, 0xe9, 0x56, 0xaf, 0x3b, 0xf8, 0xef,
-	0xc0, 0xfa, 0xbb, 0x9b, 0xbd, 0xbe, 0xc2, 0x09, 0x7d, 0x28, 0xb9, 0x7e, 0xab, 0x1d, 0xe7, 0x93,
-	0x89, 0xcf, 0x99, 0x58, 0xa0, 0x1d, 0x1a, 0xee, 0x62, 0xfa, 0x17, 0x73, 0x32, 0x79, 0x06, 0x27,
-	0x26, 0x8c, 0xf1, 0x81, 0x7b, 0xe4, 0x0e, 0xf8, 0xb0, 0x0e, 0x15, 0x2c, 0xe5, 0xe1, 0x58, 0x4c,
-	0x4d, 0x96, 0xc3, 0x0e, 0x25, 0xf9, 0x6a, 0x01, 0x86, 0x8d, 0x8f, 0x86, 0x7e, 0x39, 0x59, 0x4f,
-	0xd0, 0xca, 0xef, 0x95, 0x58, 0xff, 0x53, 0xba, 0x62, 0x20, 0x7f, 0xa5, 0xc7, 0xbb, 0x4b, 0x09,
-	0xde, 0xd9, 0x99, 0x3c, 0x96, 0x2a, 0x16, 0x98, 0x28, 0x2f, 0x78, 0xe6, 0x03, 0x30, 0x9e, 0xea,
-	0x26, 0xe3, 0x95, 0x57, 0xcd, 0x57, 0x3e, 0xb0, 0x5b, 0xca, 0x1c, 0xb2, 0xaf, 0xd0, 0x21, 0x13,
-	0x09, 0xc0, 0x81, 0x47, 0xfa, 0xf0, 0xc1, 0xa6, 0xf2, 0xfc, 0x0b, 0x7d, 0xe6, 0xf9, 0x3f, 0x01,
-	0xe5, 0x56, 0xe0, 0xb9, 0x35, 0x57, 0x95, 0x23, 0x66, 0x95, 0x05, 0x56, 0x44, 0x1b, 0x56, 0x50,
-	0x74, 0x0b, 0x2a, 0x37, 0x6f, 0xc5, 0xfc, 0xf4, 0x47, 0xf8, 0xb7, 0xf3, 0x3a, 0xf4, 0x51, 0x46,
-	0x8b, 0x3a, 0x5e, 0xc2, 0x9a, 0x16, 0xb2, 0x61, 0x90, 0x29, 0x41, 0x99, 0x0c, 0xc4, 0x7c, 0xef,
-	0x4c, 0x3b, 0x46, 0x58, 0x40, 0xec, 0xef, 0x00, 0x9c, 0xcc, 0xba, 0xe8, 0x04, 0xbd, 0x1f, 0x06,
-	0x39, 0x8f, 0xf9, 0xdc, 0xa5, 0x95, 0x45, 0xe3, 0x22, 0xeb, 0x50, 0xb0, 0xc5, 0x7e, 0x63, 0x41,
-	0x53, 0x50, 0xf7, 0x9c, 0x35, 0x31, 0x43, 0x0e, 0x87, 0xfa, 0xa2, 0xa3, 0xa9, 0x2f, 0x3a, 0x9c,
-	0xba, 0xe7, 0xac, 0xa1, 0x6d, 0x28, 0x35, 0xdc, 0x98, 0x38, 0xc2, 0x89, 0x70, 0xe3, 0x50, 0x88,
-	0x13, 0x87, 0x5b, 0x69, 0xec, 0x27, 0xe6, 0x04, 0xd1, 0x97, 0x2c, 0x18, 0x5f, 0x4b, 0x16, 0x18,
-	0x11, 0xc2, 0xd3, 0x39, 0x84, 0xcb, 0x6c, 0x92, 0x84, 0xf8, 0xfd, 0x94, 0xa9, 0x46, 0x9c, 0x66,
-	0x07, 0x7d, 0xc4, 0x82, 0xa1, 0x75, 0xd7, 0x33, 0x6e, 0x0b, 0x38, 0x84, 0x8f, 0x73, 0x81, 0x11,
-	0xd0, 0x3b, 0x0e, 0xfe, 0x3f, 0xc2, 0x92, 0x72, 0x2f, 0x4d, 0x35, 0x78, 0x50, 0x4d, 0x35, 0x74,
-	0x8f, 0x34, 0xd5, 0xc7, 0x2d, 0xa8, 0xa8, 0x91, 0x16, 0x85, 0x1a, 0xde, 0x7d, 0x88, 0x9f, 0x9c,
-	0x7b, 0x4e, 0xd4, 0x5f, 0xac, 0x89, 0xa3, 0xcf, 0x5a, 0x30, 0xec, 0xbc, 0xd6, 0x0e, 0x49, 0x9d,
-	0x6c, 0x05, 0xad, 0x48, 0x94, 0x4f, 0x7c, 0x39, 0x7f, 0x66, 0x66, 0x28, 0x91, 0x79, 0xb2, 0xb5,
-	0xdc, 0x8a, 0x44, 0xa2, 0xa2, 0x6e, 0xc0, 0x26, 0x0b, 0xe8, 0x67, 0xb4, 0x1e, 0x87, 0x3c, 0x8a,
-	0xe8, 0x66, 0x71, 0x73, 0xd8, 0xca, 0x7c, 0xa7, 0x00, 0x93, 0x7b, 0x8c, 0x02, 0x3a, 0x07, 0x23,
-	0x41, 0xd8, 0x70, 0x7c, 0xf7, 0x35, 0xb3, 0xea, 0x91, 0xb2, 0x14, 0x97, 0x0d, 0x18, 0x4e, 0x60,
-	0x9a, 0xe5, 0x30, 0x0a, 0x7b, 0x94, 0xc3, 0x38, 0x0b, 0x03, 0x21, 0x69, 0x05, 0xe9, 0x0d, 0x0f,
-	0x4b, 0x74, 0x62, 0x10, 0xf4, 0x08, 0x14, 0x9d, 0x96, 0x2b, 0xc2, 0x63, 0xd4, 0x3e, 0x6e, 0x66,
-	0x65, 0x01, 0xd3, 0xf6, 0x44, 0x75, 0x9e, 0xd2, 0x91, 0x54, 0xe7, 0xa1, 0xaa, 0x4c, 0x9c, 0xbf,
-	0x0c, 0x6a, 0x55, 0x96, 0x3c, 0x17, 0xb1, 0x5f, 0x2f, 0xc2, 0x23, 0xbb, 0xce, 0x79, 0x1d, 0x2b,
-	0x6b, 0xed, 0x12, 0x2b, 0x2b, 0x87, 0xa7, 0xb0, 0xd7, 0xf0, 0x14, 0x7b, 0x0c, 0xcf, 0x47, 0xe8,
-	0x52, 0x96, 0xd5, 0xa2, 0xf2, 0xb9, 0x62, 0xb9, 0x57, 0xf1, 0x29, 0xb1, 0x8a, 0x25, 0x14, 0x6b,
-	0xba, 0x74, 0x1f, 0x93, 0x28, 0x05, 0x51, 0xca, 0x43, 0x95, 0xf5, 0xac, 0xd8, 0xc4, 0xd7, 0x6f,
-	0xaf, 0xfa, 0x12, 0xf6, 0x6f, 0x0f, 0xc0, 0x63, 0x7d, 0x68, 0x20, 0x73, 0x16, 0x5b, 0x7d, 0xce,
-	0xe2, 0xef, 0xf2, 0xcf, 0xf4, 0xb1, 0xcc, 0xcf, 0x84, 0xf3, 0xff, 0x4c, 0xbb, 0x7f, 0x21, 0xf4,
-	0x24, 0x94, 0x5d, 0x3f, 0x22, 0xb5, 0x76, 0xc8, 0xf3, 0x06, 0x8c, 0x2c, 0xc8, 0x05, 0xd1, 0x8e,
-	0x15, 0x06, 0xdd, 0x97, 0xd6, 0x1c, 0xba, 0xfc, 0x87, 0x72, 0x4a, 0xfd, 0x37, 0x13, 0x2a, 0xb9,
-	0x59, 0x34, 0x37, 0x43, 0x25, 0x00, 0x27, 0x63, 0xff, 0x82, 0x05, 0x67, 0x7a, 0x9b, 0x09, 0xe8,
-	0x69, 0x18, 0x5e, 0x63, 0xc1, 0x67, 0xec, 0x72, 0x7d, 0x39, 0x75, 0xd8, 0xfb, 0xea, 0x66, 0x6c,
-	0xe2, 0xa0, 0x39, 0x38, 0x6e, 0x46, 0xad, 0x2d, 0x19, 0x91, 0x31, 0xcc, 0x91, 0xb1, 0x9a, 0x06,
-	0xe2, 0x6e, 0x7c, 0xfb, 0xdb, 0xc5, 0x6c, 0xb6, 0xb8, 0x39, 0xb9, 0x9f, 0xd9, 0x2c, 0xe6, 0x6a,
-	0xa1, 0x0f, 0x89, 0x5b, 0x3c, 0x6a, 0x89, 0x3b, 0xd0, 0x4b, 0xe2, 0xa2, 0x79, 0x38, 0x66, 0xdc,
-	0x7e, 0xc8, 0x8b, 0x41, 0xf0, 0x48, 0x49, 0x55, 0xc9, 0x69, 0x25, 0x05, 0xc7, 0x5d, 0x4f, 0xdc,
-	0xe7, 0x53, 0xef, 0x57, 0x0a, 0x70, 0xba, 0xa7, 0x05, 0x7f, 0x44, 0x1a, 0xc5, 0xfc, 0xfc, 0x03,
-	0x47, 0xf3, 0xf9, 0xcd, 0x8f, 0x52, 0xda, 0xeb, 0xa3, 0xd8, 0x7f, 0x52, 0xe8, 0xb9, 0x10, 0xe8,
-	0x6e, 0xee, 0x7b, 0x76, 0x94, 0x5e, 0x80, 0x51, 0xa7, 0xd5, 0xe2, 0x78, 0x2c, 0xea, 0x3c, 0x55,
-	0x39, 0x6e, 0xc6, 0x04, 0xe2, 0x24, 0x6e, 0x5f, 0x36, 0xcd, 0x9f, 0x5b, 0x50, 0xc1, 0x64, 0x9d,
-	0x4b, 0x23, 0x74, 0x53, 0x0c, 0x91, 0x95, 0x47, 0xed, 0x6e, 0x3a, 0xb0, 0x91, 0xcb, 0x6a, 0x5a,
-	0x67, 0x0d, 0xf6, 0x41, 0x73, 0xaf, 0xd5, 0x7d, 0x88, 0xc5, 0xde, 0xf7, 0x21, 0xda, 0xff, 0xad,
-	0x4c, 0x5f, 0xaf, 0x15, 0xcc, 0x85, 0xa4, 0x1e, 0xd1, 0xef, 0xdb, 0x0e, 0x3d, 0x31, 0x49, 0xd4,
-	0xf7, 0xbd, 0x86, 0x17, 0x31, 0x6d, 0x4f, 0x1c, 0xf2, 0x15, 0xf6, 0x55, 0x37, 0xab, 0xb8, 0x67,
-	0xdd, 0xac, 0x17, 0x60, 0x34, 0x8a, 0x36, 0x56, 0x42, 0x77, 0xcb, 0x89, 0xc9, 0x15, 0xd2, 0x11,
-	0xb6, 0xaf, 0xae, 0x21, 0x53, 0xbd, 0xa4, 0x81, 0x38, 0x89, 0x8b, 0x2e, 0xc2, 0x71, 0x5d, 0xbd,
-	0x8a, 0x84, 0x31, 0xcb, 0x8b, 0xe2, 0x33, 0x41, 0x15, 0x8c, 0xd0, 0xf5, 0xae, 0x04, 0x02, 0xee,
-	0x7e, 0x86, 0xca, 0xd3, 0x44, 0x23, 0x65, 0x64, 0x30, 0x29, 0x4f, 0x13, 0xfd, 0x50, 0x5e, 0xba,
-	0x9e, 0x40, 0x4b, 0x70, 0x82, 0x4f, 0x8c, 0x99, 0x56, 0xcb, 0x78, 0xa3, 0xa1, 0x64, 0xcd, 0xe4,
-	0x8b, 0xdd, 0x28, 0x38, 0xeb, 0x39, 0xf4, 0x3c, 0x0c, 0xab, 0xe6, 0x85, 0x79, 0x71, 0x3e, 0xa5,
-	0xfc, 0x63, 0xaa, 0x9b, 0x85, 0x3a, 0x36, 0xf1, 0xd0, 0xbb, 0xe0, 0x41, 0xfd, 0x97, 0x27, 0xcf,
-	0xf2, 0x43, 0xdb, 0x79, 0x51, 0x18, 0x50, 0xdd, 0xc7, 0x73, 0x31, 0x13, 0xad, 0x8e, 0x7b, 0x3d,
-	0x8f, 0xd6, 0xe0, 0x8c, 0x02, 0x9d, 0xf7, 0x63, 0x96, 0x09, 0x17, 0x91, 0x59, 0x27, 0x22, 0xd7,
-	0x42, 0x8f, 0x95, 0x12, 0xac, 0xe8, 0x0b, 0xda, 0x2f, 0xba, 0xf1, 0xa5, 0x2c, 0x4c, 0xbc, 0x88,
-	0x77, 0xe9, 0x05, 0x4d, 0x43, 0x85, 0xf8, 0xce, 0x9a, 0x47, 0x96, 0xe7, 0x16, 0x58, 0x81, 0x41,
-	0xe3, 0x8c, 0xf8, 0xbc, 0x04, 0x60, 0x8d, 0xa3, 0x62, 0x97, 0x47, 0x7a, 0xc5, 0x2e, 0xa3, 0x15,
-	0x38, 0xd9, 0xa8, 0xb5, 0xa8, 0x45, 0xe8, 0xd6, 0xc8, 0x4c, 0x8d, 0x85, 0x6a, 0xd2, 0x0f, 0xc3,
-	0x8b, 0x59, 0xab, 0x24, 0x90, 0x8b, 0x73, 0x2b, 0x5d, 0x38, 0x38, 0xf3, 0x49, 0x16, 0xd2, 0x1b,
-	0x06, 0xdb, 0x9d, 0x89, 0x13, 0xa9, 0x90, 0x5e, 0xda, 0x88, 0x39, 0x0c, 0x5d, 0x06, 0xc4, 0x32,
-	0x8a, 0x2e, 0xc5, 0x71, 0x4b, 0x99, 0xa0, 0x13, 0x27, 0x93, 0x65, 0xc2, 0x2e, 0x74, 0x61, 0xe0,
-	0x8c, 0xa7, 0xa8, 0x45, 0xe3, 0x07, 0xac, 0xf7, 0x89, 0x07, 0x93, 0x16, 0xcd, 0x55, 0xde, 0x8c,
-	0x25, 0x1c, 0xbd, 0x07, 0x26, 0xda, 0x11, 0x61, 0x9b, 0xdb, 0x1b, 0x41, 0xb8, 0xe9, 0x05, 0x4e,
-	0x7d, 0x81, 0x5d, 0xbc, 0x18, 0x77, 0x26, 0x26, 0x18, 0xf1, 0xb3, 0xe2, 0xd9, 0x89, 0x6b, 0x3d,
-	0xf0, 0x70, 0xcf, 0x1e, 0xd2, 0x75, 0xee, 0x4e, 0xf7, 0x57, 0xe7, 0xce, 0xfe, 0x33, 0x0b, 0x46,
-	0x95, 0xbc, 0x39, 0x82, 0x3c, 0x44, 0x2f, 0x99, 0x87, 0x78, 0xf1, 0xe0, 0x12, 0x9b, 0x71, 0xde,
-	0x23, 0xd8, 0xff, 0x5f, 0x8e, 0x00, 0x68, 0xa9, 0xae, 0x14, 0xaa, 0xd5, 0x53, 0xa1, 0xde, 0xb7,
-	0x12, 0x35, 0xab, 0xca, 0x58, 0xe9, 0xde, 0x56, 0x19, 0xab, 0xc2, 0x29, 0x69, 0xee, 0xf0, 0x53,
-	0xd4, 0x4b, 0x41, 0xa4, 0x04, 0xb4, 0x71, 0x91, 0xd6, 0x42, 0x16, 0x12, 0xce, 0x7e, 0x36, 0x61,
-	0x65, 0x0d, 0xed, 0x69, 0xfa, 0x2a, 0x99, 0xb4, 0xb8, 0x2e, 0xaf, 0xb9, 0x4b, 0xc9, 0xa4, 0xc5,
-	0x0b, 0x55, 0xac, 0x71, 0xb2, 0x15, 0x53, 0x25, 0x27, 0xc5, 0x04, 0xfb, 0x56, 0x4c, 0x52, 0x44,
-	0x0e, 0xf7, 0x14, 0x91, 0xf2, 0xb4, 0x66, 0xa4, 0xe7, 0x69, 0xcd, 0xdb, 0x61, 0xcc, 0xf5, 0x37,
-	0x48, 0xe8, 0xc6, 0xa4, 0xce, 0xd6, 0x02, 0x13, 0x9f, 0x65, 0x6d, 0x96, 0x2c, 0x24, 0xa0, 0x38,
-	0x85, 0x9d, 0x94, 0xeb, 0x63, 0x7d, 0xc8, 0xf5, 0x1e, 0xda, 0x74, 0x3c, 0x1f, 0x6d, 0x7a, 0xec,
-	0xe0, 0xda, 0xf4, 0xf8, 0xa1, 0x6a, 0x53, 0x94, 0x8b, 0x36, 0xed, 0x4b, 0x51, 0x19, 0xdb, 0xe5,
-	0x93, 0x7b, 0x6c, 0x97, 0x7b, 0xa9, 0xd2, 0x53, 0x77, 0xad, 0x4a, 0xb3, 0xb5, 0xe4, 0x03, 0xdf,
-	0x97, 0x5a, 0xf2, 0xe3, 0x05, 0x38, 0xa5, 0xf5, 0x08, 0x5d, 0xbd, 0xee, 0x3a, 0x95, 0xa4, 0xec,
-	0xa6, 0x57, 0x7e, 0x22, 0x6b, 0xa4, 0xd8, 0xea, 0x6c, 0x5d, 0x05, 0xc1, 0x06, 0x16, 0xcb, 0x54,
-	0x25, 0x21, 0xbb, 0x66, 0x20, 0xad, 0x64, 0xe6, 0x44, 0x3b, 0x56, 0x18, 0x94, 0x65, 0xfa, 0x5b,
-	0x54, 0x1c, 0x48, 0x17, 0xb0, 0x9d, 0xd3, 0x20, 0x6c, 0xe2, 0xa1, 0x27, 0x38, 0x11, 0x26, 0xe0,
-	0xa8, 0xa2, 0x19, 0xe1, 0x5b, 0x36, 0x25, 0xd3, 0x14, 0x54, 0xb2, 0xc3, 0x52, 0x92, 0x4b, 0xdd,
-	0xec, 0xb0, 0xe0, 0x46, 0x85, 0x61, 0xff, 0x0f, 0x0b, 0x4e, 0x67, 0x0e, 0xc5, 0x11, 0x18, 0x0f,
-	0xdb, 0x49, 0xe3, 0xa1, 0x9a, 0xd7, 0x76, 0xcf, 0x78, 0x8b, 0x1e, 0x86, 0xc4, 0xbf, 0xb7, 0x60,
-	0x4c, 0xe3, 0x1f, 0xc1, 0xab, 0xba, 0xc9, 0x57, 0xcd, 0x6f, 0x67, 0x5b, 0xe9, 0x7a, 0xb7, 0xdf,
-	0x2f, 0x80, 0x2a, 0x2a, 0x3d, 0x53, 0x93, 0x25, 0xfb, 0xf7, 0x88, 0x11, 0xe8, 0xc0, 0x20, 0x0b,
-	0x71, 0x88, 0xf2, 0x09, 0xdf, 0x4a, 0xd2, 0x67, 0xe1, 0x12, 0xfa, 0xc4, 0x89, 0xfd, 0x8d, 0xb0,
-	0x20, 0xc8, 0x2e, 0xc1, 0xe0, 0xf5, 0x7a, 0xeb, 0x22, 0xe1, 0x52, 0x5f, 0x82, 0x21, 0xda, 0xb1,
-	0xc2, 0xa0, 0xea, 0xcd, 0xad, 0x05, 0xfe, 0x9c, 0xe7, 0x44, 0xf2, 0xf2, 0x77, 0xa5, 0xde, 0x16,
-	0x24, 0x00, 0x6b, 0x1c, 0x16, 0xfd, 0xe0, 0x46, 0x2d, 0xcf, 0xe9, 0x18, 0xfe, 0x0b, 0xa3, 0xb2,
-	0x8e, 0x02, 0x61, 0x13, 0xcf, 0x6e, 0xc2, 0x44, 0xf2, 0x25, 0xe6, 0xc9, 0x3a, 0x0b, 0x3d, 0xee,
-	0x6b, 0x38, 0xa7, 0xa1, 0xe2, 0xb0, 0xa7, 0x16, 0xdb, 0x8e, 0x90, 0x09, 0x3a, 0x00, 0x57, 0x02,
-	0xb0, 0xc6, 0xb1, 0xff, 0xa1, 0x05, 0x27, 0x32, 0x06, 0x2d, 0xc7, 0x84, 0xd6, 0x58, 0x4b, 0x9b,
-	0x2c, 0xc3, 0xe4, 0x87, 0x60, 0xa8, 0x4e, 0xd6, 0x1d, 0x19, 0xdc, 0x6a, 0x88, 0xf4, 0x79, 0xde,
-	0x8c, 0x25, 0xdc, 0xfe, 0xad, 0x02, 0x8c, 0x27, 0x79, 0x8d, 0x58, 0x92, 0x18, 0x1f, 0x26, 0x37,
-	0xaa, 0x05, 0x5b, 0x24, 0xec, 0xd0, 0x37, 0xb7, 0x52, 0x49, 0x62, 0x5d, 0x18, 0x38, 0xe3, 0x29,
-	0x56, 0x52, 0xbe, 0xae, 0x46, 0x5b, 0xce, 0xc8, 0xeb, 0x79, 0xce, 0x48, 0xfd, 0x31, 0xcd, 0x40,
-	0x18, 0x45, 0x12, 0x9b, 0xf4, 0xa9, 0x81, 0xc4, 0xa2, 0xee, 0x67, 0xdb, 0xae, 0x17, 0xbb, 0xbe,
-	0x78, 0x65, 0x31, 0x57, 0x95, 0x81, 0xb4, 0xd4, 0x8d, 0x82, 0xb3, 0x9e, 0xb3, 0xbf, 0x35, 0x00,
-	0xaa, 0x58, 0x03, 0x0b, 0x54, 0xcc, 0x29, 0xcc, 0x73, 0xbf, 0xa9, 0x86, 0x6a, 0x6e, 0x0d, 0xec,
-	0x16, 0x39, 0xc4, 0x9d, 0x5e, 0xa6, 0xe7, 0x5b, 0x0d, 0xd8, 0xaa, 0x06, 0x61, 0x13, 0x8f, 0x72,
-	0xe2, 0xb9, 0x5b, 0x84, 0x3f, 0x34, 0x98, 0xe4, 0x64, 0x51, 0x02, 0xb0, 0xc6, 0xa1, 0x9c, 0xd4,
-	0xdd, 0xf5, 0x75, 0xe1, 0xc1, 0x51, 0x9c, 0xd0, 0xd1, 0xc1, 0x0c, 0xc2, 0x2f, 0x1d, 0x09, 0x36,
-	0xc5, 0xa6, 0xc0, 0xb8, 0x74, 0x24, 0xd8, 0xc4, 0x0c, 0x42, 0xbf, 0x92, 0x1f, 0x84, 0x4d, 0xc7,
-	0x73, 0x5f, 0x23, 0x75, 0x45, 0x45, 0x6c, 0x06, 0xd4, 0x57, 0xba, 0xda, 0x8d, 0x82, 0xb3, 0x9e,
-	0xa3, 0x13, 0xba, 0x15, 0x92, 0xba, 0x5b, 0x8b, 0xcd, 0xde, 0x20, 0x39, 0xa1, 0x57, 0xba, 0x30,
-	0x70, 0xc6, 0x53, 0x68, 0x06, 0xc6, 0x65, 0xb1, 0x0d, 0x59, 0xbe, 0x6d, 0x38, 0x59, 0x2e, 0x0a,
-	0x27, 0xc1, 0x38, 0x8d, 0x4f, 0x85, 0x64, 0x53, 0x14, 0x9f, 0x64, 0x7b, 0x07, 0x43, 0x48, 0xca,
-	0xa2, 0x94, 0x58, 0x61, 0xd8, 0x1f, 0x2e, 0x52, 0xa5, 0xde, 0xa3, 0xc6, 0xeb, 0x91, 0x85, 0x15,
-	0x27, 0x67, 0xe4, 0x40, 0x1f, 0x33, 0xf2, 0x39, 0x18, 0xb9, 0x19, 0x05, 0xbe, 0x0a, 0xd9, 0x2d,
-	0xf5, 0x0c, 0xd9, 0x35, 0xb0, 0xb2, 0x43, 0x76, 0x07, 0xf3, 0x0a, 0xd9, 0x1d, 0xba, 0xcb, 0x90,
-	0xdd, 0x3f, 0x2c, 0x81, 0xba, 0x55, 0xee, 0x2a, 0x89, 0x6f, 0x05, 0xe1, 0xa6, 0xeb, 0x37, 0x58,
-	0xe1, 0x88, 0x2f, 0x59, 0xb2, 0xf6, 0xc4, 0xa2, 0x99, 0x72, 0xb9, 0x9e, 0xd3, 0xcd, 0x60, 0x09,
-	0x62, 0x53, 0xab, 0x06, 0xa1, 0xd4, 0x65, 0xfd, 0x26, 0x08, 0x27, 0x38, 0x42, 0x1f, 0x00, 0x90,
-	0xee, 0xee, 0x75, 0x29, 0x81, 0x17, 0xf2, 0xe1, 0x0f, 0x93, 0x75, 0x6d, 0x52, 0xaf, 0x2a, 0x22,
-	0xd8, 0x20, 0x88, 0x3e, 0xae, 0xd3, 0x51, 0x79, 0x6e, 0xcf, 0xfb, 0x0e, 0x65, 0x6c, 0xfa, 0x49,
-	0x46, 0xc5, 0x30, 0xe4, 0xfa, 0x0d, 0x3a, 0x4f, 0x44, 0x68, 0xe3, 0x5b, 0xb2, 0x0a, 0xfc, 0x2c,
-	0x06, 0x4e, 0x7d, 0xd6, 0xf1, 0x1c, 0xbf, 0x46, 0xc2, 0x05, 0x8e, 0xae, 0x35, 0xa8, 0x68, 0xc0,
-	0xb2, 0xa3, 0xae, 0xab, 0xef, 0x4a, 0xfd, 0x5c, 0x7d, 0x77, 0xe6, 0x1d, 0x70, 0xbc, 0xeb, 0x63,
-	0xee, 0x2b, 0xf7, 0xf4, 0xee, 0xd3, 0x56, 0xed, 0xdf, 0x1e, 0xd4, 0x4a, 0xeb, 0x6a, 0x50, 0xe7,
-	0x37, 0xa9, 0x85, 0xfa, 0x8b, 0x0a, 0x93, 0x39, 0xc7, 0x29, 0xa2, 0xd4, 0x8c, 0xd1, 0x88, 0x4d,
-	0x92, 0x74, 0x8e, 0xb6, 0x9c, 0x90, 0xf8, 0x87, 0x3d, 0x47, 0x57, 0x14, 0x11, 0x6c, 0x10, 0x44,
-	0x1b, 0x89, 0xe4, 0xb3, 0x0b, 0x07, 0x4f, 0x3e, 0x63, 0xe5, 0x16, 0xb3, 0x2e, 0x1c, 0xfa, 0xac,
-	0x05, 0x63, 0x7e, 0x62, 0xe6, 0xe6, 0x13, 0x6f, 0x9e, 0xbd, 0x2a, 0xf8, 0xa5, 0xa4, 0xc9, 0x36,
-	0x9c, 0xa2, 0x9f, 0xa5, 0xd2, 0x4a, 0xfb, 0x54, 0x69, 0xfa, 0x26, 0xc7, 0xc1, 0x5e, 0x37, 0x39,
-	0x22, 0x5f, 0xdd, 0xaf, 0x3b, 0x94, 0xfb, 0xfd, 0xba, 0x90, 0x71, 0xb7, 0xee, 0x0d, 0xa8, 0xd4,
-	0x42, 0xe2, 0xc4, 0x77, 0x79, 0xd5, 0x2a, 0x8b, 0x82, 0x99, 0x93, 0x1d, 0x60, 0xdd, 0x97, 0xfd,
-	0xbf, 0x07, 0xe0, 0x98, 0x1c, 0x11, 0x99, 0xab, 0x42, 0xf5, 0x23, 0xa7, 0xab, 0x6d, 0x65, 0xa5,
-	0x1f, 0x2f, 0x49, 0x00, 0xd6, 0x38, 0xd4, 0x1e, 0x6b, 0x47, 0x64, 0xb9, 0x45, 0xfc, 0x45, 0x77,
-	0x2d, 0x12, 0xc7, 0xd6, 0x6a, 0xa1, 0x5c, 0xd3, 0x20, 0x6c, 0xe2, 0x51, 0xdb, 0xde, 0x31, 0x8c,
-	0x56, 0xc3, 0xb6, 0x97, 0x86, 0xaa, 0x84, 0xa3, 0x5f, 0xca, 0x2c, 0x3a, 0x9f, 0x4f, 0x86, 0x67,
-	0x57, 0x8a, 0xce, 0x3e, 0x2f, 0x0a, 0xff, 0x7b, 0x16, 0x9c, 0xe2, 0xad, 0x72, 0x24, 0xaf, 0xb5,
-	0xea, 0x4e, 0x4c, 0xa2, 0x7c, 0x2e, 0xab, 0xc9, 0xe0, 0x4f, 0xfb, 0xbc, 0xb3, 0xc8, 0xe2, 0x6c,
-	0x6e, 0xd0, 0x67, 0x2c, 0x18, 0xdf, 0x4c, 0x14, 0x07, 0x92, 0xaa, 0xe3, 0xa0, 0x75, 0x3b, 0x12,
-	0x9d, 0xea, 0xa5, 0x96, 0x6c, 0x8f, 0x70, 0x9a, 0xba, 0xfd, 0xdf, 0x2d, 0x30, 0xc5, 0xe8, 0xd1,
-	0xd7, 0x14, 0xda, 0xbf, 0x29, 0x28, 0xad, 0xcb, 0x52, 0x4f, 0xeb, 0xf2, 0x11, 0x28, 0xb6, 0xdd,
-	0xba, 0xd8, 0x5f, 0xe8, 0xc3, 0xf4, 0x85, 0x79, 0x4c, 0xdb, 0xed, 0x7f, 0x5e, 0xd2, 0x6e, 0x10,
-	0x91, 0x40, 0xf9, 0x3d, 0xf1, 0xda, 0xeb, 0xaa, 0xea, 0x26, 0x7f, 0xf3, 0xab, 0x5d, 0x55, 0x37,
-	0x7f, 0x6c, 0xff, 0xf9, 0xb1, 0x7c, 0x80, 0x7a, 0x15, 0xdd, 0x1c, 0xda, 0x23, 0x39, 0xf6, 0x26,
-	0x94, 0xe9, 0x16, 0x8c, 0xf9, 0x33, 0xcb, 0x09, 0xa6, 0xca, 0x97, 0x44, 0xfb, 0x9d, 0x9d, 0xc9,
-	0x1f, 0xdd, 0x3f, 0x5b, 0xf2, 0x69, 0xac, 0xfa, 0x47, 0x11, 0x54, 0xe8, 0x6f, 0x96, 0xc7, 0x2b,
-	0x36, 0x77, 0xd7, 0x94, 0xcc, 0x94, 0x80, 0x5c, 0x92, 0x84, 0x35, 0x1d, 0xe4, 0x43, 0x85, 0x22,
-	0x72, 0xa2, 0x7c, 0x0f, 0xb8, 0xa2, 0xb2, 0x69, 0x25, 0xe0, 0xce, 0xce, 0xe4, 0x0b, 0xfb, 0x27,
-	0xaa, 0x1e, 0xc7, 0x9a, 0x84, 0xfd, 0x7f, 0x06, 0xf4, 0xdc, 0x15, 0xc5, 0x56, 0xbf, 0x27, 0xe6,
-	0xee, 0xb9, 0xd4, 0xdc, 0x3d, 0xdb, 0x35, 0x77, 0xc7, 0xf4, 0x85, 0xfb, 0x89, 0xd9, 0x78, 0xd4,
-	0x86, 0xc0, 0xde, 0xfe, 0x06, 0x66, 0x01, 0xbd, 0xda, 0x76, 0x43, 0x12, 0xad, 0x84, 0x6d, 0xdf,
-	0xf5, 0x1b, 0x6c, 0x3a, 0x96, 0x4d, 0x0b, 0x28, 0x01, 0xc6, 0x69, 0x7c, 0xba, 0xa9, 0xa7, 0xdf,
-	0xfc, 0x86, 0xb3, 0xc5, 0x67, 0x95, 0x51, 0x9f, 0xaf, 0x2a, 0xda, 0xb1, 0xc2, 0x40, 0x1b, 0xf0,
-	0xb0, 0xec, 0x60, 0x9e, 0x78, 0x44, 0xdc, 0x98, 0xbf, 0xee, 0x86, 0x4d, 0x1e, 0x4a, 0xcf, 0x63,
-	0x38, 0xde, 0x2c, 0x7a, 0x78, 0x18, 0xef, 0x82, 0x8b, 0x77, 0xed, 0xc9, 0xfe, 0x0a, 0x0b, 0x22,
-	0x30, 0x4a, 0x15, 0xd0, 0xd9, 0xe7, 0xb9, 0x4d, 0x57, 0x96, 0x11, 0x54, 0xb3, 0x6f, 0x91, 0x36,
-	0x62, 0x0e, 0x43, 0xb7, 0x60, 0x68, 0x8d, 0x5f, 0x6c, 0x9c, 0xcf, 0x25, 0x2a, 0xe2, 0x96, 0x64,
-	0x56, 0x8b, 0x57, 0x5e, 0x99, 0x7c, 0x47, 0xff, 0xc4, 0x92, 0x9a, 0xfd, 0xf5, 0x12, 0x8c, 0xcb,
-	0xb0, 0xac, 0x4b, 0x6e, 0xc4, 0x62, 0x03, 0xcc, 0x02, 0xe5, 0x85, 0x3d, 0x0b, 0x94, 0xbf, 0x17,
-	0xa0, 0x4e, 0x5a, 0x5e, 0xd0, 0x61, 0x86, 0xdf, 0xc0, 0xbe, 0x0d, 0x3f, 0xb5, 0x57, 0x98, 0x57,
-	0xbd, 0x60, 0xa3, 0x47, 0x51, 0x3b, 0x91, 0xd7, 0x3b, 0x4f, 0xd5, 0x4e, 0x34, 0xae, 0x5a, 0x1a,
-	0x3c, 0xda, 0xab, 0x96, 0x5c, 0x18, 0xe7, 0x2c, 0xaa, 0x82, 0x00, 0x77, 0x91, 0xf7, 0xcf, 0x52,
-	0xaa, 0xe6, 0x93, 0xdd, 0xe0, 0x74, 0xbf, 0xe6, 0x3d, 0x4a, 0xe5, 0xa3, 0xbe, 0x47, 0xe9, 0xad,
-	0x50, 0x91, 0xdf, 0x39, 0x9a, 0xa8, 0xe8, 0xa2, 0x2a, 0x72, 0x1a, 0x44, 0x58, 0xc3, 0xbb, 0x6a,
-	0x9b, 0xc0, 0xbd, 0xaa, 0x6d, 0x62, 0x7f, 0xba, 0x40, 0x77, 0x0c, 0x9c, 0x2f, 0x55, 0xa6, 0xeb,
-	0x71, 0x18, 0x74, 0xda, 0xf1, 0x46, 0xd0, 0x75, 0x35, 0xf2, 0x0c, 0x6b, 0xc5, 0x02, 0x8a, 0x16,
-	0x61, 0xa0, 0xae, 0x4b, 0x2f, 0xed, 0xe7, 0x7b, 0x6a, 0xe7, 0xab, 0x13, 0x13, 0xcc, 0x7a, 0x41,
-	0x0f, 0xc3, 0x40, 0xec, 0x34, 0x64, 0x16, 0x28, 0xcb, 0xfc, 0x5f, 0x75, 0x1a, 0x11, 0x66, 0xad,
-	0xfb, 0x29, 0x37, 0xfb, 0x02, 0x8c, 0x46, 0x6e, 0xc3, 0x77, 0xe2, 0x76, 0x48, 0x8c, 0xf3, 0x49,
-	0x1d, 0x32, 0x63, 0x02, 0x71, 0x12, 0xd7, 0xfe, 0x9d, 0x11, 0x38, 0x59, 0x9d, 0x5b, 0x92, 0x17,
-	0x66, 0x1c, 0x5a, 0x22, 0x67, 0x16, 0x8d, 0xa3, 0x4b, 0xe4, 0xec, 0x41, 0xdd, 0x33, 0x12, 0x39,
-	0x3d, 0x23, 0x91, 0x33, 0x99, 0x55, 0x57, 0xcc, 0x23, 0xab, 0x2e, 0x8b, 0x83, 0x7e, 0xb2, 0xea,
-	0x0e, 0x2d, 0xb3, 0x73, 0x57, 0x86, 0xf6, 0x95, 0xd9, 0xa9, 0xd2, 0x5e, 0x73, 0xc9, 0x15, 0xea,
-	0xf1, 0xa9, 0x32, 0xd3, 0x5e, 0x55, 0xca, 0x21, 0xcf, 0x83, 0x13, 0xa2, 0xfe, 0xe5, 0xfc, 0x19,
-	0xe8, 0x23, 0xe5, 0x50, 0xa4, 0xe2, 0x99, 0x69, 0xae, 0x43, 0x79, 0xa4, 0xb9, 0x66, 0xb1, 0xb3,
-	0x67, 0x9a, 0xeb, 0x0b, 0x30, 0x5a, 0xf3, 0x02, 0x9f, 0xac, 0x84, 0x41, 0x1c, 0xd4, 0x02, 0x79,
-	0x39, 0xab, 0xbe, 0x5b, 0xcc, 0x04, 0xe2, 0x24, 0x6e, 0xaf, 0x1c, 0xd9, 0xca, 0x41, 0x73, 0x64,
-	0xe1, 0x1e, 0xe5, 0xc8, 0x1a, 0x59, 0xa0, 0xc3, 0x79, 0x64, 0x81, 0x66, 0x7d, 0x91, 0xbe, 0x6e,
-	0x5f, 0x7d, 0x9d, 0xdf, 0x4d, 0x4c, 0x4d, 0xf0, 0xb9, 0xa0, 0x49, 0x0d, 0xbf, 0x11, 0x36, 0x24,
-	0xaf, 0x1c, 0xc2, 0x84, 0xbd, 0x51, 0xd5, 0x64, 0xd4, 0x7d, 0xc5, 0xba, 0x09, 0x27, 0x19, 0x39,
-	0x48, 0x82, 0xea, 0x17, 0x0a, 0xf0, 0x03, 0x7b, 0xb2, 0x80, 0x6e, 0x01, 0xc4, 0x4e, 0x43, 0x4c,
-	0x54, 0x71, 0x34, 0x73, 0xc0, 0xb8, 0xd6, 0x55, 0xd9, 0x1f, 0x2f, 0x93, 0xa4, 0xfe, 0xb2, 0x43,
-	0x0f, 0xf9, 0x9b, 0x85, 0xb3, 0x06, 0x5e, 0x57, 0x35, 0x59, 0x1c, 0x78, 0x04, 0x33, 0x08, 0x55,
-	0xff, 0x21, 0x69, 0x50, 0x93, 0xb6, 0x98, 0x54, 0xff, 0x98, 0xb5, 0x62, 0x01, 0x45, 0xcf, 0xc3,
-	0xb0, 0xe3, 0x79, 0x3c, 0x91, 0x8b, 0x44, 0xe2, 0xd2, 0x3f, 0x5d, 0xd6, 0x52, 0x83, 0xb0, 0x89,
-	0x67, 0xff, 0x55, 0x01, 0x26, 0xf7, 0x90, 0x29, 0x5d, 0x09, 0xbc, 0xa5, 0xbe, 0x13, 0x78, 0x45,
-	0x72, 0xcb, 0x60, 0x8f, 0xe4, 0x96, 0xe7, 0x61, 0x38, 0x26, 0x4e, 0x53, 0x44, 0xc2, 0x09, 0x9f,
-	0x83, 0x3e, 0x6b, 0xd6, 0x20, 0x6c, 0xe2, 0x51, 0x29, 0x36, 0xe6, 0xd4, 0x6a, 0x24, 0x8a, 0x64,
-	0xf6, 0x8a, 0xf0, 0xdb, 0xe6, 0x96, 0x1a, 0xc3, 0xdc, 0xe1, 0x33, 0x09, 0x12, 0x38, 0x45, 0x32,
-	0x3d, 0xe0, 0x95, 0x3e, 0x07, 0xfc, 0x57, 0x0b, 0xf0, 0xc8, 0xae, 0xda, 0xad, 0xef, 0xc4, 0xa2,
-	0x76, 0x44, 0xc2, 0xf4, 0xc4, 0xb9, 0x16, 0x91, 0x10, 0x33, 0x08, 0x1f, 0xa5, 0x56, 0x4b, 0x45,
-	0x31, 0xe7, 0x9f, 0x65, 0xc7, 0x47, 0x29, 0x41, 0x02, 0xa7, 0x48, 0xde, 0xed, 0xb4, 0xfc, 0xfa,
-	0x00, 0x3c, 0xd6, 0x87, 0x0d, 0x90, 0x63, 0x36, 0x62, 0x32, 0x73, 0xb6, 0x78, 0x8f, 0x32, 0x67,
-	0xef, 0x6e, 0xb8, 0xde, 0x48, 0xb8, 0xed, 0x2b, 0xeb, 0xf1, 0x2b, 0x05, 0x38, 0xd3, 0xdb, 0x60,
-	0x41, 0x3f, 0x0e, 0xe3, 0xa1, 0x0a, 0xb2, 0x33, 0x93, 0x6e, 0x4f, 0x70, 0xcf, 0x4e, 0x02, 0x84,
-	0xd3, 0xb8, 0x68, 0x0a, 0xa0, 0xe5, 0xc4, 0x1b, 0xd1, 0xf9, 0x6d, 0x37, 0x8a, 0x45, 0xf9, 0xb0,
-	0x31, 0x7e, 0x96, 0x28, 0x5b, 0xb1, 0x81, 0x41, 0xc9, 0xb1, 0x7f, 0xf3, 0xc1, 0xd5, 0x20, 0xe6,
-	0x0f, 0xf1, 0xcd, 0xd6, 0x09, 0x79, 0x99, 0x98, 0x01, 0xc2, 0x69, 0x5c, 0x4a, 0x8e, 0x9d, 0x56,
-	0x73, 0x46, 0xf9, 0x2e, 0x8c, 0x91, 0x5b, 0x54, 0xad, 0xd8, 0xc0, 0x48, 0xa7, 0x13, 0x97, 0xf6,
-	0x4e, 0x27, 0xb6, 0xff, 0x59, 0x01, 0x4e, 0xf7, 0x34, 0x78, 0xfb, 0x13, 0x53, 0xf7, 0x5f, 0x0a,
-	0xf0, 0x5d, 0xae, 0xb0, 0xfd, 0xa5, 0x8e, 0xfe, 0x79, 0x8f, 0x99, 0x26, 0x52, 0x47, 0xef, 0xbe,
-	0x22, 0xc6, 0xfd, 0x37, 0x9e, 0x5d, 0xd9, 0xa2, 0x03, 0xfb, 0xc8, 0x16, 0x4d, 0x7d, 0x8c, 0x52,
-	0x9f, 0xda, 0xe1, 0x3f, 0x0f, 0xf4, 0x1c, 0x5e, 0xba, 0x41, 0xee, 0xcb, 0x6f, 0x3e, 0x0f, 0xc7,
-	0x5c, 0x9f, 0x5d, 0x2c, 0x59, 0x6d, 0xaf, 0x89, 0x8a, 0x52, 0xbc, 0x6c, 0xaa, 0xca, 0xfe, 0x58,
-	0x48, 0xc1, 0x71, 0xd7, 0x13, 0xf7, 0x61, 0xf6, 0xee, 0xdd, 0x0d, 0xe9, 0x3e, 0x25, 0xf7, 0x32,
-	0x9c, 0x92, 0x43, 0xb1, 0xe1, 0x84, 0xa4, 0x2e, 0x94, 0x6d, 0x24, 0xf2, 0x7d, 0x4e, 0xf3, 0x9c,
-	0xa1, 0x0c, 0x04, 0x9c, 0xfd, 0x1c, 0xbb, 0xcb, 0x2f, 0x68, 0xb9, 0x35, 0xb1, 0x15, 0xd4, 0x77,
-	0xf9, 0xd1, 0x46, 0xcc, 0x61, 0x5a, 0x5f, 0x54, 0x8e, 0x46, 0x5f, 0xbc, 0x17, 0x2a, 0x6a, 0xbc,
-	0x79, 0x96, 0x80, 0x9a, 0xe4, 0x5d, 0x59, 0x02, 0x6a, 0x86, 0x1b, 0x58, 0x7b, 0xdd, 0x83, 0xfd,
-	0x2c, 0x8c, 0x28, 0xef, 0x57, 0xbf, 0x37, 0x2a, 0xda, 0xff, 0xb7, 0x00, 0xa9, 0x3b, 0x8f, 0xd0,
-	0x36, 0x54, 0xea, 0xf2, 0x26, 0xea, 0x7c, 0xca, 0xf6, 0xaa, 0x8b, 0xad, 0xf5, 0xf1, 0x8f, 0x6a,
-	0xc2, 0x9a, 0x18, 0x7a, 0x3f, 0xaf, 0x90, 0x2b, 0x48, 0x17, 0xf2, 0xc8, 0xe0, 0xae, 0xaa, 0xfe,
-	0xcc, 0x2b, 0xd3, 0x64, 0x1b, 0x36, 0xe8, 0xa1, 0x18, 0x2a, 0x1b, 0xf2, 0x6e, 0xa7, 0x7c, 0xc4,
-	0x9d, 0xba, 0x2a, 0x8a, 0x9b, 0x68, 0xea, 0x2f, 0xd6, 0x84, 0xec, 0x3f, 0x2b, 0xc0, 0xc9, 0xe4,
-	0x07, 0x10, 0xc7, 0x75, 0xbf, 0x66, 0xc1, 0x83, 0x9e, 0x13, 0xc5, 0xd5, 0x36, 0xdb, 0x28, 0xac,
-	0xb7, 0xbd, 0xe5, 0x54, 0x31, 0xe5, 0x83, 0x3a, 0x5b, 0x54, 0xc7, 0xe9, 0xbb, 0xc0, 0x66, 0x1f,
-	0xba, 0xbd, 0x33, 0xf9, 0xe0, 0x62, 0x36, 0x71, 0xdc, 0x8b, 0x2b, 0xf4, 0x59, 0x0b, 0x8e, 0xd5,
-	0xda, 0x61, 0x48, 0xfc, 0x58, 0xb3, 0xca, 0xbf, 0xe2, 0xd5, 0x5c, 0x06, 0x52, 0x33, 0x78, 0x92,
-	0x0a, 0xd4, 0xb9, 0x14, 0x2d, 0xdc, 0x45, 0xdd, 0xfe, 0x79, 0xaa, 0x39, 0x7b, 0xbe, 0xe7, 0xf7,
-	0xd9, 0xe5, 0x65, 0xdf, 0x19, 0x84, 0xd1, 0x44, 0xc5, 0xe8, 0xc4, 0x11, 0x97, 0xb5, 0xe7, 0x11,
-	0x17, 0xcb, 0x50, 0x6b, 0xfb, 0xf2, 0x6a, 0x65, 0x23, 0x43, 0xad, 0xed, 0x13, 0xcc, 0x61, 0x62,
-	0x48, 0x71, 0xdb, 0x17, 0xd1, 0xed, 0xe6, 0x90, 0xe2, 0xb6, 0x8f, 0x05, 0x14, 0x7d, 0xc8, 0x82,
-	0x11, 0xb6, 0xf8, 0xc4, 0x01, 0xa1, 0x50, 0x68, 0x97, 0x73, 0x58, 0xee, 0xb2, 0x3a, 0x3a, 0x8b,
-	0x86, 0x34, 0x5b, 0x70, 0x82, 0x22, 0xfa, 0xa8, 0x05, 0x15, 0x75, 0x1b, 0xa3, 0xb8, 0x27, 0xbd,
-	0x9a, 0x6f, 0x41, 0xee, 0x94, 0xd4, 0x53, 0x95, 0x91, 0xb1, 0x26, 0x8c, 0x22, 0x75, 0x7a, 0x37,
-	0x74, 0x38, 0xa7, 0x77, 0x90, 0x71, 0x72, 0xf7, 0x56, 0xa8, 0x34, 0x1d, 0xdf, 0x5d, 0x27, 0x51,
-	0xcc, 0x0f, 0xd4, 0xe4, 0x3d, 0x01, 0xb2, 0x11, 0x6b, 0x38, 0x35, 0xf6, 0x23, 0xf6, 0x62, 0xb1,
-	0x71, 0x02, 0xc6, 0x8c, 0xfd, 0xaa, 0x6e, 0xc6, 0x26, 0x8e, 0x79, 0x5c, 0x07, 0xf7, 0xf4, 0xb8,
-	0x6e, 0x78, 0x8f, 0xe3, 0xba, 0x2a, 0x9c, 0x72, 0xda, 0x71, 0x70, 0x89, 0x38, 0xde, 0x4c, 0x1c,
-	0x93, 0x66, 0x2b, 0x8e, 0x78, 0x91, 0xf1, 0x11, 0xe6, 0x02, 0x56, 0xf1, 0x5b, 0x55, 0xe2, 0xad,
-	0x77, 0x21, 0xe1, 0xec, 0x67, 0xed, 0x7f, 0x6c, 0xc1, 0xa9, 0xcc, 0xa9, 0x70, 0xff, 0x46, 0xce,
-	0xdb, 0x9f, 0x2f, 0xc1, 0x89, 0x8c, 0x7a, 0xf2, 0xa8, 0x63, 0x2e, 0x12, 0x2b, 0x8f, 0x20, 0xb4,
-	0x64, 0x4c, 0x95, 0xfc, 0x36, 0x19, 0x2b, 0x63, 0x7f, 0x27, 0xf0, 0xfa, 0x14, 0xbc, 0x78, 0xb4,
-	0xa7, 0xe0, 0xc6, 0x5c, 0x1f, 0xb8, 0xa7, 0x73, 0xbd, 0xb4, 0xc7, 0x5c, 0xff, 0xaa, 0x05, 0x13,
-	0xcd, 0x1e, 0x97, 0x18, 0x89, 0xf3, 0xa4, 0xeb, 0x87, 0x73, 0x45, 0xd2, 0xec, 0xc3, 0xb7, 0x77,
-	0x26, 0x7b, 0xde, 0x1d, 0x85, 0x7b, 0x72, 0x65, 0x7f, 0xab, 0x08, 0xcc, 0x5e, 0x63, 0x35, 0x83,
-	0x3b, 0xe8, 0x83, 0xe6, 0xb5, 0x14, 0x56, 0x5e, 0x57, 0x28, 0xf0, 0xce, 0xd5, 0xb5, 0x16, 0x7c,
-	0x04, 0xb3, 0x6e, 0xb9, 0x48, 0x4b, 0xc2, 0x42, 0x1f, 0x92, 0xd0, 0x93, 0xf7, 0x7f, 0x14, 0xf3,
-	0xbf, 0xff, 0xa3, 0x92, 0xbe, 0xfb, 0x63, 0xf7, 0x4f, 0x3c, 0x70, 0x5f, 0x7e, 0xe2, 0xdf, 0xb5,
-	0xb8, 0xe0, 0x49, 0x7d, 0x05, 0x6d, 0x6e, 0x58, 0xbb, 0x98, 0x1b, 0x4f, 0x42, 0x39, 0x12, 0x92,
-	0x59, 0x98, 0x25, 0x3a, 0x00, 0x4a, 0xb4, 0x63, 0x85, 0x41, 0x77, 0x5d, 0x8e, 0xe7, 0x05, 0xb7,
-	0xce, 0x37, 0x5b, 0x71, 0x47, 0x18, 0x28, 0x6a, 0x5b, 0x30, 0xa3, 0x20, 0xd8, 0xc0, 0x42, 0x8f,
-	0xc1, 0x20, 0xaf, 0x74, 0x20, 0x9c, 0x3b, 0xc3, 0x74, 0x1d, 0xf2, 0x32, 0x08, 0x75, 0x2c, 0x40,
-	0xf6, 0x06, 0x18, 0xbb, 0x8a, 0xbb, 0xbf, 0x18, 0xb6, 0x8f, 0x1b, 0xbd, 0xff, 0x6e, 0x41, 0x90,
-	0xe2, 0xbb, 0x84, 0x73, 0xa9, 0x1b, 0xd4, 0xfb, 0x8f, 0x87, 0x7b, 0x3f, 0x40, 0x2d, 0x68, 0xb6,
-	0xe8, 0xbe, 0x79, 0x35, 0xc8, 0x67, 0xb3, 0x35, 0xa7, 0xfa, 0xd3, 0xa3, 0xaa, 0xdb, 0xb0, 0x41,
-	0x2f, 0x21, 0xda, 0x8b, 0x7b, 0x8a, 0xf6, 0x84, 0x94, 0x1b, 0xd8, 0x5d, 0xca, 0xd9, 0x7f, 0x65,
-	0x41, 0xc2, 0xea, 0x43, 0x2d, 0x28, 0x51, 0x76, 0x3b, 0x42, 0x60, 0x2c, 0xe7, 0x67, 0x62, 0x52,
-	0x49, 0x2d, 0x56, 0x21, 0xfb, 0x89, 0x39, 0x21, 0xe4, 0x89, 0xd8, 0xbf, 0x5c, 0x36, 0x3f, 0x26,
-	0xc1, 0x4b, 0x41, 0xb0, 0xc9, 0xc3, 0x67, 0x74, 0x1c, 0xa1, 0x7d, 0x0e, 0x8e, 0x77, 0x31, 0xc5,
-	0x2e, 0x93, 0x0d, 0xe4, 0x0e, 0xde, 0x58, 0x3d, 0xac, 0x3e, 0x03, 0xe6, 0x30, 0xfb, 0x2b, 0x16,
-	0x1c, 0x4b, 0x77, 0x8f, 0x5e, 0xb7, 0xe0, 0x78, 0x94, 0xee, 0xef, 0xb0, 0xc6, 0x4e, 0xc5, 0xef,
-	0x77, 0x81, 0x70, 0x37, 0x13, 0xf6, 0x3f, 0x15, 0xda, 0xe0, 0x86, 0xeb, 0xd7, 0x83, 0x5b, 0xca,
-	0x4e, 0xb2, 0x7a, 0xda, 0x49, 0x54, 0x3c, 0xd4, 0x36, 0x48, 0xbd, 0xed, 0x75, 0x15, 0x56, 0xa8,
-	0x8a, 0x76, 0xac, 0x30, 0x58, 0x1e, 0x79, 0x5b, 0xec, 0x5b, 0x53, 0x93, 0x72, 0x5e, 0xb4, 0x63,
-	0x85, 0x81, 0x9e, 0x83, 0x11, 0xe3, 0x25, 0xe5, 0xbc, 0x64, 0x9b, 0x0e, 0x43, 0x83, 0x47, 0x38,
-	0x81, 0x85, 0xa6, 0x00, 0x94, 0xcd, 0x25, 0x35, 0x36, 0x73, 0xb4, 0x2b, 0xc1, 0x18, 0x61, 0x03,
-	0x83, 0x55, 0x6d, 0xf0, 0xda, 0x11, 0x3b, 0x49, 0x1e, 0xd4, 0x35, 0xf4, 0xe7, 0x44, 0x1b, 0x56,
-	0x50, 0x2a, 0xdc, 0x9a, 0x8e, 0xdf, 0x76, 0x3c, 0x3a, 0x42, 0xc2, 0x75, 0xa6, 0x96, 0xe1, 0x92,
-	0x82, 0x60, 0x03, 0x8b, 0xbe, 0x71, 0xec, 0x36, 0xc9, 0x4b, 0x81, 0x2f, 0xe3, 0xae, 0x75, 0x70,
-	0x81, 0x68, 0xc7, 0x0a, 0x03, 0x9d, 0x83, 0x61, 0xc7, 0xaf, 0x73, 0x03, 0x31, 0x08, 0xc5, 0x19,
-	0xa5, 0xda, 0x7d, 0x5e, 0x8b, 0xc8, 0x8c, 0x86, 0x62, 0x13, 0xd5, 0xfe, 0x4b, 0x0b, 0xc6, 0x75,
-	0xf5, 0x1b, 0xe6, 0x2a, 0x4b, 0xf8, 0x08, 0xad, 0x3d, 0x7d, 0x84, 0xc9, 0xb2, 0x1a, 0x85, 0xbe,
-	0xca, 0x6a, 0x98, 0x15, 0x2f, 0x8a, 0xbb, 0x56, 0xbc, 0xf8, 0x41, 0x18, 0xda, 0x24, 0x1d, 0xa3,
-	0x34, 0x06, 0x93, 0xf2, 0x57, 0x78, 0x13, 0x96, 0x30, 0x64, 0xc3, 0x60, 0xcd, 0x51, 0xa5, 0xeb,
-	0x46, 0xf8, 0xce, 0x6a, 0x6e, 0x86, 0x21, 0x09, 0x88, 0xbd, 0x0c, 0x15, 0x75, 0x3a, 0x2f, 0x5d,
-	0x76, 0x56, 0xb6, 0xcb, 0xae, 0xaf, 0xcc, 0xfb, 0xd9, 0xb5, 0xaf, 0x7d, 0xfb, 0xd1, 0x37, 0xfd,
-	0xf1, 0xb7, 0x1f, 0x7d, 0xd3, 0x9f, 0x7e, 0xfb, 0xd1, 0x37, 0x7d, 0xe8, 0xf6, 0xa3, 0xd6, 0xd7,
-	0x6e, 0x3f, 0x6a, 0xfd, 0xf1, 0xed, 0x47, 0xad, 0x3f, 0xbd, 0xfd, 0xa8, 0xf5, 0xad, 0xdb, 0x8f,
-	0x5a, 0x9f, 0xfd, 0x4f, 0x8f, 0xbe, 0xe9, 0xa5, 0xcc, 0x90, 0x7d, 0xfa, 0xe3, 0xa9, 0x5a, 0x7d,
-	0x7a, 0xeb, 0x59, 0x16, 0x35, 0x4e, 0x17, 0xe6, 0xb4, 0x31, 0x1b, 0xa7, 0xe5, 0xc2, 0xfc, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xb0, 0xa6, 0x0c, 0xbd, 0xf8, 0x00, 0x00,
+	// 13384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x25, 0xd9,
+	0x59, 0x18, 0xee, 0xbe, 0x0f, 0x49, 0xf7, 0x48, 0x23, 0xcd, 0xf4, 0xcc, 0xec, 0xde, 0x19, 0xef,
+	0xae, 0x86, 0x5e, 0x63, 0x2f, 0x3f, 0xb0, 0x06, 0xef, 0xda, 0x66, 0x7f, 0x36, 0x18, 0xf4, 0x98,
+	0x87, 0x76, 0xa4, 0x91, 0xf6, 0xbb, 0x9a, 0x1d, 0xfc, 0x58, 0xdb, 0xad, 0x7b, 0x8f, 0xa4, 0x5e,
+	0xf5, 0xed, 0xbe, 0xdb, 0xdd, 0x57, 0x33, 0x5a, 0x8c, 0xb1, 0x79, 0x84, 0x87, 0xc1, 0xe6, 0x95,
+	0x60, 0x92, 0x40, 0xcc, 0xb3, 0x42, 0xa5, 0x08, 0x24, 0x54, 0x25, 0xa4, 0x12, 0x8a, 0xe2, 0x11,
+	0x8a, 0x14, 0x49, 0x41, 0x28, 0x42, 0x20, 0x90, 0x89, 0x3d, 0x49, 0x0a, 0x48, 0x55, 0xa8, 0x4a,
+	0xc8, 0x5f, 0x9b, 0x54, 0x2a, 0xf5, 0x9d, 0x77, 0x3f, 0xae, 0xee, 0xd5, 0xa8, 0xa5, 0x19, 0x9b,
+	0xfd, 0x4b, 0xba, 0xe7, 0xfb, 0xfa, 0x7c, 0x5f, 0x9f, 0x3e, 0x8f, 0xef, 0x7c, 0x4f, 0xb2, 0xb2,
+	0xed, 0x25, 0x3b, 0xfd, 0xcd, 0xb9, 0x76, 0xd8, 0xbd, 0xec, 0x46, 0xdb, 0x61, 0x2f, 0x0a, 0x5f,
+	0x61, 0xff, 0xbc, 0xbd, 0xdd, 0xb9, 0xbc, 0xf7, 0xdc, 0xe5, 0xde, 0xee, 0xf6, 0x65, 0xb7, 0xe7,
+	0xc5, 0x97, 0xdd, 0x5e, 0xcf, 0xf7, 0xda, 0x6e, 0xe2, 0x85, 0xc1, 0xe5, 0xbd, 0x77, 0xb8, 0x7e,
+	0x6f, 0xc7, 0x7d, 0xc7, 0xe5, 0x6d, 0x1a, 0xd0, 0xc8, 0x4d, 0x68, 0x67, 0xae, 0x17, 0x85, 0x49,
+	0x68, 0x7f, 0xad, 0xee, 0x6d, 0x4e, 0xf6, 0xc6, 0xfe, 0xf9, 0x48, 0xbb, 0x33, 0xb7, 0xf7, 0xdc,
+	0x5c, 0x6f, 0x77, 0x7b, 0x0e, 0x7b, 0x9b, 0x33, 0x7a, 0x9b, 0x93, 0xbd, 0x5d, 0x7c, 0xbb, 0xc1,
+	0xcb, 0x76, 0xb8, 0x1d, 0x5e, 0x66, 0x9d, 0x6e, 0xf6, 0xb7, 0xd8, 0x2f, 0xf6, 0x83, 0xfd, 0xc7,
+	0x89, 0x5d, 0x74, 0x76, 0x9f, 0x8f, 0xe7, 0xbc, 0x10, 0xd9, 0xbb, 0xdc, 0x0e, 0x23, 0x7a, 0x79,
+	0x2f, 0xc7, 0xd0, 0xc5, 0xeb, 0x1a, 0x87, 0xde, 0x4d, 0x68, 0x10, 0x7b, 0x61, 0x10, 0xbf, 0x1d,
+	0x59, 0xa0, 0xd1, 0x1e, 0x8d, 0xcc, 0xd7, 0x33, 0x10, 0x8a, 0x7a, 0x7a, 0xa7, 0xee, 0xa9, 0xeb,
+	0xb6, 0x77, 0xbc, 0x80, 0x46, 0xfb, 0xfa, 0xf1, 0x2e, 0x4d, 0xdc, 0xa2, 0xa7, 0x2e, 0x0f, 0x7a,
+	0x2a, 0xea, 0x07, 0x89, 0xd7, 0xa5, 0xb9, 0x07, 0xde, 0x3d, 0xec, 0x81, 0xb8, 0xbd, 0x43, 0xbb,
+	0x6e, 0xee, 0xb9, 0xe7, 0x06, 0x3d, 0xd7, 0x4f, 0x3c, 0xff, 0xb2, 0x17, 0x24, 0x71, 0x12, 0x65,
+	0x1f, 0x72, 0x7e, 0x73, 0x82, 0x9c, 0x9b, 0xbf, 0xdd, 0x9a, 0x6f, 0xb7, 0xc3, 0x7e, 0x90, 0xc4,
+	0xd7, 0x38, 0x38, 0x8c, 0xec, 0x65, 0x72, 0x36, 0x8c, 0xb6, 0xdd, 0xc0, 0x7b, 0x8d, 0x7d, 0x22,
+	0xd7, 0xbf, 0x15, 0x78, 0x49, 0xdc, 0xb4, 0x2e, 0x55, 0x9f, 0x69, 0x2c, 0x3c, 0x7e, 0xff, 0xde,
+	0xec, 0xd9, 0xb5, 0x3c, 0x18, 0x8a, 0x9e, 0xb1, 0x2f, 0x93, 0x46, 0x44, 0xdb, 0xfd, 0x28, 0xf6,
+	0xf6, 0x68, 0xb3, 0x72, 0xc9, 0x7a, 0x66, 0x62, 0xe1, 0xcc, 0x6f, 0xdf, 0x9b, 0x7d, 0xd3, 0xfd,
+	0x7b, 0xb3, 0x0d, 0x90, 0x00, 0xd0, 0x38, 0xf6, 0x1d, 0x42, 0x12, 0x77, 0xfb, 0xaa, 0xe7, 0x27,
+	0x34, 0x8a, 0x9b, 0xd5, 0x4b, 0xd5, 0x67, 0x26, 0x9f, 0xbd, 0x36, 0x77, 0x94, 0x89, 0x35, 0xb7,
+	0x21, 0xfb, 0x5b, 0x98, 0xbe, 0x7f, 0x6f, 0x96, 0xa8, 0x9f, 0x31, 0x18, 0xa4, 0xec, 0x79, 0x32,
+	0xe3, 0x05, 0x6d, 0xbf, 0xdf, 0xa1, 0xcb, 0x81, 0xdb, 0x4e, 0x90, 0xdf, 0x1a, 0xe3, 0xf7, 0x71,
+	0xc1, 0xef, 0xcc, 0x72, 0x1a, 0x0c, 0x59, 0x7c, 0xfb, 0xad, 0x64, 0x2c, 0xa2, 0xdb, 0x5e, 0x18,
+	0x34, 0xeb, 0x97, 0xac, 0x67, 0x1a, 0x0b, 0xd3, 0xe2, 0xc9, 0x31, 0x60, 0xad, 0x20, 0xa0, 0xf6,
+	0x25, 0x52, 0x8b, 0x42, 0x9f, 0x36, 0xc7, 0x18, 0xd6, 0x94, 0xc0, 0xaa, 0x41, 0xe8, 0x53, 0x60,
+	0x10, 0xfb, 0xdb, 0x2c, 0x32, 0xed, 0xb6, 0xdb, 0x34, 0x8e, 0x6f, 0xd0, 0xfd, 0xe5, 0x25, 0xa0,
+	0x5b, 0xcd, 0xf1, 0x4b, 0xd6, 0xd1, 0x87, 0xa2, 0x45, 0xdb, 0x11, 0x4d, 0x80, 0x6e, 0x2d, 0xd8,
+	0xf7, 0xef, 0xcd, 0x4e, 0xcf, 0xa7, 0x48, 0x40, 0x86, 0xa4, 0xfd, 0x19, 0x8b, 0xd8, 0x31, 0x7b,
+	0x42, 0x21, 0x22, 0x27, 0x13, 0xe5, 0x72, 0xf2, 0xd8, 0xfd, 0x7b, 0xb3, 0x76, 0x2b, 0x47, 0x06,
+	0x0a, 0x48, 0xe3, 0xcc, 0x8c, 0xe8, 0xab, 0x7d, 0xda, 0xa7, 0xf3, 0x5b, 0x09, 0x8d, 0x5a, 0xb4,
+	0x1d, 0x06, 0x9d, 0xb8, 0xd9, 0xb8, 0x64, 0x3d, 0x53, 0xe5, 0x33, 0x13, 0xf2, 0x60, 0x28, 0x7a,
+	0xc6, 0xfe, 0x56, 0x8b, 0x4c, 0x24, 0xb4, 0xdb, 0xf3, 0xdd, 0x84, 0x36, 0x09, 0x7b, 0xa5, 0x8d,
+	0xa3, 0xbd, 0xd2, 0xbc, 0x6e, 0x6c, 0xd1, 0x64, 0x43, 0xf4, 0xbd, 0x70, 0x5a, 0x7c, 0xdf, 0x09,
+	0xd9, 0x02, 0x8a, 0xae, 0xfd, 0x37, 0x2c, 0x32, 0xb6, 0xe7, 0xfa, 0x7d, 0x1a, 0x37, 0x27, 0xd9,
+	0x54, 0xff, 0xf0, 0x11, 0x59, 0x28, 0x58, 0xce, 0x73, 0x2f, 0x31, 0x02, 0x57, 0x82, 0x24, 0xda,
+	0xd7, 0x53, 0x92, 0x37, 0x82, 0xa0, 0x7e, 0xf1, 0xff, 0x27, 0x93, 0x06, 0x9a, 0x7d, 0x9a, 0x54,
+	0x77, 0xe9, 0x7e, 0xd3, 0xc2, 0x09, 0x0a, 0xf8, 0xaf, 0x7d, 0x8e, 0xd4, 0x19, 0x2a, 0x5b, 0xc4,
+	0x0d, 0xe0, 0x3f, 0xde, 0x53, 0x79, 0xde, 0x72, 0xfe, 0xae, 0x45, 0x4e, 0x21, 0xdd, 0x7e, 0xb2,
+	0xb3, 0x18, 0x06, 0x5b, 0xde, 0xb6, 0xfd, 0x2e, 0x32, 0xd9, 0xf6, 0xfb, 0x71, 0x42, 0xa3, 0x9b,
+	0x6e, 0x97, 0xf2, 0x5e, 0x16, 0xce, 0x0a, 0xca, 0x93, 0x8b, 0x1a, 0x04, 0x26, 0x9e, 0xfd, 0x15,
+	0x64, 0x1c, 0x27, 0xff, 0x3c, 0xdc, 0xe4, 0x44, 0x16, 0x66, 0xc4, 0x23, 0xe3, 0xc0, 0x9b, 0x41,
+	0xc2, 0x11, 0xb5, 0x17, 0x85, 0x5b, 0x9e, 0x4f, 0x9b, 0xd5, 0x34, 0xea, 0x3a, 0x6f, 0x06, 0x09,
+	0x77, 0xfe, 0xb0, 0x42, 0xc8, 0x7c, 0xaf, 0xb7, 0x1e, 0x85, 0xaf, 0xd0, 0x76, 0x62, 0x7f, 0x94,
+	0x4c, 0xe0, 0x6e, 0xdd, 0x71, 0x13, 0x97, 0x31, 0x36, 0xf9, 0xec, 0x57, 0xcf, 0xf1, 0xcd, 0x73,
+	0xce, 0xdc, 0x3c, 0xf5, 0x38, 0x23, 0xf6, 0xdc, 0xde, 0x3b, 0xe6, 0xd6, 0x36, 0xf1, 0xf9, 0x55,
+	0x9a, 0xb8, 0x0b, 0xb6, 0x20, 0x46, 0x74, 0x1b, 0xa8, 0x5e, 0xed, 0x80, 0xd4, 0xe2, 0x1e, 0x6d,
+	0xb3, 0x77, 0x98, 0x7c, 0x76, 0xe5, 0xc8, 0x73, 0x4a, 0x70, 0xde, 0xea, 0xd1, 0xb6, 0xde, 0x2b,
+	0xf0, 0x17, 0x30, 0x3a, 0xf6, 0x1e, 0x19, 0x8b, 0x13, 0x37, 0xe9, 0xc7, 0x6c, 0x28, 0x26, 0x9f,
+	0xbd, 0x59, 0x1a, 0x45, 0xd6, 0xab, 0x9e, 0x32, 0xfc, 0x37, 0x08, 0x6a, 0xce, 0x7f, 0xb4, 0xc8,
+	0xb4, 0x46, 0x5e, 0xf1, 0xe2, 0xc4, 0xfe, 0x50, 0x6e, 0x70, 0xe7, 0x46, 0x1b, 0x5c, 0x7c, 0x9a,
+	0x0d, 0xad, 0x5a, 0x2c, 0xb2, 0xc5, 0x18, 0xd8, 0x2e, 0xa9, 0x7b, 0x09, 0xed, 0xc6, 0xcd, 0x0a,
+	0x5b, 0x2a, 0xd7, 0xcb, 0x7a, 0xcf, 0x85, 0x53, 0x82, 0x68, 0x7d, 0x19, 0xbb, 0x07, 0x4e, 0xc5,
+	0xf9, 0xc3, 0x19, 0xf3, 0xfd, 0x70, 0xc0, 0xed, 0x77, 0x90, 0xc9, 0x38, 0xec, 0x47, 0x6d, 0x0a,
+	0xb4, 0x17, 0xca, 0x03, 0x71, 0x06, 0x27, 0x75, 0x4b, 0x37, 0x83, 0x89, 0x63, 0x7f, 0xda, 0x22,
+	0x53, 0x1d, 0x1a, 0x27, 0x5e, 0xc0, 0xe8, 0x4b, 0xe6, 0xcb, 0xdb, 0x6a, 0x96, 0x74, 0xe7, 0x0b,
+	0xe7, 0xc4, 0x8b, 0x4c, 0x19, 0x8d, 0x31, 0xa4, 0xe8, 0xe3, 0xe2, 0xec, 0xd0, 0xb8, 0x1d, 0x79,
+	0x3d, 0xfc, 0xdd, 0xac, 0xa6, 0x17, 0xe7, 0x92, 0x06, 0x81, 0x89, 0x67, 0x07, 0xa4, 0x8e, 0x8b,
+	0x2f, 0x6e, 0xd6, 0x18, 0xff, 0xcb, 0x47, 0xe3, 0x5f, 0x0c, 0x2a, 0xae, 0x6b, 0x3d, 0xfa, 0xf8,
+	0x2b, 0x06, 0x4e, 0xc6, 0xfe, 0x3e, 0x8b, 0x34, 0xc5, 0xe6, 0x00, 0x94, 0x0f, 0xe8, 0xed, 0x1d,
+	0x2f, 0xa1, 0xbe, 0x17, 0x27, 0xcd, 0x3a, 0xe3, 0xe1, 0xf2, 0x68, 0x73, 0xeb, 0x5a, 0x14, 0xf6,
+	0x7b, 0x37, 0xbc, 0xa0, 0xb3, 0x70, 0x49, 0x50, 0x6a, 0x2e, 0x0e, 0xe8, 0x18, 0x06, 0x92, 0xb4,
+	0x7f, 0xc8, 0x22, 0x17, 0x03, 0xb7, 0x4b, 0xe3, 0x9e, 0xdb, 0xa6, 0x12, 0xbc, 0xe0, 0xbb, 0xed,
+	0x5d, 0xc6, 0xd1, 0xd8, 0x83, 0x71, 0xe4, 0x08, 0x8e, 0x2e, 0xde, 0x1c, 0xd8, 0x35, 0x1c, 0x40,
+	0xd6, 0xfe, 0x29, 0x8b, 0x9c, 0x09, 0xa3, 0xde, 0x8e, 0x1b, 0xd0, 0x8e, 0x84, 0xc6, 0x42, 0x54,
+	0x38, 0xe2, 0x51, 0xb2, 0x96, 0xed, 0x76, 0x35, 0x0c, 0xbc, 0x24, 0x8c, 0x5a, 0x34, 0x49, 0xbc,
+	0x60, 0x3b, 0x5e, 0x38, 0x7f, 0xff, 0xde, 0xec, 0x99, 0x1c, 0x16, 0xe4, 0xf9, 0xb1, 0xbf, 0x89,
+	0x4c, 0xc6, 0xfb, 0x41, 0xfb, 0xb6, 0x17, 0x74, 0xc2, 0x3b, 0x71, 0x73, 0xa2, 0x8c, 0xe5, 0xdb,
+	0x52, 0x1d, 0x8a, 0x05, 0xa8, 0x09, 0x80, 0x49, 0xad, 0xf8, 0xc3, 0xe9, 0xa9, 0xd4, 0x28, 0xfb,
+	0xc3, 0xe9, 0xc9, 0x74, 0x00, 0x59, 0xfb, 0x3b, 0x2d, 0x72, 0x2a, 0xf6, 0xb6, 0x03, 0x37, 0xe9,
+	0x47, 0xf4, 0x06, 0xdd, 0x8f, 0x9b, 0x84, 0x31, 0xf2, 0xc2, 0x11, 0x47, 0xc5, 0xe8, 0x72, 0xe1,
+	0xbc, 0xe0, 0xf1, 0x94, 0xd9, 0x1a, 0x43, 0x9a, 0x6e, 0xd1, 0x42, 0xd3, 0xd3, 0x7a, 0xb2, 0xdc,
+	0x85, 0xa6, 0x27, 0xf5, 0x40, 0x92, 0xf6, 0x37, 0x90, 0xd3, 0xbc, 0x49, 0x8d, 0x6c, 0xdc, 0x9c,
+	0x62, 0x1b, 0xed, 0xb9, 0xfb, 0xf7, 0x66, 0x4f, 0xb7, 0x32, 0x30, 0xc8, 0x61, 0xdb, 0xaf, 0x92,
+	0xd9, 0x1e, 0x8d, 0xba, 0x5e, 0xb2, 0x16, 0xf8, 0xfb, 0x72, 0xfb, 0x6e, 0x87, 0x3d, 0xda, 0x11,
+	0xec, 0xc4, 0xcd, 0x53, 0x4c, 0xb2, 0x7f, 0x9b, 0x60, 0x73, 0x76, 0xfd, 0x60, 0x74, 0x18, 0xd6,
+	0x9f, 0xfd, 0x5b, 0x16, 0xb9, 0x68, 0xec, 0xb2, 0x2d, 0x1a, 0xed, 0x79, 0x6d, 0x2a, 0x45, 0xb1,
+	0xe6, 0x34, 0x1b, 0xc6, 0xcd, 0xe3, 0xd8, 0xf3, 0xd3, 0xa4, 0xf4, 0xbc, 0x1c, 0x88, 0x12, 0xc3,
+	0x01, 0x9c, 0xda, 0x3d, 0x72, 0xc9, 0x4d, 0x89, 0xb1, 0x4a, 0x8c, 0xd4, 0x4b, 0x66, 0x86, 0x7d,
+	0x8d, 0xb7, 0xdc, 0xbf, 0x37, 0x7b, 0x69, 0x7e, 0x08, 0x2e, 0x0c, 0xed, 0xed, 0x00, 0x8a, 0x7a,
+	0x1a, 0x9e, 0x1e, 0x4a, 0x51, 0xcf, 0xac, 0xa1, 0xbd, 0x39, 0xff, 0xaa, 0x42, 0x4e, 0x67, 0xa5,
+	0x1c, 0xfb, 0x67, 0x2d, 0x32, 0xf3, 0xca, 0x9d, 0x64, 0x23, 0xdc, 0xa5, 0x41, 0xbc, 0xb0, 0x8f,
+	0x67, 0x11, 0x3b, 0xdf, 0x27, 0x9f, 0x6d, 0x97, 0x2b, 0x4f, 0xcd, 0xbd, 0x90, 0xa6, 0xc2, 0xe5,
+	0x72, 0x75, 0xc9, 0x7c, 0xe1, 0xf6, 0x86, 0x09, 0x85, 0x2c, 0x53, 0x17, 0x3f, 0x65, 0x91, 0x73,
+	0x45, 0x5d, 0x14, 0xc8, 0xec, 0x2f, 0x9b, 0x32, 0xfb, 0x91, 0x6f, 0x6c, 0x8a, 0x33, 0x53, 0xf8,
+	0xff, 0xdd, 0x2a, 0x99, 0x34, 0x3e, 0xc9, 0x09, 0x88, 0xd7, 0x61, 0x4a, 0xbc, 0x5e, 0x2d, 0xef,
+	0xca, 0x36, 0x48, 0xbe, 0xbe, 0x93, 0x91, 0xaf, 0xd7, 0xca, 0x23, 0x79, 0xa0, 0x80, 0x6d, 0x27,
+	0xa4, 0x11, 0xf6, 0x70, 0xf2, 0xa2, 0x9c, 0x56, 0x2b, 0xe3, 0x13, 0xae, 0xc9, 0xee, 0x16, 0x4e,
+	0xa1, 0x02, 0x46, 0xfd, 0x04, 0x4d, 0xc8, 0xf9, 0xf7, 0x16, 0x39, 0x67, 0xf0, 0xb8, 0x18, 0x06,
+	0x1d, 0x2f, 0x11, 0x5a, 0x8b, 0x64, 0xbf, 0x27, 0xaf, 0x73, 0x6a, 0xa4, 0x36, 0xf6, 0x7b, 0x14,
+	0x18, 0x04, 0x6f, 0x65, 0x5d, 0x1a, 0xc7, 0xee, 0x36, 0xcd, 0x5e, 0xe0, 0x56, 0x79, 0x33, 0x48,
+	0xb8, 0x1d, 0x11, 0xdb, 0x77, 0xe3, 0x64, 0x23, 0x72, 0x83, 0x98, 0x75, 0xbf, 0xe1, 0x75, 0xa9,
+	0x18, 0xe0, 0xff, 0x6f, 0xb4, 0x19, 0x83, 0x4f, 0x70, 0xe5, 0xc1, 0x4a, 0xae, 0x27, 0x28, 0xe8,
+	0xdd, 0xf9, 0x21, 0x8b, 0x3c, 0x56, 0xbc, 0x89, 0xa2, 0xe6, 0x86, 0xab, 0x04, 0xc5, 0xdb, 0xe9,
+	0x4f, 0xc2, 0x5a, 0x41, 0x40, 0x51, 0x9d, 0xa5, 0x0e, 0x75, 0xf1, 0x8e, 0x4a, 0x9d, 0xa5, 0x25,
+	0x01, 0x8d, 0x83, 0x83, 0x16, 0xb8, 0xe2, 0xcd, 0x8c, 0x41, 0x43, 0x5c, 0x60, 0x10, 0xe7, 0x0f,
+	0x2c, 0xf2, 0x96, 0x51, 0xb6, 0xf6, 0xe3, 0xe3, 0xb1, 0x45, 0xce, 0x77, 0xe8, 0x96, 0xdb, 0xf7,
+	0x93, 0x34, 0x45, 0xc1, 0xf4, 0x93, 0xe2, 0xe1, 0xf3, 0x4b, 0x45, 0x48, 0x50, 0xfc, 0xac, 0xf3,
+	0x9f, 0x2c, 0x32, 0x63, 0xbc, 0xd6, 0x09, 0x5c, 0x0f, 0x83, 0xf4, 0xf5, 0x70, 0xb9, 0xb4, 0x65,
+	0x3a, 0xe0, 0x7e, 0xf8, 0x7d, 0x16, 0xb9, 0x68, 0x60, 0xad, 0xba, 0x49, 0x7b, 0xe7, 0xca, 0xdd,
+	0x5e, 0x44, 0xe3, 0x18, 0xa7, 0xd4, 0x93, 0xc6, 0x76, 0xbc, 0x30, 0x29, 0x7a, 0xa8, 0xa2, 0x1e,
+	0x8b, 0xed, 0xcd, 0x5f, 0x45, 0x26, 0xf8, 0x9a, 0x0b, 0x23, 0xf1, 0x91, 0xd4, 0xbb, 0xad, 0x89,
+	0x76, 0x50, 0x18, 0xb6, 0xa3, 0xd4, 0x44, 0x55, 0x76, 0x14, 0x92, 0xbc, 0x0a, 0xc7, 0x89, 0x53,
+	0xec, 0xac, 0x47, 0x94, 0xcd, 0x87, 0xce, 0x55, 0x8f, 0xfa, 0x9d, 0x18, 0xaf, 0xae, 0x6e, 0x10,
+	0x84, 0x89, 0xb8, 0x85, 0x1a, 0x57, 0xd7, 0x79, 0xdd, 0x0c, 0x26, 0x0e, 0x12, 0xf5, 0xdd, 0x4d,
+	0xea, 0xf3, 0x11, 0x15, 0x44, 0x57, 0x58, 0x0b, 0x08, 0x88, 0x73, 0xbf, 0x42, 0xa6, 0x0d, 0xaa,
+	0x2d, 0x7a, 0x12, 0x1a, 0x96, 0x28, 0x75, 0x04, 0xac, 0x97, 0xa9, 0xb5, 0x1b, 0x78, 0x0a, 0xbc,
+	0x96, 0x39, 0x05, 0xa0, 0x54, 0xaa, 0x07, 0x6b, 0x5a, 0x3e, 0x51, 0x25, 0xb3, 0xe9, 0x07, 0x72,
+	0x87, 0x08, 0x5e, 0xeb, 0x0d, 0x42, 0x59, 0x9d, 0x9b, 0x81, 0x0f, 0x26, 0xde, 0x80, 0x7d, 0xb8,
+	0x72, 0x9c, 0xfb, 0xb0, 0x79, 0x4c, 0x54, 0x87, 0x1c, 0x13, 0x6f, 0x55, 0xa3, 0x5e, 0xcb, 0xec,
+	0x79, 0xe9, 0xa3, 0xf2, 0x12, 0xa9, 0xc5, 0x09, 0xed, 0x09, 0xbd, 0xbb, 0xfe, 0x7e, 0x09, 0xed,
+	0x01, 0x83, 0xd8, 0x5f, 0x47, 0x66, 0x12, 0x37, 0xda, 0xa6, 0x49, 0x44, 0xf7, 0x3c, 0x66, 0xe6,
+	0x61, 0x77, 0xf6, 0xc6, 0xc2, 0x59, 0x94, 0xba, 0x36, 0x18, 0x08, 0x24, 0x08, 0xb2, 0xb8, 0xce,
+	0x7f, 0xab, 0x90, 0xc7, 0xd3, 0x9f, 0x40, 0x1f, 0x8c, 0x5f, 0x9f, 0x3a, 0x18, 0xbf, 0xd2, 0x3c,
+	0x18, 0x5f, 0xbf, 0x37, 0xfb, 0xe6, 0x01, 0x8f, 0x7d, 0xd1, 0x9c, 0x9b, 0xf6, 0xb5, 0xcc, 0x47,
+	0xb8, 0x9c, 0xfe, 0x08, 0xaf, 0xdf, 0x9b, 0x7d, 0x72, 0xc0, 0x3b, 0x66, 0xbe, 0x12, 0xb3, 0x8f,
+	0xb8, 0x71, 0x91, 0x7d, 0xc4, 0x8d, 0xb9, 0x7d, 0x04, 0xff, 0x3a, 0x3f, 0x9b, 0xde, 0x59, 0x5b,
+	0x34, 0xb9, 0x1a, 0x51, 0xfa, 0x1a, 0xe5, 0x57, 0x7a, 0xdc, 0x3a, 0xd1, 0x0c, 0xd6, 0xe9, 0xfb,
+	0x72, 0xcc, 0xd5, 0xd6, 0xd9, 0x12, 0xed, 0xa0, 0x30, 0x10, 0xbb, 0xd3, 0x17, 0x42, 0x54, 0x66,
+	0xa3, 0x5d, 0x12, 0xed, 0xa0, 0x30, 0x10, 0x3b, 0xf1, 0xba, 0xf4, 0x03, 0x61, 0x20, 0x27, 0xa7,
+	0x56, 0xdf, 0x8b, 0x76, 0x50, 0x18, 0xce, 0xbf, 0x9b, 0xca, 0xce, 0x0a, 0x6d, 0x44, 0xf3, 0x48,
+	0x8d, 0xdd, 0x5d, 0xf8, 0x16, 0x78, 0xe3, 0x68, 0xdb, 0x05, 0x1e, 0x77, 0xfa, 0x26, 0x33, 0x81,
+	0xd3, 0x0b, 0x9b, 0x80, 0x91, 0xb0, 0xef, 0x92, 0x89, 0xb6, 0xbc, 0xd9, 0x56, 0xca, 0xd0, 0x01,
+	0x8b, 0x7b, 0xad, 0xa6, 0x38, 0x85, 0x03, 0xa0, 0xae, 0xc3, 0x8a, 0x9a, 0x4d, 0x49, 0x75, 0xdb,
+	0x4b, 0xc4, 0xfc, 0x3b, 0xa2, 0xee, 0xe2, 0x9a, 0x67, 0xbc, 0xe2, 0x38, 0x1e, 0x96, 0xd7, 0xbc,
+	0x04, 0xb0, 0x7f, 0xfb, 0x3b, 0x2c, 0x32, 0x19, 0xb7, 0xbb, 0xeb, 0x51, 0xb8, 0xe7, 0x75, 0x68,
+	0xd4, 0xac, 0x95, 0xb1, 0x05, 0xb7, 0x16, 0x57, 0x65, 0x87, 0x9a, 0x2e, 0xd7, 0x25, 0x69, 0x08,
+	0x98, 0x74, 0xf1, 0x92, 0xf8, 0xb8, 0x78, 0xf7, 0x25, 0xda, 0x66, 0x5b, 0x83, 0x54, 0x60, 0x34,
+	0xeb, 0x65, 0x5c, 0x0e, 0x96, 0xfa, 0xed, 0x5d, 0xdc, 0x18, 0x34, 0x43, 0x6f, 0xbe, 0x7f, 0x6f,
+	0xf6, 0xf1, 0xc5, 0x62, 0x9a, 0x30, 0x88, 0x19, 0x36, 0x60, 0xbd, 0xbe, 0xef, 0x33, 0x6b, 0x18,
+	0x53, 0x4f, 0x96, 0x30, 0x60, 0xeb, 0xba, 0xc3, 0xcc, 0x80, 0x19, 0x10, 0x30, 0xe9, 0xda, 0xaf,
+	0x92, 0xb1, 0xae, 0x9b, 0x44, 0xde, 0xdd, 0xe6, 0x78, 0x19, 0xd7, 0xb5, 0x55, 0xd6, 0x97, 0x26,
+	0xce, 0x24, 0x12, 0xde, 0x08, 0x82, 0x10, 0x5a, 0x09, 0xba, 0x34, 0xda, 0xa6, 0xcd, 0x89, 0x32,
+	0xec, 0x2f, 0xab, 0xd8, 0x95, 0x26, 0xd8, 0x40, 0x29, 0x90, 0xb5, 0x01, 0xa7, 0x62, 0xbf, 0x4c,
+	0x26, 0x62, 0xea, 0xd3, 0x36, 0xca, 0x71, 0x0d, 0x46, 0xf1, 0xb9, 0x11, 0x65, 0x5a, 0x14, 0xa0,
+	0x5a, 0xe2, 0x51, 0xbe, 0xc0, 0xe4, 0x2f, 0x50, 0x5d, 0xe2, 0x00, 0xf6, 0xfc, 0xfe, 0xb6, 0x17,
+	0x34, 0x49, 0x19, 0x03, 0xb8, 0xce, 0xfa, 0xca, 0x0c, 0x20, 0x6f, 0x04, 0x41, 0x08, 0xd7, 0x74,
+	0xd8, 0xf6, 0x9a, 0x93, 0x65, 0xac, 0xe9, 0xb5, 0xc5, 0xe5, 0xcc, 0x9a, 0x5e, 0x5b, 0x5c, 0x06,
+	0xec, 0x9f, 0x4d, 0x51, 0xf7, 0x4e, 0xac, 0x74, 0x64, 0x53, 0xa5, 0x88, 0x55, 0x05, 0xf6, 0x4f,
+	0x21, 0xe5, 0x6a, 0x08, 0x98, 0x74, 0xed, 0x1f, 0xb0, 0xc8, 0x74, 0x42, 0xa3, 0xc8, 0xdd, 0x0a,
+	0xa3, 0x2e, 0x1e, 0x58, 0x94, 0x69, 0x07, 0x27, 0x9f, 0xbd, 0x75, 0x44, 0xaf, 0x83, 0x54, 0x9f,
+	0x9a, 0x1b, 0x66, 0x78, 0x4f, 0x03, 0x21, 0xc3, 0x80, 0xf3, 0x89, 0x0a, 0xb1, 0xd3, 0xe7, 0xca,
+	0xf5, 0x30, 0xdc, 0x55, 0x97, 0x49, 0x6b, 0xd0, 0x65, 0xd2, 0xfe, 0x1e, 0x8b, 0x4c, 0xb5, 0x99,
+	0x11, 0x76, 0xd5, 0xed, 0xa1, 0xad, 0xbe, 0x14, 0x11, 0x99, 0x4f, 0x90, 0x45, 0xa3, 0x5f, 0x6d,
+	0x69, 0x32, 0x5b, 0x21, 0x45, 0xdb, 0x7e, 0x2f, 0x39, 0xb5, 0xe5, 0x7a, 0x7e, 0x3f, 0xa2, 0xeb,
+	0xa1, 0xef, 0xb5, 0xf7, 0xc5, 0x81, 0xaa, 0xd4, 0xd2, 0x57, 0x4d, 0x20, 0xa4, 0x71, 0x9d, 0xcf,
+	0x55, 0xc8, 0xd9, 0xfc, 0x10, 0xc4, 0xf6, 0x27, 0x2d, 0xd2, 0xe8, 0x45, 0x14, 0x68, 0xd0, 0x61,
+	0x37, 0xe1, 0x6a, 0xd9, 0x37, 0x00, 0x24, 0xa3, 0x2f, 0xcc, 0xeb, 0x92, 0x14, 0x68, 0xaa, 0xf6,
+	0xb7, 0x5b, 0x84, 0xf4, 0xc2, 0x38, 0x11, 0x4c, 0x54, 0x8e, 0x89, 0x09, 0x75, 0x09, 0x5a, 0x57,
+	0xb4, 0xc0, 0xa0, 0xeb, 0xfc, 0x57, 0x2b, 0x3b, 0x4b, 0x4e, 0xe0, 0x96, 0xfd, 0x6a, 0xfa, 0x96,
+	0xbd, 0x52, 0xe6, 0x5b, 0x0f, 0xb8, 0x68, 0xff, 0x94, 0x45, 0x9e, 0x4a, 0x23, 0xae, 0xba, 0x81,
+	0xbb, 0x4d, 0x3b, 0x4a, 0x9b, 0x61, 0x7f, 0xc2, 0xca, 0xbd, 0xf4, 0x4b, 0x47, 0x3d, 0x6a, 0xd2,
+	0x24, 0x56, 0x45, 0xef, 0x7c, 0xa7, 0x96, 0xbf, 0xf4, 0xc0, 0x38, 0x7f, 0x3e, 0x45, 0x32, 0x62,
+	0xf0, 0x4d, 0x1a, 0x27, 0xb4, 0xf3, 0x86, 0x44, 0xf8, 0x86, 0x44, 0xf8, 0x86, 0x44, 0x28, 0x7f,
+	0xd8, 0x9b, 0x19, 0x89, 0xf0, 0x7d, 0xc6, 0xde, 0xa4, 0x5d, 0x30, 0x3f, 0xa2, 0x7c, 0x34, 0x4d,
+	0x0e, 0x0c, 0x04, 0xdc, 0xaf, 0x5e, 0x68, 0xad, 0xdd, 0x2c, 0x14, 0x01, 0x3f, 0x92, 0x16, 0x01,
+	0x8f, 0x4a, 0xe2, 0x0d, 0xa1, 0xaf, 0x34, 0xa1, 0xef, 0x19, 0x32, 0xd1, 0x8b, 0xbc, 0x30, 0xf2,
+	0x92, 0x7d, 0x26, 0xf0, 0x55, 0xf9, 0x18, 0xac, 0x8b, 0x36, 0x50, 0xd0, 0x9c, 0x78, 0x78, 0xea,
+	0xd1, 0x11, 0x0f, 0xa7, 0x1f, 0xb6, 0x78, 0xf8, 0x72, 0x56, 0x1d, 0xb8, 0x1e, 0xf5, 0x03, 0xee,
+	0xdd, 0x78, 0x9d, 0xba, 0x7e, 0xb2, 0xb3, 0x6f, 0xbf, 0x87, 0x4c, 0x27, 0x5e, 0x97, 0x86, 0xfd,
+	0x44, 0xfa, 0x48, 0x5a, 0x6c, 0xb8, 0x79, 0xf7, 0x29, 0x08, 0x64, 0x30, 0x9d, 0xdf, 0xb2, 0xc8,
+	0xdb, 0xd2, 0xfd, 0xcb, 0x5d, 0x64, 0x79, 0x3b, 0x08, 0x23, 0xba, 0xe4, 0x6d, 0x6d, 0xd1, 0x88,
+	0x06, 0x68, 0x6b, 0x1f, 0x2e, 0x92, 0xbe, 0x93, 0x4c, 0xbd, 0x12, 0x87, 0xc1, 0x7a, 0xe8, 0x05,
+	0xe2, 0x38, 0x42, 0xad, 0xdb, 0x69, 0x94, 0x1d, 0x71, 0x75, 0xc9, 0x76, 0x48, 0x61, 0xd9, 0x8b,
+	0xe4, 0xcc, 0x2b, 0xaf, 0xae, 0xbb, 0x89, 0xa1, 0x51, 0x97, 0xba, 0x6f, 0xe6, 0x77, 0xf2, 0xc2,
+	0x8b, 0x19, 0x20, 0xe4, 0xf1, 0x9d, 0xbf, 0x53, 0x21, 0x17, 0x32, 0x2f, 0x12, 0xfa, 0x3e, 0xbe,
+	0x29, 0x6a, 0x04, 0x7f, 0xdc, 0x22, 0xa7, 0xbb, 0x69, 0xa5, 0x7d, 0x2c, 0x04, 0xca, 0x6f, 0x2c,
+	0x4d, 0xaa, 0xc9, 0x58, 0x05, 0x16, 0x9a, 0x62, 0x84, 0x4e, 0x67, 0x00, 0x31, 0xe4, 0x78, 0xb1,
+	0x5f, 0x26, 0x8d, 0xae, 0x7b, 0xf7, 0x56, 0xaf, 0x83, 0x93, 0xae, 0x32, 0x44, 0x93, 0xde, 0x4f,
+	0x3c, 0x7f, 0x8e, 0x3b, 0x7a, 0xcf, 0x2d, 0x07, 0xc9, 0x5a, 0xd4, 0x4a, 0x22, 0x2f, 0xd8, 0xe6,
+	0x86, 0xbe, 0x55, 0xd9, 0x0d, 0xe8, 0x1e, 0x9d, 0x1f, 0xb3, 0xc8, 0x93, 0x03, 0x46, 0x27, 0x72,
+	0x13, 0xba, 0xbd, 0x6f, 0x7f, 0x8c, 0xd4, 0xe3, 0x84, 0xf6, 0xe4, 0xa8, 0xdc, 0x2e, 0x53, 0xd6,
+	0x33, 0xbe, 0x84, 0x16, 0xfb, 0xf0, 0x57, 0x0c, 0x9c, 0xa8, 0xf3, 0x99, 0x99, 0xac, 0x78, 0xcb,
+	0x7c, 0xf0, 0x9e, 0x25, 0x64, 0x3b, 0x94, 0xae, 0xb4, 0x6c, 0xde, 0x4d, 0x68, 0x49, 0xf9, 0x9a,
+	0x82, 0x80, 0x81, 0x65, 0x7f, 0xb7, 0x45, 0xc8, 0xb6, 0x5c, 0x62, 0x52, 0x74, 0xbd, 0x55, 0xe6,
+	0xeb, 0x18, 0x0b, 0x58, 0xf1, 0xa2, 0x08, 0x82, 0x41, 0x3c, 0xed, 0x77, 0x5c, 0x7d, 0x78, 0x7e,
+	0xc7, 0x04, 0x9d, 0xa4, 0xc4, 0xc5, 0xac, 0x56, 0x86, 0xc4, 0x9c, 0xf9, 0x56, 0xaa, 0x77, 0xee,
+	0x75, 0xaf, 0x7f, 0x83, 0x41, 0xd9, 0xfe, 0x38, 0x99, 0x88, 0xc5, 0x74, 0x6b, 0xd6, 0xcb, 0x1f,
+	0x0c, 0x39, 0x95, 0xc5, 0x51, 0x2b, 0x7e, 0x81, 0xa2, 0x69, 0xff, 0x88, 0x45, 0x66, 0x7a, 0x69,
+	0x53, 0x99, 0x10, 0x8d, 0xca, 0xdb, 0x03, 0x32, 0xa6, 0x38, 0x6e, 0x71, 0xc8, 0x34, 0x42, 0x96,
+	0x0b, 0xdc, 0x01, 0xf5, 0x0c, 0x5e, 0xeb, 0x71, 0xb3, 0xdd, 0xb8, 0xde, 0x01, 0xaf, 0x65, 0x81,
+	0x90, 0xc7, 0xb7, 0xd7, 0xc9, 0x39, 0xe4, 0x6e, 0x9f, 0x5f, 0x45, 0xa4, 0xa8, 0x11, 0x33, 0xc1,
+	0x68, 0x62, 0xe1, 0x09, 0x31, 0x43, 0xce, 0xcd, 0x17, 0xe0, 0x40, 0xe1, 0x93, 0xf6, 0xef, 0x5a,
+	0xe4, 0x09, 0x8f, 0x1d, 0x03, 0xa6, 0xd1, 0x5a, 0x9f, 0x08, 0xc2, 0xa1, 0x8e, 0x96, 0xba, 0x57,
+	0x0c, 0x3a, 0x7e, 0x16, 0xde, 0x22, 0xde, 0xe0, 0x89, 0xe5, 0x03, 0x58, 0x82, 0x03, 0x19, 0xb6,
+	0xbf, 0x86, 0x9c, 0x92, 0xeb, 0x62, 0x1d, 0xb7, 0x60, 0x26, 0x74, 0x35, 0x16, 0xce, 0xa0, 0x8a,
+	0x62, 0xc3, 0x04, 0x40, 0x1a, 0xcf, 0x5e, 0x21, 0xe7, 0xa4, 0x81, 0xe8, 0xba, 0x17, 0x27, 0x61,
+	0xb4, 0xbf, 0xe2, 0x75, 0xbd, 0x84, 0x09, 0x51, 0xd5, 0x85, 0x26, 0x0e, 0x2c, 0x14, 0xc0, 0xa1,
+	0xf0, 0x29, 0x3b, 0x22, 0xf5, 0x1d, 0xd4, 0x70, 0x08, 0x45, 0xd8, 0x8b, 0x65, 0xab, 0x13, 0x62,
+	0x2e, 0xc7, 0xb2, 0x7f, 0x81, 0x93, 0x12, 0x47, 0x60, 0xfa, 0xa2, 0x2b, 0x24, 0xad, 0x0f, 0x95,
+	0x49, 0x3f, 0x7b, 0x99, 0xe6, 0xae, 0x7c, 0xd9, 0x56, 0xc8, 0xf1, 0x82, 0xfa, 0xac, 0x49, 0x39,
+	0xe8, 0xa8, 0xce, 0xe2, 0xa2, 0xd7, 0xed, 0xe3, 0xd8, 0x2f, 0x51, 0xab, 0xc5, 0x44, 0x41, 0xa3,
+	0x01, 0x4c, 0xe2, 0xf6, 0x36, 0x79, 0x52, 0x2e, 0x52, 0xa3, 0x8b, 0xab, 0x5e, 0xe0, 0xfa, 0xde,
+	0x6b, 0x28, 0xda, 0xcc, 0xb0, 0x55, 0xf5, 0x65, 0xf7, 0xef, 0xcd, 0x3e, 0xb9, 0x7e, 0x10, 0x22,
+	0x1c, 0xdc, 0x8f, 0xfd, 0x37, 0x2d, 0x72, 0x6a, 0xcb, 0xb0, 0x78, 0xc5, 0xcc, 0x01, 0xae, 0xcc,
+	0x2d, 0x29, 0x63, 0x52, 0x33, 0x74, 0x72, 0x26, 0x59, 0x48, 0x73, 0xe1, 0xfc, 0x55, 0x9d, 0x9c,
+	0xcb, 0xee, 0xaf, 0xcc, 0xb0, 0x87, 0xe7, 0x6b, 0x5b, 0x1a, 0xfd, 0xa4, 0xb8, 0x50, 0xea, 0xf9,
+	0xaa, 0x4c, 0x8a, 0xfa, 0x7c, 0x55, 0x4d, 0x31, 0x18, 0xc4, 0xf1, 0x46, 0x7e, 0xc6, 0xcd, 0x9a,
+	0xc7, 0xc5, 0x91, 0xff, 0x72, 0x99, 0x2c, 0xe5, 0x1d, 0xb9, 0x2e, 0x08, 0xd6, 0xce, 0xe4, 0x40,
+	0x90, 0x67, 0xc9, 0xfe, 0x66, 0x0c, 0x8d, 0x93, 0x2e, 0xdb, 0xd5, 0x32, 0xb4, 0x69, 0x72, 0x9f,
+	0x14, 0xec, 0x18, 0x81, 0x76, 0x82, 0x0c, 0x68, 0x8a, 0xe8, 0x81, 0x7c, 0xc1, 0x77, 0xe3, 0xa4,
+	0xd5, 0x67, 0x01, 0x56, 0x5b, 0x7d, 0x1f, 0x50, 0xfe, 0x6f, 0x7b, 0x3e, 0x9d, 0x4f, 0x9a, 0xb5,
+	0x43, 0x5b, 0x94, 0x9f, 0xbc, 0x7f, 0x6f, 0xf6, 0xc2, 0xca, 0xa0, 0x0e, 0x61, 0x30, 0x2d, 0x0c,
+	0xea, 0xea, 0x44, 0xde, 0x56, 0x42, 0x3b, 0xc6, 0xb8, 0xc5, 0xcd, 0xba, 0x0e, 0x37, 0x5c, 0xca,
+	0x83, 0xa1, 0xe8, 0x19, 0x1b, 0xc8, 0x63, 0x2a, 0xca, 0x71, 0xdd, 0x8d, 0xdc, 0x2e, 0x65, 0x11,
+	0x5f, 0x09, 0x37, 0xf6, 0x57, 0x17, 0x2e, 0xde, 0xbf, 0x37, 0xfb, 0xd8, 0xb5, 0x42, 0x0c, 0x18,
+	0xf0, 0xa4, 0xf3, 0x3b, 0x69, 0xb7, 0x31, 0x43, 0xaa, 0x18, 0xc1, 0x25, 0xee, 0xd3, 0x16, 0x99,
+	0x8c, 0x42, 0xdf, 0xf7, 0x82, 0x6d, 0x94, 0x80, 0x84, 0x18, 0xff, 0xc1, 0x63, 0x91, 0xa4, 0x85,
+	0xa8, 0xc3, 0x36, 0x31, 0xd0, 0x34, 0xc1, 0x64, 0xc0, 0xf9, 0xd6, 0x2a, 0x69, 0x0e, 0x92, 0xd4,
+	0x6c, 0x4a, 0xde, 0x2c, 0x77, 0x26, 0x35, 0x67, 0xd6, 0x82, 0x25, 0xea, 0x53, 0xe5, 0x54, 0x32,
+	0xb1, 0xf0, 0xb4, 0x78, 0xcd, 0x37, 0xaf, 0x0f, 0x46, 0x85, 0x83, 0xfa, 0xb1, 0x3f, 0x40, 0x4e,
+	0x1b, 0xef, 0x15, 0xab, 0x81, 0x69, 0x2c, 0xcc, 0xe1, 0xb9, 0x30, 0x9f, 0x81, 0xbd, 0x7e, 0x6f,
+	0xf6, 0xb1, 0x6c, 0x9b, 0x10, 0x25, 0x73, 0xfd, 0xd8, 0x3f, 0x69, 0x91, 0x33, 0xbd, 0xec, 0x75,
+	0x58, 0xc8, 0xd9, 0xa5, 0x2e, 0xff, 0xdc, 0x9d, 0x9b, 0x8b, 0x65, 0xb9, 0x66, 0xc8, 0xb3, 0xe3,
+	0xfc, 0x74, 0x25, 0x3b, 0xa5, 0xd4, 0x55, 0xe5, 0xb3, 0x79, 0x4d, 0xf6, 0x37, 0x1e, 0xc7, 0x71,
+	0xc7, 0x14, 0xfd, 0xca, 0x5b, 0x7c, 0x30, 0xce, 0x43, 0xf4, 0xbc, 0x75, 0x7e, 0xd4, 0x22, 0x4f,
+	0x14, 0x73, 0x86, 0x7a, 0x60, 0xba, 0xc5, 0x22, 0x06, 0x69, 0x2f, 0xbc, 0x05, 0x2b, 0x4d, 0x2b,
+	0xed, 0x38, 0x03, 0xbc, 0x19, 0x24, 0x1c, 0x1d, 0x3b, 0xa4, 0xd8, 0x95, 0x75, 0x03, 0x91, 0x42,
+	0x1a, 0x28, 0x0c, 0x5c, 0xd8, 0x3d, 0x37, 0xd9, 0xc9, 0xba, 0x6d, 0xa2, 0x82, 0x01, 0x18, 0xc4,
+	0xf9, 0xd7, 0x35, 0x72, 0xc0, 0xa8, 0x8d, 0xa0, 0x17, 0x39, 0xb4, 0x9b, 0xe6, 0xf7, 0x5a, 0xca,
+	0x1f, 0x8f, 0x9f, 0x16, 0x9d, 0xe3, 0x9a, 0x17, 0x5c, 0x4d, 0x99, 0x8d, 0x18, 0x4d, 0x7b, 0xfe,
+	0xd9, 0x9f, 0xb3, 0xd2, 0x1e, 0x85, 0x3c, 0x2e, 0xcc, 0x3b, 0x36, 0x9e, 0x0c, 0x37, 0x45, 0xce,
+	0x98, 0x76, 0x6e, 0x1b, 0xe4, 0xc0, 0x38, 0x47, 0xc8, 0x96, 0x96, 0xce, 0xf8, 0x79, 0xc2, 0x2e,
+	0xa3, 0x86, 0x28, 0x66, 0x60, 0x60, 0x10, 0xac, 0xf1, 0xe6, 0x87, 0x09, 0x82, 0xbd, 0xf8, 0x3e,
+	0x72, 0x3a, 0xcb, 0xe0, 0xa1, 0x82, 0x68, 0x7f, 0xd0, 0x22, 0x17, 0x8a, 0x5f, 0x1e, 0xe7, 0x79,
+	0x9f, 0x1b, 0x55, 0xf8, 0x76, 0xf0, 0x81, 0xe3, 0x18, 0x62, 0xbe, 0xa0, 0xd2, 0x46, 0x16, 0xe7,
+	0x67, 0x26, 0xb3, 0x8a, 0xc6, 0x0d, 0x1a, 0x75, 0x71, 0xbc, 0xde, 0x30, 0x6a, 0xbd, 0x61, 0xd4,
+	0x7a, 0xc3, 0xa8, 0x65, 0xba, 0x39, 0x09, 0x83, 0xcd, 0xf8, 0x49, 0x19, 0x6c, 0x4c, 0x13, 0xd4,
+	0x44, 0xf9, 0x26, 0x28, 0x61, 0x0f, 0x6a, 0x9c, 0xa0, 0x3d, 0x88, 0x1c, 0xca, 0x1e, 0x34, 0xf9,
+	0xe8, 0xd8, 0x83, 0xa6, 0x1e, 0xb6, 0x3d, 0xe8, 0x3b, 0x72, 0x8e, 0x20, 0x1b, 0x11, 0xa5, 0x76,
+	0x48, 0xea, 0x41, 0xd8, 0xa1, 0xf2, 0x3e, 0xfe, 0x42, 0x39, 0x97, 0xcb, 0x9b, 0x61, 0xc7, 0x88,
+	0xd9, 0xc6, 0x5f, 0x31, 0x70, 0x3a, 0xce, 0xb7, 0x8f, 0x91, 0xd4, 0xd5, 0x97, 0x2f, 0xd2, 0x43,
+	0x08, 0x69, 0x52, 0xec, 0xaa, 0x0c, 0x12, 0xbb, 0xec, 0xf7, 0x91, 0xe9, 0x24, 0xe5, 0xab, 0x2d,
+	0x7c, 0x92, 0x1f, 0x13, 0xb8, 0xd3, 0x69, 0x4f, 0x6e, 0xc8, 0x60, 0xdb, 0xaf, 0x92, 0xda, 0x0e,
+	0xf5, 0xbb, 0x62, 0x9d, 0xb6, 0xca, 0x3b, 0x4a, 0xd9, 0xbb, 0x5e, 0xa7, 0x7e, 0x97, 0x1f, 0x5b,
+	0xf8, 0x1f, 0x30, 0x52, 0x38, 0x73, 0x1b, 0xbb, 0xfd, 0x38, 0x09, 0xbb, 0xde, 0x6b, 0xd2, 0x24,
+	0xfd, 0x8d, 0x25, 0x13, 0xbe, 0x21, 0xfb, 0xe7, 0xf6, 0x1e, 0xf5, 0x13, 0x34, 0x65, 0xc6, 0x47,
+	0xc7, 0x8b, 0xd8, 0xfa, 0xde, 0x6f, 0x92, 0x63, 0xe1, 0x63, 0x49, 0xf6, 0xcf, 0xf9, 0x50, 0x3f,
+	0x41, 0x53, 0xb6, 0xf7, 0xd5, 0x66, 0x39, 0x59, 0xc6, 0xc2, 0xc9, 0xf1, 0xc0, 0x37, 0xca, 0xc2,
+	0x4d, 0xf3, 0x69, 0x52, 0x6f, 0xef, 0xb8, 0x51, 0xc2, 0x96, 0x6c, 0x43, 0xcf, 0xe2, 0x45, 0x6c,
+	0x04, 0x0e, 0xc3, 0xc0, 0x9d, 0x88, 0x6e, 0x35, 0x4f, 0xa5, 0x03, 0x77, 0x50, 0x1b, 0x88, 0xed,
+	0x4a, 0xb2, 0x9f, 0x1e, 0x18, 0xd1, 0xf5, 0x13, 0x15, 0x72, 0x31, 0xc7, 0x95, 0x1a, 0x0a, 0xbe,
+	0x1e, 0x30, 0xdd, 0x91, 0xb4, 0x5e, 0x19, 0xeb, 0x81, 0x35, 0x83, 0x84, 0xa3, 0xb3, 0xdb, 0x38,
+	0x9a, 0x45, 0x03, 0x9a, 0x34, 0x2b, 0x65, 0xdb, 0x68, 0x18, 0x5b, 0x2f, 0xf0, 0xde, 0x35, 0x0f,
+	0xa2, 0x01, 0x24, 0x5d, 0x64, 0x97, 0xde, 0x65, 0x79, 0x8e, 0xb2, 0xd1, 0x1a, 0x57, 0x78, 0x33,
+	0x48, 0x38, 0xa2, 0x8a, 0x94, 0x48, 0xcd, 0x5a, 0x1a, 0x55, 0xa4, 0x4e, 0x02, 0x09, 0x77, 0x7e,
+	0x69, 0x82, 0x9c, 0x2f, 0x5c, 0x3e, 0x28, 0xb4, 0x33, 0xb1, 0xf8, 0xaa, 0xe7, 0x53, 0x19, 0xa7,
+	0xc4, 0x84, 0xf6, 0x97, 0x54, 0x2b, 0x18, 0x18, 0xf6, 0xb7, 0x10, 0xd2, 0x93, 0xfa, 0x1a, 0xa9,
+	0xe7, 0x3b, 0xa2, 0x18, 0x8a, 0x7c, 0x28, 0x1d, 0x90, 0xe1, 0x86, 0xa7, 0xc8, 0x80, 0x41, 0x12,
+	0x23, 0x6f, 0x22, 0xea, 0x53, 0x37, 0x66, 0x31, 0xe8, 0xd9, 0x84, 0x1a, 0xa0, 0x41, 0x60, 0xe2,
+	0x61, 0x30, 0x84, 0x08, 0xe9, 0xca, 0x84, 0xb6, 0xa4, 0xc3, 0xba, 0x30, 0x09, 0xd3, 0x34, 0x26,
+	0xb2, 0xd1, 0xd4, 0x45, 0xfa, 0x8b, 0xb5, 0xa3, 0xbf, 0xe4, 0x55, 0xb3, 0x5f, 0xbd, 0x87, 0xa6,
+	0x9a, 0x63, 0xc8, 0x90, 0xc7, 0xcf, 0xbc, 0x47, 0x23, 0xb6, 0xf9, 0x8e, 0xa5, 0x3f, 0xf3, 0x4b,
+	0xbc, 0x19, 0x24, 0x1c, 0x93, 0x6a, 0xf5, 0xdc, 0x38, 0x5e, 0x8c, 0x68, 0x87, 0x06, 0x89, 0xe7,
+	0xfa, 0x3c, 0x39, 0x85, 0x91, 0x54, 0x6b, 0x3d, 0x0d, 0x86, 0x2c, 0xbe, 0xfd, 0x7e, 0xf2, 0x38,
+	0x37, 0xdf, 0xac, 0x7a, 0x71, 0xec, 0x05, 0xdb, 0x7a, 0x1a, 0x08, 0x2b, 0xd6, 0xac, 0xe8, 0xea,
+	0xf1, 0xe5, 0x62, 0x34, 0x18, 0xf4, 0x3c, 0x0b, 0x24, 0xd9, 0xf5, 0x7a, 0x8b, 0x91, 0x48, 0x21,
+	0x35, 0x61, 0x04, 0x92, 0x88, 0x76, 0x50, 0x18, 0x76, 0x9b, 0x4c, 0xf1, 0x4f, 0xc2, 0x63, 0xd2,
+	0xc4, 0x0e, 0xfa, 0xf6, 0x81, 0x52, 0x97, 0x48, 0xd9, 0x36, 0x07, 0xee, 0x9d, 0x2b, 0xd2, 0xa9,
+	0x88, 0xfb, 0x3d, 0xbc, 0x64, 0x74, 0x03, 0xa9, 0x4e, 0xd3, 0x5a, 0x81, 0xc9, 0x11, 0xb4, 0x02,
+	0xef, 0x22, 0x93, 0xbb, 0xfd, 0x4d, 0x2a, 0x46, 0xbe, 0x39, 0x95, 0x9e, 0x7d, 0x37, 0x34, 0x08,
+	0x4c, 0x3c, 0x16, 0x0e, 0xd8, 0xf3, 0xc4, 0x2f, 0xf4, 0xae, 0xd1, 0xe1, 0x80, 0xeb, 0xcb, 0xb2,
+	0x19, 0x4c, 0x1c, 0x64, 0x0d, 0xc7, 0x62, 0x83, 0xc6, 0x2c, 0xa3, 0x41, 0x2a, 0x95, 0x5b, 0x4b,
+	0x02, 0x40, 0xe3, 0xa0, 0xf1, 0x11, 0x7f, 0xb4, 0x58, 0xca, 0xba, 0x97, 0x5c, 0xdf, 0xeb, 0xf0,
+	0x28, 0x9c, 0x99, 0xb4, 0xf1, 0xb1, 0x55, 0x80, 0x03, 0x85, 0x4f, 0x3a, 0x3f, 0x5a, 0x21, 0xcd,
+	0xdc, 0xae, 0x21, 0x76, 0x2c, 0x3b, 0xc6, 0x8d, 0x2a, 0x79, 0xc9, 0x8d, 0xa4, 0xc0, 0x73, 0xc4,
+	0x0c, 0x23, 0xa2, 0xdf, 0x97, 0xdc, 0xc8, 0xdc, 0xf2, 0x18, 0x01, 0x90, 0x94, 0xec, 0x57, 0x48,
+	0x2d, 0xf1, 0xdd, 0x92, 0x52, 0x12, 0x19, 0x14, 0xb5, 0x2e, 0x79, 0x65, 0x3e, 0x06, 0x46, 0xc3,
+	0x7e, 0x02, 0xaf, 0xda, 0x9b, 0xd2, 0x0d, 0x46, 0xdc, 0x8e, 0x37, 0x63, 0x60, 0xad, 0xce, 0x0f,
+	0x9f, 0x2a, 0x38, 0x75, 0x94, 0x20, 0x80, 0x6e, 0x13, 0x38, 0x69, 0xd6, 0x23, 0xba, 0xe5, 0xdd,
+	0x15, 0x82, 0x98, 0xda, 0xd9, 0x6e, 0x2a, 0x08, 0x18, 0x58, 0xf2, 0x99, 0x56, 0x7f, 0x0b, 0x9f,
+	0xa9, 0xe4, 0x9f, 0xe1, 0x10, 0x30, 0xb0, 0xec, 0x77, 0x92, 0x31, 0xaf, 0xeb, 0x6e, 0xab, 0x48,
+	0xd5, 0x27, 0x70, 0x4b, 0x5b, 0x66, 0x2d, 0xaf, 0xdf, 0x9b, 0x9d, 0x56, 0x0c, 0xb1, 0x26, 0x10,
+	0xb8, 0xf6, 0x4f, 0x33, 0xbf, 0xf5, 0x6e, 0x37, 0x0c, 0xb8, 0x02, 0x46, 0x68, 0x93, 0x5e, 0x39,
+	0x2e, 0x31, 0x69, 0x6e, 0xd1, 0x20, 0xc6, 0xd5, 0x49, 0x86, 0x47, 0xbb, 0x06, 0x41, 0x8a, 0x2b,
+	0x73, 0xe7, 0xab, 0x0f, 0xd9, 0xf9, 0x7e, 0xd9, 0x22, 0x67, 0xf8, 0xb3, 0x86, 0x5e, 0x48, 0xa4,
+	0x09, 0x0a, 0x8f, 0xf9, 0xb5, 0x72, 0xaa, 0x32, 0x65, 0x98, 0xca, 0xc1, 0x21, 0xcf, 0xa4, 0x7d,
+	0x8d, 0x9c, 0xd9, 0x0a, 0xa3, 0x36, 0x35, 0x07, 0x42, 0x6c, 0xdb, 0xaa, 0xa3, 0xab, 0x59, 0x04,
+	0xc8, 0x3f, 0x63, 0xbf, 0x44, 0x1e, 0x33, 0x1a, 0xcd, 0x71, 0xe0, 0x3b, 0xf7, 0x53, 0xa2, 0xb7,
+	0xc7, 0xae, 0x16, 0x62, 0xc1, 0x80, 0xa7, 0xd3, 0x9b, 0x64, 0x63, 0x84, 0x4d, 0xf2, 0x23, 0xe4,
+	0x42, 0x3b, 0x3f, 0x32, 0x7b, 0x71, 0x7f, 0x33, 0xe6, 0xfb, 0xf8, 0xc4, 0xc2, 0x97, 0x89, 0x0e,
+	0x2e, 0x2c, 0x0e, 0x42, 0x84, 0xc1, 0x7d, 0xd8, 0x1f, 0x43, 0xed, 0x32, 0xfb, 0x2a, 0x32, 0x91,
+	0xdf, 0x11, 0x55, 0x53, 0x5a, 0x82, 0xe7, 0xdd, 0x9a, 0xda, 0x6a, 0x4e, 0x07, 0x14, 0x45, 0xfb,
+	0x0e, 0x19, 0xef, 0xb9, 0x49, 0x7b, 0x47, 0x64, 0xca, 0x39, 0xb2, 0x1d, 0x51, 0x11, 0x67, 0x7e,
+	0x0e, 0x46, 0x6e, 0x3d, 0x4e, 0x04, 0x24, 0x35, 0x94, 0xd5, 0xda, 0x61, 0xb7, 0x17, 0x06, 0x94,
+	0xbb, 0x68, 0x2a, 0x59, 0x6d, 0x51, 0xb5, 0x82, 0x81, 0x91, 0x3b, 0xcb, 0x35, 0x5a, 0xf3, 0xcc,
+	0x01, 0x67, 0xb9, 0xd1, 0xdb, 0xa0, 0xe7, 0xf1, 0xb0, 0x61, 0x8a, 0xe9, 0xdb, 0x5e, 0xb2, 0xc3,
+	0x7c, 0x19, 0x85, 0x6e, 0x64, 0x3a, 0x7d, 0xd8, 0xac, 0x14, 0xe0, 0x40, 0xe1, 0x93, 0xd9, 0x93,
+	0x75, 0xe6, 0xc1, 0x4e, 0xd6, 0xd3, 0x23, 0x9c, 0xac, 0x2d, 0x72, 0x9e, 0x71, 0x20, 0xa4, 0x64,
+	0xa9, 0x93, 0x8d, 0x9b, 0x36, 0x63, 0x5e, 0x25, 0x60, 0x58, 0x29, 0x42, 0x82, 0xe2, 0x67, 0x2f,
+	0x7e, 0x3d, 0x39, 0x93, 0xdb, 0xe4, 0x0e, 0xa5, 0xd2, 0x5e, 0x22, 0x8f, 0x15, 0x6f, 0x27, 0x87,
+	0x52, 0x6c, 0xff, 0x52, 0x26, 0x70, 0xda, 0xb8, 0xa2, 0x8d, 0x60, 0x24, 0x71, 0x49, 0x95, 0x06,
+	0x7b, 0xe2, 0x74, 0xbd, 0x7a, 0xb4, 0x59, 0x7d, 0x25, 0xd8, 0xe3, 0xbb, 0x21, 0x53, 0x41, 0x5d,
+	0x09, 0xf6, 0x00, 0xfb, 0xb6, 0x7f, 0xd0, 0x4a, 0x5d, 0x20, 0xaa, 0xa5, 0xa4, 0xe1, 0x2c, 0x7e,
+	0xe1, 0x91, 0xef, 0x14, 0xce, 0xbf, 0xa9, 0x90, 0x4b, 0xc3, 0x3a, 0x19, 0x61, 0xf8, 0x9e, 0xc6,
+	0xc8, 0x6d, 0x74, 0x03, 0x15, 0xc7, 0xd5, 0x24, 0xae, 0x62, 0xee, 0x18, 0xfa, 0x11, 0x10, 0x20,
+	0xdb, 0x27, 0xd5, 0xae, 0xdb, 0x13, 0xca, 0xed, 0xe5, 0xa3, 0x26, 0x98, 0x49, 0x58, 0xf2, 0xdf,
+	0x55, 0xb7, 0xc7, 0xe7, 0xbc, 0xd1, 0x00, 0x48, 0xc6, 0x4e, 0x48, 0xdd, 0x8d, 0x22, 0x57, 0xfa,
+	0x1c, 0xde, 0x28, 0x87, 0xde, 0x3c, 0x76, 0xc9, 0x5d, 0xb6, 0x52, 0x4d, 0xc0, 0x89, 0x39, 0x3f,
+	0x32, 0x91, 0xca, 0x46, 0xc2, 0x1c, 0x49, 0x63, 0x32, 0x26, 0x74, 0xda, 0x56, 0xd9, 0x79, 0x7d,
+	0x58, 0xb7, 0x5c, 0x03, 0xc1, 0xff, 0x07, 0x41, 0xca, 0xfe, 0x94, 0xc5, 0xd2, 0x2f, 0xca, 0x14,
+	0x2f, 0xcd, 0x4a, 0xc9, 0x3e, 0x8f, 0x66, 0x36, 0x48, 0x33, 0xa9, 0xa3, 0x6c, 0x04, 0x93, 0xba,
+	0x48, 0xa3, 0xca, 0x6e, 0x33, 0xf9, 0x34, 0xaa, 0xd8, 0x0c, 0x12, 0x6e, 0xdf, 0x2d, 0x70, 0x18,
+	0x2d, 0x21, 0x85, 0xdf, 0x08, 0x2e, 0xa2, 0x9f, 0xb3, 0xc8, 0x19, 0x2f, 0xeb, 0xf9, 0x27, 0xee,
+	0xc0, 0xb7, 0xcb, 0xd1, 0x69, 0xe6, 0x1d, 0x0b, 0x95, 0xa0, 0x93, 0x03, 0x41, 0x9e, 0x19, 0xbb,
+	0x43, 0x6a, 0x5e, 0xb0, 0x15, 0x0a, 0xf1, 0x6e, 0xe1, 0x68, 0x4c, 0x2d, 0x07, 0x5b, 0xa1, 0x5e,
+	0xcd, 0xf8, 0x0b, 0x58, 0xef, 0x03, 0xfd, 0x0d, 0xc7, 0x1f, 0xc8, 0xdf, 0xf0, 0x35, 0x32, 0x2e,
+	0x9d, 0x8f, 0x26, 0xca, 0xd0, 0x27, 0xe4, 0xe7, 0xbf, 0x9a, 0x4c, 0xfc, 0x77, 0x0c, 0x92, 0xa0,
+	0xfd, 0x5d, 0x16, 0x99, 0xe6, 0xff, 0x5f, 0xdf, 0xef, 0xf0, 0x1c, 0x38, 0x8d, 0x32, 0xa2, 0xb5,
+	0x5b, 0xa9, 0x3e, 0xb9, 0xee, 0x3c, 0xdd, 0x06, 0x19, 0xba, 0xce, 0x4f, 0x4f, 0x91, 0x33, 0xf3,
+	0x07, 0xfb, 0x66, 0x59, 0x27, 0xee, 0x9b, 0xf5, 0x0a, 0xa9, 0xc5, 0xda, 0x5b, 0xa8, 0x84, 0x65,
+	0x26, 0xa8, 0x6a, 0x27, 0x0b, 0xf4, 0x0b, 0x62, 0x34, 0xec, 0x88, 0x8c, 0xed, 0x30, 0xb7, 0x94,
+	0x72, 0xcc, 0x9b, 0xdc, 0xc5, 0x25, 0x9b, 0xd0, 0x86, 0xb7, 0x82, 0xa0, 0x64, 0xdf, 0x25, 0xe3,
+	0x3b, 0x7c, 0x2e, 0x8a, 0x8b, 0xde, 0xea, 0x51, 0x07, 0x37, 0x35, 0xc1, 0xf5, 0xcc, 0x13, 0x0d,
+	0x20, 0xc9, 0x31, 0xc7, 0x77, 0xc3, 0x53, 0x91, 0xef, 0x22, 0xe5, 0xe5, 0xf2, 0x19, 0xdd, 0x4d,
+	0xf1, 0xa3, 0x64, 0x2a, 0x92, 0x3e, 0x70, 0x9d, 0x79, 0x69, 0xba, 0x3c, 0x8c, 0xc3, 0x1d, 0x53,
+	0x25, 0x81, 0xd1, 0x07, 0xa4, 0x7a, 0x64, 0x8b, 0x4c, 0xa5, 0x75, 0xe3, 0x96, 0xaa, 0xf1, 0x32,
+	0x16, 0xd9, 0x5a, 0xaa, 0x4f, 0xbe, 0xc8, 0xd2, 0x6d, 0x90, 0xa1, 0x6b, 0x7f, 0x80, 0x90, 0x70,
+	0x93, 0x7b, 0xb7, 0xcf, 0x27, 0xcd, 0x89, 0x43, 0xbf, 0xea, 0x34, 0x4f, 0x05, 0x25, 0x7b, 0x00,
+	0xa3, 0x37, 0xfb, 0x06, 0x21, 0x7c, 0xd9, 0xa0, 0x41, 0xb9, 0xd9, 0x48, 0xe5, 0xe0, 0x21, 0x2d,
+	0x05, 0x79, 0xfd, 0xde, 0x6c, 0x5e, 0xe1, 0x8c, 0x00, 0x30, 0x1e, 0xb7, 0xbf, 0x89, 0x8c, 0xc7,
+	0xfd, 0x6e, 0xd7, 0x55, 0x06, 0x92, 0x12, 0xa3, 0xba, 0x79, 0xbf, 0xc6, 0xae, 0xc8, 0x1b, 0x40,
+	0x52, 0xb4, 0x5f, 0xc1, 0xfd, 0x5d, 0x6c, 0x4f, 0x7c, 0x15, 0xb1, 0xff, 0x85, 0x1a, 0xf0, 0xdd,
+	0xf2, 0x0a, 0x03, 0x05, 0x38, 0xe8, 0x22, 0x97, 0x6e, 0x5f, 0x09, 0xdb, 0x42, 0x93, 0x56, 0xd4,
+	0xa7, 0xfd, 0x02, 0x99, 0xd4, 0xaf, 0x2d, 0x13, 0xac, 0x3e, 0xa3, 0x33, 0x59, 0xb3, 0xe6, 0xc1,
+	0x63, 0x66, 0x3e, 0x6c, 0xaf, 0x92, 0xb3, 0xed, 0x30, 0x48, 0xa2, 0xd0, 0xf7, 0x79, 0x26, 0x77,
+	0xed, 0x47, 0xde, 0x58, 0x78, 0xb3, 0x60, 0xfb, 0xec, 0x62, 0x1e, 0x05, 0x8a, 0x9e, 0x43, 0x81,
+	0x3c, 0x7b, 0x38, 0x4c, 0x97, 0xe2, 0x08, 0x91, 0xea, 0x53, 0xec, 0x50, 0x4a, 0xe7, 0x3d, 0xe4,
+	0x98, 0x08, 0xd2, 0x16, 0x56, 0xf1, 0xc5, 0xde, 0x49, 0xa6, 0x30, 0x5e, 0x34, 0xc2, 0x72, 0x17,
+	0xb0, 0x22, 0xad, 0x15, 0x6c, 0x61, 0x5e, 0x31, 0xda, 0x21, 0x85, 0x85, 0x79, 0xd5, 0x84, 0x8a,
+	0xcc, 0xc8, 0xab, 0xc6, 0x55, 0x64, 0x52, 0x21, 0xe6, 0xfc, 0x62, 0x35, 0x25, 0xb0, 0x3e, 0x14,
+	0x7b, 0x2e, 0x4b, 0x52, 0x2c, 0xb3, 0x39, 0x33, 0x40, 0xb3, 0x52, 0x3a, 0x65, 0xe5, 0x79, 0xbe,
+	0x66, 0x12, 0x82, 0x34, 0x5d, 0x7b, 0x17, 0x83, 0x23, 0xe2, 0x44, 0x5e, 0xcf, 0x8e, 0x78, 0x13,
+	0xbc, 0x1e, 0xc6, 0x09, 0x93, 0xb2, 0xd4, 0x6b, 0x63, 0x0b, 0x8b, 0x8a, 0x40, 0xbd, 0xf5, 0xbb,
+	0xc8, 0x64, 0xbc, 0xe3, 0x46, 0x9d, 0x78, 0x91, 0x65, 0x41, 0xac, 0x31, 0xf1, 0x4a, 0x09, 0xd3,
+	0x2d, 0x0d, 0x02, 0x13, 0xcf, 0xf9, 0x33, 0x2b, 0x65, 0xd2, 0xba, 0xcd, 0xc2, 0xf9, 0xf6, 0x68,
+	0x80, 0x5b, 0x94, 0xe9, 0x26, 0xfc, 0x35, 0x99, 0x04, 0x61, 0x6f, 0x1b, 0x54, 0xbb, 0xe5, 0x0e,
+	0xf6, 0x30, 0xc7, 0xba, 0x30, 0x3c, 0x8a, 0x3f, 0x61, 0xa5, 0x33, 0xbd, 0x55, 0xca, 0xb8, 0xb7,
+	0x19, 0x7c, 0x0f, 0x4f, 0x1a, 0x87, 0xce, 0x6a, 0xe3, 0x0b, 0x6e, 0x7b, 0x37, 0xdc, 0xda, 0x4a,
+	0xa5, 0xd7, 0xb2, 0x86, 0xa6, 0xd7, 0x72, 0xc8, 0xd8, 0x96, 0xdb, 0x96, 0x39, 0x0f, 0xab, 0x7c,
+	0xea, 0x5f, 0x65, 0x2d, 0x20, 0x20, 0x38, 0xfc, 0x5d, 0xf7, 0xae, 0x7c, 0x38, 0x6b, 0x4f, 0x5b,
+	0xd5, 0x20, 0x30, 0xf1, 0x9c, 0xdf, 0xb4, 0x48, 0x73, 0xc1, 0x8d, 0xbd, 0x36, 0x16, 0xa2, 0x58,
+	0xf0, 0x92, 0xcd, 0x7e, 0x7b, 0x97, 0x26, 0x3c, 0x37, 0x26, 0x72, 0xd9, 0x8f, 0x69, 0x64, 0x5c,
+	0x97, 0x15, 0x97, 0xb7, 0x44, 0x3b, 0x28, 0x0c, 0xfb, 0x35, 0x32, 0xd9, 0x73, 0xe3, 0xf8, 0x4e,
+	0x18, 0x75, 0x74, 0x0e, 0x95, 0xd2, 0xea, 0x9d, 0x70, 0x57, 0x22, 0xdd, 0x3f, 0x98, 0xc4, 0x9c,
+	0xef, 0xb6, 0xc8, 0xb9, 0x05, 0xea, 0x46, 0x34, 0x62, 0xc9, 0x76, 0xd5, 0x8b, 0xd8, 0xaf, 0x92,
+	0x89, 0x04, 0x5b, 0x90, 0x23, 0xab, 0x5c, 0x8e, 0x98, 0xcf, 0xcd, 0x86, 0xe8, 0x1c, 0x14, 0x19,
+	0xe7, 0xd3, 0x16, 0xb9, 0x50, 0xc4, 0xcb, 0xa2, 0x1f, 0xf6, 0x3b, 0x0f, 0x83, 0xa1, 0xbf, 0x6d,
+	0x91, 0x29, 0x66, 0xab, 0x5f, 0xa2, 0x89, 0xeb, 0xf9, 0xb9, 0x62, 0x06, 0xd6, 0x88, 0xc5, 0x0c,
+	0x2e, 0x91, 0xda, 0x4e, 0xd8, 0xa5, 0x59, 0x3f, 0x93, 0xeb, 0x21, 0x6a, 0x4e, 0x10, 0x82, 0x5a,
+	0xbc, 0xae, 0xeb, 0x05, 0x89, 0x8b, 0xcb, 0x51, 0xda, 0x32, 0x66, 0xf8, 0x04, 0x54, 0xcd, 0x60,
+	0xe2, 0x38, 0xbf, 0xd6, 0x20, 0xe3, 0xc2, 0x83, 0x6d, 0xe4, 0x5c, 0xad, 0x52, 0x85, 0x53, 0x19,
+	0xa8, 0xc2, 0x89, 0xc9, 0x18, 0x4f, 0xaa, 0xd3, 0xac, 0x96, 0xa1, 0x30, 0x11, 0x0c, 0xf2, 0xac,
+	0x3d, 0x9a, 0x2d, 0xfe, 0x1b, 0x04, 0x29, 0xfb, 0xfb, 0x2d, 0x32, 0xd3, 0x0e, 0x83, 0x80, 0xb6,
+	0xb5, 0xec, 0x58, 0x2b, 0xc3, 0xb3, 0x6d, 0x31, 0xdd, 0xa9, 0x36, 0x03, 0x67, 0x00, 0x90, 0x25,
+	0x8f, 0xc9, 0x84, 0xf8, 0x98, 0xbd, 0x94, 0x32, 0xc0, 0xe8, 0x1c, 0xf7, 0x26, 0x10, 0xd2, 0xb8,
+	0xa8, 0xa7, 0x0e, 0x74, 0x36, 0xf9, 0x31, 0xad, 0xa7, 0x36, 0xf2, 0xc8, 0x1b, 0x18, 0x98, 0x65,
+	0x31, 0xa2, 0x5b, 0x11, 0x8d, 0x77, 0x84, 0x87, 0x1f, 0x93, 0x5b, 0xc7, 0x1f, 0x2c, 0xcb, 0x22,
+	0xe4, 0x7a, 0x82, 0x82, 0xde, 0xed, 0x5d, 0xa1, 0x43, 0x98, 0x28, 0x63, 0x3f, 0x17, 0x9f, 0x79,
+	0xa0, 0x2a, 0x61, 0x96, 0xd4, 0xd9, 0xd1, 0x25, 0x2a, 0x27, 0xb1, 0xc8, 0x40, 0x76, 0xb0, 0x01,
+	0x6f, 0xb7, 0x97, 0xc8, 0xe9, 0x4c, 0x86, 0xfe, 0x58, 0x18, 0x4a, 0x54, 0x04, 0x7b, 0x26, 0xb7,
+	0x7f, 0x0c, 0xb9, 0x27, 0x4c, 0xfd, 0xd2, 0xe4, 0x10, 0xfd, 0xd2, 0xbe, 0x72, 0x6e, 0xe7, 0x26,
+	0x8c, 0x17, 0x4b, 0x19, 0x80, 0x91, 0x3c, 0xd9, 0xbf, 0x2f, 0xe3, 0xc9, 0x7e, 0xea, 0x52, 0xf5,
+	0xe8, 0x9e, 0x36, 0x92, 0x81, 0xc3, 0xbb, 0xad, 0x3f, 0x4c, 0x37, 0xf4, 0xff, 0x65, 0x11, 0xf9,
+	0x5d, 0x17, 0xdd, 0xf6, 0x0e, 0xc5, 0x29, 0x83, 0x3e, 0x77, 0x4a, 0x35, 0xc1, 0x45, 0x22, 0x9e,
+	0x4b, 0x42, 0xc9, 0xce, 0x90, 0x82, 0x42, 0x06, 0x1b, 0xcd, 0x75, 0x38, 0x4e, 0xfc, 0x51, 0x7e,
+	0xee, 0x2b, 0xf5, 0xc7, 0xfc, 0xfa, 0xb2, 0x78, 0x4a, 0xe3, 0xd8, 0x21, 0x39, 0xe3, 0xbb, 0x71,
+	0xc2, 0x38, 0x40, 0x4d, 0xc5, 0x03, 0xe6, 0x38, 0x65, 0xf1, 0x38, 0x2b, 0xd9, 0x8e, 0x20, 0xdf,
+	0xb7, 0xf3, 0x6f, 0xeb, 0xe4, 0x54, 0x6a, 0x67, 0x3c, 0xa4, 0xc0, 0xf0, 0x55, 0x64, 0x42, 0x9e,
+	0xe1, 0xd9, 0xe0, 0x12, 0x75, 0xd0, 0x2b, 0x0c, 0x3c, 0xb4, 0x36, 0xf5, 0xa9, 0x9a, 0x15, 0x70,
+	0x8c, 0x03, 0x17, 0x4c, 0x3c, 0xb6, 0x29, 0x27, 0x7e, 0xbc, 0xe8, 0x7b, 0x34, 0x48, 0x38, 0x9b,
+	0xe5, 0x6c, 0xca, 0x1b, 0x2b, 0x2d, 0xb3, 0x53, 0xbd, 0x29, 0x67, 0x00, 0x90, 0x25, 0x8f, 0x89,
+	0xd0, 0x4e, 0xa1, 0x73, 0xac, 0x2a, 0xfd, 0xd5, 0xac, 0x97, 0x71, 0x48, 0xa5, 0xaa, 0x89, 0x71,
+	0xad, 0x7e, 0xaa, 0x09, 0xd2, 0x44, 0x31, 0x66, 0xca, 0xa6, 0x77, 0x69, 0x5b, 0x3a, 0xb0, 0x0b,
+	0x5e, 0xc6, 0xca, 0xb8, 0xc1, 0x5f, 0xc9, 0xf5, 0xcb, 0x77, 0xf5, 0x7c, 0x3b, 0x14, 0xf0, 0x60,
+	0xbf, 0x40, 0xec, 0x8e, 0x17, 0xbb, 0x9b, 0x3e, 0x9a, 0xb1, 0x65, 0x6a, 0x0f, 0x61, 0x4c, 0xbf,
+	0x28, 0xc6, 0xd9, 0x5e, 0xca, 0x61, 0x40, 0xc1, 0x53, 0x6c, 0x96, 0x45, 0xe1, 0xdd, 0xfd, 0x5b,
+	0x91, 0xdf, 0x9c, 0xc8, 0xcc, 0x32, 0xd1, 0x0e, 0x0a, 0xc3, 0xf9, 0xb9, 0xba, 0x5a, 0xca, 0x3a,
+	0x5a, 0xc3, 0x35, 0xbc, 0xc6, 0xad, 0x07, 0xf7, 0x1a, 0x57, 0x74, 0x0b, 0x3c, 0xc7, 0x53, 0xf9,
+	0x2d, 0x2a, 0x0f, 0x29, 0xbf, 0xc5, 0xb7, 0x5a, 0xa9, 0x84, 0xe9, 0x47, 0x0e, 0x9a, 0xc9, 0x0e,
+	0xe4, 0x28, 0x35, 0xf5, 0xf0, 0x7b, 0x6d, 0xf9, 0x2e, 0x4b, 0xca, 0x27, 0x4a, 0x49, 0x2a, 0x96,
+	0xaf, 0x8a, 0x76, 0x50, 0x18, 0x76, 0x92, 0x71, 0x2f, 0xab, 0x97, 0x92, 0xbb, 0x6a, 0x98, 0xbf,
+	0xd9, 0x80, 0x82, 0x8a, 0x63, 0x87, 0x2f, 0xa8, 0x78, 0x94, 0x12, 0x82, 0xff, 0xa1, 0x4a, 0x26,
+	0x0d, 0x91, 0xa5, 0x50, 0xfe, 0xb4, 0x1e, 0x31, 0xf9, 0xb3, 0x72, 0x08, 0xf9, 0xf3, 0x5b, 0x48,
+	0xa3, 0x2d, 0x8f, 0xd3, 0x72, 0xaa, 0xf4, 0x65, 0x0f, 0x69, 0x7d, 0xa2, 0xaa, 0x26, 0xd0, 0x34,
+	0xd1, 0xa5, 0xc7, 0xe8, 0x26, 0xa5, 0xd8, 0x28, 0x0a, 0x5a, 0x17, 0x47, 0x72, 0xfe, 0x99, 0xac,
+	0x77, 0x43, 0x7d, 0xb8, 0x77, 0x03, 0x16, 0x14, 0x91, 0x1f, 0xf7, 0x04, 0xf2, 0x53, 0xbe, 0x92,
+	0xce, 0x4f, 0x79, 0xa5, 0x94, 0x61, 0x1e, 0x90, 0x98, 0xf2, 0x26, 0x19, 0x47, 0x0f, 0x09, 0x37,
+	0xe8, 0xd8, 0x5f, 0x4e, 0xc6, 0xdb, 0xfc, 0x5f, 0xa1, 0x04, 0x64, 0xa6, 0x76, 0x01, 0x05, 0x09,
+	0x43, 0x17, 0x3e, 0x37, 0xda, 0x96, 0x8a, 0x3f, 0xe6, 0xc2, 0x37, 0x1f, 0x6d, 0xc7, 0xc0, 0x5a,
+	0x9d, 0x7f, 0x5c, 0x23, 0xcc, 0x73, 0xc6, 0x8d, 0x68, 0x67, 0x23, 0x64, 0x85, 0x67, 0x8e, 0xd5,
+	0x40, 0xad, 0x6f, 0xa5, 0x8f, 0xb2, 0x91, 0xda, 0x30, 0x54, 0x56, 0x4f, 0xda, 0x50, 0x59, 0x6c,
+	0x7b, 0xae, 0x3d, 0x42, 0xb6, 0x67, 0xe7, 0x7b, 0x2d, 0x62, 0x2b, 0x3f, 0x28, 0xed, 0x1c, 0x72,
+	0x99, 0x34, 0x94, 0xe3, 0x95, 0x90, 0x60, 0xf5, 0x16, 0x21, 0x01, 0xa0, 0x71, 0x46, 0x50, 0x45,
+	0x3c, 0x2d, 0xf7, 0xef, 0x6a, 0x3a, 0x7a, 0x82, 0xed, 0xfa, 0x62, 0x3b, 0x77, 0x7e, 0xbd, 0x42,
+	0x1e, 0xe3, 0xb2, 0x0f, 0x4f, 0xef, 0xd2, 0x45, 0xae, 0x46, 0x75, 0xf7, 0x69, 0xe3, 0x1d, 0xd8,
+	0x93, 0xb1, 0x0e, 0x47, 0x5d, 0xbb, 0x7c, 0xcd, 0xf1, 0x55, 0xb6, 0x1c, 0x78, 0x09, 0xb0, 0xce,
+	0xed, 0x98, 0x4c, 0xc8, 0x4c, 0x0f, 0xcd, 0x6a, 0x99, 0x84, 0xd4, 0xb6, 0x24, 0x13, 0x4c, 0x80,
+	0x22, 0x84, 0xb2, 0x80, 0x1f, 0xb6, 0x77, 0x81, 0xf6, 0xc2, 0xac, 0x2c, 0xb0, 0x22, 0xda, 0x41,
+	0x61, 0x38, 0x5d, 0x32, 0x23, 0xc7, 0xb0, 0x27, 0x2a, 0x1f, 0xbf, 0x97, 0x9c, 0x52, 0xc9, 0x95,
+	0x8d, 0xaa, 0xba, 0xea, 0xfc, 0x59, 0x34, 0x81, 0x90, 0xc6, 0x95, 0xb5, 0x68, 0x2a, 0xc5, 0xb5,
+	0x68, 0x9c, 0x5f, 0xb7, 0x48, 0xf6, 0x00, 0x34, 0x2a, 0x6f, 0x58, 0x07, 0x56, 0xde, 0x38, 0x44,
+	0xed, 0x8a, 0x0f, 0x91, 0x49, 0x37, 0x41, 0x11, 0x8d, 0xab, 0x53, 0xaa, 0x0f, 0x66, 0x06, 0x5c,
+	0x0d, 0x3b, 0xde, 0x96, 0x87, 0x3d, 0x80, 0xd9, 0x9d, 0xf3, 0x59, 0x8b, 0x34, 0x96, 0xa2, 0xfd,
+	0xc3, 0x07, 0x9d, 0xe5, 0x43, 0xca, 0x2a, 0x87, 0x0a, 0x29, 0x1b, 0x9e, 0x2b, 0xe0, 0x7f, 0xd6,
+	0xc8, 0x99, 0x5c, 0xc8, 0xab, 0xfd, 0x7c, 0x26, 0x55, 0x37, 0xe7, 0x73, 0x94, 0xc4, 0xda, 0xc3,
+	0x97, 0xea, 0x00, 0xb1, 0xae, 0xfa, 0x00, 0x75, 0xb2, 0x7b, 0xe4, 0x94, 0x6f, 0x0a, 0xff, 0xcd,
+	0xda, 0x83, 0xdf, 0x1b, 0xd4, 0x6c, 0x4d, 0x35, 0x43, 0x9a, 0x40, 0xfa, 0x06, 0x51, 0x7f, 0x48,
+	0x37, 0x88, 0x6f, 0xd3, 0x37, 0x08, 0xee, 0xd5, 0xf3, 0xc1, 0x92, 0x43, 0x9e, 0x8f, 0xbb, 0x2c,
+	0xf7, 0x8b, 0x64, 0x42, 0x7a, 0x3c, 0x8e, 0xe4, 0x29, 0x68, 0xf6, 0x33, 0x60, 0x6f, 0x7f, 0x2b,
+	0x79, 0xcb, 0x95, 0x28, 0x32, 0x06, 0xf3, 0x66, 0x98, 0xcc, 0xfb, 0x7e, 0x78, 0x07, 0xc5, 0x95,
+	0x5b, 0x31, 0x15, 0x4a, 0x3d, 0xe7, 0xf5, 0x0a, 0x29, 0xb8, 0x1f, 0xe3, 0x9a, 0xd4, 0x32, 0x52,
+	0x6a, 0x4d, 0x1e, 0x4e, 0x4e, 0xb2, 0xef, 0x72, 0xaf, 0x50, 0x2e, 0x0d, 0xbc, 0xbf, 0xec, 0xfb,
+	0xbd, 0x76, 0x14, 0x55, 0x3b, 0xa5, 0x72, 0x16, 0x7d, 0x96, 0x10, 0x2d, 0xda, 0x8a, 0xc0, 0x2d,
+	0xe5, 0xe9, 0xa1, 0x25, 0x60, 0x30, 0xb0, 0x50, 0xdd, 0xe3, 0x05, 0x71, 0xe2, 0xfa, 0xfe, 0x75,
+	0x2f, 0x48, 0x84, 0xde, 0x5a, 0x89, 0x3d, 0xcb, 0x1a, 0x04, 0x26, 0xde, 0xc5, 0x77, 0x1b, 0xdf,
+	0xef, 0x30, 0xdf, 0x7d, 0x87, 0x5c, 0xb8, 0xe6, 0x25, 0x2a, 0xdc, 0x50, 0xcd, 0x37, 0x94, 0x5c,
+	0xd5, 0x5e, 0x65, 0x0d, 0x0c, 0xb0, 0x35, 0xc2, 0xfd, 0x2a, 0xe9, 0xe8, 0xc4, 0x6c, 0xb8, 0x9f,
+	0xf3, 0x3c, 0x39, 0x77, 0xcd, 0x4b, 0x30, 0x94, 0xea, 0x90, 0x44, 0x9c, 0x5f, 0x1d, 0x27, 0x53,
+	0x66, 0x1e, 0x84, 0xc3, 0x6c, 0xd7, 0x98, 0x51, 0x49, 0x06, 0x93, 0x7a, 0xca, 0x24, 0x7d, 0xfb,
+	0xc8, 0x49, 0x19, 0x8a, 0x47, 0xcc, 0x90, 0x4f, 0x35, 0x4d, 0x30, 0x19, 0xb0, 0xef, 0x90, 0xfa,
+	0x16, 0x0b, 0x47, 0xab, 0x96, 0xe1, 0x4c, 0x54, 0x34, 0xa2, 0x7a, 0x39, 0xf2, 0x80, 0x36, 0x4e,
+	0x2f, 0x95, 0xd2, 0xa6, 0x36, 0x34, 0xa5, 0xcd, 0x80, 0x23, 0xa1, 0xfe, 0x00, 0x47, 0x42, 0x6a,
+	0x83, 0x1e, 0x7b, 0x48, 0x1b, 0x34, 0x0b, 0x2d, 0x4c, 0x76, 0x98, 0xc4, 0x2b, 0xa2, 0x9a, 0xc6,
+	0xd9, 0x20, 0x18, 0xa1, 0x85, 0x29, 0x30, 0x64, 0xf1, 0xed, 0x8f, 0xab, 0x2d, 0x7e, 0xa2, 0x0c,
+	0x95, 0xbf, 0x39, 0xa3, 0x47, 0x52, 0x10, 0xa1, 0x91, 0x25, 0x0c, 0x12, 0x29, 0xb7, 0x33, 0xb1,
+	0x8e, 0x3b, 0x30, 0x69, 0x23, 0x4b, 0x06, 0x0e, 0xb9, 0x27, 0xb8, 0x1c, 0xd1, 0xed, 0x7a, 0xbc,
+	0x49, 0x9a, 0x69, 0x52, 0xe1, 0x4c, 0x12, 0x06, 0x29, 0xcc, 0xa3, 0x9c, 0x2e, 0xdf, 0x5b, 0x21,
+	0xd3, 0xd7, 0x82, 0xfe, 0xfa, 0xb5, 0xf5, 0xfe, 0xa6, 0xef, 0xb5, 0x6f, 0xd0, 0x7d, 0x3c, 0x42,
+	0x76, 0xe9, 0xfe, 0xf2, 0x92, 0x58, 0xc1, 0x6a, 0xce, 0xde, 0xc0, 0x46, 0xe0, 0x30, 0xdc, 0x0c,
+	0xb7, 0xbc, 0x60, 0x9b, 0x46, 0xbd, 0xc8, 0x13, 0xd6, 0x00, 0x63, 0x33, 0xbc, 0xaa, 0x41, 0x60,
+	0xe2, 0x61, 0xdf, 0xe1, 0x9d, 0x80, 0x46, 0xd9, 0xab, 0xc7, 0x1a, 0x36, 0x02, 0x87, 0x21, 0x52,
+	0x12, 0xf5, 0x85, 0xb2, 0xcd, 0x40, 0xda, 0xc0, 0x46, 0xe0, 0x30, 0xdc, 0x69, 0xe2, 0xfe, 0x26,
+	0xf3, 0x15, 0xcb, 0x84, 0x70, 0xb5, 0x78, 0x33, 0x48, 0x38, 0xa2, 0xee, 0xd2, 0xfd, 0x25, 0xd4,
+	0x53, 0x64, 0xe2, 0x5c, 0x6f, 0xf0, 0x66, 0x90, 0x70, 0x56, 0x8a, 0x23, 0x3d, 0x1c, 0x5f, 0x74,
+	0xa5, 0x38, 0xd2, 0xec, 0x0f, 0xd0, 0x78, 0xfc, 0xad, 0x0a, 0x99, 0x32, 0x3d, 0x3c, 0xed, 0xed,
+	0xcc, 0x35, 0x61, 0x2d, 0x57, 0x1b, 0xee, 0xeb, 0x34, 0x57, 0x97, 0x25, 0x57, 0x97, 0xb7, 0xbd,
+	0x24, 0xec, 0xc5, 0x6f, 0xa7, 0xc1, 0xb6, 0x17, 0x50, 0xe6, 0xec, 0xc2, 0x3d, 0x43, 0x53, 0xee,
+	0xa3, 0x8b, 0x61, 0x87, 0x3e, 0xc8, 0x3d, 0xe3, 0x61, 0xd4, 0x96, 0xbd, 0x4d, 0xce, 0xe4, 0x02,
+	0xaa, 0x47, 0x10, 0xbb, 0x86, 0x26, 0xbc, 0x70, 0x80, 0x4c, 0x62, 0xc7, 0x32, 0xa1, 0xef, 0x22,
+	0x39, 0xc3, 0x37, 0x0f, 0xa4, 0xc4, 0xe2, 0x63, 0x55, 0x90, 0x3c, 0x33, 0x77, 0xbd, 0x94, 0x05,
+	0x42, 0x1e, 0x1f, 0x2b, 0x97, 0x9e, 0x4a, 0xc5, 0xb8, 0x97, 0x24, 0x20, 0xb2, 0xd5, 0x1d, 0x32,
+	0x27, 0x67, 0x16, 0x74, 0x52, 0x65, 0x3b, 0x91, 0x5e, 0xdd, 0x1a, 0x04, 0x26, 0x9e, 0xf3, 0x83,
+	0x15, 0x32, 0x21, 0x7d, 0xb2, 0x46, 0x60, 0xe5, 0x53, 0x16, 0x39, 0xa5, 0x4c, 0x8c, 0xf8, 0x8c,
+	0x58, 0x00, 0x37, 0x8f, 0xee, 0x15, 0xa6, 0x94, 0x32, 0xa8, 0x52, 0x55, 0xb7, 0x15, 0x30, 0x89,
+	0x41, 0x9a, 0xb6, 0xfd, 0x12, 0x06, 0x46, 0xc4, 0x09, 0xed, 0x1a, 0xca, 0x5d, 0xc7, 0x98, 0x65,
+	0x73, 0xed, 0x30, 0xa2, 0x38, 0xa7, 0xd0, 0x93, 0xad, 0xa5, 0x30, 0xb5, 0xd8, 0xa8, 0xdb, 0xc0,
+	0xe8, 0xc9, 0xf9, 0x85, 0x0a, 0x39, 0x9d, 0x65, 0xc9, 0xfe, 0x20, 0x7a, 0x0d, 0xeb, 0x82, 0xf7,
+	0x19, 0x8f, 0xb2, 0x29, 0x30, 0x60, 0xaf, 0xdf, 0x9b, 0x9d, 0xd5, 0x9e, 0x65, 0x97, 0x91, 0x8b,
+	0xcb, 0x7b, 0x86, 0xf3, 0x1d, 0x8e, 0x67, 0xaa, 0x33, 0x6e, 0xe7, 0x15, 0x0e, 0x09, 0x0b, 0xfb,
+	0xf3, 0xbd, 0x9e, 0x30, 0xd6, 0x1a, 0x76, 0x5e, 0x13, 0x0a, 0x19, 0x6c, 0x0c, 0xc1, 0x33, 0x5a,
+	0x6e, 0x52, 0x6f, 0x7b, 0x67, 0x33, 0x8c, 0xe4, 0xad, 0xf3, 0x09, 0xed, 0xbf, 0x9a, 0xc7, 0x81,
+	0xc2, 0x27, 0x51, 0xc2, 0x69, 0xbb, 0x3d, 0xb7, 0x8d, 0xe9, 0x81, 0xb8, 0xb6, 0x5a, 0xed, 0x87,
+	0x8b, 0xa2, 0x1d, 0x14, 0x86, 0xf3, 0x93, 0x35, 0x72, 0x9a, 0x3b, 0x6c, 0x52, 0xe5, 0x8f, 0x6c,
+	0x7f, 0x90, 0x34, 0xe2, 0xc4, 0x8d, 0xb8, 0xca, 0xc1, 0x3a, 0xf4, 0x1e, 0xa0, 0x23, 0xdc, 0x65,
+	0x27, 0xa0, 0xfb, 0x43, 0xbf, 0xe6, 0x2d, 0x2f, 0xf0, 0xe2, 0x1d, 0xd6, 0x7b, 0xe5, 0xc1, 0x14,
+	0x1a, 0x57, 0x55, 0x0f, 0x60, 0xf4, 0x66, 0x7f, 0x2d, 0xa9, 0xf7, 0x76, 0xdc, 0x58, 0x6a, 0xdb,
+	0xde, 0x2a, 0x17, 0xdc, 0x3a, 0x36, 0xa2, 0x67, 0x6e, 0xf6, 0x55, 0x19, 0x00, 0xf8, 0x43, 0xe6,
+	0x76, 0x59, 0x1b, 0x5e, 0x63, 0xb5, 0x13, 0xed, 0xb7, 0xae, 0xcf, 0x67, 0xab, 0x72, 0x2e, 0xb1,
+	0x56, 0x10, 0x50, 0x5c, 0xdc, 0x3b, 0x9c, 0x64, 0x07, 0x91, 0xc7, 0xd2, 0x47, 0xf7, 0x75, 0x0d,
+	0x02, 0x13, 0x0f, 0xd3, 0x16, 0x66, 0xdd, 0x79, 0xc7, 0x8f, 0x21, 0xd6, 0x63, 0x54, 0x47, 0xde,
+	0x2b, 0xa4, 0xc1, 0xff, 0xa7, 0x1b, 0x21, 0x8a, 0x4e, 0x5c, 0x99, 0xb3, 0x10, 0xb9, 0x41, 0x7b,
+	0x27, 0xab, 0x82, 0xd9, 0x30, 0x60, 0x90, 0xc2, 0x74, 0x56, 0x49, 0x6d, 0xc4, 0xdd, 0x6a, 0xa4,
+	0x9b, 0xf5, 0x8b, 0x64, 0x02, 0xbb, 0x93, 0xd7, 0xa7, 0x32, 0xba, 0x0c, 0xc9, 0x84, 0xac, 0xd8,
+	0x6f, 0x3b, 0xa4, 0xea, 0xb9, 0xd2, 0x6d, 0x43, 0x2d, 0xa1, 0xe5, 0x38, 0xee, 0xb3, 0x69, 0x87,
+	0x40, 0xfb, 0x69, 0x52, 0xa5, 0x77, 0x7b, 0x59, 0xff, 0x8c, 0x2b, 0x77, 0x7b, 0x5e, 0x44, 0x63,
+	0x44, 0xa2, 0x77, 0x7b, 0xf6, 0x45, 0x52, 0xf1, 0x3a, 0x62, 0x46, 0x12, 0x81, 0x53, 0x59, 0x5e,
+	0x82, 0x8a, 0xd7, 0x71, 0xee, 0x92, 0x86, 0x24, 0xc8, 0x1c, 0x76, 0xb9, 0x6c, 0x62, 0x95, 0xe1,
+	0xb0, 0x2b, 0xfb, 0x1d, 0x20, 0x95, 0xf4, 0x09, 0xd1, 0xa9, 0x13, 0xca, 0x3a, 0xcb, 0x2e, 0x91,
+	0x5a, 0x3b, 0x14, 0x49, 0x6f, 0x26, 0x74, 0x37, 0x4c, 0x28, 0x61, 0x10, 0xe7, 0x36, 0x99, 0xbe,
+	0x11, 0x84, 0x77, 0x58, 0x25, 0x5f, 0x96, 0xb4, 0x1f, 0x3b, 0xde, 0xc2, 0x7f, 0xb2, 0x22, 0x30,
+	0x83, 0x02, 0x87, 0xa9, 0xa4, 0xc1, 0x95, 0x41, 0x49, 0x83, 0x9d, 0x4f, 0x58, 0x64, 0x4a, 0xc5,
+	0x60, 0x5f, 0xdb, 0xdb, 0xc5, 0x7e, 0xb7, 0xa3, 0xb0, 0xdf, 0xcb, 0xf6, 0x7b, 0x0d, 0x1b, 0x81,
+	0xc3, 0xcc, 0xe4, 0x04, 0x95, 0x21, 0xc9, 0x09, 0x2e, 0x91, 0xda, 0xae, 0x17, 0x74, 0xb2, 0x2a,
+	0xcb, 0x1b, 0x5e, 0xd0, 0x01, 0x06, 0x41, 0x16, 0x4e, 0x2b, 0x16, 0xa4, 0xf0, 0xf1, 0x3c, 0x99,
+	0xda, 0xec, 0x7b, 0x7e, 0x47, 0xfc, 0xce, 0x2e, 0x97, 0x05, 0x03, 0x06, 0x29, 0x4c, 0xd4, 0x9b,
+	0x6c, 0x7a, 0x81, 0x1b, 0xed, 0xaf, 0x6b, 0x69, 0x47, 0x1d, 0x80, 0x0b, 0x0a, 0x02, 0x06, 0x96,
+	0xf3, 0x99, 0x2a, 0x99, 0x4e, 0x47, 0xa2, 0x8f, 0xa0, 0xbe, 0x78, 0x9a, 0xd4, 0x59, 0x70, 0x7a,
+	0xf6, 0xd3, 0xb2, 0xe7, 0x81, 0xc3, 0xd0, 0xa7, 0x92, 0x2f, 0x66, 0x71, 0x5c, 0xaf, 0x95, 0x14,
+	0x2e, 0xaf, 0xf4, 0x9c, 0xcc, 0xad, 0x59, 0xa8, 0x8d, 0x05, 0x29, 0xf4, 0x95, 0x19, 0x0f, 0x7b,
+	0x66, 0xae, 0xd4, 0xf7, 0x97, 0x19, 0xa5, 0x2f, 0x42, 0x61, 0xc5, 0x8d, 0x53, 0x7d, 0x7a, 0xf9,
+	0x39, 0x24, 0xe9, 0x8b, 0xef, 0x21, 0x53, 0x26, 0xe6, 0xb0, 0x4b, 0xdf, 0x84, 0x79, 0xe9, 0xfb,
+	0x94, 0x39, 0x29, 0x44, 0x1e, 0x82, 0x11, 0x96, 0xdb, 0x2d, 0x52, 0x6f, 0x2b, 0xdf, 0xaf, 0x07,
+	0xaa, 0x61, 0xa3, 0xf2, 0x74, 0x61, 0x37, 0xc0, 0x7b, 0x43, 0xbb, 0xf2, 0xb4, 0xc1, 0x4d, 0xbc,
+	0xdc, 0xb1, 0x23, 0x52, 0xdd, 0xde, 0xdb, 0x15, 0xc7, 0xfc, 0x0b, 0x25, 0x0d, 0xef, 0xb5, 0xbd,
+	0x5d, 0x3d, 0xc7, 0xcd, 0x56, 0x40, 0x62, 0x23, 0x28, 0xe3, 0x53, 0xe9, 0x2a, 0xaa, 0xc3, 0xd3,
+	0x55, 0x38, 0x9f, 0xad, 0x90, 0x33, 0xb9, 0x49, 0x65, 0xbf, 0x46, 0xea, 0x11, 0xbe, 0x65, 0xd3,
+	0x2a, 0xe3, 0xf8, 0x4c, 0x8f, 0x9c, 0x3e, 0x3e, 0xd3, 0xed, 0xc0, 0x49, 0xa2, 0x1b, 0x93, 0xf6,
+	0x50, 0x54, 0x96, 0x00, 0xfe, 0xca, 0xca, 0x8d, 0x69, 0x3e, 0x87, 0x01, 0x05, 0x4f, 0xa1, 0x25,
+	0x2b, 0x6d, 0x50, 0xc8, 0x94, 0x05, 0x3d, 0xc8, 0x36, 0xe0, 0xfc, 0x8b, 0x0a, 0x39, 0x95, 0xca,
+	0x12, 0x6b, 0xfb, 0x64, 0x82, 0xfa, 0xcc, 0xcc, 0x28, 0x0f, 0x9b, 0x23, 0xfb, 0xcc, 0xc8, 0x03,
+	0xf2, 0x8a, 0xe8, 0x17, 0x14, 0x85, 0x47, 0xc3, 0xbb, 0xe9, 0x79, 0x32, 0x25, 0x19, 0x7a, 0xbf,
+	0xdb, 0xf5, 0xc5, 0x00, 0xaa, 0x39, 0x7a, 0xc5, 0x80, 0x41, 0x0a, 0xd3, 0xf9, 0x8d, 0x2a, 0x69,
+	0x0e, 0xaa, 0x6c, 0x89, 0xc5, 0x36, 0xa4, 0x0f, 0x2e, 0x1f, 0xc8, 0xcd, 0xe3, 0x29, 0xa1, 0x39,
+	0x92, 0x53, 0xee, 0x8f, 0x67, 0x9c, 0x72, 0xf9, 0x15, 0x6f, 0xfb, 0x98, 0x38, 0xfa, 0xe2, 0xf2,
+	0xd2, 0xfd, 0xfb, 0x15, 0x32, 0x93, 0x29, 0x85, 0x8d, 0x69, 0xe2, 0xcc, 0x12, 0x57, 0x56, 0x19,
+	0x36, 0xab, 0x03, 0xcb, 0x99, 0x1e, 0xae, 0xd0, 0xd5, 0x43, 0x5a, 0x2a, 0xce, 0x1f, 0x54, 0xc8,
+	0x74, 0xba, 0x86, 0xf7, 0x23, 0x38, 0x52, 0x5f, 0x49, 0x1a, 0xac, 0xae, 0xe4, 0x0d, 0xba, 0x2f,
+	0x4d, 0x5e, 0xbc, 0x6c, 0x9b, 0x6c, 0x04, 0x0d, 0x7f, 0x24, 0xea, 0x87, 0x39, 0xff, 0xc0, 0x22,
+	0xe7, 0xf9, 0x5b, 0x66, 0xe7, 0xe1, 0x0f, 0x14, 0x8d, 0xee, 0xcb, 0xe5, 0x32, 0x98, 0xc9, 0x41,
+	0x3e, 0x6c, 0x7c, 0x51, 0x52, 0x38, 0x27, 0xb8, 0x4d, 0x4f, 0x85, 0x47, 0x90, 0xd9, 0x43, 0x4d,
+	0x06, 0xe7, 0x1f, 0x8e, 0x93, 0x29, 0x33, 0xbd, 0xf2, 0x61, 0x0c, 0x69, 0x97, 0x49, 0x23, 0x71,
+	0xb7, 0xaf, 0x7a, 0x7e, 0x42, 0xa3, 0x6c, 0x01, 0x82, 0x0d, 0x09, 0x00, 0x8d, 0x83, 0xe6, 0x8a,
+	0x98, 0x76, 0xf7, 0x98, 0x9d, 0x34, 0x4e, 0x22, 0x17, 0x15, 0xf8, 0xd5, 0xb4, 0xb9, 0xa2, 0x95,
+	0x81, 0x43, 0xee, 0x89, 0x94, 0x67, 0x7d, 0xed, 0xb0, 0xa1, 0x78, 0xf5, 0x13, 0x0c, 0xc5, 0xb3,
+	0x13, 0x32, 0xe6, 0xde, 0x89, 0xaf, 0x2c, 0x42, 0x39, 0x9e, 0xe4, 0xe6, 0x77, 0x9a, 0xbf, 0xdd,
+	0xba, 0xb2, 0x08, 0xfc, 0x9e, 0xc0, 0xff, 0x07, 0x41, 0x0b, 0xc7, 0xc7, 0x0b, 0x62, 0xda, 0xee,
+	0x47, 0x54, 0xf8, 0x89, 0xeb, 0x0b, 0xbb, 0x68, 0x07, 0x85, 0x31, 0xc8, 0xaa, 0x37, 0x71, 0x54,
+	0xab, 0x5e, 0xe3, 0x21, 0x89, 0x36, 0xda, 0x24, 0x47, 0xca, 0x30, 0xc9, 0x99, 0x63, 0x3e, 0x92,
+	0x49, 0x4e, 0x65, 0x08, 0x9e, 0x1c, 0x9c, 0x21, 0xf8, 0x28, 0x76, 0xb3, 0x0f, 0x13, 0x3b, 0x3f,
+	0x0f, 0x50, 0x05, 0x17, 0xd1, 0x6d, 0x1d, 0xc1, 0xa8, 0xb8, 0x03, 0xd6, 0x0a, 0x02, 0x8a, 0x77,
+	0x8d, 0x28, 0xf4, 0x73, 0x77, 0x0d, 0x08, 0x7d, 0x0a, 0x0c, 0xe2, 0xfc, 0x41, 0x95, 0x34, 0xb4,
+	0xf2, 0xd3, 0x13, 0x79, 0x44, 0x4a, 0x29, 0xce, 0x80, 0xd1, 0x32, 0xaa, 0x6b, 0xee, 0x93, 0x61,
+	0xa4, 0x11, 0xf9, 0x4e, 0x0b, 0xdd, 0x1c, 0xbc, 0xc4, 0x73, 0x99, 0x0e, 0xb7, 0x59, 0x29, 0x65,
+	0xc9, 0x48, 0x72, 0xcb, 0xbc, 0xe7, 0x30, 0x32, 0x1d, 0x27, 0x14, 0x31, 0x30, 0x29, 0xdb, 0x1f,
+	0x15, 0x81, 0x74, 0xd5, 0xd2, 0x92, 0xf1, 0x4c, 0x64, 0xa2, 0xe7, 0x7a, 0x78, 0x13, 0x4b, 0xa2,
+	0x92, 0x72, 0x58, 0x01, 0x76, 0xa5, 0xaa, 0x37, 0xa9, 0x19, 0xc7, 0x9a, 0x81, 0x13, 0x72, 0x62,
+	0x62, 0xe7, 0xc7, 0xe2, 0x90, 0x41, 0x4a, 0x18, 0x86, 0xd5, 0x4f, 0xc2, 0x2e, 0x0e, 0x93, 0xf0,
+	0xed, 0xd0, 0x61, 0x58, 0x12, 0x00, 0x1a, 0xc7, 0xf9, 0x4c, 0x9d, 0x64, 0x12, 0x7b, 0xd8, 0x77,
+	0x49, 0x43, 0xa5, 0xf6, 0x28, 0x27, 0xe8, 0x57, 0xcf, 0x28, 0xc5, 0x8c, 0x6a, 0x02, 0x4d, 0xcc,
+	0xde, 0x96, 0xea, 0x70, 0x3e, 0xf7, 0x5f, 0xcc, 0xaa, 0xc3, 0xbf, 0x61, 0x34, 0x33, 0x23, 0xce,
+	0xd5, 0xcb, 0x3c, 0x8f, 0xe3, 0xdc, 0x50, 0xcd, 0x79, 0x75, 0x88, 0xe6, 0xfc, 0x93, 0xa2, 0x8a,
+	0x2a, 0xd0, 0xb8, 0xef, 0xcb, 0x9a, 0x69, 0x2f, 0x96, 0xb8, 0xca, 0x78, 0xc7, 0x3a, 0x3b, 0x16,
+	0xff, 0x0d, 0x06, 0xd1, 0xb4, 0x7d, 0x63, 0xec, 0x58, 0xed, 0x1b, 0xe3, 0xa5, 0xda, 0x37, 0x9e,
+	0x25, 0x84, 0xcd, 0x6d, 0x1e, 0x8b, 0xc0, 0x0f, 0x2c, 0x25, 0x1b, 0x81, 0x82, 0x80, 0x81, 0xe5,
+	0x7c, 0x35, 0x49, 0xa7, 0x77, 0xc3, 0x38, 0x56, 0x9e, 0x4d, 0x8e, 0x9b, 0x40, 0x59, 0x1c, 0x6b,
+	0x2a, 0xf1, 0xdb, 0x2f, 0x5b, 0xc4, 0xcc, 0x41, 0x67, 0xbf, 0xca, 0x93, 0xdd, 0x59, 0x65, 0xb8,
+	0xea, 0x18, 0xfd, 0xce, 0xad, 0xba, 0xbd, 0x8c, 0xcf, 0x98, 0xcc, 0x78, 0x87, 0x8e, 0x5c, 0x12,
+	0x7a, 0xa8, 0xa3, 0xe2, 0xe3, 0xe4, 0xac, 0xcc, 0x89, 0x21, 0x8d, 0x76, 0xc2, 0xcd, 0x62, 0xb8,
+	0x2e, 0x58, 0x2a, 0x78, 0x2b, 0x83, 0x14, 0xbc, 0x4a, 0x6d, 0x55, 0x1d, 0x98, 0xc6, 0xfe, 0x9f,
+	0x5b, 0xe4, 0x52, 0x96, 0x81, 0x78, 0x35, 0x0c, 0xbc, 0x24, 0x8c, 0x5a, 0x34, 0x49, 0xbc, 0x60,
+	0x9b, 0xe5, 0x24, 0xbe, 0xe3, 0x46, 0xb2, 0x34, 0x1c, 0xdb, 0x28, 0x6f, 0xbb, 0x51, 0x00, 0xac,
+	0x15, 0x83, 0x7a, 0xb9, 0xc3, 0xba, 0xb8, 0xbe, 0x1f, 0x71, 0x6d, 0x14, 0x0c, 0x87, 0x3e, 0x2a,
+	0xb9, 0xb3, 0x3c, 0x08, 0x82, 0xce, 0xe7, 0x2d, 0x62, 0xaf, 0xed, 0xd1, 0x28, 0xf2, 0x3a, 0x86,
+	0x8b, 0x3d, 0xab, 0x46, 0x6e, 0x54, 0x1d, 0x37, 0x33, 0xb6, 0x64, 0xaa, 0x91, 0x1b, 0xbf, 0x8a,
+	0xab, 0x91, 0x57, 0x0e, 0x57, 0x8d, 0xdc, 0x5e, 0x23, 0xe7, 0x45, 0x7d, 0x53, 0x5e, 0xe1, 0x97,
+	0x2b, 0x23, 0x54, 0x72, 0x81, 0x0b, 0x98, 0xe1, 0x73, 0xb5, 0x08, 0x01, 0x8a, 0x9f, 0x73, 0xde,
+	0x4d, 0x6c, 0xee, 0x59, 0xbf, 0x58, 0xe4, 0x1c, 0x3c, 0x50, 0x1f, 0xeb, 0xfc, 0x58, 0x9d, 0xcc,
+	0x64, 0x4a, 0xcc, 0xa0, 0xee, 0x27, 0xef, 0x8d, 0x7c, 0xe4, 0xf3, 0x3b, 0xcf, 0xde, 0x48, 0xfe,
+	0xcd, 0x01, 0xa9, 0x7b, 0x41, 0xaf, 0x9f, 0x94, 0x93, 0xdb, 0x84, 0x33, 0xb1, 0x8c, 0x1d, 0x1a,
+	0xf6, 0x23, 0xfc, 0x09, 0x9c, 0x4c, 0x99, 0xde, 0xd2, 0x29, 0x21, 0xba, 0xf6, 0x90, 0x84, 0xe8,
+	0x4f, 0x6a, 0xdf, 0xe5, 0x7a, 0x19, 0x96, 0x86, 0xcc, 0x64, 0x39, 0x6e, 0xcf, 0xe5, 0x5f, 0xac,
+	0x90, 0x49, 0xe3, 0xa3, 0xd9, 0x3f, 0x91, 0xce, 0xd0, 0x6a, 0x95, 0xf7, 0x4a, 0xac, 0xff, 0x39,
+	0x9d, 0x83, 0x95, 0xbf, 0xd2, 0x5b, 0xf3, 0xc9, 0x59, 0x5f, 0xbf, 0x37, 0x7b, 0x3a, 0x93, 0x7e,
+	0x35, 0x95, 0xb0, 0xf5, 0xe2, 0x37, 0x93, 0x99, 0x4c, 0x37, 0x05, 0xaf, 0xbc, 0x61, 0xbe, 0xf2,
+	0x91, 0xf5, 0xd4, 0xe6, 0x90, 0xfd, 0x3c, 0x0e, 0x99, 0x48, 0xa9, 0x10, 0xfa, 0x74, 0x04, 0xa3,
+	0x4c, 0x26, 0x73, 0x4a, 0x65, 0xc4, 0xcc, 0x29, 0x58, 0xb0, 0x29, 0xf4, 0xbd, 0xb6, 0xa7, 0x12,
+	0xbc, 0xf3, 0x82, 0x4d, 0xa2, 0x0d, 0x14, 0xd4, 0xbe, 0x43, 0x1a, 0xaf, 0xdc, 0x49, 0xb8, 0x39,
+	0xb8, 0x59, 0x2b, 0xd5, 0x0a, 0xac, 0x84, 0x16, 0xd9, 0x12, 0x83, 0xa6, 0x85, 0x39, 0x86, 0xd8,
+	0x21, 0x28, 0xa3, 0x13, 0xd9, 0x25, 0x9b, 0x9d, 0x8e, 0x31, 0x08, 0x88, 0xf3, 0x67, 0x84, 0x9c,
+	0x2b, 0xaa, 0xf3, 0x65, 0x7f, 0x8c, 0x8c, 0x71, 0x1e, 0xcb, 0xa9, 0xbd, 0x59, 0x44, 0xe3, 0x1a,
+	0xeb, 0x50, 0xb0, 0xc5, 0xfe, 0x07, 0x41, 0x53, 0x50, 0xf7, 0xdd, 0xcd, 0x66, 0xe5, 0x18, 0xa9,
+	0xaf, 0xb8, 0x9a, 0xfa, 0x8a, 0xcb, 0xa9, 0xfb, 0xee, 0xa6, 0x7d, 0x97, 0xd4, 0xb7, 0xbd, 0x84,
+	0xba, 0x42, 0xab, 0x78, 0xfb, 0x58, 0x88, 0x53, 0x97, 0x4b, 0x69, 0xec, 0x5f, 0xe0, 0x04, 0x31,
+	0xcc, 0x6e, 0x66, 0x33, 0x9d, 0xb2, 0x49, 0x6c, 0x9e, 0x6e, 0xf9, 0x4c, 0x64, 0x72, 0x43, 0xf1,
+	0x72, 0xfc, 0x99, 0x46, 0xc8, 0xb2, 0x83, 0xf1, 0x20, 0xe3, 0x5b, 0x4c, 0x0f, 0x26, 0x37, 0xd5,
+	0x63, 0xf8, 0x38, 0x5c, 0xd1, 0xa6, 0x6f, 0x1c, 0xfc, 0x77, 0x0c, 0x92, 0x72, 0x89, 0xe1, 0xda,
+	0xe9, 0x93, 0x6a, 0xfc, 0x21, 0x9d, 0x54, 0xdf, 0x65, 0x91, 0x86, 0x1a, 0x69, 0x91, 0xfa, 0xe6,
+	0x83, 0xc7, 0xf8, 0xc9, 0xb9, 0x2a, 0x55, 0xfd, 0x04, 0x4d, 0x1c, 0x63, 0xce, 0x27, 0xdd, 0xd7,
+	0xfa, 0x11, 0xed, 0xd0, 0xbd, 0xb0, 0x17, 0x0b, 0x0d, 0xd8, 0xcb, 0xe5, 0x33, 0x33, 0x8f, 0x44,
+	0x96, 0xe8, 0xde, 0x5a, 0x2f, 0x16, 0x91, 0xd3, 0xba, 0x01, 0x4c, 0x16, 0x30, 0x59, 0x69, 0x5a,
+	0x1b, 0xf6, 0xe1, 0xf2, 0xb9, 0x39, 0xee, 0xc3, 0xfc, 0x5e, 0x85, 0xcc, 0x0e, 0x19, 0x05, 0xb4,
+	0x67, 0x86, 0xd1, 0xb6, 0x1b, 0x78, 0xaf, 0x99, 0x79, 0xe4, 0x94, 0xa4, 0xb8, 0x66, 0xc0, 0x20,
+	0x85, 0x69, 0x26, 0x18, 0xaa, 0x0c, 0x49, 0x30, 0x84, 0xba, 0x33, 0xda, 0x0b, 0xb3, 0x17, 0x1e,
+	0x16, 0x79, 0xc9, 0x20, 0x18, 0x25, 0xe9, 0xf6, 0x3c, 0xa1, 0x94, 0x56, 0xf7, 0xb8, 0xf9, 0xf5,
+	0x65, 0xc0, 0xf6, 0x54, 0xbe, 0xb3, 0xfa, 0x89, 0xe4, 0x3b, 0xc3, 0xa3, 0x4c, 0x18, 0x64, 0xc7,
+	0xf4, 0x51, 0x96, 0x36, 0x94, 0x3a, 0x9f, 0xad, 0x92, 0x27, 0x0f, 0x9c, 0xf3, 0xda, 0x79, 0xde,
+	0x3a, 0xc0, 0x79, 0x5e, 0x0e, 0x4f, 0x65, 0xd8, 0xf0, 0x54, 0x07, 0x0c, 0xcf, 0xb7, 0xe1, 0x52,
+	0x96, 0xf9, 0xf7, 0xc4, 0xee, 0x7d, 0x44, 0xed, 0xed, 0xa0, 0x74, 0x7e, 0x62, 0x15, 0x4b, 0x28,
+	0x68, 0xba, 0x78, 0x8f, 0x49, 0x25, 0xd7, 0xa9, 0x97, 0x71, 0x94, 0x0d, 0xcc, 0x81, 0xc7, 0xd7,
+	0xef, 0xa0, 0x8c, 0x3d, 0xce, 0xaf, 0xd4, 0xc8, 0xd3, 0x23, 0x9c, 0x40, 0xe6, 0x2c, 0xb6, 0x46,
+	0x9c, 0xc5, 0x5f, 0xe4, 0x9f, 0xe9, 0x3b, 0x0a, 0x3f, 0x13, 0x94, 0xff, 0x99, 0x0e, 0xfe, 0x42,
+	0x29, 0x63, 0xcb, 0xd8, 0x50, 0x63, 0x4b, 0x40, 0xea, 0x6d, 0x17, 0x97, 0xff, 0x78, 0x49, 0xb9,
+	0x48, 0xcc, 0x08, 0x6f, 0x2e, 0x16, 0x2d, 0xce, 0xe3, 0x0e, 0xc0, 0xc9, 0x38, 0x3f, 0x6c, 0x91,
+	0x8b, 0x83, 0xc5, 0x04, 0xcc, 0xc5, 0xb1, 0xc9, 0xbc, 0x51, 0x57, 0x99, 0xc7, 0x9b, 0x98, 0x3a,
+	0xec, 0x7d, 0x75, 0x33, 0x98, 0x38, 0xa8, 0xc8, 0x30, 0xdd, 0x58, 0x57, 0x0d, 0x57, 0x39, 0xa6,
+	0xc8, 0xd8, 0xc8, 0x02, 0x21, 0x8f, 0xef, 0x7c, 0xa1, 0x5a, 0xcc, 0x16, 0x17, 0x27, 0x0f, 0x33,
+	0x9b, 0xc5, 0x5c, 0xad, 0x8c, 0xb0, 0xe3, 0x56, 0x4f, 0x7a, 0xc7, 0xad, 0x0d, 0xda, 0x71, 0xd1,
+	0x0e, 0x6a, 0x14, 0xff, 0xe5, 0xd9, 0x69, 0xea, 0x69, 0x3b, 0xe8, 0x7a, 0x06, 0x0e, 0xb9, 0x27,
+	0x1e, 0xf1, 0xa9, 0xf7, 0x93, 0x15, 0x72, 0x61, 0xa0, 0x04, 0x7f, 0x42, 0x27, 0x8a, 0xf9, 0xf9,
+	0x6b, 0x27, 0xf3, 0xf9, 0xcd, 0x8f, 0x52, 0x1f, 0xf6, 0x51, 0x9c, 0x3f, 0xac, 0x0c, 0x5c, 0x08,
+	0x78, 0x9b, 0xfb, 0x92, 0x1d, 0xa5, 0xf7, 0x92, 0x53, 0x6e, 0xaf, 0xc7, 0xf1, 0x58, 0x18, 0x4a,
+	0x26, 0x17, 0xe7, 0xbc, 0x09, 0x84, 0x34, 0xee, 0x48, 0x32, 0xcd, 0x9f, 0x5a, 0xa4, 0x01, 0x74,
+	0x8b, 0xef, 0x46, 0x58, 0x0d, 0x81, 0x0d, 0x91, 0x55, 0x46, 0x35, 0x04, 0x1c, 0xd8, 0xd8, 0x63,
+	0x55, 0x02, 0x8a, 0x06, 0xfb, 0xa8, 0xc9, 0x20, 0x94, 0xfd, 0xb8, 0x3a, 0xd8, 0x7e, 0xec, 0xfc,
+	0xf7, 0x09, 0x7c, 0xbd, 0x5e, 0x88, 0x65, 0x2e, 0x63, 0xfc, 0xbe, 0xfd, 0xc8, 0x6f, 0x5a, 0xe9,
+	0xef, 0x8b, 0xae, 0x1a, 0xd8, 0x9e, 0x32, 0xf2, 0x55, 0x0e, 0x95, 0x89, 0xb0, 0x3a, 0x34, 0x13,
+	0x21, 0x26, 0xb5, 0x8a, 0x77, 0xd6, 0x23, 0x6f, 0xcf, 0x4d, 0x50, 0x9b, 0xde, 0xac, 0xa5, 0x3f,
+	0x64, 0xab, 0x75, 0x5d, 0x03, 0x21, 0x8d, 0x8b, 0x39, 0xa5, 0x74, 0x3e, 0x40, 0x1a, 0x25, 0x2c,
+	0x50, 0x92, 0xcf, 0x04, 0x95, 0xc1, 0x46, 0x67, 0x10, 0x14, 0x08, 0x90, 0x7f, 0x06, 0xf7, 0xd3,
+	0x54, 0x23, 0x32, 0x32, 0x96, 0xde, 0x4f, 0x53, 0xfd, 0x20, 0x2f, 0xb9, 0x27, 0x30, 0x0b, 0x3d,
+	0x9f, 0x18, 0xf3, 0xbd, 0x9e, 0xf1, 0x46, 0xe3, 0xe9, 0x2c, 0xf4, 0xd7, 0xf2, 0x28, 0x50, 0xf4,
+	0x1c, 0xea, 0xc7, 0x54, 0xf3, 0xf2, 0x92, 0xb0, 0x4f, 0x29, 0xfd, 0x98, 0xea, 0x66, 0xb9, 0x03,
+	0x26, 0x1e, 0x56, 0x38, 0xd3, 0x3f, 0x79, 0x34, 0x3f, 0x37, 0xda, 0x2e, 0x89, 0x54, 0xab, 0xaa,
+	0xc2, 0xd9, 0xb5, 0x42, 0xb4, 0x0e, 0x0c, 0x7a, 0xde, 0xde, 0x24, 0x17, 0x15, 0xe8, 0x4a, 0x90,
+	0xb0, 0xd0, 0xd8, 0x98, 0x2e, 0xb8, 0x31, 0xc5, 0x84, 0x80, 0x84, 0xbd, 0xa7, 0x23, 0x7a, 0xbf,
+	0x78, 0xcd, 0x4b, 0xae, 0x17, 0x61, 0xc2, 0x0a, 0x1c, 0xd0, 0x0b, 0xda, 0x88, 0x69, 0xe0, 0x6e,
+	0xfa, 0x74, 0x6d, 0x71, 0xb9, 0x39, 0x99, 0xb6, 0x11, 0x5f, 0x91, 0x00, 0xd0, 0x38, 0x2a, 0x98,
+	0x61, 0x6a, 0x50, 0x30, 0x03, 0x46, 0x85, 0x6d, 0xb7, 0x7b, 0x28, 0x11, 0x7a, 0x6d, 0x2a, 0xca,
+	0xa8, 0xe3, 0x87, 0xe1, 0xe5, 0x01, 0x54, 0x54, 0xd8, 0xb5, 0xc5, 0xf5, 0x1c, 0x0e, 0x14, 0x3e,
+	0x89, 0x6b, 0x8c, 0x65, 0x39, 0x6c, 0x9e, 0xcd, 0xf8, 0xf8, 0x63, 0x23, 0x70, 0x18, 0x7a, 0x2c,
+	0xb3, 0x10, 0xc3, 0xeb, 0x49, 0xd2, 0x53, 0x22, 0x68, 0xf3, 0x5c, 0x3a, 0xf1, 0xe2, 0xd5, 0x1c,
+	0x06, 0x14, 0x3c, 0x85, 0x12, 0x4d, 0x10, 0xb2, 0xde, 0x9b, 0x8f, 0xa7, 0x25, 0x9a, 0x9b, 0xbc,
+	0x19, 0x24, 0xdc, 0xfe, 0x10, 0x69, 0xf6, 0x63, 0xca, 0x2e, 0xb7, 0xb7, 0xc3, 0x68, 0xd7, 0x0f,
+	0xdd, 0xce, 0x32, 0x2b, 0x65, 0x9b, 0xec, 0x37, 0x9b, 0x8c, 0xf8, 0x25, 0xf1, 0x6c, 0xf3, 0xd6,
+	0x00, 0x3c, 0x18, 0xd8, 0x43, 0x36, 0x73, 0xe8, 0x85, 0xd1, 0x32, 0x87, 0x3a, 0x7f, 0x62, 0x91,
+	0x53, 0x6a, 0xbf, 0x39, 0x81, 0xc0, 0x64, 0x3f, 0x1d, 0x98, 0x7c, 0xed, 0xe8, 0x3b, 0x36, 0xe3,
+	0x7c, 0x40, 0xf4, 0xcf, 0xbf, 0x9c, 0x22, 0x44, 0xef, 0xea, 0xea, 0x40, 0xb5, 0x06, 0x1e, 0xa8,
+	0x8f, 0xec, 0x8e, 0x5a, 0x94, 0xf6, 0xb0, 0xfe, 0x70, 0xd3, 0x1e, 0xb6, 0xc8, 0x79, 0x29, 0xee,
+	0x70, 0x2b, 0x2a, 0x86, 0xa4, 0xca, 0x0d, 0xda, 0x28, 0x4d, 0xb8, 0x5c, 0x84, 0x04, 0xc5, 0xcf,
+	0x1e, 0xd2, 0xc5, 0x4d, 0xed, 0x49, 0x2b, 0x5b, 0xb2, 0x70, 0x68, 0x66, 0x4f, 0x5a, 0xb9, 0xda,
+	0x02, 0x8d, 0x53, 0x7c, 0x30, 0x35, 0x4a, 0x3a, 0x98, 0xc8, 0xa1, 0x0f, 0x26, 0xb9, 0x45, 0x4e,
+	0x0e, 0xdc, 0x22, 0xa5, 0xb5, 0x66, 0x6a, 0xa0, 0xb5, 0xe6, 0x7d, 0x64, 0xda, 0x0b, 0x76, 0x68,
+	0xe4, 0x25, 0xb4, 0xc3, 0xd6, 0x02, 0xdb, 0x3e, 0x27, 0xb4, 0x58, 0xb2, 0x9c, 0x82, 0x42, 0x06,
+	0x3b, 0xbd, 0xaf, 0x4f, 0x8f, 0xb0, 0xaf, 0x0f, 0x38, 0x4d, 0x67, 0xca, 0x39, 0x4d, 0x4f, 0x1f,
+	0xfd, 0x34, 0x3d, 0x73, 0xac, 0xa7, 0xa9, 0x5d, 0xca, 0x69, 0x3a, 0xd2, 0x41, 0x65, 0x5c, 0x97,
+	0xcf, 0x0d, 0xb9, 0x2e, 0x0f, 0x3a, 0x4a, 0xcf, 0x3f, 0xf0, 0x51, 0x5a, 0x7c, 0x4a, 0x3e, 0xf6,
+	0xd7, 0xf2, 0x94, 0xfc, 0xae, 0x0a, 0x39, 0xaf, 0xcf, 0x11, 0x5c, 0xbd, 0xde, 0x16, 0xee, 0xa4,
+	0xac, 0x76, 0x36, 0xb7, 0xc8, 0x1a, 0x31, 0xf7, 0x3a, 0x7c, 0x5f, 0x41, 0xc0, 0xc0, 0x62, 0xa1,
+	0xeb, 0x34, 0x62, 0x85, 0x5b, 0xb2, 0x87, 0xcc, 0xa2, 0x68, 0x07, 0x85, 0x81, 0x2c, 0xe3, 0xff,
+	0x22, 0x05, 0x49, 0x36, 0x25, 0xf8, 0xa2, 0x06, 0x81, 0x89, 0x87, 0xd6, 0xd8, 0xb6, 0xdc, 0xe0,
+	0xf0, 0xa0, 0x99, 0xe2, 0x57, 0x36, 0xb5, 0xa7, 0x29, 0xa8, 0x64, 0x87, 0xe5, 0x28, 0xa8, 0xe7,
+	0xd9, 0xc1, 0x76, 0x50, 0x18, 0xce, 0x5f, 0x59, 0xe4, 0x42, 0xe1, 0x50, 0x9c, 0x80, 0xf0, 0x70,
+	0x37, 0x2d, 0x3c, 0xb4, 0xca, 0xba, 0xee, 0x19, 0x6f, 0x31, 0x40, 0x90, 0xf8, 0x63, 0x8b, 0x4c,
+	0x6b, 0xfc, 0x13, 0x78, 0x55, 0x2f, 0xfd, 0xaa, 0xe5, 0xdd, 0x6c, 0x1b, 0xb9, 0x77, 0xfb, 0x8d,
+	0x0a, 0x51, 0x69, 0xfa, 0xe7, 0xdb, 0xb2, 0x08, 0xca, 0x10, 0x1f, 0x81, 0x7d, 0x32, 0xd6, 0xe3,
+	0x39, 0x85, 0x4a, 0x71, 0xdf, 0x4a, 0xd3, 0x67, 0xee, 0x12, 0xda, 0xe2, 0xc4, 0x7e, 0xc6, 0x20,
+	0x08, 0xb2, 0xb2, 0x42, 0x3c, 0x03, 0x7a, 0x47, 0x44, 0x60, 0xeb, 0xb2, 0x42, 0xa2, 0x1d, 0x14,
+	0x06, 0x1e, 0x6f, 0x5e, 0x3b, 0x0c, 0x16, 0x7d, 0x37, 0x8e, 0x85, 0xc4, 0xa5, 0x8e, 0xb7, 0x65,
+	0x09, 0x00, 0x8d, 0xc3, 0xbc, 0x1f, 0xbc, 0xb8, 0xe7, 0xbb, 0xfb, 0x86, 0xfe, 0xc2, 0x48, 0xf5,
+	0xa5, 0x40, 0x60, 0xe2, 0x39, 0x5d, 0xd2, 0x4c, 0xbf, 0xc4, 0x12, 0xdd, 0x62, 0xae, 0xc7, 0x23,
+	0x0d, 0x27, 0x3a, 0xe0, 0xb2, 0xa7, 0x56, 0xfa, 0x6e, 0x36, 0xe0, 0x62, 0x5e, 0x02, 0x40, 0xe3,
+	0x38, 0x3f, 0x67, 0x91, 0xb3, 0x05, 0x83, 0x56, 0x62, 0x84, 0x7b, 0xa2, 0x77, 0x9b, 0x22, 0xc1,
+	0xe4, 0x2b, 0xc8, 0x78, 0x87, 0x6e, 0xb9, 0xd2, 0xb9, 0xd5, 0xd8, 0xd2, 0x97, 0x78, 0x33, 0x48,
+	0x38, 0x06, 0x66, 0xce, 0xa4, 0x79, 0x8d, 0x59, 0xd4, 0x28, 0x1f, 0x26, 0x2f, 0x6e, 0x87, 0x7b,
+	0x34, 0xda, 0xc7, 0x37, 0xb7, 0x32, 0x51, 0xa3, 0x39, 0x0c, 0x28, 0x78, 0x8a, 0x15, 0xe9, 0xe8,
+	0xa8, 0xd1, 0x96, 0x33, 0xf2, 0xa5, 0x32, 0x67, 0xa4, 0xfe, 0x98, 0xc6, 0x54, 0xd0, 0x24, 0xc1,
+	0xa4, 0x8f, 0x02, 0x12, 0x0b, 0xc3, 0xc1, 0xa0, 0xf7, 0xc4, 0x0b, 0xc4, 0x2b, 0x8b, 0xb9, 0xaa,
+	0x04, 0xa4, 0xd5, 0x3c, 0x0a, 0x14, 0x3d, 0xe7, 0x7c, 0xbe, 0x46, 0x54, 0xf6, 0x16, 0xe6, 0xa8,
+	0x58, 0x92, 0x9b, 0xe7, 0x61, 0x63, 0x8f, 0xd5, 0xdc, 0xaa, 0x1d, 0xe4, 0x39, 0xc4, 0x95, 0x5e,
+	0xa6, 0xe6, 0x5b, 0x0d, 0xd8, 0x86, 0x06, 0x81, 0x89, 0x87, 0x9c, 0xf8, 0xde, 0x1e, 0xe5, 0x0f,
+	0x8d, 0xa5, 0x39, 0x59, 0x91, 0x00, 0xd0, 0x38, 0xc8, 0x49, 0xc7, 0xdb, 0xda, 0x6a, 0x8e, 0xa7,
+	0x39, 0xc1, 0xd1, 0x01, 0x06, 0xe1, 0x65, 0x9c, 0xc2, 0x5d, 0x71, 0x29, 0x30, 0xca, 0x38, 0x85,
+	0xbb, 0xc0, 0x20, 0xf8, 0x95, 0x82, 0x30, 0xea, 0xba, 0xbe, 0xf7, 0x1a, 0xed, 0x28, 0x2a, 0xe2,
+	0x32, 0xa0, 0xbe, 0xd2, 0xcd, 0x3c, 0x0a, 0x14, 0x3d, 0x87, 0x13, 0xba, 0x17, 0xd1, 0x8e, 0xd7,
+	0x4e, 0xcc, 0xde, 0x48, 0x7a, 0x42, 0xaf, 0xe7, 0x30, 0xa0, 0xe0, 0x29, 0xcc, 0x5f, 0x27, 0xb3,
+	0xef, 0xc8, 0x7c, 0x92, 0x93, 0xe9, 0xfc, 0x75, 0x90, 0x06, 0x43, 0x16, 0x1f, 0x37, 0xc9, 0xae,
+	0xc8, 0x86, 0xdb, 0x9c, 0x4a, 0x6f, 0x92, 0x32, 0x4b, 0x2e, 0x28, 0x0c, 0xe7, 0x93, 0x55, 0x3c,
+	0xd4, 0x07, 0x24, 0x9d, 0x3e, 0x31, 0xb7, 0xe2, 0xf4, 0x8c, 0xac, 0x8d, 0x30, 0x23, 0xd1, 0x65,
+	0x37, 0x0e, 0x03, 0xe5, 0xb2, 0x5b, 0x1f, 0xe8, 0xb2, 0x6b, 0x60, 0x15, 0xbb, 0xec, 0x8e, 0x95,
+	0xe5, 0xb2, 0x3b, 0xfe, 0x80, 0x2e, 0xbb, 0xbf, 0x53, 0x27, 0xaa, 0x4e, 0xe7, 0x4d, 0x9a, 0xdc,
+	0x09, 0xa3, 0x5d, 0x2f, 0xd8, 0x66, 0x99, 0x64, 0x3e, 0x67, 0xc9, 0x64, 0x34, 0x2b, 0x66, 0x0c,
+	0xf6, 0x56, 0x49, 0xb5, 0x16, 0x53, 0xc4, 0xe6, 0x36, 0x0c, 0x42, 0xdc, 0xf5, 0x23, 0x93, 0xf4,
+	0x86, 0x83, 0x20, 0xc5, 0x91, 0xfd, 0xcd, 0x84, 0x48, 0x75, 0xf7, 0x96, 0xdc, 0x81, 0x97, 0xcb,
+	0xe1, 0x0f, 0xcd, 0x0d, 0x4a, 0xa4, 0xde, 0x50, 0x44, 0xc0, 0x20, 0x88, 0xce, 0x42, 0xd2, 0x74,
+	0xc0, 0x63, 0x7b, 0x3e, 0x7a, 0x2c, 0x63, 0x33, 0x4a, 0x74, 0x3a, 0x90, 0x71, 0x2f, 0xd8, 0xc6,
+	0x79, 0x22, 0x5c, 0x1b, 0xdf, 0x56, 0x94, 0xf1, 0x6b, 0x25, 0x74, 0x3b, 0x0b, 0xae, 0xef, 0x06,
+	0x6d, 0xac, 0x6b, 0xc1, 0xd0, 0xf5, 0x09, 0x2a, 0x1a, 0x40, 0x76, 0x94, 0x2b, 0x26, 0x5a, 0x1f,
+	0xa5, 0x98, 0xe8, 0xc5, 0xaf, 0x27, 0x67, 0x72, 0x1f, 0xf3, 0x50, 0xc1, 0xe8, 0x0f, 0x1e, 0xc7,
+	0xee, 0xfc, 0xca, 0x98, 0x3e, 0xb4, 0x30, 0xbb, 0x19, 0xab, 0x4d, 0x19, 0xe9, 0x2f, 0x2a, 0x44,
+	0xe6, 0x12, 0xa7, 0x88, 0x3a, 0x66, 0x8c, 0x46, 0x30, 0x49, 0xe2, 0x1c, 0xed, 0xb9, 0x11, 0x0d,
+	0x8e, 0x7b, 0x8e, 0xae, 0x2b, 0x22, 0x60, 0x10, 0xb4, 0x77, 0x52, 0xc1, 0x67, 0x57, 0x8f, 0x1e,
+	0x7c, 0xc6, 0xf2, 0xbf, 0x16, 0x95, 0x70, 0xfb, 0x7e, 0x8b, 0x4c, 0x07, 0xa9, 0x99, 0x5b, 0x8e,
+	0xbf, 0x79, 0xf1, 0xaa, 0xe0, 0x65, 0x9e, 0xd3, 0x6d, 0x90, 0xa1, 0x5f, 0x74, 0xa4, 0xd5, 0x0f,
+	0x79, 0xa4, 0xe9, 0xda, 0xb8, 0x63, 0x83, 0x6a, 0xe3, 0xda, 0x81, 0xaa, 0x58, 0x3e, 0x5e, 0x7a,
+	0xc5, 0x72, 0x52, 0x50, 0xad, 0xfc, 0x36, 0x69, 0xb4, 0x23, 0xea, 0x26, 0x0f, 0x58, 0xbc, 0x9a,
+	0x79, 0xc1, 0x2c, 0xca, 0x0e, 0x40, 0xf7, 0xe5, 0xfc, 0xef, 0x1a, 0x39, 0x2d, 0x47, 0x44, 0xc6,
+	0xaa, 0xe0, 0xf9, 0xc8, 0xe9, 0x6a, 0x59, 0x59, 0x9d, 0x8f, 0xd7, 0x25, 0x00, 0x34, 0x0e, 0xca,
+	0x63, 0xfd, 0x18, 0xd3, 0xc0, 0x05, 0x2b, 0xde, 0x66, 0x2c, 0xcc, 0xd6, 0x6a, 0xa1, 0xdc, 0xd2,
+	0x20, 0x30, 0xf1, 0x50, 0xb6, 0x77, 0x0d, 0xa1, 0xd5, 0x90, 0xed, 0xa5, 0xa0, 0x2a, 0xe1, 0xf6,
+	0x8f, 0x16, 0x56, 0xc1, 0x28, 0x27, 0xc2, 0x33, 0x17, 0xa2, 0x73, 0xb8, 0xf2, 0x17, 0xf6, 0xcf,
+	0x58, 0xe4, 0x3c, 0x6f, 0x95, 0x23, 0x79, 0xab, 0xd7, 0x71, 0x13, 0x1a, 0x37, 0xc7, 0x8e, 0x89,
+	0x3f, 0xad, 0xf3, 0x2e, 0x22, 0x0b, 0xc5, 0xdc, 0x60, 0xd6, 0x89, 0x99, 0xdd, 0x54, 0xb6, 0x30,
+	0x79, 0x74, 0x1c, 0x35, 0x91, 0x4f, 0xaa, 0x53, 0xbd, 0xd4, 0xd2, 0xed, 0x31, 0x64, 0xa9, 0x3b,
+	0xff, 0xc3, 0x22, 0xe6, 0x36, 0x7a, 0xf2, 0x49, 0xc6, 0x0e, 0x2f, 0x0a, 0x4a, 0xe9, 0xb2, 0x3e,
+	0x50, 0xba, 0x44, 0x63, 0xba, 0xd7, 0x69, 0x8e, 0x65, 0x8c, 0xe9, 0xcb, 0x4b, 0x80, 0xed, 0xce,
+	0x3f, 0xab, 0x6b, 0x35, 0x88, 0x08, 0xa0, 0xfc, 0x92, 0x78, 0xed, 0x2d, 0x95, 0x86, 0x97, 0xbf,
+	0xf9, 0xcd, 0x5c, 0x1a, 0xde, 0xaf, 0x3d, 0x7c, 0x7c, 0x2c, 0x1f, 0xa0, 0x41, 0x59, 0x78, 0xc7,
+	0x87, 0x04, 0xc7, 0xbe, 0x42, 0x26, 0xf0, 0x0a, 0xc6, 0xf4, 0x99, 0x13, 0x29, 0xa6, 0x26, 0xae,
+	0x8b, 0xf6, 0xd7, 0xef, 0xcd, 0xbe, 0xe7, 0xf0, 0x6c, 0xc9, 0xa7, 0x41, 0xf5, 0x6f, 0xc7, 0xa4,
+	0x81, 0xff, 0xb3, 0x38, 0x5e, 0x71, 0xb9, 0xbb, 0xa5, 0xf6, 0x4c, 0x09, 0x28, 0x25, 0x48, 0x58,
+	0xd3, 0xb1, 0x03, 0xd2, 0x40, 0x44, 0x4e, 0x94, 0xdf, 0x01, 0xd7, 0x25, 0xd1, 0x96, 0x04, 0xbc,
+	0x7e, 0x6f, 0xf6, 0xbd, 0x87, 0x27, 0xaa, 0x1e, 0x07, 0x4d, 0xc2, 0xf9, 0x3f, 0x35, 0x3d, 0x77,
+	0x45, 0xf6, 0xe5, 0x2f, 0x89, 0xb9, 0xfb, 0x7c, 0x66, 0xee, 0x5e, 0xca, 0xcd, 0xdd, 0x69, 0x1c,
+	0x8f, 0x82, 0x9c, 0xd0, 0x27, 0x2d, 0x08, 0x0c, 0xd7, 0x37, 0x30, 0x09, 0xe8, 0xd5, 0xbe, 0x17,
+	0xd1, 0x78, 0x3d, 0xea, 0x07, 0x98, 0x04, 0xb9, 0xc1, 0x90, 0x0d, 0x09, 0x28, 0x05, 0x86, 0x2c,
+	0x3e, 0x5e, 0xea, 0xf1, 0x9b, 0xdf, 0x76, 0xf7, 0xf8, 0xac, 0x32, 0x12, 0x76, 0xb6, 0x44, 0x3b,
+	0x28, 0x0c, 0x7b, 0x87, 0x3c, 0x21, 0x3b, 0x58, 0xa2, 0x3e, 0xc5, 0x17, 0x62, 0xce, 0x7d, 0x51,
+	0xd7, 0x4d, 0xa4, 0x4a, 0x61, 0x62, 0xe1, 0x2d, 0xa2, 0x87, 0x27, 0xe0, 0x00, 0x5c, 0x38, 0xb0,
+	0x27, 0xe7, 0xe7, 0x99, 0x13, 0x81, 0x91, 0xaa, 0x00, 0x67, 0x9f, 0xef, 0x75, 0x3d, 0x99, 0x57,
+	0x54, 0xcd, 0xbe, 0x15, 0x6c, 0x04, 0x0e, 0xb3, 0xef, 0x90, 0xf1, 0x4d, 0x5e, 0x2a, 0xbe, 0x9c,
+	0xaa, 0x4e, 0xa2, 0xee, 0x3c, 0x4b, 0xce, 0x2d, 0x8b, 0xd0, 0xbf, 0xae, 0xff, 0x05, 0x49, 0xcd,
+	0xf9, 0xfd, 0x3a, 0x99, 0x91, 0x6e, 0x59, 0xd7, 0xbd, 0x98, 0xf9, 0x06, 0x98, 0x15, 0x13, 0x2a,
+	0x43, 0x2b, 0x26, 0x7c, 0x98, 0x90, 0x0e, 0xed, 0xf9, 0xe1, 0x3e, 0x13, 0xfc, 0x6a, 0x87, 0x16,
+	0xfc, 0xd4, 0x5d, 0x61, 0x49, 0xf5, 0x02, 0x46, 0x8f, 0x22, 0x99, 0x2a, 0x2f, 0xc0, 0x90, 0x49,
+	0xa6, 0x6a, 0xd4, 0x7e, 0x1b, 0x3b, 0xd9, 0xda, 0x6f, 0x1e, 0x99, 0xe1, 0x2c, 0xaa, 0x84, 0x00,
+	0x0f, 0x10, 0xf7, 0xcf, 0x42, 0xaa, 0x96, 0xd2, 0xdd, 0x40, 0xb6, 0x5f, 0xb3, 0xb0, 0xdb, 0xc4,
+	0x49, 0x17, 0x76, 0xfb, 0x4a, 0xd2, 0x90, 0xdf, 0x19, 0x43, 0x7d, 0x54, 0x96, 0x25, 0x39, 0x0d,
+	0x62, 0xd0, 0xf0, 0x5c, 0x6e, 0x13, 0xf2, 0xb0, 0x72, 0x9b, 0x38, 0x9f, 0xae, 0xe0, 0x8d, 0x81,
+	0xf3, 0xa5, 0xf2, 0xf6, 0xbd, 0x95, 0x8c, 0xb9, 0xfd, 0x64, 0x27, 0xcc, 0x15, 0x9b, 0x9f, 0x67,
+	0xad, 0x20, 0xa0, 0xf6, 0x0a, 0xa9, 0x75, 0x74, 0x2e, 0xb6, 0xc3, 0x7c, 0x4f, 0xad, 0x7c, 0x75,
+	0x13, 0x0a, 0xac, 0x17, 0x8c, 0xfc, 0x4f, 0xdc, 0x6d, 0x19, 0x05, 0xca, 0x22, 0xff, 0x37, 0x5c,
+	0x2c, 0xd1, 0x83, 0xad, 0x87, 0xc9, 0x3f, 0x8d, 0x2e, 0x33, 0xde, 0x76, 0xe0, 0x26, 0xe8, 0x27,
+	0xa2, 0xed, 0x93, 0xda, 0x65, 0xc6, 0x04, 0x42, 0x1a, 0xd7, 0xf9, 0xd5, 0x29, 0x72, 0xae, 0xb5,
+	0xb8, 0x2a, 0x2b, 0xf8, 0x1c, 0x5b, 0x20, 0x67, 0x11, 0x8d, 0x93, 0x0b, 0xe4, 0x1c, 0x40, 0xdd,
+	0x37, 0x02, 0x39, 0x7d, 0x23, 0x90, 0x33, 0x1d, 0x55, 0x57, 0x2d, 0x23, 0xaa, 0xae, 0x88, 0x83,
+	0x51, 0xa2, 0xea, 0x8e, 0x2d, 0xb2, 0xf3, 0x40, 0x86, 0x0e, 0x15, 0xd9, 0xa9, 0xc2, 0x5e, 0x4b,
+	0x89, 0x15, 0x1a, 0xf0, 0xa9, 0x0a, 0xc3, 0x5e, 0x55, 0xc8, 0x21, 0x8f, 0x83, 0x6b, 0x8e, 0x95,
+	0x11, 0x72, 0x58, 0xc4, 0xc0, 0x08, 0x21, 0x87, 0xfc, 0x47, 0x2a, 0xcc, 0x75, 0xbc, 0x8c, 0x30,
+	0xd7, 0x22, 0x76, 0x86, 0x86, 0xb9, 0x62, 0xb1, 0x43, 0x3f, 0x0c, 0xb0, 0xa0, 0x58, 0x12, 0xb6,
+	0x43, 0x59, 0xee, 0x5a, 0x17, 0x3b, 0x34, 0x81, 0x90, 0xc6, 0x1d, 0x14, 0x23, 0xdb, 0x38, 0x6a,
+	0x8c, 0x2c, 0x79, 0x48, 0x31, 0xb2, 0x46, 0x14, 0xe8, 0x64, 0x19, 0x51, 0xa0, 0x45, 0x5f, 0x64,
+	0xa4, 0xdc, 0x68, 0x9f, 0xe5, 0xd5, 0xde, 0x51, 0x04, 0xe7, 0x45, 0x85, 0x98, 0xd1, 0x69, 0xf2,
+	0xd9, 0x8f, 0x1c, 0xc3, 0x84, 0xbd, 0xdd, 0xd2, 0x64, 0x54, 0x05, 0x78, 0xdd, 0x04, 0x69, 0x46,
+	0x8e, 0x12, 0xa0, 0xfa, 0x63, 0x15, 0xf2, 0x65, 0x43, 0x59, 0xb0, 0xef, 0x10, 0xa2, 0x12, 0x21,
+	0x4a, 0xd3, 0xcc, 0x11, 0xfd, 0x5a, 0x55, 0x8e, 0x45, 0x9e, 0x26, 0x49, 0xfd, 0x64, 0x46, 0x0f,
+	0xf9, 0xff, 0xf0, 0x94, 0x6f, 0x46, 0xf2, 0xb8, 0xea, 0x81, 0xc9, 0xe3, 0xde, 0x45, 0x26, 0x5d,
+	0xdf, 0xe7, 0x81, 0x5c, 0x34, 0x16, 0x55, 0x48, 0x75, 0x9e, 0x5b, 0x0d, 0x02, 0x13, 0xcf, 0xf9,
+	0xcb, 0x0a, 0x99, 0x1d, 0xb2, 0xa7, 0xe4, 0x02, 0x78, 0xeb, 0x23, 0x07, 0xf0, 0x8a, 0xe0, 0x96,
+	0xb1, 0x01, 0xc1, 0x2d, 0x68, 0x6b, 0xa6, 0x58, 0xaf, 0x8b, 0x3b, 0xc8, 0x8d, 0x67, 0x6c, 0xcd,
+	0x1a, 0x04, 0x26, 0x1e, 0xee, 0x62, 0xd3, 0x6e, 0xbb, 0x4d, 0xe3, 0x58, 0x46, 0xaf, 0x08, 0xbd,
+	0x6d, 0x69, 0xa1, 0x31, 0x4c, 0x1d, 0x3e, 0x9f, 0x22, 0x01, 0x19, 0x92, 0xd9, 0x01, 0x6f, 0x8c,
+	0x38, 0xe0, 0x3f, 0x55, 0x21, 0x4f, 0x1e, 0x78, 0xba, 0x8d, 0x1c, 0x58, 0x84, 0x3e, 0xcc, 0xd9,
+	0x89, 0x83, 0x1e, 0xce, 0xc0, 0x20, 0x7c, 0x94, 0x7a, 0x3d, 0x23, 0xff, 0x65, 0xb3, 0x7a, 0x1c,
+	0xa3, 0x94, 0x22, 0x01, 0x19, 0x92, 0x0f, 0x3a, 0x2d, 0x7f, 0xbf, 0x46, 0x9e, 0x1e, 0x41, 0x06,
+	0x28, 0x31, 0x1a, 0x31, 0x1d, 0x39, 0x5b, 0x7d, 0x48, 0x91, 0xb3, 0x0f, 0x36, 0x5c, 0x6f, 0x04,
+	0xdc, 0x8e, 0x14, 0xf5, 0xf8, 0xf3, 0x15, 0x72, 0x71, 0xb0, 0xc0, 0x62, 0x7f, 0x1d, 0x6a, 0x77,
+	0xa4, 0x93, 0x9d, 0x19, 0x74, 0x7b, 0x96, 0x6b, 0x76, 0x52, 0x20, 0xc8, 0xe2, 0xda, 0x73, 0x68,
+	0x9a, 0x4c, 0x76, 0xe2, 0x2b, 0x77, 0xbd, 0x38, 0x11, 0xe9, 0xc3, 0xa6, 0xb9, 0x2d, 0x51, 0xb6,
+	0x82, 0x81, 0x81, 0xe4, 0xd8, 0xaf, 0xa5, 0xf0, 0x66, 0x98, 0xf0, 0x87, 0xf8, 0x65, 0xeb, 0xac,
+	0xac, 0x6e, 0x68, 0x80, 0x20, 0x8b, 0x8b, 0xe4, 0x98, 0xb5, 0x9a, 0x33, 0xca, 0x6f, 0x61, 0x8c,
+	0xdc, 0x8a, 0x6a, 0x05, 0x03, 0x23, 0x1b, 0x4e, 0x5c, 0x1f, 0x1e, 0x4e, 0xec, 0xfc, 0xd3, 0x0a,
+	0xb9, 0x30, 0x50, 0xe0, 0x1d, 0x6d, 0x9b, 0x7a, 0xf4, 0x42, 0x80, 0x1f, 0x70, 0x85, 0x1d, 0x2e,
+	0x74, 0xf4, 0x4f, 0x07, 0xcc, 0x34, 0x11, 0x3a, 0xfa, 0xe0, 0x19, 0x31, 0x1e, 0xbd, 0xf1, 0xcc,
+	0x45, 0x8b, 0xd6, 0x0e, 0x11, 0x2d, 0x9a, 0xf9, 0x18, 0xf5, 0x11, 0x4f, 0x87, 0xff, 0x52, 0x1b,
+	0x38, 0xbc, 0x78, 0x41, 0x1e, 0x49, 0x6f, 0xbe, 0x44, 0x4e, 0x7b, 0x01, 0xab, 0x74, 0xdb, 0xea,
+	0x6f, 0x8a, 0x8c, 0x52, 0x3c, 0x6d, 0xaa, 0x8a, 0xfe, 0x58, 0xce, 0xc0, 0x21, 0xf7, 0xc4, 0x23,
+	0x18, 0xbd, 0xfb, 0x60, 0x43, 0x7a, 0xc8, 0x9d, 0x7b, 0x8d, 0x9c, 0x97, 0x43, 0xb1, 0xe3, 0x46,
+	0xb4, 0x23, 0x0e, 0xdb, 0x58, 0xc4, 0xfb, 0x5c, 0xe0, 0x31, 0x43, 0x05, 0x08, 0x50, 0xfc, 0x1c,
+	0x7e, 0xb2, 0x24, 0xec, 0x79, 0xed, 0xe6, 0x44, 0xfa, 0x93, 0x6d, 0x60, 0x23, 0x70, 0x98, 0x3e,
+	0x2f, 0x1a, 0x27, 0x73, 0x5e, 0xa0, 0x48, 0xa0, 0x06, 0x9c, 0x87, 0x09, 0xa8, 0x59, 0x9e, 0x0b,
+	0x13, 0x50, 0x53, 0xdc, 0xc0, 0x1a, 0x52, 0x99, 0x1f, 0xdf, 0x3a, 0x4e, 0xc2, 0x88, 0x66, 0xc3,
+	0x89, 0x5b, 0xd8, 0x08, 0x1c, 0xe6, 0x3c, 0x47, 0xa6, 0x94, 0x8e, 0x6c, 0xd4, 0x42, 0xac, 0xce,
+	0xff, 0xad, 0x90, 0x4c, 0xa9, 0x34, 0x4c, 0xee, 0xdb, 0x91, 0x05, 0xf4, 0xcb, 0x49, 0xee, 0xab,
+	0xea, 0xf1, 0x6b, 0x23, 0x91, 0x6a, 0x02, 0x4d, 0xcc, 0xfe, 0x18, 0xcf, 0xa3, 0x2b, 0x48, 0x57,
+	0xca, 0x88, 0xf3, 0x6e, 0xa9, 0xfe, 0xcc, 0x4a, 0x8b, 0xb2, 0x0d, 0x0c, 0x7a, 0x76, 0x42, 0x1a,
+	0x3b, 0xb2, 0x24, 0x5c, 0x39, 0x9b, 0xa2, 0xaa, 0x30, 0xc7, 0x05, 0x39, 0xf5, 0x13, 0x34, 0x21,
+	0xe7, 0x4f, 0x2a, 0xe4, 0x5c, 0xfa, 0x03, 0x08, 0xa3, 0xde, 0x2f, 0x58, 0xe4, 0x71, 0xdf, 0x8d,
+	0x93, 0x56, 0x9f, 0x5d, 0x27, 0xb6, 0xfa, 0xfe, 0x5a, 0x26, 0xe5, 0xf2, 0x51, 0x55, 0x32, 0xaa,
+	0xe3, 0x6c, 0x09, 0xc1, 0x85, 0x37, 0x63, 0x2c, 0xd5, 0x4a, 0x31, 0x71, 0x18, 0xc4, 0x15, 0xea,
+	0xb1, 0x4e, 0xb7, 0xfb, 0x51, 0x44, 0x83, 0x44, 0xb3, 0xca, 0xbf, 0xe2, 0xcd, 0x52, 0x06, 0x52,
+	0x33, 0x78, 0x8e, 0x15, 0x45, 0xce, 0xd0, 0x82, 0x1c, 0x75, 0xe7, 0x7b, 0xf0, 0x7c, 0x1d, 0xf8,
+	0x9e, 0x7f, 0xcd, 0x6a, 0x1e, 0xfe, 0xd9, 0x18, 0x39, 0x95, 0xca, 0x2b, 0x9d, 0x32, 0x84, 0x59,
+	0x43, 0x0d, 0x61, 0x2c, 0x8e, 0xad, 0x1f, 0xc8, 0x8a, 0xf0, 0x46, 0x1c, 0x5b, 0x3f, 0xc0, 0xbc,
+	0xd9, 0xf8, 0x47, 0x0c, 0x29, 0xf4, 0x03, 0xe1, 0x03, 0x6f, 0x0e, 0x29, 0xf4, 0x03, 0x10, 0x50,
+	0xf4, 0x11, 0x9c, 0x62, 0x8b, 0x4f, 0x98, 0x11, 0x9b, 0xb5, 0x32, 0x6c, 0xb7, 0x2d, 0xa3, 0x47,
+	0xee, 0x33, 0x69, 0xb6, 0x40, 0x8a, 0x22, 0x96, 0x62, 0x6b, 0xa8, 0x22, 0xae, 0xcd, 0xb1, 0x32,
+	0xe2, 0x8c, 0xb2, 0x69, 0xbb, 0x33, 0xbb, 0x9e, 0x6c, 0x61, 0x66, 0x25, 0xf1, 0x2f, 0x96, 0xa1,
+	0xe3, 0xff, 0x8a, 0xc9, 0x51, 0xba, 0xf9, 0x8b, 0x14, 0xd8, 0xf7, 0xb0, 0xbc, 0x88, 0x1b, 0x78,
+	0x5b, 0x34, 0x4e, 0xb8, 0xd9, 0x4d, 0x96, 0x17, 0x91, 0x8d, 0xa0, 0xe1, 0x78, 0x25, 0x88, 0xd9,
+	0x8b, 0x25, 0x86, 0x9d, 0x8c, 0x5d, 0x09, 0x5a, 0xba, 0x19, 0x4c, 0x1c, 0xd3, 0xa8, 0x47, 0x1e,
+	0xaa, 0x51, 0x6f, 0x72, 0x88, 0x51, 0xaf, 0x45, 0xce, 0xbb, 0xfd, 0x24, 0x44, 0x13, 0xff, 0x7c,
+	0x82, 0xca, 0xd6, 0x24, 0xe6, 0xa9, 0xc8, 0xa7, 0x98, 0xa2, 0x58, 0x79, 0x79, 0xb5, 0xa8, 0xbf,
+	0x95, 0x43, 0x82, 0xe2, 0x67, 0x9d, 0x7f, 0x64, 0x91, 0xf3, 0x85, 0x53, 0xe1, 0xd1, 0xf5, 0xaf,
+	0x77, 0x7e, 0xa8, 0x4e, 0xce, 0x16, 0x64, 0x9d, 0xb7, 0xf7, 0xcd, 0x45, 0x62, 0x95, 0xe1, 0xaa,
+	0x96, 0xf6, 0xbc, 0x92, 0xdf, 0xa6, 0x60, 0x65, 0x1c, 0xce, 0x4e, 0xaf, 0x6d, 0xe5, 0xd5, 0x93,
+	0xb5, 0x95, 0x1b, 0x73, 0xbd, 0xf6, 0x50, 0xe7, 0x7a, 0x7d, 0xc8, 0x5c, 0xff, 0x45, 0x8b, 0x34,
+	0xbb, 0x03, 0x6a, 0x9f, 0x35, 0xc7, 0xca, 0xd0, 0x64, 0x0d, 0xaa, 0xac, 0xb6, 0xf0, 0x04, 0x06,
+	0xf1, 0x0e, 0x82, 0xc2, 0x40, 0xae, 0x9c, 0xcf, 0x57, 0x09, 0x93, 0xd7, 0x58, 0x66, 0xe1, 0x7d,
+	0xfb, 0xe3, 0x66, 0xf1, 0x0a, 0xab, 0xac, 0x42, 0x0b, 0xbc, 0x73, 0x55, 0xfc, 0x82, 0x8f, 0x60,
+	0x51, 0x2d, 0x8c, 0xec, 0x4e, 0x58, 0x19, 0x61, 0x27, 0xf4, 0x65, 0x95, 0x90, 0x6a, 0xf9, 0x55,
+	0x42, 0x1a, 0xd9, 0x0a, 0x21, 0x07, 0x7f, 0xe2, 0xda, 0x23, 0xf9, 0x89, 0x7f, 0xcd, 0x22, 0x67,
+	0x0b, 0xbe, 0x82, 0x16, 0x37, 0xac, 0x03, 0xc4, 0x0d, 0x74, 0x93, 0x12, 0x3b, 0xb3, 0x10, 0x4b,
+	0xb4, 0x9b, 0x94, 0x68, 0x07, 0x85, 0x81, 0x57, 0x33, 0xd7, 0xf7, 0xc3, 0x3b, 0x57, 0xba, 0xbd,
+	0x64, 0x5f, 0x08, 0x28, 0xea, 0x5a, 0x30, 0xaf, 0x20, 0x60, 0x60, 0xd9, 0x4f, 0x93, 0x31, 0x9e,
+	0x0f, 0x41, 0xa8, 0x80, 0x26, 0x71, 0x1d, 0xf2, 0x64, 0x09, 0x1d, 0x10, 0x20, 0x67, 0x87, 0x18,
+	0xb7, 0x8a, 0x07, 0xaf, 0x27, 0xad, 0x2a, 0xdb, 0x56, 0x06, 0x55, 0xb6, 0x75, 0xfe, 0x5e, 0x45,
+	0x90, 0xe2, 0xb7, 0x04, 0xed, 0x35, 0x67, 0x1d, 0xd2, 0x6b, 0xee, 0x63, 0x84, 0xb4, 0xc3, 0x6e,
+	0x0f, 0x6f, 0xd7, 0x1b, 0x61, 0x39, 0x97, 0xad, 0x45, 0xd5, 0x9f, 0x1e, 0x55, 0xdd, 0x06, 0x06,
+	0xbd, 0xd4, 0xd6, 0x5e, 0x1d, 0xba, 0xb5, 0xa7, 0x76, 0xb9, 0xda, 0xc1, 0xbb, 0x9c, 0xf3, 0x97,
+	0x16, 0x49, 0x49, 0x7d, 0x58, 0xa7, 0x07, 0xd9, 0xdd, 0x17, 0x1b, 0xc6, 0x5a, 0x79, 0x22, 0x26,
+	0xee, 0xd4, 0x62, 0x15, 0xb2, 0x7f, 0x81, 0x13, 0xb2, 0x7d, 0xe1, 0x21, 0x58, 0xca, 0xe5, 0xc7,
+	0x24, 0x88, 0x3e, 0x86, 0xdc, 0xc9, 0x46, 0x7b, 0x1b, 0x3a, 0xcf, 0x93, 0x33, 0x39, 0xa6, 0x58,
+	0x0d, 0xea, 0x30, 0x6a, 0xe7, 0x56, 0x0f, 0xcb, 0xe2, 0x00, 0x1c, 0x86, 0xce, 0x7c, 0xa7, 0xb3,
+	0xdd, 0xa3, 0x7d, 0xf7, 0x4c, 0x9c, 0xed, 0xef, 0xb8, 0xc6, 0x4e, 0x79, 0xf9, 0xe7, 0x40, 0x90,
+	0x67, 0xc2, 0xf9, 0x27, 0xe2, 0x34, 0xb8, 0xed, 0x05, 0x9d, 0xf0, 0x8e, 0x92, 0x93, 0xac, 0x81,
+	0x72, 0x12, 0x6e, 0x0f, 0xed, 0x1d, 0xda, 0xe9, 0xfb, 0xb9, 0xf4, 0x0b, 0x2d, 0xd1, 0x0e, 0x0a,
+	0x03, 0xb1, 0x3b, 0x7d, 0x71, 0x6f, 0xcd, 0x4c, 0xca, 0x25, 0xd1, 0x0e, 0x0a, 0x03, 0x03, 0xb5,
+	0x8c, 0x97, 0x94, 0xf3, 0x92, 0x5d, 0x3a, 0x8c, 0x13, 0x3c, 0x86, 0x14, 0x16, 0xaa, 0xe3, 0x95,
+	0xcc, 0x25, 0x4f, 0x6c, 0xa6, 0x8e, 0x57, 0x1b, 0x63, 0x0c, 0x06, 0x06, 0xcb, 0xed, 0xe0, 0xf7,
+	0x63, 0x66, 0x6f, 0x1e, 0xd3, 0x99, 0xf6, 0x17, 0x45, 0x1b, 0x28, 0x28, 0x6e, 0x6e, 0x5d, 0x37,
+	0xe8, 0xbb, 0x3e, 0x8e, 0x90, 0x50, 0xb0, 0xa9, 0x65, 0xb8, 0xaa, 0x20, 0x60, 0x60, 0xe1, 0x1b,
+	0x27, 0x5e, 0x97, 0x7e, 0x20, 0x0c, 0xa4, 0x77, 0xb6, 0x76, 0x41, 0x10, 0xed, 0xa0, 0x30, 0xec,
+	0xe7, 0xb1, 0x16, 0x6b, 0x87, 0x0b, 0x88, 0x61, 0x24, 0x2c, 0x99, 0xea, 0xf6, 0x89, 0x29, 0x3a,
+	0x34, 0x14, 0x4c, 0x54, 0xe7, 0xcf, 0x2d, 0x32, 0xa3, 0x73, 0xe4, 0x30, 0x85, 0x5a, 0x4a, 0x93,
+	0x68, 0x0d, 0xd5, 0x24, 0xa6, 0x93, 0x6f, 0x54, 0x46, 0x4a, 0xbe, 0x61, 0xe6, 0xc5, 0xa8, 0x1e,
+	0x98, 0x17, 0xe3, 0xcb, 0xc9, 0xf8, 0x2e, 0xdd, 0x37, 0x12, 0x68, 0xb0, 0x5d, 0xfe, 0x06, 0x6f,
+	0x02, 0x09, 0xc3, 0xb0, 0xa4, 0xb6, 0xab, 0x12, 0xdc, 0x4d, 0xf1, 0x9b, 0xd5, 0xe2, 0x3c, 0x43,
+	0x12, 0x10, 0x67, 0x8d, 0xe8, 0xb2, 0x89, 0x52, 0xaf, 0x67, 0x0d, 0xd6, 0xeb, 0x0d, 0xaf, 0xe0,
+	0xff, 0x13, 0x15, 0x72, 0x76, 0x83, 0x46, 0x91, 0xbb, 0x15, 0x46, 0x5d, 0x16, 0x34, 0xcc, 0x92,
+	0x0e, 0xe3, 0xf8, 0xed, 0x84, 0x71, 0x52, 0x54, 0xf6, 0xeb, 0xba, 0x68, 0x07, 0x85, 0x91, 0x33,
+	0x25, 0x54, 0x46, 0x36, 0x25, 0x5c, 0x26, 0x0d, 0x8c, 0xee, 0x2a, 0x8c, 0x1d, 0xbf, 0x2d, 0x01,
+	0xa0, 0x71, 0x1e, 0x82, 0x32, 0xdb, 0xf9, 0x0b, 0x8b, 0x9c, 0x49, 0x8f, 0xd1, 0xb5, 0xc5, 0x16,
+	0xea, 0x22, 0x84, 0xf3, 0x5b, 0xc6, 0x23, 0x72, 0x81, 0xb5, 0x82, 0x80, 0x22, 0x5e, 0xb8, 0x69,
+	0x64, 0x0f, 0x57, 0x78, 0x6b, 0xac, 0x15, 0x04, 0x94, 0x59, 0xca, 0xdb, 0x11, 0x65, 0x09, 0x66,
+	0x5c, 0x3f, 0x3e, 0x2e, 0x4b, 0xf9, 0x62, 0x8a, 0x04, 0x64, 0x48, 0x3a, 0x7f, 0x3e, 0x4e, 0x1e,
+	0xcf, 0xbc, 0xab, 0x72, 0x77, 0xdc, 0x22, 0x95, 0xf8, 0xb9, 0xa6, 0x55, 0xc6, 0xb1, 0x93, 0x26,
+	0xd1, 0x7a, 0x6e, 0x61, 0x0c, 0xfd, 0x92, 0x5b, 0xcf, 0x41, 0x25, 0x7e, 0xce, 0x7e, 0x85, 0x54,
+	0xb7, 0xdb, 0x32, 0xf6, 0x6b, 0xad, 0x4c, 0x42, 0xd7, 0x16, 0x5b, 0x0b, 0xe3, 0xb8, 0x48, 0xae,
+	0x2d, 0xb6, 0x00, 0x89, 0xd8, 0x01, 0xa9, 0xed, 0x24, 0x49, 0xaf, 0x59, 0x2d, 0xc3, 0xdd, 0x36,
+	0x4d, 0xec, 0xfa, 0xc6, 0xc6, 0xba, 0x38, 0x4e, 0x37, 0x36, 0xd6, 0x81, 0xd1, 0xb1, 0x23, 0x52,
+	0x6f, 0xe3, 0x02, 0x2b, 0xa7, 0x90, 0x5b, 0xc1, 0xca, 0x15, 0x16, 0x04, 0xfc, 0x17, 0x38, 0x29,
+	0xb3, 0x94, 0xf7, 0xd5, 0x28, 0xec, 0x36, 0xeb, 0xc5, 0xa5, 0xbc, 0x11, 0x06, 0x29, 0x4c, 0xd3,
+	0x3c, 0x45, 0x83, 0xd8, 0x4b, 0xbc, 0x3d, 0x69, 0x97, 0xc9, 0x99, 0xa7, 0x24, 0x1c, 0x72, 0x4f,
+	0x0c, 0x72, 0x96, 0x1b, 0x3f, 0xaa, 0xb3, 0xdc, 0xc4, 0x43, 0x72, 0x96, 0xfb, 0x6e, 0xed, 0x2c,
+	0xd7, 0xb8, 0x54, 0x3d, 0xba, 0x9b, 0xe9, 0x80, 0xf5, 0x76, 0xdc, 0x55, 0x13, 0xfe, 0xb8, 0x42,
+	0xec, 0xfc, 0x8c, 0x1d, 0x96, 0x2a, 0xf6, 0x35, 0x32, 0x29, 0xd3, 0x16, 0xe2, 0x16, 0x55, 0x39,
+	0x86, 0x62, 0xb9, 0xb7, 0x74, 0xff, 0x60, 0x12, 0xcb, 0x16, 0xea, 0xad, 0x9e, 0x64, 0xa1, 0x5e,
+	0x53, 0xa0, 0xa8, 0x0d, 0x35, 0xbd, 0xff, 0x45, 0x95, 0x9c, 0xce, 0xee, 0x71, 0x23, 0x9f, 0x18,
+	0x43, 0xec, 0x75, 0xa3, 0xfa, 0xe2, 0x7d, 0x15, 0x99, 0xa0, 0x41, 0xa7, 0x17, 0x62, 0x09, 0xe5,
+	0x4c, 0x11, 0xe4, 0x2b, 0xa2, 0x1d, 0x14, 0x86, 0xf2, 0x01, 0xac, 0x0f, 0xf4, 0x01, 0xd4, 0x0e,
+	0x6f, 0xdc, 0xc8, 0x47, 0xb7, 0x9a, 0x63, 0xe5, 0x7e, 0x01, 0xc3, 0xe1, 0x4d, 0x92, 0x80, 0x0c,
+	0x49, 0x8c, 0x71, 0xb5, 0xb9, 0x6d, 0x53, 0x21, 0x6a, 0xe7, 0x9d, 0xd2, 0x38, 0xc1, 0xc8, 0x1f,
+	0xbb, 0x95, 0x23, 0x03, 0x05, 0xa4, 0x17, 0x36, 0x7f, 0xfb, 0x0b, 0x4f, 0xbd, 0xe9, 0xf7, 0xbe,
+	0xf0, 0xd4, 0x9b, 0xfe, 0xe8, 0x0b, 0x4f, 0xbd, 0xe9, 0x13, 0xf7, 0x9f, 0xb2, 0x7e, 0xfb, 0xfe,
+	0x53, 0xd6, 0xef, 0xdd, 0x7f, 0xca, 0xfa, 0xa3, 0xfb, 0x4f, 0x59, 0x9f, 0xbf, 0xff, 0x94, 0xf5,
+	0xfd, 0xff, 0xf9, 0xa9, 0x37, 0x7d, 0xa0, 0x30, 0x36, 0x12, 0xff, 0x79, 0x7b, 0xbb, 0x73, 0x79,
+	0xef, 0x39, 0x16, 0x9e, 0x87, 0x9c, 0x5d, 0x36, 0x38, 0xbb, 0x2c, 0x39, 0xfb, 0x7f, 0x03, 0x00,
+	0x52, 0xb0, 0x09, 0x6d, 0x44, 0x1b, 0x01, 0x00,
+}
+
+func (m *AWSAccountsGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AWSAccountsGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AWSAccountsGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SecretAccessKeyRef != nil {
+		{
+			size, err := m.SecretAccessKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AccessKeyIDRef != nil {
+		{
+			size, err := m.AccessKeyIDRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x2a
+	i--
+	if m.IncludeInactive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.TagFilters) > 0 {
+		for iNdEx := len(m.TagFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TagFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.Recursive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	if len(m.OrganizationalUnits) > 0 {
+		for iNdEx := len(m.OrganizationalUnits) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrganizationalUnits[iNdEx])
+			copy(dAtA[i:], m.OrganizationalUnits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OrganizationalUnits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5708,6 +6368,26 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApplicationSetGeneratorBlacklist) > 0 {
+		for iNdEx := len(m.ApplicationSetGeneratorBlacklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApplicationSetGeneratorBlacklist[iNdEx])
+			copy(dAtA[i:], m.ApplicationSetGeneratorBlacklist[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApplicationSetGeneratorBlacklist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ApplicationSetGeneratorWhitelist) > 0 {
+		for iNdEx := len(m.ApplicationSetGeneratorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApplicationSetGeneratorWhitelist[iNdEx])
+			copy(dAtA[i:], m.ApplicationSetGeneratorWhitelist[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApplicationSetGeneratorWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6420,6 +7100,44 @@ func (m *ApplicationSetCondition) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetFreezeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetFreezeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetFreezeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6440,6 +7158,42 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.TerraformState != nil {
+		{
+			size, err := m.TerraformState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AWSAccounts != nil {
+		{
+			size, err := m.AWSAccounts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6563,6 +7317,100 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.FailurePolicy)
+	copy(dAtA[i:], m.FailurePolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailurePolicy)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostRender) > 0 {
+		for iNdEx := len(m.PostRender) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRender[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PreRender) > 0 {
+		for iNdEx := len(m.PreRender) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreRender[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6610,6 +7458,41 @@ func (m *ApplicationSetList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetManagedNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetManagedNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetManagedNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetNestedGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6630,6 +7513,47 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.TerraformState != nil {
+		{
+			size, err := m.TerraformState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AWSAccounts != nil {
+		{
+			size, err := m.AWSAccounts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6753,6 +7677,34 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetPruneAfterHealthy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetPruneAfterHealthy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetPruneAfterHealthy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6905,6 +7857,73 @@ func (m *ApplicationSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FreezeWindows) > 0 {
+		for iNdEx := len(m.FreezeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreezeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.PreserveApplicationFinalizers != nil {
+		i--
+		if *m.PreserveApplicationFinalizers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ManagedNamespace != nil {
+		{
+			size, err := m.ManagedNamespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Hooks != nil {
+		{
+			size, err := m.Hooks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RevisionHistoryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.TemplatePatch != nil {
 		i -= len(*m.TemplatePatch)
 		copy(dAtA[i:], *m.TemplatePatch)
@@ -7034,6 +8053,34 @@ func (m *ApplicationSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GeneratedParameterSets) > 0 {
+		for iNdEx := len(m.GeneratedParameterSets) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.GeneratedParameterSets[iNdEx]))
+			i--
+			dAtA[i] = 0x30
+		}
+	}
+	if len(m.DriftedApplications) > 0 {
+		for iNdEx := len(m.DriftedApplications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DriftedApplications[iNdEx])
+			copy(dAtA[i:], m.DriftedApplications[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DriftedApplications[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastSuccessfulReconcileAt != nil {
+		{
+			size, err := m.LastSuccessfulReconcileAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7139,6 +8186,18 @@ func (m *ApplicationSetSyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.PruneAfterHealthy != nil {
+		{
+			size, err := m.PruneAfterHealthy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ApplicationsSync != nil {
 		i -= len(*m.ApplicationsSync)
 		copy(dAtA[i:], *m.ApplicationsSync)
@@ -7200,6 +8259,44 @@ func (m *ApplicationSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetTemplateGitRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetTemplateGitRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetTemplateGitRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetTemplateMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7290,6 +8387,41 @@ func (m *ApplicationSetTemplateMeta) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetTemplateRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetTemplateRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Git != nil {
+		{
+			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetTerminalGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7310,6 +8442,47 @@ func (m *ApplicationSetTerminalGenerator) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.TerraformState != nil {
+		{
+			size, err := m.TerraformState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AWSAccounts != nil {
+		{
+			size, err := m.AWSAccounts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -9048,6 +10221,23 @@ func (m *ClusterGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValuesObject != nil {
+		{
+			size, err := m.ValuesObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	i--
 	if m.FlatList {
 		dAtA[i] = 1
@@ -9827,6 +11017,19 @@ func (m *GitGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.CommitParams {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i -= len(m.ContentParamName)
+	copy(dAtA[i:], m.ContentParamName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContentParamName)))
+	i--
+	dAtA[i] = 0x4a
 	if len(m.Values) > 0 {
 		keysForValues := make([]string, 0, len(m.Values))
 		for k := range m.Values {
@@ -11142,192 +12345,7 @@ func (m *NestedMergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Info) > 0 {
-		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Sync != nil {
-		{
-			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OperationInitiator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OperationInitiator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i--
-	if m.Automated {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x10
-	i -= len(m.Username)
-	copy(dAtA[i:], m.Username)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *OperationState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
-	i--
-	dAtA[i] = 0x40
-	if m.FinishedAt != nil {
-		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.SyncResult != nil {
-		{
-			size, err := m.SyncResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *OptionalArray) Marshal() (dAtA []byte, err error) {
+func (m *OCIGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11337,258 +12355,595 @@ func (m *OptionalArray) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OptionalArray) MarshalTo(dAtA []byte) (int, error) {
+func (m *OCIGenerator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OptionalArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OCIGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Array) > 0 {
-		for iNdEx := len(m.Array) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Array[iNdEx])
-			copy(dAtA[i:], m.Array[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Array[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OptionalMap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OptionalMap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OptionalMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Map) > 0 {
-		keysForMap := make([]string, 0, len(m.Map))
-		for k := range m.Map {
-			keysForMap = append(keysForMap, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForMap)
-		for iNdEx := len(keysForMap) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Map[string(keysForMap[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForMap[iNdEx])
-			copy(dAtA[i:], keysForMap[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMap[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OrphanedResourceKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OrphanedResourceKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OrphanedResourceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Kind)
-	copy(dAtA[i:], m.Kind)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Group)
-	copy(dAtA[i:], m.Group)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OrphanedResourcesMonitorSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ignore) > 0 {
-		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ignore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Warn != nil {
-		i--
-		if *m.Warn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OverrideIgnoreDiff) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ManagedFieldsManagers) > 0 {
-		for iNdEx := len(m.ManagedFieldsManagers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ManagedFieldsManagers[iNdEx])
-			copy(dAtA[i:], m.ManagedFieldsManagers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManagedFieldsManagers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.JQPathExpressions) > 0 {
-		for iNdEx := len(m.JQPathExpressions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JQPathExpressions[iNdEx])
-			copy(dAtA[i:], m.JQPathExpressions[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQPathExpressions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.JSONPointers) > 0 {
-		for iNdEx := len(m.JSONPointers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JSONPointers[iNdEx])
-			copy(dAtA[i:], m.JSONPointers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPointers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PluginConfigMapRef) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PluginConfigMapRef) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PluginConfigMapRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.Chart)
+	copy(dAtA[i:], m.Chart)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *PluginGenerator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PluginGenerator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PluginGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	dAtA[i] = 0x5a
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if m.AWSECR != nil {
+		{
+			size, err := m.AWSECR.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PasswordRef != nil {
+		{
+			size, err := m.PasswordRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TagFilter)
+	copy(dAtA[i:], m.TagFilter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TagFilter)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIGeneratorAWSECR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIGeneratorAWSECR) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIGeneratorAWSECR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Sync != nil {
+		{
+			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationInitiator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationInitiator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Automated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
+	i--
+	dAtA[i] = 0x40
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.SyncResult != nil {
+		{
+			size, err := m.SyncResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionalArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Array) > 0 {
+		for iNdEx := len(m.Array) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Array[iNdEx])
+			copy(dAtA[i:], m.Array[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Array[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionalMap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalMap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Map) > 0 {
+		keysForMap := make([]string, 0, len(m.Map))
+		for k := range m.Map {
+			keysForMap = append(keysForMap, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMap)
+		for iNdEx := len(keysForMap) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Map[string(keysForMap[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMap[iNdEx])
+			copy(dAtA[i:], keysForMap[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMap[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrphanedResourceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ignore) > 0 {
+		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ignore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Warn != nil {
+		i--
+		if *m.Warn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverrideIgnoreDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManagedFieldsManagers) > 0 {
+		for iNdEx := len(m.ManagedFieldsManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManagedFieldsManagers[iNdEx])
+			copy(dAtA[i:], m.ManagedFieldsManagers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManagedFieldsManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.JQPathExpressions) > 0 {
+		for iNdEx := len(m.JQPathExpressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JQPathExpressions[iNdEx])
+			copy(dAtA[i:], m.JQPathExpressions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQPathExpressions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.JSONPointers) > 0 {
+		for iNdEx := len(m.JSONPointers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JSONPointers[iNdEx])
+			copy(dAtA[i:], m.JSONPointers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPointers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PluginConfigMapRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginConfigMapRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginConfigMapRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PluginGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Values) > 0 {
 		keysForValues := make([]string, 0, len(m.Values))
 		for k := range m.Values {
@@ -14675,6 +16030,11 @@ func (m *SecretRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Store)
+	copy(dAtA[i:], m.Store)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Store)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Key)
 	copy(dAtA[i:], m.Key)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
@@ -15577,11 +16937,322 @@ func (m *TLSClientConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *TagFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TagFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraformStateCloud) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraformStateCloud) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraformStateCloud) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenRef != nil {
+		{
+			size, err := m.TokenRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Workspace)
+	copy(dAtA[i:], m.Workspace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Workspace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Organization)
+	copy(dAtA[i:], m.Organization)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Organization)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Hostname)
+	copy(dAtA[i:], m.Hostname)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hostname)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraformStateGCS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraformStateGCS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraformStateGCS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialsRef != nil {
+		{
+			size, err := m.CredentialsRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Object)
+	copy(dAtA[i:], m.Object)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Object)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraformStateGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraformStateGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraformStateGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	i--
+	if m.IncludeSensitive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.ElementsFrom)
+	copy(dAtA[i:], m.ElementsFrom)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ElementsFrom)))
+	i--
+	dAtA[i] = 0x2a
+	if m.Cloud != nil {
+		{
+			size, err := m.Cloud.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GCS != nil {
+		{
+			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerraformStateHTTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerraformStateHTTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerraformStateHTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.PasswordRef != nil {
+		{
+			size, err := m.PasswordRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UsernameRef != nil {
+		{
+			size, err := m.UsernameRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TagFilter) Marshal() (dAtA []byte, err error) {
+func (m *TerraformStateS3) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15591,25 +17262,64 @@ func (m *TagFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TagFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerraformStateS3) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TagFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerraformStateS3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	if m.SecretAccessKeyRef != nil {
+		{
+			size, err := m.SecretAccessKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AccessKeyIDRef != nil {
+		{
+			size, err := m.AccessKeyIDRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x2a
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Key)
 	copy(dAtA[i:], m.Key)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
+	dAtA[i] = 0x12
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -15625,6 +17335,54 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *AWSAccountsGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrganizationalUnits) > 0 {
+		for _, s := range m.OrganizationalUnits {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if len(m.TagFilters) > 0 {
+		for _, e := range m.TagFilters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKeyIDRef != nil {
+		l = m.AccessKeyIDRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretAccessKeyRef != nil {
+		l = m.SecretAccessKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *AWSAuthConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15751,6 +17509,18 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ApplicationSetGeneratorWhitelist) > 0 {
+		for _, s := range m.ApplicationSetGeneratorWhitelist {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ApplicationSetGeneratorBlacklist) > 0 {
+		for _, s := range m.ApplicationSetGeneratorBlacklist {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -15958,6 +17728,21 @@ func (m *ApplicationSetCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetFreezeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationSetGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16004,6 +17789,54 @@ func (m *ApplicationSetGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSAccounts != nil {
+		l = m.AWSAccounts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TerraformState != nil {
+		l = m.TerraformState.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ConfigMapRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailurePolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreRender) > 0 {
+		for _, e := range m.PreRender {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.PostRender) > 0 {
+		for _, e := range m.PostRender {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16024,6 +17857,19 @@ func (m *ApplicationSetList) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetManagedNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationSetNestedGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16054,269 +17900,382 @@ func (m *ApplicationSetNestedGenerator) Size() (n int) {
 		l = m.PullRequest.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Matrix != nil {
-		l = m.Matrix.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Merge != nil {
-		l = m.Merge.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Selector != nil {
-		l = m.Selector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	if m.Matrix != nil {
+		l = m.Matrix.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Priority != nil {
+		n += 1 + sovGenerated(uint64(*m.Priority))
+	}
+	if m.AWSAccounts != nil {
+		l = m.AWSAccounts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TerraformState != nil {
+		l = m.TerraformState.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetPruneAfterHealthy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *ApplicationSetResourceIgnoreDifferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.JQPathExpressions) > 0 {
+		for _, s := range m.JQPathExpressions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MatchExpressions) > 0 {
+		for _, e := range m.MatchExpressions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.MaxUpdate != nil {
+		l = m.MaxUpdate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if len(m.Generators) > 0 {
+		for _, e := range m.Generators {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SyncPolicy != nil {
+		l = m.SyncPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PreservedFields != nil {
+		l = m.PreservedFields.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.GoTemplateOptions) > 0 {
+		for _, s := range m.GoTemplateOptions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if len(m.IgnoreApplicationDifferences) > 0 {
+		for _, e := range m.IgnoreApplicationDifferences {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TemplatePatch != nil {
+		l = len(*m.TemplatePatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RevisionHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
+	}
+	if m.Hooks != nil {
+		l = m.Hooks.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ManagedNamespace != nil {
+		l = m.ManagedNamespace.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PreserveApplicationFinalizers != nil {
+		n += 2
+	}
+	if len(m.FreezeWindows) > 0 {
+		for _, e := range m.FreezeWindows {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ApplicationStatus) > 0 {
+		for _, e := range m.ApplicationStatus {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastSuccessfulReconcileAt != nil {
+		l = m.LastSuccessfulReconcileAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DriftedApplications) > 0 {
+		for _, s := range m.DriftedApplications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.GeneratedParameterSets) > 0 {
+		for _, e := range m.GeneratedParameterSets {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RollingSync != nil {
+		l = m.RollingSync.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetSyncPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if m.ApplicationsSync != nil {
+		l = len(*m.ApplicationsSync)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PruneAfterHealthy != nil {
+		l = m.PruneAfterHealthy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationSetTemplateMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetTemplateGitRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetTemplateMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationSetTemplateRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetTerminalGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.List != nil {
+		l = m.List.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Clusters != nil {
+		l = m.Clusters.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SCMProvider != nil {
+		l = m.SCMProvider.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClusterDecisionResource != nil {
+		l = m.ClusterDecisionResource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	if m.Plugin != nil {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	return n
-}
-
-func (m *ApplicationSetResourceIgnoreDifferences) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.JSONPointers) > 0 {
-		for _, s := range m.JSONPointers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.JQPathExpressions) > 0 {
-		for _, s := range m.JQPathExpressions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *ApplicationSetRolloutStep) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MatchExpressions) > 0 {
-		for _, e := range m.MatchExpressions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.MaxUpdate != nil {
-		l = m.MaxUpdate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ApplicationSetRolloutStrategy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *ApplicationSetSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2
-	if len(m.Generators) > 0 {
-		for _, e := range m.Generators {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = m.Template.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SyncPolicy != nil {
-		l = m.SyncPolicy.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Strategy != nil {
-		l = m.Strategy.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.PreservedFields != nil {
-		l = m.PreservedFields.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.GoTemplateOptions) > 0 {
-		for _, s := range m.GoTemplateOptions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 2
-	if len(m.IgnoreApplicationDifferences) > 0 {
-		for _, e := range m.IgnoreApplicationDifferences {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.TemplatePatch != nil {
-		l = len(*m.TemplatePatch)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ApplicationSetStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.ApplicationStatus) > 0 {
-		for _, e := range m.ApplicationStatus {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *ApplicationSetStrategy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.RollingSync != nil {
-		l = m.RollingSync.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ApplicationSetSyncPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2
-	if m.ApplicationsSync != nil {
-		l = len(*m.ApplicationsSync)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ApplicationSetTemplate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ApplicationSetTemplateMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ApplicationSetTemplateMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Finalizers) > 0 {
-		for _, s := range m.Finalizers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *ApplicationSetTerminalGenerator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.List != nil {
-		l = m.List.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Clusters != nil {
-		l = m.Clusters.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SCMProvider != nil {
-		l = m.SCMProvider.Size()
+	if m.Selector != nil {
+		l = m.Selector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.ClusterDecisionResource != nil {
-		l = m.ClusterDecisionResource.Size()
+	if m.OCI != nil {
+		l = m.OCI.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.PullRequest != nil {
-		l = m.PullRequest.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Priority != nil {
+		n += 1 + sovGenerated(uint64(*m.Priority))
 	}
-	if m.Plugin != nil {
-		l = m.Plugin.Size()
+	if m.AWSAccounts != nil {
+		l = m.AWSAccounts.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Selector != nil {
-		l = m.Selector.Size()
+	if m.TerraformState != nil {
+		l = m.TerraformState.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
@@ -16944,6 +18903,13 @@ func (m *ClusterGenerator) Size() (n int) {
 		}
 	}
 	n += 2
+	if m.ValuesObject != nil {
+		l = m.ValuesObject.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
 	return n
 }
 
@@ -17249,6 +19215,9 @@ func (m *GitGenerator) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.ContentParamName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17715,6 +19684,60 @@ func (m *NestedMergeGenerator) Size() (n int) {
 	return n
 }
 
+func (m *OCIGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TagFilter)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PasswordRef != nil {
+		l = m.PasswordRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSECR != nil {
+		l = m.AWSECR.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Chart)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OCIGeneratorAWSECR) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Operation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19005,6 +21028,8 @@ func (m *SecretRef) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Store)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19360,12 +21385,171 @@ func (m *TagFilter) Size() (n int) {
 	return n
 }
 
+func (m *TerraformStateCloud) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Organization)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Workspace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TokenRef != nil {
+		l = m.TokenRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TerraformStateGCS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Object)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CredentialsRef != nil {
+		l = m.CredentialsRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TerraformStateGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GCS != nil {
+		l = m.GCS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Cloud != nil {
+		l = m.Cloud.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ElementsFrom)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *TerraformStateHTTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.UsernameRef != nil {
+		l = m.UsernameRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PasswordRef != nil {
+		l = m.PasswordRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *TerraformStateS3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKeyIDRef != nil {
+		l = m.AccessKeyIDRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretAccessKeyRef != nil {
+		l = m.SecretAccessKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *AWSAccountsGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTagFilters := "[]*TagFilter{"
+	for _, f := range this.TagFilters {
+		repeatedStringForTagFilters += strings.Replace(f.String(), "TagFilter", "TagFilter", 1) + ","
+	}
+	repeatedStringForTagFilters += "}"
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&AWSAccountsGenerator{`,
+		`OrganizationalUnits:` + fmt.Sprintf("%v", this.OrganizationalUnits) + `,`,
+		`Recursive:` + fmt.Sprintf("%v", this.Recursive) + `,`,
+		`TagFilters:` + repeatedStringForTagFilters + `,`,
+		`IncludeInactive:` + fmt.Sprintf("%v", this.IncludeInactive) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`AccessKeyIDRef:` + strings.Replace(this.AccessKeyIDRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`SecretAccessKeyRef:` + strings.Replace(this.SecretAccessKeyRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AWSAuthConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -19470,6 +21654,8 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`ApplicationSetGeneratorWhitelist:` + fmt.Sprintf("%v", this.ApplicationSetGeneratorWhitelist) + `,`,
+		`ApplicationSetGeneratorBlacklist:` + fmt.Sprintf("%v", this.ApplicationSetGeneratorBlacklist) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19623,6 +21809,18 @@ func (this *ApplicationSetCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetFreezeWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetFreezeWindow{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19638,6 +21836,42 @@ func (this *ApplicationSetGenerator) String() string {
 		`Merge:` + strings.Replace(this.Merge.String(), "MergeGenerator", "MergeGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIGenerator", "OCIGenerator", 1) + `,`,
+		`AWSAccounts:` + strings.Replace(this.AWSAccounts.String(), "AWSAccountsGenerator", "AWSAccountsGenerator", 1) + `,`,
+		`TerraformState:` + strings.Replace(this.TerraformState.String(), "TerraformStateGenerator", "TerraformStateGenerator", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetHook{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ConfigMapRef:` + strings.Replace(strings.Replace(this.ConfigMapRef.String(), "PluginConfigMapRef", "PluginConfigMapRef", 1), `&`, ``, 1) + `,`,
+		`FailurePolicy:` + fmt.Sprintf("%v", this.FailurePolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetHooks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPreRender := "[]ApplicationSetHook{"
+	for _, f := range this.PreRender {
+		repeatedStringForPreRender += strings.Replace(strings.Replace(f.String(), "ApplicationSetHook", "ApplicationSetHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPreRender += "}"
+	repeatedStringForPostRender := "[]ApplicationSetHook{"
+	for _, f := range this.PostRender {
+		repeatedStringForPostRender += strings.Replace(strings.Replace(f.String(), "ApplicationSetHook", "ApplicationSetHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPostRender += "}"
+	s := strings.Join([]string{`&ApplicationSetHooks{`,
+		`PreRender:` + repeatedStringForPreRender + `,`,
+		`PostRender:` + repeatedStringForPostRender + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19658,6 +21892,16 @@ func (this *ApplicationSetList) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetManagedNamespace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetManagedNamespace{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetNestedGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19673,6 +21917,20 @@ func (this *ApplicationSetNestedGenerator) String() string {
 		`Merge:` + strings.Replace(fmt.Sprintf("%v", this.Merge), "JSON", "v11.JSON", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIGenerator", "OCIGenerator", 1) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`AWSAccounts:` + strings.Replace(this.AWSAccounts.String(), "AWSAccountsGenerator", "AWSAccountsGenerator", 1) + `,`,
+		`TerraformState:` + strings.Replace(this.TerraformState.String(), "TerraformStateGenerator", "TerraformStateGenerator", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetPruneAfterHealthy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetPruneAfterHealthy{`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19734,6 +21992,11 @@ func (this *ApplicationSetSpec) String() string {
 		repeatedStringForIgnoreApplicationDifferences += strings.Replace(strings.Replace(f.String(), "ApplicationSetResourceIgnoreDifferences", "ApplicationSetResourceIgnoreDifferences", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForIgnoreApplicationDifferences += "}"
+	repeatedStringForFreezeWindows := "[]ApplicationSetFreezeWindow{"
+	for _, f := range this.FreezeWindows {
+		repeatedStringForFreezeWindows += strings.Replace(strings.Replace(f.String(), "ApplicationSetFreezeWindow", "ApplicationSetFreezeWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFreezeWindows += "}"
 	s := strings.Join([]string{`&ApplicationSetSpec{`,
 		`GoTemplate:` + fmt.Sprintf("%v", this.GoTemplate) + `,`,
 		`Generators:` + repeatedStringForGenerators + `,`,
@@ -19745,6 +22008,12 @@ func (this *ApplicationSetSpec) String() string {
 		`ApplyNestedSelectors:` + fmt.Sprintf("%v", this.ApplyNestedSelectors) + `,`,
 		`IgnoreApplicationDifferences:` + repeatedStringForIgnoreApplicationDifferences + `,`,
 		`TemplatePatch:` + valueToStringGenerated(this.TemplatePatch) + `,`,
+		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Hooks:` + strings.Replace(this.Hooks.String(), "ApplicationSetHooks", "ApplicationSetHooks", 1) + `,`,
+		`ManagedNamespace:` + strings.Replace(this.ManagedNamespace.String(), "ApplicationSetManagedNamespace", "ApplicationSetManagedNamespace", 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "ApplicationSetTemplateRef", "ApplicationSetTemplateRef", 1) + `,`,
+		`PreserveApplicationFinalizers:` + valueToStringGenerated(this.PreserveApplicationFinalizers) + `,`,
+		`FreezeWindows:` + repeatedStringForFreezeWindows + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19772,6 +22041,9 @@ func (this *ApplicationSetStatus) String() string {
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`ApplicationStatus:` + repeatedStringForApplicationStatus + `,`,
 		`Resources:` + repeatedStringForResources + `,`,
+		`LastSuccessfulReconcileAt:` + strings.Replace(fmt.Sprintf("%v", this.LastSuccessfulReconcileAt), "Time", "v1.Time", 1) + `,`,
+		`DriftedApplications:` + fmt.Sprintf("%v", this.DriftedApplications) + `,`,
+		`GeneratedParameterSets:` + fmt.Sprintf("%v", this.GeneratedParameterSets) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19794,6 +22066,7 @@ func (this *ApplicationSetSyncPolicy) String() string {
 	s := strings.Join([]string{`&ApplicationSetSyncPolicy{`,
 		`PreserveResourcesOnDeletion:` + fmt.Sprintf("%v", this.PreserveResourcesOnDeletion) + `,`,
 		`ApplicationsSync:` + valueToStringGenerated(this.ApplicationsSync) + `,`,
+		`PruneAfterHealthy:` + strings.Replace(this.PruneAfterHealthy.String(), "ApplicationSetPruneAfterHealthy", "ApplicationSetPruneAfterHealthy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19809,6 +22082,18 @@ func (this *ApplicationSetTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetTemplateGitRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetTemplateGitRef{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetTemplateMeta) String() string {
 	if this == nil {
 		return "nil"
@@ -19843,6 +22128,16 @@ func (this *ApplicationSetTemplateMeta) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetTemplateRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetTemplateRef{`,
+		`Git:` + strings.Replace(this.Git.String(), "ApplicationSetTemplateGitRef", "ApplicationSetTemplateGitRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetTerminalGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19856,6 +22151,10 @@ func (this *ApplicationSetTerminalGenerator) String() string {
 		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestGenerator", "PullRequestGenerator", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIGenerator", "OCIGenerator", 1) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`AWSAccounts:` + strings.Replace(this.AWSAccounts.String(), "AWSAccountsGenerator", "AWSAccountsGenerator", 1) + `,`,
+		`TerraformState:` + strings.Replace(this.TerraformState.String(), "TerraformStateGenerator", "TerraformStateGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20321,6 +22620,8 @@ func (this *ClusterGenerator) String() string {
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
 		`Values:` + mapStringForValues + `,`,
 		`FlatList:` + fmt.Sprintf("%v", this.FlatList) + `,`,
+		`ValuesObject:` + strings.Replace(fmt.Sprintf("%v", this.ValuesObject), "JSON", "v11.JSON", 1) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20572,6 +22873,8 @@ func (this *GitGenerator) String() string {
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
 		`PathParamPrefix:` + fmt.Sprintf("%v", this.PathParamPrefix) + `,`,
 		`Values:` + mapStringForValues + `,`,
+		`ContentParamName:` + fmt.Sprintf("%v", this.ContentParamName) + `,`,
+		`CommitParams:` + fmt.Sprintf("%v", this.CommitParams) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20973,6 +23276,47 @@ func (this *NestedMergeGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *OCIGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&OCIGenerator{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`TagFilter:` + fmt.Sprintf("%v", this.TagFilter) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`PasswordRef:` + strings.Replace(this.PasswordRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`AWSECR:` + strings.Replace(this.AWSECR.String(), "OCIGeneratorAWSECR", "OCIGeneratorAWSECR", 1) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIGeneratorAWSECR) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIGeneratorAWSECR{`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Operation) String() string {
 	if this == nil {
 		return "nil"
@@ -21884,6 +24228,7 @@ func (this *SecretRef) String() string {
 	s := strings.Join([]string{`&SecretRef{`,
 		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Store:` + fmt.Sprintf("%v", this.Store) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22118,20 +24463,574 @@ func (this *TagFilter) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TagFilter{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&TagFilter{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerraformStateCloud) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerraformStateCloud{`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`Organization:` + fmt.Sprintf("%v", this.Organization) + `,`,
+		`Workspace:` + fmt.Sprintf("%v", this.Workspace) + `,`,
+		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerraformStateGCS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerraformStateGCS{`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`CredentialsRef:` + strings.Replace(this.CredentialsRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerraformStateGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&TerraformStateGenerator{`,
+		`S3:` + strings.Replace(this.S3.String(), "TerraformStateS3", "TerraformStateS3", 1) + `,`,
+		`GCS:` + strings.Replace(this.GCS.String(), "TerraformStateGCS", "TerraformStateGCS", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "TerraformStateHTTP", "TerraformStateHTTP", 1) + `,`,
+		`Cloud:` + strings.Replace(this.Cloud.String(), "TerraformStateCloud", "TerraformStateCloud", 1) + `,`,
+		`ElementsFrom:` + fmt.Sprintf("%v", this.ElementsFrom) + `,`,
+		`IncludeSensitive:` + fmt.Sprintf("%v", this.IncludeSensitive) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerraformStateHTTP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerraformStateHTTP{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`UsernameRef:` + strings.Replace(this.UsernameRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`PasswordRef:` + strings.Replace(this.PasswordRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerraformStateS3) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerraformStateS3{`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`AccessKeyIDRef:` + strings.Replace(this.AccessKeyIDRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`SecretAccessKeyRef:` + strings.Replace(this.SecretAccessKeyRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AWSAccountsGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AWSAccountsGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AWSAccountsGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationalUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrganizationalUnits = append(m.OrganizationalUnits, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursive = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagFilters = append(m.TagFilters, &TagFilter{})
+			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeInactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeInactive = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeyIDRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeyIDRef == nil {
+				m.AccessKeyIDRef = &SecretRef{}
+			}
+			if err := m.AccessKeyIDRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretAccessKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretAccessKeyRef == nil {
+				m.SecretAccessKeyRef = &SecretRef{}
+			}
+			if err := m.SecretAccessKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
 func (m *AWSAuthConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -23032,6 +25931,70 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetGeneratorWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationSetGeneratorWhitelist = append(m.ApplicationSetGeneratorWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetGeneratorBlacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationSetGeneratorBlacklist = append(m.ApplicationSetGeneratorBlacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24664,7 +27627,475 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetFreezeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetFreezeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetFreezeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitGenerator{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24674,29 +28105,33 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
+			if m.SCMProvider == nil {
+				m.SCMProvider = &SCMProviderGenerator{}
+			}
+			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24706,27 +28141,31 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			}
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24753,18 +28192,18 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24774,29 +28213,33 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			if m.Matrix == nil {
+				m.Matrix = &MatrixGenerator{}
+			}
+			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24806,77 +28249,31 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Merge == nil {
+				m.Merge = &MergeGenerator{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24903,16 +28300,16 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
 			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24939,16 +28336,16 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
 			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24975,16 +28372,16 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitGenerator{}
+			if m.OCI == nil {
+				m.OCI = &OCIGenerator{}
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25011,16 +28408,16 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCMProvider == nil {
-				m.SCMProvider = &SCMProviderGenerator{}
+			if m.AWSAccounts == nil {
+				m.AWSAccounts = &AWSAccountsGenerator{}
 			}
-			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AWSAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraformState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25047,18 +28444,68 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			if m.TerraformState == nil {
+				m.TerraformState = &TerraformStateGenerator{}
 			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TerraformState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25068,31 +28515,27 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
-			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25119,18 +28562,15 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Matrix == nil {
-				m.Matrix = &MatrixGenerator{}
-			}
-			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25140,31 +28580,77 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &MergeGenerator{}
-			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailurePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreRender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25191,16 +28677,14 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreRender = append(m.PreRender, ApplicationSetHook{})
+			if err := m.PreRender[len(m.PreRender)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25227,10 +28711,8 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PostRender = append(m.PostRender, ApplicationSetHook{})
+			if err := m.PostRender[len(m.PostRender)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25372,6 +28854,92 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetManagedNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetManagedNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetManagedNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25574,16 +29142,124 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			}
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Matrix == nil {
+				m.Matrix = &v11.JSON{}
+			}
+			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merge == nil {
+				m.Merge = &v11.JSON{}
 			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25610,16 +29286,16 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
 			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25646,16 +29322,16 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Matrix == nil {
-				m.Matrix = &v11.JSON{}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
 			}
-			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25682,16 +29358,36 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &v11.JSON{}
+			if m.OCI == nil {
+				m.OCI = &OCIGenerator{}
 			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25718,16 +29414,16 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
+			if m.AWSAccounts == nil {
+				m.AWSAccounts = &AWSAccountsGenerator{}
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AWSAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraformState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25754,10 +29450,10 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
+			if m.TerraformState == nil {
+				m.TerraformState = &TerraformStateGenerator{}
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TerraformState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25782,6 +29478,76 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetPruneAfterHealthy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetPruneAfterHealthy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetPruneAfterHealthy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26437,14 +30203,418 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
-			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
+			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TemplatePatch = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = &ApplicationSetHooks{}
+			}
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespace == nil {
+				m.ManagedNamespace = &ApplicationSetManagedNamespace{}
+			}
+			if err := m.ManagedNamespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &ApplicationSetTemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveApplicationFinalizers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.PreserveApplicationFinalizers = &b
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezeWindows = append(m.FreezeWindows, ApplicationSetFreezeWindow{})
+			if err := m.FreezeWindows[len(m.FreezeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
+			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulReconcileAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSuccessfulReconcileAt == nil {
+				m.LastSuccessfulReconcileAt = &v1.Time{}
+			}
+			if err := m.LastSuccessfulReconcileAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftedApplications", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26472,9 +30642,84 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TemplatePatch = &s
+			m.DriftedApplications = append(m.DriftedApplications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GeneratedParameterSets = append(m.GeneratedParameterSets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GeneratedParameterSets) == 0 {
+					m.GeneratedParameterSets = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GeneratedParameterSets = append(m.GeneratedParameterSets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedParameterSets", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26496,7 +30741,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26519,17 +30764,17 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26539,29 +30784,27 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26588,42 +30831,10 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
-			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.RollingSync == nil {
+				m.RollingSync = &ApplicationSetRolloutStrategy{}
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26648,7 +30859,7 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26671,15 +30882,35 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreserveResourcesOnDeletion = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26707,11 +30938,12 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
+			m.ApplicationsSync = &s
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneAfterHealthy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26738,10 +30970,10 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollingSync == nil {
-				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			if m.PruneAfterHealthy == nil {
+				m.PruneAfterHealthy = &ApplicationSetPruneAfterHealthy{}
 			}
-			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PruneAfterHealthy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26766,7 +30998,7 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26789,17 +31021,17 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26809,17 +31041,30 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PreserveResourcesOnDeletion = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26829,24 +31074,24 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
-			m.ApplicationsSync = &s
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26869,7 +31114,7 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTemplateGitRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26892,17 +31137,17 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTemplateGitRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTemplateGitRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26912,30 +31157,29 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26945,24 +31189,55 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27385,6 +31660,92 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetTemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &ApplicationSetTemplateGitRef{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27702,6 +32063,134 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCI == nil {
+				m.OCI = &OCIGenerator{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSAccounts == nil {
+				m.AWSAccounts = &AWSAccountsGenerator{}
+			}
+			if err := m.AWSAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerraformState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TerraformState == nil {
+				m.TerraformState = &TerraformStateGenerator{}
+			}
+			if err := m.TerraformState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33216,6 +37705,62 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.FlatList = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValuesObject == nil {
+				m.ValuesObject = &v11.JSON{}
+			}
+			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35931,6 +40476,58 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			}
 			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentParamName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentParamName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitParams", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitParams = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39362,7 +43959,450 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39389,103 +44429,10 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39508,7 +44455,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39531,10 +44478,10 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39566,16 +44513,16 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
 			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39585,24 +44532,23 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39625,7 +44571,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *OCIGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39648,17 +44594,17 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39668,29 +44614,27 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39718,11 +44662,75 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.TagFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39749,63 +44757,92 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSECR", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.AWSECR == nil {
+				m.AWSECR = &OCIGeneratorAWSECR{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.AWSECR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39832,11 +44869,169 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39858,7 +45053,7 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *OCIGeneratorAWSECR) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39881,17 +45076,17 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIGeneratorAWSECR: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIGeneratorAWSECR: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39901,29 +45096,27 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39951,7 +45144,7 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47652,11 +52845,328 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47683,16 +53193,18 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47702,23 +53214,27 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47741,7 +53257,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47764,17 +53280,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47784,28 +53300,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47832,14 +53347,45 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47866,14 +53412,34 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseOpenLibs = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47900,16 +53466,63 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47937,11 +53550,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47969,13 +53582,13 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47985,33 +53598,61 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48021,27 +53662,55 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48064,7 +53733,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48087,15 +53756,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48123,13 +53792,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48139,28 +53808,27 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48188,13 +53856,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48204,31 +53872,157 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48238,17 +54032,29 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseOpenLibs = bool(v != 0)
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48258,24 +54064,23 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48298,7 +54103,7 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48321,10 +54126,10 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -48489,7 +54294,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48517,8 +54322,123 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresPruning = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			}
+			m.SyncWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresDeletionConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresDeletionConfirmation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48540,7 +54460,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48563,17 +54483,36 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48583,27 +54522,81 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48631,13 +54624,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48647,29 +54640,49 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48679,29 +54692,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48711,27 +54725,65 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DeployStartedAt == nil {
+				m.DeployStartedAt = &v1.Time{}
+			}
+			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48759,11 +54811,94 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48791,11 +54926,44 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48823,11 +54991,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48855,11 +55023,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48887,7 +55055,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48910,7 +55078,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48933,17 +55101,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48953,29 +55121,33 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Github == nil {
+				m.Github = &SCMProviderGeneratorGithub{}
+			}
+			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48985,29 +55157,33 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Gitlab == nil {
+				m.Gitlab = &SCMProviderGeneratorGitlab{}
+			}
+			if err := m.Gitlab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49017,29 +55193,33 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.Bitbucket == nil {
+				m.Bitbucket = &SCMProviderGeneratorBitbucket{}
+			}
+			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49049,29 +55229,33 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = &SCMProviderGeneratorBitbucketServer{}
+			}
+			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49081,29 +55265,33 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Gitea == nil {
+				m.Gitea = &SCMProviderGeneratorGitea{}
+			}
+			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49113,27 +55301,31 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			if m.AzureDevOps == nil {
+				m.AzureDevOps = &SCMProviderGeneratorAzureDevOps{}
+			}
+			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49160,18 +55352,16 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, SCMProviderGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneProtocol", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49181,17 +55371,29 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloneProtocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49201,17 +55403,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiresPruning = bool(v != 0)
+			m.RequeueAfterSeconds = &v
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			m.SyncWave = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49221,16 +55423,30 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SyncWave |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresDeletionConfirmation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49240,84 +55456,122 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiresDeletionConfirmation = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 2:
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSCodeCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49344,10 +55598,10 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.AWSCodeCommit == nil {
+				m.AWSCodeCommit = &SCMProviderGeneratorAWSCodeCommit{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AWSCodeCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -49372,7 +55626,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49395,132 +55649,15 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49547,18 +55684,16 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeployStartedAt == nil {
-				m.DeployStartedAt = &v1.Time{}
-			}
-			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TagFilters = append(m.TagFilters, &TagFilter{})
+			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49568,29 +55703,27 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49618,13 +55751,13 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49634,25 +55767,12 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49674,7 +55794,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49697,15 +55817,15 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49733,13 +55853,13 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49749,28 +55869,27 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49798,13 +55917,13 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.TeamProject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49814,29 +55933,33 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.AccessTokenRef == nil {
+				m.AccessTokenRef = &SecretRef{}
+			}
+			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49846,24 +55969,12 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49885,7 +55996,7 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49908,17 +56019,17 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49928,33 +56039,29 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = &SCMProviderGeneratorGithub{}
-			}
-			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49964,31 +56071,27 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitlab == nil {
-				m.Gitlab = &SCMProviderGeneratorGitlab{}
-			}
-			if err := m.Gitlab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50015,18 +56118,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitbucket == nil {
-				m.Bitbucket = &SCMProviderGeneratorBitbucket{}
+			if m.AppPasswordRef == nil {
+				m.AppPasswordRef = &SecretRef{}
 			}
-			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50036,33 +56139,67 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = &SCMProviderGeneratorBitbucketServer{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50072,33 +56209,29 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitea == nil {
-				m.Gitea = &SCMProviderGeneratorGitea{}
-			}
-			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50108,31 +56241,27 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureDevOps == nil {
-				m.AzureDevOps = &SCMProviderGeneratorAzureDevOps{}
-			}
-			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50159,48 +56288,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, SCMProviderGeneratorFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloneProtocol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CloneProtocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50210,15 +56309,15 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
-		case 10:
+			m.AllBranches = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50245,15 +56344,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucket{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50263,122 +56365,15 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 12:
+			m.Insecure = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSCodeCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50405,10 +56400,10 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSCodeCommit == nil {
-				m.AWSCodeCommit = &SCMProviderGeneratorAWSCodeCommit{}
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
 			}
-			if err := m.AWSCodeCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -50433,7 +56428,7 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50456,17 +56451,17 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50476,29 +56471,28 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TagFilters = append(m.TagFilters, &TagFilter{})
-			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RepositoryMatch = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50526,11 +56520,43 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50558,13 +56584,14 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.LabelMatch = &s
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50574,12 +56601,25 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50601,7 +56641,7 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50624,15 +56664,15 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50660,9 +56700,9 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -50694,11 +56734,11 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50708,29 +56748,33 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TeamProject = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50740,31 +56784,15 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AccessTokenRef == nil {
-				m.AccessTokenRef = &SecretRef{}
-			}
-			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			m.AllBranches = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -50781,7 +56809,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50803,7 +56831,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50826,15 +56854,15 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50862,11 +56890,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50894,11 +56922,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50925,14 +56953,46 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppPasswordRef == nil {
-				m.AppPasswordRef = &SecretRef{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -50973,7 +57033,7 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50996,15 +57056,15 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51032,9 +57092,29 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSubgroups = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -51066,9 +57146,9 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51095,14 +57175,14 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -51122,11 +57202,11 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51136,31 +57216,15 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucket{}
-			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.Insecure = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -51177,8 +57241,41 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
+			b := bool(v != 0)
+			m.IncludeSharedProjects = &b
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
@@ -51235,7 +57332,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *SecretRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51258,15 +57355,15 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51294,12 +57391,11 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RepositoryMatch = &s
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51327,11 +57423,11 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51359,44 +57455,61 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			m.Store = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.LabelMatch = &s
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51424,8 +57537,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51448,7 +57560,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51471,17 +57583,17 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceHydrator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceHydrator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51491,29 +57603,30 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncSource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51523,27 +57636,28 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			if err := m.SyncSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydrateTo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51570,53 +57684,13 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.HydrateTo == nil {
+				m.HydrateTo = &HydrateTo{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HydrateTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51638,7 +57712,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51661,17 +57735,17 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceHydratorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceHydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51681,29 +57755,33 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			if m.LastSuccessfulOperation == nil {
+				m.LastSuccessfulOperation = &SuccessfulHydrateOperation{}
+			}
+			if err := m.LastSuccessfulOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51713,29 +57791,83 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			if m.CurrentOperation == nil {
+				m.CurrentOperation = &HydrateOperation{}
+			}
+			if err := m.CurrentOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuccessfulHydrateOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuccessfulHydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51745,31 +57877,27 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51797,13 +57925,13 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51813,12 +57941,25 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51840,7 +57981,7 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51863,15 +58004,15 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51899,11 +58040,11 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -51920,12 +58061,12 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeSubgroups = bool(v != 0)
+			m.Prune = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51935,27 +58076,15 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51982,18 +58111,18 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52003,17 +58132,31 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52023,17 +58166,33 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52043,16 +58202,27 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.IncludeSharedProjects = &b
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52080,11 +58250,11 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52111,13 +58281,62 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			}
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -52139,7 +58358,7 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRef) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52162,15 +58381,15 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52198,11 +58417,11 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52230,61 +58449,43 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignatureKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52312,7 +58513,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -52335,7 +58536,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52358,15 +58559,15 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceHydrator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceHydrator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52393,15 +58594,16 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52411,28 +58613,27 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydrateTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52459,68 +58660,49 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HydrateTo == nil {
-				m.HydrateTo = &HydrateTo{}
-			}
-			if err := m.HydrateTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SourceHydratorStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceHydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52530,31 +58712,27 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastSuccessfulOperation == nil {
-				m.LastSuccessfulOperation = &SuccessfulHydrateOperation{}
-			}
-			if err := m.LastSuccessfulOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52581,10 +58759,10 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentOperation == nil {
-				m.CurrentOperation = &HydrateOperation{}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
 			}
-			if err := m.CurrentOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52609,7 +58787,7 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52632,15 +58810,51 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuccessfulHydrateOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuccessfulHydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52668,13 +58882,13 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52684,27 +58898,31 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52731,7 +58949,10 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52756,7 +58977,7 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52779,17 +59000,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52799,27 +59020,15 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Prune = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -52836,10 +59045,10 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			m.SelfHeal = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -52856,12 +59065,12 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
+			m.AllowEmpty = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52871,67 +59080,68 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			b := bool(v != 0)
+			m.Enabled = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52941,31 +59151,27 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52993,11 +59199,61 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53025,11 +59281,11 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53056,14 +59312,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53091,13 +59346,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			m.SelfHealAttemptsCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53107,11 +59362,24 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53133,7 +59401,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53156,17 +59424,17 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53176,29 +59444,33 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Apply == nil {
+				m.Apply = &SyncStrategyApply{}
+			}
+			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53208,29 +59480,83 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.Hook == nil {
+				m.Hook = &SyncStrategyHook{}
+			}
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53240,29 +59566,67 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53272,23 +59636,24 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -53311,7 +59676,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53334,17 +59699,81 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53354,29 +59783,27 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53404,13 +59831,13 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53420,30 +59847,29 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53453,29 +59879,47 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ManualSync = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53503,13 +59947,13 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseAndOperator", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53519,28 +59963,12 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.UseAndOperator = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53562,7 +59990,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53585,17 +60013,17 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: TLSClientConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TLSClientConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53605,31 +60033,15 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53657,13 +60069,13 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53673,33 +60085,31 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53709,103 +60119,31 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.KeyData = append(m.KeyData[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyData == nil {
+				m.KeyData = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Prune = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAData", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53815,53 +60153,26 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SelfHeal = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.AllowEmpty = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.CAData = append(m.CAData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CAData == nil {
+				m.CAData = []byte{}
 			}
-			b := bool(v != 0)
-			m.Enabled = &b
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53883,7 +60194,7 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncSource) Unmarshal(dAtA []byte) error {
+func (m *TagFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53906,15 +60217,15 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: TagFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TagFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53942,11 +60253,11 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53974,7 +60285,7 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -53997,7 +60308,7 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *TerraformStateCloud) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54020,15 +60331,15 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerraformStateCloud: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerraformStateCloud: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54056,13 +60367,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54072,28 +60383,27 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workspace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54121,13 +60431,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Workspace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54137,23 +60447,27 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -54176,7 +60490,7 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
+func (m *TerraformStateGCS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54199,17 +60513,17 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerraformStateGCS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerraformStateGCS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54219,119 +60533,29 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apply == nil {
-				m.Apply = &SyncStrategyApply{}
-			}
-			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Hook == nil {
-				m.Hook = &SyncStrategyHook{}
-			}
-			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54340,66 +60564,28 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Object = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54426,7 +60612,10 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CredentialsRef == nil {
+				m.CredentialsRef = &SecretRef{}
+			}
+			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -54451,7 +60640,7 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncWindow) Unmarshal(dAtA []byte) error {
+func (m *TerraformStateGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54474,17 +60663,17 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerraformStateGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerraformStateGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54494,29 +60683,33 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.S3 == nil {
+				m.S3 = &TerraformStateS3{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54526,29 +60719,33 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			if m.GCS == nil {
+				m.GCS = &TerraformStateGCS{}
+			}
+			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54558,29 +60755,33 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			if m.HTTP == nil {
+				m.HTTP = &TerraformStateHTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cloud", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54590,27 +60791,31 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
+			if m.Cloud == nil {
+				m.Cloud = &TerraformStateCloud{}
+			}
+			if err := m.Cloud.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54638,13 +60843,13 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			m.ElementsFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSensitive", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54654,29 +60859,17 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.IncludeSensitive = bool(v != 0)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54686,17 +60879,17 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ManualSync = bool(v != 0)
+			m.RequeueAfterSeconds = &v
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54706,29 +60899,30 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseAndOperator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54738,12 +60932,119 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseAndOperator = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54765,7 +61066,7 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
+func (m *TerraformStateHTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54788,35 +61089,15 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TLSClientConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerraformStateHTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLSClientConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerraformStateHTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54844,13 +61125,13 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerName = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRef", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54860,31 +61141,33 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
+			if m.UsernameRef == nil {
+				m.UsernameRef = &SecretRef{}
+			}
+			if err := m.UsernameRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54894,31 +61177,33 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = append(m.KeyData[:0], dAtA[iNdEx:postIndex]...)
-			if m.KeyData == nil {
-				m.KeyData = []byte{}
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAData", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54928,26 +61213,12 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CAData = append(m.CAData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CAData == nil {
-				m.CAData = []byte{}
-			}
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54969,7 +61240,7 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TagFilter) Unmarshal(dAtA []byte) error {
+func (m *TerraformStateS3) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54992,13 +61263,45 @@ func (m *TagFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TagFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerraformStateS3: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TagFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerraformStateS3: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
@@ -55030,9 +61333,9 @@ func (m *TagFilter) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55060,7 +61363,143 @@ func (m *TagFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeyIDRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeyIDRef == nil {
+				m.AccessKeyIDRef = &SecretRef{}
+			}
+			if err := m.AccessKeyIDRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretAccessKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretAccessKeyRef == nil {
+				m.SecretAccessKeyRef = &SecretRef{}
+			}
+			if err := m.SecretAccessKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
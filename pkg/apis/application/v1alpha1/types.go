@@ -2617,6 +2617,10 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// ApplicationSetGeneratorWhitelist contains the list of generator types, such as git or scmProvider, which the ApplicationSets of the project may use. All the generator types are permitted if it is empty
+	ApplicationSetGeneratorWhitelist []string `json:"applicationSetGeneratorWhitelist,omitempty" protobuf:"bytes,15,opt,name=applicationSetGeneratorWhitelist"`
+	// ApplicationSetGeneratorBlacklist contains the list of generator types, such as plugin or scmProvider, which the ApplicationSets of the project may not use
+	ApplicationSetGeneratorBlacklist []string `json:"applicationSetGeneratorBlacklist,omitempty" protobuf:"bytes,16,opt,name=applicationSetGeneratorBlacklist"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -557,6 +557,44 @@ func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	assert.True(t, proj6.IsGroupKindPermitted(schema.GroupKind{Group: "apps", Kind: "Action"}, true))
 }
 
+func TestAppProject_IsApplicationSetGeneratorPermitted(t *testing.T) {
+	proj := AppProject{}
+	assert.True(t, proj.IsApplicationSetGeneratorPermitted("plugin"))
+
+	proj2 := AppProject{
+		Spec: AppProjectSpec{
+			ApplicationSetGeneratorBlacklist: []string{"plugin", "scmProvider"},
+		},
+	}
+	assert.True(t, proj2.IsApplicationSetGeneratorPermitted("git"))
+	assert.False(t, proj2.IsApplicationSetGeneratorPermitted("plugin"))
+	assert.False(t, proj2.IsApplicationSetGeneratorPermitted("scmProvider"))
+
+	proj3 := AppProject{
+		Spec: AppProjectSpec{
+			ApplicationSetGeneratorWhitelist: []string{"git", "list", "matrix"},
+			ApplicationSetGeneratorBlacklist: []string{"list"},
+		},
+	}
+	assert.True(t, proj3.IsApplicationSetGeneratorPermitted("git"))
+	assert.False(t, proj3.IsApplicationSetGeneratorPermitted("list"))
+	assert.False(t, proj3.IsApplicationSetGeneratorPermitted("plugin"))
+}
+
+func TestAppProject_ValidateApplicationSetGenerators(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.ApplicationSetGeneratorWhitelist = []string{"git", "clusters"}
+	proj.Spec.ApplicationSetGeneratorBlacklist = []string{"plugin", "oci"}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.ApplicationSetGeneratorBlacklist = []string{"Plugin"}
+	require.ErrorContains(t, proj.ValidateProject(), "unknown ApplicationSet generator type 'Plugin'")
+
+	proj.Spec.ApplicationSetGeneratorBlacklist = nil
+	proj.Spec.ApplicationSetGeneratorWhitelist = []string{"selector"}
+	require.ErrorContains(t, proj.ValidateProject(), "unknown ApplicationSet generator type 'selector'")
+}
+
 func TestAppProject_GetRoleByName(t *testing.T) {
 	t.Run("NotExists", func(t *testing.T) {
 		p := &AppProject{}
@@ -1,19 +1,6 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
-/*
-Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance with the License.
-You may obtain a copy of the License at
-
-    http://www.apache.org/licenses/LICENSE-2.0
-
-Unless required by applicable law or agreed to in writing, software
-distributed under the License is distributed on an "AS IS" BASIS,
-WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-See the License for the specific language governing permissions and
-limitations under the License.
-*/
 // Code generated by deepcopy-gen. DO NOT EDIT.
 
 package v1alpha1
@@ -26,6 +13,61 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSAccountsGenerator) DeepCopyInto(out *AWSAccountsGenerator) {
+	*out = *in
+	if in.OrganizationalUnits != nil {
+		in, out := &in.OrganizationalUnits, &out.OrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagFilters != nil {
+		in, out := &in.TagFilters, &out.TagFilters
+		*out = make([]*TagFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TagFilter)
+				**out = **in
+			}
+		}
+	}
+	if in.AccessKeyIDRef != nil {
+		in, out := &in.AccessKeyIDRef, &out.AccessKeyIDRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.SecretAccessKeyRef != nil {
+		in, out := &in.SecretAccessKeyRef, &out.SecretAccessKeyRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAccountsGenerator.
+func (in *AWSAccountsGenerator) DeepCopy() *AWSAccountsGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSAccountsGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSAuthConfig) DeepCopyInto(out *AWSAuthConfig) {
 	*out = *in
@@ -174,6 +216,16 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApplicationSetGeneratorWhitelist != nil {
+		in, out := &in.ApplicationSetGeneratorWhitelist, &out.ApplicationSetGeneratorWhitelist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationSetGeneratorBlacklist != nil {
+		in, out := &in.ApplicationSetGeneratorBlacklist, &out.ApplicationSetGeneratorBlacklist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -448,6 +500,22 @@ func (in *ApplicationSetCondition) DeepCopy() *ApplicationSetCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetFreezeWindow) DeepCopyInto(out *ApplicationSetFreezeWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetFreezeWindow.
+func (in *ApplicationSetFreezeWindow) DeepCopy() *ApplicationSetFreezeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetFreezeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 	*out = *in
@@ -501,6 +569,21 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSAccounts != nil {
+		in, out := &in.AWSAccounts, &out.AWSAccounts
+		*out = new(AWSAccountsGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformState != nil {
+		in, out := &in.TerraformState, &out.TerraformState
+		*out = new(TerraformStateGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -514,6 +597,49 @@ func (in *ApplicationSetGenerator) DeepCopy() *ApplicationSetGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetHook) DeepCopyInto(out *ApplicationSetHook) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetHook.
+func (in *ApplicationSetHook) DeepCopy() *ApplicationSetHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetHooks) DeepCopyInto(out *ApplicationSetHooks) {
+	*out = *in
+	if in.PreRender != nil {
+		in, out := &in.PreRender, &out.PreRender
+		*out = make([]ApplicationSetHook, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostRender != nil {
+		in, out := &in.PostRender, &out.PostRender
+		*out = make([]ApplicationSetHook, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetHooks.
+func (in *ApplicationSetHooks) DeepCopy() *ApplicationSetHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ApplicationSetIgnoreDifferences) DeepCopyInto(out *ApplicationSetIgnoreDifferences) {
 	{
@@ -569,6 +695,27 @@ func (in *ApplicationSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetManagedNamespace) DeepCopyInto(out *ApplicationSetManagedNamespace) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ManagedNamespaceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetManagedNamespace.
+func (in *ApplicationSetManagedNamespace) DeepCopy() *ApplicationSetManagedNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetManagedNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedGenerator) {
 	*out = *in
@@ -622,6 +769,26 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AWSAccounts != nil {
+		in, out := &in.AWSAccounts, &out.AWSAccounts
+		*out = new(AWSAccountsGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformState != nil {
+		in, out := &in.TerraformState, &out.TerraformState
+		*out = new(TerraformStateGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -657,6 +824,27 @@ func (in ApplicationSetNestedGenerators) DeepCopy() ApplicationSetNestedGenerato
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetPruneAfterHealthy) DeepCopyInto(out *ApplicationSetPruneAfterHealthy) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetPruneAfterHealthy.
+func (in *ApplicationSetPruneAfterHealthy) DeepCopy() *ApplicationSetPruneAfterHealthy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetPruneAfterHealthy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetResourceIgnoreDifferences) DeepCopyInto(out *ApplicationSetResourceIgnoreDifferences) {
 	*out = *in
@@ -777,6 +965,36 @@ func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ApplicationSetHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedNamespace != nil {
+		in, out := &in.ManagedNamespace, &out.ManagedNamespace
+		*out = new(ApplicationSetManagedNamespace)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ApplicationSetTemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreserveApplicationFinalizers != nil {
+		in, out := &in.PreserveApplicationFinalizers, &out.PreserveApplicationFinalizers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FreezeWindows != nil {
+		in, out := &in.FreezeWindows, &out.FreezeWindows
+		*out = make([]ApplicationSetFreezeWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -814,6 +1032,20 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastSuccessfulReconcileAt != nil {
+		in, out := &in.LastSuccessfulReconcileAt, &out.LastSuccessfulReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DriftedApplications != nil {
+		in, out := &in.DriftedApplications, &out.DriftedApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GeneratedParameterSets != nil {
+		in, out := &in.GeneratedParameterSets, &out.GeneratedParameterSets
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -856,6 +1088,11 @@ func (in *ApplicationSetSyncPolicy) DeepCopyInto(out *ApplicationSetSyncPolicy)
 		*out = new(ApplicationsSyncPolicy)
 		**out = **in
 	}
+	if in.PruneAfterHealthy != nil {
+		in, out := &in.PruneAfterHealthy, &out.PruneAfterHealthy
+		*out = new(ApplicationSetPruneAfterHealthy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -887,6 +1124,22 @@ func (in *ApplicationSetTemplate) DeepCopy() *ApplicationSetTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetTemplateGitRef) DeepCopyInto(out *ApplicationSetTemplateGitRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetTemplateGitRef.
+func (in *ApplicationSetTemplateGitRef) DeepCopy() *ApplicationSetTemplateGitRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetTemplateGitRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetTemplateMeta) DeepCopyInto(out *ApplicationSetTemplateMeta) {
 	*out = *in
@@ -922,6 +1175,27 @@ func (in *ApplicationSetTemplateMeta) DeepCopy() *ApplicationSetTemplateMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetTemplateRef) DeepCopyInto(out *ApplicationSetTemplateRef) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(ApplicationSetTemplateGitRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetTemplateRef.
+func (in *ApplicationSetTemplateRef) DeepCopy() *ApplicationSetTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTerminalGenerator) {
 	*out = *in
@@ -965,6 +1239,26 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AWSAccounts != nil {
+		in, out := &in.AWSAccounts, &out.AWSAccounts
+		*out = new(AWSAccountsGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformState != nil {
+		in, out := &in.TerraformState, &out.TerraformState
+		*out = new(TerraformStateGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1720,6 +2014,16 @@ func (in *ClusterGenerator) DeepCopyInto(out *ClusterGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.ValuesObject != nil {
+		in, out := &in.ValuesObject, &out.ValuesObject
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -2756,6 +3060,61 @@ func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIGenerator) DeepCopyInto(out *OCIGenerator) {
+	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.AWSECR != nil {
+		in, out := &in.AWSECR, &out.AWSECR
+		*out = new(OCIGeneratorAWSECR)
+		**out = **in
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGenerator.
+func (in *OCIGenerator) DeepCopy() *OCIGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIGeneratorAWSECR) DeepCopyInto(out *OCIGeneratorAWSECR) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGeneratorAWSECR.
+func (in *OCIGeneratorAWSECR) DeepCopy() *OCIGeneratorAWSECR {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIGeneratorAWSECR)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -4717,3 +5076,146 @@ func (in *TagFilter) DeepCopy() *TagFilter {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateCloud) DeepCopyInto(out *TerraformStateCloud) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateCloud.
+func (in *TerraformStateCloud) DeepCopy() *TerraformStateCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateCloud)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateGCS) DeepCopyInto(out *TerraformStateGCS) {
+	*out = *in
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateGCS.
+func (in *TerraformStateGCS) DeepCopy() *TerraformStateGCS {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateGCS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateGenerator) DeepCopyInto(out *TerraformStateGenerator) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(TerraformStateS3)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(TerraformStateGCS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(TerraformStateHTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cloud != nil {
+		in, out := &in.Cloud, &out.Cloud
+		*out = new(TerraformStateCloud)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateGenerator.
+func (in *TerraformStateGenerator) DeepCopy() *TerraformStateGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateHTTP) DeepCopyInto(out *TerraformStateHTTP) {
+	*out = *in
+	if in.UsernameRef != nil {
+		in, out := &in.UsernameRef, &out.UsernameRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateHTTP.
+func (in *TerraformStateHTTP) DeepCopy() *TerraformStateHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformStateS3) DeepCopyInto(out *TerraformStateS3) {
+	*out = *in
+	if in.AccessKeyIDRef != nil {
+		in, out := &in.AccessKeyIDRef, &out.AccessKeyIDRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.SecretAccessKeyRef != nil {
+		in, out := &in.SecretAccessKeyRef, &out.SecretAccessKeyRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformStateS3.
+func (in *TerraformStateS3) DeepCopy() *TerraformStateS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformStateS3)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -1949,9 +1949,11 @@ type GitFilesRequest struct {
 	NewGitFileGlobbingEnabled bool                 `protobuf:"varint,5,opt,name=NewGitFileGlobbingEnabled,proto3" json:"NewGitFileGlobbingEnabled,omitempty"`
 	NoRevisionCache           bool                 `protobuf:"varint,6,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
 	VerifyCommit              bool                 `protobuf:"varint,7,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}             `json:"-"`
-	XXX_unrecognized          []byte               `json:"-"`
-	XXX_sizecache             int32                `json:"-"`
+	// Whether to return the last commit modifying each file
+	IncludeCommits       bool     `protobuf:"varint,8,opt,name=includeCommits,proto3" json:"includeCommits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
@@ -2036,12 +2038,21 @@ func (m *GitFilesRequest) GetVerifyCommit() bool {
 	return false
 }
 
+func (m *GitFilesRequest) GetIncludeCommits() bool {
+	if m != nil {
+		return m.IncludeCommits
+	}
+	return false
+}
+
 type GitFilesResponse struct {
 	// Map consisting of path of the path to its contents in bytes
-	Map                  map[string][]byte `protobuf:"bytes,1,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Map map[string][]byte `protobuf:"bytes,1,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map consisting of path of the file to the last commit modifying it, if requested
+	Commits              map[string]*GitFileCommit `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
@@ -2084,6 +2095,79 @@ func (m *GitFilesResponse) GetMap() map[string][]byte {
 	return nil
 }
 
+func (m *GitFilesResponse) GetCommits() map[string]*GitFileCommit {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+// GitFileCommit is the last commit modifying a file
+type GitFileCommit struct {
+	Sha string `protobuf:"bytes,1,opt,name=sha,proto3" json:"sha,omitempty"`
+	// Author of the commit, as name <email>
+	Author string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// Commit date, in seconds since the epoch
+	Date                 int64    `protobuf:"varint,3,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GitFileCommit) Reset()         { *m = GitFileCommit{} }
+func (m *GitFileCommit) String() string { return proto.CompactTextString(m) }
+func (*GitFileCommit) ProtoMessage()    {}
+func (*GitFileCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{29}
+}
+func (m *GitFileCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GitFileCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GitFileCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GitFileCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitFileCommit.Merge(m, src)
+}
+func (m *GitFileCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *GitFileCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitFileCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GitFileCommit proto.InternalMessageInfo
+
+func (m *GitFileCommit) GetSha() string {
+	if m != nil {
+		return m.Sha
+	}
+	return ""
+}
+
+func (m *GitFileCommit) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *GitFileCommit) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
 type GitDirectoriesRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	SubmoduleEnabled     bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
@@ -2099,7 +2183,7 @@ func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2259,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2236,7 +2320,7 @@ func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionFor
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2469,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2465,7 +2549,9 @@ func init() {
 	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
 	proto.RegisterType((*GitFilesRequest)(nil), "repository.GitFilesRequest")
 	proto.RegisterType((*GitFilesResponse)(nil), "repository.GitFilesResponse")
+	proto.RegisterMapType((map[string]*GitFileCommit)(nil), "repository.GitFilesResponse.CommitsEntry")
 	proto.RegisterMapType((map[string][]byte)(nil), "repository.GitFilesResponse.MapEntry")
+	proto.RegisterType((*GitFileCommit)(nil), "repository.GitFileCommit")
 	proto.RegisterType((*GitDirectoriesRequest)(nil), "repository.GitDirectoriesRequest")
 	proto.RegisterType((*GitDirectoriesResponse)(nil), "repository.GitDirectoriesResponse")
 	proto.RegisterType((*UpdateRevisionForPathsRequest)(nil), "repository.UpdateRevisionForPathsRequest")
@@ -2478,156 +2564,162 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
-	0x55, 0xfb, 0x25, 0xed, 0x3e, 0x59, 0x5f, 0x6d, 0x5b, 0x1e, 0xaf, 0x6d, 0xa1, 0x0c, 0xd8, 0xe5,
-	0xd8, 0xc9, 0xaa, 0x6c, 0x57, 0x62, 0x70, 0x42, 0x28, 0x45, 0xb6, 0x25, 0xc7, 0x96, 0x2d, 0xc6,
-	0x4e, 0x28, 0x83, 0x81, 0xea, 0x9d, 0x6d, 0xed, 0x76, 0x34, 0x1f, 0xed, 0x99, 0x1e, 0x05, 0xb9,
-	0x8a, 0x0b, 0x50, 0x5c, 0xb8, 0x73, 0xe0, 0xca, 0x6f, 0xa0, 0x38, 0x72, 0xa0, 0x28, 0x38, 0x52,
-	0x5c, 0xb8, 0x50, 0x05, 0xe5, 0x5f, 0x42, 0xf5, 0xc7, 0x7c, 0xee, 0xec, 0x4a, 0x61, 0x65, 0x05,
-	0xb8, 0x48, 0xd3, 0xaf, 0x5f, 0xbf, 0xf7, 0xfa, 0x7d, 0xf5, 0x7b, 0xdd, 0x0b, 0x57, 0x02, 0xc2,
-	0xfc, 0x90, 0x04, 0xfb, 0x24, 0x58, 0x93, 0x9f, 0x94, 0xfb, 0xc1, 0x41, 0xe6, 0xb3, 0xc3, 0x02,
-	0x9f, 0xfb, 0x08, 0x52, 0x48, 0xfb, 0x51, 0x9f, 0xf2, 0x41, 0xd4, 0xed, 0xd8, 0xbe, 0xbb, 0x86,
-	0x83, 0xbe, 0xcf, 0x02, 0xff, 0x73, 0xf9, 0xf1, 0xae, 0xdd, 0x5b, 0xdb, 0xbf, 0xb5, 0xc6, 0xf6,
-	0xfa, 0x6b, 0x98, 0xd1, 0x70, 0x0d, 0x33, 0xe6, 0x50, 0x1b, 0x73, 0xea, 0x7b, 0x6b, 0xfb, 0x37,
-	0xb0, 0xc3, 0x06, 0xf8, 0xc6, 0x5a, 0x9f, 0x78, 0x24, 0xc0, 0x9c, 0xf4, 0x14, 0xe5, 0xf6, 0x85,
-	0xbe, 0xef, 0xf7, 0x1d, 0xb2, 0x26, 0x47, 0xdd, 0x68, 0x77, 0x8d, 0xb8, 0x8c, 0x6b, 0xb6, 0xe6,
-	0x3f, 0xe6, 0x60, 0x61, 0x1b, 0x7b, 0x74, 0x97, 0x84, 0xdc, 0x22, 0x2f, 0x23, 0x12, 0x72, 0xf4,
-	0x02, 0xea, 0x42, 0x18, 0xa3, 0xb2, 0x5a, 0xb9, 0x3a, 0x7b, 0x73, 0xab, 0x93, 0x4a, 0xd3, 0x89,
-	0xa5, 0x91, 0x1f, 0x3f, 0xb6, 0x7b, 0x9d, 0xfd, 0x5b, 0x1d, 0xb6, 0xd7, 0xef, 0x08, 0x69, 0x3a,
-	0x19, 0x69, 0x3a, 0xb1, 0x34, 0x1d, 0x2b, 0xd9, 0x96, 0x25, 0xa9, 0xa2, 0x36, 0x34, 0x03, 0xb2,
-	0x4f, 0x43, 0xea, 0x7b, 0x46, 0x75, 0xb5, 0x72, 0xb5, 0x65, 0x25, 0x63, 0x64, 0xc0, 0x8c, 0xe7,
-	0x6f, 0x60, 0x7b, 0x40, 0x8c, 0xda, 0x6a, 0xe5, 0x6a, 0xd3, 0x8a, 0x87, 0x68, 0x15, 0x66, 0x31,
-	0x63, 0x8f, 0x70, 0x97, 0x38, 0x0f, 0xc9, 0x81, 0x51, 0x97, 0x0b, 0xb3, 0x20, 0xb1, 0x16, 0x33,
-	0xf6, 0x18, 0xbb, 0xc4, 0x68, 0xc8, 0xd9, 0x78, 0x88, 0x2e, 0x42, 0xcb, 0xc3, 0x2e, 0x09, 0x19,
-	0xb6, 0x89, 0xd1, 0x94, 0x73, 0x29, 0x00, 0xfd, 0x14, 0x96, 0x32, 0x82, 0x3f, 0xf5, 0xa3, 0xc0,
-	0x26, 0x06, 0xc8, 0xad, 0x3f, 0x99, 0x6c, 0xeb, 0xeb, 0x45, 0xb2, 0xd6, 0x30, 0x27, 0xf4, 0x23,
-	0x68, 0x48, 0xcb, 0x1b, 0xb3, 0xab, 0xb5, 0x63, 0xd5, 0xb6, 0x22, 0x8b, 0x3c, 0x98, 0x61, 0x4e,
-	0xd4, 0xa7, 0x5e, 0x68, 0x9c, 0x92, 0x1c, 0x9e, 0x4d, 0xc6, 0x61, 0xc3, 0xf7, 0x76, 0x69, 0x7f,
-	0x1b, 0x7b, 0xb8, 0x4f, 0x5c, 0xe2, 0xf1, 0x1d, 0x49, 0xdc, 0x8a, 0x99, 0xa0, 0x57, 0xb0, 0xb8,
-	0x17, 0x85, 0xdc, 0x77, 0xe9, 0x2b, 0xf2, 0x84, 0x89, 0xb5, 0xa1, 0x31, 0x27, 0xb5, 0xf9, 0x78,
-	0x32, 0xc6, 0x0f, 0x0b, 0x54, 0xad, 0x21, 0x3e, 0xc2, 0x49, 0xf6, 0xa2, 0x2e, 0xf9, 0x8c, 0x04,
-	0xd2, 0xbb, 0xe6, 0x95, 0x93, 0x64, 0x40, 0xca, 0x8d, 0xa8, 0x1e, 0x85, 0xc6, 0xc2, 0x6a, 0x4d,
-	0xb9, 0x51, 0x02, 0x42, 0x57, 0x61, 0x61, 0x9f, 0x04, 0x74, 0xf7, 0xe0, 0x29, 0xed, 0x7b, 0x98,
-	0x47, 0x01, 0x31, 0x16, 0xa5, 0x2b, 0x16, 0xc1, 0xc8, 0x85, 0xb9, 0x01, 0x71, 0x5c, 0xa1, 0xf2,
-	0x8d, 0x80, 0xf4, 0x42, 0x63, 0x49, 0xea, 0x77, 0x73, 0x72, 0x0b, 0x4a, 0x72, 0x56, 0x9e, 0xba,
-	0x10, 0xcc, 0xf3, 0x2d, 0x1d, 0x29, 0x2a, 0x46, 0x90, 0x12, 0xac, 0x00, 0x46, 0x57, 0x60, 0x9e,
-	0x07, 0xd8, 0xde, 0xa3, 0x5e, 0x7f, 0x9b, 0xf0, 0x81, 0xdf, 0x33, 0x4e, 0x4b, 0x4d, 0x14, 0xa0,
-	0xc8, 0x06, 0x44, 0x3c, 0xdc, 0x75, 0x48, 0x4f, 0xf9, 0xe2, 0xb3, 0x03, 0x46, 0x42, 0xe3, 0x8c,
-	0xdc, 0xc5, 0xad, 0x4e, 0x26, 0x43, 0x15, 0x12, 0x44, 0xe7, 0xde, 0xd0, 0xaa, 0x7b, 0x1e, 0x0f,
-	0x0e, 0xac, 0x12, 0x72, 0x68, 0x0f, 0x66, 0xc5, 0x3e, 0x62, 0x57, 0x38, 0x2b, 0x5d, 0xe1, 0xc1,
-	0x64, 0x3a, 0xda, 0x4a, 0x09, 0x5a, 0x59, 0xea, 0xa8, 0x03, 0x68, 0x80, 0xc3, 0xed, 0xc8, 0xe1,
-	0x94, 0x39, 0x44, 0x89, 0x11, 0x1a, 0xcb, 0x52, 0x4d, 0x25, 0x33, 0xe8, 0x21, 0x40, 0x40, 0x76,
-	0x63, 0xbc, 0x73, 0x72, 0xe7, 0xd7, 0xc7, 0xed, 0xdc, 0x4a, 0xb0, 0xd5, 0x8e, 0x33, 0xcb, 0x05,
-	0x73, 0xb1, 0x0d, 0x62, 0x73, 0x1d, 0xed, 0x32, 0xac, 0x0d, 0xe9, 0x62, 0x25, 0x33, 0xc2, 0x17,
-	0x35, 0x54, 0x26, 0xad, 0xf3, 0xca, 0x5b, 0x33, 0x20, 0xb4, 0x05, 0x5f, 0xc3, 0x9e, 0xe7, 0x73,
-	0xb9, 0xfd, 0x58, 0x94, 0x4d, 0x9d, 0xde, 0x77, 0x30, 0x1f, 0x84, 0x46, 0x5b, 0xae, 0x3a, 0x0c,
-	0x4d, 0xb8, 0x04, 0xf5, 0x42, 0x8e, 0x1d, 0x47, 0x22, 0x3d, 0xb8, 0x6b, 0x5c, 0x50, 0x2e, 0x91,
-	0x87, 0xb6, 0xef, 0xc1, 0xb9, 0x11, 0xc6, 0x45, 0x8b, 0x50, 0xdb, 0x23, 0x07, 0xf2, 0x50, 0x68,
-	0x59, 0xe2, 0x13, 0x9d, 0x81, 0xc6, 0x3e, 0x76, 0x22, 0x22, 0xd3, 0x78, 0xd3, 0x52, 0x83, 0x3b,
-	0xd5, 0x6f, 0x56, 0xda, 0xbf, 0xac, 0xc0, 0x42, 0x41, 0x55, 0x25, 0xeb, 0x7f, 0x98, 0x5d, 0x7f,
-	0x0c, 0x81, 0xb3, 0xfb, 0x0c, 0x07, 0x7d, 0xc2, 0x33, 0x82, 0x98, 0x7f, 0xab, 0x80, 0x51, 0xb0,
-	0xe1, 0xf7, 0x28, 0x1f, 0xdc, 0xa7, 0x0e, 0x09, 0xd1, 0x6d, 0x98, 0x09, 0x14, 0x4c, 0x1f, 0x75,
-	0x17, 0xc6, 0x98, 0x7e, 0x6b, 0xca, 0x8a, 0xb1, 0xd1, 0x47, 0xd0, 0x74, 0x09, 0xc7, 0x3d, 0xcc,
-	0xb1, 0x96, 0x7d, 0xb5, 0x6c, 0xa5, 0xe0, 0xb2, 0xad, 0xf1, 0xb6, 0xa6, 0xac, 0x64, 0x0d, 0x7a,
-	0x0f, 0x1a, 0xf6, 0x20, 0xf2, 0xf6, 0xe4, 0x21, 0x37, 0x7b, 0xf3, 0xd2, 0xa8, 0xc5, 0x1b, 0x02,
-	0x69, 0x6b, 0xca, 0x52, 0xd8, 0x1f, 0x4f, 0x43, 0x9d, 0xe1, 0x80, 0x9b, 0xf7, 0xe1, 0x4c, 0x19,
-	0x0b, 0x71, 0xb2, 0xda, 0x03, 0x62, 0xef, 0x85, 0x91, 0xab, 0xd5, 0x9c, 0x8c, 0x11, 0x82, 0x7a,
-	0x48, 0x5f, 0x29, 0x55, 0xd7, 0x2c, 0xf9, 0x6d, 0xbe, 0x0d, 0x4b, 0x43, 0xdc, 0x84, 0x51, 0x95,
-	0x6c, 0x82, 0xc2, 0x29, 0xcd, 0xda, 0x8c, 0xe0, 0xec, 0x33, 0xa9, 0x8b, 0xe4, 0x78, 0x39, 0x89,
-	0x5a, 0xc1, 0xdc, 0x82, 0xe5, 0x22, 0xdb, 0x90, 0xf9, 0x5e, 0x48, 0x44, 0xb0, 0xc9, 0x7c, 0x4c,
-	0x49, 0x2f, 0x9d, 0x95, 0x52, 0x34, 0xad, 0x92, 0x19, 0xf3, 0xb7, 0x55, 0x58, 0xb6, 0x48, 0xe8,
-	0x3b, 0xfb, 0x24, 0x4e, 0x96, 0x27, 0x53, 0xee, 0xfc, 0x00, 0x6a, 0x98, 0x31, 0xed, 0x26, 0x0f,
-	0x8e, 0xad, 0xa0, 0xb0, 0x04, 0x55, 0xf4, 0x0e, 0x2c, 0x61, 0xb7, 0x4b, 0xfb, 0x91, 0x1f, 0x85,
-	0xf1, 0xb6, 0xa4, 0x53, 0xb5, 0xac, 0xe1, 0x09, 0x91, 0x70, 0x42, 0x19, 0x91, 0x0f, 0xbc, 0x1e,
-	0xf9, 0x89, 0xac, 0xa1, 0x6a, 0x56, 0x16, 0x64, 0xda, 0x70, 0x6e, 0x48, 0x49, 0x5a, 0xe1, 0xd9,
-	0xb2, 0xad, 0x52, 0x28, 0xdb, 0x4a, 0xc5, 0xa8, 0x8e, 0x10, 0xc3, 0x7c, 0x5d, 0x81, 0xc5, 0x34,
-	0xb8, 0x34, 0xf9, 0x8b, 0xd0, 0x72, 0x35, 0x2c, 0x34, 0x2a, 0x32, 0x67, 0xa6, 0x80, 0x7c, 0x05,
-	0x57, 0x2d, 0x56, 0x70, 0xcb, 0x30, 0xad, 0x0a, 0x6c, 0xbd, 0x75, 0x3d, 0xca, 0x89, 0x5c, 0x2f,
-	0x88, 0xbc, 0x02, 0x10, 0x26, 0x19, 0xce, 0x98, 0x96, 0xb3, 0x19, 0x08, 0x32, 0xe1, 0x94, 0x3a,
-	0xef, 0x2d, 0x12, 0x46, 0x0e, 0x37, 0x66, 0x24, 0x46, 0x0e, 0x26, 0xe3, 0xcd, 0x77, 0x5d, 0xec,
-	0xf5, 0x42, 0xa3, 0x29, 0x45, 0x4e, 0xc6, 0xa6, 0x0f, 0x0b, 0x8f, 0xa8, 0xd8, 0xdf, 0x6e, 0x78,
-	0x32, 0xa1, 0xf2, 0x3e, 0xd4, 0x05, 0x33, 0x21, 0x54, 0x37, 0xc0, 0x9e, 0x3d, 0x20, 0xb1, 0x1e,
-	0x93, 0xb1, 0x48, 0x02, 0x1c, 0xf7, 0x43, 0xa3, 0x2a, 0xe1, 0xf2, 0xdb, 0xfc, 0x7d, 0x55, 0x49,
-	0xba, 0xce, 0x58, 0xf8, 0xd5, 0x37, 0x00, 0xe5, 0x25, 0x49, 0x6d, 0xb8, 0x24, 0x29, 0x88, 0xfc,
-	0x65, 0x4a, 0x92, 0x63, 0x3a, 0xe4, 0xcc, 0x08, 0x66, 0xd6, 0x19, 0x13, 0x82, 0xa0, 0x1b, 0x50,
-	0xc7, 0x8c, 0x29, 0x85, 0x17, 0xf2, 0xb9, 0x46, 0x11, 0xff, 0xb5, 0x48, 0x12, 0xb5, 0x7d, 0x1b,
-	0x5a, 0x09, 0xe8, 0x30, 0xb6, 0xad, 0x2c, 0xdb, 0x55, 0x00, 0x55, 0x73, 0x3f, 0xf0, 0x76, 0x7d,
-	0x61, 0x52, 0x11, 0x08, 0x7a, 0xa9, 0xfc, 0x36, 0xef, 0xc4, 0x18, 0x52, 0xb6, 0x77, 0xa0, 0x41,
-	0x39, 0x71, 0x63, 0xe1, 0x96, 0xb3, 0xc2, 0xa5, 0x84, 0x2c, 0x85, 0x64, 0xfe, 0xb9, 0x09, 0xe7,
-	0x85, 0xc5, 0x9e, 0xca, 0x10, 0x5a, 0x67, 0xec, 0x2e, 0xe1, 0x98, 0x3a, 0xe1, 0x77, 0x23, 0x12,
-	0x1c, 0xbc, 0x61, 0xc7, 0xe8, 0xc3, 0xb4, 0x8a, 0x40, 0x9d, 0x2d, 0x8f, 0xbd, 0xfd, 0xd2, 0xe4,
-	0xd3, 0x9e, 0xab, 0xf6, 0x66, 0x7a, 0xae, 0xb2, 0x1e, 0xa8, 0x7e, 0x42, 0x3d, 0xd0, 0xe8, 0x36,
-	0x38, 0xd3, 0x5c, 0x4f, 0xe7, 0x9b, 0xeb, 0x92, 0xd6, 0x62, 0xe6, 0xa8, 0xad, 0x45, 0xb3, 0xb4,
-	0xb5, 0x70, 0x4b, 0xe3, 0xb8, 0x25, 0xd5, 0xfd, 0xed, 0xac, 0x07, 0x8e, 0xf4, 0xb5, 0x49, 0x9a,
-	0x0c, 0x78, 0xa3, 0x4d, 0xc6, 0xa7, 0xb9, 0xa6, 0x41, 0xb5, 0xed, 0xef, 0x1d, 0x6d, 0x4f, 0x63,
-	0xda, 0x87, 0xff, 0xbb, 0xd2, 0xfb, 0x17, 0xb2, 0xe2, 0x62, 0x7e, 0xaa, 0x83, 0xe4, 0xb0, 0x17,
-	0xe7, 0x90, 0x38, 0x76, 0x75, 0xd2, 0x12, 0xdf, 0xe8, 0x3a, 0xd4, 0x85, 0x92, 0x75, 0x49, 0x7c,
-	0x2e, 0xab, 0x4f, 0x61, 0x89, 0x75, 0xc6, 0x9e, 0x32, 0x62, 0x5b, 0x12, 0x09, 0xdd, 0x81, 0x56,
-	0xe2, 0xf8, 0x3a, 0xb2, 0x2e, 0x66, 0x57, 0x24, 0x71, 0x12, 0x2f, 0x4b, 0xd1, 0xc5, 0xda, 0x1e,
-	0x0d, 0x88, 0x2d, 0x0b, 0xc6, 0xc6, 0xf0, 0xda, 0xbb, 0xf1, 0x64, 0xb2, 0x36, 0x41, 0x47, 0x37,
-	0x60, 0x5a, 0xdd, 0x73, 0xc8, 0x08, 0x9a, 0xbd, 0x79, 0x7e, 0x38, 0x99, 0xc6, 0xab, 0x34, 0xa2,
-	0xf9, 0xa7, 0x0a, 0xbc, 0x95, 0x3a, 0x44, 0x1c, 0x4d, 0x71, 0xcd, 0xfe, 0xd5, 0x9f, 0xb8, 0x57,
-	0x60, 0x5e, 0x36, 0x09, 0xe9, 0x75, 0x87, 0xba, 0x79, 0x2b, 0x40, 0xcd, 0xdf, 0x55, 0xe0, 0xf2,
-	0xf0, 0x3e, 0x36, 0x06, 0x38, 0xe0, 0x89, 0x79, 0x4f, 0x62, 0x2f, 0xf1, 0x81, 0x57, 0x4d, 0x0f,
-	0xbc, 0xdc, 0xfe, 0x6a, 0xf9, 0xfd, 0x99, 0x7f, 0xa8, 0xc2, 0x6c, 0xc6, 0x81, 0xca, 0x0e, 0x4c,
-	0x51, 0x0c, 0x4a, 0xbf, 0x95, 0x6d, 0xa1, 0x3c, 0x14, 0x5a, 0x56, 0x06, 0x82, 0xf6, 0x00, 0x18,
-	0x0e, 0xb0, 0x4b, 0x38, 0x09, 0x44, 0x26, 0x17, 0x11, 0xff, 0x70, 0xf2, 0xec, 0xb2, 0x13, 0xd3,
-	0xb4, 0x32, 0xe4, 0x45, 0x35, 0x2b, 0x59, 0x87, 0x3a, 0x7f, 0xeb, 0x11, 0xfa, 0x02, 0xe6, 0x77,
-	0xa9, 0x43, 0x76, 0x52, 0x41, 0xa6, 0xa5, 0x20, 0x4f, 0x26, 0x17, 0xe4, 0x7e, 0x96, 0xae, 0x55,
-	0x60, 0x63, 0x5e, 0x83, 0xc5, 0x62, 0x3c, 0x09, 0x21, 0xa9, 0x8b, 0xfb, 0x89, 0xb6, 0xf4, 0xc8,
-	0x44, 0xb0, 0x58, 0x8c, 0x1f, 0xf3, 0x9f, 0x55, 0x38, 0x9b, 0x90, 0x5b, 0xf7, 0x3c, 0x3f, 0xf2,
-	0x6c, 0x79, 0x75, 0x58, 0x6a, 0x8b, 0x33, 0xd0, 0xe0, 0x94, 0x3b, 0x49, 0xe1, 0x23, 0x07, 0xe2,
-	0xec, 0xe2, 0xbe, 0xef, 0x70, 0xca, 0xb4, 0x81, 0xe3, 0xa1, 0xb2, 0xfd, 0xcb, 0x88, 0x06, 0xa4,
-	0x27, 0x33, 0x41, 0xd3, 0x4a, 0xc6, 0x62, 0x4e, 0x54, 0x35, 0xb2, 0xc4, 0x57, 0xca, 0x4c, 0xc6,
-	0xd2, 0xef, 0x7d, 0xc7, 0x21, 0xb6, 0x50, 0x47, 0xa6, 0x09, 0x28, 0x40, 0x65, 0x73, 0xc1, 0x03,
-	0xea, 0xf5, 0x75, 0x0b, 0xa0, 0x47, 0x42, 0x4e, 0x1c, 0x04, 0xf8, 0x40, 0x57, 0xfe, 0x6a, 0x80,
-	0x3e, 0x84, 0x9a, 0x8b, 0x99, 0x3e, 0xe8, 0xae, 0xe5, 0xb2, 0x43, 0x99, 0x06, 0x3a, 0xdb, 0x98,
-	0xa9, 0x93, 0x40, 0x2c, 0x6b, 0xbf, 0x0f, 0xcd, 0x18, 0xf0, 0xa5, 0x4a, 0xc2, 0xcf, 0x61, 0x2e,
-	0x97, 0x7c, 0xd0, 0x73, 0x58, 0x4e, 0x3d, 0x2a, 0xcb, 0x50, 0x17, 0x81, 0x6f, 0x1d, 0x2a, 0x99,
-	0x35, 0x82, 0x80, 0xf9, 0x12, 0x96, 0x84, 0xcb, 0xc8, 0xc0, 0x3f, 0xa1, 0xd6, 0xe6, 0x03, 0x68,
-	0x25, 0x2c, 0x4b, 0x7d, 0xa6, 0x0d, 0xcd, 0xfd, 0xf8, 0x4a, 0x57, 0xf5, 0x36, 0xc9, 0xd8, 0x5c,
-	0x07, 0x94, 0x95, 0x57, 0x9f, 0x40, 0xd7, 0xf3, 0x45, 0xf1, 0xd9, 0xe2, 0x71, 0x23, 0xd1, 0xe3,
-	0x9a, 0xf8, 0xef, 0x55, 0x58, 0xd8, 0xa4, 0xf2, 0x8e, 0xe4, 0x84, 0x92, 0xdc, 0x35, 0x58, 0x0c,
-	0xa3, 0xae, 0xeb, 0xf7, 0x22, 0x87, 0xe8, 0xa2, 0x40, 0x9f, 0xf4, 0x43, 0xf0, 0x71, 0xc9, 0x4f,
-	0x28, 0x8b, 0x61, 0x3e, 0xd0, 0xdd, 0xaf, 0xfc, 0x46, 0x1f, 0xc2, 0xf9, 0xc7, 0xe4, 0x0b, 0xbd,
-	0x9f, 0x4d, 0xc7, 0xef, 0x76, 0xa9, 0xd7, 0x8f, 0x99, 0x34, 0x24, 0x93, 0xd1, 0x08, 0x65, 0xa5,
-	0xe2, 0x74, 0x79, 0xa9, 0x98, 0x74, 0xd0, 0x1b, 0xbe, 0xeb, 0x52, 0xae, 0x2b, 0xca, 0x1c, 0xcc,
-	0xfc, 0x79, 0x05, 0x16, 0x53, 0xcd, 0x6a, 0xdb, 0xdc, 0x56, 0x31, 0xa4, 0x2c, 0x73, 0x39, 0x6b,
-	0x99, 0x22, 0xea, 0x7f, 0x1e, 0x3e, 0xa7, 0xb2, 0xe1, 0xf3, 0xab, 0x2a, 0x9c, 0xdd, 0xa4, 0x3c,
-	0x4e, 0x5c, 0xf4, 0x7f, 0xcd, 0xca, 0x25, 0x36, 0xa9, 0x1f, 0xcd, 0x26, 0x8d, 0x12, 0x9b, 0x74,
-	0x60, 0xb9, 0xa8, 0x0c, 0x6d, 0x98, 0x33, 0xd0, 0x60, 0xf2, 0xd2, 0x59, 0xdd, 0x2b, 0xa8, 0x81,
-	0xf9, 0xb3, 0x19, 0xb8, 0xf4, 0x29, 0xeb, 0x61, 0x9e, 0xdc, 0x19, 0xdd, 0xf7, 0x03, 0x79, 0xeb,
-	0x7c, 0x32, 0x5a, 0x2c, 0xbc, 0x0c, 0x56, 0xc7, 0xbe, 0x0c, 0xd6, 0xc6, 0xbc, 0x0c, 0xd6, 0x8f,
-	0xf4, 0x32, 0xd8, 0x38, 0xb1, 0x97, 0xc1, 0xe1, 0x5e, 0x6b, 0xba, 0xb4, 0xd7, 0x7a, 0x9e, 0xeb,
-	0x47, 0x66, 0x64, 0xd8, 0x7c, 0x2b, 0x1b, 0x36, 0x63, 0xad, 0x33, 0xf6, 0x49, 0xa3, 0xf0, 0xa0,
-	0xd6, 0x3c, 0xf4, 0x41, 0xad, 0x35, 0xfc, 0xa0, 0x56, 0xfe, 0x26, 0x03, 0x23, 0xdf, 0x64, 0xae,
-	0xc0, 0x7c, 0x78, 0xe0, 0xd9, 0xa4, 0x97, 0xdc, 0x24, 0xce, 0xaa, 0x6d, 0xe7, 0xa1, 0xb9, 0x88,
-	0x38, 0x55, 0x88, 0x88, 0xc4, 0x53, 0xe7, 0x32, 0x9e, 0x5a, 0x16, 0x27, 0xf3, 0x23, 0xdb, 0xdc,
-	0xc2, 0x73, 0xc9, 0x42, 0xe9, 0x73, 0xc9, 0x7f, 0x4d, 0xb3, 0xf5, 0x19, 0xac, 0x8c, 0xb2, 0xb2,
-	0x0e, 0x5e, 0x03, 0x66, 0xec, 0x01, 0xf6, 0xfa, 0xf2, 0x5a, 0x50, 0x76, 0xff, 0x7a, 0x38, 0xae,
-	0x3b, 0xb8, 0xf9, 0x47, 0x80, 0xa5, 0xb4, 0xea, 0x17, 0x7f, 0xa9, 0x4d, 0xd0, 0x13, 0x58, 0x8c,
-	0x9f, 0x97, 0xe2, 0x8b, 0x5c, 0x34, 0xee, 0xed, 0xa4, 0x7d, 0xb1, 0x7c, 0x52, 0x89, 0x66, 0x4e,
-	0x21, 0x1b, 0xce, 0x17, 0x09, 0xa6, 0xcf, 0x34, 0xdf, 0x18, 0x43, 0x39, 0xc1, 0x3a, 0x8c, 0xc5,
-	0xd5, 0x0a, 0x7a, 0x0e, 0xf3, 0xf9, 0xc7, 0x04, 0x94, 0x2b, 0x83, 0x4a, 0xdf, 0x37, 0xda, 0xe6,
-	0x38, 0x94, 0x44, 0xfe, 0x17, 0xc2, 0x0d, 0x72, 0xf7, 0xe6, 0xc8, 0xcc, 0xdf, 0x08, 0x94, 0xbd,
-	0x3c, 0xb4, 0xbf, 0x3e, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0x34, 0xe3, 0xbb, 0xe4, 0xbc, 0x9a, 0x0b,
-	0x37, 0xcc, 0xed, 0xc5, 0x3c, 0xbd, 0xdd, 0xd0, 0x9c, 0x42, 0x1f, 0xa9, 0xc5, 0xeb, 0x8c, 0x95,
-	0x2c, 0xce, 0xdc, 0xa0, 0xb6, 0x4f, 0x97, 0xdc, 0x5a, 0x9a, 0x53, 0xe8, 0x3b, 0x30, 0x2b, 0xbe,
-	0x76, 0xf4, 0xf3, 0xfe, 0x72, 0x47, 0xfd, 0x9a, 0xa4, 0x13, 0xff, 0x9a, 0xa4, 0x73, 0xcf, 0x65,
-	0xfc, 0xa0, 0x5d, 0x72, 0xad, 0xa8, 0x09, 0xbc, 0x80, 0xb9, 0x4d, 0xc2, 0xd3, 0x5b, 0x00, 0x74,
-	0xf9, 0x48, 0x77, 0x25, 0x6d, 0xb3, 0x88, 0x36, 0x7c, 0x91, 0x60, 0x4e, 0xa1, 0x5f, 0x57, 0xe0,
-	0xf4, 0x26, 0xe1, 0xc5, 0xbe, 0x1a, 0xbd, 0x5b, 0xce, 0x64, 0x44, 0xff, 0xdd, 0x7e, 0x3c, 0x69,
-	0x4c, 0xe6, 0xc9, 0x9a, 0x53, 0xe8, 0x37, 0x15, 0x38, 0x97, 0x11, 0x2c, 0xdb, 0x28, 0xa3, 0x1b,
-	0xe3, 0x85, 0x2b, 0x69, 0xaa, 0xdb, 0x9f, 0x4c, 0xf8, 0xab, 0x8d, 0x0c, 0x49, 0x73, 0x0a, 0xed,
-	0x48, 0x9b, 0xa4, 0x75, 0x31, 0xba, 0x54, 0x5a, 0x00, 0x27, 0xdc, 0x57, 0x46, 0x4d, 0x27, 0x76,
-	0xf8, 0x04, 0x66, 0x37, 0x09, 0x8f, 0x0b, 0xb4, 0xbc, 0xa7, 0x15, 0x6a, 0xe7, 0x7c, 0xa8, 0x16,
-	0x6b, 0x3a, 0xe9, 0x31, 0x4b, 0x8a, 0x56, 0xa6, 0x08, 0xc9, 0xc7, 0x6a, 0x69, 0xb5, 0x96, 0xf7,
-	0x98, 0xf2, 0x1a, 0xc6, 0x9c, 0x42, 0x2f, 0x61, 0xb9, 0x3c, 0x55, 0xa2, 0xb7, 0x8f, 0x7c, 0x68,
-	0xb6, 0xaf, 0x1d, 0x05, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5e, 0xaf, 0x54, 0xfe, 0xfa, 0x7a,
-	0xa5, 0xf2, 0xaf, 0xd7, 0x2b, 0x95, 0xef, 0xdf, 0x3a, 0xe4, 0xd7, 0x5d, 0x99, 0x1f, 0x8c, 0x61,
-	0x46, 0x6d, 0x87, 0x12, 0x8f, 0x77, 0xa7, 0x65, 0xbc, 0xdd, 0xfa, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x17, 0x3e, 0x16, 0x5a, 0x4f, 0x26, 0x00, 0x00,
+	// 2465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x4b, 0x73, 0x1c, 0x49,
+	0xd1, 0x9a, 0x97, 0x34, 0x93, 0x7a, 0x97, 0x6d, 0xb9, 0x35, 0xb6, 0xf5, 0x69, 0xfb, 0xc3, 0x0e,
+	0x3f, 0x76, 0x67, 0xc2, 0x76, 0xec, 0x1a, 0xbc, 0xcb, 0x12, 0x5a, 0xd9, 0x96, 0xbc, 0xb6, 0x6c,
+	0xd1, 0xb6, 0x97, 0x30, 0x18, 0x88, 0x9a, 0x9e, 0x52, 0x4f, 0xaf, 0xfa, 0x51, 0xee, 0xae, 0xd6,
+	0x22, 0x47, 0x70, 0x21, 0x08, 0x2e, 0xdc, 0x39, 0x70, 0xe1, 0xc0, 0x6f, 0x20, 0x38, 0x72, 0x20,
+	0x08, 0x38, 0x12, 0x9c, 0x89, 0x80, 0xf0, 0x1f, 0x81, 0xa8, 0x47, 0x3f, 0xa7, 0x67, 0x24, 0xc7,
+	0xd8, 0x5a, 0xe0, 0x22, 0x75, 0x65, 0x55, 0x3e, 0x2a, 0x2b, 0x33, 0x2b, 0x33, 0x6b, 0xe0, 0x52,
+	0x40, 0xa8, 0x1f, 0x92, 0xe0, 0x80, 0x04, 0x5d, 0xf1, 0x69, 0x33, 0x3f, 0x38, 0xcc, 0x7c, 0x76,
+	0x68, 0xe0, 0x33, 0x1f, 0x41, 0x0a, 0x69, 0x3f, 0xb4, 0x6c, 0x36, 0x88, 0x7a, 0x1d, 0xd3, 0x77,
+	0xbb, 0x38, 0xb0, 0x7c, 0x1a, 0xf8, 0x5f, 0x8a, 0x8f, 0x0f, 0xcc, 0x7e, 0xf7, 0xe0, 0x66, 0x97,
+	0xee, 0x5b, 0x5d, 0x4c, 0xed, 0xb0, 0x8b, 0x29, 0x75, 0x6c, 0x13, 0x33, 0xdb, 0xf7, 0xba, 0x07,
+	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0x7a, 0xd7, 0x22, 0x1e, 0x09, 0x30, 0x23, 0x7d, 0x49, 0xb9, 0x7d,
+	0xce, 0xf2, 0x7d, 0xcb, 0x21, 0x5d, 0x31, 0xea, 0x45, 0x7b, 0x5d, 0xe2, 0x52, 0xa6, 0xd8, 0xea,
+	0x7f, 0x9f, 0x87, 0xc5, 0x1d, 0xec, 0xd9, 0x7b, 0x24, 0x64, 0x06, 0x79, 0x19, 0x91, 0x90, 0xa1,
+	0x17, 0x50, 0xe7, 0xc2, 0x68, 0x95, 0xf5, 0xca, 0xe5, 0xd9, 0x1b, 0xdb, 0x9d, 0x54, 0x9a, 0x4e,
+	0x2c, 0x8d, 0xf8, 0xf8, 0xb1, 0xd9, 0xef, 0x1c, 0xdc, 0xec, 0xd0, 0x7d, 0xab, 0xc3, 0xa5, 0xe9,
+	0x64, 0xa4, 0xe9, 0xc4, 0xd2, 0x74, 0x8c, 0x64, 0x5b, 0x86, 0xa0, 0x8a, 0xda, 0xd0, 0x0c, 0xc8,
+	0x81, 0x1d, 0xda, 0xbe, 0xa7, 0x55, 0xd7, 0x2b, 0x97, 0x5b, 0x46, 0x32, 0x46, 0x1a, 0xcc, 0x78,
+	0xfe, 0x26, 0x36, 0x07, 0x44, 0xab, 0xad, 0x57, 0x2e, 0x37, 0x8d, 0x78, 0x88, 0xd6, 0x61, 0x16,
+	0x53, 0xfa, 0x10, 0xf7, 0x88, 0xf3, 0x80, 0x1c, 0x6a, 0x75, 0x81, 0x98, 0x05, 0x71, 0x5c, 0x4c,
+	0xe9, 0x23, 0xec, 0x12, 0xad, 0x21, 0x66, 0xe3, 0x21, 0x3a, 0x0f, 0x2d, 0x0f, 0xbb, 0x24, 0xa4,
+	0xd8, 0x24, 0x5a, 0x53, 0xcc, 0xa5, 0x00, 0xf4, 0x53, 0x58, 0xce, 0x08, 0xfe, 0xc4, 0x8f, 0x02,
+	0x93, 0x68, 0x20, 0xb6, 0xfe, 0x78, 0xb2, 0xad, 0x6f, 0x14, 0xc9, 0x1a, 0xc3, 0x9c, 0xd0, 0x8f,
+	0xa0, 0x21, 0x4e, 0x5e, 0x9b, 0x5d, 0xaf, 0xbd, 0x55, 0x6d, 0x4b, 0xb2, 0xc8, 0x83, 0x19, 0xea,
+	0x44, 0x96, 0xed, 0x85, 0xda, 0x9c, 0xe0, 0xf0, 0x74, 0x32, 0x0e, 0x9b, 0xbe, 0xb7, 0x67, 0x5b,
+	0x3b, 0xd8, 0xc3, 0x16, 0x71, 0x89, 0xc7, 0x76, 0x05, 0x71, 0x23, 0x66, 0x82, 0x5e, 0xc1, 0xd2,
+	0x7e, 0x14, 0x32, 0xdf, 0xb5, 0x5f, 0x91, 0xc7, 0x94, 0xe3, 0x86, 0xda, 0xbc, 0xd0, 0xe6, 0xa3,
+	0xc9, 0x18, 0x3f, 0x28, 0x50, 0x35, 0x86, 0xf8, 0x70, 0x23, 0xd9, 0x8f, 0x7a, 0xe4, 0x0b, 0x12,
+	0x08, 0xeb, 0x5a, 0x90, 0x46, 0x92, 0x01, 0x49, 0x33, 0xb2, 0xd5, 0x28, 0xd4, 0x16, 0xd7, 0x6b,
+	0xd2, 0x8c, 0x12, 0x10, 0xba, 0x0c, 0x8b, 0x07, 0x24, 0xb0, 0xf7, 0x0e, 0x9f, 0xd8, 0x96, 0x87,
+	0x59, 0x14, 0x10, 0x6d, 0x49, 0x98, 0x62, 0x11, 0x8c, 0x5c, 0x98, 0x1f, 0x10, 0xc7, 0xe5, 0x2a,
+	0xdf, 0x0c, 0x48, 0x3f, 0xd4, 0x96, 0x85, 0x7e, 0xb7, 0x26, 0x3f, 0x41, 0x41, 0xce, 0xc8, 0x53,
+	0xe7, 0x82, 0x79, 0xbe, 0xa1, 0x3c, 0x45, 0xfa, 0x08, 0x92, 0x82, 0x15, 0xc0, 0xe8, 0x12, 0x2c,
+	0xb0, 0x00, 0x9b, 0xfb, 0xb6, 0x67, 0xed, 0x10, 0x36, 0xf0, 0xfb, 0xda, 0x29, 0xa1, 0x89, 0x02,
+	0x14, 0x99, 0x80, 0x88, 0x87, 0x7b, 0x0e, 0xe9, 0x4b, 0x5b, 0x7c, 0x7a, 0x48, 0x49, 0xa8, 0x9d,
+	0x16, 0xbb, 0xb8, 0xd9, 0xc9, 0x44, 0xa8, 0x42, 0x80, 0xe8, 0xdc, 0x1d, 0xc2, 0xba, 0xeb, 0xb1,
+	0xe0, 0xd0, 0x28, 0x21, 0x87, 0xf6, 0x61, 0x96, 0xef, 0x23, 0x36, 0x85, 0x33, 0xc2, 0x14, 0xee,
+	0x4f, 0xa6, 0xa3, 0xed, 0x94, 0xa0, 0x91, 0xa5, 0x8e, 0x3a, 0x80, 0x06, 0x38, 0xdc, 0x89, 0x1c,
+	0x66, 0x53, 0x87, 0x48, 0x31, 0x42, 0x6d, 0x45, 0xa8, 0xa9, 0x64, 0x06, 0x3d, 0x00, 0x08, 0xc8,
+	0x5e, 0xbc, 0xee, 0xac, 0xd8, 0xf9, 0xb5, 0x71, 0x3b, 0x37, 0x92, 0xd5, 0x72, 0xc7, 0x19, 0x74,
+	0xce, 0x9c, 0x6f, 0x83, 0x98, 0x4c, 0x79, 0xbb, 0x70, 0x6b, 0x4d, 0x98, 0x58, 0xc9, 0x0c, 0xb7,
+	0x45, 0x05, 0x15, 0x41, 0x6b, 0x55, 0x5a, 0x6b, 0x06, 0x84, 0xb6, 0xe1, 0xff, 0xb0, 0xe7, 0xf9,
+	0x4c, 0x6c, 0x3f, 0x16, 0x65, 0x4b, 0x85, 0xf7, 0x5d, 0xcc, 0x06, 0xa1, 0xd6, 0x16, 0x58, 0x47,
+	0x2d, 0xe3, 0x26, 0x61, 0x7b, 0x21, 0xc3, 0x8e, 0x23, 0x16, 0xdd, 0xbf, 0xa3, 0x9d, 0x93, 0x26,
+	0x91, 0x87, 0xb6, 0xef, 0xc2, 0xd9, 0x11, 0x87, 0x8b, 0x96, 0xa0, 0xb6, 0x4f, 0x0e, 0xc5, 0xa5,
+	0xd0, 0x32, 0xf8, 0x27, 0x3a, 0x0d, 0x8d, 0x03, 0xec, 0x44, 0x44, 0x84, 0xf1, 0xa6, 0x21, 0x07,
+	0xb7, 0xab, 0xdf, 0xac, 0xb4, 0x7f, 0x51, 0x81, 0xc5, 0x82, 0xaa, 0x4a, 0xf0, 0x7f, 0x98, 0xc5,
+	0x7f, 0x0b, 0x8e, 0xb3, 0xf7, 0x14, 0x07, 0x16, 0x61, 0x19, 0x41, 0xf4, 0xbf, 0x55, 0x40, 0x2b,
+	0x9c, 0xe1, 0xf7, 0x6c, 0x36, 0xb8, 0x67, 0x3b, 0x24, 0x44, 0xb7, 0x60, 0x26, 0x90, 0x30, 0x75,
+	0xd5, 0x9d, 0x1b, 0x73, 0xf4, 0xdb, 0x53, 0x46, 0xbc, 0x1a, 0x7d, 0x0a, 0x4d, 0x97, 0x30, 0xdc,
+	0xc7, 0x0c, 0x2b, 0xd9, 0xd7, 0xcb, 0x30, 0x39, 0x97, 0x1d, 0xb5, 0x6e, 0x7b, 0xca, 0x48, 0x70,
+	0xd0, 0x87, 0xd0, 0x30, 0x07, 0x91, 0xb7, 0x2f, 0x2e, 0xb9, 0xd9, 0x1b, 0x17, 0x46, 0x21, 0x6f,
+	0xf2, 0x45, 0xdb, 0x53, 0x86, 0x5c, 0xfd, 0xd9, 0x34, 0xd4, 0x29, 0x0e, 0x98, 0x7e, 0x0f, 0x4e,
+	0x97, 0xb1, 0xe0, 0x37, 0xab, 0x39, 0x20, 0xe6, 0x7e, 0x18, 0xb9, 0x4a, 0xcd, 0xc9, 0x18, 0x21,
+	0xa8, 0x87, 0xf6, 0x2b, 0xa9, 0xea, 0x9a, 0x21, 0xbe, 0xf5, 0x2b, 0xb0, 0x3c, 0xc4, 0x8d, 0x1f,
+	0xaa, 0x94, 0x8d, 0x53, 0x98, 0x53, 0xac, 0xf5, 0x08, 0xce, 0x3c, 0x15, 0xba, 0x48, 0xae, 0x97,
+	0x93, 0xc8, 0x15, 0xf4, 0x6d, 0x58, 0x29, 0xb2, 0x0d, 0xa9, 0xef, 0x85, 0x84, 0x3b, 0x9b, 0x88,
+	0xc7, 0x36, 0xe9, 0xa7, 0xb3, 0x42, 0x8a, 0xa6, 0x51, 0x32, 0xa3, 0xff, 0xb6, 0x0a, 0x2b, 0x06,
+	0x09, 0x7d, 0xe7, 0x80, 0xc4, 0xc1, 0xf2, 0x64, 0xd2, 0x9d, 0x1f, 0x40, 0x0d, 0x53, 0xaa, 0xcc,
+	0xe4, 0xfe, 0x5b, 0x4b, 0x28, 0x0c, 0x4e, 0x15, 0xbd, 0x0f, 0xcb, 0xd8, 0xed, 0xd9, 0x56, 0xe4,
+	0x47, 0x61, 0xbc, 0x2d, 0x61, 0x54, 0x2d, 0x63, 0x78, 0x82, 0x07, 0x9c, 0x50, 0x78, 0xe4, 0x7d,
+	0xaf, 0x4f, 0x7e, 0x22, 0x72, 0xa8, 0x9a, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
+	0x78, 0x36, 0x6d, 0xab, 0x14, 0xd2, 0xb6, 0x52, 0x31, 0xaa, 0x23, 0xc4, 0xd0, 0x5f, 0x57, 0x60,
+	0x29, 0x75, 0x2e, 0x45, 0xfe, 0x3c, 0xb4, 0x5c, 0x05, 0x0b, 0xb5, 0x8a, 0x88, 0x99, 0x29, 0x20,
+	0x9f, 0xc1, 0x55, 0x8b, 0x19, 0xdc, 0x0a, 0x4c, 0xcb, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
+	0xd7, 0x0b, 0x22, 0xaf, 0x01, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc5, 0x6c, 0x06, 0x82, 0x74, 0x98,
+	0x93, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0x8c, 0x58, 0x91, 0x83, 0x09, 0x7f, 0xf3, 0x5d,
+	0x17, 0x7b, 0xfd, 0x50, 0x6b, 0x0a, 0x91, 0x93, 0xb1, 0xee, 0xc3, 0xe2, 0x43, 0x9b, 0xef, 0x6f,
+	0x2f, 0x3c, 0x19, 0x57, 0xf9, 0x08, 0xea, 0x9c, 0x19, 0x17, 0xaa, 0x17, 0x60, 0xcf, 0x1c, 0x90,
+	0x58, 0x8f, 0xc9, 0x98, 0x07, 0x01, 0x86, 0xad, 0x50, 0xab, 0x0a, 0xb8, 0xf8, 0xd6, 0x7f, 0x5f,
+	0x95, 0x92, 0x6e, 0x50, 0x1a, 0x7e, 0xfd, 0x05, 0x40, 0x79, 0x4a, 0x52, 0x1b, 0x4e, 0x49, 0x0a,
+	0x22, 0xbf, 0x49, 0x4a, 0xf2, 0x96, 0x2e, 0x39, 0x3d, 0x82, 0x99, 0x0d, 0x4a, 0xb9, 0x20, 0xe8,
+	0x3a, 0xd4, 0x31, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x96, 0xf0, 0xff, 0x4a, 0x24, 0xb1, 0xb4,
+	0x7d, 0x0b, 0x5a, 0x09, 0xe8, 0x28, 0xb6, 0xad, 0x2c, 0xdb, 0x75, 0x00, 0x99, 0x73, 0xdf, 0xf7,
+	0xf6, 0x7c, 0x7e, 0xa4, 0xdc, 0x11, 0x14, 0xaa, 0xf8, 0xd6, 0x6f, 0xc7, 0x2b, 0x84, 0x6c, 0xef,
+	0x43, 0xc3, 0x66, 0xc4, 0x8d, 0x85, 0x5b, 0xc9, 0x0a, 0x97, 0x12, 0x32, 0xe4, 0x22, 0xfd, 0xcf,
+	0x4d, 0x58, 0xe5, 0x27, 0xf6, 0x44, 0xb8, 0xd0, 0x06, 0xa5, 0x77, 0x08, 0xc3, 0xb6, 0x13, 0x7e,
+	0x37, 0x22, 0xc1, 0xe1, 0x3b, 0x36, 0x0c, 0x0b, 0xa6, 0xa5, 0x07, 0xaa, 0x68, 0xf9, 0xd6, 0xcb,
+	0x2f, 0x45, 0x3e, 0xad, 0xb9, 0x6a, 0xef, 0xa6, 0xe6, 0x2a, 0xab, 0x81, 0xea, 0x27, 0x54, 0x03,
+	0x8d, 0x2e, 0x83, 0x33, 0xc5, 0xf5, 0x74, 0xbe, 0xb8, 0x2e, 0x29, 0x2d, 0x66, 0x8e, 0x5b, 0x5a,
+	0x34, 0x4b, 0x4b, 0x0b, 0xb7, 0xd4, 0x8f, 0x5b, 0x42, 0xdd, 0xdf, 0xce, 0x5a, 0xe0, 0x48, 0x5b,
+	0x9b, 0xa4, 0xc8, 0x80, 0x77, 0x5a, 0x64, 0x3c, 0xcb, 0x15, 0x0d, 0xb2, 0x6c, 0xff, 0xf0, 0x78,
+	0x7b, 0x1a, 0x53, 0x3e, 0xfc, 0xcf, 0xa5, 0xde, 0x3f, 0x17, 0x19, 0x17, 0xf5, 0x53, 0x1d, 0x24,
+	0x97, 0x3d, 0xbf, 0x87, 0xf8, 0xb5, 0xab, 0x82, 0x16, 0xff, 0x46, 0xd7, 0xa0, 0xce, 0x95, 0xac,
+	0x52, 0xe2, 0xb3, 0x59, 0x7d, 0xf2, 0x93, 0xd8, 0xa0, 0xf4, 0x09, 0x25, 0xa6, 0x21, 0x16, 0xa1,
+	0xdb, 0xd0, 0x4a, 0x0c, 0x5f, 0x79, 0xd6, 0xf9, 0x2c, 0x46, 0xe2, 0x27, 0x31, 0x5a, 0xba, 0x9c,
+	0xe3, 0xf6, 0xed, 0x80, 0x98, 0x22, 0x61, 0x6c, 0x0c, 0xe3, 0xde, 0x89, 0x27, 0x13, 0xdc, 0x64,
+	0x39, 0xba, 0x0e, 0xd3, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xf6, 0xc6, 0xea, 0x70, 0x30, 0x8d, 0xb1,
+	0xd4, 0x42, 0xfd, 0x4f, 0x15, 0x78, 0x2f, 0x35, 0x88, 0xd8, 0x9b, 0xe2, 0x9c, 0xfd, 0xeb, 0xbf,
+	0x71, 0x2f, 0xc1, 0x82, 0x28, 0x12, 0xd2, 0x76, 0x87, 0xec, 0xbc, 0x15, 0xa0, 0xfa, 0xef, 0x2a,
+	0x70, 0x71, 0x78, 0x1f, 0x9b, 0x03, 0x1c, 0xb0, 0xe4, 0x78, 0x4f, 0x62, 0x2f, 0xf1, 0x85, 0x57,
+	0x4d, 0x2f, 0xbc, 0xdc, 0xfe, 0x6a, 0xf9, 0xfd, 0xe9, 0x7f, 0xa8, 0xc2, 0x6c, 0xc6, 0x80, 0xca,
+	0x2e, 0x4c, 0x9e, 0x0c, 0x0a, 0xbb, 0x15, 0x65, 0xa1, 0xb8, 0x14, 0x5a, 0x46, 0x06, 0x82, 0xf6,
+	0x01, 0x28, 0x0e, 0xb0, 0x4b, 0x18, 0x09, 0x78, 0x24, 0xe7, 0x1e, 0xff, 0x60, 0xf2, 0xe8, 0xb2,
+	0x1b, 0xd3, 0x34, 0x32, 0xe4, 0x79, 0x36, 0x2b, 0x58, 0x87, 0x2a, 0x7e, 0xab, 0x11, 0xfa, 0x0a,
+	0x16, 0xf6, 0x6c, 0x87, 0xec, 0xa6, 0x82, 0x4c, 0x0b, 0x41, 0x1e, 0x4f, 0x2e, 0xc8, 0xbd, 0x2c,
+	0x5d, 0xa3, 0xc0, 0x46, 0xbf, 0x0a, 0x4b, 0x45, 0x7f, 0xe2, 0x42, 0xda, 0x2e, 0xb6, 0x12, 0x6d,
+	0xa9, 0x91, 0x8e, 0x60, 0xa9, 0xe8, 0x3f, 0xfa, 0x3f, 0xaa, 0x70, 0x26, 0x21, 0xb7, 0xe1, 0x79,
+	0x7e, 0xe4, 0x99, 0xa2, 0x75, 0x58, 0x7a, 0x16, 0xa7, 0xa1, 0xc1, 0x6c, 0xe6, 0x24, 0x89, 0x8f,
+	0x18, 0xf0, 0xbb, 0x8b, 0xf9, 0xbe, 0xc3, 0x6c, 0xaa, 0x0e, 0x38, 0x1e, 0xca, 0xb3, 0x7f, 0x19,
+	0xd9, 0x01, 0xe9, 0x8b, 0x48, 0xd0, 0x34, 0x92, 0x31, 0x9f, 0xe3, 0x59, 0x8d, 0x48, 0xf1, 0xa5,
+	0x32, 0x93, 0xb1, 0xb0, 0x7b, 0xdf, 0x71, 0x88, 0xc9, 0xd5, 0x91, 0x29, 0x02, 0x0a, 0x50, 0x51,
+	0x5c, 0xb0, 0xc0, 0xf6, 0x2c, 0x55, 0x02, 0xa8, 0x11, 0x97, 0x13, 0x07, 0x01, 0x3e, 0x54, 0x99,
+	0xbf, 0x1c, 0xa0, 0x4f, 0xa0, 0xe6, 0x62, 0xaa, 0x2e, 0xba, 0xab, 0xb9, 0xe8, 0x50, 0xa6, 0x81,
+	0xce, 0x0e, 0xa6, 0xf2, 0x26, 0xe0, 0x68, 0xed, 0x8f, 0xa0, 0x19, 0x03, 0xde, 0x28, 0x25, 0xfc,
+	0x12, 0xe6, 0x73, 0xc1, 0x07, 0x3d, 0x87, 0x95, 0xd4, 0xa2, 0xb2, 0x0c, 0x55, 0x12, 0xf8, 0xde,
+	0x91, 0x92, 0x19, 0x23, 0x08, 0xe8, 0x2f, 0x61, 0x99, 0x9b, 0x8c, 0x70, 0xfc, 0x13, 0x2a, 0x6d,
+	0x3e, 0x86, 0x56, 0xc2, 0xb2, 0xd4, 0x66, 0xda, 0xd0, 0x3c, 0x88, 0x5b, 0xba, 0xb2, 0xb6, 0x49,
+	0xc6, 0xfa, 0x06, 0xa0, 0xac, 0xbc, 0xea, 0x06, 0xba, 0x96, 0x4f, 0x8a, 0xcf, 0x14, 0xaf, 0x1b,
+	0xb1, 0x3c, 0xce, 0x89, 0xff, 0x55, 0x85, 0xc5, 0x2d, 0x5b, 0xf4, 0x48, 0x4e, 0x28, 0xc8, 0x5d,
+	0x85, 0xa5, 0x30, 0xea, 0xb9, 0x7e, 0x3f, 0x72, 0x88, 0x4a, 0x0a, 0xd4, 0x4d, 0x3f, 0x04, 0x1f,
+	0x17, 0xfc, 0xb8, 0xb2, 0x28, 0x66, 0x03, 0x55, 0xfd, 0x8a, 0x6f, 0xf4, 0x09, 0xac, 0x3e, 0x22,
+	0x5f, 0xa9, 0xfd, 0x6c, 0x39, 0x7e, 0xaf, 0x67, 0x7b, 0x56, 0xcc, 0xa4, 0x21, 0x98, 0x8c, 0x5e,
+	0x50, 0x96, 0x2a, 0x4e, 0x97, 0xa7, 0x8a, 0x49, 0x05, 0xbd, 0xe9, 0xbb, 0xae, 0xcd, 0x54, 0x46,
+	0x99, 0x83, 0xc9, 0xb6, 0xa4, 0xe9, 0x44, 0x7d, 0x22, 0x01, 0xa1, 0x48, 0x27, 0x9b, 0x46, 0x01,
+	0xaa, 0xff, 0xa6, 0x0a, 0x4b, 0xe9, 0x09, 0xa8, 0x33, 0xbc, 0x25, 0x7d, 0x4d, 0x9e, 0xe0, 0xc5,
+	0xec, 0x09, 0x16, 0x97, 0xe6, 0xdd, 0x0c, 0x6d, 0xc2, 0x8c, 0xa9, 0xd8, 0x55, 0x05, 0xf2, 0x95,
+	0xb1, 0xc8, 0x4a, 0x08, 0x49, 0x20, 0xc6, 0x7c, 0x13, 0x5f, 0x9d, 0xcb, 0xe6, 0x67, 0xcf, 0x60,
+	0x2e, 0x4b, 0xb0, 0x04, 0xb7, 0x9b, 0xcf, 0xcd, 0x56, 0x4b, 0x84, 0x93, 0x14, 0xb2, 0x21, 0x60,
+	0x07, 0xe6, 0x73, 0x73, 0x9c, 0x6e, 0x38, 0xc0, 0x31, 0xdd, 0x70, 0x80, 0x79, 0x24, 0xc3, 0x11,
+	0x1b, 0xf8, 0x81, 0x0a, 0x20, 0x6a, 0xc4, 0x8d, 0xa4, 0x8f, 0x99, 0xbc, 0xf7, 0x6b, 0x86, 0xf8,
+	0xd6, 0x7f, 0x59, 0x85, 0x33, 0x5b, 0x36, 0x8b, 0x63, 0xb9, 0xfd, 0xdf, 0x66, 0xf8, 0x25, 0x66,
+	0x5a, 0x3f, 0x9e, 0x99, 0x36, 0x86, 0xcd, 0x54, 0xef, 0xc0, 0x4a, 0x51, 0x19, 0xca, 0x06, 0x4f,
+	0x43, 0x83, 0x8a, 0x3e, 0xbc, 0x6c, 0xb5, 0xc8, 0x81, 0xfe, 0xb3, 0x19, 0xb8, 0xf0, 0x8c, 0x72,
+	0x45, 0xc6, 0xbc, 0xee, 0xf9, 0x81, 0x68, 0xc4, 0x9f, 0x8c, 0x16, 0x0b, 0x8f, 0xa5, 0xd5, 0xb1,
+	0x8f, 0xa5, 0xb5, 0x31, 0x8f, 0xa5, 0xf5, 0x63, 0x3d, 0x96, 0x36, 0x4e, 0xec, 0xb1, 0x74, 0xb8,
+	0xfc, 0x9c, 0x2e, 0x2d, 0x3f, 0x9f, 0xe7, 0x4a, 0xb4, 0x19, 0xe1, 0xe4, 0xdf, 0xca, 0xfa, 0xd1,
+	0xd8, 0xd3, 0x19, 0xfb, 0xca, 0x53, 0x78, 0x63, 0x6c, 0x1e, 0xf9, 0xc6, 0xd8, 0x1a, 0x7e, 0x63,
+	0x2c, 0x7f, 0xa6, 0x82, 0x91, 0xcf, 0x54, 0x97, 0x60, 0x21, 0x3c, 0xf4, 0x4c, 0xd2, 0x4f, 0x9a,
+	0xab, 0xb3, 0x72, 0xdb, 0x79, 0x68, 0xce, 0x23, 0xe6, 0x0a, 0x1e, 0x91, 0x58, 0xea, 0x7c, 0xc6,
+	0x52, 0xcb, 0xfc, 0x64, 0x61, 0x64, 0xe5, 0x5f, 0x78, 0x41, 0x5a, 0x2c, 0x7d, 0x41, 0xfa, 0x8f,
+	0xa9, 0x3f, 0xbf, 0x80, 0xb5, 0x51, 0xa7, 0xac, 0x9c, 0x57, 0x83, 0x19, 0x73, 0x80, 0x3d, 0x4b,
+	0x74, 0x4a, 0x45, 0x43, 0x44, 0x0d, 0xc7, 0x15, 0x4c, 0x37, 0xfe, 0x08, 0xb0, 0x9c, 0x16, 0x42,
+	0xfc, 0xaf, 0x6d, 0x12, 0xf4, 0x18, 0x96, 0xe2, 0x17, 0xb7, 0xb8, 0xb7, 0x8d, 0xc6, 0x3d, 0x27,
+	0xb5, 0xcf, 0x97, 0x4f, 0x4a, 0xd1, 0xf4, 0x29, 0x64, 0xc2, 0x6a, 0x91, 0x60, 0xfa, 0x72, 0xf5,
+	0x8d, 0x31, 0x94, 0x93, 0x55, 0x47, 0xb1, 0xb8, 0x5c, 0x41, 0xcf, 0x61, 0x21, 0xff, 0xbe, 0x82,
+	0x72, 0x99, 0x61, 0xe9, 0x93, 0x4f, 0x5b, 0x1f, 0xb7, 0x24, 0x91, 0xff, 0x05, 0x37, 0x83, 0xdc,
+	0x53, 0x02, 0xd2, 0xf3, 0x4d, 0x92, 0xb2, 0xc7, 0x98, 0xf6, 0xff, 0x8f, 0x5d, 0x93, 0x50, 0xff,
+	0x18, 0x9a, 0x71, 0x7b, 0x3d, 0xaf, 0xe6, 0x42, 0xd3, 0xbd, 0xbd, 0x94, 0xa7, 0xb7, 0x17, 0xea,
+	0x53, 0xe8, 0x53, 0x89, 0xbc, 0x41, 0x69, 0x09, 0x72, 0xa6, 0xa9, 0xdc, 0x3e, 0x55, 0xd2, 0xc8,
+	0xd5, 0xa7, 0xd0, 0x77, 0x60, 0x96, 0x7f, 0xed, 0xaa, 0x5f, 0x3c, 0xac, 0x74, 0xe4, 0x0f, 0x6c,
+	0x3a, 0xf1, 0x0f, 0x6c, 0x3a, 0x77, 0x5d, 0xca, 0x0e, 0xdb, 0x25, 0x9d, 0x56, 0x45, 0xe0, 0x05,
+	0xcc, 0x6f, 0x11, 0x96, 0x36, 0x46, 0xd0, 0xc5, 0x63, 0xb5, 0x8f, 0xda, 0x7a, 0x71, 0xd9, 0x70,
+	0x6f, 0x45, 0x9f, 0x42, 0xbf, 0xaa, 0xc0, 0xa9, 0x2d, 0xc2, 0x8a, 0xad, 0x06, 0xf4, 0x41, 0x39,
+	0x93, 0x11, 0x2d, 0x89, 0xf6, 0xa3, 0x49, 0x7d, 0x32, 0x4f, 0x56, 0x9f, 0x42, 0xbf, 0xae, 0xc0,
+	0xd9, 0x8c, 0x60, 0xd9, 0xde, 0x01, 0xba, 0x3e, 0x5e, 0xb8, 0x92, 0x3e, 0x43, 0xfb, 0xf3, 0x09,
+	0x7f, 0xc8, 0x92, 0x21, 0xa9, 0x4f, 0xa1, 0x5d, 0x71, 0x26, 0x69, 0xa9, 0x80, 0x2e, 0x94, 0xd6,
+	0x04, 0x09, 0xf7, 0xb5, 0x51, 0xd3, 0xc9, 0x39, 0x7c, 0x0e, 0xb3, 0x5b, 0x84, 0xc5, 0xe9, 0x64,
+	0xde, 0xd2, 0x0a, 0xe5, 0x44, 0xde, 0x55, 0x8b, 0x19, 0xa8, 0xb0, 0x98, 0x65, 0x49, 0x2b, 0x93,
+	0x84, 0xe4, 0x7d, 0xb5, 0x34, 0x5b, 0xcb, 0x5b, 0x4c, 0x79, 0x0e, 0xa3, 0x4f, 0xa1, 0x97, 0xb0,
+	0x52, 0x1e, 0x2a, 0xd1, 0x95, 0x63, 0x5f, 0x9a, 0xed, 0xab, 0xc7, 0x59, 0x1a, 0xb3, 0xfc, 0x6c,
+	0xe3, 0x2f, 0xaf, 0xd7, 0x2a, 0x7f, 0x7d, 0xbd, 0x56, 0xf9, 0xe7, 0xeb, 0xb5, 0xca, 0xf7, 0x6f,
+	0x1e, 0xf1, 0x83, 0xb7, 0xcc, 0x6f, 0xe8, 0x30, 0xb5, 0x4d, 0xc7, 0x26, 0x1e, 0xeb, 0x4d, 0x0b,
+	0x7f, 0xbb, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x17, 0xfa, 0x73, 0x1e, 0x62, 0x27, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5054,6 +5146,16 @@ func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IncludeCommits {
+		i--
+		if m.IncludeCommits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.VerifyCommit {
 		i--
 		if m.VerifyCommit {
@@ -5147,6 +5249,32 @@ func (m *GitFilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Commits) > 0 {
+		for k := range m.Commits {
+			v := m.Commits[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Map) > 0 {
 		for k := range m.Map {
 			v := m.Map[k]
@@ -5171,6 +5299,52 @@ func (m *GitFilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GitFileCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitFileCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitFileCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Date != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sha) > 0 {
+		i -= len(m.Sha)
+		copy(dAtA[i:], m.Sha)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Sha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GitDirectoriesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6339,6 +6513,9 @@ func (m *GitFilesRequest) Size() (n int) {
 	if m.VerifyCommit {
 		n += 2
 	}
+	if m.IncludeCommits {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6363,6 +6540,42 @@ func (m *GitFilesResponse) Size() (n int) {
 			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Commits) > 0 {
+		for k, v := range m.Commits {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GitFileCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sha)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovRepository(uint64(m.Date))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11790,6 +12003,26 @@ func (m *GitFilesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.VerifyCommit = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeCommits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeCommits = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -11969,6 +12202,269 @@ func (m *GitFilesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Map[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commits == nil {
+				m.Commits = make(map[string]*GitFileCommit)
+			}
+			var mapkey string
+			var mapvalue *GitFileCommit
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GitFileCommit{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Commits[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitFileCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitFileCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitFileCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -466,6 +466,22 @@ func (c *Cache) GetGitFiles(repoURL, revision, pattern string) (map[string][]byt
 	return item, c.cache.GetItem(gitFilesKey(repoURL, revision, pattern), &item)
 }
 
+func gitFileCommitsKey(repoURL, revision, pattern string) string {
+	return fmt.Sprintf("gitfilecommits|%s|%s|%s", repoURL, revision, pattern)
+}
+
+func (c *Cache) SetGitFileCommits(repoURL, revision, pattern string, commits map[string]*apiclient.GitFileCommit) error {
+	return c.cache.SetItem(
+		gitFileCommitsKey(repoURL, revision, pattern),
+		&commits,
+		&cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+}
+
+func (c *Cache) GetGitFileCommits(repoURL, revision, pattern string) (map[string]*apiclient.GitFileCommit, error) {
+	var item map[string]*apiclient.GitFileCommit
+	return item, c.cache.GetItem(gitFileCommitsKey(repoURL, revision, pattern), &item)
+}
+
 func gitDirectoriesKey(repoURL, revision string) string {
 	return fmt.Sprintf("gitdirs|%s|%s", repoURL, revision)
 }
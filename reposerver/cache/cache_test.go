@@ -750,3 +750,18 @@ func TestGetGitFiles(t *testing.T) {
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1, ExternalSets: 1})
 	})
 }
+
+func TestGetGitFileCommits(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	_, err := fixtures.cache.GetGitFileCommits("test-repo", "test-revision", "*.json")
+	require.ErrorIs(t, err, ErrCacheMiss)
+
+	expectedItem := map[string]*apiclient.GitFileCommit{"test/file.json": {Sha: "632039659e542ed7de0c170a4fcc1c571b288fc0", Author: "foo <foo@foo.com>", Date: 1622923200}}
+	err = fixtures.cache.SetGitFileCommits("test-repo", "test-revision", "*.json", expectedItem)
+	require.NoError(t, err)
+	commits, err := fixtures.cache.GetGitFileCommits("test-repo", "test-revision", "*.json")
+	require.NoError(t, err)
+	assert.Equal(t, expectedItem, commits)
+	fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 2, ExternalSets: 1})
+}
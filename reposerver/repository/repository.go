@@ -2664,10 +2664,19 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 
 	// check the cache and return the results if present
 	if cachedFiles, err := s.cache.GetGitFiles(repo.Repo, revision, gitPath); err == nil {
-		log.Debugf("cache hit for repo: %s revision: %s pattern: %s", repo.Repo, revision, gitPath)
-		return &apiclient.GitFilesResponse{
-			Map: cachedFiles,
-		}, nil
+		if !request.GetIncludeCommits() {
+			log.Debugf("cache hit for repo: %s revision: %s pattern: %s", repo.Repo, revision, gitPath)
+			return &apiclient.GitFilesResponse{
+				Map: cachedFiles,
+			}, nil
+		}
+		if cachedCommits, err := s.cache.GetGitFileCommits(repo.Repo, revision, gitPath); err == nil {
+			log.Debugf("cache hit for repo: %s revision: %s pattern: %s", repo.Repo, revision, gitPath)
+			return &apiclient.GitFilesResponse{
+				Map:     cachedFiles,
+				Commits: cachedCommits,
+			}, nil
+		}
 	}
 
 	s.metricsServer.IncPendingRepoRequest(repo.Repo)
@@ -2702,8 +2711,33 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 		log.Warnf("error caching git files for repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
 	}
 
+	if !request.GetIncludeCommits() {
+		return &apiclient.GitFilesResponse{
+			Map: res,
+		}, nil
+	}
+
+	commits := make(map[string]*apiclient.GitFileCommit)
+	for _, filePath := range gitFiles {
+		commit, err := gitClient.LastFileCommit(filePath)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to get the last commit of file %s. repo %s with revision %s: %v", filePath, repo.Repo, revision, err)
+		}
+		commits[filePath] = &apiclient.GitFileCommit{
+			Sha:    commit.SHA,
+			Author: commit.Author,
+			Date:   commit.Date.Unix(),
+		}
+	}
+
+	err = s.cache.SetGitFileCommits(repo.Repo, revision, gitPath, commits)
+	if err != nil {
+		log.Warnf("error caching git file commits for repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
+	}
+
 	return &apiclient.GitFilesResponse{
-		Map: res,
+		Map:     res,
+		Commits: commits,
 	}, nil
 }
 
@@ -3668,6 +3668,51 @@ func TestGetGitFiles(t *testing.T) {
 	})
 }
 
+func TestGetGitFilesWithCommits(t *testing.T) {
+	files := []string{"./testdata/git-files-dirs/somedir/config.yaml", "./testdata/git-files-dirs/config.yaml"}
+	root := ""
+	commitDate := time.Date(2021, time.June, 5, 20, 0, 0, 0, time.UTC)
+	s, _, cacheMocks := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, paths *iomocks.TempPaths) {
+		gitClient.On("Init").Return(nil)
+		gitClient.On("IsRevisionPresent", mock.Anything).Return(false)
+		gitClient.On("Fetch", mock.Anything).Return(nil)
+		gitClient.On("Checkout", mock.Anything, mock.Anything).Once().Return("", nil)
+		gitClient.On("LsRemote", "HEAD").Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+		gitClient.On("Root").Return(root)
+		gitClient.On("LsFiles", mock.Anything, mock.Anything).Once().Return(files, nil)
+		for _, filePath := range files {
+			gitClient.On("LastFileCommit", filePath).Once().Return(&git.FileCommit{SHA: "sha-" + filePath, Author: "foo <foo@foo.com>", Date: commitDate}, nil)
+		}
+		paths.On("GetPath", mock.Anything).Return(root, nil)
+		paths.On("GetPathIfExists", mock.Anything).Return(root, nil)
+	}, root)
+	filesRequest := &apiclient.GitFilesRequest{
+		Repo:           &v1alpha1.Repository{Repo: "a-url.com"},
+		Revision:       "HEAD",
+		IncludeCommits: true,
+	}
+
+	expected := make(map[string]*apiclient.GitFileCommit)
+	for _, filePath := range files {
+		expected[filePath] = &apiclient.GitFileCommit{Sha: "sha-" + filePath, Author: "foo <foo@foo.com>", Date: commitDate.Unix()}
+	}
+
+	fileResponse, err := s.GetGitFiles(t.Context(), filesRequest)
+	require.NoError(t, err)
+	assert.Len(t, fileResponse.GetMap(), len(files))
+	assert.Equal(t, expected, fileResponse.GetCommits())
+
+	// do the same request again to use the cache
+	// we only allow LsFiles and LastFileCommit to be called once in the mock
+	fileResponse, err = s.GetGitFiles(t.Context(), filesRequest)
+	require.NoError(t, err)
+	assert.Equal(t, expected, fileResponse.GetCommits())
+	cacheMocks.mockCache.AssertCacheCalledTimes(t, &repositorymocks.CacheCallCounts{
+		ExternalSets: 2,
+		ExternalGets: 3,
+	})
+}
+
 func TestErrorUpdateRevisionForPaths(t *testing.T) {
 	// test not using the cache
 	root := ""
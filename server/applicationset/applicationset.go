@@ -3,32 +3,50 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	gosync "sync"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
+	jsonpatch "github.com/evanphx/json-patch"
+	gocache "github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsettemplate "github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/plugin"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	appsetvalidation "github.com/argoproj/argo-cd/v3/applicationset/validation"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -37,6 +55,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/collections"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/github_app"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -44,6 +63,11 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
+// generateLimiterExpiration is the time after which the generate rate limiter of an inactive user is dropped
+const generateLimiterExpiration = 10 * time.Minute
+
+var watchAPIBufferSize = env.ParseNumFromEnv(common.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+
 type Server struct {
 	ns                       string
 	db                       db.ArgoDB
@@ -52,9 +76,11 @@ type Server struct {
 	dynamicClient            dynamic.Interface
 	client                   client.Client
 	repoClientSet            repoapiclient.Clientset
+	kubectl                  kube.Kubectl
 	appclientset             appclientset.Interface
 	appsetInformer           cache.SharedIndexInformer
 	appsetLister             applisters.ApplicationSetLister
+	appsetBroadcaster        *broadcasterHandler
 	projLister               applisters.AppProjectNamespaceLister
 	auditLogger              *argo.AuditLogger
 	settings                 *settings.SettingsManager
@@ -65,6 +91,14 @@ type Server struct {
 	ScmRootCAPath            string
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
+	// generateLimiters holds the rate limiters of the ApplicationSet generate requests, by user
+	generateLimiters *gocache.Cache
+	generateLimit    rate.Limit
+	generateBurst    int
+	// generateLimitersLock serializes the lookup and creation of the rate limiters
+	generateLimitersLock gosync.Mutex
+	// generateCache holds the Applications generated for a submitted ApplicationSet, by hash of the ApplicationSet
+	generateCache *gocache.Cache
 }
 
 // NewServer returns a new instance of the ApplicationSet service
@@ -75,6 +109,7 @@ func NewServer(
 	kubeControllerClientset client.Client,
 	enf *rbac.Enforcer,
 	repoClientSet repoapiclient.Clientset,
+	kubectl kube.Kubectl,
 	appclientset appclientset.Interface,
 	appsetInformer cache.SharedIndexInformer,
 	appsetLister applisters.ApplicationSetLister,
@@ -89,7 +124,14 @@ func NewServer(
 	allowedScmProviders []string,
 	enableScmProviders bool,
 	enableK8sEvent []string,
+	generateRateLimit int,
+	generateCacheExpiration time.Duration,
 ) applicationset.ApplicationSetServiceServer {
+	appsetBroadcaster := &broadcasterHandler{}
+	_, err := appsetInformer.AddEventHandler(appsetBroadcaster)
+	if err != nil {
+		log.Error(err)
+	}
 	s := &Server{
 		ns:                       namespace,
 		db:                       db,
@@ -98,9 +140,11 @@ func NewServer(
 		client:                   kubeControllerClientset,
 		k8sClient:                kubeclientset,
 		repoClientSet:            repoClientSet,
+		kubectl:                  kubectl,
 		appclientset:             appclientset,
 		appsetInformer:           appsetInformer,
 		appsetLister:             appsetLister,
+		appsetBroadcaster:        appsetBroadcaster,
 		projLister:               projLister,
 		settings:                 settings,
 		projectLock:              projectLock,
@@ -112,6 +156,14 @@ func NewServer(
 		AllowedScmProviders:      allowedScmProviders,
 		EnableScmProviders:       enableScmProviders,
 	}
+	if generateRateLimit > 0 {
+		s.generateLimiters = gocache.New(generateLimiterExpiration, generateLimiterExpiration)
+		s.generateLimit = rate.Every(time.Minute / time.Duration(generateRateLimit))
+		s.generateBurst = generateRateLimit
+	}
+	if generateCacheExpiration > 0 {
+		s.generateCache = gocache.New(generateCacheExpiration, generateCacheExpiration)
+	}
 	return s
 }
 
@@ -180,6 +232,99 @@ func (s *Server) List(ctx context.Context, q *applicationset.ApplicationSetListQ
 	return appsetList, nil
 }
 
+// isAppSetPermitted returns whether the ApplicationSet matches the watch query and the caller is permitted to get it.
+func (s *Server) isAppSetPermitted(selector labels.Selector, minVersion int, claims any, appsetName, appsetNs string, projects map[string]bool, a *v1alpha1.ApplicationSet) bool {
+	if len(projects) > 0 && !projects[a.Spec.Template.Spec.GetProject()] {
+		return false
+	}
+
+	if appsetVersion, err := strconv.Atoi(a.ResourceVersion); err == nil && appsetVersion < minVersion {
+		return false
+	}
+	matchedEvent := (appsetName == "" || (a.Name == appsetName && a.Namespace == appsetNs)) && selector.Matches(labels.Set(a.Labels))
+	if !matchedEvent {
+		return false
+	}
+
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return false
+	}
+
+	if !s.enf.Enforce(claims, rbac.ResourceApplicationSets, rbac.ActionGet, a.RBACName(s.ns)) {
+		// do not emit applicationsets user does not have accessing
+		return false
+	}
+
+	return true
+}
+
+// Watch returns a stream of the changes of the ApplicationSets, so that clients can observe them without polling
+func (s *Server) Watch(q *applicationset.ApplicationSetWatchQuery, ws applicationset.ApplicationSetService_WatchServer) error {
+	appsetName := q.GetName()
+	appsetNs := s.appsetNamespaceOrDefault(q.GetAppsetNamespace())
+	logCtx := log.NewEntry(log.New())
+	if appsetName != "" {
+		logCtx = logCtx.WithField("applicationset", appsetName)
+	}
+	projects := map[string]bool{}
+	for _, project := range q.GetProjects() {
+		projects[project] = true
+	}
+	claims := ws.Context().Value("claims")
+	selector, err := labels.Parse(q.GetSelector())
+	if err != nil {
+		return fmt.Errorf("error parsing labels with selectors: %w", err)
+	}
+	minVersion := 0
+	if q.GetResourceVersion() != "" {
+		if minVersion, err = strconv.Atoi(q.GetResourceVersion()); err != nil {
+			minVersion = 0
+		}
+	}
+
+	// sendIfPermitted is a helper to send the applicationset to the client's streaming channel if the
+	// caller has RBAC privileges permissions to view it
+	sendIfPermitted := func(a *v1alpha1.ApplicationSet, eventType watch.EventType) {
+		if !s.isAppSetPermitted(selector, minVersion, claims, appsetName, appsetNs, projects, a) {
+			return
+		}
+		err := ws.Send(&applicationset.ApplicationSetWatchEvent{
+			Type:           string(eventType),
+			ApplicationSet: a,
+		})
+		if err != nil {
+			logCtx.Warnf("Unable to send stream message: %v", err)
+			return
+		}
+	}
+
+	events := make(chan *applicationset.ApplicationSetWatchEvent, watchAPIBufferSize)
+	// Mimic watch API behavior: send ADDED events if no resource version provided, or if a single applicationset is
+	// watched, so that its current state is never missed.
+	if q.GetResourceVersion() == "" || appsetName != "" {
+		appsets, err := s.appsetLister.List(selector)
+		if err != nil {
+			return fmt.Errorf("error listing ApplicationSets with selectors: %w", err)
+		}
+		sort.Slice(appsets, func(i, j int) bool {
+			return appsets[i].QualifiedName() < appsets[j].QualifiedName()
+		})
+		for i := range appsets {
+			sendIfPermitted(appsets[i], watch.Added)
+		}
+	}
+	unsubscribe := s.appsetBroadcaster.Subscribe(events)
+	defer unsubscribe()
+	for {
+		select {
+		case event := <-events:
+			sendIfPermitted(event.ApplicationSet, watch.EventType(event.Type))
+		case <-ws.Context().Done():
+			return nil
+		}
+	}
+}
+
 func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCreateRequest) (*v1alpha1.ApplicationSet, error) {
 	appset := q.GetApplicationset()
 
@@ -187,7 +332,12 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 		return nil, errors.New("error creating ApplicationSets: ApplicationSets is nil in request")
 	}
 
-	projectName, err := s.validateAppSet(appset)
+	// The project and the generators are checked against the template the controller will render
+	resolved, err := s.resolveTemplateRef(ctx, appset)
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := s.validateAppSet(resolved)
 	if err != nil {
 		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
 	}
@@ -198,12 +348,12 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 		return nil, security.NamespaceNotPermittedError(namespace)
 	}
 
-	if err := s.checkCreatePermissions(ctx, appset, projectName); err != nil {
+	if err := s.checkCreatePermissions(ctx, resolved, projectName); err != nil {
 		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %w", appset.Name, err)
 	}
 
 	if q.GetDryRun() {
-		apps, err := s.generateApplicationSetApps(ctx, log.WithField("applicationset", appset.Name), *appset, namespace)
+		apps, err := s.previewApplicationSetApps(ctx, log.WithField("applicationset", appset.Name), *resolved, namespace)
 		if err != nil {
 			return nil, fmt.Errorf("unable to generate Applications of ApplicationSet: %w", err)
 		}
@@ -263,28 +413,165 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 }
 
 func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.Entry, appset v1alpha1.ApplicationSet, namespace string) ([]v1alpha1.Application, error) {
+	resolved, appSetGenerators, err := s.applicationSetGenerators(ctx, appset, namespace)
+	if err != nil {
+		return nil, err
+	}
+	apps, _, _, err := appsettemplate.GenerateApplications(ctx, logEntry, *resolved, appSetGenerators, nil, appsetutils.NewRender(plugin.NewFunctionRegistry(s.client, s.ns, true)), s.client, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error generating applications: %w", err)
+	}
+	return apps, nil
+}
+
+// resolveTemplateRef returns the ApplicationSet with its template reference, if any, resolved.
+func (s *Server) resolveTemplateRef(ctx context.Context, appset *v1alpha1.ApplicationSet) (*v1alpha1.ApplicationSet, error) {
+	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
+	resolved, err := appsettemplate.ResolveTemplateRef(ctx, argoCDService, appset)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving template reference: %w", err)
+	}
+	return resolved, nil
+}
+
+// applicationSetGenerators returns the ApplicationSet with its template reference resolved, and the generators to
+// generate its Applications with.
+func (s *Server) applicationSetGenerators(ctx context.Context, appset v1alpha1.ApplicationSet, namespace string) (*v1alpha1.ApplicationSet, map[string]generators.Generator, error) {
 	argoCDDB := s.db
 
 	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig, generators.AWSAccountsConfig{}, generators.TerraformStateConfig{}, false, nil, nil, false, nil)
 
-	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
+	resolved, err := s.resolveTemplateRef(ctx, &appset)
 	if err != nil {
-		return nil, fmt.Errorf("error generating applications: %w", err)
+		return nil, nil, err
 	}
-	return apps, nil
+	return resolved, appSetGenerators, nil
 }
 
-func (s *Server) updateAppSet(ctx context.Context, appset *v1alpha1.ApplicationSet, newAppset *v1alpha1.ApplicationSet, merge bool) (*v1alpha1.ApplicationSet, error) {
-	if appset != nil && appset.Spec.Template.Spec.Project != newAppset.Spec.Template.Spec.Project {
-		// When changing projects, caller must have applicationset create and update privileges in new project
-		// NOTE: the update check was already verified in the caller to this function
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionCreate, newAppset.RBACName(s.ns)); err != nil {
+// previewApplicationSetApps generates the Applications of an ApplicationSet submitted by a user for a preview, which
+// can be expensive because of the calls to the SCM providers. The generated Applications are cached by hash of the
+// ApplicationSet, and the generations are rate limited per user, if enabled.
+func (s *Server) previewApplicationSetApps(ctx context.Context, logEntry *log.Entry, appset v1alpha1.ApplicationSet, namespace string) ([]v1alpha1.Application, error) {
+	var cacheKey string
+	if s.generateCache != nil {
+		key, err := generateCacheKey(appset, namespace)
+		if err != nil {
 			return nil, err
 		}
-		// They also need 'update' privileges in the old project
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, appset.RBACName(s.ns)); err != nil {
+		cacheKey = key
+		if cached, ok := s.generateCache.Get(cacheKey); ok {
+			return copyApplications(cached.([]v1alpha1.Application)), nil
+		}
+	}
+
+	if err := s.checkGenerateRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	apps, err := s.generateApplicationSetApps(ctx, logEntry, appset, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if s.generateCache != nil {
+		s.generateCache.SetDefault(cacheKey, copyApplications(apps))
+	}
+	return apps, nil
+}
+
+// checkGenerateRateLimit returns a ResourceExhausted error when the user exceeded their rate of generate requests, if
+// the rate limit is enabled.
+func (s *Server) checkGenerateRateLimit(ctx context.Context) error {
+	if s.generateLimiters == nil {
+		return nil
+	}
+	user := session.GetUserIdentifier(ctx)
+	s.generateLimitersLock.Lock()
+	limiter, ok := s.generateLimiters.Get(user)
+	if !ok {
+		limiter = rate.NewLimiter(s.generateLimit, s.generateBurst)
+	}
+	// Refresh the expiration of the limiter of an active user
+	s.generateLimiters.SetDefault(user, limiter)
+	s.generateLimitersLock.Unlock()
+	if !limiter.(*rate.Limiter).Allow() {
+		return status.Errorf(codes.ResourceExhausted, "too many ApplicationSet generate requests, at most %d per minute are allowed", s.generateBurst)
+	}
+	return nil
+}
+
+// generateCacheKey returns the key of the Applications generated for the given ApplicationSet in the generate cache:
+// the hash of the ApplicationSet as submitted, and of the namespace it is generated in.
+func generateCacheKey(appset v1alpha1.ApplicationSet, namespace string) (string, error) {
+	data, err := json.Marshal(appset)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling ApplicationSet: %w", err)
+	}
+	hash := sha256.Sum256(append([]byte(namespace+"/"), data...))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func copyApplications(apps []v1alpha1.Application) []v1alpha1.Application {
+	res := make([]v1alpha1.Application, len(apps))
+	for i := range apps {
+		apps[i].DeepCopyInto(&res[i])
+	}
+	return res
+}
+
+// Update replaces the spec, labels and annotations of an existing ApplicationSet
+func (s *Server) Update(ctx context.Context, q *applicationset.ApplicationSetUpdateRequest) (*v1alpha1.ApplicationSet, error) {
+	appset := q.GetApplicationset()
+
+	if appset == nil {
+		return nil, errors.New("error updating ApplicationSets: ApplicationSets is nil in request")
+	}
+
+	// The project and the generators are checked against the template the controller will render
+	resolved, err := s.resolveTemplateRef(ctx, appset)
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := s.validateAppSet(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+
+	namespace := s.appsetNamespaceOrDefault(appset.Namespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+
+	existing, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, appset.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	resolvedExisting, err := s.resolveTemplateRef(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, resolvedExisting.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	if err := s.checkPermittedGenerators(ctx, resolved, projectName); err != nil {
+		return nil, fmt.Errorf("error checking update permissions for ApplicationSets %s : %w", appset.Name, err)
+	}
+
+	s.projectLock.RLock(projectName)
+	defer s.projectLock.RUnlock(projectName)
+
+	updated, err := s.updateAppSet(ctx, existing, appset, false)
+	if err != nil {
+		return nil, fmt.Errorf("error updating ApplicationSets: %w", err)
+	}
+	return updated, nil
+}
+
+func (s *Server) updateAppSet(ctx context.Context, appset *v1alpha1.ApplicationSet, newAppset *v1alpha1.ApplicationSet, merge bool) (*v1alpha1.ApplicationSet, error) {
+	if appset != nil {
+		if err := s.checkProjectChangePermissions(ctx, appset, newAppset); err != nil {
 			return nil, err
 		}
 	}
@@ -299,7 +586,7 @@ func (s *Server) updateAppSet(ctx context.Context, appset *v1alpha1.ApplicationS
 			appset.Annotations = newAppset.Annotations
 		}
 
-		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Update(ctx, appset, metav1.UpdateOptions{})
+		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Update(ctx, appset, metav1.UpdateOptions{})
 		if err == nil {
 			s.logAppSetEvent(ctx, appset, argo.EventReasonResourceUpdated, "updated ApplicationSets spec")
 			s.waitSync(res)
@@ -309,7 +596,7 @@ func (s *Server) updateAppSet(ctx context.Context, appset *v1alpha1.ApplicationS
 			return nil, err
 		}
 
-		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(s.ns).Get(ctx, newAppset.Name, metav1.GetOptions{})
+		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Get(ctx, newAppset.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("error getting ApplicationSets: %w", err)
 		}
@@ -317,6 +604,112 @@ func (s *Server) updateAppSet(ctx context.Context, appset *v1alpha1.ApplicationS
 	return nil, status.Errorf(codes.Internal, "Failed to update ApplicationSets. Too many conflicts")
 }
 
+// checkProjectChangePermissions checks that the caller may move the ApplicationSet to the project of the new one, if
+// they differ.
+func (s *Server) checkProjectChangePermissions(ctx context.Context, appset *v1alpha1.ApplicationSet, newAppset *v1alpha1.ApplicationSet) error {
+	// The projects are the ones of the templates rendered by the controller
+	current, err := s.resolveTemplateRef(ctx, appset)
+	if err != nil {
+		return err
+	}
+	desired, err := s.resolveTemplateRef(ctx, newAppset)
+	if err != nil {
+		return err
+	}
+	if current.Spec.Template.Spec.Project != desired.Spec.Template.Spec.Project {
+		// When changing projects, caller must have applicationset create and update privileges in new project
+		// NOTE: the update check was already verified in the caller to this function
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionCreate, desired.RBACName(s.ns)); err != nil {
+			return err
+		}
+		// They also need 'update' privileges in the old project
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, current.RBACName(s.ns)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Patch applies a JSON patch or a JSON merge patch to an ApplicationSet. The patch is sent as is to the API server, so
+// that the fields it does not touch are left as they are, even when they were changed since the ApplicationSet was read.
+func (s *Server) Patch(ctx context.Context, q *applicationset.ApplicationSetPatchRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+
+	existing, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	resolvedExisting, err := s.resolveTemplateRef(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, resolvedExisting.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	// The patch is applied locally first, to validate the resulting ApplicationSet
+	existingData, err := json.Marshal(existing)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet: %w", err)
+	}
+	var patchType types.PatchType
+	var patchedData []byte
+	switch q.PatchType {
+	case "json", "":
+		patchType = types.JSONPatchType
+		patch, err := jsonpatch.DecodePatch([]byte(q.Patch))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error decoding json patch: %v", err)
+		}
+		patchedData, err = patch.Apply(existingData)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error applying patch: %v", err)
+		}
+	case "merge":
+		patchType = types.MergePatchType
+		patchedData, err = jsonpatch.MergePatch(existingData, []byte(q.Patch))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error calculating merge patch: %v", err)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Patch type '%s' is not supported", q.PatchType)
+	}
+	patched := &v1alpha1.ApplicationSet{}
+	if err := json.Unmarshal(patchedData, patched); err != nil {
+		return nil, fmt.Errorf("error unmarshaling patched ApplicationSet: %w", err)
+	}
+
+	resolved, err := s.resolveTemplateRef(ctx, patched)
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := s.validateAppSet(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+	if err := s.checkPermittedGenerators(ctx, resolved, projectName); err != nil {
+		return nil, fmt.Errorf("error checking update permissions for ApplicationSets %s : %w", q.Name, err)
+	}
+	if err := s.checkProjectChangePermissions(ctx, existing, patched); err != nil {
+		return nil, err
+	}
+
+	s.projectLock.RLock(projectName)
+	defer s.projectLock.RUnlock(projectName)
+
+	res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, q.Name, patchType, []byte(q.Patch), metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(ctx, res, argo.EventReasonResourceUpdated, "patched ApplicationSets")
+	s.waitSync(res)
+	return res, nil
+}
+
 func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDeleteRequest) (*applicationset.ApplicationSetResponse, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
@@ -332,14 +725,128 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 	s.projectLock.RLock(appset.Spec.Template.Spec.Project)
 	defer s.projectLock.RUnlock(appset.Spec.Template.Spec.Project)
 
-	err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Delete(ctx, q.Name, metav1.DeleteOptions{})
+	deleteOptions := metav1.DeleteOptions{}
+	if q.PreserveChildren {
+		// The garbage collector removes the owner references of the Applications instead of deleting them
+		deleteOptions.PropagationPolicy = ptr.To(metav1.DeletePropagationOrphan)
+	}
+	err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Delete(ctx, q.Name, deleteOptions)
 	if err != nil {
 		return nil, fmt.Errorf("error deleting ApplicationSets: %w", err)
 	}
+	if q.PreserveChildren {
+		if err := s.releaseApplications(ctx, appset); err != nil {
+			return nil, err
+		}
+		s.logAppSetEvent(ctx, appset, argo.EventReasonResourceDeleted, "deleted ApplicationSets, preserving their Applications")
+		return &applicationset.ApplicationSetResponse{}, nil
+	}
 	s.logAppSetEvent(ctx, appset, argo.EventReasonResourceDeleted, "deleted ApplicationSets")
 	return &applicationset.ApplicationSetResponse{}, nil
 }
 
+// releaseApplications removes from the Applications of the ApplicationSet the owner reference and the annotation set by
+// the ApplicationSet controller, so that they are left as standalone Applications once the ApplicationSet is deleted.
+func (s *Server) releaseApplications(ctx context.Context, appset *v1alpha1.ApplicationSet) error {
+	apps, err := s.appclientset.ArgoprojV1alpha1().Applications(appset.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing Applications: %w", err)
+	}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		if !metav1.IsControlledBy(app, appset) {
+			continue
+		}
+		var ownerReferences []metav1.OwnerReference
+		for _, ref := range app.OwnerReferences {
+			if ref.UID != appset.UID {
+				ownerReferences = append(ownerReferences, ref)
+			}
+		}
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"ownerReferences": ownerReferences,
+				"annotations":     map[string]any{common.AnnotationApplicationSetSpecHash: nil},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error marshaling patch: %w", err)
+		}
+		_, err = s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(ctx, app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error releasing Application %s: %w", app.QualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// UpdateMetadata sets and removes annotations and labels of an ApplicationSet, so that the features driven by them can
+// be toggled without access to the cluster.
+func (s *Server) UpdateMetadata(ctx context.Context, q *applicationset.ApplicationSetMetadataRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, appset.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	patch, err := metadataPatch(q)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	patched, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, q.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(ctx, patched, argo.EventReasonResourceUpdated, "updated ApplicationSet metadata")
+	s.waitSync(patched)
+	return patched, nil
+}
+
+// metadataPatch returns the JSON merge patch setting and removing the annotations and labels of the request.
+func metadataPatch(q *applicationset.ApplicationSetMetadataRequest) ([]byte, error) {
+	errs := apivalidation.ValidateAnnotations(q.Annotations, field.NewPath("metadata", "annotations"))
+	errs = append(errs, metav1validation.ValidateLabels(q.Labels, field.NewPath("metadata", "labels"))...)
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	metadata := map[string]any{}
+	for name, fields := range map[string]struct {
+		set    map[string]string
+		remove []string
+	}{
+		"annotations": {set: q.Annotations, remove: q.RemoveAnnotations},
+		"labels":      {set: q.Labels, remove: q.RemoveLabels},
+	} {
+		if len(fields.set) == 0 && len(fields.remove) == 0 {
+			continue
+		}
+		patch := make(map[string]any, len(fields.set)+len(fields.remove))
+		for key, value := range fields.set {
+			patch[key] = value
+		}
+		for _, key := range fields.remove {
+			if _, ok := fields.set[key]; ok {
+				return nil, fmt.Errorf("%s: key %q cannot be both set and removed", name, key)
+			}
+			patch[key] = nil
+		}
+		metadata[name] = patch
+	}
+	if len(metadata) == 0 {
+		return nil, errors.New("no annotation or label to set or remove")
+	}
+	return json.Marshal(map[string]any{"metadata": metadata})
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *applicationset.ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
@@ -369,11 +876,16 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	if !s.isNamespaceEnabled(namespace) {
 		return nil, security.NamespaceNotPermittedError(namespace)
 	}
-	projectName, err := s.validateAppSet(appset)
+	// The project and the generators are checked against the template the controller will render
+	resolved, err := s.resolveTemplateRef(ctx, appset)
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := s.validateAppSet(resolved)
 	if err != nil {
 		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
 	}
-	if err := s.checkCreatePermissions(ctx, appset, projectName); err != nil {
+	if err := s.checkCreatePermissions(ctx, resolved, projectName); err != nil {
 		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %w", appset.Name, err)
 	}
 
@@ -381,8 +893,11 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	logger := log.New()
 	logger.SetOutput(logs)
 
-	apps, err := s.generateApplicationSetApps(ctx, logger.WithField("applicationset", appset.Name), *appset, namespace)
+	apps, err := s.previewApplicationSetApps(ctx, logger.WithField("applicationset", appset.Name), *resolved, namespace)
 	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, err
+		}
 		return nil, fmt.Errorf("unable to generate Applications of ApplicationSet: %w\n%s", err, logs.String())
 	}
 	res := &applicationset.ApplicationSetGenerateResponse{}
@@ -392,6 +907,134 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	return res, nil
 }
 
+// Validate renders the ApplicationSet and validates every generated Application against its project and sources.
+// With ServerSide set, the manifests of every Application are also applied to its destination cluster with a
+// server-side dry-run.
+func (s *Server) Validate(ctx context.Context, q *applicationset.ApplicationSetValidateQuery) (*applicationset.ApplicationSetValidateResponse, error) {
+	appset, err := s.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: q.Name, AppsetNamespace: q.AppsetNamespace})
+	if err != nil {
+		return nil, err
+	}
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	// Generating the Applications reaches the same external systems as Generate, so it requires the same permissions
+	// The project and the generators are checked against the template the controller will render
+	resolved, err := s.resolveTemplateRef(ctx, appset)
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := s.validateAppSet(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+	if err := s.checkCreatePermissions(ctx, resolved, projectName); err != nil {
+		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %w", appset.Name, err)
+	}
+
+	logs := bytes.NewBuffer(nil)
+	logger := log.New()
+	logger.SetOutput(logs)
+
+	apps, err := s.previewApplicationSetApps(ctx, logger.WithField("applicationset", appset.Name), *resolved, namespace)
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, err
+		}
+		return nil, fmt.Errorf("unable to generate Applications of ApplicationSet: %w\n%s", err, logs.String())
+	}
+
+	res := &applicationset.ApplicationSetValidateResponse{}
+	for i := range apps {
+		conditions, err := s.validateGeneratedApp(ctx, &apps[i], q.ServerSide)
+		if err != nil {
+			return nil, fmt.Errorf("error validating Application %s: %w", apps[i].Name, err)
+		}
+		result := &applicationset.ApplicationSetValidationResult{Application: apps[i].Name}
+		for j := range conditions {
+			result.Conditions = append(result.Conditions, &conditions[j])
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// Params returns the parameter sets produced by the generators of an ApplicationSet, along with the names of the
+// Applications rendered from them.
+func (s *Server) Params(ctx context.Context, q *applicationset.ApplicationSetParamsQuery) (*applicationset.ApplicationSetParamsResponse, error) {
+	appset, err := s.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: q.Name, AppsetNamespace: q.AppsetNamespace})
+	if err != nil {
+		return nil, err
+	}
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	// Generating the parameter sets reaches the same external systems as Generate, so it requires the same permissions
+	// The project and the generators are checked against the template the controller will render
+	resolved, err := s.resolveTemplateRef(ctx, appset)
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := s.validateAppSet(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+	if err := s.checkCreatePermissions(ctx, resolved, projectName); err != nil {
+		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %w", appset.Name, err)
+	}
+	if err := s.checkGenerateRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	logs := bytes.NewBuffer(nil)
+	logger := log.New()
+	logger.SetOutput(logs)
+
+	resolved, appSetGenerators, err := s.applicationSetGenerators(ctx, *resolved, namespace)
+	if err != nil {
+		return nil, err
+	}
+	parameterSets, err := appsettemplate.GenerateParameterSets(ctx, logger.WithField("applicationset", appset.Name), *resolved, appSetGenerators, appsetutils.NewRender(plugin.NewFunctionRegistry(s.client, s.ns, true)), s.client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate parameter sets of ApplicationSet: %w\n%s", err, logs.String())
+	}
+
+	res := &applicationset.ApplicationSetParamsResponse{}
+	for _, p := range parameterSets {
+		params, err := json.Marshal(p.Params)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling parameter set of Application %s: %w", p.Application, err)
+		}
+		res.ParameterSets = append(res.ParameterSets, &applicationset.ApplicationSetParameterSet{
+			Generator:   int32(p.Generator),
+			Params:      string(params),
+			Application: p.Application,
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) validateGeneratedApp(ctx context.Context, app *v1alpha1.Application, serverSide bool) ([]v1alpha1.ApplicationCondition, error) {
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return []v1alpha1.ApplicationCondition{{
+				Type:    v1alpha1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Application referencing project %s which does not exist", app.Spec.GetProject()),
+			}}, nil
+		}
+		return nil, fmt.Errorf("error getting Application's project %q: %w", app.Spec.GetProject(), err)
+	}
+
+	conditions, err := argo.ValidatePermissions(ctx, &app.Spec, proj, s.db)
+	if err != nil || len(conditions) > 0 {
+		return conditions, err
+	}
+
+	if serverSide {
+		return argo.DryRunApplication(ctx, app, s.repoClientSet, s.db, s.kubectl, proj, s.settings)
+	}
+	return argo.ValidateRepo(ctx, app, s.repoClientSet, s.db, s.kubectl, proj, s.settings)
+}
+
 func (s *Server) buildApplicationSetTree(a *v1alpha1.ApplicationSet) (*v1alpha1.ApplicationSetTree, error) {
 	var tree v1alpha1.ApplicationSetTree
 
@@ -434,6 +1077,10 @@ func (s *Server) validateAppSet(appset *v1alpha1.ApplicationSet) (string, error)
 		return "", err
 	}
 
+	if err := appsetvalidation.ValidateGenerators(appset).ToAggregate(); err != nil {
+		return "", err
+	}
+
 	return projectName, nil
 }
 
@@ -442,7 +1089,12 @@ func (s *Server) checkCreatePermissions(ctx context.Context, appset *v1alpha1.Ap
 		return err
 	}
 
-	_, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, projectName, metav1.GetOptions{})
+	return s.checkPermittedGenerators(ctx, appset, projectName)
+}
+
+// checkPermittedGenerators checks that the project of the ApplicationSet exists and permits its generators.
+func (s *Server) checkPermittedGenerators(ctx context.Context, appset *v1alpha1.ApplicationSet, projectName string) error {
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, projectName, metav1.GetOptions{})
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return status.Errorf(codes.InvalidArgument, "ApplicationSet references project %s which does not exist", projectName)
@@ -450,6 +1102,10 @@ func (s *Server) checkCreatePermissions(ctx context.Context, appset *v1alpha1.Ap
 		return fmt.Errorf("error getting ApplicationSet's project %q: %w", projectName, err)
 	}
 
+	if err := appsetutils.CheckPermittedGenerators(appset, proj); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
 	return nil
 }
 
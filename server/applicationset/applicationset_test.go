@@ -1,25 +1,39 @@
 package applicationset
 
 import (
+	"context"
 	"sort"
+	gosync "sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/pkg/v2/sync"
+	"github.com/golang-jwt/jwt/v5"
+	gocache "github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	appinformer "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions"
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/assets"
@@ -150,11 +164,12 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		db,
 		kubeclientset,
 		nil,
-		nil,
+		crfake.NewClientBuilder().Build(),
 		enforcer,
 		nil,
+		nil,
 		fakeAppsClientset,
-		appInformer,
+		appsetInformer,
 		factory.Argoproj().V1alpha1().ApplicationSets().Lister(),
 		fakeProjLister,
 		settingsMgr,
@@ -167,6 +182,8 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		[]string{},
 		true,
 		testEnableEventList,
+		0,
+		0,
 	)
 	return server.(*Server)
 }
@@ -362,6 +379,97 @@ func TestCreateAppSetTemplatedProject(t *testing.T) {
 	assert.EqualError(t, err, "error validating ApplicationSets: the Argo CD API does not currently support creating ApplicationSets with templated `project` fields")
 }
 
+func TestCreateAppSetGeneratorNotPermitted(t *testing.T) {
+	testAppSet := newTestAppSet()
+	appServer := newTestAppSetServer(t)
+	testAppSet.Spec.Generators = []appsv1.ApplicationSetGenerator{
+		{
+			Plugin: &appsv1.PluginGenerator{ConfigMapRef: appsv1.PluginConfigMapRef{Name: "plugin"}},
+		},
+	}
+	proj, err := appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "default", metav1.GetOptions{})
+	require.NoError(t, err)
+	proj.Spec.ApplicationSetGeneratorBlacklist = []string{"plugin"}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(t.Context(), proj, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	createReq := applicationset.ApplicationSetCreateRequest{
+		Applicationset: testAppSet,
+	}
+	_, err = appServer.Create(t.Context(), &createReq)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "contains generators which are not permitted in project default: plugin")
+}
+
+func TestCreateAppSetTemplateRef(t *testing.T) {
+	newServer := func(t *testing.T, template string) *Server {
+		t.Helper()
+		appServer := newTestAppSetServer(t)
+		repoServerClient := repomocks.RepoServerServiceClient{}
+		repoServerClient.On("GetGitFiles", mock.Anything, mock.Anything).
+			Return(&repoapiclient.GitFilesResponse{Map: map[string][]byte{"template.yaml": []byte(template)}}, nil)
+		appServer.repoClientSet = &repomocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		return appServer
+	}
+	newAppSet := func() *appsv1.ApplicationSet {
+		return newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "test"
+			appset.Spec.Template.Spec.Project = ""
+			appset.Spec.TemplateRef = &appsv1.ApplicationSetTemplateRef{
+				Git: &appsv1.ApplicationSetTemplateGitRef{RepoURL: fakeRepoURL, Path: "template.yaml"},
+			}
+		})
+	}
+
+	t.Run("templated project of the referenced template", func(t *testing.T) {
+		appServer := newServer(t, "spec:\n  project: '{{ .project }}'\n")
+		_, err := appServer.Create(t.Context(), &applicationset.ApplicationSetCreateRequest{Applicationset: newAppSet()})
+		assert.EqualError(t, err, "error validating ApplicationSets: the Argo CD API does not currently support creating ApplicationSets with templated `project` fields")
+	})
+
+	t.Run("generators checked against the project of the referenced template", func(t *testing.T) {
+		appServer := newServer(t, "spec:\n  project: my-proj\n")
+		proj, err := appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "my-proj", metav1.GetOptions{})
+		require.NoError(t, err)
+		proj.Spec.ApplicationSetGeneratorBlacklist = []string{"plugin"}
+		_, err = appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(t.Context(), proj, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		appset := newAppSet()
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{
+			{Plugin: &appsv1.PluginGenerator{ConfigMapRef: appsv1.PluginConfigMapRef{Name: "plugin"}}},
+		}
+		_, err = appServer.Create(t.Context(), &applicationset.ApplicationSetCreateRequest{Applicationset: appset})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "contains generators which are not permitted in project my-proj: plugin")
+	})
+
+	t.Run("ApplicationSet created with its template reference", func(t *testing.T) {
+		appServer := newServer(t, "spec:\n  project: my-proj\n")
+		created, err := appServer.Create(t.Context(), &applicationset.ApplicationSetCreateRequest{Applicationset: newAppSet()})
+		require.NoError(t, err)
+		assert.NotNil(t, created.Spec.TemplateRef)
+		assert.Empty(t, created.Spec.Template.Spec.Project)
+	})
+}
+
+func TestCreateAppSetInvalidGenerator(t *testing.T) {
+	testAppSet := newTestAppSet()
+	appServer := newTestAppSetServer(t)
+	testAppSet.Spec.Generators = []appsv1.ApplicationSetGenerator{
+		{
+			Git: &appsv1.GitGenerator{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		},
+	}
+	createReq := applicationset.ApplicationSetCreateRequest{
+		Applicationset: testAppSet,
+	}
+	_, err := appServer.Create(t.Context(), &createReq)
+	assert.EqualError(t, err, "error validating ApplicationSets: spec.generators[0].git: Required value: one of directories or files must be set")
+}
+
 func TestCreateAppSetWrongNamespace(t *testing.T) {
 	testAppSet := newTestAppSet()
 	appServer := newTestAppSetServer(t)
@@ -428,6 +536,133 @@ func TestCreateAppSetDryRunWithDuplicate(t *testing.T) {
 	assert.Equal(t, testAppSet.Namespace, result.Status.Resources[0].Namespace)
 }
 
+func TestGenerateAppSet(t *testing.T) {
+	testAppSet := newTestAppSet()
+	testAppSet.Spec.Template.Name = "{{name}}"
+	testAppSet.Spec.Generators = []appsv1.ApplicationSetGenerator{
+		{
+			List: &appsv1.ListGenerator{
+				Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"name": "a"}`)}, {Raw: []byte(`{"name": "b"}`)}},
+			},
+		},
+	}
+	appServer := newTestAppSetServer(t)
+
+	res, err := appServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	require.NoError(t, err)
+	require.Len(t, res.Applications, 2)
+	assert.Equal(t, "a", res.Applications[0].Name)
+	assert.Equal(t, "b", res.Applications[1].Name)
+
+	// Neither the ApplicationSet nor its Applications are created
+	appSets, err := appServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, appSets.Items)
+	apps, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, apps.Items)
+}
+
+func TestGenerateAppSetRateLimit(t *testing.T) {
+	testAppSet := newTestAppSet()
+	testAppSet.Spec.Template.Name = "{{name}}"
+	testAppSet.Spec.Generators = []appsv1.ApplicationSetGenerator{
+		{
+			List: &appsv1.ListGenerator{
+				Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"name": "a"}`)}},
+			},
+		},
+	}
+	appServer := newTestAppSetServer(t)
+	appServer.generateLimiters = gocache.New(generateLimiterExpiration, generateLimiterExpiration)
+	appServer.generateLimit = rate.Every(time.Hour)
+	appServer.generateBurst = 1
+
+	userCtx := func(user string) context.Context {
+		return context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: user})
+	}
+
+	_, err := appServer.Generate(userCtx("user1"), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	require.NoError(t, err)
+
+	_, err = appServer.Generate(userCtx("user1"), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = appServer.Create(userCtx("user1"), &applicationset.ApplicationSetCreateRequest{Applicationset: testAppSet, DryRun: true})
+	require.Error(t, err)
+
+	// Each user has their own limit
+	_, err = appServer.Generate(userCtx("user2"), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	require.NoError(t, err)
+}
+
+func TestGenerateAppSetRateLimitConcurrent(t *testing.T) {
+	appServer := newTestAppSetServer(t)
+	appServer.generateLimiters = gocache.New(generateLimiterExpiration, generateLimiterExpiration)
+	appServer.generateLimit = rate.Every(time.Hour)
+	appServer.generateBurst = 1
+
+	ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "user1"})
+	var allowed atomic.Int32
+	var wg gosync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if appServer.checkGenerateRateLimit(ctx) == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The concurrent first requests of a user share a single limiter
+	assert.Equal(t, int32(1), allowed.Load())
+}
+
+func TestGenerateAppSetCache(t *testing.T) {
+	testAppSet := newTestAppSet()
+	testAppSet.Spec.Template.Name = "{{name}}"
+	testAppSet.Spec.Generators = []appsv1.ApplicationSetGenerator{
+		{
+			List: &appsv1.ListGenerator{
+				Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"name": "a"}`)}},
+			},
+		},
+	}
+	appServer := newTestAppSetServer(t)
+	appServer.generateCache = gocache.New(time.Minute, time.Minute)
+	// Only the first request is allowed, the next ones must be served from the cache
+	appServer.generateLimiters = gocache.New(generateLimiterExpiration, generateLimiterExpiration)
+	appServer.generateLimit = rate.Every(time.Hour)
+	appServer.generateBurst = 1
+
+	res, err := appServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	require.NoError(t, err)
+	require.Len(t, res.Applications, 1)
+	assert.Equal(t, "a", res.Applications[0].Name)
+	assert.Equal(t, 1, appServer.generateCache.ItemCount())
+
+	// The cached Applications are not modified by the callers
+	res.Applications[0].Name = "modified"
+
+	res, err = appServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	require.NoError(t, err)
+	require.Len(t, res.Applications, 1)
+	assert.Equal(t, "a", res.Applications[0].Name)
+
+	result, err := appServer.Create(t.Context(), &applicationset.ApplicationSetCreateRequest{Applicationset: testAppSet, DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, result.Status.Resources, 1)
+	assert.Equal(t, "a", result.Status.Resources[0].Name)
+
+	// A different ApplicationSet is not served from the cache
+	testAppSet.Spec.Generators[0].List.Elements = []apiextensionsv1.JSON{{Raw: []byte(`{"name": "b"}`)}}
+	_, err = appServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: testAppSet})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
 func TestGetAppSet(t *testing.T) {
 	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Name = "AppSet1"
@@ -471,6 +706,141 @@ func TestGetAppSet(t *testing.T) {
 	})
 }
 
+func TestValidateAppSet(t *testing.T) {
+	testAppSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Template.Name = "{{name}}"
+		appset.Spec.Template.Spec.Source = &appsv1.ApplicationSource{RepoURL: fakeRepoURL, Path: "guestbook"}
+		appset.Spec.Template.Spec.Destination = appsv1.ApplicationDestination{Server: "{{server}}", Namespace: "guestbook"}
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{
+			{
+				List: &appsv1.ListGenerator{
+					Elements: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"name": "a", "server": "https://unknown-cluster-a.example.com"}`)},
+						{Raw: []byte(`{"name": "b", "server": "https://unknown-cluster-b.example.com"}`)},
+					},
+				},
+			},
+		}
+	})
+
+	t.Run("Validate generated Applications", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, testAppSet)
+
+		res, err := appServer.Validate(t.Context(), &applicationset.ApplicationSetValidateQuery{Name: "AppSet1"})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+
+		sort.Slice(res.Results, func(i, j int) bool {
+			return res.Results[i].Application < res.Results[j].Application
+		})
+
+		assert.Equal(t, "a", res.Results[0].Application)
+		require.Len(t, res.Results[0].Conditions, 1)
+		assert.Equal(t, appsv1.ApplicationConditionInvalidSpecError, res.Results[0].Conditions[0].Type)
+		assert.Contains(t, res.Results[0].Conditions[0].Message, "https://unknown-cluster-a.example.com")
+
+		assert.Equal(t, "b", res.Results[1].Application)
+		require.Len(t, res.Results[1].Conditions, 1)
+		assert.Contains(t, res.Results[1].Conditions[0].Message, "https://unknown-cluster-b.example.com")
+
+		_, err = appServer.Validate(t.Context(), &applicationset.ApplicationSetValidateQuery{Name: "AppSet1", AppsetNamespace: "NOT-ALLOWED"})
+		assert.EqualError(t, err, "namespace 'NOT-ALLOWED' is not permitted")
+	})
+
+	t.Run("Templated project", func(t *testing.T) {
+		appSet := testAppSet.DeepCopy()
+		appSet.Spec.Template.Spec.Project = "{{project}}"
+		appServer := newTestAppSetServer(t, appSet)
+
+		_, err := appServer.Validate(t.Context(), &applicationset.ApplicationSetValidateQuery{Name: "AppSet1"})
+		require.ErrorContains(t, err, "templated `project` fields")
+	})
+
+	t.Run("Generator not permitted by the project", func(t *testing.T) {
+		appSet := testAppSet.DeepCopy()
+		appSet.Spec.Template.Spec.Project = "my-proj"
+		appServer := newTestAppSetServer(t, appSet)
+		proj, err := appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), "my-proj", metav1.GetOptions{})
+		require.NoError(t, err)
+		proj.Spec.ApplicationSetGeneratorBlacklist = []string{"list"}
+		_, err = appServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(t.Context(), proj, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		_, err = appServer.Validate(t.Context(), &applicationset.ApplicationSetValidateQuery{Name: "AppSet1"})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Create not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, "", testAppSet)
+
+		_, err := appServer.Validate(t.Context(), &applicationset.ApplicationSetValidateQuery{Name: "AppSet1"})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestParamsAppSet(t *testing.T) {
+	testAppSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Template.Name = "{{name}}"
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{
+			{
+				List: &appsv1.ListGenerator{
+					Elements: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"name": "a", "cluster": "dev-01"}`)},
+						{Raw: []byte(`{"name": "b", "cluster": "prod-01"}`)},
+					},
+				},
+			},
+		}
+	})
+
+	t.Run("Query parameter sets", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, testAppSet)
+
+		res, err := appServer.Params(t.Context(), &applicationset.ApplicationSetParamsQuery{Name: "AppSet1"})
+		require.NoError(t, err)
+		require.Len(t, res.ParameterSets, 2)
+		assert.Equal(t, "a", res.ParameterSets[0].Application)
+		assert.JSONEq(t, `{"name": "a", "cluster": "dev-01", "index": "0", "count": "2"}`, res.ParameterSets[0].Params)
+		assert.Equal(t, "b", res.ParameterSets[1].Application)
+		assert.JSONEq(t, `{"name": "b", "cluster": "prod-01", "index": "1", "count": "2"}`, res.ParameterSets[1].Params)
+
+		_, err = appServer.Params(t.Context(), &applicationset.ApplicationSetParamsQuery{Name: "AppSet1", AppsetNamespace: "NOT-ALLOWED"})
+		assert.EqualError(t, err, "namespace 'NOT-ALLOWED' is not permitted")
+	})
+
+	t.Run("Create not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, "", testAppSet)
+
+		_, err := appServer.Params(t.Context(), &applicationset.ApplicationSetParamsQuery{Name: "AppSet1"})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Rate limited", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, testAppSet)
+		appServer.generateLimiters = gocache.New(generateLimiterExpiration, generateLimiterExpiration)
+		appServer.generateLimit = rate.Every(time.Hour)
+		appServer.generateBurst = 1
+
+		_, err := appServer.Params(t.Context(), &applicationset.ApplicationSetParamsQuery{Name: "AppSet1"})
+		require.NoError(t, err)
+
+		_, err = appServer.Params(t.Context(), &applicationset.ApplicationSetParamsQuery{Name: "AppSet1"})
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
 func TestDeleteAppSet(t *testing.T) {
 	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Name = "AppSet1"
@@ -503,6 +873,40 @@ func TestDeleteAppSet(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, &applicationset.ApplicationSetResponse{}, res)
 	})
+
+	t.Run("Delete preserving the Applications", func(t *testing.T) {
+		appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+			appset.UID = "appset1-uid"
+		})
+		newApp := func(name string, owner *appsv1.ApplicationSet) *appsv1.Application {
+			return &appsv1.Application{ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       testNamespace,
+				Annotations:     map[string]string{common.AnnotationApplicationSetSpecHash: "hash", "team": "a"},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, appsv1.ApplicationSetSchemaGroupVersionKind)},
+			}}
+		}
+		otherAppSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet2"
+			appset.UID = "appset2-uid"
+		})
+		appSetServer := newTestAppSetServer(t, appSet, otherAppSet, newApp("app1", appSet), newApp("app2", otherAppSet))
+
+		res, err := appSetServer.Delete(t.Context(), &applicationset.ApplicationSetDeleteRequest{Name: "AppSet1", PreserveChildren: true})
+		require.NoError(t, err)
+		assert.Equal(t, &applicationset.ApplicationSetResponse{}, res)
+
+		app1, err := appSetServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "app1", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, app1.OwnerReferences)
+		assert.Equal(t, map[string]string{"team": "a"}, app1.Annotations)
+
+		app2, err := appSetServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "app2", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Len(t, app2.OwnerReferences, 1)
+		assert.Contains(t, app2.Annotations, common.AnnotationApplicationSetSpecHash)
+	})
 }
 
 func TestUpdateAppSet(t *testing.T) {
@@ -557,6 +961,266 @@ func TestUpdateAppSet(t *testing.T) {
 	})
 }
 
+func TestUpdateAppSetRequest(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Labels = map[string]string{"env": "dev"}
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{{List: &appsv1.ListGenerator{}}}
+	})
+
+	newAppSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Labels = map[string]string{"env": "prod"}
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{{Clusters: &appsv1.ClusterGenerator{}}}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet)
+
+		updated, err := appServer.Update(t.Context(), &applicationset.ApplicationSetUpdateRequest{Applicationset: newAppSet})
+		require.NoError(t, err)
+		assert.Equal(t, newAppSet.Spec, updated.Spec)
+		assert.Equal(t, map[string]string{"env": "prod"}, updated.Labels)
+	})
+
+	t.Run("Update missing ApplicationSet", func(t *testing.T) {
+		appServer := newTestAppSetServer(t)
+
+		_, err := appServer.Update(t.Context(), &applicationset.ApplicationSetUpdateRequest{Applicationset: newAppSet})
+		require.ErrorContains(t, err, "error getting ApplicationSet")
+	})
+
+	t.Run("Update not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, "", appSet)
+
+		_, err := appServer.Update(t.Context(), &applicationset.ApplicationSetUpdateRequest{Applicationset: newAppSet})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+type testWatchServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *applicationset.ApplicationSetWatchEvent
+}
+
+func (s *testWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchServer) Send(event *applicationset.ApplicationSetWatchEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestWatchAppSet(t *testing.T) {
+	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+	})
+	appSet2 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet2"
+		appset.Spec.Template.Spec.Project = "my-proj"
+	})
+	appSet3 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet3"
+		appset.Spec.Template.Spec.Project = "my-proj"
+	})
+
+	// receive returns the names of the ApplicationSets of the events received until the event of the given type for
+	// the given ApplicationSet. The informer may replay its ADDED events after the initial events of the watch.
+	receive := func(t *testing.T, events chan *applicationset.ApplicationSetWatchEvent, eventType, name string) []string {
+		t.Helper()
+		var names []string
+		for {
+			select {
+			case event := <-events:
+				names = append(names, event.ApplicationSet.Name)
+				if event.Type == eventType && event.ApplicationSet.Name == name {
+					return names
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timeout waiting for the %s event of %s", eventType, name)
+				return nil
+			}
+		}
+	}
+
+	watch := func(t *testing.T, appServer *Server, q *applicationset.ApplicationSetWatchQuery) chan *applicationset.ApplicationSetWatchEvent {
+		t.Helper()
+		ctx, cancel := context.WithCancel(t.Context())
+		ws := &testWatchServer{ctx: ctx, events: make(chan *applicationset.ApplicationSetWatchEvent, 10)}
+		done := make(chan error)
+		go func() {
+			done <- appServer.Watch(q, ws)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-done)
+		})
+		return ws.events
+	}
+
+	t.Run("Existing and new ApplicationSets", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet1, appSet2)
+		events := watch(t, appServer, &applicationset.ApplicationSetWatchQuery{})
+
+		assert.Equal(t, []string{"AppSet1", "AppSet2"}, receive(t, events, "ADDED", "AppSet2"))
+
+		_, err := appServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).Create(t.Context(), appSet3, metav1.CreateOptions{})
+		require.NoError(t, err)
+		receive(t, events, "ADDED", "AppSet3")
+
+		err = appServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).Delete(t.Context(), "AppSet1", metav1.DeleteOptions{})
+		require.NoError(t, err)
+		receive(t, events, "DELETED", "AppSet1")
+	})
+
+	t.Run("Filter by project", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet1, appSet2)
+		events := watch(t, appServer, &applicationset.ApplicationSetWatchQuery{Projects: []string{"my-proj"}})
+
+		_, err := appServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).Create(t.Context(), appSet3, metav1.CreateOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, receive(t, events, "ADDED", "AppSet3"), "AppSet1")
+	})
+
+	t.Run("Filter by name", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet1, appSet2)
+		events := watch(t, appServer, &applicationset.ApplicationSetWatchQuery{Name: "AppSet1", ResourceVersion: "1"})
+
+		assert.Equal(t, []string{"AppSet1"}, receive(t, events, "ADDED", "AppSet1"))
+	})
+
+	t.Run("Not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(`p, role:default, applicationsets, get, my-proj/*, allow`)
+			enf.SetDefaultRole("role:default")
+		}, "", appSet1, appSet2)
+		events := watch(t, appServer, &applicationset.ApplicationSetWatchQuery{})
+
+		_, err := appServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).Create(t.Context(), appSet3, metav1.CreateOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, receive(t, events, "ADDED", "AppSet3"), "AppSet1")
+	})
+}
+
+func TestUpdateAppSetMetadata(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Annotations = map[string]string{"team": "a"}
+		appset.Labels = map[string]string{"env": "dev"}
+	})
+
+	t.Run("Set and remove annotations and labels", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet)
+
+		updated, err := appServer.UpdateMetadata(t.Context(), &applicationset.ApplicationSetMetadataRequest{
+			Name:              "AppSet1",
+			Annotations:       map[string]string{common.AnnotationApplicationSetRefresh: "true"},
+			RemoveAnnotations: []string{"team"},
+			Labels:            map[string]string{common.LabelKeyApplicationSetControllerInstance: "shard-1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{common.AnnotationApplicationSetRefresh: "true"}, updated.Annotations)
+		assert.Equal(t, map[string]string{"env": "dev", common.LabelKeyApplicationSetControllerInstance: "shard-1"}, updated.Labels)
+		assert.Equal(t, appSet.Spec, updated.Spec)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet)
+
+		for _, c := range []struct {
+			req           *applicationset.ApplicationSetMetadataRequest
+			expectedError string
+		}{
+			{req: &applicationset.ApplicationSetMetadataRequest{Name: "AppSet1"}, expectedError: "no annotation or label to set or remove"},
+			{req: &applicationset.ApplicationSetMetadataRequest{Name: "AppSet1", Labels: map[string]string{"env": "not a label value"}}, expectedError: "metadata.labels: Invalid value"},
+			{req: &applicationset.ApplicationSetMetadataRequest{Name: "AppSet1", Annotations: map[string]string{"team": "b"}, RemoveAnnotations: []string{"team"}}, expectedError: `annotations: key "team" cannot be both set and removed`},
+		} {
+			_, err := appServer.UpdateMetadata(t.Context(), c.req)
+			require.ErrorContains(t, err, c.expectedError)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("Update not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, "", appSet)
+
+		_, err := appServer.UpdateMetadata(t.Context(), &applicationset.ApplicationSetMetadataRequest{
+			Name:        "AppSet1",
+			Annotations: map[string]string{common.AnnotationApplicationSetRefresh: "true"},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestPatchAppSet(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Template.Labels = map[string]string{"env": "dev", "team": "a"}
+	})
+
+	t.Run("JSON patch", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet)
+
+		patched, err := appServer.Patch(t.Context(), &applicationset.ApplicationSetPatchRequest{
+			Name:  "AppSet1",
+			Patch: `[{"op": "remove", "path": "/spec/template/metadata/labels/team"}]`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"env": "dev"}, patched.Spec.Template.Labels)
+		assert.Equal(t, appSet.Spec.Generators, patched.Spec.Generators)
+	})
+
+	t.Run("Merge patch", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet)
+
+		patched, err := appServer.Patch(t.Context(), &applicationset.ApplicationSetPatchRequest{
+			Name:      "AppSet1",
+			Patch:     `{"spec": {"template": {"metadata": {"labels": {"env": null}}}}}`,
+			PatchType: "merge",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"team": "a"}, patched.Spec.Template.Labels)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		appServer := newTestAppSetServer(t, appSet)
+
+		for _, c := range []struct {
+			req           *applicationset.ApplicationSetPatchRequest
+			expectedError string
+		}{
+			{req: &applicationset.ApplicationSetPatchRequest{Name: "AppSet1", Patch: `{}`, PatchType: "strategic"}, expectedError: "Patch type 'strategic' is not supported"},
+			{req: &applicationset.ApplicationSetPatchRequest{Name: "AppSet1", Patch: `not a patch`}, expectedError: "error decoding json patch"},
+			{req: &applicationset.ApplicationSetPatchRequest{Name: "AppSet1", Patch: `[{"op": "remove", "path": "/spec/template/metadata/labels/missing"}]`}, expectedError: "error applying patch"},
+		} {
+			_, err := appServer.Patch(t.Context(), c.req)
+			require.ErrorContains(t, err, c.expectedError)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("Patch not permitted", func(t *testing.T) {
+		appServer := newTestAppSetServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, "", appSet)
+
+		_, err := appServer.Patch(t.Context(), &applicationset.ApplicationSetPatchRequest{
+			Name:  "AppSet1",
+			Patch: `[{"op": "remove", "path": "/spec/template/metadata/labels/team"}]`,
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
 func TestResourceTree(t *testing.T) {
 	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Name = "AppSet1"
@@ -0,0 +1,55 @@
+package applicationset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestBroadcasterHandler_SubscribeUnsubscribe(t *testing.T) {
+	broadcaster := broadcasterHandler{}
+
+	subscriber := make(chan *applicationset.ApplicationSetWatchEvent)
+	unsubscribe := broadcaster.Subscribe(subscriber)
+
+	assert.Len(t, broadcaster.subscribers, 1)
+
+	unsubscribe()
+	assert.Empty(t, broadcaster.subscribers)
+}
+
+func TestBroadcasterHandler_ReceiveEvents(t *testing.T) {
+	broadcaster := broadcasterHandler{}
+
+	subscriber1 := make(chan *applicationset.ApplicationSetWatchEvent, 1000)
+	subscriber2 := make(chan *applicationset.ApplicationSetWatchEvent, 1000)
+
+	_ = broadcaster.Subscribe(subscriber1)
+	_ = broadcaster.Subscribe(subscriber2)
+
+	firstReceived := false
+	secondReceived := false
+
+	go broadcaster.OnUpdate(nil, &appsv1.ApplicationSet{})
+
+	for {
+		select {
+		case <-time.After(1 * time.Second):
+			t.Error("timeout expired")
+			return
+		case event := <-subscriber1:
+			assert.Equal(t, "MODIFIED", event.Type)
+			firstReceived = true
+		case event := <-subscriber2:
+			assert.Equal(t, "MODIFIED", event.Type)
+			secondReceived = true
+		}
+		if firstReceived && secondReceived {
+			return
+		}
+	}
+}
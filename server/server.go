@@ -248,6 +248,10 @@ type ApplicationSetOpts struct {
 	ScmRootCAPath            string
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
+	// GenerateRateLimit is the maximum number of ApplicationSet generate requests per minute per user, 0 for no limit
+	GenerateRateLimit int
+	// GenerateCacheExpiration is the expiration of the Applications generated for an ApplicationSet, 0 for no caching
+	GenerateCacheExpiration time.Duration
 }
 
 // GracefulRestartSignal implements a signal to be used for a graceful restart trigger.
@@ -1037,6 +1041,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.KubeControllerClientset,
 		a.enf,
 		a.RepoClientset,
+		kubectl,
 		a.AppClientset,
 		a.appsetInformer,
 		a.appsetLister,
@@ -1051,6 +1056,8 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.AllowedScmProviders,
 		a.EnableScmProviders,
 		a.EnableK8sEvent,
+		a.GenerateRateLimit,
+		a.GenerateCacheExpiration,
 	)
 
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db, a.EnableK8sEvent)
@@ -20,11 +20,15 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
 	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -294,6 +298,100 @@ func ValidateRepo(
 	proj *argoappv1.AppProject,
 	settingsMgr *settings.SettingsManager,
 ) ([]argoappv1.ApplicationCondition, error) {
+	conditions, _, err := validateRepoAndGenerateManifests(ctx, app, repoClientset, db, kubectl, proj, settingsMgr)
+	return conditions, err
+}
+
+// DryRunApplication validates the application sources like ValidateRepo does, and then applies the generated
+// manifests to the destination cluster using a server-side dry-run, the same way the application controller applies
+// them during a sync. Manifests rejected by the cluster (e.g. because of a schema or API version mismatch) are
+// reported as InvalidSpecError conditions.
+func DryRunApplication(
+	ctx context.Context,
+	app *argoappv1.Application,
+	repoClientset apiclient.Clientset,
+	db db.ArgoDB,
+	kubectl kube.Kubectl,
+	proj *argoappv1.AppProject,
+	settingsMgr *settings.SettingsManager,
+) ([]argoappv1.ApplicationCondition, error) {
+	conditions, generated, err := validateRepoAndGenerateManifests(ctx, app, repoClientset, db, kubectl, proj, settingsMgr)
+	if err != nil || len(conditions) > 0 || generated == nil {
+		return conditions, err
+	}
+
+	openAPISchema, _, err := kubectl.LoadOpenAPISchema(generated.config)
+	if err != nil {
+		return nil, fmt.Errorf("error loading OpenAPI schema: %w", err)
+	}
+	resourceOps, cleanup, err := kubectl.ManageResources(generated.config, openAPISchema)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing resource operations: %w", err)
+	}
+	defer cleanup()
+
+	syncOptions := argoappv1.SyncOptions{}
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+	serverSideApply := syncOptions.HasOption(common.SyncOptionServerSideApply)
+	createNamespace := syncOptions.HasOption("CreateNamespace=true")
+
+	for _, manifest := range generated.manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Unable to parse generated manifest: %v", err),
+			})
+			continue
+		}
+		if obj.GetNamespace() == "" && isNamespacedOrUnknown(generated.apiResources, obj.GroupVersionKind().GroupKind()) {
+			obj.SetNamespace(app.Spec.Destination.Namespace)
+		}
+		_, err := resourceOps.ApplyResource(ctx, obj, cmdutil.DryRunServer, false, true, serverSideApply, argocommon.ArgoCDSSAManager)
+		if err != nil {
+			// the destination namespace doesn't exist yet, but it will be created by the sync
+			if createNamespace && obj.GetNamespace() == app.Spec.Destination.Namespace && apierrors.IsNotFound(err) {
+				continue
+			}
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Server-side dry-run of %s/%s %s failed: %v", obj.GetKind(), obj.GetName(), obj.GetNamespace(), err),
+			})
+		}
+	}
+
+	return conditions, nil
+}
+
+// isNamespacedOrUnknown returns false only if the given kind is known to be cluster-scoped
+func isNamespacedOrUnknown(apiResources []kube.APIResourceInfo, gk schema.GroupKind) bool {
+	for _, res := range apiResources {
+		if res.GroupKind == gk {
+			return res.Meta.Namespaced
+		}
+	}
+	return true
+}
+
+// generatedManifests holds the manifests generated for an application, along with the destination cluster they
+// were generated for
+type generatedManifests struct {
+	manifests    []string
+	config       *rest.Config
+	apiResources []kube.APIResourceInfo
+}
+
+func validateRepoAndGenerateManifests(
+	ctx context.Context,
+	app *argoappv1.Application,
+	repoClientset apiclient.Clientset,
+	db db.ArgoDB,
+	kubectl kube.Kubectl,
+	proj *argoappv1.AppProject,
+	settingsMgr *settings.SettingsManager,
+) ([]argoappv1.ApplicationCondition, *generatedManifests, error) {
 	spec := &app.Spec
 
 	conditions := make([]argoappv1.ApplicationCondition, 0)
@@ -301,30 +399,30 @@ func ValidateRepo(
 	// Test the repo
 	conn, repoClient, err := repoClientset.NewRepoServerClient()
 	if err != nil {
-		return nil, fmt.Errorf("error instantiating new repo server client: %w", err)
+		return nil, nil, fmt.Errorf("error instantiating new repo server client: %w", err)
 	}
 	defer io.Close(conn)
 
 	helmOptions, err := settingsMgr.GetHelmSettings()
 	if err != nil {
-		return nil, fmt.Errorf("error getting helm settings: %w", err)
+		return nil, nil, fmt.Errorf("error getting helm settings: %w", err)
 	}
 
 	helmRepos, err := db.ListHelmRepositories(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error listing helm repos: %w", err)
+		return nil, nil, fmt.Errorf("error listing helm repos: %w", err)
 	}
 	permittedHelmRepos, err := GetPermittedRepos(proj, helmRepos)
 	if err != nil {
-		return nil, fmt.Errorf("error getting permitted repos: %w", err)
+		return nil, nil, fmt.Errorf("error getting permitted repos: %w", err)
 	}
 	helmRepositoryCredentials, err := db.GetAllHelmRepositoryCredentials(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error getting helm repo creds: %w", err)
+		return nil, nil, fmt.Errorf("error getting helm repo creds: %w", err)
 	}
 	permittedHelmCredentials, err := GetPermittedReposCredentials(proj, helmRepositoryCredentials)
 	if err != nil {
-		return nil, fmt.Errorf("error getting permitted repo creds: %w", err)
+		return nil, nil, fmt.Errorf("error getting permitted repo creds: %w", err)
 	}
 
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
@@ -333,27 +431,27 @@ func ValidateRepo(
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: fmt.Sprintf("Unable to get cluster: %v", err),
 		})
-		return conditions, nil
+		return conditions, nil, nil
 	}
 	config, err := destCluster.RESTConfig()
 	if err != nil {
-		return nil, fmt.Errorf("error getting cluster REST config: %w", err)
+		return nil, nil, fmt.Errorf("error getting cluster REST config: %w", err)
 	}
 	//nolint:staticcheck
 	destCluster.ServerVersion, err = kubectl.GetServerVersion(config)
 	if err != nil {
-		return nil, fmt.Errorf("error getting k8s server version: %w", err)
+		return nil, nil, fmt.Errorf("error getting k8s server version: %w", err)
 	}
 	apiGroups, err := kubectl.GetAPIResources(config, false, cache.NewNoopSettings())
 	if err != nil {
-		return nil, fmt.Errorf("error getting API resources: %w", err)
+		return nil, nil, fmt.Errorf("error getting API resources: %w", err)
 	}
 	enabledSourceTypes, err := settingsMgr.GetEnabledSourceTypes()
 	if err != nil {
-		return nil, fmt.Errorf("error getting enabled source types: %w", err)
+		return nil, nil, fmt.Errorf("error getting enabled source types: %w", err)
 	}
 
-	sourceCondition, err := validateRepo(
+	sourceCondition, manifests, err := validateRepo(
 		ctx,
 		app,
 		db,
@@ -368,11 +466,11 @@ func ValidateRepo(
 		enabledSourceTypes,
 		settingsMgr)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	conditions = append(conditions, sourceCondition...)
 
-	return conditions, nil
+	return conditions, &generatedManifests{manifests: manifests, config: config, apiResources: apiGroups}, nil
 }
 
 func validateRepo(ctx context.Context,
@@ -388,14 +486,14 @@ func validateRepo(ctx context.Context,
 	permittedHelmCredentials []*argoappv1.RepoCreds,
 	enabledSourceTypes map[string]bool,
 	settingsMgr *settings.SettingsManager,
-) ([]argoappv1.ApplicationCondition, error) {
+) ([]argoappv1.ApplicationCondition, []string, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
 	errMessage := ""
 
 	for _, source := range sources {
 		repo, err := db.GetRepository(ctx, source.RepoURL, proj.Name)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci()); err != nil {
 			errMessage = fmt.Sprintf("repositories not accessible: %v: %v", repo.StringForLogging(), err)
@@ -414,12 +512,12 @@ func validateRepo(ctx context.Context,
 		// Verify only one source type is defined
 		_, err = source.ExplicitType()
 		if err != nil {
-			return nil, fmt.Errorf("error verifying source type: %w", err)
+			return nil, nil, fmt.Errorf("error verifying source type: %w", err)
 		}
 
 		// is the repo inaccessible - abort now
 		if !repoAccessible {
-			return conditions, nil
+			return conditions, nil, nil
 		}
 	}
 
@@ -431,9 +529,9 @@ func validateRepo(ctx context.Context,
 
 	refSources, err := GetRefSources(ctx, sources, app.Spec.Project, db.GetRepository, []string{}, false)
 	if err != nil {
-		return nil, fmt.Errorf("error getting ref sources: %w", err)
+		return nil, nil, fmt.Errorf("error getting ref sources: %w", err)
 	}
-	conditions = append(conditions, verifyGenerateManifests(
+	manifestConditions, manifests := verifyGenerateManifests(
 		ctx,
 		db,
 		permittedHelmRepos,
@@ -448,9 +546,10 @@ func validateRepo(ctx context.Context,
 		permittedHelmCredentials,
 		enabledSourceTypes,
 		settingsMgr,
-		refSources)...)
+		refSources)
+	conditions = append(conditions, manifestConditions...)
 
-	return conditions, nil
+	return conditions, manifests, nil
 }
 
 // GetRefSources creates a map of ref keys (from the sources' 'ref' fields) to information about the referenced source.
@@ -726,8 +825,9 @@ func verifyGenerateManifests(
 	enableGenerateManifests map[string]bool,
 	settingsMgr *settings.SettingsManager,
 	refSources argoappv1.RefTargetRevisionMapping,
-) []argoappv1.ApplicationCondition {
+) ([]argoappv1.ApplicationCondition, []string) {
 	var conditions []argoappv1.ApplicationCondition
+	var manifests []string
 	// If source is Kustomize add build options
 	kustomizeSettings, err := settingsMgr.GetKustomizeSettings()
 	if err != nil {
@@ -735,7 +835,7 @@ func verifyGenerateManifests(
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: fmt.Sprintf("Error getting Kustomize settings: %v", err),
 		})
-		return conditions // Can't perform the next check without settings.
+		return conditions, nil // Can't perform the next check without settings.
 	}
 
 	for _, source := range sources {
@@ -813,17 +913,19 @@ func verifyGenerateManifests(
 
 		// Only check whether we can access the application's path,
 		// and not whether it actually contains any manifests.
-		_, err = repoClient.GenerateManifest(ctx, &req)
+		res, err := repoClient.GenerateManifest(ctx, &req)
 		if err != nil {
 			errMessage := fmt.Sprintf("Unable to generate manifests in %s: %s", source.Path, err)
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: errMessage,
 			})
+			continue
 		}
+		manifests = append(manifests, res.GetManifests()...)
 	}
 
-	return conditions
+	return conditions, manifests
 }
 
 // SetAppOperation updates an application with the specified operation, retrying conflict errors
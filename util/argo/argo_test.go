@@ -19,7 +19,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 
@@ -316,7 +319,7 @@ func TestValidateChartWithoutRevision(t *testing.T) {
 			Server: "https://kubernetes.default.svc", Namespace: "default",
 		},
 	}
-	cluster := &argoappv1.Cluster{Server: "https://kubernetes.default.svc"}
+	cluster := &argoappv1.Cluster{Server: "https://cluster.example.com"}
 	db := &dbmocks.ArgoDB{}
 	ctx := t.Context()
 	db.On("GetCluster", ctx, appSpec.Destination.Server).Return(cluster, nil)
@@ -459,6 +462,105 @@ func TestValidateRepo(t *testing.T) {
 	assert.Equal(t, kustomizeOptions, receivedRequest.KustomizeOptions)
 }
 
+type dryRunResourceOps struct {
+	kubetest.MockResourceOps
+	applied []*unstructured.Unstructured
+	errors  map[string]error
+}
+
+func (r *dryRunResourceOps) ApplyResource(_ context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, _, _, _ bool, _ string) (string, error) {
+	if dryRunStrategy != cmdutil.DryRunServer {
+		return "", fmt.Errorf("unexpected dry-run strategy %v", dryRunStrategy)
+	}
+	r.applied = append(r.applied, obj)
+	return "", r.errors[obj.GetName()]
+}
+
+type dryRunKubectl struct {
+	kubetest.MockKubectlCmd
+	resourceOps *dryRunResourceOps
+}
+
+func (k *dryRunKubectl) ManageResources(_ *rest.Config, _ openapi.Resources) (kube.ResourceOperations, func(), error) {
+	return k.resourceOps, func() {}, nil
+}
+
+func TestDryRunApplication(t *testing.T) {
+	repo := &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps", Type: "git"}
+	cluster := &argoappv1.Cluster{Server: "https://cluster.example.com"}
+	app := &argoappv1.Application{
+		Spec: argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL: repo.Repo,
+				Path:    "guestbook",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    cluster.Server,
+				Namespace: "guestbook",
+			},
+		},
+	}
+	proj := &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos: []string{"*"},
+		},
+	}
+
+	repoClient := &mocks.RepoServerServiceClient{}
+	repoClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{VerifiedRepository: true}, nil)
+	repoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+		Manifests: []string{
+			`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui"}}`,
+			`{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"guestbook-role"}}`,
+			`{"apiVersion":"batch/v1beta1","kind":"CronJob","metadata":{"name":"guestbook-cron"}}`,
+		},
+	}, nil)
+	repoClientSet := &mocks.Clientset{RepoServerServiceClient: repoClient}
+
+	db := &dbmocks.ArgoDB{}
+	db.On("GetRepository", mock.Anything, repo.Repo, "").Return(repo, nil)
+	db.On("ListHelmRepositories", mock.Anything).Return(nil, nil)
+	db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	db.On("GetAllHelmRepositoryCredentials", mock.Anything).Return(nil, nil)
+
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace)
+
+	resourceOps := &dryRunResourceOps{errors: map[string]error{
+		"guestbook-cron": errors.New(`no matches for kind "CronJob" in version "batch/v1beta1"`),
+	}}
+	kubectl := &dryRunKubectl{
+		MockKubectlCmd: kubetest.MockKubectlCmd{
+			Version: "v1.32",
+			APIResources: []kube.APIResourceInfo{{
+				GroupKind:            schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+				GroupVersionResource: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+				Meta:                 metav1.APIResource{Namespaced: false},
+			}},
+		},
+		resourceOps: resourceOps,
+	}
+
+	conditions, err := DryRunApplication(t.Context(), app, repoClientSet, db, kubectl, proj, settingsMgr)
+	require.NoError(t, err)
+
+	require.Len(t, resourceOps.applied, 3)
+	assert.Equal(t, "guestbook", resourceOps.applied[0].GetNamespace())
+	assert.Empty(t, resourceOps.applied[1].GetNamespace())
+	require.Len(t, conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "CronJob/guestbook-cron")
+	assert.Contains(t, conditions[0].Message, `no matches for kind "CronJob"`)
+}
+
 func TestFormatAppConditions(t *testing.T) {
 	conditions := []argoappv1.ApplicationCondition{
 		{
@@ -48,6 +48,13 @@ type RevisionMetadata struct {
 	Message string
 }
 
+// FileCommit is the last commit modifying a file
+type FileCommit struct {
+	SHA    string
+	Author string
+	Date   time.Time
+}
+
 // this should match reposerver/repository/repository.proto/RefsList
 type Refs struct {
 	Branches []string
@@ -74,6 +81,9 @@ type Client interface {
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
+	// LastFileCommit returns the last commit modifying the given file, relative to the root of the repository, at the
+	// checked out revision.
+	LastFileCommit(path string) (*FileCommit, error)
 	VerifyCommitSignature(string) (string, error)
 	IsAnnotatedTag(string) bool
 	ChangedFiles(revision string, targetRevision string) ([]string, error)
@@ -784,6 +794,23 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
+// LastFileCommit returns the last commit modifying the given file at the checked out revision, with its committer date
+func (m *nativeGitClient) LastFileCommit(path string) (*FileCommit, error) {
+	out, err := m.runCmd("log", "-1", "--format=%H%n%an <%ae>%n%ct", "--", path)
+	if err != nil {
+		return nil, err
+	}
+	segments := strings.Split(out, "\n")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("no commit found for file %s", path)
+	}
+	commitDateUnixTimestamp, err := strconv.ParseInt(segments[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit date %q: %w", segments[2], err)
+	}
+	return &FileCommit{SHA: segments[0], Author: segments[1], Date: time.Unix(commitDateUnixTimestamp, 0)}, nil
+}
+
 // VerifyCommitSignature Runs verify-commit on a given revision and returns the output
 func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error) {
 	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", revision)
@@ -508,6 +508,41 @@ func Test_nativeGitClient_RevisionMetadata(t *testing.T) {
 	}, metadata)
 }
 
+func Test_nativeGitClient_LastFileCommit(t *testing.T) {
+	tempDir := t.TempDir()
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = runCmd(client.Root(), "git", "config", "user.name", "FooBar")
+	require.NoError(t, err)
+	err = runCmd(client.Root(), "git", "config", "user.email", "foo@foo.com")
+	require.NoError(t, err)
+
+	for _, file := range []string{"config.yaml", "other.yaml"} {
+		err = os.WriteFile(path.Join(client.Root(), file), []byte("Hello."), 0o644)
+		require.NoError(t, err)
+		err = runCmd(client.Root(), "git", "add", file)
+		require.NoError(t, err)
+		err = runCmd(client.Root(), "git", "commit", "-m", "Add "+file)
+		require.NoError(t, err)
+	}
+
+	sha, err := client.(*nativeGitClient).runCmd("rev-parse", "HEAD~1")
+	require.NoError(t, err)
+
+	commit, err := client.LastFileCommit("config.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, sha, commit.SHA)
+	assert.Equal(t, "FooBar <foo@foo.com>", commit.Author)
+	assert.False(t, commit.Date.IsZero())
+
+	_, err = client.LastFileCommit("missing.yaml")
+	require.Error(t, err)
+}
+
 func Test_nativeGitClient_SetAuthor(t *testing.T) {
 	expectedName := "Tester"
 	expectedEmail := "test@example.com"
@@ -254,6 +254,36 @@ func (_m *Client) IsRevisionPresent(revision string) bool {
 	return r0
 }
 
+// LastFileCommit provides a mock function with given fields: path
+func (_m *Client) LastFileCommit(path string) (*git.FileCommit, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastFileCommit")
+	}
+
+	var r0 *git.FileCommit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*git.FileCommit, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) *git.FileCommit); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.FileCommit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LsFiles provides a mock function with given fields: path, enableNewGitFileGlobbing
 func (_m *Client) LsFiles(path string, enableNewGitFileGlobbing bool) ([]string, error) {
 	ret := _m.Called(path, enableNewGitFileGlobbing)
@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceIDExemplarLabel is the exemplar label holding the ID of the trace an observation belongs to.
+const TraceIDExemplarLabel = "trace_id"
+
+// ObserveWithTraceExemplar observes the given value and, if the context holds a sampled span, attaches the ID of its
+// trace as an exemplar, so that the observation can be linked to the corresponding trace. Exemplars are only exposed
+// by metrics handlers serving the OpenMetrics format.
+func ObserveWithTraceExemplar(ctx context.Context, observer prometheus.Observer, value float64) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok && spanContext.IsValid() && spanContext.IsSampled() {
+		exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{TraceIDExemplarLabel: spanContext.TraceID().String()})
+		return
+	}
+	observer.Observe(value)
+}
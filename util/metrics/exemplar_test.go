@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserveWithTraceExemplar(t *testing.T) {
+	traceID := trace.TraceID{0x01, 0x02, 0x03}
+	spanID := trace.SpanID{0x04, 0x05}
+
+	observe := func(ctx context.Context) *dto.Histogram {
+		histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test"})
+		ObserveWithTraceExemplar(ctx, histogram, 0.5)
+		var metric dto.Metric
+		require.NoError(t, histogram.Write(&metric))
+		return metric.GetHistogram()
+	}
+
+	t.Run("sampled span", func(t *testing.T) {
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		histogram := observe(ctx)
+
+		assert.Equal(t, uint64(1), histogram.GetSampleCount())
+		var exemplars []*dto.Exemplar
+		for _, bucket := range histogram.GetBucket() {
+			if bucket.GetExemplar() != nil {
+				exemplars = append(exemplars, bucket.GetExemplar())
+			}
+		}
+		require.Len(t, exemplars, 1)
+		require.Len(t, exemplars[0].GetLabel(), 1)
+		assert.Equal(t, TraceIDExemplarLabel, exemplars[0].GetLabel()[0].GetName())
+		assert.Equal(t, traceID.String(), exemplars[0].GetLabel()[0].GetValue())
+	})
+
+	t.Run("unsampled span", func(t *testing.T) {
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+		}))
+
+		histogram := observe(ctx)
+
+		assert.Equal(t, uint64(1), histogram.GetSampleCount())
+		for _, bucket := range histogram.GetBucket() {
+			assert.Nil(t, bucket.GetExemplar())
+		}
+	})
+
+	t.Run("no span", func(t *testing.T) {
+		histogram := observe(context.Background())
+
+		assert.Equal(t, uint64(1), histogram.GetSampleCount())
+		for _, bucket := range histogram.GetBucket() {
+			assert.Nil(t, bucket.GetExemplar())
+		}
+	})
+}